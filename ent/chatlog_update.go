@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/chatlog"
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChatLogUpdate is the builder for updating ChatLog entities.
+type ChatLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatLogMutation
+}
+
+// Where appends a list predicates to the ChatLogUpdate builder.
+func (_u *ChatLogUpdate) Where(ps ...predicate.ChatLog) *ChatLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatLogUpdate) SetUserID(v int64) *ChatLogUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatLogUpdate) SetNillableUserID(v *int64) *ChatLogUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ChatLogUpdate) AddUserID(v int64) *ChatLogUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ChatLogUpdate) SetTargetID(v int64) *ChatLogUpdate {
+	_u.mutation.ResetTargetID()
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ChatLogUpdate) SetNillableTargetID(v *int64) *ChatLogUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// AddTargetID adds value to the "target_id" field.
+func (_u *ChatLogUpdate) AddTargetID(v int64) *ChatLogUpdate {
+	_u.mutation.AddTargetID(v)
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ChatLogUpdate) SetChannel(v string) *ChatLogUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ChatLogUpdate) SetNillableChannel(v *string) *ChatLogUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ChatLogUpdate) SetMessage(v string) *ChatLogUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ChatLogUpdate) SetNillableMessage(v *string) *ChatLogUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ChatLogUpdate) SetCreatedAt(v time.Time) *ChatLogUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ChatLogUpdate) SetNillableCreatedAt(v *time.Time) *ChatLogUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChatLogMutation object of the builder.
+func (_u *ChatLogUpdate) Mutation() *ChatLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ChatLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chatlog.Table, chatlog.Columns, sqlgraph.NewFieldSpec(chatlog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(chatlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(chatlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(chatlog.FieldTargetID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetID(); ok {
+		_spec.AddField(chatlog.FieldTargetID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(chatlog.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(chatlog.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(chatlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatLogUpdateOne is the builder for updating a single ChatLog entity.
+type ChatLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatLogMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatLogUpdateOne) SetUserID(v int64) *ChatLogUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatLogUpdateOne) SetNillableUserID(v *int64) *ChatLogUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ChatLogUpdateOne) AddUserID(v int64) *ChatLogUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ChatLogUpdateOne) SetTargetID(v int64) *ChatLogUpdateOne {
+	_u.mutation.ResetTargetID()
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ChatLogUpdateOne) SetNillableTargetID(v *int64) *ChatLogUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// AddTargetID adds value to the "target_id" field.
+func (_u *ChatLogUpdateOne) AddTargetID(v int64) *ChatLogUpdateOne {
+	_u.mutation.AddTargetID(v)
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ChatLogUpdateOne) SetChannel(v string) *ChatLogUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ChatLogUpdateOne) SetNillableChannel(v *string) *ChatLogUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ChatLogUpdateOne) SetMessage(v string) *ChatLogUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ChatLogUpdateOne) SetNillableMessage(v *string) *ChatLogUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ChatLogUpdateOne) SetCreatedAt(v time.Time) *ChatLogUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ChatLogUpdateOne) SetNillableCreatedAt(v *time.Time) *ChatLogUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChatLogMutation object of the builder.
+func (_u *ChatLogUpdateOne) Mutation() *ChatLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChatLogUpdate builder.
+func (_u *ChatLogUpdateOne) Where(ps ...predicate.ChatLog) *ChatLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatLogUpdateOne) Select(field string, fields ...string) *ChatLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatLog entity.
+func (_u *ChatLogUpdateOne) Save(ctx context.Context) (*ChatLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatLogUpdateOne) SaveX(ctx context.Context) *ChatLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ChatLogUpdateOne) sqlSave(ctx context.Context) (_node *ChatLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chatlog.Table, chatlog.Columns, sqlgraph.NewFieldSpec(chatlog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatlog.FieldID)
+		for _, f := range fields {
+			if !chatlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(chatlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(chatlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(chatlog.FieldTargetID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetID(); ok {
+		_spec.AddField(chatlog.FieldTargetID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(chatlog.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(chatlog.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(chatlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &ChatLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/chatmute"
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChatMuteUpdate is the builder for updating ChatMute entities.
+type ChatMuteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatMuteMutation
+}
+
+// Where appends a list predicates to the ChatMuteUpdate builder.
+func (_u *ChatMuteUpdate) Where(ps ...predicate.ChatMute) *ChatMuteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatMuteUpdate) SetUserID(v int64) *ChatMuteUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatMuteUpdate) SetNillableUserID(v *int64) *ChatMuteUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ChatMuteUpdate) AddUserID(v int64) *ChatMuteUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUntil sets the "until" field.
+func (_u *ChatMuteUpdate) SetUntil(v time.Time) *ChatMuteUpdate {
+	_u.mutation.SetUntil(v)
+	return _u
+}
+
+// SetNillableUntil sets the "until" field if the given value is not nil.
+func (_u *ChatMuteUpdate) SetNillableUntil(v *time.Time) *ChatMuteUpdate {
+	if v != nil {
+		_u.SetUntil(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ChatMuteUpdate) SetReason(v string) *ChatMuteUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ChatMuteUpdate) SetNillableReason(v *string) *ChatMuteUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetOperator sets the "operator" field.
+func (_u *ChatMuteUpdate) SetOperator(v string) *ChatMuteUpdate {
+	_u.mutation.SetOperator(v)
+	return _u
+}
+
+// SetNillableOperator sets the "operator" field if the given value is not nil.
+func (_u *ChatMuteUpdate) SetNillableOperator(v *string) *ChatMuteUpdate {
+	if v != nil {
+		_u.SetOperator(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ChatMuteUpdate) SetCreatedAt(v time.Time) *ChatMuteUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ChatMuteUpdate) SetNillableCreatedAt(v *time.Time) *ChatMuteUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatMuteUpdate) SetUpdatedAt(v time.Time) *ChatMuteUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatMuteMutation object of the builder.
+func (_u *ChatMuteUpdate) Mutation() *ChatMuteMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatMuteUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatMuteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatMuteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatMuteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatMuteUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatmute.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ChatMuteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chatmute.Table, chatmute.Columns, sqlgraph.NewFieldSpec(chatmute.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(chatmute.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(chatmute.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Until(); ok {
+		_spec.SetField(chatmute.FieldUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(chatmute.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operator(); ok {
+		_spec.SetField(chatmute.FieldOperator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(chatmute.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatmute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatMuteUpdateOne is the builder for updating a single ChatMute entity.
+type ChatMuteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatMuteMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatMuteUpdateOne) SetUserID(v int64) *ChatMuteUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatMuteUpdateOne) SetNillableUserID(v *int64) *ChatMuteUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ChatMuteUpdateOne) AddUserID(v int64) *ChatMuteUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUntil sets the "until" field.
+func (_u *ChatMuteUpdateOne) SetUntil(v time.Time) *ChatMuteUpdateOne {
+	_u.mutation.SetUntil(v)
+	return _u
+}
+
+// SetNillableUntil sets the "until" field if the given value is not nil.
+func (_u *ChatMuteUpdateOne) SetNillableUntil(v *time.Time) *ChatMuteUpdateOne {
+	if v != nil {
+		_u.SetUntil(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ChatMuteUpdateOne) SetReason(v string) *ChatMuteUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ChatMuteUpdateOne) SetNillableReason(v *string) *ChatMuteUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetOperator sets the "operator" field.
+func (_u *ChatMuteUpdateOne) SetOperator(v string) *ChatMuteUpdateOne {
+	_u.mutation.SetOperator(v)
+	return _u
+}
+
+// SetNillableOperator sets the "operator" field if the given value is not nil.
+func (_u *ChatMuteUpdateOne) SetNillableOperator(v *string) *ChatMuteUpdateOne {
+	if v != nil {
+		_u.SetOperator(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ChatMuteUpdateOne) SetCreatedAt(v time.Time) *ChatMuteUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ChatMuteUpdateOne) SetNillableCreatedAt(v *time.Time) *ChatMuteUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatMuteUpdateOne) SetUpdatedAt(v time.Time) *ChatMuteUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ChatMuteMutation object of the builder.
+func (_u *ChatMuteUpdateOne) Mutation() *ChatMuteMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChatMuteUpdate builder.
+func (_u *ChatMuteUpdateOne) Where(ps ...predicate.ChatMute) *ChatMuteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatMuteUpdateOne) Select(field string, fields ...string) *ChatMuteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatMute entity.
+func (_u *ChatMuteUpdateOne) Save(ctx context.Context) (*ChatMute, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatMuteUpdateOne) SaveX(ctx context.Context) *ChatMute {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatMuteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatMuteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatMuteUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatmute.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ChatMuteUpdateOne) sqlSave(ctx context.Context) (_node *ChatMute, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chatmute.Table, chatmute.Columns, sqlgraph.NewFieldSpec(chatmute.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatMute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatmute.FieldID)
+		for _, f := range fields {
+			if !chatmute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatmute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(chatmute.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(chatmute.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Until(); ok {
+		_spec.SetField(chatmute.FieldUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(chatmute.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operator(); ok {
+		_spec.SetField(chatmute.FieldOperator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(chatmute.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatmute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ChatMute{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
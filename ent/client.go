@@ -13,14 +13,23 @@ import (
 
 	"jseer/ent/account"
 	"jseer/ent/auditlog"
+	"jseer/ent/chatlog"
+	"jseer/ent/chatmute"
 	"jseer/ent/configentry"
 	"jseer/ent/configversion"
 	"jseer/ent/gmuser"
 	"jseer/ent/item"
+	"jseer/ent/mentorship"
 	"jseer/ent/permission"
 	"jseer/ent/pet"
 	"jseer/ent/player"
+	"jseer/ent/pvprating"
 	"jseer/ent/role"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"jseer/ent/teampkhistory"
+	"jseer/ent/teampkscore"
+	"jseer/ent/teampkseerscore"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -37,6 +46,10 @@ type Client struct {
 	Account *AccountClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// ChatLog is the client for interacting with the ChatLog builders.
+	ChatLog *ChatLogClient
+	// ChatMute is the client for interacting with the ChatMute builders.
+	ChatMute *ChatMuteClient
 	// ConfigEntry is the client for interacting with the ConfigEntry builders.
 	ConfigEntry *ConfigEntryClient
 	// ConfigVersion is the client for interacting with the ConfigVersion builders.
@@ -45,14 +58,28 @@ type Client struct {
 	GMUser *GMUserClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// Mentorship is the client for interacting with the Mentorship builders.
+	Mentorship *MentorshipClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
+	// PvpRating is the client for interacting with the PvpRating builders.
+	PvpRating *PvpRatingClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
+	// TeamMember is the client for interacting with the TeamMember builders.
+	TeamMember *TeamMemberClient
+	// TeamPkHistory is the client for interacting with the TeamPkHistory builders.
+	TeamPkHistory *TeamPkHistoryClient
+	// TeamPkScore is the client for interacting with the TeamPkScore builders.
+	TeamPkScore *TeamPkScoreClient
+	// TeamPkSeerScore is the client for interacting with the TeamPkSeerScore builders.
+	TeamPkSeerScore *TeamPkSeerScoreClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -66,14 +93,23 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Account = NewAccountClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.ChatLog = NewChatLogClient(c.config)
+	c.ChatMute = NewChatMuteClient(c.config)
 	c.ConfigEntry = NewConfigEntryClient(c.config)
 	c.ConfigVersion = NewConfigVersionClient(c.config)
 	c.GMUser = NewGMUserClient(c.config)
 	c.Item = NewItemClient(c.config)
+	c.Mentorship = NewMentorshipClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
 	c.Pet = NewPetClient(c.config)
 	c.Player = NewPlayerClient(c.config)
+	c.PvpRating = NewPvpRatingClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.Team = NewTeamClient(c.config)
+	c.TeamMember = NewTeamMemberClient(c.config)
+	c.TeamPkHistory = NewTeamPkHistoryClient(c.config)
+	c.TeamPkScore = NewTeamPkScoreClient(c.config)
+	c.TeamPkSeerScore = NewTeamPkSeerScoreClient(c.config)
 }
 
 type (
@@ -164,18 +200,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Account:       NewAccountClient(cfg),
-		AuditLog:      NewAuditLogClient(cfg),
-		ConfigEntry:   NewConfigEntryClient(cfg),
-		ConfigVersion: NewConfigVersionClient(cfg),
-		GMUser:        NewGMUserClient(cfg),
-		Item:          NewItemClient(cfg),
-		Permission:    NewPermissionClient(cfg),
-		Pet:           NewPetClient(cfg),
-		Player:        NewPlayerClient(cfg),
-		Role:          NewRoleClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Account:         NewAccountClient(cfg),
+		AuditLog:        NewAuditLogClient(cfg),
+		ChatLog:         NewChatLogClient(cfg),
+		ChatMute:        NewChatMuteClient(cfg),
+		ConfigEntry:     NewConfigEntryClient(cfg),
+		ConfigVersion:   NewConfigVersionClient(cfg),
+		GMUser:          NewGMUserClient(cfg),
+		Item:            NewItemClient(cfg),
+		Mentorship:      NewMentorshipClient(cfg),
+		Permission:      NewPermissionClient(cfg),
+		Pet:             NewPetClient(cfg),
+		Player:          NewPlayerClient(cfg),
+		PvpRating:       NewPvpRatingClient(cfg),
+		Role:            NewRoleClient(cfg),
+		Team:            NewTeamClient(cfg),
+		TeamMember:      NewTeamMemberClient(cfg),
+		TeamPkHistory:   NewTeamPkHistoryClient(cfg),
+		TeamPkScore:     NewTeamPkScoreClient(cfg),
+		TeamPkSeerScore: NewTeamPkSeerScoreClient(cfg),
 	}, nil
 }
 
@@ -193,18 +238,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Account:       NewAccountClient(cfg),
-		AuditLog:      NewAuditLogClient(cfg),
-		ConfigEntry:   NewConfigEntryClient(cfg),
-		ConfigVersion: NewConfigVersionClient(cfg),
-		GMUser:        NewGMUserClient(cfg),
-		Item:          NewItemClient(cfg),
-		Permission:    NewPermissionClient(cfg),
-		Pet:           NewPetClient(cfg),
-		Player:        NewPlayerClient(cfg),
-		Role:          NewRoleClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Account:         NewAccountClient(cfg),
+		AuditLog:        NewAuditLogClient(cfg),
+		ChatLog:         NewChatLogClient(cfg),
+		ChatMute:        NewChatMuteClient(cfg),
+		ConfigEntry:     NewConfigEntryClient(cfg),
+		ConfigVersion:   NewConfigVersionClient(cfg),
+		GMUser:          NewGMUserClient(cfg),
+		Item:            NewItemClient(cfg),
+		Mentorship:      NewMentorshipClient(cfg),
+		Permission:      NewPermissionClient(cfg),
+		Pet:             NewPetClient(cfg),
+		Player:          NewPlayerClient(cfg),
+		PvpRating:       NewPvpRatingClient(cfg),
+		Role:            NewRoleClient(cfg),
+		Team:            NewTeamClient(cfg),
+		TeamMember:      NewTeamMemberClient(cfg),
+		TeamPkHistory:   NewTeamPkHistoryClient(cfg),
+		TeamPkScore:     NewTeamPkScoreClient(cfg),
+		TeamPkSeerScore: NewTeamPkSeerScoreClient(cfg),
 	}, nil
 }
 
@@ -234,8 +288,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Account, c.AuditLog, c.ConfigEntry, c.ConfigVersion, c.GMUser, c.Item,
-		c.Permission, c.Pet, c.Player, c.Role,
+		c.Account, c.AuditLog, c.ChatLog, c.ChatMute, c.ConfigEntry, c.ConfigVersion,
+		c.GMUser, c.Item, c.Mentorship, c.Permission, c.Pet, c.Player, c.PvpRating,
+		c.Role, c.Team, c.TeamMember, c.TeamPkHistory, c.TeamPkScore,
+		c.TeamPkSeerScore,
 	} {
 		n.Use(hooks...)
 	}
@@ -245,8 +301,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Account, c.AuditLog, c.ConfigEntry, c.ConfigVersion, c.GMUser, c.Item,
-		c.Permission, c.Pet, c.Player, c.Role,
+		c.Account, c.AuditLog, c.ChatLog, c.ChatMute, c.ConfigEntry, c.ConfigVersion,
+		c.GMUser, c.Item, c.Mentorship, c.Permission, c.Pet, c.Player, c.PvpRating,
+		c.Role, c.Team, c.TeamMember, c.TeamPkHistory, c.TeamPkScore,
+		c.TeamPkSeerScore,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -259,6 +317,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Account.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *ChatLogMutation:
+		return c.ChatLog.mutate(ctx, m)
+	case *ChatMuteMutation:
+		return c.ChatMute.mutate(ctx, m)
 	case *ConfigEntryMutation:
 		return c.ConfigEntry.mutate(ctx, m)
 	case *ConfigVersionMutation:
@@ -267,14 +329,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.GMUser.mutate(ctx, m)
 	case *ItemMutation:
 		return c.Item.mutate(ctx, m)
+	case *MentorshipMutation:
+		return c.Mentorship.mutate(ctx, m)
 	case *PermissionMutation:
 		return c.Permission.mutate(ctx, m)
 	case *PetMutation:
 		return c.Pet.mutate(ctx, m)
 	case *PlayerMutation:
 		return c.Player.mutate(ctx, m)
+	case *PvpRatingMutation:
+		return c.PvpRating.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *TeamMutation:
+		return c.Team.mutate(ctx, m)
+	case *TeamMemberMutation:
+		return c.TeamMember.mutate(ctx, m)
+	case *TeamPkHistoryMutation:
+		return c.TeamPkHistory.mutate(ctx, m)
+	case *TeamPkScoreMutation:
+		return c.TeamPkScore.mutate(ctx, m)
+	case *TeamPkSeerScoreMutation:
+		return c.TeamPkSeerScore.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -562,6 +638,272 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
+// ChatLogClient is a client for the ChatLog schema.
+type ChatLogClient struct {
+	config
+}
+
+// NewChatLogClient returns a client for the ChatLog from the given config.
+func NewChatLogClient(c config) *ChatLogClient {
+	return &ChatLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatlog.Hooks(f(g(h())))`.
+func (c *ChatLogClient) Use(hooks ...Hook) {
+	c.hooks.ChatLog = append(c.hooks.ChatLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatlog.Intercept(f(g(h())))`.
+func (c *ChatLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatLog = append(c.inters.ChatLog, interceptors...)
+}
+
+// Create returns a builder for creating a ChatLog entity.
+func (c *ChatLogClient) Create() *ChatLogCreate {
+	mutation := newChatLogMutation(c.config, OpCreate)
+	return &ChatLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatLog entities.
+func (c *ChatLogClient) CreateBulk(builders ...*ChatLogCreate) *ChatLogCreateBulk {
+	return &ChatLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatLogClient) MapCreateBulk(slice any, setFunc func(*ChatLogCreate, int)) *ChatLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatLogCreateBulk{err: fmt.Errorf("calling to ChatLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatLog.
+func (c *ChatLogClient) Update() *ChatLogUpdate {
+	mutation := newChatLogMutation(c.config, OpUpdate)
+	return &ChatLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatLogClient) UpdateOne(_m *ChatLog) *ChatLogUpdateOne {
+	mutation := newChatLogMutation(c.config, OpUpdateOne, withChatLog(_m))
+	return &ChatLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatLogClient) UpdateOneID(id int) *ChatLogUpdateOne {
+	mutation := newChatLogMutation(c.config, OpUpdateOne, withChatLogID(id))
+	return &ChatLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatLog.
+func (c *ChatLogClient) Delete() *ChatLogDelete {
+	mutation := newChatLogMutation(c.config, OpDelete)
+	return &ChatLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatLogClient) DeleteOne(_m *ChatLog) *ChatLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatLogClient) DeleteOneID(id int) *ChatLogDeleteOne {
+	builder := c.Delete().Where(chatlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatLogDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatLog.
+func (c *ChatLogClient) Query() *ChatLogQuery {
+	return &ChatLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatLog entity by its id.
+func (c *ChatLogClient) Get(ctx context.Context, id int) (*ChatLog, error) {
+	return c.Query().Where(chatlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatLogClient) GetX(ctx context.Context, id int) *ChatLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChatLogClient) Hooks() []Hook {
+	return c.hooks.ChatLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatLogClient) Interceptors() []Interceptor {
+	return c.inters.ChatLog
+}
+
+func (c *ChatLogClient) mutate(ctx context.Context, m *ChatLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatLog mutation op: %q", m.Op())
+	}
+}
+
+// ChatMuteClient is a client for the ChatMute schema.
+type ChatMuteClient struct {
+	config
+}
+
+// NewChatMuteClient returns a client for the ChatMute from the given config.
+func NewChatMuteClient(c config) *ChatMuteClient {
+	return &ChatMuteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatmute.Hooks(f(g(h())))`.
+func (c *ChatMuteClient) Use(hooks ...Hook) {
+	c.hooks.ChatMute = append(c.hooks.ChatMute, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatmute.Intercept(f(g(h())))`.
+func (c *ChatMuteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatMute = append(c.inters.ChatMute, interceptors...)
+}
+
+// Create returns a builder for creating a ChatMute entity.
+func (c *ChatMuteClient) Create() *ChatMuteCreate {
+	mutation := newChatMuteMutation(c.config, OpCreate)
+	return &ChatMuteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatMute entities.
+func (c *ChatMuteClient) CreateBulk(builders ...*ChatMuteCreate) *ChatMuteCreateBulk {
+	return &ChatMuteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatMuteClient) MapCreateBulk(slice any, setFunc func(*ChatMuteCreate, int)) *ChatMuteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatMuteCreateBulk{err: fmt.Errorf("calling to ChatMuteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatMuteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatMuteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatMute.
+func (c *ChatMuteClient) Update() *ChatMuteUpdate {
+	mutation := newChatMuteMutation(c.config, OpUpdate)
+	return &ChatMuteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatMuteClient) UpdateOne(_m *ChatMute) *ChatMuteUpdateOne {
+	mutation := newChatMuteMutation(c.config, OpUpdateOne, withChatMute(_m))
+	return &ChatMuteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatMuteClient) UpdateOneID(id int) *ChatMuteUpdateOne {
+	mutation := newChatMuteMutation(c.config, OpUpdateOne, withChatMuteID(id))
+	return &ChatMuteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatMute.
+func (c *ChatMuteClient) Delete() *ChatMuteDelete {
+	mutation := newChatMuteMutation(c.config, OpDelete)
+	return &ChatMuteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatMuteClient) DeleteOne(_m *ChatMute) *ChatMuteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatMuteClient) DeleteOneID(id int) *ChatMuteDeleteOne {
+	builder := c.Delete().Where(chatmute.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatMuteDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatMute.
+func (c *ChatMuteClient) Query() *ChatMuteQuery {
+	return &ChatMuteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatMute},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatMute entity by its id.
+func (c *ChatMuteClient) Get(ctx context.Context, id int) (*ChatMute, error) {
+	return c.Query().Where(chatmute.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatMuteClient) GetX(ctx context.Context, id int) *ChatMute {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChatMuteClient) Hooks() []Hook {
+	return c.hooks.ChatMute
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatMuteClient) Interceptors() []Interceptor {
+	return c.inters.ChatMute
+}
+
+func (c *ChatMuteClient) mutate(ctx context.Context, m *ChatMuteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatMuteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatMuteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatMuteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatMuteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatMute mutation op: %q", m.Op())
+	}
+}
+
 // ConfigEntryClient is a client for the ConfigEntry schema.
 type ConfigEntryClient struct {
 	config
@@ -1158,6 +1500,139 @@ func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error)
 	}
 }
 
+// MentorshipClient is a client for the Mentorship schema.
+type MentorshipClient struct {
+	config
+}
+
+// NewMentorshipClient returns a client for the Mentorship from the given config.
+func NewMentorshipClient(c config) *MentorshipClient {
+	return &MentorshipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mentorship.Hooks(f(g(h())))`.
+func (c *MentorshipClient) Use(hooks ...Hook) {
+	c.hooks.Mentorship = append(c.hooks.Mentorship, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mentorship.Intercept(f(g(h())))`.
+func (c *MentorshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Mentorship = append(c.inters.Mentorship, interceptors...)
+}
+
+// Create returns a builder for creating a Mentorship entity.
+func (c *MentorshipClient) Create() *MentorshipCreate {
+	mutation := newMentorshipMutation(c.config, OpCreate)
+	return &MentorshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Mentorship entities.
+func (c *MentorshipClient) CreateBulk(builders ...*MentorshipCreate) *MentorshipCreateBulk {
+	return &MentorshipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MentorshipClient) MapCreateBulk(slice any, setFunc func(*MentorshipCreate, int)) *MentorshipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MentorshipCreateBulk{err: fmt.Errorf("calling to MentorshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MentorshipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MentorshipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Mentorship.
+func (c *MentorshipClient) Update() *MentorshipUpdate {
+	mutation := newMentorshipMutation(c.config, OpUpdate)
+	return &MentorshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MentorshipClient) UpdateOne(_m *Mentorship) *MentorshipUpdateOne {
+	mutation := newMentorshipMutation(c.config, OpUpdateOne, withMentorship(_m))
+	return &MentorshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MentorshipClient) UpdateOneID(id int) *MentorshipUpdateOne {
+	mutation := newMentorshipMutation(c.config, OpUpdateOne, withMentorshipID(id))
+	return &MentorshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Mentorship.
+func (c *MentorshipClient) Delete() *MentorshipDelete {
+	mutation := newMentorshipMutation(c.config, OpDelete)
+	return &MentorshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MentorshipClient) DeleteOne(_m *Mentorship) *MentorshipDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MentorshipClient) DeleteOneID(id int) *MentorshipDeleteOne {
+	builder := c.Delete().Where(mentorship.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MentorshipDeleteOne{builder}
+}
+
+// Query returns a query builder for Mentorship.
+func (c *MentorshipClient) Query() *MentorshipQuery {
+	return &MentorshipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMentorship},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Mentorship entity by its id.
+func (c *MentorshipClient) Get(ctx context.Context, id int) (*Mentorship, error) {
+	return c.Query().Where(mentorship.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MentorshipClient) GetX(ctx context.Context, id int) *Mentorship {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MentorshipClient) Hooks() []Hook {
+	return c.hooks.Mentorship
+}
+
+// Interceptors returns the client interceptors.
+func (c *MentorshipClient) Interceptors() []Interceptor {
+	return c.inters.Mentorship
+}
+
+func (c *MentorshipClient) mutate(ctx context.Context, m *MentorshipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MentorshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MentorshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MentorshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MentorshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Mentorship mutation op: %q", m.Op())
+	}
+}
+
 // PermissionClient is a client for the Permission schema.
 type PermissionClient struct {
 	config
@@ -1612,6 +2087,22 @@ func (c *PlayerClient) QueryItems(_m *Player) *ItemQuery {
 	return query
 }
 
+// QueryPvpRatings queries the pvp_ratings edge of a Player.
+func (c *PlayerClient) QueryPvpRatings(_m *Player) *PvpRatingQuery {
+	query := (&PvpRatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(pvprating.Table, pvprating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.PvpRatingsTable, player.PvpRatingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PlayerClient) Hooks() []Hook {
 	return c.hooks.Player
@@ -1637,45 +2128,194 @@ func (c *PlayerClient) mutate(ctx context.Context, m *PlayerMutation) (Value, er
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// PvpRatingClient is a client for the PvpRating schema.
+type PvpRatingClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewPvpRatingClient returns a client for the PvpRating from the given config.
+func NewPvpRatingClient(c config) *PvpRatingClient {
+	return &PvpRatingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `pvprating.Hooks(f(g(h())))`.
+func (c *PvpRatingClient) Use(hooks ...Hook) {
+	c.hooks.PvpRating = append(c.hooks.PvpRating, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `pvprating.Intercept(f(g(h())))`.
+func (c *PvpRatingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PvpRating = append(c.inters.PvpRating, interceptors...)
 }
 
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PvpRating entity.
+func (c *PvpRatingClient) Create() *PvpRatingCreate {
+	mutation := newPvpRatingMutation(c.config, OpCreate)
+	return &PvpRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PvpRating entities.
+func (c *PvpRatingClient) CreateBulk(builders ...*PvpRatingCreate) *PvpRatingCreateBulk {
+	return &PvpRatingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+func (c *PvpRatingClient) MapCreateBulk(slice any, setFunc func(*PvpRatingCreate, int)) *PvpRatingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PvpRatingCreateBulk{err: fmt.Errorf("calling to PvpRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PvpRatingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PvpRatingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PvpRating.
+func (c *PvpRatingClient) Update() *PvpRatingUpdate {
+	mutation := newPvpRatingMutation(c.config, OpUpdate)
+	return &PvpRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PvpRatingClient) UpdateOne(_m *PvpRating) *PvpRatingUpdateOne {
+	mutation := newPvpRatingMutation(c.config, OpUpdateOne, withPvpRating(_m))
+	return &PvpRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PvpRatingClient) UpdateOneID(id int) *PvpRatingUpdateOne {
+	mutation := newPvpRatingMutation(c.config, OpUpdateOne, withPvpRatingID(id))
+	return &PvpRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PvpRating.
+func (c *PvpRatingClient) Delete() *PvpRatingDelete {
+	mutation := newPvpRatingMutation(c.config, OpDelete)
+	return &PvpRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PvpRatingClient) DeleteOne(_m *PvpRating) *PvpRatingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PvpRatingClient) DeleteOneID(id int) *PvpRatingDeleteOne {
+	builder := c.Delete().Where(pvprating.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PvpRatingDeleteOne{builder}
+}
+
+// Query returns a query builder for PvpRating.
+func (c *PvpRatingClient) Query() *PvpRatingQuery {
+	return &PvpRatingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePvpRating},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PvpRating entity by its id.
+func (c *PvpRatingClient) Get(ctx context.Context, id int) (*PvpRating, error) {
+	return c.Query().Where(pvprating.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PvpRatingClient) GetX(ctx context.Context, id int) *PvpRating {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a PvpRating.
+func (c *PvpRatingClient) QueryPlayer(_m *PvpRating) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pvprating.Table, pvprating.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pvprating.PlayerTable, pvprating.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PvpRatingClient) Hooks() []Hook {
+	return c.hooks.PvpRating
+}
+
+// Interceptors returns the client interceptors.
+func (c *PvpRatingClient) Interceptors() []Interceptor {
+	return c.inters.PvpRating
+}
+
+func (c *PvpRatingClient) mutate(ctx context.Context, m *PvpRatingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PvpRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PvpRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PvpRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PvpRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PvpRating mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
 	builders := make([]*RoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
@@ -1802,14 +2442,713 @@ func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error)
 	}
 }
 
+// TeamClient is a client for the Team schema.
+type TeamClient struct {
+	config
+}
+
+// NewTeamClient returns a client for the Team from the given config.
+func NewTeamClient(c config) *TeamClient {
+	return &TeamClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `team.Hooks(f(g(h())))`.
+func (c *TeamClient) Use(hooks ...Hook) {
+	c.hooks.Team = append(c.hooks.Team, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `team.Intercept(f(g(h())))`.
+func (c *TeamClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Team = append(c.inters.Team, interceptors...)
+}
+
+// Create returns a builder for creating a Team entity.
+func (c *TeamClient) Create() *TeamCreate {
+	mutation := newTeamMutation(c.config, OpCreate)
+	return &TeamCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Team entities.
+func (c *TeamClient) CreateBulk(builders ...*TeamCreate) *TeamCreateBulk {
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamClient) MapCreateBulk(slice any, setFunc func(*TeamCreate, int)) *TeamCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamCreateBulk{err: fmt.Errorf("calling to TeamClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Team.
+func (c *TeamClient) Update() *TeamUpdate {
+	mutation := newTeamMutation(c.config, OpUpdate)
+	return &TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamClient) UpdateOne(_m *Team) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeam(_m))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamClient) UpdateOneID(id int) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeamID(id))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Team.
+func (c *TeamClient) Delete() *TeamDelete {
+	mutation := newTeamMutation(c.config, OpDelete)
+	return &TeamDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamClient) DeleteOne(_m *Team) *TeamDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamClient) DeleteOneID(id int) *TeamDeleteOne {
+	builder := c.Delete().Where(team.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamDeleteOne{builder}
+}
+
+// Query returns a query builder for Team.
+func (c *TeamClient) Query() *TeamQuery {
+	return &TeamQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeam},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Team entity by its id.
+func (c *TeamClient) Get(ctx context.Context, id int) (*Team, error) {
+	return c.Query().Where(team.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamClient) GetX(ctx context.Context, id int) *Team {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMembers queries the members edge of a Team.
+func (c *TeamClient) QueryMembers(_m *Team) *TeamMemberQuery {
+	query := (&TeamMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.MembersTable, team.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamClient) Hooks() []Hook {
+	return c.hooks.Team
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamClient) Interceptors() []Interceptor {
+	return c.inters.Team
+}
+
+func (c *TeamClient) mutate(ctx context.Context, m *TeamMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Team mutation op: %q", m.Op())
+	}
+}
+
+// TeamMemberClient is a client for the TeamMember schema.
+type TeamMemberClient struct {
+	config
+}
+
+// NewTeamMemberClient returns a client for the TeamMember from the given config.
+func NewTeamMemberClient(c config) *TeamMemberClient {
+	return &TeamMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teammember.Hooks(f(g(h())))`.
+func (c *TeamMemberClient) Use(hooks ...Hook) {
+	c.hooks.TeamMember = append(c.hooks.TeamMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teammember.Intercept(f(g(h())))`.
+func (c *TeamMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamMember = append(c.inters.TeamMember, interceptors...)
+}
+
+// Create returns a builder for creating a TeamMember entity.
+func (c *TeamMemberClient) Create() *TeamMemberCreate {
+	mutation := newTeamMemberMutation(c.config, OpCreate)
+	return &TeamMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamMember entities.
+func (c *TeamMemberClient) CreateBulk(builders ...*TeamMemberCreate) *TeamMemberCreateBulk {
+	return &TeamMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamMemberClient) MapCreateBulk(slice any, setFunc func(*TeamMemberCreate, int)) *TeamMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamMemberCreateBulk{err: fmt.Errorf("calling to TeamMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamMember.
+func (c *TeamMemberClient) Update() *TeamMemberUpdate {
+	mutation := newTeamMemberMutation(c.config, OpUpdate)
+	return &TeamMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamMemberClient) UpdateOne(_m *TeamMember) *TeamMemberUpdateOne {
+	mutation := newTeamMemberMutation(c.config, OpUpdateOne, withTeamMember(_m))
+	return &TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamMemberClient) UpdateOneID(id int) *TeamMemberUpdateOne {
+	mutation := newTeamMemberMutation(c.config, OpUpdateOne, withTeamMemberID(id))
+	return &TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamMember.
+func (c *TeamMemberClient) Delete() *TeamMemberDelete {
+	mutation := newTeamMemberMutation(c.config, OpDelete)
+	return &TeamMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamMemberClient) DeleteOne(_m *TeamMember) *TeamMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamMemberClient) DeleteOneID(id int) *TeamMemberDeleteOne {
+	builder := c.Delete().Where(teammember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamMember.
+func (c *TeamMemberClient) Query() *TeamMemberQuery {
+	return &TeamMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamMember entity by its id.
+func (c *TeamMemberClient) Get(ctx context.Context, id int) (*TeamMember, error) {
+	return c.Query().Where(teammember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamMemberClient) GetX(ctx context.Context, id int) *TeamMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTeam queries the team edge of a TeamMember.
+func (c *TeamMemberClient) QueryTeam(_m *TeamMember) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teammember.Table, teammember.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammember.TeamTable, teammember.TeamColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamMemberClient) Hooks() []Hook {
+	return c.hooks.TeamMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamMemberClient) Interceptors() []Interceptor {
+	return c.inters.TeamMember
+}
+
+func (c *TeamMemberClient) mutate(ctx context.Context, m *TeamMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TeamMember mutation op: %q", m.Op())
+	}
+}
+
+// TeamPkHistoryClient is a client for the TeamPkHistory schema.
+type TeamPkHistoryClient struct {
+	config
+}
+
+// NewTeamPkHistoryClient returns a client for the TeamPkHistory from the given config.
+func NewTeamPkHistoryClient(c config) *TeamPkHistoryClient {
+	return &TeamPkHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teampkhistory.Hooks(f(g(h())))`.
+func (c *TeamPkHistoryClient) Use(hooks ...Hook) {
+	c.hooks.TeamPkHistory = append(c.hooks.TeamPkHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teampkhistory.Intercept(f(g(h())))`.
+func (c *TeamPkHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamPkHistory = append(c.inters.TeamPkHistory, interceptors...)
+}
+
+// Create returns a builder for creating a TeamPkHistory entity.
+func (c *TeamPkHistoryClient) Create() *TeamPkHistoryCreate {
+	mutation := newTeamPkHistoryMutation(c.config, OpCreate)
+	return &TeamPkHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamPkHistory entities.
+func (c *TeamPkHistoryClient) CreateBulk(builders ...*TeamPkHistoryCreate) *TeamPkHistoryCreateBulk {
+	return &TeamPkHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamPkHistoryClient) MapCreateBulk(slice any, setFunc func(*TeamPkHistoryCreate, int)) *TeamPkHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamPkHistoryCreateBulk{err: fmt.Errorf("calling to TeamPkHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamPkHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamPkHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamPkHistory.
+func (c *TeamPkHistoryClient) Update() *TeamPkHistoryUpdate {
+	mutation := newTeamPkHistoryMutation(c.config, OpUpdate)
+	return &TeamPkHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamPkHistoryClient) UpdateOne(_m *TeamPkHistory) *TeamPkHistoryUpdateOne {
+	mutation := newTeamPkHistoryMutation(c.config, OpUpdateOne, withTeamPkHistory(_m))
+	return &TeamPkHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamPkHistoryClient) UpdateOneID(id int) *TeamPkHistoryUpdateOne {
+	mutation := newTeamPkHistoryMutation(c.config, OpUpdateOne, withTeamPkHistoryID(id))
+	return &TeamPkHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamPkHistory.
+func (c *TeamPkHistoryClient) Delete() *TeamPkHistoryDelete {
+	mutation := newTeamPkHistoryMutation(c.config, OpDelete)
+	return &TeamPkHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamPkHistoryClient) DeleteOne(_m *TeamPkHistory) *TeamPkHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamPkHistoryClient) DeleteOneID(id int) *TeamPkHistoryDeleteOne {
+	builder := c.Delete().Where(teampkhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamPkHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamPkHistory.
+func (c *TeamPkHistoryClient) Query() *TeamPkHistoryQuery {
+	return &TeamPkHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamPkHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamPkHistory entity by its id.
+func (c *TeamPkHistoryClient) Get(ctx context.Context, id int) (*TeamPkHistory, error) {
+	return c.Query().Where(teampkhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamPkHistoryClient) GetX(ctx context.Context, id int) *TeamPkHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TeamPkHistoryClient) Hooks() []Hook {
+	return c.hooks.TeamPkHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamPkHistoryClient) Interceptors() []Interceptor {
+	return c.inters.TeamPkHistory
+}
+
+func (c *TeamPkHistoryClient) mutate(ctx context.Context, m *TeamPkHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamPkHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamPkHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamPkHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamPkHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TeamPkHistory mutation op: %q", m.Op())
+	}
+}
+
+// TeamPkScoreClient is a client for the TeamPkScore schema.
+type TeamPkScoreClient struct {
+	config
+}
+
+// NewTeamPkScoreClient returns a client for the TeamPkScore from the given config.
+func NewTeamPkScoreClient(c config) *TeamPkScoreClient {
+	return &TeamPkScoreClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teampkscore.Hooks(f(g(h())))`.
+func (c *TeamPkScoreClient) Use(hooks ...Hook) {
+	c.hooks.TeamPkScore = append(c.hooks.TeamPkScore, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teampkscore.Intercept(f(g(h())))`.
+func (c *TeamPkScoreClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamPkScore = append(c.inters.TeamPkScore, interceptors...)
+}
+
+// Create returns a builder for creating a TeamPkScore entity.
+func (c *TeamPkScoreClient) Create() *TeamPkScoreCreate {
+	mutation := newTeamPkScoreMutation(c.config, OpCreate)
+	return &TeamPkScoreCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamPkScore entities.
+func (c *TeamPkScoreClient) CreateBulk(builders ...*TeamPkScoreCreate) *TeamPkScoreCreateBulk {
+	return &TeamPkScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamPkScoreClient) MapCreateBulk(slice any, setFunc func(*TeamPkScoreCreate, int)) *TeamPkScoreCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamPkScoreCreateBulk{err: fmt.Errorf("calling to TeamPkScoreClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamPkScoreCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamPkScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamPkScore.
+func (c *TeamPkScoreClient) Update() *TeamPkScoreUpdate {
+	mutation := newTeamPkScoreMutation(c.config, OpUpdate)
+	return &TeamPkScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamPkScoreClient) UpdateOne(_m *TeamPkScore) *TeamPkScoreUpdateOne {
+	mutation := newTeamPkScoreMutation(c.config, OpUpdateOne, withTeamPkScore(_m))
+	return &TeamPkScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamPkScoreClient) UpdateOneID(id int) *TeamPkScoreUpdateOne {
+	mutation := newTeamPkScoreMutation(c.config, OpUpdateOne, withTeamPkScoreID(id))
+	return &TeamPkScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamPkScore.
+func (c *TeamPkScoreClient) Delete() *TeamPkScoreDelete {
+	mutation := newTeamPkScoreMutation(c.config, OpDelete)
+	return &TeamPkScoreDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamPkScoreClient) DeleteOne(_m *TeamPkScore) *TeamPkScoreDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamPkScoreClient) DeleteOneID(id int) *TeamPkScoreDeleteOne {
+	builder := c.Delete().Where(teampkscore.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamPkScoreDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamPkScore.
+func (c *TeamPkScoreClient) Query() *TeamPkScoreQuery {
+	return &TeamPkScoreQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamPkScore},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamPkScore entity by its id.
+func (c *TeamPkScoreClient) Get(ctx context.Context, id int) (*TeamPkScore, error) {
+	return c.Query().Where(teampkscore.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamPkScoreClient) GetX(ctx context.Context, id int) *TeamPkScore {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TeamPkScoreClient) Hooks() []Hook {
+	return c.hooks.TeamPkScore
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamPkScoreClient) Interceptors() []Interceptor {
+	return c.inters.TeamPkScore
+}
+
+func (c *TeamPkScoreClient) mutate(ctx context.Context, m *TeamPkScoreMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamPkScoreCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamPkScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamPkScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamPkScoreDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TeamPkScore mutation op: %q", m.Op())
+	}
+}
+
+// TeamPkSeerScoreClient is a client for the TeamPkSeerScore schema.
+type TeamPkSeerScoreClient struct {
+	config
+}
+
+// NewTeamPkSeerScoreClient returns a client for the TeamPkSeerScore from the given config.
+func NewTeamPkSeerScoreClient(c config) *TeamPkSeerScoreClient {
+	return &TeamPkSeerScoreClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teampkseerscore.Hooks(f(g(h())))`.
+func (c *TeamPkSeerScoreClient) Use(hooks ...Hook) {
+	c.hooks.TeamPkSeerScore = append(c.hooks.TeamPkSeerScore, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teampkseerscore.Intercept(f(g(h())))`.
+func (c *TeamPkSeerScoreClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamPkSeerScore = append(c.inters.TeamPkSeerScore, interceptors...)
+}
+
+// Create returns a builder for creating a TeamPkSeerScore entity.
+func (c *TeamPkSeerScoreClient) Create() *TeamPkSeerScoreCreate {
+	mutation := newTeamPkSeerScoreMutation(c.config, OpCreate)
+	return &TeamPkSeerScoreCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamPkSeerScore entities.
+func (c *TeamPkSeerScoreClient) CreateBulk(builders ...*TeamPkSeerScoreCreate) *TeamPkSeerScoreCreateBulk {
+	return &TeamPkSeerScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamPkSeerScoreClient) MapCreateBulk(slice any, setFunc func(*TeamPkSeerScoreCreate, int)) *TeamPkSeerScoreCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamPkSeerScoreCreateBulk{err: fmt.Errorf("calling to TeamPkSeerScoreClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamPkSeerScoreCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamPkSeerScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamPkSeerScore.
+func (c *TeamPkSeerScoreClient) Update() *TeamPkSeerScoreUpdate {
+	mutation := newTeamPkSeerScoreMutation(c.config, OpUpdate)
+	return &TeamPkSeerScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamPkSeerScoreClient) UpdateOne(_m *TeamPkSeerScore) *TeamPkSeerScoreUpdateOne {
+	mutation := newTeamPkSeerScoreMutation(c.config, OpUpdateOne, withTeamPkSeerScore(_m))
+	return &TeamPkSeerScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamPkSeerScoreClient) UpdateOneID(id int) *TeamPkSeerScoreUpdateOne {
+	mutation := newTeamPkSeerScoreMutation(c.config, OpUpdateOne, withTeamPkSeerScoreID(id))
+	return &TeamPkSeerScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamPkSeerScore.
+func (c *TeamPkSeerScoreClient) Delete() *TeamPkSeerScoreDelete {
+	mutation := newTeamPkSeerScoreMutation(c.config, OpDelete)
+	return &TeamPkSeerScoreDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamPkSeerScoreClient) DeleteOne(_m *TeamPkSeerScore) *TeamPkSeerScoreDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamPkSeerScoreClient) DeleteOneID(id int) *TeamPkSeerScoreDeleteOne {
+	builder := c.Delete().Where(teampkseerscore.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamPkSeerScoreDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamPkSeerScore.
+func (c *TeamPkSeerScoreClient) Query() *TeamPkSeerScoreQuery {
+	return &TeamPkSeerScoreQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamPkSeerScore},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamPkSeerScore entity by its id.
+func (c *TeamPkSeerScoreClient) Get(ctx context.Context, id int) (*TeamPkSeerScore, error) {
+	return c.Query().Where(teampkseerscore.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamPkSeerScoreClient) GetX(ctx context.Context, id int) *TeamPkSeerScore {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TeamPkSeerScoreClient) Hooks() []Hook {
+	return c.hooks.TeamPkSeerScore
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamPkSeerScoreClient) Interceptors() []Interceptor {
+	return c.inters.TeamPkSeerScore
+}
+
+func (c *TeamPkSeerScoreClient) mutate(ctx context.Context, m *TeamPkSeerScoreMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamPkSeerScoreCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamPkSeerScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamPkSeerScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamPkSeerScoreDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TeamPkSeerScore mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Account, AuditLog, ConfigEntry, ConfigVersion, GMUser, Item, Permission, Pet,
-		Player, Role []ent.Hook
+		Account, AuditLog, ChatLog, ChatMute, ConfigEntry, ConfigVersion, GMUser, Item,
+		Mentorship, Permission, Pet, Player, PvpRating, Role, Team, TeamMember,
+		TeamPkHistory, TeamPkScore, TeamPkSeerScore []ent.Hook
 	}
 	inters struct {
-		Account, AuditLog, ConfigEntry, ConfigVersion, GMUser, Item, Permission, Pet,
-		Player, Role []ent.Interceptor
+		Account, AuditLog, ChatLog, ChatMute, ConfigEntry, ConfigVersion, GMUser, Item,
+		Mentorship, Permission, Pet, Player, PvpRating, Role, Team, TeamMember,
+		TeamPkHistory, TeamPkScore, TeamPkSeerScore []ent.Interceptor
 	}
 )
@@ -8,14 +8,23 @@ import (
 	"fmt"
 	"jseer/ent/account"
 	"jseer/ent/auditlog"
+	"jseer/ent/chatlog"
+	"jseer/ent/chatmute"
 	"jseer/ent/configentry"
 	"jseer/ent/configversion"
 	"jseer/ent/gmuser"
 	"jseer/ent/item"
+	"jseer/ent/mentorship"
 	"jseer/ent/permission"
 	"jseer/ent/pet"
 	"jseer/ent/player"
+	"jseer/ent/pvprating"
 	"jseer/ent/role"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"jseer/ent/teampkhistory"
+	"jseer/ent/teampkscore"
+	"jseer/ent/teampkseerscore"
 	"reflect"
 	"sync"
 
@@ -82,16 +91,25 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table:       account.ValidColumn,
-			auditlog.Table:      auditlog.ValidColumn,
-			configentry.Table:   configentry.ValidColumn,
-			configversion.Table: configversion.ValidColumn,
-			gmuser.Table:        gmuser.ValidColumn,
-			item.Table:          item.ValidColumn,
-			permission.Table:    permission.ValidColumn,
-			pet.Table:           pet.ValidColumn,
-			player.Table:        player.ValidColumn,
-			role.Table:          role.ValidColumn,
+			account.Table:         account.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			chatlog.Table:         chatlog.ValidColumn,
+			chatmute.Table:        chatmute.ValidColumn,
+			configentry.Table:     configentry.ValidColumn,
+			configversion.Table:   configversion.ValidColumn,
+			gmuser.Table:          gmuser.ValidColumn,
+			item.Table:            item.ValidColumn,
+			mentorship.Table:      mentorship.ValidColumn,
+			permission.Table:      permission.ValidColumn,
+			pet.Table:             pet.ValidColumn,
+			player.Table:          player.ValidColumn,
+			pvprating.Table:       pvprating.ValidColumn,
+			role.Table:            role.ValidColumn,
+			team.Table:            team.ValidColumn,
+			teammember.Table:      teammember.ValidColumn,
+			teampkhistory.Table:   teampkhistory.ValidColumn,
+			teampkscore.Table:     teampkscore.ValidColumn,
+			teampkseerscore.Table: teampkseerscore.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
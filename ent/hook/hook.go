@@ -32,6 +32,30 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The ChatLogFunc type is an adapter to allow the use of ordinary
+// function as ChatLog mutator.
+type ChatLogFunc func(context.Context, *ent.ChatLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatLogMutation", m)
+}
+
+// The ChatMuteFunc type is an adapter to allow the use of ordinary
+// function as ChatMute mutator.
+type ChatMuteFunc func(context.Context, *ent.ChatMuteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatMuteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatMuteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatMuteMutation", m)
+}
+
 // The ConfigEntryFunc type is an adapter to allow the use of ordinary
 // function as ConfigEntry mutator.
 type ConfigEntryFunc func(context.Context, *ent.ConfigEntryMutation) (ent.Value, error)
@@ -80,6 +104,18 @@ func (f ItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemMutation", m)
 }
 
+// The MentorshipFunc type is an adapter to allow the use of ordinary
+// function as Mentorship mutator.
+type MentorshipFunc func(context.Context, *ent.MentorshipMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MentorshipFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MentorshipMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MentorshipMutation", m)
+}
+
 // The PermissionFunc type is an adapter to allow the use of ordinary
 // function as Permission mutator.
 type PermissionFunc func(context.Context, *ent.PermissionMutation) (ent.Value, error)
@@ -116,6 +152,18 @@ func (f PlayerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PlayerMutation", m)
 }
 
+// The PvpRatingFunc type is an adapter to allow the use of ordinary
+// function as PvpRating mutator.
+type PvpRatingFunc func(context.Context, *ent.PvpRatingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PvpRatingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PvpRatingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PvpRatingMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -128,6 +176,66 @@ func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
 }
 
+// The TeamFunc type is an adapter to allow the use of ordinary
+// function as Team mutator.
+type TeamFunc func(context.Context, *ent.TeamMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamMutation", m)
+}
+
+// The TeamMemberFunc type is an adapter to allow the use of ordinary
+// function as TeamMember mutator.
+type TeamMemberFunc func(context.Context, *ent.TeamMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamMemberMutation", m)
+}
+
+// The TeamPkHistoryFunc type is an adapter to allow the use of ordinary
+// function as TeamPkHistory mutator.
+type TeamPkHistoryFunc func(context.Context, *ent.TeamPkHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamPkHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamPkHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamPkHistoryMutation", m)
+}
+
+// The TeamPkScoreFunc type is an adapter to allow the use of ordinary
+// function as TeamPkScore mutator.
+type TeamPkScoreFunc func(context.Context, *ent.TeamPkScoreMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamPkScoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamPkScoreMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamPkScoreMutation", m)
+}
+
+// The TeamPkSeerScoreFunc type is an adapter to allow the use of ordinary
+// function as TeamPkSeerScore mutator.
+type TeamPkSeerScoreFunc func(context.Context, *ent.TeamPkSeerScoreMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamPkSeerScoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamPkSeerScoreMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamPkSeerScoreMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
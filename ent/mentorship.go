@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jseer/ent/mentorship"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Mentorship is the model entity for the Mentorship schema.
+type Mentorship struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeacherID holds the value of the "teacher_id" field.
+	TeacherID int64 `json:"teacher_id,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID int64 `json:"student_id,omitempty"`
+	// ExpShared holds the value of the "exp_shared" field.
+	ExpShared int64 `json:"exp_shared,omitempty"`
+	// ExpTotal holds the value of the "exp_total" field.
+	ExpTotal int64 `json:"exp_total,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Mentorship) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mentorship.FieldID, mentorship.FieldTeacherID, mentorship.FieldStudentID, mentorship.FieldExpShared, mentorship.FieldExpTotal:
+			values[i] = new(sql.NullInt64)
+		case mentorship.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Mentorship fields.
+func (_m *Mentorship) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mentorship.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case mentorship.FieldTeacherID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field teacher_id", values[i])
+			} else if value.Valid {
+				_m.TeacherID = value.Int64
+			}
+		case mentorship.FieldStudentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				_m.StudentID = value.Int64
+			}
+		case mentorship.FieldExpShared:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exp_shared", values[i])
+			} else if value.Valid {
+				_m.ExpShared = value.Int64
+			}
+		case mentorship.FieldExpTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exp_total", values[i])
+			} else if value.Valid {
+				_m.ExpTotal = value.Int64
+			}
+		case mentorship.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Mentorship.
+// This includes values selected through modifiers, order, etc.
+func (_m *Mentorship) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Mentorship.
+// Note that you need to call Mentorship.Unwrap() before calling this method if this Mentorship
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Mentorship) Update() *MentorshipUpdateOne {
+	return NewMentorshipClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Mentorship entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Mentorship) Unwrap() *Mentorship {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Mentorship is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Mentorship) String() string {
+	var builder strings.Builder
+	builder.WriteString("Mentorship(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("teacher_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TeacherID))
+	builder.WriteString(", ")
+	builder.WriteString("student_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StudentID))
+	builder.WriteString(", ")
+	builder.WriteString("exp_shared=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpShared))
+	builder.WriteString(", ")
+	builder.WriteString("exp_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpTotal))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Mentorships is a parsable slice of Mentorship.
+type Mentorships []*Mentorship
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mentorship
+
+import (
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLTE(FieldID, id))
+}
+
+// TeacherID applies equality check predicate on the "teacher_id" field. It's identical to TeacherIDEQ.
+func TeacherID(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldTeacherID, v))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldStudentID, v))
+}
+
+// ExpShared applies equality check predicate on the "exp_shared" field. It's identical to ExpSharedEQ.
+func ExpShared(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldExpShared, v))
+}
+
+// ExpTotal applies equality check predicate on the "exp_total" field. It's identical to ExpTotalEQ.
+func ExpTotal(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldExpTotal, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TeacherIDEQ applies the EQ predicate on the "teacher_id" field.
+func TeacherIDEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldTeacherID, v))
+}
+
+// TeacherIDNEQ applies the NEQ predicate on the "teacher_id" field.
+func TeacherIDNEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNEQ(FieldTeacherID, v))
+}
+
+// TeacherIDIn applies the In predicate on the "teacher_id" field.
+func TeacherIDIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldIn(FieldTeacherID, vs...))
+}
+
+// TeacherIDNotIn applies the NotIn predicate on the "teacher_id" field.
+func TeacherIDNotIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNotIn(FieldTeacherID, vs...))
+}
+
+// TeacherIDGT applies the GT predicate on the "teacher_id" field.
+func TeacherIDGT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGT(FieldTeacherID, v))
+}
+
+// TeacherIDGTE applies the GTE predicate on the "teacher_id" field.
+func TeacherIDGTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGTE(FieldTeacherID, v))
+}
+
+// TeacherIDLT applies the LT predicate on the "teacher_id" field.
+func TeacherIDLT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLT(FieldTeacherID, v))
+}
+
+// TeacherIDLTE applies the LTE predicate on the "teacher_id" field.
+func TeacherIDLTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLTE(FieldTeacherID, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// StudentIDGT applies the GT predicate on the "student_id" field.
+func StudentIDGT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGT(FieldStudentID, v))
+}
+
+// StudentIDGTE applies the GTE predicate on the "student_id" field.
+func StudentIDGTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGTE(FieldStudentID, v))
+}
+
+// StudentIDLT applies the LT predicate on the "student_id" field.
+func StudentIDLT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLT(FieldStudentID, v))
+}
+
+// StudentIDLTE applies the LTE predicate on the "student_id" field.
+func StudentIDLTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLTE(FieldStudentID, v))
+}
+
+// ExpSharedEQ applies the EQ predicate on the "exp_shared" field.
+func ExpSharedEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldExpShared, v))
+}
+
+// ExpSharedNEQ applies the NEQ predicate on the "exp_shared" field.
+func ExpSharedNEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNEQ(FieldExpShared, v))
+}
+
+// ExpSharedIn applies the In predicate on the "exp_shared" field.
+func ExpSharedIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldIn(FieldExpShared, vs...))
+}
+
+// ExpSharedNotIn applies the NotIn predicate on the "exp_shared" field.
+func ExpSharedNotIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNotIn(FieldExpShared, vs...))
+}
+
+// ExpSharedGT applies the GT predicate on the "exp_shared" field.
+func ExpSharedGT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGT(FieldExpShared, v))
+}
+
+// ExpSharedGTE applies the GTE predicate on the "exp_shared" field.
+func ExpSharedGTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGTE(FieldExpShared, v))
+}
+
+// ExpSharedLT applies the LT predicate on the "exp_shared" field.
+func ExpSharedLT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLT(FieldExpShared, v))
+}
+
+// ExpSharedLTE applies the LTE predicate on the "exp_shared" field.
+func ExpSharedLTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLTE(FieldExpShared, v))
+}
+
+// ExpTotalEQ applies the EQ predicate on the "exp_total" field.
+func ExpTotalEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldExpTotal, v))
+}
+
+// ExpTotalNEQ applies the NEQ predicate on the "exp_total" field.
+func ExpTotalNEQ(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNEQ(FieldExpTotal, v))
+}
+
+// ExpTotalIn applies the In predicate on the "exp_total" field.
+func ExpTotalIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldIn(FieldExpTotal, vs...))
+}
+
+// ExpTotalNotIn applies the NotIn predicate on the "exp_total" field.
+func ExpTotalNotIn(vs ...int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNotIn(FieldExpTotal, vs...))
+}
+
+// ExpTotalGT applies the GT predicate on the "exp_total" field.
+func ExpTotalGT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGT(FieldExpTotal, v))
+}
+
+// ExpTotalGTE applies the GTE predicate on the "exp_total" field.
+func ExpTotalGTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGTE(FieldExpTotal, v))
+}
+
+// ExpTotalLT applies the LT predicate on the "exp_total" field.
+func ExpTotalLT(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLT(FieldExpTotal, v))
+}
+
+// ExpTotalLTE applies the LTE predicate on the "exp_total" field.
+func ExpTotalLTE(v int64) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLTE(FieldExpTotal, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Mentorship {
+	return predicate.Mentorship(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Mentorship) predicate.Mentorship {
+	return predicate.Mentorship(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Mentorship) predicate.Mentorship {
+	return predicate.Mentorship(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Mentorship) predicate.Mentorship {
+	return predicate.Mentorship(sql.NotPredicates(p))
+}
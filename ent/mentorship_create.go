@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/mentorship"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MentorshipCreate is the builder for creating a Mentorship entity.
+type MentorshipCreate struct {
+	config
+	mutation *MentorshipMutation
+	hooks    []Hook
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_c *MentorshipCreate) SetTeacherID(v int64) *MentorshipCreate {
+	_c.mutation.SetTeacherID(v)
+	return _c
+}
+
+// SetStudentID sets the "student_id" field.
+func (_c *MentorshipCreate) SetStudentID(v int64) *MentorshipCreate {
+	_c.mutation.SetStudentID(v)
+	return _c
+}
+
+// SetExpShared sets the "exp_shared" field.
+func (_c *MentorshipCreate) SetExpShared(v int64) *MentorshipCreate {
+	_c.mutation.SetExpShared(v)
+	return _c
+}
+
+// SetNillableExpShared sets the "exp_shared" field if the given value is not nil.
+func (_c *MentorshipCreate) SetNillableExpShared(v *int64) *MentorshipCreate {
+	if v != nil {
+		_c.SetExpShared(*v)
+	}
+	return _c
+}
+
+// SetExpTotal sets the "exp_total" field.
+func (_c *MentorshipCreate) SetExpTotal(v int64) *MentorshipCreate {
+	_c.mutation.SetExpTotal(v)
+	return _c
+}
+
+// SetNillableExpTotal sets the "exp_total" field if the given value is not nil.
+func (_c *MentorshipCreate) SetNillableExpTotal(v *int64) *MentorshipCreate {
+	if v != nil {
+		_c.SetExpTotal(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MentorshipCreate) SetCreatedAt(v time.Time) *MentorshipCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MentorshipCreate) SetNillableCreatedAt(v *time.Time) *MentorshipCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MentorshipMutation object of the builder.
+func (_c *MentorshipCreate) Mutation() *MentorshipMutation {
+	return _c.mutation
+}
+
+// Save creates the Mentorship in the database.
+func (_c *MentorshipCreate) Save(ctx context.Context) (*Mentorship, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MentorshipCreate) SaveX(ctx context.Context) *Mentorship {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MentorshipCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MentorshipCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MentorshipCreate) defaults() {
+	if _, ok := _c.mutation.ExpShared(); !ok {
+		v := mentorship.DefaultExpShared
+		_c.mutation.SetExpShared(v)
+	}
+	if _, ok := _c.mutation.ExpTotal(); !ok {
+		v := mentorship.DefaultExpTotal
+		_c.mutation.SetExpTotal(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := mentorship.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MentorshipCreate) check() error {
+	if _, ok := _c.mutation.TeacherID(); !ok {
+		return &ValidationError{Name: "teacher_id", err: errors.New(`ent: missing required field "Mentorship.teacher_id"`)}
+	}
+	if _, ok := _c.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "Mentorship.student_id"`)}
+	}
+	if _, ok := _c.mutation.ExpShared(); !ok {
+		return &ValidationError{Name: "exp_shared", err: errors.New(`ent: missing required field "Mentorship.exp_shared"`)}
+	}
+	if _, ok := _c.mutation.ExpTotal(); !ok {
+		return &ValidationError{Name: "exp_total", err: errors.New(`ent: missing required field "Mentorship.exp_total"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Mentorship.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *MentorshipCreate) sqlSave(ctx context.Context) (*Mentorship, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MentorshipCreate) createSpec() (*Mentorship, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Mentorship{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mentorship.Table, sqlgraph.NewFieldSpec(mentorship.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TeacherID(); ok {
+		_spec.SetField(mentorship.FieldTeacherID, field.TypeInt64, value)
+		_node.TeacherID = value
+	}
+	if value, ok := _c.mutation.StudentID(); ok {
+		_spec.SetField(mentorship.FieldStudentID, field.TypeInt64, value)
+		_node.StudentID = value
+	}
+	if value, ok := _c.mutation.ExpShared(); ok {
+		_spec.SetField(mentorship.FieldExpShared, field.TypeInt64, value)
+		_node.ExpShared = value
+	}
+	if value, ok := _c.mutation.ExpTotal(); ok {
+		_spec.SetField(mentorship.FieldExpTotal, field.TypeInt64, value)
+		_node.ExpTotal = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mentorship.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// MentorshipCreateBulk is the builder for creating many Mentorship entities in bulk.
+type MentorshipCreateBulk struct {
+	config
+	err      error
+	builders []*MentorshipCreate
+}
+
+// Save creates the Mentorship entities in the database.
+func (_c *MentorshipCreateBulk) Save(ctx context.Context) ([]*Mentorship, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Mentorship, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MentorshipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MentorshipCreateBulk) SaveX(ctx context.Context) []*Mentorship {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MentorshipCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MentorshipCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/mentorship"
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MentorshipUpdate is the builder for updating Mentorship entities.
+type MentorshipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MentorshipMutation
+}
+
+// Where appends a list predicates to the MentorshipUpdate builder.
+func (_u *MentorshipUpdate) Where(ps ...predicate.Mentorship) *MentorshipUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_u *MentorshipUpdate) SetTeacherID(v int64) *MentorshipUpdate {
+	_u.mutation.ResetTeacherID()
+	_u.mutation.SetTeacherID(v)
+	return _u
+}
+
+// SetNillableTeacherID sets the "teacher_id" field if the given value is not nil.
+func (_u *MentorshipUpdate) SetNillableTeacherID(v *int64) *MentorshipUpdate {
+	if v != nil {
+		_u.SetTeacherID(*v)
+	}
+	return _u
+}
+
+// AddTeacherID adds value to the "teacher_id" field.
+func (_u *MentorshipUpdate) AddTeacherID(v int64) *MentorshipUpdate {
+	_u.mutation.AddTeacherID(v)
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *MentorshipUpdate) SetStudentID(v int64) *MentorshipUpdate {
+	_u.mutation.ResetStudentID()
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *MentorshipUpdate) SetNillableStudentID(v *int64) *MentorshipUpdate {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// AddStudentID adds value to the "student_id" field.
+func (_u *MentorshipUpdate) AddStudentID(v int64) *MentorshipUpdate {
+	_u.mutation.AddStudentID(v)
+	return _u
+}
+
+// SetExpShared sets the "exp_shared" field.
+func (_u *MentorshipUpdate) SetExpShared(v int64) *MentorshipUpdate {
+	_u.mutation.ResetExpShared()
+	_u.mutation.SetExpShared(v)
+	return _u
+}
+
+// SetNillableExpShared sets the "exp_shared" field if the given value is not nil.
+func (_u *MentorshipUpdate) SetNillableExpShared(v *int64) *MentorshipUpdate {
+	if v != nil {
+		_u.SetExpShared(*v)
+	}
+	return _u
+}
+
+// AddExpShared adds value to the "exp_shared" field.
+func (_u *MentorshipUpdate) AddExpShared(v int64) *MentorshipUpdate {
+	_u.mutation.AddExpShared(v)
+	return _u
+}
+
+// SetExpTotal sets the "exp_total" field.
+func (_u *MentorshipUpdate) SetExpTotal(v int64) *MentorshipUpdate {
+	_u.mutation.ResetExpTotal()
+	_u.mutation.SetExpTotal(v)
+	return _u
+}
+
+// SetNillableExpTotal sets the "exp_total" field if the given value is not nil.
+func (_u *MentorshipUpdate) SetNillableExpTotal(v *int64) *MentorshipUpdate {
+	if v != nil {
+		_u.SetExpTotal(*v)
+	}
+	return _u
+}
+
+// AddExpTotal adds value to the "exp_total" field.
+func (_u *MentorshipUpdate) AddExpTotal(v int64) *MentorshipUpdate {
+	_u.mutation.AddExpTotal(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MentorshipUpdate) SetCreatedAt(v time.Time) *MentorshipUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MentorshipUpdate) SetNillableCreatedAt(v *time.Time) *MentorshipUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MentorshipMutation object of the builder.
+func (_u *MentorshipUpdate) Mutation() *MentorshipMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MentorshipUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MentorshipUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MentorshipUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MentorshipUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *MentorshipUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(mentorship.Table, mentorship.Columns, sqlgraph.NewFieldSpec(mentorship.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TeacherID(); ok {
+		_spec.SetField(mentorship.FieldTeacherID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeacherID(); ok {
+		_spec.AddField(mentorship.FieldTeacherID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StudentID(); ok {
+		_spec.SetField(mentorship.FieldStudentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStudentID(); ok {
+		_spec.AddField(mentorship.FieldStudentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ExpShared(); ok {
+		_spec.SetField(mentorship.FieldExpShared, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpShared(); ok {
+		_spec.AddField(mentorship.FieldExpShared, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ExpTotal(); ok {
+		_spec.SetField(mentorship.FieldExpTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpTotal(); ok {
+		_spec.AddField(mentorship.FieldExpTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(mentorship.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mentorship.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MentorshipUpdateOne is the builder for updating a single Mentorship entity.
+type MentorshipUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MentorshipMutation
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_u *MentorshipUpdateOne) SetTeacherID(v int64) *MentorshipUpdateOne {
+	_u.mutation.ResetTeacherID()
+	_u.mutation.SetTeacherID(v)
+	return _u
+}
+
+// SetNillableTeacherID sets the "teacher_id" field if the given value is not nil.
+func (_u *MentorshipUpdateOne) SetNillableTeacherID(v *int64) *MentorshipUpdateOne {
+	if v != nil {
+		_u.SetTeacherID(*v)
+	}
+	return _u
+}
+
+// AddTeacherID adds value to the "teacher_id" field.
+func (_u *MentorshipUpdateOne) AddTeacherID(v int64) *MentorshipUpdateOne {
+	_u.mutation.AddTeacherID(v)
+	return _u
+}
+
+// SetStudentID sets the "student_id" field.
+func (_u *MentorshipUpdateOne) SetStudentID(v int64) *MentorshipUpdateOne {
+	_u.mutation.ResetStudentID()
+	_u.mutation.SetStudentID(v)
+	return _u
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (_u *MentorshipUpdateOne) SetNillableStudentID(v *int64) *MentorshipUpdateOne {
+	if v != nil {
+		_u.SetStudentID(*v)
+	}
+	return _u
+}
+
+// AddStudentID adds value to the "student_id" field.
+func (_u *MentorshipUpdateOne) AddStudentID(v int64) *MentorshipUpdateOne {
+	_u.mutation.AddStudentID(v)
+	return _u
+}
+
+// SetExpShared sets the "exp_shared" field.
+func (_u *MentorshipUpdateOne) SetExpShared(v int64) *MentorshipUpdateOne {
+	_u.mutation.ResetExpShared()
+	_u.mutation.SetExpShared(v)
+	return _u
+}
+
+// SetNillableExpShared sets the "exp_shared" field if the given value is not nil.
+func (_u *MentorshipUpdateOne) SetNillableExpShared(v *int64) *MentorshipUpdateOne {
+	if v != nil {
+		_u.SetExpShared(*v)
+	}
+	return _u
+}
+
+// AddExpShared adds value to the "exp_shared" field.
+func (_u *MentorshipUpdateOne) AddExpShared(v int64) *MentorshipUpdateOne {
+	_u.mutation.AddExpShared(v)
+	return _u
+}
+
+// SetExpTotal sets the "exp_total" field.
+func (_u *MentorshipUpdateOne) SetExpTotal(v int64) *MentorshipUpdateOne {
+	_u.mutation.ResetExpTotal()
+	_u.mutation.SetExpTotal(v)
+	return _u
+}
+
+// SetNillableExpTotal sets the "exp_total" field if the given value is not nil.
+func (_u *MentorshipUpdateOne) SetNillableExpTotal(v *int64) *MentorshipUpdateOne {
+	if v != nil {
+		_u.SetExpTotal(*v)
+	}
+	return _u
+}
+
+// AddExpTotal adds value to the "exp_total" field.
+func (_u *MentorshipUpdateOne) AddExpTotal(v int64) *MentorshipUpdateOne {
+	_u.mutation.AddExpTotal(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MentorshipUpdateOne) SetCreatedAt(v time.Time) *MentorshipUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MentorshipUpdateOne) SetNillableCreatedAt(v *time.Time) *MentorshipUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MentorshipMutation object of the builder.
+func (_u *MentorshipUpdateOne) Mutation() *MentorshipMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MentorshipUpdate builder.
+func (_u *MentorshipUpdateOne) Where(ps ...predicate.Mentorship) *MentorshipUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MentorshipUpdateOne) Select(field string, fields ...string) *MentorshipUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Mentorship entity.
+func (_u *MentorshipUpdateOne) Save(ctx context.Context) (*Mentorship, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MentorshipUpdateOne) SaveX(ctx context.Context) *Mentorship {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MentorshipUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MentorshipUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *MentorshipUpdateOne) sqlSave(ctx context.Context) (_node *Mentorship, err error) {
+	_spec := sqlgraph.NewUpdateSpec(mentorship.Table, mentorship.Columns, sqlgraph.NewFieldSpec(mentorship.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Mentorship.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mentorship.FieldID)
+		for _, f := range fields {
+			if !mentorship.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mentorship.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TeacherID(); ok {
+		_spec.SetField(mentorship.FieldTeacherID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeacherID(); ok {
+		_spec.AddField(mentorship.FieldTeacherID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StudentID(); ok {
+		_spec.SetField(mentorship.FieldStudentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStudentID(); ok {
+		_spec.AddField(mentorship.FieldStudentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ExpShared(); ok {
+		_spec.SetField(mentorship.FieldExpShared, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpShared(); ok {
+		_spec.AddField(mentorship.FieldExpShared, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ExpTotal(); ok {
+		_spec.SetField(mentorship.FieldExpTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpTotal(); ok {
+		_spec.AddField(mentorship.FieldExpTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(mentorship.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &Mentorship{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mentorship.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
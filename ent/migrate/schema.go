@@ -40,6 +40,56 @@ var (
 		Columns:    AuditLogsColumns,
 		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
 	}
+	// ChatLogsColumns holds the columns for the "chat_logs" table.
+	ChatLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "target_id", Type: field.TypeInt64, Default: 0},
+		{Name: "channel", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ChatLogsTable holds the schema information for the "chat_logs" table.
+	ChatLogsTable = &schema.Table{
+		Name:       "chat_logs",
+		Columns:    ChatLogsColumns,
+		PrimaryKey: []*schema.Column{ChatLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatlog_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatLogsColumns[1], ChatLogsColumns[5]},
+			},
+			{
+				Name:    "chatlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatLogsColumns[5]},
+			},
+		},
+	}
+	// ChatMutesColumns holds the columns for the "chat_mutes" table.
+	ChatMutesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64, Unique: true},
+		{Name: "until", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "operator", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ChatMutesTable holds the schema information for the "chat_mutes" table.
+	ChatMutesTable = &schema.Table{
+		Name:       "chat_mutes",
+		Columns:    ChatMutesColumns,
+		PrimaryKey: []*schema.Column{ChatMutesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatmute_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMutesColumns[6]},
+			},
+		},
+	}
 	// ConfigEntriesColumns holds the columns for the "config_entries" table.
 	ConfigEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -119,6 +169,28 @@ var (
 			},
 		},
 	}
+	// MentorshipsColumns holds the columns for the "mentorships" table.
+	MentorshipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "teacher_id", Type: field.TypeInt64},
+		{Name: "student_id", Type: field.TypeInt64, Unique: true},
+		{Name: "exp_shared", Type: field.TypeInt64, Default: 0},
+		{Name: "exp_total", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MentorshipsTable holds the schema information for the "mentorships" table.
+	MentorshipsTable = &schema.Table{
+		Name:       "mentorships",
+		Columns:    MentorshipsColumns,
+		PrimaryKey: []*schema.Column{MentorshipsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mentorship_teacher_id",
+				Unique:  false,
+				Columns: []*schema.Column{MentorshipsColumns[1]},
+			},
+		},
+	}
 	// PermissionsColumns holds the columns for the "permissions" table.
 	PermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -144,6 +216,14 @@ var (
 		{Name: "dv", Type: field.TypeInt, Default: 31},
 		{Name: "nature", Type: field.TypeString, Default: "normal"},
 		{Name: "skills", Type: field.TypeString, Default: ""},
+		{Name: "learned_skills", Type: field.TypeString, Default: "[]"},
+		{Name: "ev", Type: field.TypeString, Default: "{}"},
+		{Name: "skill_pp", Type: field.TypeString, Default: "{}"},
+		{Name: "status", Type: field.TypeString, Default: "{}"},
+		{Name: "fainted", Type: field.TypeBool, Default: false},
+		{Name: "collected", Type: field.TypeBool, Default: false},
+		{Name: "room_show", Type: field.TypeBool, Default: false},
+		{Name: "location", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "player_id", Type: field.TypeInt},
@@ -156,7 +236,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pets_players_pets",
-				Columns:    []*schema.Column{PetsColumns[11]},
+				Columns:    []*schema.Column{PetsColumns[19]},
 				RefColumns: []*schema.Column{PlayersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -192,9 +272,16 @@ var (
 		{Name: "team_info", Type: field.TypeString, Default: "{}"},
 		{Name: "student_ids", Type: field.TypeString, Default: "[]"},
 		{Name: "room_id", Type: field.TypeInt64, Default: 0},
+		{Name: "room_privacy", Type: field.TypeInt64, Default: 0},
 		{Name: "fitments", Type: field.TypeString, Default: "[]"},
 		{Name: "nono_info", Type: field.TypeString, Default: "{}"},
 		{Name: "mailbox", Type: field.TypeString, Default: "[]"},
+		{Name: "boss_clears", Type: field.TypeString, Default: "[]"},
+		{Name: "exp_pool", Type: field.TypeInt64, Default: 0},
+		{Name: "graduation_count", Type: field.TypeInt64, Default: 0},
+		{Name: "incubator", Type: field.TypeString, Default: "{}"},
+		{Name: "soul_beads", Type: field.TypeString, Default: "{}"},
+		{Name: "item_buffs", Type: field.TypeString, Default: "{}"},
 		{Name: "current_pet_id", Type: field.TypeInt64, Default: 0},
 		{Name: "current_pet_catch_time", Type: field.TypeInt64, Default: 0},
 		{Name: "current_pet_dv", Type: field.TypeInt64, Default: 31},
@@ -211,12 +298,50 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "players_accounts_players",
-				Columns:    []*schema.Column{PlayersColumns[37]},
+				Columns:    []*schema.Column{PlayersColumns[44]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// PvpRatingsColumns holds the columns for the "pvp_ratings" table.
+	PvpRatingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "season", Type: field.TypeInt},
+		{Name: "nick", Type: field.TypeString, Default: ""},
+		{Name: "rating", Type: field.TypeInt, Default: 1500},
+		{Name: "wins", Type: field.TypeInt, Default: 0},
+		{Name: "losses", Type: field.TypeInt, Default: 0},
+		{Name: "draws", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "player_id", Type: field.TypeInt},
+	}
+	// PvpRatingsTable holds the schema information for the "pvp_ratings" table.
+	PvpRatingsTable = &schema.Table{
+		Name:       "pvp_ratings",
+		Columns:    PvpRatingsColumns,
+		PrimaryKey: []*schema.Column{PvpRatingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pvp_ratings_players_pvp_ratings",
+				Columns:    []*schema.Column{PvpRatingsColumns[8]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pvprating_player_id_season",
+				Unique:  true,
+				Columns: []*schema.Column{PvpRatingsColumns[8], PvpRatingsColumns[1]},
+			},
+			{
+				Name:    "pvprating_season_rating",
+				Unique:  false,
+				Columns: []*schema.Column{PvpRatingsColumns[1], PvpRatingsColumns[3]},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -230,6 +355,150 @@ var (
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
 	}
+	// TeamsColumns holds the columns for the "teams" table.
+	TeamsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "name_key", Type: field.TypeString, Unique: true},
+		{Name: "legacy_id", Type: field.TypeInt64, Unique: true, Nullable: true},
+		{Name: "leader_id", Type: field.TypeInt64},
+		{Name: "interest", Type: field.TypeInt, Default: 0},
+		{Name: "join_flag", Type: field.TypeInt, Default: 0},
+		{Name: "exp", Type: field.TypeInt64, Default: 0},
+		{Name: "score", Type: field.TypeInt64, Default: 0},
+		{Name: "slogan", Type: field.TypeString, Default: ""},
+		{Name: "notice", Type: field.TypeString, Default: ""},
+		{Name: "logo_bg", Type: field.TypeInt, Default: 1},
+		{Name: "logo_icon", Type: field.TypeInt, Default: 1},
+		{Name: "logo_color", Type: field.TypeInt, Default: 65535},
+		{Name: "txt_color", Type: field.TypeInt, Default: 65535},
+		{Name: "logo_word", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TeamsTable holds the schema information for the "teams" table.
+	TeamsTable = &schema.Table{
+		Name:       "teams",
+		Columns:    TeamsColumns,
+		PrimaryKey: []*schema.Column{TeamsColumns[0]},
+	}
+	// TeamMembersColumns holds the columns for the "team_members" table.
+	TeamMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64, Unique: true},
+		{Name: "nick", Type: field.TypeString, Default: ""},
+		{Name: "priv", Type: field.TypeInt, Default: 0},
+		{Name: "contribution", Type: field.TypeInt64, Default: 0},
+		{Name: "can_ex_contribution", Type: field.TypeInt64, Default: 0},
+		{Name: "is_show", Type: field.TypeBool, Default: true},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "team_id", Type: field.TypeInt},
+	}
+	// TeamMembersTable holds the schema information for the "team_members" table.
+	TeamMembersTable = &schema.Table{
+		Name:       "team_members",
+		Columns:    TeamMembersColumns,
+		PrimaryKey: []*schema.Column{TeamMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "team_members_teams_members",
+				Columns:    []*schema.Column{TeamMembersColumns[8]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teammember_team_id_priv",
+				Unique:  false,
+				Columns: []*schema.Column{TeamMembersColumns[8], TeamMembersColumns[3]},
+			},
+		},
+	}
+	// TeamPkHistoriesColumns holds the columns for the "team_pk_histories" table.
+	TeamPkHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "team_id", Type: field.TypeInt64},
+		{Name: "season", Type: field.TypeInt},
+		{Name: "opponent_id", Type: field.TypeInt64},
+		{Name: "opponent_name", Type: field.TypeString, Default: ""},
+		{Name: "result", Type: field.TypeInt, Default: 0},
+		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "building_hp", Type: field.TypeInt, Default: 0},
+		{Name: "opponent_building_hp", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TeamPkHistoriesTable holds the schema information for the "team_pk_histories" table.
+	TeamPkHistoriesTable = &schema.Table{
+		Name:       "team_pk_histories",
+		Columns:    TeamPkHistoriesColumns,
+		PrimaryKey: []*schema.Column{TeamPkHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teampkhistory_team_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TeamPkHistoriesColumns[1], TeamPkHistoriesColumns[9]},
+			},
+		},
+	}
+	// TeamPkScoresColumns holds the columns for the "team_pk_scores" table.
+	TeamPkScoresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "team_id", Type: field.TypeInt64},
+		{Name: "season", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "wins", Type: field.TypeInt, Default: 0},
+		{Name: "losses", Type: field.TypeInt, Default: 0},
+		{Name: "draws", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TeamPkScoresTable holds the schema information for the "team_pk_scores" table.
+	TeamPkScoresTable = &schema.Table{
+		Name:       "team_pk_scores",
+		Columns:    TeamPkScoresColumns,
+		PrimaryKey: []*schema.Column{TeamPkScoresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teampkscore_team_id_season",
+				Unique:  true,
+				Columns: []*schema.Column{TeamPkScoresColumns[1], TeamPkScoresColumns[2]},
+			},
+			{
+				Name:    "teampkscore_season_score",
+				Unique:  false,
+				Columns: []*schema.Column{TeamPkScoresColumns[2], TeamPkScoresColumns[4]},
+			},
+		},
+	}
+	// TeamPkSeerScoresColumns holds the columns for the "team_pk_seer_scores" table.
+	TeamPkSeerScoresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "season", Type: field.TypeInt},
+		{Name: "team_id", Type: field.TypeInt64, Default: 0},
+		{Name: "nick", Type: field.TypeString, Default: ""},
+		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TeamPkSeerScoresTable holds the schema information for the "team_pk_seer_scores" table.
+	TeamPkSeerScoresTable = &schema.Table{
+		Name:       "team_pk_seer_scores",
+		Columns:    TeamPkSeerScoresColumns,
+		PrimaryKey: []*schema.Column{TeamPkSeerScoresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "teampkseerscore_user_id_season",
+				Unique:  true,
+				Columns: []*schema.Column{TeamPkSeerScoresColumns[1], TeamPkSeerScoresColumns[2]},
+			},
+			{
+				Name:    "teampkseerscore_season_score",
+				Unique:  false,
+				Columns: []*schema.Column{TeamPkSeerScoresColumns[2], TeamPkSeerScoresColumns[5]},
+			},
+		},
+	}
 	// RolePermissionsColumns holds the columns for the "role_permissions" table.
 	RolePermissionsColumns = []*schema.Column{
 		{Name: "role_id", Type: field.TypeInt},
@@ -284,14 +553,23 @@ var (
 	Tables = []*schema.Table{
 		AccountsTable,
 		AuditLogsTable,
+		ChatLogsTable,
+		ChatMutesTable,
 		ConfigEntriesTable,
 		ConfigVersionsTable,
 		GmUsersTable,
 		ItemsTable,
+		MentorshipsTable,
 		PermissionsTable,
 		PetsTable,
 		PlayersTable,
+		PvpRatingsTable,
 		RolesTable,
+		TeamsTable,
+		TeamMembersTable,
+		TeamPkHistoriesTable,
+		TeamPkScoresTable,
+		TeamPkSeerScoresTable,
 		RolePermissionsTable,
 		RoleGmUsersTable,
 	}
@@ -302,6 +580,8 @@ func init() {
 	ItemsTable.ForeignKeys[0].RefTable = PlayersTable
 	PetsTable.ForeignKeys[0].RefTable = PlayersTable
 	PlayersTable.ForeignKeys[0].RefTable = AccountsTable
+	PvpRatingsTable.ForeignKeys[0].RefTable = PlayersTable
+	TeamMembersTable.ForeignKeys[0].RefTable = TeamsTable
 	RolePermissionsTable.ForeignKeys[0].RefTable = RolesTable
 	RolePermissionsTable.ForeignKeys[1].RefTable = PermissionsTable
 	RoleGmUsersTable.ForeignKeys[0].RefTable = RolesTable
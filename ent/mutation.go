@@ -8,15 +8,24 @@ import (
 	"fmt"
 	"jseer/ent/account"
 	"jseer/ent/auditlog"
+	"jseer/ent/chatlog"
+	"jseer/ent/chatmute"
 	"jseer/ent/configentry"
 	"jseer/ent/configversion"
 	"jseer/ent/gmuser"
 	"jseer/ent/item"
+	"jseer/ent/mentorship"
 	"jseer/ent/permission"
 	"jseer/ent/pet"
 	"jseer/ent/player"
 	"jseer/ent/predicate"
+	"jseer/ent/pvprating"
 	"jseer/ent/role"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"jseer/ent/teampkhistory"
+	"jseer/ent/teampkscore"
+	"jseer/ent/teampkseerscore"
 	"sync"
 	"time"
 
@@ -33,16 +42,25 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccount       = "Account"
-	TypeAuditLog      = "AuditLog"
-	TypeConfigEntry   = "ConfigEntry"
-	TypeConfigVersion = "ConfigVersion"
-	TypeGMUser        = "GMUser"
-	TypeItem          = "Item"
-	TypePermission    = "Permission"
-	TypePet           = "Pet"
-	TypePlayer        = "Player"
-	TypeRole          = "Role"
+	TypeAccount         = "Account"
+	TypeAuditLog        = "AuditLog"
+	TypeChatLog         = "ChatLog"
+	TypeChatMute        = "ChatMute"
+	TypeConfigEntry     = "ConfigEntry"
+	TypeConfigVersion   = "ConfigVersion"
+	TypeGMUser          = "GMUser"
+	TypeItem            = "Item"
+	TypeMentorship      = "Mentorship"
+	TypePermission      = "Permission"
+	TypePet             = "Pet"
+	TypePlayer          = "Player"
+	TypePvpRating       = "PvpRating"
+	TypeRole            = "Role"
+	TypeTeam            = "Team"
+	TypeTeamMember      = "TeamMember"
+	TypeTeamPkHistory   = "TeamPkHistory"
+	TypeTeamPkScore     = "TeamPkScore"
+	TypeTeamPkSeerScore = "TeamPkSeerScore"
 )
 
 // AccountMutation represents an operation that mutates the Account nodes in the graph.
@@ -1330,38 +1348,36 @@ func (m *AuditLogMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// ConfigEntryMutation represents an operation that mutates the ConfigEntry nodes in the graph.
-type ConfigEntryMutation struct {
+// ChatLogMutation represents an operation that mutates the ChatLog nodes in the graph.
+type ChatLogMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	key             *string
-	value           *[]byte
-	version         *int64
-	addversion      *int64
-	checksum        *string
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	versions        map[int]struct{}
-	removedversions map[int]struct{}
-	clearedversions bool
-	done            bool
-	oldValue        func(context.Context) (*ConfigEntry, error)
-	predicates      []predicate.ConfigEntry
+	op            Op
+	typ           string
+	id            *int
+	user_id       *int64
+	adduser_id    *int64
+	target_id     *int64
+	addtarget_id  *int64
+	channel       *string
+	message       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ChatLog, error)
+	predicates    []predicate.ChatLog
 }
 
-var _ ent.Mutation = (*ConfigEntryMutation)(nil)
+var _ ent.Mutation = (*ChatLogMutation)(nil)
 
-// configentryOption allows management of the mutation configuration using functional options.
-type configentryOption func(*ConfigEntryMutation)
+// chatlogOption allows management of the mutation configuration using functional options.
+type chatlogOption func(*ChatLogMutation)
 
-// newConfigEntryMutation creates new mutation for the ConfigEntry entity.
-func newConfigEntryMutation(c config, op Op, opts ...configentryOption) *ConfigEntryMutation {
-	m := &ConfigEntryMutation{
+// newChatLogMutation creates new mutation for the ChatLog entity.
+func newChatLogMutation(c config, op Op, opts ...chatlogOption) *ChatLogMutation {
+	m := &ChatLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConfigEntry,
+		typ:           TypeChatLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1370,20 +1386,20 @@ func newConfigEntryMutation(c config, op Op, opts ...configentryOption) *ConfigE
 	return m
 }
 
-// withConfigEntryID sets the ID field of the mutation.
-func withConfigEntryID(id int) configentryOption {
-	return func(m *ConfigEntryMutation) {
+// withChatLogID sets the ID field of the mutation.
+func withChatLogID(id int) chatlogOption {
+	return func(m *ChatLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ConfigEntry
+			value *ChatLog
 		)
-		m.oldValue = func(ctx context.Context) (*ConfigEntry, error) {
+		m.oldValue = func(ctx context.Context) (*ChatLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ConfigEntry.Get(ctx, id)
+					value, err = m.Client().ChatLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1392,10 +1408,10 @@ func withConfigEntryID(id int) configentryOption {
 	}
 }
 
-// withConfigEntry sets the old ConfigEntry of the mutation.
-func withConfigEntry(node *ConfigEntry) configentryOption {
-	return func(m *ConfigEntryMutation) {
-		m.oldValue = func(context.Context) (*ConfigEntry, error) {
+// withChatLog sets the old ChatLog of the mutation.
+func withChatLog(node *ChatLog) chatlogOption {
+	return func(m *ChatLogMutation) {
+		m.oldValue = func(context.Context) (*ChatLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1404,7 +1420,7 @@ func withConfigEntry(node *ConfigEntry) configentryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConfigEntryMutation) Client() *Client {
+func (m ChatLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1412,7 +1428,7 @@ func (m ConfigEntryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConfigEntryMutation) Tx() (*Tx, error) {
+func (m ChatLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1423,7 +1439,7 @@ func (m ConfigEntryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConfigEntryMutation) ID() (id int, exists bool) {
+func (m *ChatLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1434,7 +1450,7 @@ func (m *ConfigEntryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConfigEntryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChatLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1443,275 +1459,241 @@ func (m *ConfigEntryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ConfigEntry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChatLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *ConfigEntryMutation) SetKey(s string) {
-	m.key = &s
+// SetUserID sets the "user_id" field.
+func (m *ChatLogMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *ConfigEntryMutation) Key() (r string, exists bool) {
-	v := m.key
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ChatLogMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the ConfigEntry entity.
-// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ChatLog entity.
+// If the ChatLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigEntryMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *ChatLogMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Key, nil
-}
-
-// ResetKey resets all changes to the "key" field.
-func (m *ConfigEntryMutation) ResetKey() {
-	m.key = nil
+	return oldValue.UserID, nil
 }
 
-// SetValue sets the "value" field.
-func (m *ConfigEntryMutation) SetValue(b []byte) {
-	m.value = &b
+// AddUserID adds i to the "user_id" field.
+func (m *ChatLogMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ConfigEntryMutation) Value() (r []byte, exists bool) {
-	v := m.value
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ChatLogMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the ConfigEntry entity.
-// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigEntryMutation) OldValue(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
-	}
-	return oldValue.Value, nil
-}
-
-// ResetValue resets all changes to the "value" field.
-func (m *ConfigEntryMutation) ResetValue() {
-	m.value = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ChatLogMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *ConfigEntryMutation) SetVersion(i int64) {
-	m.version = &i
-	m.addversion = nil
+// SetTargetID sets the "target_id" field.
+func (m *ChatLogMutation) SetTargetID(i int64) {
+	m.target_id = &i
+	m.addtarget_id = nil
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *ConfigEntryMutation) Version() (r int64, exists bool) {
-	v := m.version
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *ChatLogMutation) TargetID() (r int64, exists bool) {
+	v := m.target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the ConfigEntry entity.
-// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the ChatLog entity.
+// If the ChatLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigEntryMutation) OldVersion(ctx context.Context) (v int64, err error) {
+func (m *ChatLogMutation) OldTargetID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.TargetID, nil
 }
 
-// AddVersion adds i to the "version" field.
-func (m *ConfigEntryMutation) AddVersion(i int64) {
-	if m.addversion != nil {
-		*m.addversion += i
+// AddTargetID adds i to the "target_id" field.
+func (m *ChatLogMutation) AddTargetID(i int64) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += i
 	} else {
-		m.addversion = &i
+		m.addtarget_id = &i
 	}
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *ConfigEntryMutation) AddedVersion() (r int64, exists bool) {
-	v := m.addversion
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *ChatLogMutation) AddedTargetID() (r int64, exists bool) {
+	v := m.addtarget_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *ConfigEntryMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *ChatLogMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
 }
 
-// SetChecksum sets the "checksum" field.
-func (m *ConfigEntryMutation) SetChecksum(s string) {
-	m.checksum = &s
+// SetChannel sets the "channel" field.
+func (m *ChatLogMutation) SetChannel(s string) {
+	m.channel = &s
 }
 
-// Checksum returns the value of the "checksum" field in the mutation.
-func (m *ConfigEntryMutation) Checksum() (r string, exists bool) {
-	v := m.checksum
+// Channel returns the value of the "channel" field in the mutation.
+func (m *ChatLogMutation) Channel() (r string, exists bool) {
+	v := m.channel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChecksum returns the old "checksum" field's value of the ConfigEntry entity.
-// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldChannel returns the old "channel" field's value of the ChatLog entity.
+// If the ChatLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigEntryMutation) OldChecksum(ctx context.Context) (v string, err error) {
+func (m *ChatLogMutation) OldChannel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksum requires an ID field in the mutation")
+		return v, errors.New("OldChannel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
 	}
-	return oldValue.Checksum, nil
+	return oldValue.Channel, nil
 }
 
-// ResetChecksum resets all changes to the "checksum" field.
-func (m *ConfigEntryMutation) ResetChecksum() {
-	m.checksum = nil
+// ResetChannel resets all changes to the "channel" field.
+func (m *ChatLogMutation) ResetChannel() {
+	m.channel = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConfigEntryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetMessage sets the "message" field.
+func (m *ChatLogMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConfigEntryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Message returns the value of the "message" field in the mutation.
+func (m *ChatLogMutation) Message() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ConfigEntry entity.
-// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the ChatLog entity.
+// If the ChatLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatLogMutation) OldMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConfigEntryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddVersionIDs adds the "versions" edge to the ConfigVersion entity by ids.
-func (m *ConfigEntryMutation) AddVersionIDs(ids ...int) {
-	if m.versions == nil {
-		m.versions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.versions[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
+	return oldValue.Message, nil
 }
 
-// ClearVersions clears the "versions" edge to the ConfigVersion entity.
-func (m *ConfigEntryMutation) ClearVersions() {
-	m.clearedversions = true
+// ResetMessage resets all changes to the "message" field.
+func (m *ChatLogMutation) ResetMessage() {
+	m.message = nil
 }
 
-// VersionsCleared reports if the "versions" edge to the ConfigVersion entity was cleared.
-func (m *ConfigEntryMutation) VersionsCleared() bool {
-	return m.clearedversions
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveVersionIDs removes the "versions" edge to the ConfigVersion entity by IDs.
-func (m *ConfigEntryMutation) RemoveVersionIDs(ids ...int) {
-	if m.removedversions == nil {
-		m.removedversions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.versions, ids[i])
-		m.removedversions[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedVersions returns the removed IDs of the "versions" edge to the ConfigVersion entity.
-func (m *ConfigEntryMutation) RemovedVersionsIDs() (ids []int) {
-	for id := range m.removedversions {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the ChatLog entity.
+// If the ChatLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// VersionsIDs returns the "versions" edge IDs in the mutation.
-func (m *ConfigEntryMutation) VersionsIDs() (ids []int) {
-	for id := range m.versions {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetVersions resets all changes to the "versions" edge.
-func (m *ConfigEntryMutation) ResetVersions() {
-	m.versions = nil
-	m.clearedversions = false
-	m.removedversions = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the ConfigEntryMutation builder.
-func (m *ConfigEntryMutation) Where(ps ...predicate.ConfigEntry) {
+// Where appends a list predicates to the ChatLogMutation builder.
+func (m *ChatLogMutation) Where(ps ...predicate.ChatLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConfigEntryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChatLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConfigEntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ConfigEntry, len(ps))
+func (m *ChatLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1719,39 +1701,39 @@ func (m *ConfigEntryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConfigEntryMutation) Op() Op {
+func (m *ChatLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConfigEntryMutation) SetOp(op Op) {
+func (m *ChatLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ConfigEntry).
-func (m *ConfigEntryMutation) Type() string {
+// Type returns the node type of this mutation (ChatLog).
+func (m *ChatLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConfigEntryMutation) Fields() []string {
+func (m *ChatLogMutation) Fields() []string {
 	fields := make([]string, 0, 5)
-	if m.key != nil {
-		fields = append(fields, configentry.FieldKey)
+	if m.user_id != nil {
+		fields = append(fields, chatlog.FieldUserID)
 	}
-	if m.value != nil {
-		fields = append(fields, configentry.FieldValue)
+	if m.target_id != nil {
+		fields = append(fields, chatlog.FieldTargetID)
 	}
-	if m.version != nil {
-		fields = append(fields, configentry.FieldVersion)
+	if m.channel != nil {
+		fields = append(fields, chatlog.FieldChannel)
 	}
-	if m.checksum != nil {
-		fields = append(fields, configentry.FieldChecksum)
+	if m.message != nil {
+		fields = append(fields, chatlog.FieldMessage)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, configentry.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, chatlog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1759,18 +1741,18 @@ func (m *ConfigEntryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConfigEntryMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case configentry.FieldKey:
-		return m.Key()
-	case configentry.FieldValue:
-		return m.Value()
-	case configentry.FieldVersion:
-		return m.Version()
-	case configentry.FieldChecksum:
-		return m.Checksum()
-	case configentry.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case chatlog.FieldUserID:
+		return m.UserID()
+	case chatlog.FieldTargetID:
+		return m.TargetID()
+	case chatlog.FieldChannel:
+		return m.Channel()
+	case chatlog.FieldMessage:
+		return m.Message()
+	case chatlog.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1778,72 +1760,75 @@ func (m *ConfigEntryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConfigEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case configentry.FieldKey:
-		return m.OldKey(ctx)
-	case configentry.FieldValue:
-		return m.OldValue(ctx)
-	case configentry.FieldVersion:
-		return m.OldVersion(ctx)
-	case configentry.FieldChecksum:
-		return m.OldChecksum(ctx)
-	case configentry.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case chatlog.FieldUserID:
+		return m.OldUserID(ctx)
+	case chatlog.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case chatlog.FieldChannel:
+		return m.OldChannel(ctx)
+	case chatlog.FieldMessage:
+		return m.OldMessage(ctx)
+	case chatlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ConfigEntry field %s", name)
+	return nil, fmt.Errorf("unknown ChatLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigEntryMutation) SetField(name string, value ent.Value) error {
+func (m *ChatLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case configentry.FieldKey:
-		v, ok := value.(string)
+	case chatlog.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetUserID(v)
 		return nil
-	case configentry.FieldValue:
-		v, ok := value.([]byte)
+	case chatlog.FieldTargetID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetTargetID(v)
 		return nil
-	case configentry.FieldVersion:
-		v, ok := value.(int64)
+	case chatlog.FieldChannel:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetChannel(v)
 		return nil
-	case configentry.FieldChecksum:
+	case chatlog.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksum(v)
+		m.SetMessage(v)
 		return nil
-	case configentry.FieldUpdatedAt:
+	case chatlog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigEntry field %s", name)
+	return fmt.Errorf("unknown ChatLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConfigEntryMutation) AddedFields() []string {
+func (m *ChatLogMutation) AddedFields() []string {
 	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, configentry.FieldVersion)
+	if m.adduser_id != nil {
+		fields = append(fields, chatlog.FieldUserID)
+	}
+	if m.addtarget_id != nil {
+		fields = append(fields, chatlog.FieldTargetID)
 	}
 	return fields
 }
@@ -1851,10 +1836,12 @@ func (m *ConfigEntryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConfigEntryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChatLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case configentry.FieldVersion:
-		return m.AddedVersion()
+	case chatlog.FieldUserID:
+		return m.AddedUserID()
+	case chatlog.FieldTargetID:
+		return m.AddedTargetID()
 	}
 	return nil, false
 }
@@ -1862,177 +1849,146 @@ func (m *ConfigEntryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigEntryMutation) AddField(name string, value ent.Value) error {
+func (m *ChatLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case configentry.FieldVersion:
+	case chatlog.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVersion(v)
+		m.AddUserID(v)
+		return nil
+	case chatlog.FieldTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigEntry numeric field %s", name)
+	return fmt.Errorf("unknown ChatLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConfigEntryMutation) ClearedFields() []string {
+func (m *ChatLogMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConfigEntryMutation) FieldCleared(name string) bool {
+func (m *ChatLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConfigEntryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ConfigEntry nullable field %s", name)
+func (m *ChatLogMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChatLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConfigEntryMutation) ResetField(name string) error {
+func (m *ChatLogMutation) ResetField(name string) error {
 	switch name {
-	case configentry.FieldKey:
-		m.ResetKey()
+	case chatlog.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case configentry.FieldValue:
-		m.ResetValue()
+	case chatlog.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case configentry.FieldVersion:
-		m.ResetVersion()
+	case chatlog.FieldChannel:
+		m.ResetChannel()
 		return nil
-	case configentry.FieldChecksum:
-		m.ResetChecksum()
+	case chatlog.FieldMessage:
+		m.ResetMessage()
 		return nil
-	case configentry.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case chatlog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigEntry field %s", name)
+	return fmt.Errorf("unknown ChatLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConfigEntryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.versions != nil {
-		edges = append(edges, configentry.EdgeVersions)
-	}
+func (m *ChatLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConfigEntryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case configentry.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.versions))
-		for id := range m.versions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ChatLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConfigEntryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedversions != nil {
-		edges = append(edges, configentry.EdgeVersions)
-	}
+func (m *ChatLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConfigEntryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case configentry.EdgeVersions:
-		ids := make([]ent.Value, 0, len(m.removedversions))
-		for id := range m.removedversions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ChatLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConfigEntryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedversions {
-		edges = append(edges, configentry.EdgeVersions)
-	}
+func (m *ChatLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConfigEntryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case configentry.EdgeVersions:
-		return m.clearedversions
-	}
+func (m *ChatLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConfigEntryMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown ConfigEntry unique edge %s", name)
+func (m *ChatLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChatLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConfigEntryMutation) ResetEdge(name string) error {
-	switch name {
-	case configentry.EdgeVersions:
-		m.ResetVersions()
-		return nil
-	}
-	return fmt.Errorf("unknown ConfigEntry edge %s", name)
+func (m *ChatLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChatLog edge %s", name)
 }
 
-// ConfigVersionMutation represents an operation that mutates the ConfigVersion nodes in the graph.
-type ConfigVersionMutation struct {
+// ChatMuteMutation represents an operation that mutates the ChatMute nodes in the graph.
+type ChatMuteMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	key           *string
-	version       *int64
-	addversion    *int64
-	value         *[]byte
-	checksum      *string
+	user_id       *int64
+	adduser_id    *int64
+	until         *time.Time
+	reason        *string
 	operator      *string
 	created_at    *time.Time
+	updated_at    *time.Time
 	clearedFields map[string]struct{}
-	entry         *int
-	clearedentry  bool
 	done          bool
-	oldValue      func(context.Context) (*ConfigVersion, error)
-	predicates    []predicate.ConfigVersion
+	oldValue      func(context.Context) (*ChatMute, error)
+	predicates    []predicate.ChatMute
 }
 
-var _ ent.Mutation = (*ConfigVersionMutation)(nil)
+var _ ent.Mutation = (*ChatMuteMutation)(nil)
 
-// configversionOption allows management of the mutation configuration using functional options.
-type configversionOption func(*ConfigVersionMutation)
+// chatmuteOption allows management of the mutation configuration using functional options.
+type chatmuteOption func(*ChatMuteMutation)
 
-// newConfigVersionMutation creates new mutation for the ConfigVersion entity.
-func newConfigVersionMutation(c config, op Op, opts ...configversionOption) *ConfigVersionMutation {
-	m := &ConfigVersionMutation{
+// newChatMuteMutation creates new mutation for the ChatMute entity.
+func newChatMuteMutation(c config, op Op, opts ...chatmuteOption) *ChatMuteMutation {
+	m := &ChatMuteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConfigVersion,
+		typ:           TypeChatMute,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2041,20 +1997,20 @@ func newConfigVersionMutation(c config, op Op, opts ...configversionOption) *Con
 	return m
 }
 
-// withConfigVersionID sets the ID field of the mutation.
-func withConfigVersionID(id int) configversionOption {
-	return func(m *ConfigVersionMutation) {
+// withChatMuteID sets the ID field of the mutation.
+func withChatMuteID(id int) chatmuteOption {
+	return func(m *ChatMuteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ConfigVersion
+			value *ChatMute
 		)
-		m.oldValue = func(ctx context.Context) (*ConfigVersion, error) {
+		m.oldValue = func(ctx context.Context) (*ChatMute, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ConfigVersion.Get(ctx, id)
+					value, err = m.Client().ChatMute.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2063,10 +2019,10 @@ func withConfigVersionID(id int) configversionOption {
 	}
 }
 
-// withConfigVersion sets the old ConfigVersion of the mutation.
-func withConfigVersion(node *ConfigVersion) configversionOption {
-	return func(m *ConfigVersionMutation) {
-		m.oldValue = func(context.Context) (*ConfigVersion, error) {
+// withChatMute sets the old ChatMute of the mutation.
+func withChatMute(node *ChatMute) chatmuteOption {
+	return func(m *ChatMuteMutation) {
+		m.oldValue = func(context.Context) (*ChatMute, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2075,7 +2031,7 @@ func withConfigVersion(node *ConfigVersion) configversionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConfigVersionMutation) Client() *Client {
+func (m ChatMuteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2083,7 +2039,7 @@ func (m ConfigVersionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConfigVersionMutation) Tx() (*Tx, error) {
+func (m ChatMuteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2094,7 +2050,7 @@ func (m ConfigVersionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConfigVersionMutation) ID() (id int, exists bool) {
+func (m *ChatMuteMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2105,7 +2061,7 @@ func (m *ConfigVersionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConfigVersionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChatMuteMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2114,183 +2070,147 @@ func (m *ConfigVersionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ConfigVersion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChatMute.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *ConfigVersionMutation) SetKey(s string) {
-	m.key = &s
-}
-
-// Key returns the value of the "key" field in the mutation.
-func (m *ConfigVersionMutation) Key() (r string, exists bool) {
-	v := m.key
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldKey returns the old "key" field's value of the ConfigVersion entity.
-// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigVersionMutation) OldKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
-	}
-	return oldValue.Key, nil
-}
-
-// ResetKey resets all changes to the "key" field.
-func (m *ConfigVersionMutation) ResetKey() {
-	m.key = nil
-}
-
-// SetVersion sets the "version" field.
-func (m *ConfigVersionMutation) SetVersion(i int64) {
-	m.version = &i
-	m.addversion = nil
+// SetUserID sets the "user_id" field.
+func (m *ChatMuteMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *ConfigVersionMutation) Version() (r int64, exists bool) {
-	v := m.version
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ChatMuteMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the ConfigVersion entity.
-// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ChatMute entity.
+// If the ChatMute object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigVersionMutation) OldVersion(ctx context.Context) (v int64, err error) {
+func (m *ChatMuteMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.UserID, nil
 }
 
-// AddVersion adds i to the "version" field.
-func (m *ConfigVersionMutation) AddVersion(i int64) {
-	if m.addversion != nil {
-		*m.addversion += i
+// AddUserID adds i to the "user_id" field.
+func (m *ChatMuteMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
 	} else {
-		m.addversion = &i
+		m.adduser_id = &i
 	}
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *ConfigVersionMutation) AddedVersion() (r int64, exists bool) {
-	v := m.addversion
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ChatMuteMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *ConfigVersionMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ChatMuteMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetValue sets the "value" field.
-func (m *ConfigVersionMutation) SetValue(b []byte) {
-	m.value = &b
+// SetUntil sets the "until" field.
+func (m *ChatMuteMutation) SetUntil(t time.Time) {
+	m.until = &t
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ConfigVersionMutation) Value() (r []byte, exists bool) {
-	v := m.value
+// Until returns the value of the "until" field in the mutation.
+func (m *ChatMuteMutation) Until() (r time.Time, exists bool) {
+	v := m.until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the ConfigVersion entity.
-// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldUntil returns the old "until" field's value of the ChatMute entity.
+// If the ChatMute object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigVersionMutation) OldValue(ctx context.Context) (v []byte, err error) {
+func (m *ChatMuteMutation) OldUntil(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldUntil: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Until, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *ConfigVersionMutation) ResetValue() {
-	m.value = nil
+// ResetUntil resets all changes to the "until" field.
+func (m *ChatMuteMutation) ResetUntil() {
+	m.until = nil
 }
 
-// SetChecksum sets the "checksum" field.
-func (m *ConfigVersionMutation) SetChecksum(s string) {
-	m.checksum = &s
+// SetReason sets the "reason" field.
+func (m *ChatMuteMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Checksum returns the value of the "checksum" field in the mutation.
-func (m *ConfigVersionMutation) Checksum() (r string, exists bool) {
-	v := m.checksum
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ChatMuteMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChecksum returns the old "checksum" field's value of the ConfigVersion entity.
-// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the ChatMute entity.
+// If the ChatMute object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigVersionMutation) OldChecksum(ctx context.Context) (v string, err error) {
+func (m *ChatMuteMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksum requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Checksum, nil
+	return oldValue.Reason, nil
 }
 
-// ResetChecksum resets all changes to the "checksum" field.
-func (m *ConfigVersionMutation) ResetChecksum() {
-	m.checksum = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *ChatMuteMutation) ResetReason() {
+	m.reason = nil
 }
 
 // SetOperator sets the "operator" field.
-func (m *ConfigVersionMutation) SetOperator(s string) {
+func (m *ChatMuteMutation) SetOperator(s string) {
 	m.operator = &s
 }
 
 // Operator returns the value of the "operator" field in the mutation.
-func (m *ConfigVersionMutation) Operator() (r string, exists bool) {
+func (m *ChatMuteMutation) Operator() (r string, exists bool) {
 	v := m.operator
 	if v == nil {
 		return
@@ -2298,10 +2218,10 @@ func (m *ConfigVersionMutation) Operator() (r string, exists bool) {
 	return *v, true
 }
 
-// OldOperator returns the old "operator" field's value of the ConfigVersion entity.
-// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldOperator returns the old "operator" field's value of the ChatMute entity.
+// If the ChatMute object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigVersionMutation) OldOperator(ctx context.Context) (v string, err error) {
+func (m *ChatMuteMutation) OldOperator(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOperator is only allowed on UpdateOne operations")
 	}
@@ -2316,17 +2236,17 @@ func (m *ConfigVersionMutation) OldOperator(ctx context.Context) (v string, err
 }
 
 // ResetOperator resets all changes to the "operator" field.
-func (m *ConfigVersionMutation) ResetOperator() {
+func (m *ChatMuteMutation) ResetOperator() {
 	m.operator = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ConfigVersionMutation) SetCreatedAt(t time.Time) {
+func (m *ChatMuteMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConfigVersionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ChatMuteMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2334,10 +2254,10 @@ func (m *ConfigVersionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ConfigVersion entity.
-// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ChatMute entity.
+// If the ChatMute object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatMuteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2352,58 +2272,55 @@ func (m *ConfigVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConfigVersionMutation) ResetCreatedAt() {
+func (m *ChatMuteMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetEntryID sets the "entry" edge to the ConfigEntry entity by id.
-func (m *ConfigVersionMutation) SetEntryID(id int) {
-	m.entry = &id
-}
-
-// ClearEntry clears the "entry" edge to the ConfigEntry entity.
-func (m *ConfigVersionMutation) ClearEntry() {
-	m.clearedentry = true
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChatMuteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// EntryCleared reports if the "entry" edge to the ConfigEntry entity was cleared.
-func (m *ConfigVersionMutation) EntryCleared() bool {
-	return m.clearedentry
-}
-
-// EntryID returns the "entry" edge ID in the mutation.
-func (m *ConfigVersionMutation) EntryID() (id int, exists bool) {
-	if m.entry != nil {
-		return *m.entry, true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChatMuteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// EntryIDs returns the "entry" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EntryID instead. It exists only for internal usage by the builders.
-func (m *ConfigVersionMutation) EntryIDs() (ids []int) {
-	if id := m.entry; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatMute entity.
+// If the ChatMute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMuteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetEntry resets all changes to the "entry" edge.
-func (m *ConfigVersionMutation) ResetEntry() {
-	m.entry = nil
-	m.clearedentry = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChatMuteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the ConfigVersionMutation builder.
-func (m *ConfigVersionMutation) Where(ps ...predicate.ConfigVersion) {
+// Where appends a list predicates to the ChatMuteMutation builder.
+func (m *ChatMuteMutation) Where(ps ...predicate.ChatMute) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConfigVersionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChatMuteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConfigVersionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ConfigVersion, len(ps))
+func (m *ChatMuteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatMute, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2411,42 +2328,42 @@ func (m *ConfigVersionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConfigVersionMutation) Op() Op {
+func (m *ChatMuteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConfigVersionMutation) SetOp(op Op) {
+func (m *ChatMuteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ConfigVersion).
-func (m *ConfigVersionMutation) Type() string {
+// Type returns the node type of this mutation (ChatMute).
+func (m *ChatMuteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConfigVersionMutation) Fields() []string {
+func (m *ChatMuteMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.key != nil {
-		fields = append(fields, configversion.FieldKey)
-	}
-	if m.version != nil {
-		fields = append(fields, configversion.FieldVersion)
+	if m.user_id != nil {
+		fields = append(fields, chatmute.FieldUserID)
 	}
-	if m.value != nil {
-		fields = append(fields, configversion.FieldValue)
+	if m.until != nil {
+		fields = append(fields, chatmute.FieldUntil)
 	}
-	if m.checksum != nil {
-		fields = append(fields, configversion.FieldChecksum)
+	if m.reason != nil {
+		fields = append(fields, chatmute.FieldReason)
 	}
 	if m.operator != nil {
-		fields = append(fields, configversion.FieldOperator)
+		fields = append(fields, chatmute.FieldOperator)
 	}
 	if m.created_at != nil {
-		fields = append(fields, configversion.FieldCreatedAt)
+		fields = append(fields, chatmute.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chatmute.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2454,20 +2371,20 @@ func (m *ConfigVersionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConfigVersionMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatMuteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case configversion.FieldKey:
-		return m.Key()
-	case configversion.FieldVersion:
-		return m.Version()
-	case configversion.FieldValue:
-		return m.Value()
-	case configversion.FieldChecksum:
-		return m.Checksum()
-	case configversion.FieldOperator:
+	case chatmute.FieldUserID:
+		return m.UserID()
+	case chatmute.FieldUntil:
+		return m.Until()
+	case chatmute.FieldReason:
+		return m.Reason()
+	case chatmute.FieldOperator:
 		return m.Operator()
-	case configversion.FieldCreatedAt:
+	case chatmute.FieldCreatedAt:
 		return m.CreatedAt()
+	case chatmute.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2475,81 +2392,81 @@ func (m *ConfigVersionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConfigVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatMuteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case configversion.FieldKey:
-		return m.OldKey(ctx)
-	case configversion.FieldVersion:
-		return m.OldVersion(ctx)
-	case configversion.FieldValue:
-		return m.OldValue(ctx)
-	case configversion.FieldChecksum:
-		return m.OldChecksum(ctx)
-	case configversion.FieldOperator:
+	case chatmute.FieldUserID:
+		return m.OldUserID(ctx)
+	case chatmute.FieldUntil:
+		return m.OldUntil(ctx)
+	case chatmute.FieldReason:
+		return m.OldReason(ctx)
+	case chatmute.FieldOperator:
 		return m.OldOperator(ctx)
-	case configversion.FieldCreatedAt:
+	case chatmute.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case chatmute.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ConfigVersion field %s", name)
+	return nil, fmt.Errorf("unknown ChatMute field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigVersionMutation) SetField(name string, value ent.Value) error {
+func (m *ChatMuteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case configversion.FieldKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKey(v)
-		return nil
-	case configversion.FieldVersion:
+	case chatmute.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetUserID(v)
 		return nil
-	case configversion.FieldValue:
-		v, ok := value.([]byte)
+	case chatmute.FieldUntil:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetUntil(v)
 		return nil
-	case configversion.FieldChecksum:
+	case chatmute.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksum(v)
+		m.SetReason(v)
 		return nil
-	case configversion.FieldOperator:
+	case chatmute.FieldOperator:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOperator(v)
 		return nil
-	case configversion.FieldCreatedAt:
+	case chatmute.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case chatmute.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ConfigVersion field %s", name)
+	return fmt.Errorf("unknown ChatMute field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConfigVersionMutation) AddedFields() []string {
+func (m *ChatMuteMutation) AddedFields() []string {
 	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, configversion.FieldVersion)
+	if m.adduser_id != nil {
+		fields = append(fields, chatmute.FieldUserID)
 	}
 	return fields
 }
@@ -2557,10 +2474,10 @@ func (m *ConfigVersionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConfigVersionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChatMuteMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case configversion.FieldVersion:
-		return m.AddedVersion()
+	case chatmute.FieldUserID:
+		return m.AddedUserID()
 	}
 	return nil, false
 }
@@ -2568,169 +2485,144 @@ func (m *ConfigVersionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigVersionMutation) AddField(name string, value ent.Value) error {
+func (m *ChatMuteMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case configversion.FieldVersion:
+	case chatmute.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVersion(v)
+		m.AddUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigVersion numeric field %s", name)
+	return fmt.Errorf("unknown ChatMute numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConfigVersionMutation) ClearedFields() []string {
+func (m *ChatMuteMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConfigVersionMutation) FieldCleared(name string) bool {
+func (m *ChatMuteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConfigVersionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ConfigVersion nullable field %s", name)
+func (m *ChatMuteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChatMute nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConfigVersionMutation) ResetField(name string) error {
+func (m *ChatMuteMutation) ResetField(name string) error {
 	switch name {
-	case configversion.FieldKey:
-		m.ResetKey()
-		return nil
-	case configversion.FieldVersion:
-		m.ResetVersion()
+	case chatmute.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case configversion.FieldValue:
-		m.ResetValue()
+	case chatmute.FieldUntil:
+		m.ResetUntil()
 		return nil
-	case configversion.FieldChecksum:
-		m.ResetChecksum()
+	case chatmute.FieldReason:
+		m.ResetReason()
 		return nil
-	case configversion.FieldOperator:
+	case chatmute.FieldOperator:
 		m.ResetOperator()
 		return nil
-	case configversion.FieldCreatedAt:
+	case chatmute.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case chatmute.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown ConfigVersion field %s", name)
+	return fmt.Errorf("unknown ChatMute field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConfigVersionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.entry != nil {
-		edges = append(edges, configversion.EdgeEntry)
-	}
+func (m *ChatMuteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConfigVersionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case configversion.EdgeEntry:
-		if id := m.entry; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ChatMuteMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConfigVersionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ChatMuteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConfigVersionMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChatMuteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConfigVersionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedentry {
-		edges = append(edges, configversion.EdgeEntry)
-	}
+func (m *ChatMuteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConfigVersionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case configversion.EdgeEntry:
-		return m.clearedentry
-	}
+func (m *ChatMuteMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConfigVersionMutation) ClearEdge(name string) error {
-	switch name {
-	case configversion.EdgeEntry:
-		m.ClearEntry()
-		return nil
-	}
-	return fmt.Errorf("unknown ConfigVersion unique edge %s", name)
+func (m *ChatMuteMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChatMute unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConfigVersionMutation) ResetEdge(name string) error {
-	switch name {
-	case configversion.EdgeEntry:
-		m.ResetEntry()
-		return nil
-	}
-	return fmt.Errorf("unknown ConfigVersion edge %s", name)
+func (m *ChatMuteMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChatMute edge %s", name)
 }
 
-// GMUserMutation represents an operation that mutates the GMUser nodes in the graph.
-type GMUserMutation struct {
+// ConfigEntryMutation represents an operation that mutates the ConfigEntry nodes in the graph.
+type ConfigEntryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	username      *string
-	password_hash *string
-	status        *string
-	last_login_at *time.Time
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	roles         map[int]struct{}
-	removedroles  map[int]struct{}
-	clearedroles  bool
-	done          bool
-	oldValue      func(context.Context) (*GMUser, error)
-	predicates    []predicate.GMUser
+	op              Op
+	typ             string
+	id              *int
+	key             *string
+	value           *[]byte
+	version         *int64
+	addversion      *int64
+	checksum        *string
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	versions        map[int]struct{}
+	removedversions map[int]struct{}
+	clearedversions bool
+	done            bool
+	oldValue        func(context.Context) (*ConfigEntry, error)
+	predicates      []predicate.ConfigEntry
 }
 
-var _ ent.Mutation = (*GMUserMutation)(nil)
+var _ ent.Mutation = (*ConfigEntryMutation)(nil)
 
-// gmuserOption allows management of the mutation configuration using functional options.
-type gmuserOption func(*GMUserMutation)
+// configentryOption allows management of the mutation configuration using functional options.
+type configentryOption func(*ConfigEntryMutation)
 
-// newGMUserMutation creates new mutation for the GMUser entity.
-func newGMUserMutation(c config, op Op, opts ...gmuserOption) *GMUserMutation {
-	m := &GMUserMutation{
+// newConfigEntryMutation creates new mutation for the ConfigEntry entity.
+func newConfigEntryMutation(c config, op Op, opts ...configentryOption) *ConfigEntryMutation {
+	m := &ConfigEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGMUser,
+		typ:           TypeConfigEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2739,20 +2631,20 @@ func newGMUserMutation(c config, op Op, opts ...gmuserOption) *GMUserMutation {
 	return m
 }
 
-// withGMUserID sets the ID field of the mutation.
-func withGMUserID(id int) gmuserOption {
-	return func(m *GMUserMutation) {
+// withConfigEntryID sets the ID field of the mutation.
+func withConfigEntryID(id int) configentryOption {
+	return func(m *ConfigEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GMUser
+			value *ConfigEntry
 		)
-		m.oldValue = func(ctx context.Context) (*GMUser, error) {
+		m.oldValue = func(ctx context.Context) (*ConfigEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GMUser.Get(ctx, id)
+					value, err = m.Client().ConfigEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2761,10 +2653,10 @@ func withGMUserID(id int) gmuserOption {
 	}
 }
 
-// withGMUser sets the old GMUser of the mutation.
-func withGMUser(node *GMUser) gmuserOption {
-	return func(m *GMUserMutation) {
-		m.oldValue = func(context.Context) (*GMUser, error) {
+// withConfigEntry sets the old ConfigEntry of the mutation.
+func withConfigEntry(node *ConfigEntry) configentryOption {
+	return func(m *ConfigEntryMutation) {
+		m.oldValue = func(context.Context) (*ConfigEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2773,7 +2665,7 @@ func withGMUser(node *GMUser) gmuserOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GMUserMutation) Client() *Client {
+func (m ConfigEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2781,7 +2673,7 @@ func (m GMUserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GMUserMutation) Tx() (*Tx, error) {
+func (m ConfigEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2792,7 +2684,7 @@ func (m GMUserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GMUserMutation) ID() (id int, exists bool) {
+func (m *ConfigEntryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2803,7 +2695,7 @@ func (m *GMUserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GMUserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConfigEntryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2812,268 +2704,275 @@ func (m *GMUserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GMUser.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConfigEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUsername sets the "username" field.
-func (m *GMUserMutation) SetUsername(s string) {
-	m.username = &s
+// SetKey sets the "key" field.
+func (m *ConfigEntryMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *GMUserMutation) Username() (r string, exists bool) {
-	v := m.username
+// Key returns the value of the "key" field in the mutation.
+func (m *ConfigEntryMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the GMUser entity.
-// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the ConfigEntry entity.
+// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GMUserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *ConfigEntryMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.Key, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *GMUserMutation) ResetUsername() {
-	m.username = nil
+// ResetKey resets all changes to the "key" field.
+func (m *ConfigEntryMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *GMUserMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// SetValue sets the "value" field.
+func (m *ConfigEntryMutation) SetValue(b []byte) {
+	m.value = &b
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *GMUserMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
+// Value returns the value of the "value" field in the mutation.
+func (m *ConfigEntryMutation) Value() (r []byte, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the GMUser entity.
-// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the ConfigEntry entity.
+// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GMUserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+func (m *ConfigEntryMutation) OldValue(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.PasswordHash, nil
+	return oldValue.Value, nil
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *GMUserMutation) ResetPasswordHash() {
-	m.password_hash = nil
+// ResetValue resets all changes to the "value" field.
+func (m *ConfigEntryMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *GMUserMutation) SetStatus(s string) {
-	m.status = &s
+// SetVersion sets the "version" field.
+func (m *ConfigEntryMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *GMUserMutation) Status() (r string, exists bool) {
-	v := m.status
+// Version returns the value of the "version" field in the mutation.
+func (m *ConfigEntryMutation) Version() (r int64, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the GMUser entity.
-// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the ConfigEntry entity.
+// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GMUserMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *ConfigEntryMutation) OldVersion(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Version, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *GMUserMutation) ResetStatus() {
-	m.status = nil
+// AddVersion adds i to the "version" field.
+func (m *ConfigEntryMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SetLastLoginAt sets the "last_login_at" field.
-func (m *GMUserMutation) SetLastLoginAt(t time.Time) {
-	m.last_login_at = &t
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ConfigEntryMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// LastLoginAt returns the value of the "last_login_at" field in the mutation.
-func (m *GMUserMutation) LastLoginAt() (r time.Time, exists bool) {
-	v := m.last_login_at
+// ResetVersion resets all changes to the "version" field.
+func (m *ConfigEntryMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *ConfigEntryMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *ConfigEntryMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastLoginAt returns the old "last_login_at" field's value of the GMUser entity.
-// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
+// OldChecksum returns the old "checksum" field's value of the ConfigEntry entity.
+// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GMUserMutation) OldLastLoginAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigEntryMutation) OldChecksum(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
 	}
-	return oldValue.LastLoginAt, nil
-}
-
-// ClearLastLoginAt clears the value of the "last_login_at" field.
-func (m *GMUserMutation) ClearLastLoginAt() {
-	m.last_login_at = nil
-	m.clearedFields[gmuser.FieldLastLoginAt] = struct{}{}
-}
-
-// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
-func (m *GMUserMutation) LastLoginAtCleared() bool {
-	_, ok := m.clearedFields[gmuser.FieldLastLoginAt]
-	return ok
+	return oldValue.Checksum, nil
 }
 
-// ResetLastLoginAt resets all changes to the "last_login_at" field.
-func (m *GMUserMutation) ResetLastLoginAt() {
-	m.last_login_at = nil
-	delete(m.clearedFields, gmuser.FieldLastLoginAt)
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *ConfigEntryMutation) ResetChecksum() {
+	m.checksum = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GMUserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GMUserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GMUser entity.
-// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigEntry entity.
+// If the ConfigEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GMUserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GMUserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigEntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddRoleIDs adds the "roles" edge to the Role entity by ids.
-func (m *GMUserMutation) AddRoleIDs(ids ...int) {
-	if m.roles == nil {
-		m.roles = make(map[int]struct{})
+// AddVersionIDs adds the "versions" edge to the ConfigVersion entity by ids.
+func (m *ConfigEntryMutation) AddVersionIDs(ids ...int) {
+	if m.versions == nil {
+		m.versions = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
+		m.versions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRoles clears the "roles" edge to the Role entity.
-func (m *GMUserMutation) ClearRoles() {
-	m.clearedroles = true
+// ClearVersions clears the "versions" edge to the ConfigVersion entity.
+func (m *ConfigEntryMutation) ClearVersions() {
+	m.clearedversions = true
 }
 
-// RolesCleared reports if the "roles" edge to the Role entity was cleared.
-func (m *GMUserMutation) RolesCleared() bool {
-	return m.clearedroles
+// VersionsCleared reports if the "versions" edge to the ConfigVersion entity was cleared.
+func (m *ConfigEntryMutation) VersionsCleared() bool {
+	return m.clearedversions
 }
 
-// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
-func (m *GMUserMutation) RemoveRoleIDs(ids ...int) {
-	if m.removedroles == nil {
-		m.removedroles = make(map[int]struct{})
+// RemoveVersionIDs removes the "versions" edge to the ConfigVersion entity by IDs.
+func (m *ConfigEntryMutation) RemoveVersionIDs(ids ...int) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.roles, ids[i])
-		m.removedroles[ids[i]] = struct{}{}
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
-func (m *GMUserMutation) RemovedRolesIDs() (ids []int) {
-	for id := range m.removedroles {
+// RemovedVersions returns the removed IDs of the "versions" edge to the ConfigVersion entity.
+func (m *ConfigEntryMutation) RemovedVersionsIDs() (ids []int) {
+	for id := range m.removedversions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *GMUserMutation) RolesIDs() (ids []int) {
-	for id := range m.roles {
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *ConfigEntryMutation) VersionsIDs() (ids []int) {
+	for id := range m.versions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRoles resets all changes to the "roles" edge.
-func (m *GMUserMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-	m.removedroles = nil
+// ResetVersions resets all changes to the "versions" edge.
+func (m *ConfigEntryMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
 }
 
-// Where appends a list predicates to the GMUserMutation builder.
-func (m *GMUserMutation) Where(ps ...predicate.GMUser) {
+// Where appends a list predicates to the ConfigEntryMutation builder.
+func (m *ConfigEntryMutation) Where(ps ...predicate.ConfigEntry) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GMUserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConfigEntryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GMUserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GMUser, len(ps))
+func (m *ConfigEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigEntry, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3081,39 +2980,39 @@ func (m *GMUserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GMUserMutation) Op() Op {
+func (m *ConfigEntryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GMUserMutation) SetOp(op Op) {
+func (m *ConfigEntryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GMUser).
-func (m *GMUserMutation) Type() string {
+// Type returns the node type of this mutation (ConfigEntry).
+func (m *ConfigEntryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GMUserMutation) Fields() []string {
+func (m *ConfigEntryMutation) Fields() []string {
 	fields := make([]string, 0, 5)
-	if m.username != nil {
-		fields = append(fields, gmuser.FieldUsername)
+	if m.key != nil {
+		fields = append(fields, configentry.FieldKey)
 	}
-	if m.password_hash != nil {
-		fields = append(fields, gmuser.FieldPasswordHash)
+	if m.value != nil {
+		fields = append(fields, configentry.FieldValue)
 	}
-	if m.status != nil {
-		fields = append(fields, gmuser.FieldStatus)
+	if m.version != nil {
+		fields = append(fields, configentry.FieldVersion)
 	}
-	if m.last_login_at != nil {
-		fields = append(fields, gmuser.FieldLastLoginAt)
+	if m.checksum != nil {
+		fields = append(fields, configentry.FieldChecksum)
 	}
-	if m.created_at != nil {
-		fields = append(fields, gmuser.FieldCreatedAt)
+	if m.updated_at != nil {
+		fields = append(fields, configentry.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3121,18 +3020,18 @@ func (m *GMUserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GMUserMutation) Field(name string) (ent.Value, bool) {
+func (m *ConfigEntryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case gmuser.FieldUsername:
-		return m.Username()
-	case gmuser.FieldPasswordHash:
-		return m.PasswordHash()
-	case gmuser.FieldStatus:
-		return m.Status()
-	case gmuser.FieldLastLoginAt:
-		return m.LastLoginAt()
-	case gmuser.FieldCreatedAt:
-		return m.CreatedAt()
+	case configentry.FieldKey:
+		return m.Key()
+	case configentry.FieldValue:
+		return m.Value()
+	case configentry.FieldVersion:
+		return m.Version()
+	case configentry.FieldChecksum:
+		return m.Checksum()
+	case configentry.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3140,155 +3039,161 @@ func (m *GMUserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GMUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConfigEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case gmuser.FieldUsername:
-		return m.OldUsername(ctx)
-	case gmuser.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case gmuser.FieldStatus:
-		return m.OldStatus(ctx)
-	case gmuser.FieldLastLoginAt:
-		return m.OldLastLoginAt(ctx)
-	case gmuser.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case configentry.FieldKey:
+		return m.OldKey(ctx)
+	case configentry.FieldValue:
+		return m.OldValue(ctx)
+	case configentry.FieldVersion:
+		return m.OldVersion(ctx)
+	case configentry.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case configentry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown GMUser field %s", name)
+	return nil, fmt.Errorf("unknown ConfigEntry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GMUserMutation) SetField(name string, value ent.Value) error {
+func (m *ConfigEntryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case gmuser.FieldUsername:
+	case configentry.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetKey(v)
 		return nil
-	case gmuser.FieldPasswordHash:
-		v, ok := value.(string)
+	case configentry.FieldValue:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPasswordHash(v)
+		m.SetValue(v)
 		return nil
-	case gmuser.FieldStatus:
-		v, ok := value.(string)
+	case configentry.FieldVersion:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetVersion(v)
 		return nil
-	case gmuser.FieldLastLoginAt:
-		v, ok := value.(time.Time)
+	case configentry.FieldChecksum:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastLoginAt(v)
+		m.SetChecksum(v)
 		return nil
-	case gmuser.FieldCreatedAt:
+	case configentry.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GMUser field %s", name)
+	return fmt.Errorf("unknown ConfigEntry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GMUserMutation) AddedFields() []string {
-	return nil
+func (m *ConfigEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, configentry.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GMUserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConfigEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case configentry.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GMUserMutation) AddField(name string, value ent.Value) error {
+func (m *ConfigEntryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case configentry.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown GMUser numeric field %s", name)
+	return fmt.Errorf("unknown ConfigEntry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GMUserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(gmuser.FieldLastLoginAt) {
-		fields = append(fields, gmuser.FieldLastLoginAt)
-	}
-	return fields
+func (m *ConfigEntryMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GMUserMutation) FieldCleared(name string) bool {
+func (m *ConfigEntryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GMUserMutation) ClearField(name string) error {
-	switch name {
-	case gmuser.FieldLastLoginAt:
-		m.ClearLastLoginAt()
-		return nil
-	}
-	return fmt.Errorf("unknown GMUser nullable field %s", name)
+func (m *ConfigEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConfigEntry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GMUserMutation) ResetField(name string) error {
+func (m *ConfigEntryMutation) ResetField(name string) error {
 	switch name {
-	case gmuser.FieldUsername:
-		m.ResetUsername()
+	case configentry.FieldKey:
+		m.ResetKey()
 		return nil
-	case gmuser.FieldPasswordHash:
-		m.ResetPasswordHash()
+	case configentry.FieldValue:
+		m.ResetValue()
 		return nil
-	case gmuser.FieldStatus:
-		m.ResetStatus()
+	case configentry.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case gmuser.FieldLastLoginAt:
-		m.ResetLastLoginAt()
+	case configentry.FieldChecksum:
+		m.ResetChecksum()
 		return nil
-	case gmuser.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case configentry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown GMUser field %s", name)
+	return fmt.Errorf("unknown ConfigEntry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GMUserMutation) AddedEdges() []string {
+func (m *ConfigEntryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.roles != nil {
-		edges = append(edges, gmuser.EdgeRoles)
+	if m.versions != nil {
+		edges = append(edges, configentry.EdgeVersions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GMUserMutation) AddedIDs(name string) []ent.Value {
+func (m *ConfigEntryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case gmuser.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.roles))
-		for id := range m.roles {
+	case configentry.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3297,21 +3202,21 @@ func (m *GMUserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GMUserMutation) RemovedEdges() []string {
+func (m *ConfigEntryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedroles != nil {
-		edges = append(edges, gmuser.EdgeRoles)
+	if m.removedversions != nil {
+		edges = append(edges, configentry.EdgeVersions)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GMUserMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConfigEntryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case gmuser.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.removedroles))
-		for id := range m.removedroles {
+	case configentry.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3320,75 +3225,75 @@ func (m *GMUserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GMUserMutation) ClearedEdges() []string {
+func (m *ConfigEntryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedroles {
-		edges = append(edges, gmuser.EdgeRoles)
+	if m.clearedversions {
+		edges = append(edges, configentry.EdgeVersions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GMUserMutation) EdgeCleared(name string) bool {
+func (m *ConfigEntryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case gmuser.EdgeRoles:
-		return m.clearedroles
+	case configentry.EdgeVersions:
+		return m.clearedversions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GMUserMutation) ClearEdge(name string) error {
+func (m *ConfigEntryMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown GMUser unique edge %s", name)
+	return fmt.Errorf("unknown ConfigEntry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GMUserMutation) ResetEdge(name string) error {
+func (m *ConfigEntryMutation) ResetEdge(name string) error {
 	switch name {
-	case gmuser.EdgeRoles:
-		m.ResetRoles()
+	case configentry.EdgeVersions:
+		m.ResetVersions()
 		return nil
 	}
-	return fmt.Errorf("unknown GMUser edge %s", name)
+	return fmt.Errorf("unknown ConfigEntry edge %s", name)
 }
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// ConfigVersionMutation represents an operation that mutates the ConfigVersion nodes in the graph.
+type ConfigVersionMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	item_id       *int
-	additem_id    *int
-	count         *int
-	addcount      *int
-	meta          *string
+	key           *string
+	version       *int64
+	addversion    *int64
+	value         *[]byte
+	checksum      *string
+	operator      *string
 	created_at    *time.Time
-	updated_at    *time.Time
 	clearedFields map[string]struct{}
-	player        *int
-	clearedplayer bool
+	entry         *int
+	clearedentry  bool
 	done          bool
-	oldValue      func(context.Context) (*Item, error)
-	predicates    []predicate.Item
+	oldValue      func(context.Context) (*ConfigVersion, error)
+	predicates    []predicate.ConfigVersion
 }
 
-var _ ent.Mutation = (*ItemMutation)(nil)
+var _ ent.Mutation = (*ConfigVersionMutation)(nil)
 
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
+// configversionOption allows management of the mutation configuration using functional options.
+type configversionOption func(*ConfigVersionMutation)
 
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+// newConfigVersionMutation creates new mutation for the ConfigVersion entity.
+func newConfigVersionMutation(c config, op Op, opts ...configversionOption) *ConfigVersionMutation {
+	m := &ConfigVersionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypeConfigVersion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3397,20 +3302,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id int) itemOption {
-	return func(m *ItemMutation) {
+// withConfigVersionID sets the ID field of the mutation.
+func withConfigVersionID(id int) configversionOption {
+	return func(m *ConfigVersionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *ConfigVersion
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*ConfigVersion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().ConfigVersion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3419,10 +3324,10 @@ func withItemID(id int) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withConfigVersion sets the old ConfigVersion of the mutation.
+func withConfigVersion(node *ConfigVersion) configversionOption {
+	return func(m *ConfigVersionMutation) {
+		m.oldValue = func(context.Context) (*ConfigVersion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3431,7 +3336,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m ConfigVersionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3439,7 +3344,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m ConfigVersionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3450,7 +3355,7 @@ func (m ItemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id int, exists bool) {
+func (m *ConfigVersionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3461,7 +3366,7 @@ func (m *ItemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConfigVersionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3470,203 +3375,219 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConfigVersion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPlayerID sets the "player_id" field.
-func (m *ItemMutation) SetPlayerID(i int) {
-	m.player = &i
+// SetKey sets the "key" field.
+func (m *ConfigVersionMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// PlayerID returns the value of the "player_id" field in the mutation.
-func (m *ItemMutation) PlayerID() (r int, exists bool) {
-	v := m.player
+// Key returns the value of the "key" field in the mutation.
+func (m *ConfigVersionMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlayerID returns the old "player_id" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the ConfigVersion entity.
+// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPlayerID(ctx context.Context) (v int, err error) {
+func (m *ConfigVersionMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlayerID requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.PlayerID, nil
+	return oldValue.Key, nil
 }
 
-// ResetPlayerID resets all changes to the "player_id" field.
-func (m *ItemMutation) ResetPlayerID() {
-	m.player = nil
+// ResetKey resets all changes to the "key" field.
+func (m *ConfigVersionMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetItemID sets the "item_id" field.
-func (m *ItemMutation) SetItemID(i int) {
-	m.item_id = &i
-	m.additem_id = nil
+// SetVersion sets the "version" field.
+func (m *ConfigVersionMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// ItemID returns the value of the "item_id" field in the mutation.
-func (m *ItemMutation) ItemID() (r int, exists bool) {
-	v := m.item_id
+// Version returns the value of the "version" field in the mutation.
+func (m *ConfigVersionMutation) Version() (r int64, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldItemID returns the old "item_id" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the ConfigVersion entity.
+// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldItemID(ctx context.Context) (v int, err error) {
+func (m *ConfigVersionMutation) OldVersion(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldItemID requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.ItemID, nil
+	return oldValue.Version, nil
 }
 
-// AddItemID adds i to the "item_id" field.
-func (m *ItemMutation) AddItemID(i int) {
-	if m.additem_id != nil {
-		*m.additem_id += i
+// AddVersion adds i to the "version" field.
+func (m *ConfigVersionMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
 	} else {
-		m.additem_id = &i
+		m.addversion = &i
 	}
 }
 
-// AddedItemID returns the value that was added to the "item_id" field in this mutation.
-func (m *ItemMutation) AddedItemID() (r int, exists bool) {
-	v := m.additem_id
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ConfigVersionMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetItemID resets all changes to the "item_id" field.
-func (m *ItemMutation) ResetItemID() {
-	m.item_id = nil
-	m.additem_id = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *ConfigVersionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetCount sets the "count" field.
-func (m *ItemMutation) SetCount(i int) {
-	m.count = &i
-	m.addcount = nil
+// SetValue sets the "value" field.
+func (m *ConfigVersionMutation) SetValue(b []byte) {
+	m.value = &b
 }
 
-// Count returns the value of the "count" field in the mutation.
-func (m *ItemMutation) Count() (r int, exists bool) {
-	v := m.count
+// Value returns the value of the "value" field in the mutation.
+func (m *ConfigVersionMutation) Value() (r []byte, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old "count" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the ConfigVersion entity.
+// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldCount(ctx context.Context) (v int, err error) {
+func (m *ConfigVersionMutation) OldValue(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCount requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.Value, nil
 }
 
-// AddCount adds i to the "count" field.
-func (m *ItemMutation) AddCount(i int) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
+// ResetValue resets all changes to the "value" field.
+func (m *ConfigVersionMutation) ResetValue() {
+	m.value = nil
 }
 
-// AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *ItemMutation) AddedCount() (r int, exists bool) {
-	v := m.addcount
+// SetChecksum sets the "checksum" field.
+func (m *ConfigVersionMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *ConfigVersionMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCount resets all changes to the "count" field.
-func (m *ItemMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
+// OldChecksum returns the old "checksum" field's value of the ConfigVersion entity.
+// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigVersionMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
 }
 
-// SetMeta sets the "meta" field.
-func (m *ItemMutation) SetMeta(s string) {
-	m.meta = &s
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *ConfigVersionMutation) ResetChecksum() {
+	m.checksum = nil
 }
 
-// Meta returns the value of the "meta" field in the mutation.
-func (m *ItemMutation) Meta() (r string, exists bool) {
-	v := m.meta
+// SetOperator sets the "operator" field.
+func (m *ConfigVersionMutation) SetOperator(s string) {
+	m.operator = &s
+}
+
+// Operator returns the value of the "operator" field in the mutation.
+func (m *ConfigVersionMutation) Operator() (r string, exists bool) {
+	v := m.operator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMeta returns the old "meta" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldOperator returns the old "operator" field's value of the ConfigVersion entity.
+// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldMeta(ctx context.Context) (v string, err error) {
+func (m *ConfigVersionMutation) OldOperator(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMeta is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperator is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMeta requires an ID field in the mutation")
+		return v, errors.New("OldOperator requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMeta: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperator: %w", err)
 	}
-	return oldValue.Meta, nil
+	return oldValue.Operator, nil
 }
 
-// ResetMeta resets all changes to the "meta" field.
-func (m *ItemMutation) ResetMeta() {
-	m.meta = nil
+// ResetOperator resets all changes to the "operator" field.
+func (m *ConfigVersionMutation) ResetOperator() {
+	m.operator = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ItemMutation) SetCreatedAt(t time.Time) {
+func (m *ConfigVersionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ConfigVersionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3674,10 +3595,10 @@ func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ConfigVersion entity.
+// If the ConfigVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3692,82 +3613,58 @@ func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ItemMutation) ResetCreatedAt() {
+func (m *ConfigVersionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ItemMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetEntryID sets the "entry" edge to the ConfigEntry entity by id.
+func (m *ConfigVersionMutation) SetEntryID(id int) {
+	m.entry = &id
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ItemMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearEntry clears the "entry" edge to the ConfigEntry entity.
+func (m *ConfigVersionMutation) ClearEntry() {
+	m.clearedentry = true
 }
 
-// ClearPlayer clears the "player" edge to the Player entity.
-func (m *ItemMutation) ClearPlayer() {
-	m.clearedplayer = true
-	m.clearedFields[item.FieldPlayerID] = struct{}{}
+// EntryCleared reports if the "entry" edge to the ConfigEntry entity was cleared.
+func (m *ConfigVersionMutation) EntryCleared() bool {
+	return m.clearedentry
 }
 
-// PlayerCleared reports if the "player" edge to the Player entity was cleared.
-func (m *ItemMutation) PlayerCleared() bool {
-	return m.clearedplayer
+// EntryID returns the "entry" edge ID in the mutation.
+func (m *ConfigVersionMutation) EntryID() (id int, exists bool) {
+	if m.entry != nil {
+		return *m.entry, true
+	}
+	return
 }
 
-// PlayerIDs returns the "player" edge IDs in the mutation.
+// EntryIDs returns the "entry" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PlayerID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) PlayerIDs() (ids []int) {
-	if id := m.player; id != nil {
+// EntryID instead. It exists only for internal usage by the builders.
+func (m *ConfigVersionMutation) EntryIDs() (ids []int) {
+	if id := m.entry; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPlayer resets all changes to the "player" edge.
-func (m *ItemMutation) ResetPlayer() {
-	m.player = nil
-	m.clearedplayer = false
+// ResetEntry resets all changes to the "entry" edge.
+func (m *ConfigVersionMutation) ResetEntry() {
+	m.entry = nil
+	m.clearedentry = false
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
+// Where appends a list predicates to the ConfigVersionMutation builder.
+func (m *ConfigVersionMutation) Where(ps ...predicate.ConfigVersion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConfigVersionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
+func (m *ConfigVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigVersion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3775,42 +3672,42 @@ func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemMutation) Op() Op {
+func (m *ConfigVersionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
+func (m *ConfigVersionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
+// Type returns the node type of this mutation (ConfigVersion).
+func (m *ConfigVersionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemMutation) Fields() []string {
+func (m *ConfigVersionMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.player != nil {
-		fields = append(fields, item.FieldPlayerID)
+	if m.key != nil {
+		fields = append(fields, configversion.FieldKey)
 	}
-	if m.item_id != nil {
-		fields = append(fields, item.FieldItemID)
+	if m.version != nil {
+		fields = append(fields, configversion.FieldVersion)
 	}
-	if m.count != nil {
-		fields = append(fields, item.FieldCount)
+	if m.value != nil {
+		fields = append(fields, configversion.FieldValue)
 	}
-	if m.meta != nil {
-		fields = append(fields, item.FieldMeta)
+	if m.checksum != nil {
+		fields = append(fields, configversion.FieldChecksum)
 	}
-	if m.created_at != nil {
-		fields = append(fields, item.FieldCreatedAt)
+	if m.operator != nil {
+		fields = append(fields, configversion.FieldOperator)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, item.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, configversion.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3818,20 +3715,20 @@ func (m *ItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+func (m *ConfigVersionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldPlayerID:
-		return m.PlayerID()
-	case item.FieldItemID:
-		return m.ItemID()
-	case item.FieldCount:
-		return m.Count()
-	case item.FieldMeta:
-		return m.Meta()
-	case item.FieldCreatedAt:
+	case configversion.FieldKey:
+		return m.Key()
+	case configversion.FieldVersion:
+		return m.Version()
+	case configversion.FieldValue:
+		return m.Value()
+	case configversion.FieldChecksum:
+		return m.Checksum()
+	case configversion.FieldOperator:
+		return m.Operator()
+	case configversion.FieldCreatedAt:
 		return m.CreatedAt()
-	case item.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3839,84 +3736,81 @@ func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConfigVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case item.FieldPlayerID:
-		return m.OldPlayerID(ctx)
-	case item.FieldItemID:
-		return m.OldItemID(ctx)
-	case item.FieldCount:
-		return m.OldCount(ctx)
-	case item.FieldMeta:
-		return m.OldMeta(ctx)
-	case item.FieldCreatedAt:
+	case configversion.FieldKey:
+		return m.OldKey(ctx)
+	case configversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case configversion.FieldValue:
+		return m.OldValue(ctx)
+	case configversion.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case configversion.FieldOperator:
+		return m.OldOperator(ctx)
+	case configversion.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case item.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
+	return nil, fmt.Errorf("unknown ConfigVersion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
+func (m *ConfigVersionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldPlayerID:
-		v, ok := value.(int)
+	case configversion.FieldKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlayerID(v)
+		m.SetKey(v)
 		return nil
-	case item.FieldItemID:
-		v, ok := value.(int)
+	case configversion.FieldVersion:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetItemID(v)
+		m.SetVersion(v)
 		return nil
-	case item.FieldCount:
-		v, ok := value.(int)
+	case configversion.FieldValue:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCount(v)
+		m.SetValue(v)
 		return nil
-	case item.FieldMeta:
+	case configversion.FieldChecksum:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMeta(v)
+		m.SetChecksum(v)
 		return nil
-	case item.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case configversion.FieldOperator:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetOperator(v)
 		return nil
-	case item.FieldUpdatedAt:
+	case configversion.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown ConfigVersion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemMutation) AddedFields() []string {
+func (m *ConfigVersionMutation) AddedFields() []string {
 	var fields []string
-	if m.additem_id != nil {
-		fields = append(fields, item.FieldItemID)
-	}
-	if m.addcount != nil {
-		fields = append(fields, item.FieldCount)
+	if m.addversion != nil {
+		fields = append(fields, configversion.FieldVersion)
 	}
 	return fields
 }
@@ -3924,12 +3818,10 @@ func (m *ItemMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConfigVersionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldItemID:
-		return m.AddedItemID()
-	case item.FieldCount:
-		return m.AddedCount()
+	case configversion.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -3937,86 +3829,79 @@ func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
+func (m *ConfigVersionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldItemID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddItemID(v)
-		return nil
-	case item.FieldCount:
-		v, ok := value.(int)
+	case configversion.FieldVersion:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCount(v)
+		m.AddVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
+	return fmt.Errorf("unknown ConfigVersion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ItemMutation) ClearedFields() []string {
+func (m *ConfigVersionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
+func (m *ConfigVersionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Item nullable field %s", name)
+func (m *ConfigVersionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConfigVersion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
+func (m *ConfigVersionMutation) ResetField(name string) error {
 	switch name {
-	case item.FieldPlayerID:
-		m.ResetPlayerID()
+	case configversion.FieldKey:
+		m.ResetKey()
 		return nil
-	case item.FieldItemID:
-		m.ResetItemID()
+	case configversion.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case item.FieldCount:
-		m.ResetCount()
+	case configversion.FieldValue:
+		m.ResetValue()
 		return nil
-	case item.FieldMeta:
-		m.ResetMeta()
+	case configversion.FieldChecksum:
+		m.ResetChecksum()
 		return nil
-	case item.FieldCreatedAt:
+	case configversion.FieldOperator:
+		m.ResetOperator()
+		return nil
+	case configversion.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case item.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown ConfigVersion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
+func (m *ConfigVersionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.player != nil {
-		edges = append(edges, item.EdgePlayer)
+	if m.entry != nil {
+		edges = append(edges, configversion.EdgeEntry)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+func (m *ConfigVersionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case item.EdgePlayer:
-		if id := m.player; id != nil {
+	case configversion.EdgeEntry:
+		if id := m.entry; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4024,88 +3909,89 @@ func (m *ItemMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
+func (m *ConfigVersionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConfigVersionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
+func (m *ConfigVersionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedplayer {
-		edges = append(edges, item.EdgePlayer)
+	if m.clearedentry {
+		edges = append(edges, configversion.EdgeEntry)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
+func (m *ConfigVersionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case item.EdgePlayer:
-		return m.clearedplayer
+	case configversion.EdgeEntry:
+		return m.clearedentry
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
+func (m *ConfigVersionMutation) ClearEdge(name string) error {
 	switch name {
-	case item.EdgePlayer:
-		m.ClearPlayer()
+	case configversion.EdgeEntry:
+		m.ClearEntry()
 		return nil
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return fmt.Errorf("unknown ConfigVersion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
+func (m *ConfigVersionMutation) ResetEdge(name string) error {
 	switch name {
-	case item.EdgePlayer:
-		m.ResetPlayer()
+	case configversion.EdgeEntry:
+		m.ResetEntry()
 		return nil
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return fmt.Errorf("unknown ConfigVersion edge %s", name)
 }
 
-// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
-type PermissionMutation struct {
+// GMUserMutation represents an operation that mutates the GMUser nodes in the graph.
+type GMUserMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	code          *string
-	name          *string
-	description   *string
+	username      *string
+	password_hash *string
+	status        *string
+	last_login_at *time.Time
 	created_at    *time.Time
 	clearedFields map[string]struct{}
 	roles         map[int]struct{}
 	removedroles  map[int]struct{}
 	clearedroles  bool
 	done          bool
-	oldValue      func(context.Context) (*Permission, error)
-	predicates    []predicate.Permission
+	oldValue      func(context.Context) (*GMUser, error)
+	predicates    []predicate.GMUser
 }
 
-var _ ent.Mutation = (*PermissionMutation)(nil)
+var _ ent.Mutation = (*GMUserMutation)(nil)
 
-// permissionOption allows management of the mutation configuration using functional options.
-type permissionOption func(*PermissionMutation)
+// gmuserOption allows management of the mutation configuration using functional options.
+type gmuserOption func(*GMUserMutation)
 
-// newPermissionMutation creates new mutation for the Permission entity.
-func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
-	m := &PermissionMutation{
+// newGMUserMutation creates new mutation for the GMUser entity.
+func newGMUserMutation(c config, op Op, opts ...gmuserOption) *GMUserMutation {
+	m := &GMUserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePermission,
+		typ:           TypeGMUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4114,20 +4000,20 @@ func newPermissionMutation(c config, op Op, opts ...permissionOption) *Permissio
 	return m
 }
 
-// withPermissionID sets the ID field of the mutation.
-func withPermissionID(id int) permissionOption {
-	return func(m *PermissionMutation) {
+// withGMUserID sets the ID field of the mutation.
+func withGMUserID(id int) gmuserOption {
+	return func(m *GMUserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Permission
+			value *GMUser
 		)
-		m.oldValue = func(ctx context.Context) (*Permission, error) {
+		m.oldValue = func(ctx context.Context) (*GMUser, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Permission.Get(ctx, id)
+					value, err = m.Client().GMUser.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4136,10 +4022,10 @@ func withPermissionID(id int) permissionOption {
 	}
 }
 
-// withPermission sets the old Permission of the mutation.
-func withPermission(node *Permission) permissionOption {
-	return func(m *PermissionMutation) {
-		m.oldValue = func(context.Context) (*Permission, error) {
+// withGMUser sets the old GMUser of the mutation.
+func withGMUser(node *GMUser) gmuserOption {
+	return func(m *GMUserMutation) {
+		m.oldValue = func(context.Context) (*GMUser, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4148,7 +4034,7 @@ func withPermission(node *Permission) permissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PermissionMutation) Client() *Client {
+func (m GMUserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4156,7 +4042,7 @@ func (m PermissionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PermissionMutation) Tx() (*Tx, error) {
+func (m GMUserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4167,7 +4053,7 @@ func (m PermissionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PermissionMutation) ID() (id int, exists bool) {
+func (m *GMUserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4178,7 +4064,7 @@ func (m *PermissionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PermissionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GMUserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4187,178 +4073,227 @@ func (m *PermissionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GMUser.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *PermissionMutation) SetCode(s string) {
-	m.code = &s
+// SetUsername sets the "username" field.
+func (m *GMUserMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *PermissionMutation) Code() (r string, exists bool) {
-	v := m.code
+// Username returns the value of the "username" field in the mutation.
+func (m *GMUserMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the GMUser entity.
+// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *GMUserMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.Username, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *PermissionMutation) ResetCode() {
-	m.code = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *GMUserMutation) ResetUsername() {
+	m.username = nil
 }
 
-// SetName sets the "name" field.
-func (m *PermissionMutation) SetName(s string) {
-	m.name = &s
+// SetPasswordHash sets the "password_hash" field.
+func (m *GMUserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PermissionMutation) Name() (r string, exists bool) {
-	v := m.name
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *GMUserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the GMUser entity.
+// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *GMUserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PermissionMutation) ResetName() {
-	m.name = nil
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *GMUserMutation) ResetPasswordHash() {
+	m.password_hash = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *PermissionMutation) SetDescription(s string) {
-	m.description = &s
+// SetStatus sets the "status" field.
+func (m *GMUserMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PermissionMutation) Description() (r string, exists bool) {
-	v := m.description
+// Status returns the value of the "status" field in the mutation.
+func (m *GMUserMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the GMUser entity.
+// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *GMUserMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Status, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PermissionMutation) ResetDescription() {
-	m.description = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *GMUserMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PermissionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *GMUserMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PermissionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *GMUserMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldLastLoginAt returns the old "last_login_at" field's value of the GMUser entity.
+// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GMUserMutation) OldLastLoginAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.LastLoginAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PermissionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *GMUserMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[gmuser.FieldLastLoginAt] = struct{}{}
 }
 
-// AddRoleIDs adds the "roles" edge to the Role entity by ids.
-func (m *PermissionMutation) AddRoleIDs(ids ...int) {
-	if m.roles == nil {
-		m.roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
-	}
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *GMUserMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[gmuser.FieldLastLoginAt]
+	return ok
 }
 
-// ClearRoles clears the "roles" edge to the Role entity.
-func (m *PermissionMutation) ClearRoles() {
-	m.clearedroles = true
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *GMUserMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, gmuser.FieldLastLoginAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GMUserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GMUserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GMUser entity.
+// If the GMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GMUserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GMUserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *GMUserMutation) AddRoleIDs(ids ...int) {
+	if m.roles == nil {
+		m.roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *GMUserMutation) ClearRoles() {
+	m.clearedroles = true
 }
 
 // RolesCleared reports if the "roles" edge to the Role entity was cleared.
-func (m *PermissionMutation) RolesCleared() bool {
+func (m *GMUserMutation) RolesCleared() bool {
 	return m.clearedroles
 }
 
 // RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
-func (m *PermissionMutation) RemoveRoleIDs(ids ...int) {
+func (m *GMUserMutation) RemoveRoleIDs(ids ...int) {
 	if m.removedroles == nil {
 		m.removedroles = make(map[int]struct{})
 	}
@@ -4369,7 +4304,7 @@ func (m *PermissionMutation) RemoveRoleIDs(ids ...int) {
 }
 
 // RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
-func (m *PermissionMutation) RemovedRolesIDs() (ids []int) {
+func (m *GMUserMutation) RemovedRolesIDs() (ids []int) {
 	for id := range m.removedroles {
 		ids = append(ids, id)
 	}
@@ -4377,7 +4312,7 @@ func (m *PermissionMutation) RemovedRolesIDs() (ids []int) {
 }
 
 // RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *PermissionMutation) RolesIDs() (ids []int) {
+func (m *GMUserMutation) RolesIDs() (ids []int) {
 	for id := range m.roles {
 		ids = append(ids, id)
 	}
@@ -4385,21 +4320,21 @@ func (m *PermissionMutation) RolesIDs() (ids []int) {
 }
 
 // ResetRoles resets all changes to the "roles" edge.
-func (m *PermissionMutation) ResetRoles() {
+func (m *GMUserMutation) ResetRoles() {
 	m.roles = nil
 	m.clearedroles = false
 	m.removedroles = nil
 }
 
-// Where appends a list predicates to the PermissionMutation builder.
-func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+// Where appends a list predicates to the GMUserMutation builder.
+func (m *GMUserMutation) Where(ps ...predicate.GMUser) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the GMUserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Permission, len(ps))
+func (m *GMUserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GMUser, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4407,36 +4342,39 @@ func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PermissionMutation) Op() Op {
+func (m *GMUserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PermissionMutation) SetOp(op Op) {
+func (m *GMUserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Permission).
-func (m *PermissionMutation) Type() string {
+// Type returns the node type of this mutation (GMUser).
+func (m *GMUserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PermissionMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.code != nil {
-		fields = append(fields, permission.FieldCode)
+func (m *GMUserMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.username != nil {
+		fields = append(fields, gmuser.FieldUsername)
 	}
-	if m.name != nil {
-		fields = append(fields, permission.FieldName)
+	if m.password_hash != nil {
+		fields = append(fields, gmuser.FieldPasswordHash)
 	}
-	if m.description != nil {
-		fields = append(fields, permission.FieldDescription)
+	if m.status != nil {
+		fields = append(fields, gmuser.FieldStatus)
+	}
+	if m.last_login_at != nil {
+		fields = append(fields, gmuser.FieldLastLoginAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, permission.FieldCreatedAt)
+		fields = append(fields, gmuser.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4444,15 +4382,17 @@ func (m *PermissionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+func (m *GMUserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case permission.FieldCode:
-		return m.Code()
-	case permission.FieldName:
-		return m.Name()
-	case permission.FieldDescription:
-		return m.Description()
-	case permission.FieldCreatedAt:
+	case gmuser.FieldUsername:
+		return m.Username()
+	case gmuser.FieldPasswordHash:
+		return m.PasswordHash()
+	case gmuser.FieldStatus:
+		return m.Status()
+	case gmuser.FieldLastLoginAt:
+		return m.LastLoginAt()
+	case gmuser.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -4461,47 +4401,56 @@ func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GMUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case permission.FieldCode:
-		return m.OldCode(ctx)
-	case permission.FieldName:
-		return m.OldName(ctx)
-	case permission.FieldDescription:
-		return m.OldDescription(ctx)
-	case permission.FieldCreatedAt:
+	case gmuser.FieldUsername:
+		return m.OldUsername(ctx)
+	case gmuser.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case gmuser.FieldStatus:
+		return m.OldStatus(ctx)
+	case gmuser.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
+	case gmuser.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Permission field %s", name)
+	return nil, fmt.Errorf("unknown GMUser field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+func (m *GMUserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case permission.FieldCode:
+	case gmuser.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetUsername(v)
 		return nil
-	case permission.FieldName:
+	case gmuser.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPasswordHash(v)
 		return nil
-	case permission.FieldDescription:
+	case gmuser.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetStatus(v)
 		return nil
-	case permission.FieldCreatedAt:
+	case gmuser.FieldLastLoginAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLoginAt(v)
+		return nil
+	case gmuser.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4509,84 +4458,96 @@ func (m *PermissionMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return fmt.Errorf("unknown GMUser field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PermissionMutation) AddedFields() []string {
+func (m *GMUserMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GMUserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+func (m *GMUserMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Permission numeric field %s", name)
+	return fmt.Errorf("unknown GMUser numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PermissionMutation) ClearedFields() []string {
-	return nil
+func (m *GMUserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gmuser.FieldLastLoginAt) {
+		fields = append(fields, gmuser.FieldLastLoginAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PermissionMutation) FieldCleared(name string) bool {
+func (m *GMUserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PermissionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Permission nullable field %s", name)
+func (m *GMUserMutation) ClearField(name string) error {
+	switch name {
+	case gmuser.FieldLastLoginAt:
+		m.ClearLastLoginAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GMUser nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PermissionMutation) ResetField(name string) error {
+func (m *GMUserMutation) ResetField(name string) error {
 	switch name {
-	case permission.FieldCode:
-		m.ResetCode()
+	case gmuser.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case permission.FieldName:
-		m.ResetName()
+	case gmuser.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case permission.FieldDescription:
-		m.ResetDescription()
+	case gmuser.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case permission.FieldCreatedAt:
+	case gmuser.FieldLastLoginAt:
+		m.ResetLastLoginAt()
+		return nil
+	case gmuser.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return fmt.Errorf("unknown GMUser field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PermissionMutation) AddedEdges() []string {
+func (m *GMUserMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.roles != nil {
-		edges = append(edges, permission.EdgeRoles)
+		edges = append(edges, gmuser.EdgeRoles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
+func (m *GMUserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case permission.EdgeRoles:
+	case gmuser.EdgeRoles:
 		ids := make([]ent.Value, 0, len(m.roles))
 		for id := range m.roles {
 			ids = append(ids, id)
@@ -4597,19 +4558,19 @@ func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PermissionMutation) RemovedEdges() []string {
+func (m *GMUserMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.removedroles != nil {
-		edges = append(edges, permission.EdgeRoles)
+		edges = append(edges, gmuser.EdgeRoles)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
+func (m *GMUserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case permission.EdgeRoles:
+	case gmuser.EdgeRoles:
 		ids := make([]ent.Value, 0, len(m.removedroles))
 		for id := range m.removedroles {
 			ids = append(ids, id)
@@ -4620,19 +4581,19 @@ func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PermissionMutation) ClearedEdges() []string {
+func (m *GMUserMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedroles {
-		edges = append(edges, permission.EdgeRoles)
+		edges = append(edges, gmuser.EdgeRoles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PermissionMutation) EdgeCleared(name string) bool {
+func (m *GMUserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case permission.EdgeRoles:
+	case gmuser.EdgeRoles:
 		return m.clearedroles
 	}
 	return false
@@ -4640,64 +4601,55 @@ func (m *PermissionMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PermissionMutation) ClearEdge(name string) error {
+func (m *GMUserMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Permission unique edge %s", name)
+	return fmt.Errorf("unknown GMUser unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PermissionMutation) ResetEdge(name string) error {
+func (m *GMUserMutation) ResetEdge(name string) error {
 	switch name {
-	case permission.EdgeRoles:
+	case gmuser.EdgeRoles:
 		m.ResetRoles()
 		return nil
 	}
-	return fmt.Errorf("unknown Permission edge %s", name)
+	return fmt.Errorf("unknown GMUser edge %s", name)
 }
 
-// PetMutation represents an operation that mutates the Pet nodes in the graph.
-type PetMutation struct {
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	species_id    *int
-	addspecies_id *int
-	level         *int
-	addlevel      *int
-	exp           *int
-	addexp        *int
-	hp            *int
-	addhp         *int
-	catch_time    *int64
-	addcatch_time *int64
-	dv            *int
-	adddv         *int
-	nature        *string
-	skills        *string
+	item_id       *int
+	additem_id    *int
+	count         *int
+	addcount      *int
+	meta          *string
 	created_at    *time.Time
 	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	player        *int
 	clearedplayer bool
 	done          bool
-	oldValue      func(context.Context) (*Pet, error)
-	predicates    []predicate.Pet
+	oldValue      func(context.Context) (*Item, error)
+	predicates    []predicate.Item
 }
 
-var _ ent.Mutation = (*PetMutation)(nil)
+var _ ent.Mutation = (*ItemMutation)(nil)
 
-// petOption allows management of the mutation configuration using functional options.
-type petOption func(*PetMutation)
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
 
-// newPetMutation creates new mutation for the Pet entity.
-func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
-	m := &PetMutation{
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePet,
+		typ:           TypeItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4706,20 +4658,20 @@ func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
 	return m
 }
 
-// withPetID sets the ID field of the mutation.
-func withPetID(id int) petOption {
-	return func(m *PetMutation) {
+// withItemID sets the ID field of the mutation.
+func withItemID(id int) itemOption {
+	return func(m *ItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pet
+			value *Item
 		)
-		m.oldValue = func(ctx context.Context) (*Pet, error) {
+		m.oldValue = func(ctx context.Context) (*Item, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pet.Get(ctx, id)
+					value, err = m.Client().Item.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4728,10 +4680,10 @@ func withPetID(id int) petOption {
 	}
 }
 
-// withPet sets the old Pet of the mutation.
-func withPet(node *Pet) petOption {
-	return func(m *PetMutation) {
-		m.oldValue = func(context.Context) (*Pet, error) {
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4740,7 +4692,7 @@ func withPet(node *Pet) petOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PetMutation) Client() *Client {
+func (m ItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4748,7 +4700,7 @@ func (m PetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PetMutation) Tx() (*Tx, error) {
+func (m ItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4759,7 +4711,7 @@ func (m PetMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PetMutation) ID() (id int, exists bool) {
+func (m *ItemMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4770,7 +4722,7 @@ func (m *PetMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4779,19 +4731,19 @@ func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetPlayerID sets the "player_id" field.
-func (m *PetMutation) SetPlayerID(i int) {
+func (m *ItemMutation) SetPlayerID(i int) {
 	m.player = &i
 }
 
 // PlayerID returns the value of the "player_id" field in the mutation.
-func (m *PetMutation) PlayerID() (r int, exists bool) {
+func (m *ItemMutation) PlayerID() (r int, exists bool) {
 	v := m.player
 	if v == nil {
 		return
@@ -4799,10 +4751,10 @@ func (m *PetMutation) PlayerID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldPlayerID returns the old "player_id" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldPlayerID returns the old "player_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldPlayerID(ctx context.Context) (v int, err error) {
+func (m *ItemMutation) OldPlayerID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
 	}
@@ -4817,757 +4769,1103 @@ func (m *PetMutation) OldPlayerID(ctx context.Context) (v int, err error) {
 }
 
 // ResetPlayerID resets all changes to the "player_id" field.
-func (m *PetMutation) ResetPlayerID() {
+func (m *ItemMutation) ResetPlayerID() {
 	m.player = nil
 }
 
-// SetSpeciesID sets the "species_id" field.
-func (m *PetMutation) SetSpeciesID(i int) {
-	m.species_id = &i
-	m.addspecies_id = nil
+// SetItemID sets the "item_id" field.
+func (m *ItemMutation) SetItemID(i int) {
+	m.item_id = &i
+	m.additem_id = nil
 }
 
-// SpeciesID returns the value of the "species_id" field in the mutation.
-func (m *PetMutation) SpeciesID() (r int, exists bool) {
-	v := m.species_id
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ItemMutation) ItemID() (r int, exists bool) {
+	v := m.item_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpeciesID returns the old "species_id" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldItemID returns the old "item_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldSpeciesID(ctx context.Context) (v int, err error) {
+func (m *ItemMutation) OldItemID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpeciesID is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpeciesID requires an ID field in the mutation")
+		return v, errors.New("OldItemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpeciesID: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
 	}
-	return oldValue.SpeciesID, nil
+	return oldValue.ItemID, nil
 }
 
-// AddSpeciesID adds i to the "species_id" field.
-func (m *PetMutation) AddSpeciesID(i int) {
-	if m.addspecies_id != nil {
-		*m.addspecies_id += i
+// AddItemID adds i to the "item_id" field.
+func (m *ItemMutation) AddItemID(i int) {
+	if m.additem_id != nil {
+		*m.additem_id += i
 	} else {
-		m.addspecies_id = &i
+		m.additem_id = &i
 	}
 }
 
-// AddedSpeciesID returns the value that was added to the "species_id" field in this mutation.
-func (m *PetMutation) AddedSpeciesID() (r int, exists bool) {
-	v := m.addspecies_id
+// AddedItemID returns the value that was added to the "item_id" field in this mutation.
+func (m *ItemMutation) AddedItemID() (r int, exists bool) {
+	v := m.additem_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSpeciesID resets all changes to the "species_id" field.
-func (m *PetMutation) ResetSpeciesID() {
-	m.species_id = nil
-	m.addspecies_id = nil
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ItemMutation) ResetItemID() {
+	m.item_id = nil
+	m.additem_id = nil
 }
 
-// SetLevel sets the "level" field.
-func (m *PetMutation) SetLevel(i int) {
-	m.level = &i
-	m.addlevel = nil
+// SetCount sets the "count" field.
+func (m *ItemMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
 }
 
-// Level returns the value of the "level" field in the mutation.
-func (m *PetMutation) Level() (r int, exists bool) {
-	v := m.level
+// Count returns the value of the "count" field in the mutation.
+func (m *ItemMutation) Count() (r int, exists bool) {
+	v := m.count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLevel returns the old "level" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldCount returns the old "count" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldLevel(ctx context.Context) (v int, err error) {
+func (m *ItemMutation) OldCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLevel requires an ID field in the mutation")
+		return v, errors.New("OldCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
 	}
-	return oldValue.Level, nil
+	return oldValue.Count, nil
 }
 
-// AddLevel adds i to the "level" field.
-func (m *PetMutation) AddLevel(i int) {
-	if m.addlevel != nil {
-		*m.addlevel += i
+// AddCount adds i to the "count" field.
+func (m *ItemMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
 	} else {
-		m.addlevel = &i
+		m.addcount = &i
 	}
 }
 
-// AddedLevel returns the value that was added to the "level" field in this mutation.
-func (m *PetMutation) AddedLevel() (r int, exists bool) {
-	v := m.addlevel
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *ItemMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLevel resets all changes to the "level" field.
-func (m *PetMutation) ResetLevel() {
-	m.level = nil
-	m.addlevel = nil
+// ResetCount resets all changes to the "count" field.
+func (m *ItemMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
 }
 
-// SetExp sets the "exp" field.
-func (m *PetMutation) SetExp(i int) {
-	m.exp = &i
-	m.addexp = nil
+// SetMeta sets the "meta" field.
+func (m *ItemMutation) SetMeta(s string) {
+	m.meta = &s
 }
 
-// Exp returns the value of the "exp" field in the mutation.
-func (m *PetMutation) Exp() (r int, exists bool) {
-	v := m.exp
+// Meta returns the value of the "meta" field in the mutation.
+func (m *ItemMutation) Meta() (r string, exists bool) {
+	v := m.meta
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExp returns the old "exp" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldMeta returns the old "meta" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldExp(ctx context.Context) (v int, err error) {
+func (m *ItemMutation) OldMeta(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExp is only allowed on UpdateOne operations")
+		return v, errors.New("OldMeta is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExp requires an ID field in the mutation")
+		return v, errors.New("OldMeta requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExp: %w", err)
+		return v, fmt.Errorf("querying old value for OldMeta: %w", err)
 	}
-	return oldValue.Exp, nil
+	return oldValue.Meta, nil
 }
 
-// AddExp adds i to the "exp" field.
-func (m *PetMutation) AddExp(i int) {
-	if m.addexp != nil {
-		*m.addexp += i
-	} else {
-		m.addexp = &i
-	}
+// ResetMeta resets all changes to the "meta" field.
+func (m *ItemMutation) ResetMeta() {
+	m.meta = nil
 }
 
-// AddedExp returns the value that was added to the "exp" field in this mutation.
-func (m *PetMutation) AddedExp() (r int, exists bool) {
-	v := m.addexp
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetExp resets all changes to the "exp" field.
-func (m *PetMutation) ResetExp() {
-	m.exp = nil
-	m.addexp = nil
+// OldCreatedAt returns the old "created_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// SetHp sets the "hp" field.
-func (m *PetMutation) SetHp(i int) {
-	m.hp = &i
-	m.addhp = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Hp returns the value of the "hp" field in the mutation.
-func (m *PetMutation) Hp() (r int, exists bool) {
-	v := m.hp
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHp returns the old "hp" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldHp(ctx context.Context) (v int, err error) {
+func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHp is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHp requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHp: %w", err)
-	}
-	return oldValue.Hp, nil
-}
-
-// AddHp adds i to the "hp" field.
-func (m *PetMutation) AddHp(i int) {
-	if m.addhp != nil {
-		*m.addhp += i
-	} else {
-		m.addhp = &i
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// AddedHp returns the value that was added to the "hp" field in this mutation.
-func (m *PetMutation) AddedHp() (r int, exists bool) {
-	v := m.addhp
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ResetHp resets all changes to the "hp" field.
-func (m *PetMutation) ResetHp() {
-	m.hp = nil
-	m.addhp = nil
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *ItemMutation) ClearPlayer() {
+	m.clearedplayer = true
+	m.clearedFields[item.FieldPlayerID] = struct{}{}
 }
 
-// SetCatchTime sets the "catch_time" field.
-func (m *PetMutation) SetCatchTime(i int64) {
-	m.catch_time = &i
-	m.addcatch_time = nil
+// PlayerCleared reports if the "player" edge to the Player entity was cleared.
+func (m *ItemMutation) PlayerCleared() bool {
+	return m.clearedplayer
 }
 
-// CatchTime returns the value of the "catch_time" field in the mutation.
-func (m *PetMutation) CatchTime() (r int64, exists bool) {
-	v := m.catch_time
-	if v == nil {
-		return
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldCatchTime returns the old "catch_time" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldCatchTime(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCatchTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCatchTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCatchTime: %w", err)
-	}
-	return oldValue.CatchTime, nil
+// ResetPlayer resets all changes to the "player" edge.
+func (m *ItemMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
 }
 
-// AddCatchTime adds i to the "catch_time" field.
-func (m *PetMutation) AddCatchTime(i int64) {
-	if m.addcatch_time != nil {
-		*m.addcatch_time += i
-	} else {
-		m.addcatch_time = &i
-	}
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddedCatchTime returns the value that was added to the "catch_time" field in this mutation.
-func (m *PetMutation) AddedCatchTime() (r int64, exists bool) {
-	v := m.addcatch_time
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetCatchTime resets all changes to the "catch_time" field.
-func (m *PetMutation) ResetCatchTime() {
-	m.catch_time = nil
-	m.addcatch_time = nil
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
 }
 
-// SetDv sets the "dv" field.
-func (m *PetMutation) SetDv(i int) {
-	m.dv = &i
-	m.adddv = nil
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Dv returns the value of the "dv" field in the mutation.
-func (m *PetMutation) Dv() (r int, exists bool) {
-	v := m.dv
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
 }
 
-// OldDv returns the old "dv" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldDv(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDv is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.player != nil {
+		fields = append(fields, item.FieldPlayerID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDv requires an ID field in the mutation")
+	if m.item_id != nil {
+		fields = append(fields, item.FieldItemID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDv: %w", err)
+	if m.count != nil {
+		fields = append(fields, item.FieldCount)
 	}
-	return oldValue.Dv, nil
-}
-
-// AddDv adds i to the "dv" field.
-func (m *PetMutation) AddDv(i int) {
-	if m.adddv != nil {
-		*m.adddv += i
-	} else {
-		m.adddv = &i
+	if m.meta != nil {
+		fields = append(fields, item.FieldMeta)
 	}
-}
-
-// AddedDv returns the value that was added to the "dv" field in this mutation.
-func (m *PetMutation) AddedDv() (r int, exists bool) {
-	v := m.adddv
-	if v == nil {
-		return
+	if m.created_at != nil {
+		fields = append(fields, item.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// ResetDv resets all changes to the "dv" field.
-func (m *PetMutation) ResetDv() {
-	m.dv = nil
-	m.adddv = nil
-}
-
-// SetNature sets the "nature" field.
-func (m *PetMutation) SetNature(s string) {
-	m.nature = &s
-}
-
-// Nature returns the value of the "nature" field in the mutation.
-func (m *PetMutation) Nature() (r string, exists bool) {
-	v := m.nature
-	if v == nil {
-		return
+	if m.updated_at != nil {
+		fields = append(fields, item.FieldUpdatedAt)
 	}
-	return *v, true
+	return fields
 }
 
-// OldNature returns the old "nature" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldNature(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNature is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNature requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNature: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldPlayerID:
+		return m.PlayerID()
+	case item.FieldItemID:
+		return m.ItemID()
+	case item.FieldCount:
+		return m.Count()
+	case item.FieldMeta:
+		return m.Meta()
+	case item.FieldCreatedAt:
+		return m.CreatedAt()
+	case item.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return oldValue.Nature, nil
-}
-
-// ResetNature resets all changes to the "nature" field.
-func (m *PetMutation) ResetNature() {
-	m.nature = nil
-}
-
-// SetSkills sets the "skills" field.
-func (m *PetMutation) SetSkills(s string) {
-	m.skills = &s
+	return nil, false
 }
 
-// Skills returns the value of the "skills" field in the mutation.
-func (m *PetMutation) Skills() (r string, exists bool) {
-	v := m.skills
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldPlayerID:
+		return m.OldPlayerID(ctx)
+	case item.FieldItemID:
+		return m.OldItemID(ctx)
+	case item.FieldCount:
+		return m.OldCount(ctx)
+	case item.FieldMeta:
+		return m.OldMeta(ctx)
+	case item.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case item.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Item field %s", name)
 }
 
-// OldSkills returns the old "skills" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldSkills(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkills is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkills requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkills: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldPlayerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerID(v)
+		return nil
+	case item.FieldItemID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case item.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	case item.FieldMeta:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeta(v)
+		return nil
+	case item.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case item.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.Skills, nil
+	return fmt.Errorf("unknown Item field %s", name)
 }
 
-// ResetSkills resets all changes to the "skills" field.
-func (m *PetMutation) ResetSkills() {
-	m.skills = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	var fields []string
+	if m.additem_id != nil {
+		fields = append(fields, item.FieldItemID)
+	}
+	if m.addcount != nil {
+		fields = append(fields, item.FieldCount)
+	}
+	return fields
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PetMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldItemID:
+		return m.AddedItemID()
+	case item.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PetMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldItemID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddItemID(v)
+		return nil
+	case item.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Item numeric field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PetMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PetMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Item nullable field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PetMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldPlayerID:
+		m.ResetPlayerID()
+		return nil
+	case item.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case item.FieldCount:
+		m.ResetCount()
+		return nil
+	case item.FieldMeta:
+		m.ResetMeta()
+		return nil
+	case item.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case item.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Item field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.player != nil {
+		edges = append(edges, item.EdgePlayer)
 	}
-	return oldValue.UpdatedAt, nil
+	return edges
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PetMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// ClearPlayer clears the "player" edge to the Player entity.
-func (m *PetMutation) ClearPlayer() {
-	m.clearedplayer = true
-	m.clearedFields[pet.FieldPlayerID] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// PlayerCleared reports if the "player" edge to the Player entity was cleared.
-func (m *PetMutation) PlayerCleared() bool {
-	return m.clearedplayer
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// PlayerIDs returns the "player" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PlayerID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) PlayerIDs() (ids []int) {
-	if id := m.player; id != nil {
-		ids = append(ids, *id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplayer {
+		edges = append(edges, item.EdgePlayer)
 	}
-	return
+	return edges
 }
 
-// ResetPlayer resets all changes to the "player" edge.
-func (m *PetMutation) ResetPlayer() {
-	m.player = nil
-	m.clearedplayer = false
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgePlayer:
+		return m.clearedplayer
+	}
+	return false
 }
 
-// Where appends a list predicates to the PetMutation builder.
-func (m *PetMutation) Where(ps ...predicate.Pet) {
-	m.predicates = append(m.predicates, ps...)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	case item.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
 }
 
-// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Pet, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgePlayer:
+		m.ResetPlayer()
+		return nil
 	}
-	m.Where(p...)
+	return fmt.Errorf("unknown Item edge %s", name)
 }
 
-// Op returns the operation name.
-func (m *PetMutation) Op() Op {
-	return m.op
+// MentorshipMutation represents an operation that mutates the Mentorship nodes in the graph.
+type MentorshipMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	teacher_id    *int64
+	addteacher_id *int64
+	student_id    *int64
+	addstudent_id *int64
+	exp_shared    *int64
+	addexp_shared *int64
+	exp_total     *int64
+	addexp_total  *int64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Mentorship, error)
+	predicates    []predicate.Mentorship
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PetMutation) SetOp(op Op) {
-	m.op = op
+var _ ent.Mutation = (*MentorshipMutation)(nil)
+
+// mentorshipOption allows management of the mutation configuration using functional options.
+type mentorshipOption func(*MentorshipMutation)
+
+// newMentorshipMutation creates new mutation for the Mentorship entity.
+func newMentorshipMutation(c config, op Op, opts ...mentorshipOption) *MentorshipMutation {
+	m := &MentorshipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMentorship,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Type returns the node type of this mutation (Pet).
-func (m *PetMutation) Type() string {
-	return m.typ
+// withMentorshipID sets the ID field of the mutation.
+func withMentorshipID(id int) mentorshipOption {
+	return func(m *MentorshipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Mentorship
+		)
+		m.oldValue = func(ctx context.Context) (*Mentorship, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Mentorship.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PetMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.player != nil {
-		fields = append(fields, pet.FieldPlayerID)
+// withMentorship sets the old Mentorship of the mutation.
+func withMentorship(node *Mentorship) mentorshipOption {
+	return func(m *MentorshipMutation) {
+		m.oldValue = func(context.Context) (*Mentorship, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	if m.species_id != nil {
-		fields = append(fields, pet.FieldSpeciesID)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MentorshipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MentorshipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.level != nil {
-		fields = append(fields, pet.FieldLevel)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MentorshipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.exp != nil {
-		fields = append(fields, pet.FieldExp)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MentorshipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Mentorship.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.hp != nil {
-		fields = append(fields, pet.FieldHp)
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (m *MentorshipMutation) SetTeacherID(i int64) {
+	m.teacher_id = &i
+	m.addteacher_id = nil
+}
+
+// TeacherID returns the value of the "teacher_id" field in the mutation.
+func (m *MentorshipMutation) TeacherID() (r int64, exists bool) {
+	v := m.teacher_id
+	if v == nil {
+		return
 	}
-	if m.catch_time != nil {
-		fields = append(fields, pet.FieldCatchTime)
+	return *v, true
+}
+
+// OldTeacherID returns the old "teacher_id" field's value of the Mentorship entity.
+// If the Mentorship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MentorshipMutation) OldTeacherID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeacherID is only allowed on UpdateOne operations")
 	}
-	if m.dv != nil {
-		fields = append(fields, pet.FieldDv)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeacherID requires an ID field in the mutation")
 	}
-	if m.nature != nil {
-		fields = append(fields, pet.FieldNature)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeacherID: %w", err)
 	}
-	if m.skills != nil {
-		fields = append(fields, pet.FieldSkills)
+	return oldValue.TeacherID, nil
+}
+
+// AddTeacherID adds i to the "teacher_id" field.
+func (m *MentorshipMutation) AddTeacherID(i int64) {
+	if m.addteacher_id != nil {
+		*m.addteacher_id += i
+	} else {
+		m.addteacher_id = &i
 	}
-	if m.created_at != nil {
-		fields = append(fields, pet.FieldCreatedAt)
+}
+
+// AddedTeacherID returns the value that was added to the "teacher_id" field in this mutation.
+func (m *MentorshipMutation) AddedTeacherID() (r int64, exists bool) {
+	v := m.addteacher_id
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, pet.FieldUpdatedAt)
+	return *v, true
+}
+
+// ResetTeacherID resets all changes to the "teacher_id" field.
+func (m *MentorshipMutation) ResetTeacherID() {
+	m.teacher_id = nil
+	m.addteacher_id = nil
+}
+
+// SetStudentID sets the "student_id" field.
+func (m *MentorshipMutation) SetStudentID(i int64) {
+	m.student_id = &i
+	m.addstudent_id = nil
+}
+
+// StudentID returns the value of the "student_id" field in the mutation.
+func (m *MentorshipMutation) StudentID() (r int64, exists bool) {
+	v := m.student_id
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PetMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case pet.FieldPlayerID:
-		return m.PlayerID()
-	case pet.FieldSpeciesID:
-		return m.SpeciesID()
-	case pet.FieldLevel:
-		return m.Level()
-	case pet.FieldExp:
-		return m.Exp()
-	case pet.FieldHp:
-		return m.Hp()
-	case pet.FieldCatchTime:
-		return m.CatchTime()
-	case pet.FieldDv:
-		return m.Dv()
-	case pet.FieldNature:
-		return m.Nature()
-	case pet.FieldSkills:
-		return m.Skills()
-	case pet.FieldCreatedAt:
-		return m.CreatedAt()
-	case pet.FieldUpdatedAt:
-		return m.UpdatedAt()
+// OldStudentID returns the old "student_id" field's value of the Mentorship entity.
+// If the Mentorship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MentorshipMutation) OldStudentID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStudentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
+	}
+	return oldValue.StudentID, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case pet.FieldPlayerID:
-		return m.OldPlayerID(ctx)
-	case pet.FieldSpeciesID:
-		return m.OldSpeciesID(ctx)
-	case pet.FieldLevel:
-		return m.OldLevel(ctx)
-	case pet.FieldExp:
-		return m.OldExp(ctx)
-	case pet.FieldHp:
-		return m.OldHp(ctx)
-	case pet.FieldCatchTime:
-		return m.OldCatchTime(ctx)
-	case pet.FieldDv:
-		return m.OldDv(ctx)
-	case pet.FieldNature:
-		return m.OldNature(ctx)
-	case pet.FieldSkills:
-		return m.OldSkills(ctx)
-	case pet.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case pet.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddStudentID adds i to the "student_id" field.
+func (m *MentorshipMutation) AddStudentID(i int64) {
+	if m.addstudent_id != nil {
+		*m.addstudent_id += i
+	} else {
+		m.addstudent_id = &i
 	}
-	return nil, fmt.Errorf("unknown Pet field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PetMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case pet.FieldPlayerID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlayerID(v)
-		return nil
-	case pet.FieldSpeciesID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpeciesID(v)
-		return nil
-	case pet.FieldLevel:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLevel(v)
-		return nil
-	case pet.FieldExp:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExp(v)
-		return nil
-	case pet.FieldHp:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHp(v)
-		return nil
-	case pet.FieldCatchTime:
+// AddedStudentID returns the value that was added to the "student_id" field in this mutation.
+func (m *MentorshipMutation) AddedStudentID() (r int64, exists bool) {
+	v := m.addstudent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStudentID resets all changes to the "student_id" field.
+func (m *MentorshipMutation) ResetStudentID() {
+	m.student_id = nil
+	m.addstudent_id = nil
+}
+
+// SetExpShared sets the "exp_shared" field.
+func (m *MentorshipMutation) SetExpShared(i int64) {
+	m.exp_shared = &i
+	m.addexp_shared = nil
+}
+
+// ExpShared returns the value of the "exp_shared" field in the mutation.
+func (m *MentorshipMutation) ExpShared() (r int64, exists bool) {
+	v := m.exp_shared
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpShared returns the old "exp_shared" field's value of the Mentorship entity.
+// If the Mentorship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MentorshipMutation) OldExpShared(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpShared is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpShared requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpShared: %w", err)
+	}
+	return oldValue.ExpShared, nil
+}
+
+// AddExpShared adds i to the "exp_shared" field.
+func (m *MentorshipMutation) AddExpShared(i int64) {
+	if m.addexp_shared != nil {
+		*m.addexp_shared += i
+	} else {
+		m.addexp_shared = &i
+	}
+}
+
+// AddedExpShared returns the value that was added to the "exp_shared" field in this mutation.
+func (m *MentorshipMutation) AddedExpShared() (r int64, exists bool) {
+	v := m.addexp_shared
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpShared resets all changes to the "exp_shared" field.
+func (m *MentorshipMutation) ResetExpShared() {
+	m.exp_shared = nil
+	m.addexp_shared = nil
+}
+
+// SetExpTotal sets the "exp_total" field.
+func (m *MentorshipMutation) SetExpTotal(i int64) {
+	m.exp_total = &i
+	m.addexp_total = nil
+}
+
+// ExpTotal returns the value of the "exp_total" field in the mutation.
+func (m *MentorshipMutation) ExpTotal() (r int64, exists bool) {
+	v := m.exp_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpTotal returns the old "exp_total" field's value of the Mentorship entity.
+// If the Mentorship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MentorshipMutation) OldExpTotal(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpTotal: %w", err)
+	}
+	return oldValue.ExpTotal, nil
+}
+
+// AddExpTotal adds i to the "exp_total" field.
+func (m *MentorshipMutation) AddExpTotal(i int64) {
+	if m.addexp_total != nil {
+		*m.addexp_total += i
+	} else {
+		m.addexp_total = &i
+	}
+}
+
+// AddedExpTotal returns the value that was added to the "exp_total" field in this mutation.
+func (m *MentorshipMutation) AddedExpTotal() (r int64, exists bool) {
+	v := m.addexp_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpTotal resets all changes to the "exp_total" field.
+func (m *MentorshipMutation) ResetExpTotal() {
+	m.exp_total = nil
+	m.addexp_total = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MentorshipMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MentorshipMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Mentorship entity.
+// If the Mentorship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MentorshipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MentorshipMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the MentorshipMutation builder.
+func (m *MentorshipMutation) Where(ps ...predicate.Mentorship) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MentorshipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MentorshipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Mentorship, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MentorshipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MentorshipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Mentorship).
+func (m *MentorshipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MentorshipMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.teacher_id != nil {
+		fields = append(fields, mentorship.FieldTeacherID)
+	}
+	if m.student_id != nil {
+		fields = append(fields, mentorship.FieldStudentID)
+	}
+	if m.exp_shared != nil {
+		fields = append(fields, mentorship.FieldExpShared)
+	}
+	if m.exp_total != nil {
+		fields = append(fields, mentorship.FieldExpTotal)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mentorship.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MentorshipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mentorship.FieldTeacherID:
+		return m.TeacherID()
+	case mentorship.FieldStudentID:
+		return m.StudentID()
+	case mentorship.FieldExpShared:
+		return m.ExpShared()
+	case mentorship.FieldExpTotal:
+		return m.ExpTotal()
+	case mentorship.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MentorshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mentorship.FieldTeacherID:
+		return m.OldTeacherID(ctx)
+	case mentorship.FieldStudentID:
+		return m.OldStudentID(ctx)
+	case mentorship.FieldExpShared:
+		return m.OldExpShared(ctx)
+	case mentorship.FieldExpTotal:
+		return m.OldExpTotal(ctx)
+	case mentorship.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Mentorship field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MentorshipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mentorship.FieldTeacherID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCatchTime(v)
+		m.SetTeacherID(v)
 		return nil
-	case pet.FieldDv:
-		v, ok := value.(int)
+	case mentorship.FieldStudentID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDv(v)
+		m.SetStudentID(v)
 		return nil
-	case pet.FieldNature:
-		v, ok := value.(string)
+	case mentorship.FieldExpShared:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNature(v)
+		m.SetExpShared(v)
 		return nil
-	case pet.FieldSkills:
-		v, ok := value.(string)
+	case mentorship.FieldExpTotal:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkills(v)
+		m.SetExpTotal(v)
 		return nil
-	case pet.FieldCreatedAt:
+	case mentorship.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case pet.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
+	return fmt.Errorf("unknown Mentorship field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PetMutation) AddedFields() []string {
+func (m *MentorshipMutation) AddedFields() []string {
 	var fields []string
-	if m.addspecies_id != nil {
-		fields = append(fields, pet.FieldSpeciesID)
-	}
-	if m.addlevel != nil {
-		fields = append(fields, pet.FieldLevel)
-	}
-	if m.addexp != nil {
-		fields = append(fields, pet.FieldExp)
+	if m.addteacher_id != nil {
+		fields = append(fields, mentorship.FieldTeacherID)
 	}
-	if m.addhp != nil {
-		fields = append(fields, pet.FieldHp)
+	if m.addstudent_id != nil {
+		fields = append(fields, mentorship.FieldStudentID)
 	}
-	if m.addcatch_time != nil {
-		fields = append(fields, pet.FieldCatchTime)
+	if m.addexp_shared != nil {
+		fields = append(fields, mentorship.FieldExpShared)
 	}
-	if m.adddv != nil {
-		fields = append(fields, pet.FieldDv)
+	if m.addexp_total != nil {
+		fields = append(fields, mentorship.FieldExpTotal)
 	}
 	return fields
 }
@@ -5575,20 +5873,16 @@ func (m *PetMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MentorshipMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case pet.FieldSpeciesID:
-		return m.AddedSpeciesID()
-	case pet.FieldLevel:
-		return m.AddedLevel()
-	case pet.FieldExp:
-		return m.AddedExp()
-	case pet.FieldHp:
-		return m.AddedHp()
-	case pet.FieldCatchTime:
-		return m.AddedCatchTime()
-	case pet.FieldDv:
-		return m.AddedDv()
+	case mentorship.FieldTeacherID:
+		return m.AddedTeacherID()
+	case mentorship.FieldStudentID:
+		return m.AddedStudentID()
+	case mentorship.FieldExpShared:
+		return m.AddedExpShared()
+	case mentorship.FieldExpTotal:
+		return m.AddedExpTotal()
 	}
 	return nil, false
 }
@@ -5596,276 +5890,160 @@ func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PetMutation) AddField(name string, value ent.Value) error {
+func (m *MentorshipMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case pet.FieldSpeciesID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSpeciesID(v)
-		return nil
-	case pet.FieldLevel:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLevel(v)
-		return nil
-	case pet.FieldExp:
-		v, ok := value.(int)
+	case mentorship.FieldTeacherID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExp(v)
+		m.AddTeacherID(v)
 		return nil
-	case pet.FieldHp:
-		v, ok := value.(int)
+	case mentorship.FieldStudentID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddHp(v)
+		m.AddStudentID(v)
 		return nil
-	case pet.FieldCatchTime:
+	case mentorship.FieldExpShared:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCatchTime(v)
+		m.AddExpShared(v)
 		return nil
-	case pet.FieldDv:
-		v, ok := value.(int)
+	case mentorship.FieldExpTotal:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDv(v)
+		m.AddExpTotal(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Pet numeric field %s", name)
+	return fmt.Errorf("unknown Mentorship numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PetMutation) ClearedFields() []string {
+func (m *MentorshipMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PetMutation) FieldCleared(name string) bool {
+func (m *MentorshipMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PetMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Pet nullable field %s", name)
+func (m *MentorshipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Mentorship nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PetMutation) ResetField(name string) error {
+func (m *MentorshipMutation) ResetField(name string) error {
 	switch name {
-	case pet.FieldPlayerID:
-		m.ResetPlayerID()
-		return nil
-	case pet.FieldSpeciesID:
-		m.ResetSpeciesID()
-		return nil
-	case pet.FieldLevel:
-		m.ResetLevel()
-		return nil
-	case pet.FieldExp:
-		m.ResetExp()
-		return nil
-	case pet.FieldHp:
-		m.ResetHp()
-		return nil
-	case pet.FieldCatchTime:
-		m.ResetCatchTime()
+	case mentorship.FieldTeacherID:
+		m.ResetTeacherID()
 		return nil
-	case pet.FieldDv:
-		m.ResetDv()
+	case mentorship.FieldStudentID:
+		m.ResetStudentID()
 		return nil
-	case pet.FieldNature:
-		m.ResetNature()
+	case mentorship.FieldExpShared:
+		m.ResetExpShared()
 		return nil
-	case pet.FieldSkills:
-		m.ResetSkills()
+	case mentorship.FieldExpTotal:
+		m.ResetExpTotal()
 		return nil
-	case pet.FieldCreatedAt:
+	case mentorship.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case pet.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
+	return fmt.Errorf("unknown Mentorship field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.player != nil {
-		edges = append(edges, pet.EdgePlayer)
-	}
+func (m *MentorshipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PetMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case pet.EdgePlayer:
-		if id := m.player; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *MentorshipMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *MentorshipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+func (m *MentorshipMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedplayer {
-		edges = append(edges, pet.EdgePlayer)
-	}
+func (m *MentorshipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PetMutation) EdgeCleared(name string) bool {
-	switch name {
-	case pet.EdgePlayer:
-		return m.clearedplayer
-	}
+func (m *MentorshipMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PetMutation) ClearEdge(name string) error {
-	switch name {
-	case pet.EdgePlayer:
-		m.ClearPlayer()
-		return nil
-	}
-	return fmt.Errorf("unknown Pet unique edge %s", name)
+func (m *MentorshipMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Mentorship unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PetMutation) ResetEdge(name string) error {
-	switch name {
-	case pet.EdgePlayer:
-		m.ResetPlayer()
-		return nil
-	}
-	return fmt.Errorf("unknown Pet edge %s", name)
+func (m *MentorshipMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Mentorship edge %s", name)
 }
 
-// PlayerMutation represents an operation that mutates the Player nodes in the graph.
-type PlayerMutation struct {
+// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
+type PermissionMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *int
-	nick                      *string
-	level                     *int
-	addlevel                  *int
-	coins                     *int64
-	addcoins                  *int64
-	gold                      *int64
-	addgold                   *int64
-	map_id                    *int
-	addmap_id                 *int
-	map_type                  *int
-	addmap_type               *int
-	pos_x                     *int
-	addpos_x                  *int
-	pos_y                     *int
-	addpos_y                  *int
-	last_map_id               *int
-	addlast_map_id            *int
-	color                     *int64
-	addcolor                  *int64
-	texture                   *int64
-	addtexture                *int64
-	energy                    *int64
-	addenergy                 *int64
-	fight_badge               *int64
-	addfight_badge            *int64
-	time_today                *int64
-	addtime_today             *int64
-	time_limit                *int64
-	addtime_limit             *int64
-	teacher_id                *int64
-	addteacher_id             *int64
-	student_id                *int64
-	addstudent_id             *int64
-	cur_title                 *int64
-	addcur_title              *int64
-	task_status               *string
-	task_bufs                 *string
-	friends                   *string
-	blacklist                 *string
-	achievements              *string
-	titles                    *string
-	team_info                 *string
-	student_ids               *string
-	room_id                   *int64
-	addroom_id                *int64
-	fitments                  *string
-	nono_info                 *string
-	mailbox                   *string
-	current_pet_id            *int64
-	addcurrent_pet_id         *int64
-	current_pet_catch_time    *int64
-	addcurrent_pet_catch_time *int64
-	current_pet_dv            *int64
-	addcurrent_pet_dv         *int64
-	last_login_at             *time.Time
-	created_at                *time.Time
-	updated_at                *time.Time
-	clearedFields             map[string]struct{}
-	account                   *int
-	clearedaccount            bool
-	pets                      map[int]struct{}
-	removedpets               map[int]struct{}
-	clearedpets               bool
-	items                     map[int]struct{}
-	removeditems              map[int]struct{}
-	cleareditems              bool
-	done                      bool
-	oldValue                  func(context.Context) (*Player, error)
-	predicates                []predicate.Player
+	op            Op
+	typ           string
+	id            *int
+	code          *string
+	name          *string
+	description   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	roles         map[int]struct{}
+	removedroles  map[int]struct{}
+	clearedroles  bool
+	done          bool
+	oldValue      func(context.Context) (*Permission, error)
+	predicates    []predicate.Permission
 }
 
-var _ ent.Mutation = (*PlayerMutation)(nil)
+var _ ent.Mutation = (*PermissionMutation)(nil)
 
-// playerOption allows management of the mutation configuration using functional options.
-type playerOption func(*PlayerMutation)
+// permissionOption allows management of the mutation configuration using functional options.
+type permissionOption func(*PermissionMutation)
 
-// newPlayerMutation creates new mutation for the Player entity.
-func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
-	m := &PlayerMutation{
+// newPermissionMutation creates new mutation for the Permission entity.
+func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
+	m := &PermissionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlayer,
+		typ:           TypePermission,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5874,20 +6052,20 @@ func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
 	return m
 }
 
-// withPlayerID sets the ID field of the mutation.
-func withPlayerID(id int) playerOption {
-	return func(m *PlayerMutation) {
+// withPermissionID sets the ID field of the mutation.
+func withPermissionID(id int) permissionOption {
+	return func(m *PermissionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Player
+			value *Permission
 		)
-		m.oldValue = func(ctx context.Context) (*Player, error) {
+		m.oldValue = func(ctx context.Context) (*Permission, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Player.Get(ctx, id)
+					value, err = m.Client().Permission.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5896,10 +6074,10 @@ func withPlayerID(id int) playerOption {
 	}
 }
 
-// withPlayer sets the old Player of the mutation.
-func withPlayer(node *Player) playerOption {
-	return func(m *PlayerMutation) {
-		m.oldValue = func(context.Context) (*Player, error) {
+// withPermission sets the old Permission of the mutation.
+func withPermission(node *Permission) permissionOption {
+	return func(m *PermissionMutation) {
+		m.oldValue = func(context.Context) (*Permission, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5908,7 +6086,7 @@ func withPlayer(node *Player) playerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlayerMutation) Client() *Client {
+func (m PermissionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5916,7 +6094,7 @@ func (m PlayerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PlayerMutation) Tx() (*Tx, error) {
+func (m PermissionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5927,7 +6105,7 @@ func (m PlayerMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PlayerMutation) ID() (id int, exists bool) {
+func (m *PermissionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5938,7 +6116,7 @@ func (m *PlayerMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PermissionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5947,3079 +6125,11830 @@ func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAccountID sets the "account_id" field.
-func (m *PlayerMutation) SetAccountID(i int) {
-	m.account = &i
+// SetCode sets the "code" field.
+func (m *PermissionMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// AccountID returns the value of the "account_id" field in the mutation.
-func (m *PlayerMutation) AccountID() (r int, exists bool) {
-	v := m.account
+// Code returns the value of the "code" field in the mutation.
+func (m *PermissionMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccountID returns the old "account_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldAccountID(ctx context.Context) (v int, err error) {
+// OldCode returns the old "code" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountID requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.AccountID, nil
+	return oldValue.Code, nil
 }
 
-// ResetAccountID resets all changes to the "account_id" field.
-func (m *PlayerMutation) ResetAccountID() {
-	m.account = nil
+// ResetCode resets all changes to the "code" field.
+func (m *PermissionMutation) ResetCode() {
+	m.code = nil
 }
 
-// SetNick sets the "nick" field.
-func (m *PlayerMutation) SetNick(s string) {
-	m.nick = &s
+// SetName sets the "name" field.
+func (m *PermissionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Nick returns the value of the "nick" field in the mutation.
-func (m *PlayerMutation) Nick() (r string, exists bool) {
-	v := m.nick
+// Name returns the value of the "name" field in the mutation.
+func (m *PermissionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNick returns the old "nick" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldNick(ctx context.Context) (v string, err error) {
+func (m *PermissionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNick is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNick requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNick: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Nick, nil
+	return oldValue.Name, nil
 }
 
-// ResetNick resets all changes to the "nick" field.
-func (m *PlayerMutation) ResetNick() {
-	m.nick = nil
+// ResetName resets all changes to the "name" field.
+func (m *PermissionMutation) ResetName() {
+	m.name = nil
 }
 
-// SetLevel sets the "level" field.
-func (m *PlayerMutation) SetLevel(i int) {
-	m.level = &i
-	m.addlevel = nil
+// SetDescription sets the "description" field.
+func (m *PermissionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Level returns the value of the "level" field in the mutation.
-func (m *PlayerMutation) Level() (r int, exists bool) {
-	v := m.level
+// Description returns the value of the "description" field in the mutation.
+func (m *PermissionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLevel returns the old "level" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldLevel(ctx context.Context) (v int, err error) {
+func (m *PermissionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLevel requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
-	}
-	return oldValue.Level, nil
-}
-
-// AddLevel adds i to the "level" field.
-func (m *PlayerMutation) AddLevel(i int) {
-	if m.addlevel != nil {
-		*m.addlevel += i
-	} else {
-		m.addlevel = &i
-	}
-}
-
-// AddedLevel returns the value that was added to the "level" field in this mutation.
-func (m *PlayerMutation) AddedLevel() (r int, exists bool) {
-	v := m.addlevel
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return *v, true
+	return oldValue.Description, nil
 }
 
-// ResetLevel resets all changes to the "level" field.
-func (m *PlayerMutation) ResetLevel() {
-	m.level = nil
-	m.addlevel = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *PermissionMutation) ResetDescription() {
+	m.description = nil
 }
 
-// SetCoins sets the "coins" field.
-func (m *PlayerMutation) SetCoins(i int64) {
-	m.coins = &i
-	m.addcoins = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *PermissionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Coins returns the value of the "coins" field in the mutation.
-func (m *PlayerMutation) Coins() (r int64, exists bool) {
-	v := m.coins
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PermissionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCoins returns the old "coins" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldCoins(ctx context.Context) (v int64, err error) {
+func (m *PermissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCoins is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCoins requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCoins: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Coins, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddCoins adds i to the "coins" field.
-func (m *PlayerMutation) AddCoins(i int64) {
-	if m.addcoins != nil {
-		*m.addcoins += i
-	} else {
-		m.addcoins = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PermissionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedCoins returns the value that was added to the "coins" field in this mutation.
-func (m *PlayerMutation) AddedCoins() (r int64, exists bool) {
-	v := m.addcoins
-	if v == nil {
-		return
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *PermissionMutation) AddRoleIDs(ids ...int) {
+	if m.roles == nil {
+		m.roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
 	}
-	return *v, true
-}
-
-// ResetCoins resets all changes to the "coins" field.
-func (m *PlayerMutation) ResetCoins() {
-	m.coins = nil
-	m.addcoins = nil
 }
 
-// SetGold sets the "gold" field.
-func (m *PlayerMutation) SetGold(i int64) {
-	m.gold = &i
-	m.addgold = nil
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *PermissionMutation) ClearRoles() {
+	m.clearedroles = true
 }
 
-// Gold returns the value of the "gold" field in the mutation.
-func (m *PlayerMutation) Gold() (r int64, exists bool) {
-	v := m.gold
-	if v == nil {
-		return
-	}
-	return *v, true
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *PermissionMutation) RolesCleared() bool {
+	return m.clearedroles
 }
 
-// OldGold returns the old "gold" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldGold(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGold is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGold requires an ID field in the mutation")
+// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
+func (m *PermissionMutation) RemoveRoleIDs(ids ...int) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGold: %w", err)
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
 	}
-	return oldValue.Gold, nil
 }
 
-// AddGold adds i to the "gold" field.
-func (m *PlayerMutation) AddGold(i int64) {
-	if m.addgold != nil {
-		*m.addgold += i
-	} else {
-		m.addgold = &i
+// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
+func (m *PermissionMutation) RemovedRolesIDs() (ids []int) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedGold returns the value that was added to the "gold" field in this mutation.
-func (m *PlayerMutation) AddedGold() (r int64, exists bool) {
-	v := m.addgold
-	if v == nil {
-		return
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *PermissionMutation) RolesIDs() (ids []int) {
+	for id := range m.roles {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetGold resets all changes to the "gold" field.
-func (m *PlayerMutation) ResetGold() {
-	m.gold = nil
-	m.addgold = nil
+// ResetRoles resets all changes to the "roles" edge.
+func (m *PermissionMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
 }
 
-// SetMapID sets the "map_id" field.
-func (m *PlayerMutation) SetMapID(i int) {
-	m.map_id = &i
-	m.addmap_id = nil
+// Where appends a list predicates to the PermissionMutation builder.
+func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// MapID returns the value of the "map_id" field in the mutation.
-func (m *PlayerMutation) MapID() (r int, exists bool) {
-	v := m.map_id
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Permission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldMapID returns the old "map_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldMapID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMapID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMapID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMapID: %w", err)
-	}
-	return oldValue.MapID, nil
-}
-
-// AddMapID adds i to the "map_id" field.
-func (m *PlayerMutation) AddMapID(i int) {
-	if m.addmap_id != nil {
-		*m.addmap_id += i
-	} else {
-		m.addmap_id = &i
-	}
-}
-
-// AddedMapID returns the value that was added to the "map_id" field in this mutation.
-func (m *PlayerMutation) AddedMapID() (r int, exists bool) {
-	v := m.addmap_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// Op returns the operation name.
+func (m *PermissionMutation) Op() Op {
+	return m.op
 }
 
-// ResetMapID resets all changes to the "map_id" field.
-func (m *PlayerMutation) ResetMapID() {
-	m.map_id = nil
-	m.addmap_id = nil
+// SetOp allows setting the mutation operation.
+func (m *PermissionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetMapType sets the "map_type" field.
-func (m *PlayerMutation) SetMapType(i int) {
-	m.map_type = &i
-	m.addmap_type = nil
+// Type returns the node type of this mutation (Permission).
+func (m *PermissionMutation) Type() string {
+	return m.typ
 }
 
-// MapType returns the value of the "map_type" field in the mutation.
-func (m *PlayerMutation) MapType() (r int, exists bool) {
-	v := m.map_type
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PermissionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.code != nil {
+		fields = append(fields, permission.FieldCode)
 	}
-	return *v, true
-}
-
-// OldMapType returns the old "map_type" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldMapType(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMapType is only allowed on UpdateOne operations")
+	if m.name != nil {
+		fields = append(fields, permission.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMapType requires an ID field in the mutation")
+	if m.description != nil {
+		fields = append(fields, permission.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMapType: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, permission.FieldCreatedAt)
 	}
-	return oldValue.MapType, nil
+	return fields
 }
 
-// AddMapType adds i to the "map_type" field.
-func (m *PlayerMutation) AddMapType(i int) {
-	if m.addmap_type != nil {
-		*m.addmap_type += i
-	} else {
-		m.addmap_type = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case permission.FieldCode:
+		return m.Code()
+	case permission.FieldName:
+		return m.Name()
+	case permission.FieldDescription:
+		return m.Description()
+	case permission.FieldCreatedAt:
+		return m.CreatedAt()
 	}
+	return nil, false
 }
 
-// AddedMapType returns the value that was added to the "map_type" field in this mutation.
-func (m *PlayerMutation) AddedMapType() (r int, exists bool) {
-	v := m.addmap_type
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case permission.FieldCode:
+		return m.OldCode(ctx)
+	case permission.FieldName:
+		return m.OldName(ctx)
+	case permission.FieldDescription:
+		return m.OldDescription(ctx)
+	case permission.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
-}
-
-// ResetMapType resets all changes to the "map_type" field.
-func (m *PlayerMutation) ResetMapType() {
-	m.map_type = nil
-	m.addmap_type = nil
+	return nil, fmt.Errorf("unknown Permission field %s", name)
 }
 
-// SetPosX sets the "pos_x" field.
-func (m *PlayerMutation) SetPosX(i int) {
-	m.pos_x = &i
-	m.addpos_x = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case permission.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case permission.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case permission.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case permission.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Permission field %s", name)
 }
 
-// PosX returns the value of the "pos_x" field in the mutation.
-func (m *PlayerMutation) PosX() (r int, exists bool) {
-	v := m.pos_x
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PermissionMutation) AddedFields() []string {
+	return nil
 }
 
-// OldPosX returns the old "pos_x" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldPosX(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosX is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosX requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosX: %w", err)
-	}
-	return oldValue.PosX, nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AddPosX adds i to the "pos_x" field.
-func (m *PlayerMutation) AddPosX(i int) {
-	if m.addpos_x != nil {
-		*m.addpos_x += i
-	} else {
-		m.addpos_x = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown Permission numeric field %s", name)
 }
 
-// AddedPosX returns the value that was added to the "pos_x" field in this mutation.
-func (m *PlayerMutation) AddedPosX() (r int, exists bool) {
-	v := m.addpos_x
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PermissionMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetPosX resets all changes to the "pos_x" field.
-func (m *PlayerMutation) ResetPosX() {
-	m.pos_x = nil
-	m.addpos_x = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PermissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetPosY sets the "pos_y" field.
-func (m *PlayerMutation) SetPosY(i int) {
-	m.pos_y = &i
-	m.addpos_y = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PermissionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Permission nullable field %s", name)
 }
 
-// PosY returns the value of the "pos_y" field in the mutation.
-func (m *PlayerMutation) PosY() (r int, exists bool) {
-	v := m.pos_y
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PermissionMutation) ResetField(name string) error {
+	switch name {
+	case permission.FieldCode:
+		m.ResetCode()
+		return nil
+	case permission.FieldName:
+		m.ResetName()
+		return nil
+	case permission.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case permission.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Permission field %s", name)
 }
 
-// OldPosY returns the old "pos_y" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldPosY(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosY is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.roles != nil {
+		edges = append(edges, permission.EdgeRoles)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosY requires an ID field in the mutation")
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosY: %w", err)
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedroles != nil {
+		edges = append(edges, permission.EdgeRoles)
 	}
-	return oldValue.PosY, nil
+	return edges
 }
 
-// AddPosY adds i to the "pos_y" field.
-func (m *PlayerMutation) AddPosY(i int) {
-	if m.addpos_y != nil {
-		*m.addpos_y += i
-	} else {
-		m.addpos_y = &i
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
 	}
+	return nil
 }
 
-// AddedPosY returns the value that was added to the "pos_y" field in this mutation.
-func (m *PlayerMutation) AddedPosY() (r int, exists bool) {
-	v := m.addpos_y
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedroles {
+		edges = append(edges, permission.EdgeRoles)
 	}
-	return *v, true
+	return edges
 }
 
-// ResetPosY resets all changes to the "pos_y" field.
-func (m *PlayerMutation) ResetPosY() {
-	m.pos_y = nil
-	m.addpos_y = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PermissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case permission.EdgeRoles:
+		return m.clearedroles
+	}
+	return false
 }
 
-// SetLastMapID sets the "last_map_id" field.
-func (m *PlayerMutation) SetLastMapID(i int) {
-	m.last_map_id = &i
-	m.addlast_map_id = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PermissionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Permission unique edge %s", name)
 }
 
-// LastMapID returns the value of the "last_map_id" field in the mutation.
-func (m *PlayerMutation) LastMapID() (r int, exists bool) {
-	v := m.last_map_id
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PermissionMutation) ResetEdge(name string) error {
+	switch name {
+	case permission.EdgeRoles:
+		m.ResetRoles()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Permission edge %s", name)
 }
 
-// OldLastMapID returns the old "last_map_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldLastMapID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastMapID is only allowed on UpdateOne operations")
+// PetMutation represents an operation that mutates the Pet nodes in the graph.
+type PetMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	species_id     *int
+	addspecies_id  *int
+	level          *int
+	addlevel       *int
+	exp            *int
+	addexp         *int
+	hp             *int
+	addhp          *int
+	catch_time     *int64
+	addcatch_time  *int64
+	dv             *int
+	adddv          *int
+	nature         *string
+	skills         *string
+	learned_skills *string
+	ev             *string
+	skill_pp       *string
+	status         *string
+	fainted        *bool
+	collected      *bool
+	room_show      *bool
+	location       *int
+	addlocation    *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	player         *int
+	clearedplayer  bool
+	done           bool
+	oldValue       func(context.Context) (*Pet, error)
+	predicates     []predicate.Pet
+}
+
+var _ ent.Mutation = (*PetMutation)(nil)
+
+// petOption allows management of the mutation configuration using functional options.
+type petOption func(*PetMutation)
+
+// newPetMutation creates new mutation for the Pet entity.
+func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
+	m := &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastMapID requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastMapID: %w", err)
+	return m
+}
+
+// withPetID sets the ID field of the mutation.
+func withPetID(id int) petOption {
+	return func(m *PetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pet
+		)
+		m.oldValue = func(ctx context.Context) (*Pet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.LastMapID, nil
 }
 
-// AddLastMapID adds i to the "last_map_id" field.
-func (m *PlayerMutation) AddLastMapID(i int) {
-	if m.addlast_map_id != nil {
-		*m.addlast_map_id += i
-	} else {
-		m.addlast_map_id = &i
+// withPet sets the old Pet of the mutation.
+func withPet(node *Pet) petOption {
+	return func(m *PetMutation) {
+		m.oldValue = func(context.Context) (*Pet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedLastMapID returns the value that was added to the "last_map_id" field in this mutation.
-func (m *PlayerMutation) AddedLastMapID() (r int, exists bool) {
-	v := m.addlast_map_id
-	if v == nil {
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PetMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetLastMapID resets all changes to the "last_map_id" field.
-func (m *PlayerMutation) ResetLastMapID() {
-	m.last_map_id = nil
-	m.addlast_map_id = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetColor sets the "color" field.
-func (m *PlayerMutation) SetColor(i int64) {
-	m.color = &i
-	m.addcolor = nil
+// SetPlayerID sets the "player_id" field.
+func (m *PetMutation) SetPlayerID(i int) {
+	m.player = &i
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *PlayerMutation) Color() (r int64, exists bool) {
-	v := m.color
+// PlayerID returns the value of the "player_id" field in the mutation.
+func (m *PetMutation) PlayerID() (r int, exists bool) {
+	v := m.player
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldPlayerID returns the old "player_id" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldColor(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldPlayerID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldPlayerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
 	}
-	return oldValue.Color, nil
+	return oldValue.PlayerID, nil
 }
 
-// AddColor adds i to the "color" field.
-func (m *PlayerMutation) AddColor(i int64) {
-	if m.addcolor != nil {
-		*m.addcolor += i
-	} else {
-		m.addcolor = &i
-	}
-}
-
-// AddedColor returns the value that was added to the "color" field in this mutation.
-func (m *PlayerMutation) AddedColor() (r int64, exists bool) {
-	v := m.addcolor
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetColor resets all changes to the "color" field.
-func (m *PlayerMutation) ResetColor() {
-	m.color = nil
-	m.addcolor = nil
+// ResetPlayerID resets all changes to the "player_id" field.
+func (m *PetMutation) ResetPlayerID() {
+	m.player = nil
 }
 
-// SetTexture sets the "texture" field.
-func (m *PlayerMutation) SetTexture(i int64) {
-	m.texture = &i
-	m.addtexture = nil
+// SetSpeciesID sets the "species_id" field.
+func (m *PetMutation) SetSpeciesID(i int) {
+	m.species_id = &i
+	m.addspecies_id = nil
 }
 
-// Texture returns the value of the "texture" field in the mutation.
-func (m *PlayerMutation) Texture() (r int64, exists bool) {
-	v := m.texture
+// SpeciesID returns the value of the "species_id" field in the mutation.
+func (m *PetMutation) SpeciesID() (r int, exists bool) {
+	v := m.species_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTexture returns the old "texture" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldSpeciesID returns the old "species_id" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTexture(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldSpeciesID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTexture is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpeciesID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTexture requires an ID field in the mutation")
+		return v, errors.New("OldSpeciesID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTexture: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpeciesID: %w", err)
 	}
-	return oldValue.Texture, nil
+	return oldValue.SpeciesID, nil
 }
 
-// AddTexture adds i to the "texture" field.
-func (m *PlayerMutation) AddTexture(i int64) {
-	if m.addtexture != nil {
-		*m.addtexture += i
+// AddSpeciesID adds i to the "species_id" field.
+func (m *PetMutation) AddSpeciesID(i int) {
+	if m.addspecies_id != nil {
+		*m.addspecies_id += i
 	} else {
-		m.addtexture = &i
+		m.addspecies_id = &i
 	}
 }
 
-// AddedTexture returns the value that was added to the "texture" field in this mutation.
-func (m *PlayerMutation) AddedTexture() (r int64, exists bool) {
-	v := m.addtexture
+// AddedSpeciesID returns the value that was added to the "species_id" field in this mutation.
+func (m *PetMutation) AddedSpeciesID() (r int, exists bool) {
+	v := m.addspecies_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTexture resets all changes to the "texture" field.
-func (m *PlayerMutation) ResetTexture() {
-	m.texture = nil
-	m.addtexture = nil
+// ResetSpeciesID resets all changes to the "species_id" field.
+func (m *PetMutation) ResetSpeciesID() {
+	m.species_id = nil
+	m.addspecies_id = nil
 }
 
-// SetEnergy sets the "energy" field.
-func (m *PlayerMutation) SetEnergy(i int64) {
-	m.energy = &i
-	m.addenergy = nil
+// SetLevel sets the "level" field.
+func (m *PetMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
 }
 
-// Energy returns the value of the "energy" field in the mutation.
-func (m *PlayerMutation) Energy() (r int64, exists bool) {
-	v := m.energy
+// Level returns the value of the "level" field in the mutation.
+func (m *PetMutation) Level() (r int, exists bool) {
+	v := m.level
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnergy returns the old "energy" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldLevel returns the old "level" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldEnergy(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldLevel(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnergy is only allowed on UpdateOne operations")
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnergy requires an ID field in the mutation")
+		return v, errors.New("OldLevel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnergy: %w", err)
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
 	}
-	return oldValue.Energy, nil
+	return oldValue.Level, nil
 }
 
-// AddEnergy adds i to the "energy" field.
-func (m *PlayerMutation) AddEnergy(i int64) {
-	if m.addenergy != nil {
-		*m.addenergy += i
+// AddLevel adds i to the "level" field.
+func (m *PetMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
 	} else {
-		m.addenergy = &i
+		m.addlevel = &i
 	}
 }
 
-// AddedEnergy returns the value that was added to the "energy" field in this mutation.
-func (m *PlayerMutation) AddedEnergy() (r int64, exists bool) {
-	v := m.addenergy
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *PetMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetEnergy resets all changes to the "energy" field.
-func (m *PlayerMutation) ResetEnergy() {
-	m.energy = nil
-	m.addenergy = nil
+// ResetLevel resets all changes to the "level" field.
+func (m *PetMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
 }
 
-// SetFightBadge sets the "fight_badge" field.
-func (m *PlayerMutation) SetFightBadge(i int64) {
-	m.fight_badge = &i
-	m.addfight_badge = nil
+// SetExp sets the "exp" field.
+func (m *PetMutation) SetExp(i int) {
+	m.exp = &i
+	m.addexp = nil
 }
 
-// FightBadge returns the value of the "fight_badge" field in the mutation.
-func (m *PlayerMutation) FightBadge() (r int64, exists bool) {
-	v := m.fight_badge
+// Exp returns the value of the "exp" field in the mutation.
+func (m *PetMutation) Exp() (r int, exists bool) {
+	v := m.exp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFightBadge returns the old "fight_badge" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldExp returns the old "exp" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldFightBadge(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldExp(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFightBadge is only allowed on UpdateOne operations")
+		return v, errors.New("OldExp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFightBadge requires an ID field in the mutation")
+		return v, errors.New("OldExp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFightBadge: %w", err)
+		return v, fmt.Errorf("querying old value for OldExp: %w", err)
 	}
-	return oldValue.FightBadge, nil
+	return oldValue.Exp, nil
 }
 
-// AddFightBadge adds i to the "fight_badge" field.
-func (m *PlayerMutation) AddFightBadge(i int64) {
-	if m.addfight_badge != nil {
-		*m.addfight_badge += i
+// AddExp adds i to the "exp" field.
+func (m *PetMutation) AddExp(i int) {
+	if m.addexp != nil {
+		*m.addexp += i
 	} else {
-		m.addfight_badge = &i
+		m.addexp = &i
 	}
 }
 
-// AddedFightBadge returns the value that was added to the "fight_badge" field in this mutation.
-func (m *PlayerMutation) AddedFightBadge() (r int64, exists bool) {
-	v := m.addfight_badge
+// AddedExp returns the value that was added to the "exp" field in this mutation.
+func (m *PetMutation) AddedExp() (r int, exists bool) {
+	v := m.addexp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFightBadge resets all changes to the "fight_badge" field.
-func (m *PlayerMutation) ResetFightBadge() {
-	m.fight_badge = nil
-	m.addfight_badge = nil
+// ResetExp resets all changes to the "exp" field.
+func (m *PetMutation) ResetExp() {
+	m.exp = nil
+	m.addexp = nil
 }
 
-// SetTimeToday sets the "time_today" field.
-func (m *PlayerMutation) SetTimeToday(i int64) {
-	m.time_today = &i
-	m.addtime_today = nil
+// SetHp sets the "hp" field.
+func (m *PetMutation) SetHp(i int) {
+	m.hp = &i
+	m.addhp = nil
 }
 
-// TimeToday returns the value of the "time_today" field in the mutation.
-func (m *PlayerMutation) TimeToday() (r int64, exists bool) {
-	v := m.time_today
+// Hp returns the value of the "hp" field in the mutation.
+func (m *PetMutation) Hp() (r int, exists bool) {
+	v := m.hp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimeToday returns the old "time_today" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldHp returns the old "hp" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTimeToday(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldHp(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeToday is only allowed on UpdateOne operations")
+		return v, errors.New("OldHp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeToday requires an ID field in the mutation")
+		return v, errors.New("OldHp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeToday: %w", err)
+		return v, fmt.Errorf("querying old value for OldHp: %w", err)
 	}
-	return oldValue.TimeToday, nil
+	return oldValue.Hp, nil
 }
 
-// AddTimeToday adds i to the "time_today" field.
-func (m *PlayerMutation) AddTimeToday(i int64) {
-	if m.addtime_today != nil {
-		*m.addtime_today += i
+// AddHp adds i to the "hp" field.
+func (m *PetMutation) AddHp(i int) {
+	if m.addhp != nil {
+		*m.addhp += i
 	} else {
-		m.addtime_today = &i
+		m.addhp = &i
 	}
 }
 
-// AddedTimeToday returns the value that was added to the "time_today" field in this mutation.
-func (m *PlayerMutation) AddedTimeToday() (r int64, exists bool) {
-	v := m.addtime_today
+// AddedHp returns the value that was added to the "hp" field in this mutation.
+func (m *PetMutation) AddedHp() (r int, exists bool) {
+	v := m.addhp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTimeToday resets all changes to the "time_today" field.
-func (m *PlayerMutation) ResetTimeToday() {
-	m.time_today = nil
-	m.addtime_today = nil
+// ResetHp resets all changes to the "hp" field.
+func (m *PetMutation) ResetHp() {
+	m.hp = nil
+	m.addhp = nil
 }
 
-// SetTimeLimit sets the "time_limit" field.
-func (m *PlayerMutation) SetTimeLimit(i int64) {
-	m.time_limit = &i
-	m.addtime_limit = nil
+// SetCatchTime sets the "catch_time" field.
+func (m *PetMutation) SetCatchTime(i int64) {
+	m.catch_time = &i
+	m.addcatch_time = nil
 }
 
-// TimeLimit returns the value of the "time_limit" field in the mutation.
-func (m *PlayerMutation) TimeLimit() (r int64, exists bool) {
-	v := m.time_limit
+// CatchTime returns the value of the "catch_time" field in the mutation.
+func (m *PetMutation) CatchTime() (r int64, exists bool) {
+	v := m.catch_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimeLimit returns the old "time_limit" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldCatchTime returns the old "catch_time" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTimeLimit(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldCatchTime(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldCatchTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeLimit requires an ID field in the mutation")
+		return v, errors.New("OldCatchTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeLimit: %w", err)
+		return v, fmt.Errorf("querying old value for OldCatchTime: %w", err)
 	}
-	return oldValue.TimeLimit, nil
+	return oldValue.CatchTime, nil
 }
 
-// AddTimeLimit adds i to the "time_limit" field.
-func (m *PlayerMutation) AddTimeLimit(i int64) {
-	if m.addtime_limit != nil {
-		*m.addtime_limit += i
+// AddCatchTime adds i to the "catch_time" field.
+func (m *PetMutation) AddCatchTime(i int64) {
+	if m.addcatch_time != nil {
+		*m.addcatch_time += i
 	} else {
-		m.addtime_limit = &i
+		m.addcatch_time = &i
 	}
 }
 
-// AddedTimeLimit returns the value that was added to the "time_limit" field in this mutation.
-func (m *PlayerMutation) AddedTimeLimit() (r int64, exists bool) {
-	v := m.addtime_limit
+// AddedCatchTime returns the value that was added to the "catch_time" field in this mutation.
+func (m *PetMutation) AddedCatchTime() (r int64, exists bool) {
+	v := m.addcatch_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTimeLimit resets all changes to the "time_limit" field.
-func (m *PlayerMutation) ResetTimeLimit() {
-	m.time_limit = nil
-	m.addtime_limit = nil
+// ResetCatchTime resets all changes to the "catch_time" field.
+func (m *PetMutation) ResetCatchTime() {
+	m.catch_time = nil
+	m.addcatch_time = nil
 }
 
-// SetTeacherID sets the "teacher_id" field.
-func (m *PlayerMutation) SetTeacherID(i int64) {
-	m.teacher_id = &i
-	m.addteacher_id = nil
+// SetDv sets the "dv" field.
+func (m *PetMutation) SetDv(i int) {
+	m.dv = &i
+	m.adddv = nil
 }
 
-// TeacherID returns the value of the "teacher_id" field in the mutation.
-func (m *PlayerMutation) TeacherID() (r int64, exists bool) {
-	v := m.teacher_id
+// Dv returns the value of the "dv" field in the mutation.
+func (m *PetMutation) Dv() (r int, exists bool) {
+	v := m.dv
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeacherID returns the old "teacher_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldDv returns the old "dv" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTeacherID(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldDv(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeacherID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDv is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeacherID requires an ID field in the mutation")
+		return v, errors.New("OldDv requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeacherID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDv: %w", err)
 	}
-	return oldValue.TeacherID, nil
+	return oldValue.Dv, nil
 }
 
-// AddTeacherID adds i to the "teacher_id" field.
-func (m *PlayerMutation) AddTeacherID(i int64) {
-	if m.addteacher_id != nil {
-		*m.addteacher_id += i
+// AddDv adds i to the "dv" field.
+func (m *PetMutation) AddDv(i int) {
+	if m.adddv != nil {
+		*m.adddv += i
 	} else {
-		m.addteacher_id = &i
+		m.adddv = &i
 	}
 }
 
-// AddedTeacherID returns the value that was added to the "teacher_id" field in this mutation.
-func (m *PlayerMutation) AddedTeacherID() (r int64, exists bool) {
-	v := m.addteacher_id
+// AddedDv returns the value that was added to the "dv" field in this mutation.
+func (m *PetMutation) AddedDv() (r int, exists bool) {
+	v := m.adddv
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTeacherID resets all changes to the "teacher_id" field.
-func (m *PlayerMutation) ResetTeacherID() {
-	m.teacher_id = nil
-	m.addteacher_id = nil
+// ResetDv resets all changes to the "dv" field.
+func (m *PetMutation) ResetDv() {
+	m.dv = nil
+	m.adddv = nil
 }
 
-// SetStudentID sets the "student_id" field.
-func (m *PlayerMutation) SetStudentID(i int64) {
-	m.student_id = &i
-	m.addstudent_id = nil
+// SetNature sets the "nature" field.
+func (m *PetMutation) SetNature(s string) {
+	m.nature = &s
 }
 
-// StudentID returns the value of the "student_id" field in the mutation.
-func (m *PlayerMutation) StudentID() (r int64, exists bool) {
-	v := m.student_id
+// Nature returns the value of the "nature" field in the mutation.
+func (m *PetMutation) Nature() (r string, exists bool) {
+	v := m.nature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStudentID returns the old "student_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldNature returns the old "nature" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldStudentID(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldNature(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldNature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStudentID requires an ID field in the mutation")
+		return v, errors.New("OldNature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
-	}
-	return oldValue.StudentID, nil
-}
-
-// AddStudentID adds i to the "student_id" field.
-func (m *PlayerMutation) AddStudentID(i int64) {
-	if m.addstudent_id != nil {
-		*m.addstudent_id += i
-	} else {
-		m.addstudent_id = &i
-	}
-}
-
-// AddedStudentID returns the value that was added to the "student_id" field in this mutation.
-func (m *PlayerMutation) AddedStudentID() (r int64, exists bool) {
-	v := m.addstudent_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldNature: %w", err)
 	}
-	return *v, true
+	return oldValue.Nature, nil
 }
 
-// ResetStudentID resets all changes to the "student_id" field.
-func (m *PlayerMutation) ResetStudentID() {
-	m.student_id = nil
-	m.addstudent_id = nil
+// ResetNature resets all changes to the "nature" field.
+func (m *PetMutation) ResetNature() {
+	m.nature = nil
 }
 
-// SetCurTitle sets the "cur_title" field.
-func (m *PlayerMutation) SetCurTitle(i int64) {
-	m.cur_title = &i
-	m.addcur_title = nil
+// SetSkills sets the "skills" field.
+func (m *PetMutation) SetSkills(s string) {
+	m.skills = &s
 }
 
-// CurTitle returns the value of the "cur_title" field in the mutation.
-func (m *PlayerMutation) CurTitle() (r int64, exists bool) {
-	v := m.cur_title
+// Skills returns the value of the "skills" field in the mutation.
+func (m *PetMutation) Skills() (r string, exists bool) {
+	v := m.skills
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurTitle returns the old "cur_title" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldSkills returns the old "skills" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldCurTitle(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldSkills(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkills is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurTitle requires an ID field in the mutation")
+		return v, errors.New("OldSkills requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurTitle: %w", err)
-	}
-	return oldValue.CurTitle, nil
-}
-
-// AddCurTitle adds i to the "cur_title" field.
-func (m *PlayerMutation) AddCurTitle(i int64) {
-	if m.addcur_title != nil {
-		*m.addcur_title += i
-	} else {
-		m.addcur_title = &i
-	}
-}
-
-// AddedCurTitle returns the value that was added to the "cur_title" field in this mutation.
-func (m *PlayerMutation) AddedCurTitle() (r int64, exists bool) {
-	v := m.addcur_title
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSkills: %w", err)
 	}
-	return *v, true
+	return oldValue.Skills, nil
 }
 
-// ResetCurTitle resets all changes to the "cur_title" field.
-func (m *PlayerMutation) ResetCurTitle() {
-	m.cur_title = nil
-	m.addcur_title = nil
+// ResetSkills resets all changes to the "skills" field.
+func (m *PetMutation) ResetSkills() {
+	m.skills = nil
 }
 
-// SetTaskStatus sets the "task_status" field.
-func (m *PlayerMutation) SetTaskStatus(s string) {
-	m.task_status = &s
+// SetLearnedSkills sets the "learned_skills" field.
+func (m *PetMutation) SetLearnedSkills(s string) {
+	m.learned_skills = &s
 }
 
-// TaskStatus returns the value of the "task_status" field in the mutation.
-func (m *PlayerMutation) TaskStatus() (r string, exists bool) {
-	v := m.task_status
+// LearnedSkills returns the value of the "learned_skills" field in the mutation.
+func (m *PetMutation) LearnedSkills() (r string, exists bool) {
+	v := m.learned_skills
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskStatus returns the old "task_status" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldLearnedSkills returns the old "learned_skills" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTaskStatus(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldLearnedSkills(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldLearnedSkills is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskStatus requires an ID field in the mutation")
+		return v, errors.New("OldLearnedSkills requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldLearnedSkills: %w", err)
 	}
-	return oldValue.TaskStatus, nil
+	return oldValue.LearnedSkills, nil
 }
 
-// ResetTaskStatus resets all changes to the "task_status" field.
-func (m *PlayerMutation) ResetTaskStatus() {
-	m.task_status = nil
+// ResetLearnedSkills resets all changes to the "learned_skills" field.
+func (m *PetMutation) ResetLearnedSkills() {
+	m.learned_skills = nil
 }
 
-// SetTaskBufs sets the "task_bufs" field.
-func (m *PlayerMutation) SetTaskBufs(s string) {
-	m.task_bufs = &s
+// SetEv sets the "ev" field.
+func (m *PetMutation) SetEv(s string) {
+	m.ev = &s
 }
 
-// TaskBufs returns the value of the "task_bufs" field in the mutation.
-func (m *PlayerMutation) TaskBufs() (r string, exists bool) {
-	v := m.task_bufs
+// Ev returns the value of the "ev" field in the mutation.
+func (m *PetMutation) Ev() (r string, exists bool) {
+	v := m.ev
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskBufs returns the old "task_bufs" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldEv returns the old "ev" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTaskBufs(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldEv(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskBufs is only allowed on UpdateOne operations")
+		return v, errors.New("OldEv is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskBufs requires an ID field in the mutation")
+		return v, errors.New("OldEv requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskBufs: %w", err)
+		return v, fmt.Errorf("querying old value for OldEv: %w", err)
 	}
-	return oldValue.TaskBufs, nil
+	return oldValue.Ev, nil
 }
 
-// ResetTaskBufs resets all changes to the "task_bufs" field.
-func (m *PlayerMutation) ResetTaskBufs() {
-	m.task_bufs = nil
+// ResetEv resets all changes to the "ev" field.
+func (m *PetMutation) ResetEv() {
+	m.ev = nil
 }
 
-// SetFriends sets the "friends" field.
-func (m *PlayerMutation) SetFriends(s string) {
-	m.friends = &s
+// SetSkillPp sets the "skill_pp" field.
+func (m *PetMutation) SetSkillPp(s string) {
+	m.skill_pp = &s
 }
 
-// Friends returns the value of the "friends" field in the mutation.
-func (m *PlayerMutation) Friends() (r string, exists bool) {
-	v := m.friends
+// SkillPp returns the value of the "skill_pp" field in the mutation.
+func (m *PetMutation) SkillPp() (r string, exists bool) {
+	v := m.skill_pp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFriends returns the old "friends" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldSkillPp returns the old "skill_pp" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldFriends(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldSkillPp(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFriends is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkillPp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFriends requires an ID field in the mutation")
+		return v, errors.New("OldSkillPp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFriends: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkillPp: %w", err)
 	}
-	return oldValue.Friends, nil
+	return oldValue.SkillPp, nil
 }
 
-// ResetFriends resets all changes to the "friends" field.
-func (m *PlayerMutation) ResetFriends() {
-	m.friends = nil
+// ResetSkillPp resets all changes to the "skill_pp" field.
+func (m *PetMutation) ResetSkillPp() {
+	m.skill_pp = nil
 }
 
-// SetBlacklist sets the "blacklist" field.
-func (m *PlayerMutation) SetBlacklist(s string) {
-	m.blacklist = &s
+// SetStatus sets the "status" field.
+func (m *PetMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// Blacklist returns the value of the "blacklist" field in the mutation.
-func (m *PlayerMutation) Blacklist() (r string, exists bool) {
-	v := m.blacklist
+// Status returns the value of the "status" field in the mutation.
+func (m *PetMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBlacklist returns the old "blacklist" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldBlacklist(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBlacklist is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBlacklist requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBlacklist: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Blacklist, nil
+	return oldValue.Status, nil
 }
 
-// ResetBlacklist resets all changes to the "blacklist" field.
-func (m *PlayerMutation) ResetBlacklist() {
-	m.blacklist = nil
-}
-
-// SetAchievements sets the "achievements" field.
-func (m *PlayerMutation) SetAchievements(s string) {
-	m.achievements = &s
-}
-
-// Achievements returns the value of the "achievements" field in the mutation.
-func (m *PlayerMutation) Achievements() (r string, exists bool) {
-	v := m.achievements
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAchievements returns the old "achievements" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldAchievements(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAchievements is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAchievements requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAchievements: %w", err)
-	}
-	return oldValue.Achievements, nil
-}
-
-// ResetAchievements resets all changes to the "achievements" field.
-func (m *PlayerMutation) ResetAchievements() {
-	m.achievements = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *PetMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetTitles sets the "titles" field.
-func (m *PlayerMutation) SetTitles(s string) {
-	m.titles = &s
+// SetFainted sets the "fainted" field.
+func (m *PetMutation) SetFainted(b bool) {
+	m.fainted = &b
 }
 
-// Titles returns the value of the "titles" field in the mutation.
-func (m *PlayerMutation) Titles() (r string, exists bool) {
-	v := m.titles
+// Fainted returns the value of the "fainted" field in the mutation.
+func (m *PetMutation) Fainted() (r bool, exists bool) {
+	v := m.fainted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitles returns the old "titles" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldFainted returns the old "fainted" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTitles(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldFainted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitles is only allowed on UpdateOne operations")
+		return v, errors.New("OldFainted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitles requires an ID field in the mutation")
+		return v, errors.New("OldFainted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitles: %w", err)
+		return v, fmt.Errorf("querying old value for OldFainted: %w", err)
 	}
-	return oldValue.Titles, nil
+	return oldValue.Fainted, nil
 }
 
-// ResetTitles resets all changes to the "titles" field.
-func (m *PlayerMutation) ResetTitles() {
-	m.titles = nil
+// ResetFainted resets all changes to the "fainted" field.
+func (m *PetMutation) ResetFainted() {
+	m.fainted = nil
 }
 
-// SetTeamInfo sets the "team_info" field.
-func (m *PlayerMutation) SetTeamInfo(s string) {
-	m.team_info = &s
+// SetCollected sets the "collected" field.
+func (m *PetMutation) SetCollected(b bool) {
+	m.collected = &b
 }
 
-// TeamInfo returns the value of the "team_info" field in the mutation.
-func (m *PlayerMutation) TeamInfo() (r string, exists bool) {
-	v := m.team_info
+// Collected returns the value of the "collected" field in the mutation.
+func (m *PetMutation) Collected() (r bool, exists bool) {
+	v := m.collected
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamInfo returns the old "team_info" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldCollected returns the old "collected" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldTeamInfo(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldCollected(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamInfo is only allowed on UpdateOne operations")
+		return v, errors.New("OldCollected is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamInfo requires an ID field in the mutation")
+		return v, errors.New("OldCollected requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamInfo: %w", err)
+		return v, fmt.Errorf("querying old value for OldCollected: %w", err)
 	}
-	return oldValue.TeamInfo, nil
+	return oldValue.Collected, nil
 }
 
-// ResetTeamInfo resets all changes to the "team_info" field.
-func (m *PlayerMutation) ResetTeamInfo() {
-	m.team_info = nil
+// ResetCollected resets all changes to the "collected" field.
+func (m *PetMutation) ResetCollected() {
+	m.collected = nil
 }
 
-// SetStudentIds sets the "student_ids" field.
-func (m *PlayerMutation) SetStudentIds(s string) {
-	m.student_ids = &s
+// SetRoomShow sets the "room_show" field.
+func (m *PetMutation) SetRoomShow(b bool) {
+	m.room_show = &b
 }
 
-// StudentIds returns the value of the "student_ids" field in the mutation.
-func (m *PlayerMutation) StudentIds() (r string, exists bool) {
-	v := m.student_ids
+// RoomShow returns the value of the "room_show" field in the mutation.
+func (m *PetMutation) RoomShow() (r bool, exists bool) {
+	v := m.room_show
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStudentIds returns the old "student_ids" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldRoomShow returns the old "room_show" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldStudentIds(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldRoomShow(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStudentIds is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoomShow is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStudentIds requires an ID field in the mutation")
+		return v, errors.New("OldRoomShow requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStudentIds: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoomShow: %w", err)
 	}
-	return oldValue.StudentIds, nil
+	return oldValue.RoomShow, nil
 }
 
-// ResetStudentIds resets all changes to the "student_ids" field.
-func (m *PlayerMutation) ResetStudentIds() {
-	m.student_ids = nil
+// ResetRoomShow resets all changes to the "room_show" field.
+func (m *PetMutation) ResetRoomShow() {
+	m.room_show = nil
 }
 
-// SetRoomID sets the "room_id" field.
-func (m *PlayerMutation) SetRoomID(i int64) {
-	m.room_id = &i
-	m.addroom_id = nil
+// SetLocation sets the "location" field.
+func (m *PetMutation) SetLocation(i int) {
+	m.location = &i
+	m.addlocation = nil
 }
 
-// RoomID returns the value of the "room_id" field in the mutation.
-func (m *PlayerMutation) RoomID() (r int64, exists bool) {
-	v := m.room_id
+// Location returns the value of the "location" field in the mutation.
+func (m *PetMutation) Location() (r int, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoomID returns the old "room_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldLocation returns the old "location" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldRoomID(ctx context.Context) (v int64, err error) {
+func (m *PetMutation) OldLocation(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoomID requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return oldValue.RoomID, nil
+	return oldValue.Location, nil
 }
 
-// AddRoomID adds i to the "room_id" field.
-func (m *PlayerMutation) AddRoomID(i int64) {
-	if m.addroom_id != nil {
-		*m.addroom_id += i
+// AddLocation adds i to the "location" field.
+func (m *PetMutation) AddLocation(i int) {
+	if m.addlocation != nil {
+		*m.addlocation += i
 	} else {
-		m.addroom_id = &i
+		m.addlocation = &i
 	}
 }
 
-// AddedRoomID returns the value that was added to the "room_id" field in this mutation.
-func (m *PlayerMutation) AddedRoomID() (r int64, exists bool) {
-	v := m.addroom_id
+// AddedLocation returns the value that was added to the "location" field in this mutation.
+func (m *PetMutation) AddedLocation() (r int, exists bool) {
+	v := m.addlocation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRoomID resets all changes to the "room_id" field.
-func (m *PlayerMutation) ResetRoomID() {
-	m.room_id = nil
-	m.addroom_id = nil
+// ResetLocation resets all changes to the "location" field.
+func (m *PetMutation) ResetLocation() {
+	m.location = nil
+	m.addlocation = nil
 }
 
-// SetFitments sets the "fitments" field.
-func (m *PlayerMutation) SetFitments(s string) {
-	m.fitments = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *PetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Fitments returns the value of the "fitments" field in the mutation.
-func (m *PlayerMutation) Fitments() (r string, exists bool) {
-	v := m.fitments
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFitments returns the old "fitments" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldFitments(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFitments is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFitments requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFitments: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Fitments, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetFitments resets all changes to the "fitments" field.
-func (m *PlayerMutation) ResetFitments() {
-	m.fitments = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetNonoInfo sets the "nono_info" field.
-func (m *PlayerMutation) SetNonoInfo(s string) {
-	m.nono_info = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// NonoInfo returns the value of the "nono_info" field in the mutation.
-func (m *PlayerMutation) NonoInfo() (r string, exists bool) {
-	v := m.nono_info
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNonoInfo returns the old "nono_info" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldNonoInfo(ctx context.Context) (v string, err error) {
+func (m *PetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNonoInfo is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNonoInfo requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNonoInfo: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.NonoInfo, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetNonoInfo resets all changes to the "nono_info" field.
-func (m *PlayerMutation) ResetNonoInfo() {
-	m.nono_info = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetMailbox sets the "mailbox" field.
-func (m *PlayerMutation) SetMailbox(s string) {
-	m.mailbox = &s
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *PetMutation) ClearPlayer() {
+	m.clearedplayer = true
+	m.clearedFields[pet.FieldPlayerID] = struct{}{}
 }
 
-// Mailbox returns the value of the "mailbox" field in the mutation.
-func (m *PlayerMutation) Mailbox() (r string, exists bool) {
-	v := m.mailbox
-	if v == nil {
-		return
-	}
-	return *v, true
+// PlayerCleared reports if the "player" edge to the Player entity was cleared.
+func (m *PetMutation) PlayerCleared() bool {
+	return m.clearedplayer
 }
 
-// OldMailbox returns the old "mailbox" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldMailbox(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMailbox is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMailbox requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMailbox: %w", err)
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Mailbox, nil
+	return
 }
 
-// ResetMailbox resets all changes to the "mailbox" field.
-func (m *PlayerMutation) ResetMailbox() {
-	m.mailbox = nil
+// ResetPlayer resets all changes to the "player" edge.
+func (m *PetMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
 }
 
-// SetCurrentPetID sets the "current_pet_id" field.
-func (m *PlayerMutation) SetCurrentPetID(i int64) {
-	m.current_pet_id = &i
-	m.addcurrent_pet_id = nil
+// Where appends a list predicates to the PetMutation builder.
+func (m *PetMutation) Where(ps ...predicate.Pet) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CurrentPetID returns the value of the "current_pet_id" field in the mutation.
-func (m *PlayerMutation) CurrentPetID() (r int64, exists bool) {
-	v := m.current_pet_id
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldCurrentPetID returns the old "current_pet_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldCurrentPetID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPetID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPetID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPetID: %w", err)
-	}
-	return oldValue.CurrentPetID, nil
-}
-
-// AddCurrentPetID adds i to the "current_pet_id" field.
-func (m *PlayerMutation) AddCurrentPetID(i int64) {
-	if m.addcurrent_pet_id != nil {
-		*m.addcurrent_pet_id += i
-	} else {
-		m.addcurrent_pet_id = &i
-	}
-}
-
-// AddedCurrentPetID returns the value that was added to the "current_pet_id" field in this mutation.
-func (m *PlayerMutation) AddedCurrentPetID() (r int64, exists bool) {
-	v := m.addcurrent_pet_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetCurrentPetID resets all changes to the "current_pet_id" field.
-func (m *PlayerMutation) ResetCurrentPetID() {
-	m.current_pet_id = nil
-	m.addcurrent_pet_id = nil
+// Op returns the operation name.
+func (m *PetMutation) Op() Op {
+	return m.op
 }
 
-// SetCurrentPetCatchTime sets the "current_pet_catch_time" field.
-func (m *PlayerMutation) SetCurrentPetCatchTime(i int64) {
-	m.current_pet_catch_time = &i
-	m.addcurrent_pet_catch_time = nil
+// SetOp allows setting the mutation operation.
+func (m *PetMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CurrentPetCatchTime returns the value of the "current_pet_catch_time" field in the mutation.
-func (m *PlayerMutation) CurrentPetCatchTime() (r int64, exists bool) {
-	v := m.current_pet_catch_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Pet).
+func (m *PetMutation) Type() string {
+	return m.typ
 }
 
-// OldCurrentPetCatchTime returns the old "current_pet_catch_time" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldCurrentPetCatchTime(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPetCatchTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPetCatchTime requires an ID field in the mutation")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PetMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.player != nil {
+		fields = append(fields, pet.FieldPlayerID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPetCatchTime: %w", err)
+	if m.species_id != nil {
+		fields = append(fields, pet.FieldSpeciesID)
 	}
-	return oldValue.CurrentPetCatchTime, nil
-}
-
-// AddCurrentPetCatchTime adds i to the "current_pet_catch_time" field.
-func (m *PlayerMutation) AddCurrentPetCatchTime(i int64) {
-	if m.addcurrent_pet_catch_time != nil {
-		*m.addcurrent_pet_catch_time += i
-	} else {
-		m.addcurrent_pet_catch_time = &i
+	if m.level != nil {
+		fields = append(fields, pet.FieldLevel)
 	}
-}
-
-// AddedCurrentPetCatchTime returns the value that was added to the "current_pet_catch_time" field in this mutation.
-func (m *PlayerMutation) AddedCurrentPetCatchTime() (r int64, exists bool) {
-	v := m.addcurrent_pet_catch_time
-	if v == nil {
-		return
+	if m.exp != nil {
+		fields = append(fields, pet.FieldExp)
 	}
-	return *v, true
-}
-
-// ResetCurrentPetCatchTime resets all changes to the "current_pet_catch_time" field.
-func (m *PlayerMutation) ResetCurrentPetCatchTime() {
-	m.current_pet_catch_time = nil
-	m.addcurrent_pet_catch_time = nil
-}
-
-// SetCurrentPetDv sets the "current_pet_dv" field.
-func (m *PlayerMutation) SetCurrentPetDv(i int64) {
-	m.current_pet_dv = &i
-	m.addcurrent_pet_dv = nil
-}
-
-// CurrentPetDv returns the value of the "current_pet_dv" field in the mutation.
-func (m *PlayerMutation) CurrentPetDv() (r int64, exists bool) {
-	v := m.current_pet_dv
-	if v == nil {
-		return
+	if m.hp != nil {
+		fields = append(fields, pet.FieldHp)
 	}
-	return *v, true
-}
-
-// OldCurrentPetDv returns the old "current_pet_dv" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldCurrentPetDv(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPetDv is only allowed on UpdateOne operations")
+	if m.catch_time != nil {
+		fields = append(fields, pet.FieldCatchTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPetDv requires an ID field in the mutation")
+	if m.dv != nil {
+		fields = append(fields, pet.FieldDv)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPetDv: %w", err)
+	if m.nature != nil {
+		fields = append(fields, pet.FieldNature)
 	}
-	return oldValue.CurrentPetDv, nil
-}
-
-// AddCurrentPetDv adds i to the "current_pet_dv" field.
-func (m *PlayerMutation) AddCurrentPetDv(i int64) {
-	if m.addcurrent_pet_dv != nil {
-		*m.addcurrent_pet_dv += i
-	} else {
-		m.addcurrent_pet_dv = &i
+	if m.skills != nil {
+		fields = append(fields, pet.FieldSkills)
 	}
-}
-
-// AddedCurrentPetDv returns the value that was added to the "current_pet_dv" field in this mutation.
-func (m *PlayerMutation) AddedCurrentPetDv() (r int64, exists bool) {
-	v := m.addcurrent_pet_dv
-	if v == nil {
-		return
+	if m.learned_skills != nil {
+		fields = append(fields, pet.FieldLearnedSkills)
 	}
-	return *v, true
-}
-
-// ResetCurrentPetDv resets all changes to the "current_pet_dv" field.
-func (m *PlayerMutation) ResetCurrentPetDv() {
-	m.current_pet_dv = nil
-	m.addcurrent_pet_dv = nil
-}
-
-// SetLastLoginAt sets the "last_login_at" field.
-func (m *PlayerMutation) SetLastLoginAt(t time.Time) {
-	m.last_login_at = &t
-}
-
-// LastLoginAt returns the value of the "last_login_at" field in the mutation.
-func (m *PlayerMutation) LastLoginAt() (r time.Time, exists bool) {
-	v := m.last_login_at
-	if v == nil {
-		return
+	if m.ev != nil {
+		fields = append(fields, pet.FieldEv)
 	}
-	return *v, true
-}
-
-// OldLastLoginAt returns the old "last_login_at" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldLastLoginAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	if m.skill_pp != nil {
+		fields = append(fields, pet.FieldSkillPp)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	if m.status != nil {
+		fields = append(fields, pet.FieldStatus)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	if m.fainted != nil {
+		fields = append(fields, pet.FieldFainted)
 	}
-	return oldValue.LastLoginAt, nil
-}
-
-// ClearLastLoginAt clears the value of the "last_login_at" field.
-func (m *PlayerMutation) ClearLastLoginAt() {
-	m.last_login_at = nil
-	m.clearedFields[player.FieldLastLoginAt] = struct{}{}
-}
-
-// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
-func (m *PlayerMutation) LastLoginAtCleared() bool {
-	_, ok := m.clearedFields[player.FieldLastLoginAt]
-	return ok
-}
-
-// ResetLastLoginAt resets all changes to the "last_login_at" field.
-func (m *PlayerMutation) ResetLastLoginAt() {
-	m.last_login_at = nil
-	delete(m.clearedFields, player.FieldLastLoginAt)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *PlayerMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PlayerMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+	if m.collected != nil {
+		fields = append(fields, pet.FieldCollected)
 	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	if m.room_show != nil {
+		fields = append(fields, pet.FieldRoomShow)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.location != nil {
+		fields = append(fields, pet.FieldLocation)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, pet.FieldCreatedAt)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PlayerMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PlayerMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PlayerMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+	if m.updated_at != nil {
+		fields = append(fields, pet.FieldUpdatedAt)
 	}
-	return *v, true
+	return fields
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldPlayerID:
+		return m.PlayerID()
+	case pet.FieldSpeciesID:
+		return m.SpeciesID()
+	case pet.FieldLevel:
+		return m.Level()
+	case pet.FieldExp:
+		return m.Exp()
+	case pet.FieldHp:
+		return m.Hp()
+	case pet.FieldCatchTime:
+		return m.CatchTime()
+	case pet.FieldDv:
+		return m.Dv()
+	case pet.FieldNature:
+		return m.Nature()
+	case pet.FieldSkills:
+		return m.Skills()
+	case pet.FieldLearnedSkills:
+		return m.LearnedSkills()
+	case pet.FieldEv:
+		return m.Ev()
+	case pet.FieldSkillPp:
+		return m.SkillPp()
+	case pet.FieldStatus:
+		return m.Status()
+	case pet.FieldFainted:
+		return m.Fainted()
+	case pet.FieldCollected:
+		return m.Collected()
+	case pet.FieldRoomShow:
+		return m.RoomShow()
+	case pet.FieldLocation:
+		return m.Location()
+	case pet.FieldCreatedAt:
+		return m.CreatedAt()
+	case pet.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PlayerMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearAccount clears the "account" edge to the Account entity.
-func (m *PlayerMutation) ClearAccount() {
-	m.clearedaccount = true
-	m.clearedFields[player.FieldAccountID] = struct{}{}
-}
-
-// AccountCleared reports if the "account" edge to the Account entity was cleared.
-func (m *PlayerMutation) AccountCleared() bool {
-	return m.clearedaccount
+	return nil, false
 }
 
-// AccountIDs returns the "account" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AccountID instead. It exists only for internal usage by the builders.
-func (m *PlayerMutation) AccountIDs() (ids []int) {
-	if id := m.account; id != nil {
-		ids = append(ids, *id)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pet.FieldPlayerID:
+		return m.OldPlayerID(ctx)
+	case pet.FieldSpeciesID:
+		return m.OldSpeciesID(ctx)
+	case pet.FieldLevel:
+		return m.OldLevel(ctx)
+	case pet.FieldExp:
+		return m.OldExp(ctx)
+	case pet.FieldHp:
+		return m.OldHp(ctx)
+	case pet.FieldCatchTime:
+		return m.OldCatchTime(ctx)
+	case pet.FieldDv:
+		return m.OldDv(ctx)
+	case pet.FieldNature:
+		return m.OldNature(ctx)
+	case pet.FieldSkills:
+		return m.OldSkills(ctx)
+	case pet.FieldLearnedSkills:
+		return m.OldLearnedSkills(ctx)
+	case pet.FieldEv:
+		return m.OldEv(ctx)
+	case pet.FieldSkillPp:
+		return m.OldSkillPp(ctx)
+	case pet.FieldStatus:
+		return m.OldStatus(ctx)
+	case pet.FieldFainted:
+		return m.OldFainted(ctx)
+	case pet.FieldCollected:
+		return m.OldCollected(ctx)
+	case pet.FieldRoomShow:
+		return m.OldRoomShow(ctx)
+	case pet.FieldLocation:
+		return m.OldLocation(ctx)
+	case pet.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pet.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return
+	return nil, fmt.Errorf("unknown Pet field %s", name)
 }
 
-// ResetAccount resets all changes to the "account" edge.
-func (m *PlayerMutation) ResetAccount() {
-	m.account = nil
-	m.clearedaccount = false
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldPlayerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerID(v)
+		return nil
+	case pet.FieldSpeciesID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeciesID(v)
+		return nil
+	case pet.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case pet.FieldExp:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExp(v)
+		return nil
+	case pet.FieldHp:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHp(v)
+		return nil
+	case pet.FieldCatchTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCatchTime(v)
+		return nil
+	case pet.FieldDv:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDv(v)
+		return nil
+	case pet.FieldNature:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNature(v)
+		return nil
+	case pet.FieldSkills:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkills(v)
+		return nil
+	case pet.FieldLearnedSkills:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLearnedSkills(v)
+		return nil
+	case pet.FieldEv:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEv(v)
+		return nil
+	case pet.FieldSkillPp:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkillPp(v)
+		return nil
+	case pet.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case pet.FieldFainted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFainted(v)
+		return nil
+	case pet.FieldCollected:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollected(v)
+		return nil
+	case pet.FieldRoomShow:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomShow(v)
+		return nil
+	case pet.FieldLocation:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case pet.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pet.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
 }
 
-// AddPetIDs adds the "pets" edge to the Pet entity by ids.
-func (m *PlayerMutation) AddPetIDs(ids ...int) {
-	if m.pets == nil {
-		m.pets = make(map[int]struct{})
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PetMutation) AddedFields() []string {
+	var fields []string
+	if m.addspecies_id != nil {
+		fields = append(fields, pet.FieldSpeciesID)
 	}
-	for i := range ids {
-		m.pets[ids[i]] = struct{}{}
+	if m.addlevel != nil {
+		fields = append(fields, pet.FieldLevel)
 	}
-}
-
-// ClearPets clears the "pets" edge to the Pet entity.
-func (m *PlayerMutation) ClearPets() {
-	m.clearedpets = true
-}
-
-// PetsCleared reports if the "pets" edge to the Pet entity was cleared.
-func (m *PlayerMutation) PetsCleared() bool {
-	return m.clearedpets
-}
-
-// RemovePetIDs removes the "pets" edge to the Pet entity by IDs.
-func (m *PlayerMutation) RemovePetIDs(ids ...int) {
-	if m.removedpets == nil {
-		m.removedpets = make(map[int]struct{})
+	if m.addexp != nil {
+		fields = append(fields, pet.FieldExp)
 	}
-	for i := range ids {
-		delete(m.pets, ids[i])
-		m.removedpets[ids[i]] = struct{}{}
+	if m.addhp != nil {
+		fields = append(fields, pet.FieldHp)
 	}
-}
-
-// RemovedPets returns the removed IDs of the "pets" edge to the Pet entity.
-func (m *PlayerMutation) RemovedPetsIDs() (ids []int) {
-	for id := range m.removedpets {
-		ids = append(ids, id)
+	if m.addcatch_time != nil {
+		fields = append(fields, pet.FieldCatchTime)
 	}
-	return
+	if m.adddv != nil {
+		fields = append(fields, pet.FieldDv)
+	}
+	if m.addlocation != nil {
+		fields = append(fields, pet.FieldLocation)
+	}
+	return fields
 }
 
-// PetsIDs returns the "pets" edge IDs in the mutation.
-func (m *PlayerMutation) PetsIDs() (ids []int) {
-	for id := range m.pets {
-		ids = append(ids, id)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldSpeciesID:
+		return m.AddedSpeciesID()
+	case pet.FieldLevel:
+		return m.AddedLevel()
+	case pet.FieldExp:
+		return m.AddedExp()
+	case pet.FieldHp:
+		return m.AddedHp()
+	case pet.FieldCatchTime:
+		return m.AddedCatchTime()
+	case pet.FieldDv:
+		return m.AddedDv()
+	case pet.FieldLocation:
+		return m.AddedLocation()
 	}
-	return
+	return nil, false
 }
 
-// ResetPets resets all changes to the "pets" edge.
-func (m *PlayerMutation) ResetPets() {
-	m.pets = nil
-	m.clearedpets = false
-	m.removedpets = nil
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldSpeciesID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpeciesID(v)
+		return nil
+	case pet.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case pet.FieldExp:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExp(v)
+		return nil
+	case pet.FieldHp:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHp(v)
+		return nil
+	case pet.FieldCatchTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCatchTime(v)
+		return nil
+	case pet.FieldDv:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDv(v)
+		return nil
+	case pet.FieldLocation:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLocation(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet numeric field %s", name)
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *PlayerMutation) AddItemIDs(ids ...int) {
-	if m.items == nil {
-		m.items = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
-	}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PetMutation) ClearedFields() []string {
+	return nil
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *PlayerMutation) ClearItems() {
-	m.cleareditems = true
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *PlayerMutation) ItemsCleared() bool {
-	return m.cleareditems
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Pet nullable field %s", name)
 }
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *PlayerMutation) RemoveItemIDs(ids ...int) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PetMutation) ResetField(name string) error {
+	switch name {
+	case pet.FieldPlayerID:
+		m.ResetPlayerID()
+		return nil
+	case pet.FieldSpeciesID:
+		m.ResetSpeciesID()
+		return nil
+	case pet.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case pet.FieldExp:
+		m.ResetExp()
+		return nil
+	case pet.FieldHp:
+		m.ResetHp()
+		return nil
+	case pet.FieldCatchTime:
+		m.ResetCatchTime()
+		return nil
+	case pet.FieldDv:
+		m.ResetDv()
+		return nil
+	case pet.FieldNature:
+		m.ResetNature()
+		return nil
+	case pet.FieldSkills:
+		m.ResetSkills()
+		return nil
+	case pet.FieldLearnedSkills:
+		m.ResetLearnedSkills()
+		return nil
+	case pet.FieldEv:
+		m.ResetEv()
+		return nil
+	case pet.FieldSkillPp:
+		m.ResetSkillPp()
+		return nil
+	case pet.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case pet.FieldFainted:
+		m.ResetFainted()
+		return nil
+	case pet.FieldCollected:
+		m.ResetCollected()
+		return nil
+	case pet.FieldRoomShow:
+		m.ResetRoomShow()
+		return nil
+	case pet.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case pet.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pet.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown Pet field %s", name)
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *PlayerMutation) RemovedItemsIDs() (ids []int) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.player != nil {
+		edges = append(edges, pet.EdgePlayer)
 	}
-	return
+	return edges
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *PlayerMutation) ItemsIDs() (ids []int) {
-	for id := range m.items {
-		ids = append(ids, id)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pet.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return
+	return nil
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *PlayerMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// Where appends a list predicates to the PlayerMutation builder.
-func (m *PlayerMutation) Where(ps ...predicate.Player) {
-	m.predicates = append(m.predicates, ps...)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Player, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplayer {
+		edges = append(edges, pet.EdgePlayer)
 	}
-	m.Where(p...)
+	return edges
 }
 
-// Op returns the operation name.
-func (m *PlayerMutation) Op() Op {
-	return m.op
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pet.EdgePlayer:
+		return m.clearedplayer
+	}
+	return false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PlayerMutation) SetOp(op Op) {
-	m.op = op
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PetMutation) ClearEdge(name string) error {
+	switch name {
+	case pet.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet unique edge %s", name)
 }
 
-// Type returns the node type of this mutation (Player).
-func (m *PlayerMutation) Type() string {
-	return m.typ
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PetMutation) ResetEdge(name string) error {
+	switch name {
+	case pet.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet edge %s", name)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PlayerMutation) Fields() []string {
-	fields := make([]string, 0, 37)
-	if m.account != nil {
-		fields = append(fields, player.FieldAccountID)
-	}
-	if m.nick != nil {
-		fields = append(fields, player.FieldNick)
-	}
-	if m.level != nil {
-		fields = append(fields, player.FieldLevel)
+// PlayerMutation represents an operation that mutates the Player nodes in the graph.
+type PlayerMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	nick                      *string
+	level                     *int
+	addlevel                  *int
+	coins                     *int64
+	addcoins                  *int64
+	gold                      *int64
+	addgold                   *int64
+	map_id                    *int
+	addmap_id                 *int
+	map_type                  *int
+	addmap_type               *int
+	pos_x                     *int
+	addpos_x                  *int
+	pos_y                     *int
+	addpos_y                  *int
+	last_map_id               *int
+	addlast_map_id            *int
+	color                     *int64
+	addcolor                  *int64
+	texture                   *int64
+	addtexture                *int64
+	energy                    *int64
+	addenergy                 *int64
+	fight_badge               *int64
+	addfight_badge            *int64
+	time_today                *int64
+	addtime_today             *int64
+	time_limit                *int64
+	addtime_limit             *int64
+	teacher_id                *int64
+	addteacher_id             *int64
+	student_id                *int64
+	addstudent_id             *int64
+	cur_title                 *int64
+	addcur_title              *int64
+	task_status               *string
+	task_bufs                 *string
+	friends                   *string
+	blacklist                 *string
+	achievements              *string
+	titles                    *string
+	team_info                 *string
+	student_ids               *string
+	room_id                   *int64
+	addroom_id                *int64
+	room_privacy              *int64
+	addroom_privacy           *int64
+	fitments                  *string
+	nono_info                 *string
+	mailbox                   *string
+	boss_clears               *string
+	exp_pool                  *int64
+	addexp_pool               *int64
+	graduation_count          *int64
+	addgraduation_count       *int64
+	incubator                 *string
+	soul_beads                *string
+	item_buffs                *string
+	current_pet_id            *int64
+	addcurrent_pet_id         *int64
+	current_pet_catch_time    *int64
+	addcurrent_pet_catch_time *int64
+	current_pet_dv            *int64
+	addcurrent_pet_dv         *int64
+	last_login_at             *time.Time
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	account                   *int
+	clearedaccount            bool
+	pets                      map[int]struct{}
+	removedpets               map[int]struct{}
+	clearedpets               bool
+	items                     map[int]struct{}
+	removeditems              map[int]struct{}
+	cleareditems              bool
+	pvp_ratings               map[int]struct{}
+	removedpvp_ratings        map[int]struct{}
+	clearedpvp_ratings        bool
+	done                      bool
+	oldValue                  func(context.Context) (*Player, error)
+	predicates                []predicate.Player
+}
+
+var _ ent.Mutation = (*PlayerMutation)(nil)
+
+// playerOption allows management of the mutation configuration using functional options.
+type playerOption func(*PlayerMutation)
+
+// newPlayerMutation creates new mutation for the Player entity.
+func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
+	m := &PlayerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayer,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.coins != nil {
-		fields = append(fields, player.FieldCoins)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.gold != nil {
-		fields = append(fields, player.FieldGold)
+	return m
+}
+
+// withPlayerID sets the ID field of the mutation.
+func withPlayerID(id int) playerOption {
+	return func(m *PlayerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Player
+		)
+		m.oldValue = func(ctx context.Context) (*Player, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Player.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.map_id != nil {
-		fields = append(fields, player.FieldMapID)
+}
+
+// withPlayer sets the old Player of the mutation.
+func withPlayer(node *Player) playerOption {
+	return func(m *PlayerMutation) {
+		m.oldValue = func(context.Context) (*Player, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	if m.map_type != nil {
-		fields = append(fields, player.FieldMapType)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.pos_x != nil {
-		fields = append(fields, player.FieldPosX)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlayerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.pos_y != nil {
-		fields = append(fields, player.FieldPosY)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.last_map_id != nil {
-		fields = append(fields, player.FieldLastMapID)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *PlayerMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *PlayerMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
 	}
-	if m.color != nil {
-		fields = append(fields, player.FieldColor)
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
-	if m.texture != nil {
-		fields = append(fields, player.FieldTexture)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
-	if m.energy != nil {
-		fields = append(fields, player.FieldEnergy)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	if m.fight_badge != nil {
-		fields = append(fields, player.FieldFightBadge)
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *PlayerMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetNick sets the "nick" field.
+func (m *PlayerMutation) SetNick(s string) {
+	m.nick = &s
+}
+
+// Nick returns the value of the "nick" field in the mutation.
+func (m *PlayerMutation) Nick() (r string, exists bool) {
+	v := m.nick
+	if v == nil {
+		return
 	}
-	if m.time_today != nil {
-		fields = append(fields, player.FieldTimeToday)
+	return *v, true
+}
+
+// OldNick returns the old "nick" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldNick(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNick is only allowed on UpdateOne operations")
 	}
-	if m.time_limit != nil {
-		fields = append(fields, player.FieldTimeLimit)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNick requires an ID field in the mutation")
 	}
-	if m.teacher_id != nil {
-		fields = append(fields, player.FieldTeacherID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNick: %w", err)
 	}
-	if m.student_id != nil {
-		fields = append(fields, player.FieldStudentID)
+	return oldValue.Nick, nil
+}
+
+// ResetNick resets all changes to the "nick" field.
+func (m *PlayerMutation) ResetNick() {
+	m.nick = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *PlayerMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *PlayerMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
 	}
-	if m.cur_title != nil {
-		fields = append(fields, player.FieldCurTitle)
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
 	}
-	if m.task_status != nil {
-		fields = append(fields, player.FieldTaskStatus)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
 	}
-	if m.task_bufs != nil {
-		fields = append(fields, player.FieldTaskBufs)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
 	}
-	if m.friends != nil {
-		fields = append(fields, player.FieldFriends)
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *PlayerMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
 	}
-	if m.blacklist != nil {
-		fields = append(fields, player.FieldBlacklist)
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *PlayerMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
 	}
-	if m.achievements != nil {
-		fields = append(fields, player.FieldAchievements)
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *PlayerMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetCoins sets the "coins" field.
+func (m *PlayerMutation) SetCoins(i int64) {
+	m.coins = &i
+	m.addcoins = nil
+}
+
+// Coins returns the value of the "coins" field in the mutation.
+func (m *PlayerMutation) Coins() (r int64, exists bool) {
+	v := m.coins
+	if v == nil {
+		return
 	}
-	if m.titles != nil {
-		fields = append(fields, player.FieldTitles)
+	return *v, true
+}
+
+// OldCoins returns the old "coins" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldCoins(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoins is only allowed on UpdateOne operations")
 	}
-	if m.team_info != nil {
-		fields = append(fields, player.FieldTeamInfo)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoins requires an ID field in the mutation")
 	}
-	if m.student_ids != nil {
-		fields = append(fields, player.FieldStudentIds)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoins: %w", err)
 	}
-	if m.room_id != nil {
-		fields = append(fields, player.FieldRoomID)
+	return oldValue.Coins, nil
+}
+
+// AddCoins adds i to the "coins" field.
+func (m *PlayerMutation) AddCoins(i int64) {
+	if m.addcoins != nil {
+		*m.addcoins += i
+	} else {
+		m.addcoins = &i
 	}
-	if m.fitments != nil {
-		fields = append(fields, player.FieldFitments)
+}
+
+// AddedCoins returns the value that was added to the "coins" field in this mutation.
+func (m *PlayerMutation) AddedCoins() (r int64, exists bool) {
+	v := m.addcoins
+	if v == nil {
+		return
 	}
-	if m.nono_info != nil {
-		fields = append(fields, player.FieldNonoInfo)
+	return *v, true
+}
+
+// ResetCoins resets all changes to the "coins" field.
+func (m *PlayerMutation) ResetCoins() {
+	m.coins = nil
+	m.addcoins = nil
+}
+
+// SetGold sets the "gold" field.
+func (m *PlayerMutation) SetGold(i int64) {
+	m.gold = &i
+	m.addgold = nil
+}
+
+// Gold returns the value of the "gold" field in the mutation.
+func (m *PlayerMutation) Gold() (r int64, exists bool) {
+	v := m.gold
+	if v == nil {
+		return
 	}
-	if m.mailbox != nil {
-		fields = append(fields, player.FieldMailbox)
+	return *v, true
+}
+
+// OldGold returns the old "gold" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldGold(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGold is only allowed on UpdateOne operations")
 	}
-	if m.current_pet_id != nil {
-		fields = append(fields, player.FieldCurrentPetID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGold requires an ID field in the mutation")
 	}
-	if m.current_pet_catch_time != nil {
-		fields = append(fields, player.FieldCurrentPetCatchTime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGold: %w", err)
 	}
-	if m.current_pet_dv != nil {
-		fields = append(fields, player.FieldCurrentPetDv)
+	return oldValue.Gold, nil
+}
+
+// AddGold adds i to the "gold" field.
+func (m *PlayerMutation) AddGold(i int64) {
+	if m.addgold != nil {
+		*m.addgold += i
+	} else {
+		m.addgold = &i
 	}
-	if m.last_login_at != nil {
-		fields = append(fields, player.FieldLastLoginAt)
+}
+
+// AddedGold returns the value that was added to the "gold" field in this mutation.
+func (m *PlayerMutation) AddedGold() (r int64, exists bool) {
+	v := m.addgold
+	if v == nil {
+		return
 	}
-	if m.created_at != nil {
-		fields = append(fields, player.FieldCreatedAt)
+	return *v, true
+}
+
+// ResetGold resets all changes to the "gold" field.
+func (m *PlayerMutation) ResetGold() {
+	m.gold = nil
+	m.addgold = nil
+}
+
+// SetMapID sets the "map_id" field.
+func (m *PlayerMutation) SetMapID(i int) {
+	m.map_id = &i
+	m.addmap_id = nil
+}
+
+// MapID returns the value of the "map_id" field in the mutation.
+func (m *PlayerMutation) MapID() (r int, exists bool) {
+	v := m.map_id
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, player.FieldUpdatedAt)
+	return *v, true
+}
+
+// OldMapID returns the old "map_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldMapID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapID is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapID: %w", err)
+	}
+	return oldValue.MapID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case player.FieldAccountID:
-		return m.AccountID()
-	case player.FieldNick:
-		return m.Nick()
-	case player.FieldLevel:
-		return m.Level()
-	case player.FieldCoins:
-		return m.Coins()
-	case player.FieldGold:
-		return m.Gold()
-	case player.FieldMapID:
-		return m.MapID()
-	case player.FieldMapType:
-		return m.MapType()
-	case player.FieldPosX:
-		return m.PosX()
-	case player.FieldPosY:
-		return m.PosY()
-	case player.FieldLastMapID:
-		return m.LastMapID()
-	case player.FieldColor:
-		return m.Color()
-	case player.FieldTexture:
-		return m.Texture()
-	case player.FieldEnergy:
-		return m.Energy()
-	case player.FieldFightBadge:
-		return m.FightBadge()
-	case player.FieldTimeToday:
-		return m.TimeToday()
-	case player.FieldTimeLimit:
-		return m.TimeLimit()
-	case player.FieldTeacherID:
-		return m.TeacherID()
-	case player.FieldStudentID:
-		return m.StudentID()
-	case player.FieldCurTitle:
-		return m.CurTitle()
-	case player.FieldTaskStatus:
-		return m.TaskStatus()
-	case player.FieldTaskBufs:
-		return m.TaskBufs()
-	case player.FieldFriends:
-		return m.Friends()
-	case player.FieldBlacklist:
-		return m.Blacklist()
-	case player.FieldAchievements:
-		return m.Achievements()
-	case player.FieldTitles:
-		return m.Titles()
-	case player.FieldTeamInfo:
-		return m.TeamInfo()
-	case player.FieldStudentIds:
-		return m.StudentIds()
-	case player.FieldRoomID:
-		return m.RoomID()
-	case player.FieldFitments:
-		return m.Fitments()
-	case player.FieldNonoInfo:
-		return m.NonoInfo()
-	case player.FieldMailbox:
-		return m.Mailbox()
-	case player.FieldCurrentPetID:
-		return m.CurrentPetID()
-	case player.FieldCurrentPetCatchTime:
-		return m.CurrentPetCatchTime()
-	case player.FieldCurrentPetDv:
-		return m.CurrentPetDv()
-	case player.FieldLastLoginAt:
-		return m.LastLoginAt()
-	case player.FieldCreatedAt:
-		return m.CreatedAt()
-	case player.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddMapID adds i to the "map_id" field.
+func (m *PlayerMutation) AddMapID(i int) {
+	if m.addmap_id != nil {
+		*m.addmap_id += i
+	} else {
+		m.addmap_id = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case player.FieldAccountID:
-		return m.OldAccountID(ctx)
-	case player.FieldNick:
-		return m.OldNick(ctx)
-	case player.FieldLevel:
-		return m.OldLevel(ctx)
-	case player.FieldCoins:
-		return m.OldCoins(ctx)
-	case player.FieldGold:
-		return m.OldGold(ctx)
-	case player.FieldMapID:
-		return m.OldMapID(ctx)
-	case player.FieldMapType:
-		return m.OldMapType(ctx)
-	case player.FieldPosX:
-		return m.OldPosX(ctx)
-	case player.FieldPosY:
-		return m.OldPosY(ctx)
-	case player.FieldLastMapID:
-		return m.OldLastMapID(ctx)
-	case player.FieldColor:
-		return m.OldColor(ctx)
-	case player.FieldTexture:
-		return m.OldTexture(ctx)
-	case player.FieldEnergy:
-		return m.OldEnergy(ctx)
-	case player.FieldFightBadge:
-		return m.OldFightBadge(ctx)
-	case player.FieldTimeToday:
-		return m.OldTimeToday(ctx)
-	case player.FieldTimeLimit:
-		return m.OldTimeLimit(ctx)
-	case player.FieldTeacherID:
-		return m.OldTeacherID(ctx)
-	case player.FieldStudentID:
-		return m.OldStudentID(ctx)
-	case player.FieldCurTitle:
-		return m.OldCurTitle(ctx)
-	case player.FieldTaskStatus:
-		return m.OldTaskStatus(ctx)
-	case player.FieldTaskBufs:
-		return m.OldTaskBufs(ctx)
-	case player.FieldFriends:
-		return m.OldFriends(ctx)
-	case player.FieldBlacklist:
-		return m.OldBlacklist(ctx)
-	case player.FieldAchievements:
-		return m.OldAchievements(ctx)
-	case player.FieldTitles:
-		return m.OldTitles(ctx)
-	case player.FieldTeamInfo:
-		return m.OldTeamInfo(ctx)
-	case player.FieldStudentIds:
-		return m.OldStudentIds(ctx)
-	case player.FieldRoomID:
-		return m.OldRoomID(ctx)
-	case player.FieldFitments:
-		return m.OldFitments(ctx)
-	case player.FieldNonoInfo:
-		return m.OldNonoInfo(ctx)
-	case player.FieldMailbox:
-		return m.OldMailbox(ctx)
-	case player.FieldCurrentPetID:
-		return m.OldCurrentPetID(ctx)
-	case player.FieldCurrentPetCatchTime:
-		return m.OldCurrentPetCatchTime(ctx)
-	case player.FieldCurrentPetDv:
-		return m.OldCurrentPetDv(ctx)
-	case player.FieldLastLoginAt:
-		return m.OldLastLoginAt(ctx)
-	case player.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case player.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedMapID returns the value that was added to the "map_id" field in this mutation.
+func (m *PlayerMutation) AddedMapID() (r int, exists bool) {
+	v := m.addmap_id
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Player field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PlayerMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case player.FieldAccountID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccountID(v)
-		return nil
-	case player.FieldNick:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNick(v)
-		return nil
-	case player.FieldLevel:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLevel(v)
-		return nil
-	case player.FieldCoins:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCoins(v)
-		return nil
-	case player.FieldGold:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGold(v)
-		return nil
-	case player.FieldMapID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMapID(v)
-		return nil
-	case player.FieldMapType:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMapType(v)
-		return nil
-	case player.FieldPosX:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPosX(v)
-		return nil
-	case player.FieldPosY:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPosY(v)
-		return nil
-	case player.FieldLastMapID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastMapID(v)
-		return nil
-	case player.FieldColor:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetColor(v)
-		return nil
-	case player.FieldTexture:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTexture(v)
-		return nil
-	case player.FieldEnergy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnergy(v)
+// ResetMapID resets all changes to the "map_id" field.
+func (m *PlayerMutation) ResetMapID() {
+	m.map_id = nil
+	m.addmap_id = nil
+}
+
+// SetMapType sets the "map_type" field.
+func (m *PlayerMutation) SetMapType(i int) {
+	m.map_type = &i
+	m.addmap_type = nil
+}
+
+// MapType returns the value of the "map_type" field in the mutation.
+func (m *PlayerMutation) MapType() (r int, exists bool) {
+	v := m.map_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapType returns the old "map_type" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldMapType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapType: %w", err)
+	}
+	return oldValue.MapType, nil
+}
+
+// AddMapType adds i to the "map_type" field.
+func (m *PlayerMutation) AddMapType(i int) {
+	if m.addmap_type != nil {
+		*m.addmap_type += i
+	} else {
+		m.addmap_type = &i
+	}
+}
+
+// AddedMapType returns the value that was added to the "map_type" field in this mutation.
+func (m *PlayerMutation) AddedMapType() (r int, exists bool) {
+	v := m.addmap_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMapType resets all changes to the "map_type" field.
+func (m *PlayerMutation) ResetMapType() {
+	m.map_type = nil
+	m.addmap_type = nil
+}
+
+// SetPosX sets the "pos_x" field.
+func (m *PlayerMutation) SetPosX(i int) {
+	m.pos_x = &i
+	m.addpos_x = nil
+}
+
+// PosX returns the value of the "pos_x" field in the mutation.
+func (m *PlayerMutation) PosX() (r int, exists bool) {
+	v := m.pos_x
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosX returns the old "pos_x" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldPosX(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosX is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosX requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosX: %w", err)
+	}
+	return oldValue.PosX, nil
+}
+
+// AddPosX adds i to the "pos_x" field.
+func (m *PlayerMutation) AddPosX(i int) {
+	if m.addpos_x != nil {
+		*m.addpos_x += i
+	} else {
+		m.addpos_x = &i
+	}
+}
+
+// AddedPosX returns the value that was added to the "pos_x" field in this mutation.
+func (m *PlayerMutation) AddedPosX() (r int, exists bool) {
+	v := m.addpos_x
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosX resets all changes to the "pos_x" field.
+func (m *PlayerMutation) ResetPosX() {
+	m.pos_x = nil
+	m.addpos_x = nil
+}
+
+// SetPosY sets the "pos_y" field.
+func (m *PlayerMutation) SetPosY(i int) {
+	m.pos_y = &i
+	m.addpos_y = nil
+}
+
+// PosY returns the value of the "pos_y" field in the mutation.
+func (m *PlayerMutation) PosY() (r int, exists bool) {
+	v := m.pos_y
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosY returns the old "pos_y" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldPosY(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosY is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosY requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosY: %w", err)
+	}
+	return oldValue.PosY, nil
+}
+
+// AddPosY adds i to the "pos_y" field.
+func (m *PlayerMutation) AddPosY(i int) {
+	if m.addpos_y != nil {
+		*m.addpos_y += i
+	} else {
+		m.addpos_y = &i
+	}
+}
+
+// AddedPosY returns the value that was added to the "pos_y" field in this mutation.
+func (m *PlayerMutation) AddedPosY() (r int, exists bool) {
+	v := m.addpos_y
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosY resets all changes to the "pos_y" field.
+func (m *PlayerMutation) ResetPosY() {
+	m.pos_y = nil
+	m.addpos_y = nil
+}
+
+// SetLastMapID sets the "last_map_id" field.
+func (m *PlayerMutation) SetLastMapID(i int) {
+	m.last_map_id = &i
+	m.addlast_map_id = nil
+}
+
+// LastMapID returns the value of the "last_map_id" field in the mutation.
+func (m *PlayerMutation) LastMapID() (r int, exists bool) {
+	v := m.last_map_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastMapID returns the old "last_map_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldLastMapID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastMapID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastMapID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastMapID: %w", err)
+	}
+	return oldValue.LastMapID, nil
+}
+
+// AddLastMapID adds i to the "last_map_id" field.
+func (m *PlayerMutation) AddLastMapID(i int) {
+	if m.addlast_map_id != nil {
+		*m.addlast_map_id += i
+	} else {
+		m.addlast_map_id = &i
+	}
+}
+
+// AddedLastMapID returns the value that was added to the "last_map_id" field in this mutation.
+func (m *PlayerMutation) AddedLastMapID() (r int, exists bool) {
+	v := m.addlast_map_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastMapID resets all changes to the "last_map_id" field.
+func (m *PlayerMutation) ResetLastMapID() {
+	m.last_map_id = nil
+	m.addlast_map_id = nil
+}
+
+// SetColor sets the "color" field.
+func (m *PlayerMutation) SetColor(i int64) {
+	m.color = &i
+	m.addcolor = nil
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *PlayerMutation) Color() (r int64, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldColor(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// AddColor adds i to the "color" field.
+func (m *PlayerMutation) AddColor(i int64) {
+	if m.addcolor != nil {
+		*m.addcolor += i
+	} else {
+		m.addcolor = &i
+	}
+}
+
+// AddedColor returns the value that was added to the "color" field in this mutation.
+func (m *PlayerMutation) AddedColor() (r int64, exists bool) {
+	v := m.addcolor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *PlayerMutation) ResetColor() {
+	m.color = nil
+	m.addcolor = nil
+}
+
+// SetTexture sets the "texture" field.
+func (m *PlayerMutation) SetTexture(i int64) {
+	m.texture = &i
+	m.addtexture = nil
+}
+
+// Texture returns the value of the "texture" field in the mutation.
+func (m *PlayerMutation) Texture() (r int64, exists bool) {
+	v := m.texture
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTexture returns the old "texture" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTexture(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTexture is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTexture requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTexture: %w", err)
+	}
+	return oldValue.Texture, nil
+}
+
+// AddTexture adds i to the "texture" field.
+func (m *PlayerMutation) AddTexture(i int64) {
+	if m.addtexture != nil {
+		*m.addtexture += i
+	} else {
+		m.addtexture = &i
+	}
+}
+
+// AddedTexture returns the value that was added to the "texture" field in this mutation.
+func (m *PlayerMutation) AddedTexture() (r int64, exists bool) {
+	v := m.addtexture
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTexture resets all changes to the "texture" field.
+func (m *PlayerMutation) ResetTexture() {
+	m.texture = nil
+	m.addtexture = nil
+}
+
+// SetEnergy sets the "energy" field.
+func (m *PlayerMutation) SetEnergy(i int64) {
+	m.energy = &i
+	m.addenergy = nil
+}
+
+// Energy returns the value of the "energy" field in the mutation.
+func (m *PlayerMutation) Energy() (r int64, exists bool) {
+	v := m.energy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnergy returns the old "energy" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldEnergy(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnergy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnergy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnergy: %w", err)
+	}
+	return oldValue.Energy, nil
+}
+
+// AddEnergy adds i to the "energy" field.
+func (m *PlayerMutation) AddEnergy(i int64) {
+	if m.addenergy != nil {
+		*m.addenergy += i
+	} else {
+		m.addenergy = &i
+	}
+}
+
+// AddedEnergy returns the value that was added to the "energy" field in this mutation.
+func (m *PlayerMutation) AddedEnergy() (r int64, exists bool) {
+	v := m.addenergy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnergy resets all changes to the "energy" field.
+func (m *PlayerMutation) ResetEnergy() {
+	m.energy = nil
+	m.addenergy = nil
+}
+
+// SetFightBadge sets the "fight_badge" field.
+func (m *PlayerMutation) SetFightBadge(i int64) {
+	m.fight_badge = &i
+	m.addfight_badge = nil
+}
+
+// FightBadge returns the value of the "fight_badge" field in the mutation.
+func (m *PlayerMutation) FightBadge() (r int64, exists bool) {
+	v := m.fight_badge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFightBadge returns the old "fight_badge" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldFightBadge(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFightBadge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFightBadge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFightBadge: %w", err)
+	}
+	return oldValue.FightBadge, nil
+}
+
+// AddFightBadge adds i to the "fight_badge" field.
+func (m *PlayerMutation) AddFightBadge(i int64) {
+	if m.addfight_badge != nil {
+		*m.addfight_badge += i
+	} else {
+		m.addfight_badge = &i
+	}
+}
+
+// AddedFightBadge returns the value that was added to the "fight_badge" field in this mutation.
+func (m *PlayerMutation) AddedFightBadge() (r int64, exists bool) {
+	v := m.addfight_badge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFightBadge resets all changes to the "fight_badge" field.
+func (m *PlayerMutation) ResetFightBadge() {
+	m.fight_badge = nil
+	m.addfight_badge = nil
+}
+
+// SetTimeToday sets the "time_today" field.
+func (m *PlayerMutation) SetTimeToday(i int64) {
+	m.time_today = &i
+	m.addtime_today = nil
+}
+
+// TimeToday returns the value of the "time_today" field in the mutation.
+func (m *PlayerMutation) TimeToday() (r int64, exists bool) {
+	v := m.time_today
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeToday returns the old "time_today" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTimeToday(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeToday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeToday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeToday: %w", err)
+	}
+	return oldValue.TimeToday, nil
+}
+
+// AddTimeToday adds i to the "time_today" field.
+func (m *PlayerMutation) AddTimeToday(i int64) {
+	if m.addtime_today != nil {
+		*m.addtime_today += i
+	} else {
+		m.addtime_today = &i
+	}
+}
+
+// AddedTimeToday returns the value that was added to the "time_today" field in this mutation.
+func (m *PlayerMutation) AddedTimeToday() (r int64, exists bool) {
+	v := m.addtime_today
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeToday resets all changes to the "time_today" field.
+func (m *PlayerMutation) ResetTimeToday() {
+	m.time_today = nil
+	m.addtime_today = nil
+}
+
+// SetTimeLimit sets the "time_limit" field.
+func (m *PlayerMutation) SetTimeLimit(i int64) {
+	m.time_limit = &i
+	m.addtime_limit = nil
+}
+
+// TimeLimit returns the value of the "time_limit" field in the mutation.
+func (m *PlayerMutation) TimeLimit() (r int64, exists bool) {
+	v := m.time_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeLimit returns the old "time_limit" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTimeLimit(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeLimit: %w", err)
+	}
+	return oldValue.TimeLimit, nil
+}
+
+// AddTimeLimit adds i to the "time_limit" field.
+func (m *PlayerMutation) AddTimeLimit(i int64) {
+	if m.addtime_limit != nil {
+		*m.addtime_limit += i
+	} else {
+		m.addtime_limit = &i
+	}
+}
+
+// AddedTimeLimit returns the value that was added to the "time_limit" field in this mutation.
+func (m *PlayerMutation) AddedTimeLimit() (r int64, exists bool) {
+	v := m.addtime_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeLimit resets all changes to the "time_limit" field.
+func (m *PlayerMutation) ResetTimeLimit() {
+	m.time_limit = nil
+	m.addtime_limit = nil
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (m *PlayerMutation) SetTeacherID(i int64) {
+	m.teacher_id = &i
+	m.addteacher_id = nil
+}
+
+// TeacherID returns the value of the "teacher_id" field in the mutation.
+func (m *PlayerMutation) TeacherID() (r int64, exists bool) {
+	v := m.teacher_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeacherID returns the old "teacher_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTeacherID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeacherID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeacherID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeacherID: %w", err)
+	}
+	return oldValue.TeacherID, nil
+}
+
+// AddTeacherID adds i to the "teacher_id" field.
+func (m *PlayerMutation) AddTeacherID(i int64) {
+	if m.addteacher_id != nil {
+		*m.addteacher_id += i
+	} else {
+		m.addteacher_id = &i
+	}
+}
+
+// AddedTeacherID returns the value that was added to the "teacher_id" field in this mutation.
+func (m *PlayerMutation) AddedTeacherID() (r int64, exists bool) {
+	v := m.addteacher_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTeacherID resets all changes to the "teacher_id" field.
+func (m *PlayerMutation) ResetTeacherID() {
+	m.teacher_id = nil
+	m.addteacher_id = nil
+}
+
+// SetStudentID sets the "student_id" field.
+func (m *PlayerMutation) SetStudentID(i int64) {
+	m.student_id = &i
+	m.addstudent_id = nil
+}
+
+// StudentID returns the value of the "student_id" field in the mutation.
+func (m *PlayerMutation) StudentID() (r int64, exists bool) {
+	v := m.student_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStudentID returns the old "student_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldStudentID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStudentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
+	}
+	return oldValue.StudentID, nil
+}
+
+// AddStudentID adds i to the "student_id" field.
+func (m *PlayerMutation) AddStudentID(i int64) {
+	if m.addstudent_id != nil {
+		*m.addstudent_id += i
+	} else {
+		m.addstudent_id = &i
+	}
+}
+
+// AddedStudentID returns the value that was added to the "student_id" field in this mutation.
+func (m *PlayerMutation) AddedStudentID() (r int64, exists bool) {
+	v := m.addstudent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStudentID resets all changes to the "student_id" field.
+func (m *PlayerMutation) ResetStudentID() {
+	m.student_id = nil
+	m.addstudent_id = nil
+}
+
+// SetCurTitle sets the "cur_title" field.
+func (m *PlayerMutation) SetCurTitle(i int64) {
+	m.cur_title = &i
+	m.addcur_title = nil
+}
+
+// CurTitle returns the value of the "cur_title" field in the mutation.
+func (m *PlayerMutation) CurTitle() (r int64, exists bool) {
+	v := m.cur_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurTitle returns the old "cur_title" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldCurTitle(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurTitle: %w", err)
+	}
+	return oldValue.CurTitle, nil
+}
+
+// AddCurTitle adds i to the "cur_title" field.
+func (m *PlayerMutation) AddCurTitle(i int64) {
+	if m.addcur_title != nil {
+		*m.addcur_title += i
+	} else {
+		m.addcur_title = &i
+	}
+}
+
+// AddedCurTitle returns the value that was added to the "cur_title" field in this mutation.
+func (m *PlayerMutation) AddedCurTitle() (r int64, exists bool) {
+	v := m.addcur_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurTitle resets all changes to the "cur_title" field.
+func (m *PlayerMutation) ResetCurTitle() {
+	m.cur_title = nil
+	m.addcur_title = nil
+}
+
+// SetTaskStatus sets the "task_status" field.
+func (m *PlayerMutation) SetTaskStatus(s string) {
+	m.task_status = &s
+}
+
+// TaskStatus returns the value of the "task_status" field in the mutation.
+func (m *PlayerMutation) TaskStatus() (r string, exists bool) {
+	v := m.task_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskStatus returns the old "task_status" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTaskStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskStatus: %w", err)
+	}
+	return oldValue.TaskStatus, nil
+}
+
+// ResetTaskStatus resets all changes to the "task_status" field.
+func (m *PlayerMutation) ResetTaskStatus() {
+	m.task_status = nil
+}
+
+// SetTaskBufs sets the "task_bufs" field.
+func (m *PlayerMutation) SetTaskBufs(s string) {
+	m.task_bufs = &s
+}
+
+// TaskBufs returns the value of the "task_bufs" field in the mutation.
+func (m *PlayerMutation) TaskBufs() (r string, exists bool) {
+	v := m.task_bufs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskBufs returns the old "task_bufs" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTaskBufs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskBufs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskBufs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskBufs: %w", err)
+	}
+	return oldValue.TaskBufs, nil
+}
+
+// ResetTaskBufs resets all changes to the "task_bufs" field.
+func (m *PlayerMutation) ResetTaskBufs() {
+	m.task_bufs = nil
+}
+
+// SetFriends sets the "friends" field.
+func (m *PlayerMutation) SetFriends(s string) {
+	m.friends = &s
+}
+
+// Friends returns the value of the "friends" field in the mutation.
+func (m *PlayerMutation) Friends() (r string, exists bool) {
+	v := m.friends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFriends returns the old "friends" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldFriends(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFriends is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFriends requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFriends: %w", err)
+	}
+	return oldValue.Friends, nil
+}
+
+// ResetFriends resets all changes to the "friends" field.
+func (m *PlayerMutation) ResetFriends() {
+	m.friends = nil
+}
+
+// SetBlacklist sets the "blacklist" field.
+func (m *PlayerMutation) SetBlacklist(s string) {
+	m.blacklist = &s
+}
+
+// Blacklist returns the value of the "blacklist" field in the mutation.
+func (m *PlayerMutation) Blacklist() (r string, exists bool) {
+	v := m.blacklist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlacklist returns the old "blacklist" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldBlacklist(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlacklist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlacklist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlacklist: %w", err)
+	}
+	return oldValue.Blacklist, nil
+}
+
+// ResetBlacklist resets all changes to the "blacklist" field.
+func (m *PlayerMutation) ResetBlacklist() {
+	m.blacklist = nil
+}
+
+// SetAchievements sets the "achievements" field.
+func (m *PlayerMutation) SetAchievements(s string) {
+	m.achievements = &s
+}
+
+// Achievements returns the value of the "achievements" field in the mutation.
+func (m *PlayerMutation) Achievements() (r string, exists bool) {
+	v := m.achievements
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAchievements returns the old "achievements" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldAchievements(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAchievements is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAchievements requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAchievements: %w", err)
+	}
+	return oldValue.Achievements, nil
+}
+
+// ResetAchievements resets all changes to the "achievements" field.
+func (m *PlayerMutation) ResetAchievements() {
+	m.achievements = nil
+}
+
+// SetTitles sets the "titles" field.
+func (m *PlayerMutation) SetTitles(s string) {
+	m.titles = &s
+}
+
+// Titles returns the value of the "titles" field in the mutation.
+func (m *PlayerMutation) Titles() (r string, exists bool) {
+	v := m.titles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitles returns the old "titles" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTitles(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitles: %w", err)
+	}
+	return oldValue.Titles, nil
+}
+
+// ResetTitles resets all changes to the "titles" field.
+func (m *PlayerMutation) ResetTitles() {
+	m.titles = nil
+}
+
+// SetTeamInfo sets the "team_info" field.
+func (m *PlayerMutation) SetTeamInfo(s string) {
+	m.team_info = &s
+}
+
+// TeamInfo returns the value of the "team_info" field in the mutation.
+func (m *PlayerMutation) TeamInfo() (r string, exists bool) {
+	v := m.team_info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamInfo returns the old "team_info" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTeamInfo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamInfo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamInfo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamInfo: %w", err)
+	}
+	return oldValue.TeamInfo, nil
+}
+
+// ResetTeamInfo resets all changes to the "team_info" field.
+func (m *PlayerMutation) ResetTeamInfo() {
+	m.team_info = nil
+}
+
+// SetStudentIds sets the "student_ids" field.
+func (m *PlayerMutation) SetStudentIds(s string) {
+	m.student_ids = &s
+}
+
+// StudentIds returns the value of the "student_ids" field in the mutation.
+func (m *PlayerMutation) StudentIds() (r string, exists bool) {
+	v := m.student_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStudentIds returns the old "student_ids" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldStudentIds(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStudentIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStudentIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStudentIds: %w", err)
+	}
+	return oldValue.StudentIds, nil
+}
+
+// ResetStudentIds resets all changes to the "student_ids" field.
+func (m *PlayerMutation) ResetStudentIds() {
+	m.student_ids = nil
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *PlayerMutation) SetRoomID(i int64) {
+	m.room_id = &i
+	m.addroom_id = nil
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *PlayerMutation) RoomID() (r int64, exists bool) {
+	v := m.room_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldRoomID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// AddRoomID adds i to the "room_id" field.
+func (m *PlayerMutation) AddRoomID(i int64) {
+	if m.addroom_id != nil {
+		*m.addroom_id += i
+	} else {
+		m.addroom_id = &i
+	}
+}
+
+// AddedRoomID returns the value that was added to the "room_id" field in this mutation.
+func (m *PlayerMutation) AddedRoomID() (r int64, exists bool) {
+	v := m.addroom_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *PlayerMutation) ResetRoomID() {
+	m.room_id = nil
+	m.addroom_id = nil
+}
+
+// SetRoomPrivacy sets the "room_privacy" field.
+func (m *PlayerMutation) SetRoomPrivacy(i int64) {
+	m.room_privacy = &i
+	m.addroom_privacy = nil
+}
+
+// RoomPrivacy returns the value of the "room_privacy" field in the mutation.
+func (m *PlayerMutation) RoomPrivacy() (r int64, exists bool) {
+	v := m.room_privacy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomPrivacy returns the old "room_privacy" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldRoomPrivacy(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomPrivacy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomPrivacy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomPrivacy: %w", err)
+	}
+	return oldValue.RoomPrivacy, nil
+}
+
+// AddRoomPrivacy adds i to the "room_privacy" field.
+func (m *PlayerMutation) AddRoomPrivacy(i int64) {
+	if m.addroom_privacy != nil {
+		*m.addroom_privacy += i
+	} else {
+		m.addroom_privacy = &i
+	}
+}
+
+// AddedRoomPrivacy returns the value that was added to the "room_privacy" field in this mutation.
+func (m *PlayerMutation) AddedRoomPrivacy() (r int64, exists bool) {
+	v := m.addroom_privacy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoomPrivacy resets all changes to the "room_privacy" field.
+func (m *PlayerMutation) ResetRoomPrivacy() {
+	m.room_privacy = nil
+	m.addroom_privacy = nil
+}
+
+// SetFitments sets the "fitments" field.
+func (m *PlayerMutation) SetFitments(s string) {
+	m.fitments = &s
+}
+
+// Fitments returns the value of the "fitments" field in the mutation.
+func (m *PlayerMutation) Fitments() (r string, exists bool) {
+	v := m.fitments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFitments returns the old "fitments" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldFitments(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFitments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFitments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFitments: %w", err)
+	}
+	return oldValue.Fitments, nil
+}
+
+// ResetFitments resets all changes to the "fitments" field.
+func (m *PlayerMutation) ResetFitments() {
+	m.fitments = nil
+}
+
+// SetNonoInfo sets the "nono_info" field.
+func (m *PlayerMutation) SetNonoInfo(s string) {
+	m.nono_info = &s
+}
+
+// NonoInfo returns the value of the "nono_info" field in the mutation.
+func (m *PlayerMutation) NonoInfo() (r string, exists bool) {
+	v := m.nono_info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNonoInfo returns the old "nono_info" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldNonoInfo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonoInfo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonoInfo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNonoInfo: %w", err)
+	}
+	return oldValue.NonoInfo, nil
+}
+
+// ResetNonoInfo resets all changes to the "nono_info" field.
+func (m *PlayerMutation) ResetNonoInfo() {
+	m.nono_info = nil
+}
+
+// SetMailbox sets the "mailbox" field.
+func (m *PlayerMutation) SetMailbox(s string) {
+	m.mailbox = &s
+}
+
+// Mailbox returns the value of the "mailbox" field in the mutation.
+func (m *PlayerMutation) Mailbox() (r string, exists bool) {
+	v := m.mailbox
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMailbox returns the old "mailbox" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldMailbox(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMailbox is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMailbox requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMailbox: %w", err)
+	}
+	return oldValue.Mailbox, nil
+}
+
+// ResetMailbox resets all changes to the "mailbox" field.
+func (m *PlayerMutation) ResetMailbox() {
+	m.mailbox = nil
+}
+
+// SetBossClears sets the "boss_clears" field.
+func (m *PlayerMutation) SetBossClears(s string) {
+	m.boss_clears = &s
+}
+
+// BossClears returns the value of the "boss_clears" field in the mutation.
+func (m *PlayerMutation) BossClears() (r string, exists bool) {
+	v := m.boss_clears
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBossClears returns the old "boss_clears" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldBossClears(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBossClears is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBossClears requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBossClears: %w", err)
+	}
+	return oldValue.BossClears, nil
+}
+
+// ResetBossClears resets all changes to the "boss_clears" field.
+func (m *PlayerMutation) ResetBossClears() {
+	m.boss_clears = nil
+}
+
+// SetExpPool sets the "exp_pool" field.
+func (m *PlayerMutation) SetExpPool(i int64) {
+	m.exp_pool = &i
+	m.addexp_pool = nil
+}
+
+// ExpPool returns the value of the "exp_pool" field in the mutation.
+func (m *PlayerMutation) ExpPool() (r int64, exists bool) {
+	v := m.exp_pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpPool returns the old "exp_pool" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldExpPool(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpPool is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpPool requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpPool: %w", err)
+	}
+	return oldValue.ExpPool, nil
+}
+
+// AddExpPool adds i to the "exp_pool" field.
+func (m *PlayerMutation) AddExpPool(i int64) {
+	if m.addexp_pool != nil {
+		*m.addexp_pool += i
+	} else {
+		m.addexp_pool = &i
+	}
+}
+
+// AddedExpPool returns the value that was added to the "exp_pool" field in this mutation.
+func (m *PlayerMutation) AddedExpPool() (r int64, exists bool) {
+	v := m.addexp_pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpPool resets all changes to the "exp_pool" field.
+func (m *PlayerMutation) ResetExpPool() {
+	m.exp_pool = nil
+	m.addexp_pool = nil
+}
+
+// SetGraduationCount sets the "graduation_count" field.
+func (m *PlayerMutation) SetGraduationCount(i int64) {
+	m.graduation_count = &i
+	m.addgraduation_count = nil
+}
+
+// GraduationCount returns the value of the "graduation_count" field in the mutation.
+func (m *PlayerMutation) GraduationCount() (r int64, exists bool) {
+	v := m.graduation_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGraduationCount returns the old "graduation_count" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldGraduationCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGraduationCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGraduationCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGraduationCount: %w", err)
+	}
+	return oldValue.GraduationCount, nil
+}
+
+// AddGraduationCount adds i to the "graduation_count" field.
+func (m *PlayerMutation) AddGraduationCount(i int64) {
+	if m.addgraduation_count != nil {
+		*m.addgraduation_count += i
+	} else {
+		m.addgraduation_count = &i
+	}
+}
+
+// AddedGraduationCount returns the value that was added to the "graduation_count" field in this mutation.
+func (m *PlayerMutation) AddedGraduationCount() (r int64, exists bool) {
+	v := m.addgraduation_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGraduationCount resets all changes to the "graduation_count" field.
+func (m *PlayerMutation) ResetGraduationCount() {
+	m.graduation_count = nil
+	m.addgraduation_count = nil
+}
+
+// SetIncubator sets the "incubator" field.
+func (m *PlayerMutation) SetIncubator(s string) {
+	m.incubator = &s
+}
+
+// Incubator returns the value of the "incubator" field in the mutation.
+func (m *PlayerMutation) Incubator() (r string, exists bool) {
+	v := m.incubator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncubator returns the old "incubator" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldIncubator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncubator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncubator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncubator: %w", err)
+	}
+	return oldValue.Incubator, nil
+}
+
+// ResetIncubator resets all changes to the "incubator" field.
+func (m *PlayerMutation) ResetIncubator() {
+	m.incubator = nil
+}
+
+// SetSoulBeads sets the "soul_beads" field.
+func (m *PlayerMutation) SetSoulBeads(s string) {
+	m.soul_beads = &s
+}
+
+// SoulBeads returns the value of the "soul_beads" field in the mutation.
+func (m *PlayerMutation) SoulBeads() (r string, exists bool) {
+	v := m.soul_beads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoulBeads returns the old "soul_beads" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldSoulBeads(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoulBeads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoulBeads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoulBeads: %w", err)
+	}
+	return oldValue.SoulBeads, nil
+}
+
+// ResetSoulBeads resets all changes to the "soul_beads" field.
+func (m *PlayerMutation) ResetSoulBeads() {
+	m.soul_beads = nil
+}
+
+// SetItemBuffs sets the "item_buffs" field.
+func (m *PlayerMutation) SetItemBuffs(s string) {
+	m.item_buffs = &s
+}
+
+// ItemBuffs returns the value of the "item_buffs" field in the mutation.
+func (m *PlayerMutation) ItemBuffs() (r string, exists bool) {
+	v := m.item_buffs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemBuffs returns the old "item_buffs" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldItemBuffs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemBuffs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemBuffs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemBuffs: %w", err)
+	}
+	return oldValue.ItemBuffs, nil
+}
+
+// ResetItemBuffs resets all changes to the "item_buffs" field.
+func (m *PlayerMutation) ResetItemBuffs() {
+	m.item_buffs = nil
+}
+
+// SetCurrentPetID sets the "current_pet_id" field.
+func (m *PlayerMutation) SetCurrentPetID(i int64) {
+	m.current_pet_id = &i
+	m.addcurrent_pet_id = nil
+}
+
+// CurrentPetID returns the value of the "current_pet_id" field in the mutation.
+func (m *PlayerMutation) CurrentPetID() (r int64, exists bool) {
+	v := m.current_pet_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentPetID returns the old "current_pet_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldCurrentPetID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentPetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentPetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentPetID: %w", err)
+	}
+	return oldValue.CurrentPetID, nil
+}
+
+// AddCurrentPetID adds i to the "current_pet_id" field.
+func (m *PlayerMutation) AddCurrentPetID(i int64) {
+	if m.addcurrent_pet_id != nil {
+		*m.addcurrent_pet_id += i
+	} else {
+		m.addcurrent_pet_id = &i
+	}
+}
+
+// AddedCurrentPetID returns the value that was added to the "current_pet_id" field in this mutation.
+func (m *PlayerMutation) AddedCurrentPetID() (r int64, exists bool) {
+	v := m.addcurrent_pet_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentPetID resets all changes to the "current_pet_id" field.
+func (m *PlayerMutation) ResetCurrentPetID() {
+	m.current_pet_id = nil
+	m.addcurrent_pet_id = nil
+}
+
+// SetCurrentPetCatchTime sets the "current_pet_catch_time" field.
+func (m *PlayerMutation) SetCurrentPetCatchTime(i int64) {
+	m.current_pet_catch_time = &i
+	m.addcurrent_pet_catch_time = nil
+}
+
+// CurrentPetCatchTime returns the value of the "current_pet_catch_time" field in the mutation.
+func (m *PlayerMutation) CurrentPetCatchTime() (r int64, exists bool) {
+	v := m.current_pet_catch_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentPetCatchTime returns the old "current_pet_catch_time" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldCurrentPetCatchTime(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentPetCatchTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentPetCatchTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentPetCatchTime: %w", err)
+	}
+	return oldValue.CurrentPetCatchTime, nil
+}
+
+// AddCurrentPetCatchTime adds i to the "current_pet_catch_time" field.
+func (m *PlayerMutation) AddCurrentPetCatchTime(i int64) {
+	if m.addcurrent_pet_catch_time != nil {
+		*m.addcurrent_pet_catch_time += i
+	} else {
+		m.addcurrent_pet_catch_time = &i
+	}
+}
+
+// AddedCurrentPetCatchTime returns the value that was added to the "current_pet_catch_time" field in this mutation.
+func (m *PlayerMutation) AddedCurrentPetCatchTime() (r int64, exists bool) {
+	v := m.addcurrent_pet_catch_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentPetCatchTime resets all changes to the "current_pet_catch_time" field.
+func (m *PlayerMutation) ResetCurrentPetCatchTime() {
+	m.current_pet_catch_time = nil
+	m.addcurrent_pet_catch_time = nil
+}
+
+// SetCurrentPetDv sets the "current_pet_dv" field.
+func (m *PlayerMutation) SetCurrentPetDv(i int64) {
+	m.current_pet_dv = &i
+	m.addcurrent_pet_dv = nil
+}
+
+// CurrentPetDv returns the value of the "current_pet_dv" field in the mutation.
+func (m *PlayerMutation) CurrentPetDv() (r int64, exists bool) {
+	v := m.current_pet_dv
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentPetDv returns the old "current_pet_dv" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldCurrentPetDv(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentPetDv is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentPetDv requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentPetDv: %w", err)
+	}
+	return oldValue.CurrentPetDv, nil
+}
+
+// AddCurrentPetDv adds i to the "current_pet_dv" field.
+func (m *PlayerMutation) AddCurrentPetDv(i int64) {
+	if m.addcurrent_pet_dv != nil {
+		*m.addcurrent_pet_dv += i
+	} else {
+		m.addcurrent_pet_dv = &i
+	}
+}
+
+// AddedCurrentPetDv returns the value that was added to the "current_pet_dv" field in this mutation.
+func (m *PlayerMutation) AddedCurrentPetDv() (r int64, exists bool) {
+	v := m.addcurrent_pet_dv
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentPetDv resets all changes to the "current_pet_dv" field.
+func (m *PlayerMutation) ResetCurrentPetDv() {
+	m.current_pet_dv = nil
+	m.addcurrent_pet_dv = nil
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *PlayerMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *PlayerMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLoginAt returns the old "last_login_at" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldLastLoginAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	}
+	return oldValue.LastLoginAt, nil
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *PlayerMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[player.FieldLastLoginAt] = struct{}{}
+}
+
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *PlayerMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[player.FieldLastLoginAt]
+	return ok
+}
+
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *PlayerMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, player.FieldLastLoginAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlayerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlayerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlayerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlayerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlayerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlayerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *PlayerMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[player.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *PlayerMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *PlayerMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by ids.
+func (m *PlayerMutation) AddPetIDs(ids ...int) {
+	if m.pets == nil {
+		m.pets = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPets clears the "pets" edge to the Pet entity.
+func (m *PlayerMutation) ClearPets() {
+	m.clearedpets = true
+}
+
+// PetsCleared reports if the "pets" edge to the Pet entity was cleared.
+func (m *PlayerMutation) PetsCleared() bool {
+	return m.clearedpets
+}
+
+// RemovePetIDs removes the "pets" edge to the Pet entity by IDs.
+func (m *PlayerMutation) RemovePetIDs(ids ...int) {
+	if m.removedpets == nil {
+		m.removedpets = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pets, ids[i])
+		m.removedpets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPets returns the removed IDs of the "pets" edge to the Pet entity.
+func (m *PlayerMutation) RemovedPetsIDs() (ids []int) {
+	for id := range m.removedpets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PetsIDs returns the "pets" edge IDs in the mutation.
+func (m *PlayerMutation) PetsIDs() (ids []int) {
+	for id := range m.pets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPets resets all changes to the "pets" edge.
+func (m *PlayerMutation) ResetPets() {
+	m.pets = nil
+	m.clearedpets = false
+	m.removedpets = nil
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *PlayerMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *PlayerMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *PlayerMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *PlayerMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *PlayerMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *PlayerMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *PlayerMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// AddPvpRatingIDs adds the "pvp_ratings" edge to the PvpRating entity by ids.
+func (m *PlayerMutation) AddPvpRatingIDs(ids ...int) {
+	if m.pvp_ratings == nil {
+		m.pvp_ratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pvp_ratings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPvpRatings clears the "pvp_ratings" edge to the PvpRating entity.
+func (m *PlayerMutation) ClearPvpRatings() {
+	m.clearedpvp_ratings = true
+}
+
+// PvpRatingsCleared reports if the "pvp_ratings" edge to the PvpRating entity was cleared.
+func (m *PlayerMutation) PvpRatingsCleared() bool {
+	return m.clearedpvp_ratings
+}
+
+// RemovePvpRatingIDs removes the "pvp_ratings" edge to the PvpRating entity by IDs.
+func (m *PlayerMutation) RemovePvpRatingIDs(ids ...int) {
+	if m.removedpvp_ratings == nil {
+		m.removedpvp_ratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pvp_ratings, ids[i])
+		m.removedpvp_ratings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPvpRatings returns the removed IDs of the "pvp_ratings" edge to the PvpRating entity.
+func (m *PlayerMutation) RemovedPvpRatingsIDs() (ids []int) {
+	for id := range m.removedpvp_ratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PvpRatingsIDs returns the "pvp_ratings" edge IDs in the mutation.
+func (m *PlayerMutation) PvpRatingsIDs() (ids []int) {
+	for id := range m.pvp_ratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPvpRatings resets all changes to the "pvp_ratings" edge.
+func (m *PlayerMutation) ResetPvpRatings() {
+	m.pvp_ratings = nil
+	m.clearedpvp_ratings = false
+	m.removedpvp_ratings = nil
+}
+
+// Where appends a list predicates to the PlayerMutation builder.
+func (m *PlayerMutation) Where(ps ...predicate.Player) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Player, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlayerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlayerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Player).
+func (m *PlayerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerMutation) Fields() []string {
+	fields := make([]string, 0, 44)
+	if m.account != nil {
+		fields = append(fields, player.FieldAccountID)
+	}
+	if m.nick != nil {
+		fields = append(fields, player.FieldNick)
+	}
+	if m.level != nil {
+		fields = append(fields, player.FieldLevel)
+	}
+	if m.coins != nil {
+		fields = append(fields, player.FieldCoins)
+	}
+	if m.gold != nil {
+		fields = append(fields, player.FieldGold)
+	}
+	if m.map_id != nil {
+		fields = append(fields, player.FieldMapID)
+	}
+	if m.map_type != nil {
+		fields = append(fields, player.FieldMapType)
+	}
+	if m.pos_x != nil {
+		fields = append(fields, player.FieldPosX)
+	}
+	if m.pos_y != nil {
+		fields = append(fields, player.FieldPosY)
+	}
+	if m.last_map_id != nil {
+		fields = append(fields, player.FieldLastMapID)
+	}
+	if m.color != nil {
+		fields = append(fields, player.FieldColor)
+	}
+	if m.texture != nil {
+		fields = append(fields, player.FieldTexture)
+	}
+	if m.energy != nil {
+		fields = append(fields, player.FieldEnergy)
+	}
+	if m.fight_badge != nil {
+		fields = append(fields, player.FieldFightBadge)
+	}
+	if m.time_today != nil {
+		fields = append(fields, player.FieldTimeToday)
+	}
+	if m.time_limit != nil {
+		fields = append(fields, player.FieldTimeLimit)
+	}
+	if m.teacher_id != nil {
+		fields = append(fields, player.FieldTeacherID)
+	}
+	if m.student_id != nil {
+		fields = append(fields, player.FieldStudentID)
+	}
+	if m.cur_title != nil {
+		fields = append(fields, player.FieldCurTitle)
+	}
+	if m.task_status != nil {
+		fields = append(fields, player.FieldTaskStatus)
+	}
+	if m.task_bufs != nil {
+		fields = append(fields, player.FieldTaskBufs)
+	}
+	if m.friends != nil {
+		fields = append(fields, player.FieldFriends)
+	}
+	if m.blacklist != nil {
+		fields = append(fields, player.FieldBlacklist)
+	}
+	if m.achievements != nil {
+		fields = append(fields, player.FieldAchievements)
+	}
+	if m.titles != nil {
+		fields = append(fields, player.FieldTitles)
+	}
+	if m.team_info != nil {
+		fields = append(fields, player.FieldTeamInfo)
+	}
+	if m.student_ids != nil {
+		fields = append(fields, player.FieldStudentIds)
+	}
+	if m.room_id != nil {
+		fields = append(fields, player.FieldRoomID)
+	}
+	if m.room_privacy != nil {
+		fields = append(fields, player.FieldRoomPrivacy)
+	}
+	if m.fitments != nil {
+		fields = append(fields, player.FieldFitments)
+	}
+	if m.nono_info != nil {
+		fields = append(fields, player.FieldNonoInfo)
+	}
+	if m.mailbox != nil {
+		fields = append(fields, player.FieldMailbox)
+	}
+	if m.boss_clears != nil {
+		fields = append(fields, player.FieldBossClears)
+	}
+	if m.exp_pool != nil {
+		fields = append(fields, player.FieldExpPool)
+	}
+	if m.graduation_count != nil {
+		fields = append(fields, player.FieldGraduationCount)
+	}
+	if m.incubator != nil {
+		fields = append(fields, player.FieldIncubator)
+	}
+	if m.soul_beads != nil {
+		fields = append(fields, player.FieldSoulBeads)
+	}
+	if m.item_buffs != nil {
+		fields = append(fields, player.FieldItemBuffs)
+	}
+	if m.current_pet_id != nil {
+		fields = append(fields, player.FieldCurrentPetID)
+	}
+	if m.current_pet_catch_time != nil {
+		fields = append(fields, player.FieldCurrentPetCatchTime)
+	}
+	if m.current_pet_dv != nil {
+		fields = append(fields, player.FieldCurrentPetDv)
+	}
+	if m.last_login_at != nil {
+		fields = append(fields, player.FieldLastLoginAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, player.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, player.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldAccountID:
+		return m.AccountID()
+	case player.FieldNick:
+		return m.Nick()
+	case player.FieldLevel:
+		return m.Level()
+	case player.FieldCoins:
+		return m.Coins()
+	case player.FieldGold:
+		return m.Gold()
+	case player.FieldMapID:
+		return m.MapID()
+	case player.FieldMapType:
+		return m.MapType()
+	case player.FieldPosX:
+		return m.PosX()
+	case player.FieldPosY:
+		return m.PosY()
+	case player.FieldLastMapID:
+		return m.LastMapID()
+	case player.FieldColor:
+		return m.Color()
+	case player.FieldTexture:
+		return m.Texture()
+	case player.FieldEnergy:
+		return m.Energy()
+	case player.FieldFightBadge:
+		return m.FightBadge()
+	case player.FieldTimeToday:
+		return m.TimeToday()
+	case player.FieldTimeLimit:
+		return m.TimeLimit()
+	case player.FieldTeacherID:
+		return m.TeacherID()
+	case player.FieldStudentID:
+		return m.StudentID()
+	case player.FieldCurTitle:
+		return m.CurTitle()
+	case player.FieldTaskStatus:
+		return m.TaskStatus()
+	case player.FieldTaskBufs:
+		return m.TaskBufs()
+	case player.FieldFriends:
+		return m.Friends()
+	case player.FieldBlacklist:
+		return m.Blacklist()
+	case player.FieldAchievements:
+		return m.Achievements()
+	case player.FieldTitles:
+		return m.Titles()
+	case player.FieldTeamInfo:
+		return m.TeamInfo()
+	case player.FieldStudentIds:
+		return m.StudentIds()
+	case player.FieldRoomID:
+		return m.RoomID()
+	case player.FieldRoomPrivacy:
+		return m.RoomPrivacy()
+	case player.FieldFitments:
+		return m.Fitments()
+	case player.FieldNonoInfo:
+		return m.NonoInfo()
+	case player.FieldMailbox:
+		return m.Mailbox()
+	case player.FieldBossClears:
+		return m.BossClears()
+	case player.FieldExpPool:
+		return m.ExpPool()
+	case player.FieldGraduationCount:
+		return m.GraduationCount()
+	case player.FieldIncubator:
+		return m.Incubator()
+	case player.FieldSoulBeads:
+		return m.SoulBeads()
+	case player.FieldItemBuffs:
+		return m.ItemBuffs()
+	case player.FieldCurrentPetID:
+		return m.CurrentPetID()
+	case player.FieldCurrentPetCatchTime:
+		return m.CurrentPetCatchTime()
+	case player.FieldCurrentPetDv:
+		return m.CurrentPetDv()
+	case player.FieldLastLoginAt:
+		return m.LastLoginAt()
+	case player.FieldCreatedAt:
+		return m.CreatedAt()
+	case player.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case player.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case player.FieldNick:
+		return m.OldNick(ctx)
+	case player.FieldLevel:
+		return m.OldLevel(ctx)
+	case player.FieldCoins:
+		return m.OldCoins(ctx)
+	case player.FieldGold:
+		return m.OldGold(ctx)
+	case player.FieldMapID:
+		return m.OldMapID(ctx)
+	case player.FieldMapType:
+		return m.OldMapType(ctx)
+	case player.FieldPosX:
+		return m.OldPosX(ctx)
+	case player.FieldPosY:
+		return m.OldPosY(ctx)
+	case player.FieldLastMapID:
+		return m.OldLastMapID(ctx)
+	case player.FieldColor:
+		return m.OldColor(ctx)
+	case player.FieldTexture:
+		return m.OldTexture(ctx)
+	case player.FieldEnergy:
+		return m.OldEnergy(ctx)
+	case player.FieldFightBadge:
+		return m.OldFightBadge(ctx)
+	case player.FieldTimeToday:
+		return m.OldTimeToday(ctx)
+	case player.FieldTimeLimit:
+		return m.OldTimeLimit(ctx)
+	case player.FieldTeacherID:
+		return m.OldTeacherID(ctx)
+	case player.FieldStudentID:
+		return m.OldStudentID(ctx)
+	case player.FieldCurTitle:
+		return m.OldCurTitle(ctx)
+	case player.FieldTaskStatus:
+		return m.OldTaskStatus(ctx)
+	case player.FieldTaskBufs:
+		return m.OldTaskBufs(ctx)
+	case player.FieldFriends:
+		return m.OldFriends(ctx)
+	case player.FieldBlacklist:
+		return m.OldBlacklist(ctx)
+	case player.FieldAchievements:
+		return m.OldAchievements(ctx)
+	case player.FieldTitles:
+		return m.OldTitles(ctx)
+	case player.FieldTeamInfo:
+		return m.OldTeamInfo(ctx)
+	case player.FieldStudentIds:
+		return m.OldStudentIds(ctx)
+	case player.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case player.FieldRoomPrivacy:
+		return m.OldRoomPrivacy(ctx)
+	case player.FieldFitments:
+		return m.OldFitments(ctx)
+	case player.FieldNonoInfo:
+		return m.OldNonoInfo(ctx)
+	case player.FieldMailbox:
+		return m.OldMailbox(ctx)
+	case player.FieldBossClears:
+		return m.OldBossClears(ctx)
+	case player.FieldExpPool:
+		return m.OldExpPool(ctx)
+	case player.FieldGraduationCount:
+		return m.OldGraduationCount(ctx)
+	case player.FieldIncubator:
+		return m.OldIncubator(ctx)
+	case player.FieldSoulBeads:
+		return m.OldSoulBeads(ctx)
+	case player.FieldItemBuffs:
+		return m.OldItemBuffs(ctx)
+	case player.FieldCurrentPetID:
+		return m.OldCurrentPetID(ctx)
+	case player.FieldCurrentPetCatchTime:
+		return m.OldCurrentPetCatchTime(ctx)
+	case player.FieldCurrentPetDv:
+		return m.OldCurrentPetDv(ctx)
+	case player.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
+	case player.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case player.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Player field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case player.FieldNick:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNick(v)
+		return nil
+	case player.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case player.FieldCoins:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoins(v)
+		return nil
+	case player.FieldGold:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGold(v)
+		return nil
+	case player.FieldMapID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapID(v)
+		return nil
+	case player.FieldMapType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapType(v)
+		return nil
+	case player.FieldPosX:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosX(v)
+		return nil
+	case player.FieldPosY:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosY(v)
+		return nil
+	case player.FieldLastMapID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastMapID(v)
+		return nil
+	case player.FieldColor:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case player.FieldTexture:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTexture(v)
+		return nil
+	case player.FieldEnergy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnergy(v)
+		return nil
+	case player.FieldFightBadge:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFightBadge(v)
+		return nil
+	case player.FieldTimeToday:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeToday(v)
+		return nil
+	case player.FieldTimeLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeLimit(v)
+		return nil
+	case player.FieldTeacherID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeacherID(v)
+		return nil
+	case player.FieldStudentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStudentID(v)
+		return nil
+	case player.FieldCurTitle:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurTitle(v)
+		return nil
+	case player.FieldTaskStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskStatus(v)
+		return nil
+	case player.FieldTaskBufs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskBufs(v)
+		return nil
+	case player.FieldFriends:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFriends(v)
+		return nil
+	case player.FieldBlacklist:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlacklist(v)
+		return nil
+	case player.FieldAchievements:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAchievements(v)
+		return nil
+	case player.FieldTitles:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitles(v)
+		return nil
+	case player.FieldTeamInfo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamInfo(v)
+		return nil
+	case player.FieldStudentIds:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStudentIds(v)
+		return nil
+	case player.FieldRoomID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case player.FieldRoomPrivacy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomPrivacy(v)
+		return nil
+	case player.FieldFitments:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFitments(v)
+		return nil
+	case player.FieldNonoInfo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNonoInfo(v)
+		return nil
+	case player.FieldMailbox:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMailbox(v)
+		return nil
+	case player.FieldBossClears:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBossClears(v)
+		return nil
+	case player.FieldExpPool:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpPool(v)
+		return nil
+	case player.FieldGraduationCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGraduationCount(v)
+		return nil
+	case player.FieldIncubator:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncubator(v)
+		return nil
+	case player.FieldSoulBeads:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoulBeads(v)
+		return nil
+	case player.FieldItemBuffs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemBuffs(v)
+		return nil
+	case player.FieldCurrentPetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentPetID(v)
+		return nil
+	case player.FieldCurrentPetCatchTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentPetCatchTime(v)
+		return nil
+	case player.FieldCurrentPetDv:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentPetDv(v)
+		return nil
+	case player.FieldLastLoginAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLoginAt(v)
+		return nil
+	case player.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case player.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerMutation) AddedFields() []string {
+	var fields []string
+	if m.addlevel != nil {
+		fields = append(fields, player.FieldLevel)
+	}
+	if m.addcoins != nil {
+		fields = append(fields, player.FieldCoins)
+	}
+	if m.addgold != nil {
+		fields = append(fields, player.FieldGold)
+	}
+	if m.addmap_id != nil {
+		fields = append(fields, player.FieldMapID)
+	}
+	if m.addmap_type != nil {
+		fields = append(fields, player.FieldMapType)
+	}
+	if m.addpos_x != nil {
+		fields = append(fields, player.FieldPosX)
+	}
+	if m.addpos_y != nil {
+		fields = append(fields, player.FieldPosY)
+	}
+	if m.addlast_map_id != nil {
+		fields = append(fields, player.FieldLastMapID)
+	}
+	if m.addcolor != nil {
+		fields = append(fields, player.FieldColor)
+	}
+	if m.addtexture != nil {
+		fields = append(fields, player.FieldTexture)
+	}
+	if m.addenergy != nil {
+		fields = append(fields, player.FieldEnergy)
+	}
+	if m.addfight_badge != nil {
+		fields = append(fields, player.FieldFightBadge)
+	}
+	if m.addtime_today != nil {
+		fields = append(fields, player.FieldTimeToday)
+	}
+	if m.addtime_limit != nil {
+		fields = append(fields, player.FieldTimeLimit)
+	}
+	if m.addteacher_id != nil {
+		fields = append(fields, player.FieldTeacherID)
+	}
+	if m.addstudent_id != nil {
+		fields = append(fields, player.FieldStudentID)
+	}
+	if m.addcur_title != nil {
+		fields = append(fields, player.FieldCurTitle)
+	}
+	if m.addroom_id != nil {
+		fields = append(fields, player.FieldRoomID)
+	}
+	if m.addroom_privacy != nil {
+		fields = append(fields, player.FieldRoomPrivacy)
+	}
+	if m.addexp_pool != nil {
+		fields = append(fields, player.FieldExpPool)
+	}
+	if m.addgraduation_count != nil {
+		fields = append(fields, player.FieldGraduationCount)
+	}
+	if m.addcurrent_pet_id != nil {
+		fields = append(fields, player.FieldCurrentPetID)
+	}
+	if m.addcurrent_pet_catch_time != nil {
+		fields = append(fields, player.FieldCurrentPetCatchTime)
+	}
+	if m.addcurrent_pet_dv != nil {
+		fields = append(fields, player.FieldCurrentPetDv)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldLevel:
+		return m.AddedLevel()
+	case player.FieldCoins:
+		return m.AddedCoins()
+	case player.FieldGold:
+		return m.AddedGold()
+	case player.FieldMapID:
+		return m.AddedMapID()
+	case player.FieldMapType:
+		return m.AddedMapType()
+	case player.FieldPosX:
+		return m.AddedPosX()
+	case player.FieldPosY:
+		return m.AddedPosY()
+	case player.FieldLastMapID:
+		return m.AddedLastMapID()
+	case player.FieldColor:
+		return m.AddedColor()
+	case player.FieldTexture:
+		return m.AddedTexture()
+	case player.FieldEnergy:
+		return m.AddedEnergy()
+	case player.FieldFightBadge:
+		return m.AddedFightBadge()
+	case player.FieldTimeToday:
+		return m.AddedTimeToday()
+	case player.FieldTimeLimit:
+		return m.AddedTimeLimit()
+	case player.FieldTeacherID:
+		return m.AddedTeacherID()
+	case player.FieldStudentID:
+		return m.AddedStudentID()
+	case player.FieldCurTitle:
+		return m.AddedCurTitle()
+	case player.FieldRoomID:
+		return m.AddedRoomID()
+	case player.FieldRoomPrivacy:
+		return m.AddedRoomPrivacy()
+	case player.FieldExpPool:
+		return m.AddedExpPool()
+	case player.FieldGraduationCount:
+		return m.AddedGraduationCount()
+	case player.FieldCurrentPetID:
+		return m.AddedCurrentPetID()
+	case player.FieldCurrentPetCatchTime:
+		return m.AddedCurrentPetCatchTime()
+	case player.FieldCurrentPetDv:
+		return m.AddedCurrentPetDv()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case player.FieldCoins:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCoins(v)
+		return nil
+	case player.FieldGold:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGold(v)
+		return nil
+	case player.FieldMapID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMapID(v)
+		return nil
+	case player.FieldMapType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMapType(v)
+		return nil
+	case player.FieldPosX:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosX(v)
+		return nil
+	case player.FieldPosY:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosY(v)
+		return nil
+	case player.FieldLastMapID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastMapID(v)
+		return nil
+	case player.FieldColor:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColor(v)
+		return nil
+	case player.FieldTexture:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTexture(v)
+		return nil
+	case player.FieldEnergy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnergy(v)
+		return nil
+	case player.FieldFightBadge:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFightBadge(v)
+		return nil
+	case player.FieldTimeToday:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeToday(v)
+		return nil
+	case player.FieldTimeLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeLimit(v)
+		return nil
+	case player.FieldTeacherID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTeacherID(v)
+		return nil
+	case player.FieldStudentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStudentID(v)
+		return nil
+	case player.FieldCurTitle:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurTitle(v)
+		return nil
+	case player.FieldRoomID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoomID(v)
+		return nil
+	case player.FieldRoomPrivacy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoomPrivacy(v)
+		return nil
+	case player.FieldExpPool:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpPool(v)
+		return nil
+	case player.FieldGraduationCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGraduationCount(v)
+		return nil
+	case player.FieldCurrentPetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentPetID(v)
+		return nil
+	case player.FieldCurrentPetCatchTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentPetCatchTime(v)
+		return nil
+	case player.FieldCurrentPetDv:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentPetDv(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(player.FieldLastLoginAt) {
+		fields = append(fields, player.FieldLastLoginAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerMutation) ClearField(name string) error {
+	switch name {
+	case player.FieldLastLoginAt:
+		m.ClearLastLoginAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Player nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerMutation) ResetField(name string) error {
+	switch name {
+	case player.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case player.FieldNick:
+		m.ResetNick()
+		return nil
+	case player.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case player.FieldCoins:
+		m.ResetCoins()
+		return nil
+	case player.FieldGold:
+		m.ResetGold()
+		return nil
+	case player.FieldMapID:
+		m.ResetMapID()
+		return nil
+	case player.FieldMapType:
+		m.ResetMapType()
+		return nil
+	case player.FieldPosX:
+		m.ResetPosX()
+		return nil
+	case player.FieldPosY:
+		m.ResetPosY()
+		return nil
+	case player.FieldLastMapID:
+		m.ResetLastMapID()
+		return nil
+	case player.FieldColor:
+		m.ResetColor()
+		return nil
+	case player.FieldTexture:
+		m.ResetTexture()
+		return nil
+	case player.FieldEnergy:
+		m.ResetEnergy()
+		return nil
+	case player.FieldFightBadge:
+		m.ResetFightBadge()
+		return nil
+	case player.FieldTimeToday:
+		m.ResetTimeToday()
+		return nil
+	case player.FieldTimeLimit:
+		m.ResetTimeLimit()
+		return nil
+	case player.FieldTeacherID:
+		m.ResetTeacherID()
+		return nil
+	case player.FieldStudentID:
+		m.ResetStudentID()
+		return nil
+	case player.FieldCurTitle:
+		m.ResetCurTitle()
+		return nil
+	case player.FieldTaskStatus:
+		m.ResetTaskStatus()
+		return nil
+	case player.FieldTaskBufs:
+		m.ResetTaskBufs()
+		return nil
+	case player.FieldFriends:
+		m.ResetFriends()
+		return nil
+	case player.FieldBlacklist:
+		m.ResetBlacklist()
+		return nil
+	case player.FieldAchievements:
+		m.ResetAchievements()
+		return nil
+	case player.FieldTitles:
+		m.ResetTitles()
+		return nil
+	case player.FieldTeamInfo:
+		m.ResetTeamInfo()
+		return nil
+	case player.FieldStudentIds:
+		m.ResetStudentIds()
+		return nil
+	case player.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case player.FieldRoomPrivacy:
+		m.ResetRoomPrivacy()
+		return nil
+	case player.FieldFitments:
+		m.ResetFitments()
+		return nil
+	case player.FieldNonoInfo:
+		m.ResetNonoInfo()
+		return nil
+	case player.FieldMailbox:
+		m.ResetMailbox()
+		return nil
+	case player.FieldBossClears:
+		m.ResetBossClears()
+		return nil
+	case player.FieldExpPool:
+		m.ResetExpPool()
+		return nil
+	case player.FieldGraduationCount:
+		m.ResetGraduationCount()
+		return nil
+	case player.FieldIncubator:
+		m.ResetIncubator()
+		return nil
+	case player.FieldSoulBeads:
+		m.ResetSoulBeads()
+		return nil
+	case player.FieldItemBuffs:
+		m.ResetItemBuffs()
+		return nil
+	case player.FieldCurrentPetID:
+		m.ResetCurrentPetID()
+		return nil
+	case player.FieldCurrentPetCatchTime:
+		m.ResetCurrentPetCatchTime()
+		return nil
+	case player.FieldCurrentPetDv:
+		m.ResetCurrentPetDv()
+		return nil
+	case player.FieldLastLoginAt:
+		m.ResetLastLoginAt()
+		return nil
+	case player.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case player.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.account != nil {
+		edges = append(edges, player.EdgeAccount)
+	}
+	if m.pets != nil {
+		edges = append(edges, player.EdgePets)
+	}
+	if m.items != nil {
+		edges = append(edges, player.EdgeItems)
+	}
+	if m.pvp_ratings != nil {
+		edges = append(edges, player.EdgePvpRatings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case player.EdgePets:
+		ids := make([]ent.Value, 0, len(m.pets))
+		for id := range m.pets {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgePvpRatings:
+		ids := make([]ent.Value, 0, len(m.pvp_ratings))
+		for id := range m.pvp_ratings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedpets != nil {
+		edges = append(edges, player.EdgePets)
+	}
+	if m.removeditems != nil {
+		edges = append(edges, player.EdgeItems)
+	}
+	if m.removedpvp_ratings != nil {
+		edges = append(edges, player.EdgePvpRatings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgePets:
+		ids := make([]ent.Value, 0, len(m.removedpets))
+		for id := range m.removedpets {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgePvpRatings:
+		ids := make([]ent.Value, 0, len(m.removedpvp_ratings))
+		for id := range m.removedpvp_ratings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedaccount {
+		edges = append(edges, player.EdgeAccount)
+	}
+	if m.clearedpets {
+		edges = append(edges, player.EdgePets)
+	}
+	if m.cleareditems {
+		edges = append(edges, player.EdgeItems)
+	}
+	if m.clearedpvp_ratings {
+		edges = append(edges, player.EdgePvpRatings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case player.EdgeAccount:
+		return m.clearedaccount
+	case player.EdgePets:
+		return m.clearedpets
+	case player.EdgeItems:
+		return m.cleareditems
+	case player.EdgePvpRatings:
+		return m.clearedpvp_ratings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerMutation) ClearEdge(name string) error {
+	switch name {
+	case player.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown Player unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerMutation) ResetEdge(name string) error {
+	switch name {
+	case player.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case player.EdgePets:
+		m.ResetPets()
+		return nil
+	case player.EdgeItems:
+		m.ResetItems()
+		return nil
+	case player.EdgePvpRatings:
+		m.ResetPvpRatings()
+		return nil
+	}
+	return fmt.Errorf("unknown Player edge %s", name)
+}
+
+// PvpRatingMutation represents an operation that mutates the PvpRating nodes in the graph.
+type PvpRatingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	season        *int
+	addseason     *int
+	nick          *string
+	rating        *int
+	addrating     *int
+	wins          *int
+	addwins       *int
+	losses        *int
+	addlosses     *int
+	draws         *int
+	adddraws      *int
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	player        *int
+	clearedplayer bool
+	done          bool
+	oldValue      func(context.Context) (*PvpRating, error)
+	predicates    []predicate.PvpRating
+}
+
+var _ ent.Mutation = (*PvpRatingMutation)(nil)
+
+// pvpratingOption allows management of the mutation configuration using functional options.
+type pvpratingOption func(*PvpRatingMutation)
+
+// newPvpRatingMutation creates new mutation for the PvpRating entity.
+func newPvpRatingMutation(c config, op Op, opts ...pvpratingOption) *PvpRatingMutation {
+	m := &PvpRatingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePvpRating,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPvpRatingID sets the ID field of the mutation.
+func withPvpRatingID(id int) pvpratingOption {
+	return func(m *PvpRatingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PvpRating
+		)
+		m.oldValue = func(ctx context.Context) (*PvpRating, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PvpRating.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPvpRating sets the old PvpRating of the mutation.
+func withPvpRating(node *PvpRating) pvpratingOption {
+	return func(m *PvpRatingMutation) {
+		m.oldValue = func(context.Context) (*PvpRating, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PvpRatingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PvpRatingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PvpRatingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PvpRatingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PvpRating.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlayerID sets the "player_id" field.
+func (m *PvpRatingMutation) SetPlayerID(i int) {
+	m.player = &i
+}
+
+// PlayerID returns the value of the "player_id" field in the mutation.
+func (m *PvpRatingMutation) PlayerID() (r int, exists bool) {
+	v := m.player
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerID returns the old "player_id" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldPlayerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
+	}
+	return oldValue.PlayerID, nil
+}
+
+// ResetPlayerID resets all changes to the "player_id" field.
+func (m *PvpRatingMutation) ResetPlayerID() {
+	m.player = nil
+}
+
+// SetSeason sets the "season" field.
+func (m *PvpRatingMutation) SetSeason(i int) {
+	m.season = &i
+	m.addseason = nil
+}
+
+// Season returns the value of the "season" field in the mutation.
+func (m *PvpRatingMutation) Season() (r int, exists bool) {
+	v := m.season
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeason returns the old "season" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldSeason(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeason: %w", err)
+	}
+	return oldValue.Season, nil
+}
+
+// AddSeason adds i to the "season" field.
+func (m *PvpRatingMutation) AddSeason(i int) {
+	if m.addseason != nil {
+		*m.addseason += i
+	} else {
+		m.addseason = &i
+	}
+}
+
+// AddedSeason returns the value that was added to the "season" field in this mutation.
+func (m *PvpRatingMutation) AddedSeason() (r int, exists bool) {
+	v := m.addseason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeason resets all changes to the "season" field.
+func (m *PvpRatingMutation) ResetSeason() {
+	m.season = nil
+	m.addseason = nil
+}
+
+// SetNick sets the "nick" field.
+func (m *PvpRatingMutation) SetNick(s string) {
+	m.nick = &s
+}
+
+// Nick returns the value of the "nick" field in the mutation.
+func (m *PvpRatingMutation) Nick() (r string, exists bool) {
+	v := m.nick
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNick returns the old "nick" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldNick(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNick is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNick requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNick: %w", err)
+	}
+	return oldValue.Nick, nil
+}
+
+// ResetNick resets all changes to the "nick" field.
+func (m *PvpRatingMutation) ResetNick() {
+	m.nick = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *PvpRatingMutation) SetRating(i int) {
+	m.rating = &i
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *PvpRatingMutation) Rating() (r int, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// AddRating adds i to the "rating" field.
+func (m *PvpRatingMutation) AddRating(i int) {
+	if m.addrating != nil {
+		*m.addrating += i
+	} else {
+		m.addrating = &i
+	}
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *PvpRatingMutation) AddedRating() (r int, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *PvpRatingMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetWins sets the "wins" field.
+func (m *PvpRatingMutation) SetWins(i int) {
+	m.wins = &i
+	m.addwins = nil
+}
+
+// Wins returns the value of the "wins" field in the mutation.
+func (m *PvpRatingMutation) Wins() (r int, exists bool) {
+	v := m.wins
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWins returns the old "wins" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldWins(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWins is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWins requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWins: %w", err)
+	}
+	return oldValue.Wins, nil
+}
+
+// AddWins adds i to the "wins" field.
+func (m *PvpRatingMutation) AddWins(i int) {
+	if m.addwins != nil {
+		*m.addwins += i
+	} else {
+		m.addwins = &i
+	}
+}
+
+// AddedWins returns the value that was added to the "wins" field in this mutation.
+func (m *PvpRatingMutation) AddedWins() (r int, exists bool) {
+	v := m.addwins
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWins resets all changes to the "wins" field.
+func (m *PvpRatingMutation) ResetWins() {
+	m.wins = nil
+	m.addwins = nil
+}
+
+// SetLosses sets the "losses" field.
+func (m *PvpRatingMutation) SetLosses(i int) {
+	m.losses = &i
+	m.addlosses = nil
+}
+
+// Losses returns the value of the "losses" field in the mutation.
+func (m *PvpRatingMutation) Losses() (r int, exists bool) {
+	v := m.losses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLosses returns the old "losses" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldLosses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLosses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLosses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLosses: %w", err)
+	}
+	return oldValue.Losses, nil
+}
+
+// AddLosses adds i to the "losses" field.
+func (m *PvpRatingMutation) AddLosses(i int) {
+	if m.addlosses != nil {
+		*m.addlosses += i
+	} else {
+		m.addlosses = &i
+	}
+}
+
+// AddedLosses returns the value that was added to the "losses" field in this mutation.
+func (m *PvpRatingMutation) AddedLosses() (r int, exists bool) {
+	v := m.addlosses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLosses resets all changes to the "losses" field.
+func (m *PvpRatingMutation) ResetLosses() {
+	m.losses = nil
+	m.addlosses = nil
+}
+
+// SetDraws sets the "draws" field.
+func (m *PvpRatingMutation) SetDraws(i int) {
+	m.draws = &i
+	m.adddraws = nil
+}
+
+// Draws returns the value of the "draws" field in the mutation.
+func (m *PvpRatingMutation) Draws() (r int, exists bool) {
+	v := m.draws
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDraws returns the old "draws" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldDraws(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDraws is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDraws requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDraws: %w", err)
+	}
+	return oldValue.Draws, nil
+}
+
+// AddDraws adds i to the "draws" field.
+func (m *PvpRatingMutation) AddDraws(i int) {
+	if m.adddraws != nil {
+		*m.adddraws += i
+	} else {
+		m.adddraws = &i
+	}
+}
+
+// AddedDraws returns the value that was added to the "draws" field in this mutation.
+func (m *PvpRatingMutation) AddedDraws() (r int, exists bool) {
+	v := m.adddraws
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDraws resets all changes to the "draws" field.
+func (m *PvpRatingMutation) ResetDraws() {
+	m.draws = nil
+	m.adddraws = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PvpRatingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PvpRatingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PvpRating entity.
+// If the PvpRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PvpRatingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PvpRatingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *PvpRatingMutation) ClearPlayer() {
+	m.clearedplayer = true
+	m.clearedFields[pvprating.FieldPlayerID] = struct{}{}
+}
+
+// PlayerCleared reports if the "player" edge to the Player entity was cleared.
+func (m *PvpRatingMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *PvpRatingMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlayer resets all changes to the "player" edge.
+func (m *PvpRatingMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
+}
+
+// Where appends a list predicates to the PvpRatingMutation builder.
+func (m *PvpRatingMutation) Where(ps ...predicate.PvpRating) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PvpRatingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PvpRatingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PvpRating, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PvpRatingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PvpRatingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PvpRating).
+func (m *PvpRatingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PvpRatingMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.player != nil {
+		fields = append(fields, pvprating.FieldPlayerID)
+	}
+	if m.season != nil {
+		fields = append(fields, pvprating.FieldSeason)
+	}
+	if m.nick != nil {
+		fields = append(fields, pvprating.FieldNick)
+	}
+	if m.rating != nil {
+		fields = append(fields, pvprating.FieldRating)
+	}
+	if m.wins != nil {
+		fields = append(fields, pvprating.FieldWins)
+	}
+	if m.losses != nil {
+		fields = append(fields, pvprating.FieldLosses)
+	}
+	if m.draws != nil {
+		fields = append(fields, pvprating.FieldDraws)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pvprating.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PvpRatingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pvprating.FieldPlayerID:
+		return m.PlayerID()
+	case pvprating.FieldSeason:
+		return m.Season()
+	case pvprating.FieldNick:
+		return m.Nick()
+	case pvprating.FieldRating:
+		return m.Rating()
+	case pvprating.FieldWins:
+		return m.Wins()
+	case pvprating.FieldLosses:
+		return m.Losses()
+	case pvprating.FieldDraws:
+		return m.Draws()
+	case pvprating.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PvpRatingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pvprating.FieldPlayerID:
+		return m.OldPlayerID(ctx)
+	case pvprating.FieldSeason:
+		return m.OldSeason(ctx)
+	case pvprating.FieldNick:
+		return m.OldNick(ctx)
+	case pvprating.FieldRating:
+		return m.OldRating(ctx)
+	case pvprating.FieldWins:
+		return m.OldWins(ctx)
+	case pvprating.FieldLosses:
+		return m.OldLosses(ctx)
+	case pvprating.FieldDraws:
+		return m.OldDraws(ctx)
+	case pvprating.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PvpRating field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PvpRatingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pvprating.FieldPlayerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerID(v)
+		return nil
+	case pvprating.FieldSeason:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeason(v)
+		return nil
+	case pvprating.FieldNick:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNick(v)
+		return nil
+	case pvprating.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case pvprating.FieldWins:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWins(v)
+		return nil
+	case pvprating.FieldLosses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLosses(v)
+		return nil
+	case pvprating.FieldDraws:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDraws(v)
+		return nil
+	case pvprating.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PvpRating field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PvpRatingMutation) AddedFields() []string {
+	var fields []string
+	if m.addseason != nil {
+		fields = append(fields, pvprating.FieldSeason)
+	}
+	if m.addrating != nil {
+		fields = append(fields, pvprating.FieldRating)
+	}
+	if m.addwins != nil {
+		fields = append(fields, pvprating.FieldWins)
+	}
+	if m.addlosses != nil {
+		fields = append(fields, pvprating.FieldLosses)
+	}
+	if m.adddraws != nil {
+		fields = append(fields, pvprating.FieldDraws)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PvpRatingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pvprating.FieldSeason:
+		return m.AddedSeason()
+	case pvprating.FieldRating:
+		return m.AddedRating()
+	case pvprating.FieldWins:
+		return m.AddedWins()
+	case pvprating.FieldLosses:
+		return m.AddedLosses()
+	case pvprating.FieldDraws:
+		return m.AddedDraws()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PvpRatingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pvprating.FieldSeason:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeason(v)
+		return nil
+	case pvprating.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	case pvprating.FieldWins:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWins(v)
+		return nil
+	case pvprating.FieldLosses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLosses(v)
+		return nil
+	case pvprating.FieldDraws:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDraws(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PvpRating numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PvpRatingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PvpRatingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PvpRatingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PvpRating nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PvpRatingMutation) ResetField(name string) error {
+	switch name {
+	case pvprating.FieldPlayerID:
+		m.ResetPlayerID()
+		return nil
+	case pvprating.FieldSeason:
+		m.ResetSeason()
+		return nil
+	case pvprating.FieldNick:
+		m.ResetNick()
+		return nil
+	case pvprating.FieldRating:
+		m.ResetRating()
+		return nil
+	case pvprating.FieldWins:
+		m.ResetWins()
+		return nil
+	case pvprating.FieldLosses:
+		m.ResetLosses()
+		return nil
+	case pvprating.FieldDraws:
+		m.ResetDraws()
+		return nil
+	case pvprating.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PvpRating field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PvpRatingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.player != nil {
+		edges = append(edges, pvprating.EdgePlayer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PvpRatingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pvprating.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PvpRatingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PvpRatingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PvpRatingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplayer {
+		edges = append(edges, pvprating.EdgePlayer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PvpRatingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pvprating.EdgePlayer:
+		return m.clearedplayer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PvpRatingMutation) ClearEdge(name string) error {
+	switch name {
+	case pvprating.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown PvpRating unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PvpRatingMutation) ResetEdge(name string) error {
+	switch name {
+	case pvprating.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown PvpRating edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	description        *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	permissions        map[int]struct{}
+	removedpermissions map[int]struct{}
+	clearedpermissions bool
+	gm_users           map[int]struct{}
+	removedgm_users    map[int]struct{}
+	clearedgm_users    bool
+	done               bool
+	oldValue           func(context.Context) (*Role, error)
+	predicates         []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
+func (m *RoleMutation) AddPermissionIDs(ids ...int) {
+	if m.permissions == nil {
+		m.permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPermissions clears the "permissions" edge to the Permission entity.
+func (m *RoleMutation) ClearPermissions() {
+	m.clearedpermissions = true
+}
+
+// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
+func (m *RoleMutation) PermissionsCleared() bool {
+	return m.clearedpermissions
+}
+
+// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
+func (m *RoleMutation) RemovePermissionIDs(ids ...int) {
+	if m.removedpermissions == nil {
+		m.removedpermissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.permissions, ids[i])
+		m.removedpermissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
+func (m *RoleMutation) RemovedPermissionsIDs() (ids []int) {
+	for id := range m.removedpermissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PermissionsIDs returns the "permissions" edge IDs in the mutation.
+func (m *RoleMutation) PermissionsIDs() (ids []int) {
+	for id := range m.permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPermissions resets all changes to the "permissions" edge.
+func (m *RoleMutation) ResetPermissions() {
+	m.permissions = nil
+	m.clearedpermissions = false
+	m.removedpermissions = nil
+}
+
+// AddGmUserIDs adds the "gm_users" edge to the GMUser entity by ids.
+func (m *RoleMutation) AddGmUserIDs(ids ...int) {
+	if m.gm_users == nil {
+		m.gm_users = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.gm_users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGmUsers clears the "gm_users" edge to the GMUser entity.
+func (m *RoleMutation) ClearGmUsers() {
+	m.clearedgm_users = true
+}
+
+// GmUsersCleared reports if the "gm_users" edge to the GMUser entity was cleared.
+func (m *RoleMutation) GmUsersCleared() bool {
+	return m.clearedgm_users
+}
+
+// RemoveGmUserIDs removes the "gm_users" edge to the GMUser entity by IDs.
+func (m *RoleMutation) RemoveGmUserIDs(ids ...int) {
+	if m.removedgm_users == nil {
+		m.removedgm_users = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.gm_users, ids[i])
+		m.removedgm_users[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGmUsers returns the removed IDs of the "gm_users" edge to the GMUser entity.
+func (m *RoleMutation) RemovedGmUsersIDs() (ids []int) {
+	for id := range m.removedgm_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GmUsersIDs returns the "gm_users" edge IDs in the mutation.
+func (m *RoleMutation) GmUsersIDs() (ids []int) {
+	for id := range m.gm_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGmUsers resets all changes to the "gm_users" edge.
+func (m *RoleMutation) ResetGmUsers() {
+	m.gm_users = nil
+	m.clearedgm_users = false
+	m.removedgm_users = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, role.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldName:
+		return m.Name()
+	case role.FieldDescription:
+		return m.Description()
+	case role.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldDescription:
+		return m.OldDescription(ctx)
+	case role.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case role.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case role.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.permissions != nil {
+		edges = append(edges, role.EdgePermissions)
+	}
+	if m.gm_users != nil {
+		edges = append(edges, role.EdgeGmUsers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.permissions))
+		for id := range m.permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeGmUsers:
+		ids := make([]ent.Value, 0, len(m.gm_users))
+		for id := range m.gm_users {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedpermissions != nil {
+		edges = append(edges, role.EdgePermissions)
+	}
+	if m.removedgm_users != nil {
+		edges = append(edges, role.EdgeGmUsers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.removedpermissions))
+		for id := range m.removedpermissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeGmUsers:
+		ids := make([]ent.Value, 0, len(m.removedgm_users))
+		for id := range m.removedgm_users {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpermissions {
+		edges = append(edges, role.EdgePermissions)
+	}
+	if m.clearedgm_users {
+		edges = append(edges, role.EdgeGmUsers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case role.EdgePermissions:
+		return m.clearedpermissions
+	case role.EdgeGmUsers:
+		return m.clearedgm_users
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	switch name {
+	case role.EdgePermissions:
+		m.ResetPermissions()
+		return nil
+	case role.EdgeGmUsers:
+		m.ResetGmUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	name_key       *string
+	legacy_id      *int64
+	addlegacy_id   *int64
+	leader_id      *int64
+	addleader_id   *int64
+	interest       *int
+	addinterest    *int
+	join_flag      *int
+	addjoin_flag   *int
+	exp            *int64
+	addexp         *int64
+	score          *int64
+	addscore       *int64
+	slogan         *string
+	notice         *string
+	logo_bg        *int
+	addlogo_bg     *int
+	logo_icon      *int
+	addlogo_icon   *int
+	logo_color     *int
+	addlogo_color  *int
+	txt_color      *int
+	addtxt_color   *int
+	logo_word      *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	members        map[int]struct{}
+	removedmembers map[int]struct{}
+	clearedmembers bool
+	done           bool
+	oldValue       func(context.Context) (*Team, error)
+	predicates     []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id int) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
+}
+
+// SetNameKey sets the "name_key" field.
+func (m *TeamMutation) SetNameKey(s string) {
+	m.name_key = &s
+}
+
+// NameKey returns the value of the "name_key" field in the mutation.
+func (m *TeamMutation) NameKey() (r string, exists bool) {
+	v := m.name_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameKey returns the old "name_key" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldNameKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameKey: %w", err)
+	}
+	return oldValue.NameKey, nil
+}
+
+// ResetNameKey resets all changes to the "name_key" field.
+func (m *TeamMutation) ResetNameKey() {
+	m.name_key = nil
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (m *TeamMutation) SetLegacyID(i int64) {
+	m.legacy_id = &i
+	m.addlegacy_id = nil
+}
+
+// LegacyID returns the value of the "legacy_id" field in the mutation.
+func (m *TeamMutation) LegacyID() (r int64, exists bool) {
+	v := m.legacy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegacyID returns the old "legacy_id" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldLegacyID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegacyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegacyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegacyID: %w", err)
+	}
+	return oldValue.LegacyID, nil
+}
+
+// AddLegacyID adds i to the "legacy_id" field.
+func (m *TeamMutation) AddLegacyID(i int64) {
+	if m.addlegacy_id != nil {
+		*m.addlegacy_id += i
+	} else {
+		m.addlegacy_id = &i
+	}
+}
+
+// AddedLegacyID returns the value that was added to the "legacy_id" field in this mutation.
+func (m *TeamMutation) AddedLegacyID() (r int64, exists bool) {
+	v := m.addlegacy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (m *TeamMutation) ClearLegacyID() {
+	m.legacy_id = nil
+	m.addlegacy_id = nil
+	m.clearedFields[team.FieldLegacyID] = struct{}{}
+}
+
+// LegacyIDCleared returns if the "legacy_id" field was cleared in this mutation.
+func (m *TeamMutation) LegacyIDCleared() bool {
+	_, ok := m.clearedFields[team.FieldLegacyID]
+	return ok
+}
+
+// ResetLegacyID resets all changes to the "legacy_id" field.
+func (m *TeamMutation) ResetLegacyID() {
+	m.legacy_id = nil
+	m.addlegacy_id = nil
+	delete(m.clearedFields, team.FieldLegacyID)
+}
+
+// SetLeaderID sets the "leader_id" field.
+func (m *TeamMutation) SetLeaderID(i int64) {
+	m.leader_id = &i
+	m.addleader_id = nil
+}
+
+// LeaderID returns the value of the "leader_id" field in the mutation.
+func (m *TeamMutation) LeaderID() (r int64, exists bool) {
+	v := m.leader_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaderID returns the old "leader_id" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldLeaderID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaderID: %w", err)
+	}
+	return oldValue.LeaderID, nil
+}
+
+// AddLeaderID adds i to the "leader_id" field.
+func (m *TeamMutation) AddLeaderID(i int64) {
+	if m.addleader_id != nil {
+		*m.addleader_id += i
+	} else {
+		m.addleader_id = &i
+	}
+}
+
+// AddedLeaderID returns the value that was added to the "leader_id" field in this mutation.
+func (m *TeamMutation) AddedLeaderID() (r int64, exists bool) {
+	v := m.addleader_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLeaderID resets all changes to the "leader_id" field.
+func (m *TeamMutation) ResetLeaderID() {
+	m.leader_id = nil
+	m.addleader_id = nil
+}
+
+// SetInterest sets the "interest" field.
+func (m *TeamMutation) SetInterest(i int) {
+	m.interest = &i
+	m.addinterest = nil
+}
+
+// Interest returns the value of the "interest" field in the mutation.
+func (m *TeamMutation) Interest() (r int, exists bool) {
+	v := m.interest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterest returns the old "interest" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldInterest(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterest: %w", err)
+	}
+	return oldValue.Interest, nil
+}
+
+// AddInterest adds i to the "interest" field.
+func (m *TeamMutation) AddInterest(i int) {
+	if m.addinterest != nil {
+		*m.addinterest += i
+	} else {
+		m.addinterest = &i
+	}
+}
+
+// AddedInterest returns the value that was added to the "interest" field in this mutation.
+func (m *TeamMutation) AddedInterest() (r int, exists bool) {
+	v := m.addinterest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInterest resets all changes to the "interest" field.
+func (m *TeamMutation) ResetInterest() {
+	m.interest = nil
+	m.addinterest = nil
+}
+
+// SetJoinFlag sets the "join_flag" field.
+func (m *TeamMutation) SetJoinFlag(i int) {
+	m.join_flag = &i
+	m.addjoin_flag = nil
+}
+
+// JoinFlag returns the value of the "join_flag" field in the mutation.
+func (m *TeamMutation) JoinFlag() (r int, exists bool) {
+	v := m.join_flag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinFlag returns the old "join_flag" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldJoinFlag(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinFlag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinFlag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinFlag: %w", err)
+	}
+	return oldValue.JoinFlag, nil
+}
+
+// AddJoinFlag adds i to the "join_flag" field.
+func (m *TeamMutation) AddJoinFlag(i int) {
+	if m.addjoin_flag != nil {
+		*m.addjoin_flag += i
+	} else {
+		m.addjoin_flag = &i
+	}
+}
+
+// AddedJoinFlag returns the value that was added to the "join_flag" field in this mutation.
+func (m *TeamMutation) AddedJoinFlag() (r int, exists bool) {
+	v := m.addjoin_flag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetJoinFlag resets all changes to the "join_flag" field.
+func (m *TeamMutation) ResetJoinFlag() {
+	m.join_flag = nil
+	m.addjoin_flag = nil
+}
+
+// SetExp sets the "exp" field.
+func (m *TeamMutation) SetExp(i int64) {
+	m.exp = &i
+	m.addexp = nil
+}
+
+// Exp returns the value of the "exp" field in the mutation.
+func (m *TeamMutation) Exp() (r int64, exists bool) {
+	v := m.exp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExp returns the old "exp" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldExp(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExp: %w", err)
+	}
+	return oldValue.Exp, nil
+}
+
+// AddExp adds i to the "exp" field.
+func (m *TeamMutation) AddExp(i int64) {
+	if m.addexp != nil {
+		*m.addexp += i
+	} else {
+		m.addexp = &i
+	}
+}
+
+// AddedExp returns the value that was added to the "exp" field in this mutation.
+func (m *TeamMutation) AddedExp() (r int64, exists bool) {
+	v := m.addexp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExp resets all changes to the "exp" field.
+func (m *TeamMutation) ResetExp() {
+	m.exp = nil
+	m.addexp = nil
+}
+
+// SetScore sets the "score" field.
+func (m *TeamMutation) SetScore(i int64) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *TeamMutation) Score() (r int64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldScore(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *TeamMutation) AddScore(i int64) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *TeamMutation) AddedScore() (r int64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *TeamMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetSlogan sets the "slogan" field.
+func (m *TeamMutation) SetSlogan(s string) {
+	m.slogan = &s
+}
+
+// Slogan returns the value of the "slogan" field in the mutation.
+func (m *TeamMutation) Slogan() (r string, exists bool) {
+	v := m.slogan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlogan returns the old "slogan" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldSlogan(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlogan is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlogan requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlogan: %w", err)
+	}
+	return oldValue.Slogan, nil
+}
+
+// ResetSlogan resets all changes to the "slogan" field.
+func (m *TeamMutation) ResetSlogan() {
+	m.slogan = nil
+}
+
+// SetNotice sets the "notice" field.
+func (m *TeamMutation) SetNotice(s string) {
+	m.notice = &s
+}
+
+// Notice returns the value of the "notice" field in the mutation.
+func (m *TeamMutation) Notice() (r string, exists bool) {
+	v := m.notice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotice returns the old "notice" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldNotice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotice: %w", err)
+	}
+	return oldValue.Notice, nil
+}
+
+// ResetNotice resets all changes to the "notice" field.
+func (m *TeamMutation) ResetNotice() {
+	m.notice = nil
+}
+
+// SetLogoBg sets the "logo_bg" field.
+func (m *TeamMutation) SetLogoBg(i int) {
+	m.logo_bg = &i
+	m.addlogo_bg = nil
+}
+
+// LogoBg returns the value of the "logo_bg" field in the mutation.
+func (m *TeamMutation) LogoBg() (r int, exists bool) {
+	v := m.logo_bg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoBg returns the old "logo_bg" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldLogoBg(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoBg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoBg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoBg: %w", err)
+	}
+	return oldValue.LogoBg, nil
+}
+
+// AddLogoBg adds i to the "logo_bg" field.
+func (m *TeamMutation) AddLogoBg(i int) {
+	if m.addlogo_bg != nil {
+		*m.addlogo_bg += i
+	} else {
+		m.addlogo_bg = &i
+	}
+}
+
+// AddedLogoBg returns the value that was added to the "logo_bg" field in this mutation.
+func (m *TeamMutation) AddedLogoBg() (r int, exists bool) {
+	v := m.addlogo_bg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLogoBg resets all changes to the "logo_bg" field.
+func (m *TeamMutation) ResetLogoBg() {
+	m.logo_bg = nil
+	m.addlogo_bg = nil
+}
+
+// SetLogoIcon sets the "logo_icon" field.
+func (m *TeamMutation) SetLogoIcon(i int) {
+	m.logo_icon = &i
+	m.addlogo_icon = nil
+}
+
+// LogoIcon returns the value of the "logo_icon" field in the mutation.
+func (m *TeamMutation) LogoIcon() (r int, exists bool) {
+	v := m.logo_icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoIcon returns the old "logo_icon" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldLogoIcon(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoIcon: %w", err)
+	}
+	return oldValue.LogoIcon, nil
+}
+
+// AddLogoIcon adds i to the "logo_icon" field.
+func (m *TeamMutation) AddLogoIcon(i int) {
+	if m.addlogo_icon != nil {
+		*m.addlogo_icon += i
+	} else {
+		m.addlogo_icon = &i
+	}
+}
+
+// AddedLogoIcon returns the value that was added to the "logo_icon" field in this mutation.
+func (m *TeamMutation) AddedLogoIcon() (r int, exists bool) {
+	v := m.addlogo_icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLogoIcon resets all changes to the "logo_icon" field.
+func (m *TeamMutation) ResetLogoIcon() {
+	m.logo_icon = nil
+	m.addlogo_icon = nil
+}
+
+// SetLogoColor sets the "logo_color" field.
+func (m *TeamMutation) SetLogoColor(i int) {
+	m.logo_color = &i
+	m.addlogo_color = nil
+}
+
+// LogoColor returns the value of the "logo_color" field in the mutation.
+func (m *TeamMutation) LogoColor() (r int, exists bool) {
+	v := m.logo_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoColor returns the old "logo_color" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldLogoColor(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoColor: %w", err)
+	}
+	return oldValue.LogoColor, nil
+}
+
+// AddLogoColor adds i to the "logo_color" field.
+func (m *TeamMutation) AddLogoColor(i int) {
+	if m.addlogo_color != nil {
+		*m.addlogo_color += i
+	} else {
+		m.addlogo_color = &i
+	}
+}
+
+// AddedLogoColor returns the value that was added to the "logo_color" field in this mutation.
+func (m *TeamMutation) AddedLogoColor() (r int, exists bool) {
+	v := m.addlogo_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLogoColor resets all changes to the "logo_color" field.
+func (m *TeamMutation) ResetLogoColor() {
+	m.logo_color = nil
+	m.addlogo_color = nil
+}
+
+// SetTxtColor sets the "txt_color" field.
+func (m *TeamMutation) SetTxtColor(i int) {
+	m.txt_color = &i
+	m.addtxt_color = nil
+}
+
+// TxtColor returns the value of the "txt_color" field in the mutation.
+func (m *TeamMutation) TxtColor() (r int, exists bool) {
+	v := m.txt_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxtColor returns the old "txt_color" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldTxtColor(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxtColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxtColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxtColor: %w", err)
+	}
+	return oldValue.TxtColor, nil
+}
+
+// AddTxtColor adds i to the "txt_color" field.
+func (m *TeamMutation) AddTxtColor(i int) {
+	if m.addtxt_color != nil {
+		*m.addtxt_color += i
+	} else {
+		m.addtxt_color = &i
+	}
+}
+
+// AddedTxtColor returns the value that was added to the "txt_color" field in this mutation.
+func (m *TeamMutation) AddedTxtColor() (r int, exists bool) {
+	v := m.addtxt_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTxtColor resets all changes to the "txt_color" field.
+func (m *TeamMutation) ResetTxtColor() {
+	m.txt_color = nil
+	m.addtxt_color = nil
+}
+
+// SetLogoWord sets the "logo_word" field.
+func (m *TeamMutation) SetLogoWord(s string) {
+	m.logo_word = &s
+}
+
+// LogoWord returns the value of the "logo_word" field in the mutation.
+func (m *TeamMutation) LogoWord() (r string, exists bool) {
+	v := m.logo_word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoWord returns the old "logo_word" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldLogoWord(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoWord is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoWord requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoWord: %w", err)
+	}
+	return oldValue.LogoWord, nil
+}
+
+// ResetLogoWord resets all changes to the "logo_word" field.
+func (m *TeamMutation) ResetLogoWord() {
+	m.logo_word = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMemberIDs adds the "members" edge to the TeamMember entity by ids.
+func (m *TeamMutation) AddMemberIDs(ids ...int) {
+	if m.members == nil {
+		m.members = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the TeamMember entity.
+func (m *TeamMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the TeamMember entity was cleared.
+func (m *TeamMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the TeamMember entity by IDs.
+func (m *TeamMutation) RemoveMemberIDs(ids ...int) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the TeamMember entity.
+func (m *TeamMutation) RemovedMembersIDs() (ids []int) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *TeamMutation) MembersIDs() (ids []int) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *TeamMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
+	}
+	if m.name_key != nil {
+		fields = append(fields, team.FieldNameKey)
+	}
+	if m.legacy_id != nil {
+		fields = append(fields, team.FieldLegacyID)
+	}
+	if m.leader_id != nil {
+		fields = append(fields, team.FieldLeaderID)
+	}
+	if m.interest != nil {
+		fields = append(fields, team.FieldInterest)
+	}
+	if m.join_flag != nil {
+		fields = append(fields, team.FieldJoinFlag)
+	}
+	if m.exp != nil {
+		fields = append(fields, team.FieldExp)
+	}
+	if m.score != nil {
+		fields = append(fields, team.FieldScore)
+	}
+	if m.slogan != nil {
+		fields = append(fields, team.FieldSlogan)
+	}
+	if m.notice != nil {
+		fields = append(fields, team.FieldNotice)
+	}
+	if m.logo_bg != nil {
+		fields = append(fields, team.FieldLogoBg)
+	}
+	if m.logo_icon != nil {
+		fields = append(fields, team.FieldLogoIcon)
+	}
+	if m.logo_color != nil {
+		fields = append(fields, team.FieldLogoColor)
+	}
+	if m.txt_color != nil {
+		fields = append(fields, team.FieldTxtColor)
+	}
+	if m.logo_word != nil {
+		fields = append(fields, team.FieldLogoWord)
+	}
+	if m.created_at != nil {
+		fields = append(fields, team.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, team.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldName:
+		return m.Name()
+	case team.FieldNameKey:
+		return m.NameKey()
+	case team.FieldLegacyID:
+		return m.LegacyID()
+	case team.FieldLeaderID:
+		return m.LeaderID()
+	case team.FieldInterest:
+		return m.Interest()
+	case team.FieldJoinFlag:
+		return m.JoinFlag()
+	case team.FieldExp:
+		return m.Exp()
+	case team.FieldScore:
+		return m.Score()
+	case team.FieldSlogan:
+		return m.Slogan()
+	case team.FieldNotice:
+		return m.Notice()
+	case team.FieldLogoBg:
+		return m.LogoBg()
+	case team.FieldLogoIcon:
+		return m.LogoIcon()
+	case team.FieldLogoColor:
+		return m.LogoColor()
+	case team.FieldTxtColor:
+		return m.TxtColor()
+	case team.FieldLogoWord:
+		return m.LogoWord()
+	case team.FieldCreatedAt:
+		return m.CreatedAt()
+	case team.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldNameKey:
+		return m.OldNameKey(ctx)
+	case team.FieldLegacyID:
+		return m.OldLegacyID(ctx)
+	case team.FieldLeaderID:
+		return m.OldLeaderID(ctx)
+	case team.FieldInterest:
+		return m.OldInterest(ctx)
+	case team.FieldJoinFlag:
+		return m.OldJoinFlag(ctx)
+	case team.FieldExp:
+		return m.OldExp(ctx)
+	case team.FieldScore:
+		return m.OldScore(ctx)
+	case team.FieldSlogan:
+		return m.OldSlogan(ctx)
+	case team.FieldNotice:
+		return m.OldNotice(ctx)
+	case team.FieldLogoBg:
+		return m.OldLogoBg(ctx)
+	case team.FieldLogoIcon:
+		return m.OldLogoIcon(ctx)
+	case team.FieldLogoColor:
+		return m.OldLogoColor(ctx)
+	case team.FieldTxtColor:
+		return m.OldTxtColor(ctx)
+	case team.FieldLogoWord:
+		return m.OldLogoWord(ctx)
+	case team.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case team.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case team.FieldNameKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameKey(v)
+		return nil
+	case team.FieldLegacyID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegacyID(v)
+		return nil
+	case team.FieldLeaderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaderID(v)
+		return nil
+	case team.FieldInterest:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterest(v)
+		return nil
+	case team.FieldJoinFlag:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinFlag(v)
+		return nil
+	case team.FieldExp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExp(v)
+		return nil
+	case team.FieldScore:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case team.FieldSlogan:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlogan(v)
+		return nil
+	case team.FieldNotice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotice(v)
+		return nil
+	case team.FieldLogoBg:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoBg(v)
+		return nil
+	case team.FieldLogoIcon:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoIcon(v)
+		return nil
+	case team.FieldLogoColor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoColor(v)
+		return nil
+	case team.FieldTxtColor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxtColor(v)
+		return nil
+	case team.FieldLogoWord:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoWord(v)
+		return nil
+	case team.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case team.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMutation) AddedFields() []string {
+	var fields []string
+	if m.addlegacy_id != nil {
+		fields = append(fields, team.FieldLegacyID)
+	}
+	if m.addleader_id != nil {
+		fields = append(fields, team.FieldLeaderID)
+	}
+	if m.addinterest != nil {
+		fields = append(fields, team.FieldInterest)
+	}
+	if m.addjoin_flag != nil {
+		fields = append(fields, team.FieldJoinFlag)
+	}
+	if m.addexp != nil {
+		fields = append(fields, team.FieldExp)
+	}
+	if m.addscore != nil {
+		fields = append(fields, team.FieldScore)
+	}
+	if m.addlogo_bg != nil {
+		fields = append(fields, team.FieldLogoBg)
+	}
+	if m.addlogo_icon != nil {
+		fields = append(fields, team.FieldLogoIcon)
+	}
+	if m.addlogo_color != nil {
+		fields = append(fields, team.FieldLogoColor)
+	}
+	if m.addtxt_color != nil {
+		fields = append(fields, team.FieldTxtColor)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldLegacyID:
+		return m.AddedLegacyID()
+	case team.FieldLeaderID:
+		return m.AddedLeaderID()
+	case team.FieldInterest:
+		return m.AddedInterest()
+	case team.FieldJoinFlag:
+		return m.AddedJoinFlag()
+	case team.FieldExp:
+		return m.AddedExp()
+	case team.FieldScore:
+		return m.AddedScore()
+	case team.FieldLogoBg:
+		return m.AddedLogoBg()
+	case team.FieldLogoIcon:
+		return m.AddedLogoIcon()
+	case team.FieldLogoColor:
+		return m.AddedLogoColor()
+	case team.FieldTxtColor:
+		return m.AddedTxtColor()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldLegacyID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLegacyID(v)
+		return nil
+	case team.FieldLeaderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeaderID(v)
+		return nil
+	case team.FieldInterest:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInterest(v)
+		return nil
+	case team.FieldJoinFlag:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddJoinFlag(v)
+		return nil
+	case team.FieldExp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExp(v)
+		return nil
+	case team.FieldScore:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case team.FieldLogoBg:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLogoBg(v)
+		return nil
+	case team.FieldLogoIcon:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLogoIcon(v)
+		return nil
+	case team.FieldLogoColor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLogoColor(v)
+		return nil
+	case team.FieldTxtColor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTxtColor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(team.FieldLegacyID) {
+		fields = append(fields, team.FieldLegacyID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMutation) ClearField(name string) error {
+	switch name {
+	case team.FieldLegacyID:
+		m.ClearLegacyID()
+		return nil
+	}
+	return fmt.Errorf("unknown Team nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMutation) ResetField(name string) error {
+	switch name {
+	case team.FieldName:
+		m.ResetName()
+		return nil
+	case team.FieldNameKey:
+		m.ResetNameKey()
+		return nil
+	case team.FieldLegacyID:
+		m.ResetLegacyID()
+		return nil
+	case team.FieldLeaderID:
+		m.ResetLeaderID()
+		return nil
+	case team.FieldInterest:
+		m.ResetInterest()
+		return nil
+	case team.FieldJoinFlag:
+		m.ResetJoinFlag()
+		return nil
+	case team.FieldExp:
+		m.ResetExp()
+		return nil
+	case team.FieldScore:
+		m.ResetScore()
+		return nil
+	case team.FieldSlogan:
+		m.ResetSlogan()
+		return nil
+	case team.FieldNotice:
+		m.ResetNotice()
+		return nil
+	case team.FieldLogoBg:
+		m.ResetLogoBg()
+		return nil
+	case team.FieldLogoIcon:
+		m.ResetLogoIcon()
+		return nil
+	case team.FieldLogoColor:
+		m.ResetLogoColor()
+		return nil
+	case team.FieldTxtColor:
+		m.ResetTxtColor()
+		return nil
+	case team.FieldLogoWord:
+		m.ResetLogoWord()
+		return nil
+	case team.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case team.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.members != nil {
+		edges = append(edges, team.EdgeMembers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmembers != nil {
+		edges = append(edges, team.EdgeMembers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmembers {
+		edges = append(edges, team.EdgeMembers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case team.EdgeMembers:
+		return m.clearedmembers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Team unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMutation) ResetEdge(name string) error {
+	switch name {
+	case team.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
+// TeamMemberMutation represents an operation that mutates the TeamMember nodes in the graph.
+type TeamMemberMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	user_id                *int64
+	adduser_id             *int64
+	nick                   *string
+	priv                   *int
+	addpriv                *int
+	contribution           *int64
+	addcontribution        *int64
+	can_ex_contribution    *int64
+	addcan_ex_contribution *int64
+	is_show                *bool
+	joined_at              *time.Time
+	clearedFields          map[string]struct{}
+	team                   *int
+	clearedteam            bool
+	done                   bool
+	oldValue               func(context.Context) (*TeamMember, error)
+	predicates             []predicate.TeamMember
+}
+
+var _ ent.Mutation = (*TeamMemberMutation)(nil)
+
+// teammemberOption allows management of the mutation configuration using functional options.
+type teammemberOption func(*TeamMemberMutation)
+
+// newTeamMemberMutation creates new mutation for the TeamMember entity.
+func newTeamMemberMutation(c config, op Op, opts ...teammemberOption) *TeamMemberMutation {
+	m := &TeamMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamMemberID sets the ID field of the mutation.
+func withTeamMemberID(id int) teammemberOption {
+	return func(m *TeamMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamMember
+		)
+		m.oldValue = func(ctx context.Context) (*TeamMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamMember sets the old TeamMember of the mutation.
+func withTeamMember(node *TeamMember) teammemberOption {
+	return func(m *TeamMemberMutation) {
+		m.oldValue = func(context.Context) (*TeamMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMemberMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMemberMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamMemberMutation) SetTeamID(i int) {
+	m.team = &i
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamMemberMutation) TeamID() (r int, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldTeamID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamMemberMutation) ResetTeamID() {
+	m.team = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TeamMemberMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TeamMemberMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *TeamMemberMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *TeamMemberMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TeamMemberMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetNick sets the "nick" field.
+func (m *TeamMemberMutation) SetNick(s string) {
+	m.nick = &s
+}
+
+// Nick returns the value of the "nick" field in the mutation.
+func (m *TeamMemberMutation) Nick() (r string, exists bool) {
+	v := m.nick
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNick returns the old "nick" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldNick(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNick is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNick requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNick: %w", err)
+	}
+	return oldValue.Nick, nil
+}
+
+// ResetNick resets all changes to the "nick" field.
+func (m *TeamMemberMutation) ResetNick() {
+	m.nick = nil
+}
+
+// SetPriv sets the "priv" field.
+func (m *TeamMemberMutation) SetPriv(i int) {
+	m.priv = &i
+	m.addpriv = nil
+}
+
+// Priv returns the value of the "priv" field in the mutation.
+func (m *TeamMemberMutation) Priv() (r int, exists bool) {
+	v := m.priv
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriv returns the old "priv" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldPriv(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriv is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriv requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriv: %w", err)
+	}
+	return oldValue.Priv, nil
+}
+
+// AddPriv adds i to the "priv" field.
+func (m *TeamMemberMutation) AddPriv(i int) {
+	if m.addpriv != nil {
+		*m.addpriv += i
+	} else {
+		m.addpriv = &i
+	}
+}
+
+// AddedPriv returns the value that was added to the "priv" field in this mutation.
+func (m *TeamMemberMutation) AddedPriv() (r int, exists bool) {
+	v := m.addpriv
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriv resets all changes to the "priv" field.
+func (m *TeamMemberMutation) ResetPriv() {
+	m.priv = nil
+	m.addpriv = nil
+}
+
+// SetContribution sets the "contribution" field.
+func (m *TeamMemberMutation) SetContribution(i int64) {
+	m.contribution = &i
+	m.addcontribution = nil
+}
+
+// Contribution returns the value of the "contribution" field in the mutation.
+func (m *TeamMemberMutation) Contribution() (r int64, exists bool) {
+	v := m.contribution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContribution returns the old "contribution" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldContribution(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContribution is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContribution requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContribution: %w", err)
+	}
+	return oldValue.Contribution, nil
+}
+
+// AddContribution adds i to the "contribution" field.
+func (m *TeamMemberMutation) AddContribution(i int64) {
+	if m.addcontribution != nil {
+		*m.addcontribution += i
+	} else {
+		m.addcontribution = &i
+	}
+}
+
+// AddedContribution returns the value that was added to the "contribution" field in this mutation.
+func (m *TeamMemberMutation) AddedContribution() (r int64, exists bool) {
+	v := m.addcontribution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetContribution resets all changes to the "contribution" field.
+func (m *TeamMemberMutation) ResetContribution() {
+	m.contribution = nil
+	m.addcontribution = nil
+}
+
+// SetCanExContribution sets the "can_ex_contribution" field.
+func (m *TeamMemberMutation) SetCanExContribution(i int64) {
+	m.can_ex_contribution = &i
+	m.addcan_ex_contribution = nil
+}
+
+// CanExContribution returns the value of the "can_ex_contribution" field in the mutation.
+func (m *TeamMemberMutation) CanExContribution() (r int64, exists bool) {
+	v := m.can_ex_contribution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanExContribution returns the old "can_ex_contribution" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldCanExContribution(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanExContribution is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanExContribution requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanExContribution: %w", err)
+	}
+	return oldValue.CanExContribution, nil
+}
+
+// AddCanExContribution adds i to the "can_ex_contribution" field.
+func (m *TeamMemberMutation) AddCanExContribution(i int64) {
+	if m.addcan_ex_contribution != nil {
+		*m.addcan_ex_contribution += i
+	} else {
+		m.addcan_ex_contribution = &i
+	}
+}
+
+// AddedCanExContribution returns the value that was added to the "can_ex_contribution" field in this mutation.
+func (m *TeamMemberMutation) AddedCanExContribution() (r int64, exists bool) {
+	v := m.addcan_ex_contribution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCanExContribution resets all changes to the "can_ex_contribution" field.
+func (m *TeamMemberMutation) ResetCanExContribution() {
+	m.can_ex_contribution = nil
+	m.addcan_ex_contribution = nil
+}
+
+// SetIsShow sets the "is_show" field.
+func (m *TeamMemberMutation) SetIsShow(b bool) {
+	m.is_show = &b
+}
+
+// IsShow returns the value of the "is_show" field in the mutation.
+func (m *TeamMemberMutation) IsShow() (r bool, exists bool) {
+	v := m.is_show
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsShow returns the old "is_show" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldIsShow(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsShow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsShow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsShow: %w", err)
+	}
+	return oldValue.IsShow, nil
+}
+
+// ResetIsShow resets all changes to the "is_show" field.
+func (m *TeamMemberMutation) ResetIsShow() {
+	m.is_show = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *TeamMemberMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *TeamMemberMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *TeamMemberMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *TeamMemberMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[teammember.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *TeamMemberMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *TeamMemberMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *TeamMemberMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// Where appends a list predicates to the TeamMemberMutation builder.
+func (m *TeamMemberMutation) Where(ps ...predicate.TeamMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamMember).
+func (m *TeamMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMemberMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.team != nil {
+		fields = append(fields, teammember.FieldTeamID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, teammember.FieldUserID)
+	}
+	if m.nick != nil {
+		fields = append(fields, teammember.FieldNick)
+	}
+	if m.priv != nil {
+		fields = append(fields, teammember.FieldPriv)
+	}
+	if m.contribution != nil {
+		fields = append(fields, teammember.FieldContribution)
+	}
+	if m.can_ex_contribution != nil {
+		fields = append(fields, teammember.FieldCanExContribution)
+	}
+	if m.is_show != nil {
+		fields = append(fields, teammember.FieldIsShow)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, teammember.FieldJoinedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teammember.FieldTeamID:
+		return m.TeamID()
+	case teammember.FieldUserID:
+		return m.UserID()
+	case teammember.FieldNick:
+		return m.Nick()
+	case teammember.FieldPriv:
+		return m.Priv()
+	case teammember.FieldContribution:
+		return m.Contribution()
+	case teammember.FieldCanExContribution:
+		return m.CanExContribution()
+	case teammember.FieldIsShow:
+		return m.IsShow()
+	case teammember.FieldJoinedAt:
+		return m.JoinedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teammember.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teammember.FieldUserID:
+		return m.OldUserID(ctx)
+	case teammember.FieldNick:
+		return m.OldNick(ctx)
+	case teammember.FieldPriv:
+		return m.OldPriv(ctx)
+	case teammember.FieldContribution:
+		return m.OldContribution(ctx)
+	case teammember.FieldCanExContribution:
+		return m.OldCanExContribution(ctx)
+	case teammember.FieldIsShow:
+		return m.OldIsShow(ctx)
+	case teammember.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teammember.FieldTeamID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
 		return nil
-	case player.FieldFightBadge:
+	case teammember.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case teammember.FieldNick:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNick(v)
+		return nil
+	case teammember.FieldPriv:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriv(v)
+		return nil
+	case teammember.FieldContribution:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContribution(v)
+		return nil
+	case teammember.FieldCanExContribution:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanExContribution(v)
+		return nil
+	case teammember.FieldIsShow:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsShow(v)
+		return nil
+	case teammember.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMemberMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, teammember.FieldUserID)
+	}
+	if m.addpriv != nil {
+		fields = append(fields, teammember.FieldPriv)
+	}
+	if m.addcontribution != nil {
+		fields = append(fields, teammember.FieldContribution)
+	}
+	if m.addcan_ex_contribution != nil {
+		fields = append(fields, teammember.FieldCanExContribution)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMemberMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teammember.FieldUserID:
+		return m.AddedUserID()
+	case teammember.FieldPriv:
+		return m.AddedPriv()
+	case teammember.FieldContribution:
+		return m.AddedContribution()
+	case teammember.FieldCanExContribution:
+		return m.AddedCanExContribution()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case teammember.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case teammember.FieldPriv:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriv(v)
+		return nil
+	case teammember.FieldContribution:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContribution(v)
+		return nil
+	case teammember.FieldCanExContribution:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCanExContribution(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMemberMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMemberMutation) ResetField(name string) error {
+	switch name {
+	case teammember.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case teammember.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case teammember.FieldNick:
+		m.ResetNick()
+		return nil
+	case teammember.FieldPriv:
+		m.ResetPriv()
+		return nil
+	case teammember.FieldContribution:
+		m.ResetContribution()
+		return nil
+	case teammember.FieldCanExContribution:
+		m.ResetCanExContribution()
+		return nil
+	case teammember.FieldIsShow:
+		m.ResetIsShow()
+		return nil
+	case teammember.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.team != nil {
+		edges = append(edges, teammember.EdgeTeam)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case teammember.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedteam {
+		edges = append(edges, teammember.EdgeTeam)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case teammember.EdgeTeam:
+		return m.clearedteam
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case teammember.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case teammember.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember edge %s", name)
+}
+
+// TeamPkHistoryMutation represents an operation that mutates the TeamPkHistory nodes in the graph.
+type TeamPkHistoryMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	team_id                 *int64
+	addteam_id              *int64
+	season                  *int
+	addseason               *int
+	opponent_id             *int64
+	addopponent_id          *int64
+	opponent_name           *string
+	result                  *int
+	addresult               *int
+	score                   *int
+	addscore                *int
+	building_hp             *int
+	addbuilding_hp          *int
+	opponent_building_hp    *int
+	addopponent_building_hp *int
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*TeamPkHistory, error)
+	predicates              []predicate.TeamPkHistory
+}
+
+var _ ent.Mutation = (*TeamPkHistoryMutation)(nil)
+
+// teampkhistoryOption allows management of the mutation configuration using functional options.
+type teampkhistoryOption func(*TeamPkHistoryMutation)
+
+// newTeamPkHistoryMutation creates new mutation for the TeamPkHistory entity.
+func newTeamPkHistoryMutation(c config, op Op, opts ...teampkhistoryOption) *TeamPkHistoryMutation {
+	m := &TeamPkHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamPkHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamPkHistoryID sets the ID field of the mutation.
+func withTeamPkHistoryID(id int) teampkhistoryOption {
+	return func(m *TeamPkHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamPkHistory
+		)
+		m.oldValue = func(ctx context.Context) (*TeamPkHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamPkHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamPkHistory sets the old TeamPkHistory of the mutation.
+func withTeamPkHistory(node *TeamPkHistory) teampkhistoryOption {
+	return func(m *TeamPkHistoryMutation) {
+		m.oldValue = func(context.Context) (*TeamPkHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamPkHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamPkHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamPkHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamPkHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamPkHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamPkHistoryMutation) SetTeamID(i int64) {
+	m.team_id = &i
+	m.addteam_id = nil
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamPkHistoryMutation) TeamID() (r int64, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldTeamID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// AddTeamID adds i to the "team_id" field.
+func (m *TeamPkHistoryMutation) AddTeamID(i int64) {
+	if m.addteam_id != nil {
+		*m.addteam_id += i
+	} else {
+		m.addteam_id = &i
+	}
+}
+
+// AddedTeamID returns the value that was added to the "team_id" field in this mutation.
+func (m *TeamPkHistoryMutation) AddedTeamID() (r int64, exists bool) {
+	v := m.addteam_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamPkHistoryMutation) ResetTeamID() {
+	m.team_id = nil
+	m.addteam_id = nil
+}
+
+// SetSeason sets the "season" field.
+func (m *TeamPkHistoryMutation) SetSeason(i int) {
+	m.season = &i
+	m.addseason = nil
+}
+
+// Season returns the value of the "season" field in the mutation.
+func (m *TeamPkHistoryMutation) Season() (r int, exists bool) {
+	v := m.season
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeason returns the old "season" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldSeason(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeason: %w", err)
+	}
+	return oldValue.Season, nil
+}
+
+// AddSeason adds i to the "season" field.
+func (m *TeamPkHistoryMutation) AddSeason(i int) {
+	if m.addseason != nil {
+		*m.addseason += i
+	} else {
+		m.addseason = &i
+	}
+}
+
+// AddedSeason returns the value that was added to the "season" field in this mutation.
+func (m *TeamPkHistoryMutation) AddedSeason() (r int, exists bool) {
+	v := m.addseason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeason resets all changes to the "season" field.
+func (m *TeamPkHistoryMutation) ResetSeason() {
+	m.season = nil
+	m.addseason = nil
+}
+
+// SetOpponentID sets the "opponent_id" field.
+func (m *TeamPkHistoryMutation) SetOpponentID(i int64) {
+	m.opponent_id = &i
+	m.addopponent_id = nil
+}
+
+// OpponentID returns the value of the "opponent_id" field in the mutation.
+func (m *TeamPkHistoryMutation) OpponentID() (r int64, exists bool) {
+	v := m.opponent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpponentID returns the old "opponent_id" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldOpponentID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpponentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpponentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpponentID: %w", err)
+	}
+	return oldValue.OpponentID, nil
+}
+
+// AddOpponentID adds i to the "opponent_id" field.
+func (m *TeamPkHistoryMutation) AddOpponentID(i int64) {
+	if m.addopponent_id != nil {
+		*m.addopponent_id += i
+	} else {
+		m.addopponent_id = &i
+	}
+}
+
+// AddedOpponentID returns the value that was added to the "opponent_id" field in this mutation.
+func (m *TeamPkHistoryMutation) AddedOpponentID() (r int64, exists bool) {
+	v := m.addopponent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOpponentID resets all changes to the "opponent_id" field.
+func (m *TeamPkHistoryMutation) ResetOpponentID() {
+	m.opponent_id = nil
+	m.addopponent_id = nil
+}
+
+// SetOpponentName sets the "opponent_name" field.
+func (m *TeamPkHistoryMutation) SetOpponentName(s string) {
+	m.opponent_name = &s
+}
+
+// OpponentName returns the value of the "opponent_name" field in the mutation.
+func (m *TeamPkHistoryMutation) OpponentName() (r string, exists bool) {
+	v := m.opponent_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpponentName returns the old "opponent_name" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldOpponentName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpponentName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpponentName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpponentName: %w", err)
+	}
+	return oldValue.OpponentName, nil
+}
+
+// ResetOpponentName resets all changes to the "opponent_name" field.
+func (m *TeamPkHistoryMutation) ResetOpponentName() {
+	m.opponent_name = nil
+}
+
+// SetResult sets the "result" field.
+func (m *TeamPkHistoryMutation) SetResult(i int) {
+	m.result = &i
+	m.addresult = nil
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *TeamPkHistoryMutation) Result() (r int, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldResult(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// AddResult adds i to the "result" field.
+func (m *TeamPkHistoryMutation) AddResult(i int) {
+	if m.addresult != nil {
+		*m.addresult += i
+	} else {
+		m.addresult = &i
+	}
+}
+
+// AddedResult returns the value that was added to the "result" field in this mutation.
+func (m *TeamPkHistoryMutation) AddedResult() (r int, exists bool) {
+	v := m.addresult
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *TeamPkHistoryMutation) ResetResult() {
+	m.result = nil
+	m.addresult = nil
+}
+
+// SetScore sets the "score" field.
+func (m *TeamPkHistoryMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *TeamPkHistoryMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *TeamPkHistoryMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *TeamPkHistoryMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *TeamPkHistoryMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetBuildingHp sets the "building_hp" field.
+func (m *TeamPkHistoryMutation) SetBuildingHp(i int) {
+	m.building_hp = &i
+	m.addbuilding_hp = nil
+}
+
+// BuildingHp returns the value of the "building_hp" field in the mutation.
+func (m *TeamPkHistoryMutation) BuildingHp() (r int, exists bool) {
+	v := m.building_hp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildingHp returns the old "building_hp" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldBuildingHp(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildingHp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildingHp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildingHp: %w", err)
+	}
+	return oldValue.BuildingHp, nil
+}
+
+// AddBuildingHp adds i to the "building_hp" field.
+func (m *TeamPkHistoryMutation) AddBuildingHp(i int) {
+	if m.addbuilding_hp != nil {
+		*m.addbuilding_hp += i
+	} else {
+		m.addbuilding_hp = &i
+	}
+}
+
+// AddedBuildingHp returns the value that was added to the "building_hp" field in this mutation.
+func (m *TeamPkHistoryMutation) AddedBuildingHp() (r int, exists bool) {
+	v := m.addbuilding_hp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBuildingHp resets all changes to the "building_hp" field.
+func (m *TeamPkHistoryMutation) ResetBuildingHp() {
+	m.building_hp = nil
+	m.addbuilding_hp = nil
+}
+
+// SetOpponentBuildingHp sets the "opponent_building_hp" field.
+func (m *TeamPkHistoryMutation) SetOpponentBuildingHp(i int) {
+	m.opponent_building_hp = &i
+	m.addopponent_building_hp = nil
+}
+
+// OpponentBuildingHp returns the value of the "opponent_building_hp" field in the mutation.
+func (m *TeamPkHistoryMutation) OpponentBuildingHp() (r int, exists bool) {
+	v := m.opponent_building_hp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpponentBuildingHp returns the old "opponent_building_hp" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldOpponentBuildingHp(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpponentBuildingHp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpponentBuildingHp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpponentBuildingHp: %w", err)
+	}
+	return oldValue.OpponentBuildingHp, nil
+}
+
+// AddOpponentBuildingHp adds i to the "opponent_building_hp" field.
+func (m *TeamPkHistoryMutation) AddOpponentBuildingHp(i int) {
+	if m.addopponent_building_hp != nil {
+		*m.addopponent_building_hp += i
+	} else {
+		m.addopponent_building_hp = &i
+	}
+}
+
+// AddedOpponentBuildingHp returns the value that was added to the "opponent_building_hp" field in this mutation.
+func (m *TeamPkHistoryMutation) AddedOpponentBuildingHp() (r int, exists bool) {
+	v := m.addopponent_building_hp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOpponentBuildingHp resets all changes to the "opponent_building_hp" field.
+func (m *TeamPkHistoryMutation) ResetOpponentBuildingHp() {
+	m.opponent_building_hp = nil
+	m.addopponent_building_hp = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamPkHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamPkHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TeamPkHistory entity.
+// If the TeamPkHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamPkHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TeamPkHistoryMutation builder.
+func (m *TeamPkHistoryMutation) Where(ps ...predicate.TeamPkHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamPkHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamPkHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamPkHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamPkHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamPkHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamPkHistory).
+func (m *TeamPkHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamPkHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.team_id != nil {
+		fields = append(fields, teampkhistory.FieldTeamID)
+	}
+	if m.season != nil {
+		fields = append(fields, teampkhistory.FieldSeason)
+	}
+	if m.opponent_id != nil {
+		fields = append(fields, teampkhistory.FieldOpponentID)
+	}
+	if m.opponent_name != nil {
+		fields = append(fields, teampkhistory.FieldOpponentName)
+	}
+	if m.result != nil {
+		fields = append(fields, teampkhistory.FieldResult)
+	}
+	if m.score != nil {
+		fields = append(fields, teampkhistory.FieldScore)
+	}
+	if m.building_hp != nil {
+		fields = append(fields, teampkhistory.FieldBuildingHp)
+	}
+	if m.opponent_building_hp != nil {
+		fields = append(fields, teampkhistory.FieldOpponentBuildingHp)
+	}
+	if m.created_at != nil {
+		fields = append(fields, teampkhistory.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamPkHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teampkhistory.FieldTeamID:
+		return m.TeamID()
+	case teampkhistory.FieldSeason:
+		return m.Season()
+	case teampkhistory.FieldOpponentID:
+		return m.OpponentID()
+	case teampkhistory.FieldOpponentName:
+		return m.OpponentName()
+	case teampkhistory.FieldResult:
+		return m.Result()
+	case teampkhistory.FieldScore:
+		return m.Score()
+	case teampkhistory.FieldBuildingHp:
+		return m.BuildingHp()
+	case teampkhistory.FieldOpponentBuildingHp:
+		return m.OpponentBuildingHp()
+	case teampkhistory.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamPkHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teampkhistory.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teampkhistory.FieldSeason:
+		return m.OldSeason(ctx)
+	case teampkhistory.FieldOpponentID:
+		return m.OldOpponentID(ctx)
+	case teampkhistory.FieldOpponentName:
+		return m.OldOpponentName(ctx)
+	case teampkhistory.FieldResult:
+		return m.OldResult(ctx)
+	case teampkhistory.FieldScore:
+		return m.OldScore(ctx)
+	case teampkhistory.FieldBuildingHp:
+		return m.OldBuildingHp(ctx)
+	case teampkhistory.FieldOpponentBuildingHp:
+		return m.OldOpponentBuildingHp(ctx)
+	case teampkhistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamPkHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamPkHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teampkhistory.FieldTeamID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFightBadge(v)
+		m.SetTeamID(v)
 		return nil
-	case player.FieldTimeToday:
-		v, ok := value.(int64)
+	case teampkhistory.FieldSeason:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimeToday(v)
+		m.SetSeason(v)
 		return nil
-	case player.FieldTimeLimit:
+	case teampkhistory.FieldOpponentID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimeLimit(v)
+		m.SetOpponentID(v)
 		return nil
-	case player.FieldTeacherID:
-		v, ok := value.(int64)
+	case teampkhistory.FieldOpponentName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeacherID(v)
+		m.SetOpponentName(v)
 		return nil
-	case player.FieldStudentID:
-		v, ok := value.(int64)
+	case teampkhistory.FieldResult:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStudentID(v)
+		m.SetResult(v)
 		return nil
-	case player.FieldCurTitle:
-		v, ok := value.(int64)
+	case teampkhistory.FieldScore:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurTitle(v)
+		m.SetScore(v)
 		return nil
-	case player.FieldTaskStatus:
-		v, ok := value.(string)
+	case teampkhistory.FieldBuildingHp:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTaskStatus(v)
+		m.SetBuildingHp(v)
 		return nil
-	case player.FieldTaskBufs:
-		v, ok := value.(string)
+	case teampkhistory.FieldOpponentBuildingHp:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTaskBufs(v)
+		m.SetOpponentBuildingHp(v)
 		return nil
-	case player.FieldFriends:
-		v, ok := value.(string)
+	case teampkhistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFriends(v)
+		m.SetCreatedAt(v)
 		return nil
-	case player.FieldBlacklist:
-		v, ok := value.(string)
+	}
+	return fmt.Errorf("unknown TeamPkHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamPkHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addteam_id != nil {
+		fields = append(fields, teampkhistory.FieldTeamID)
+	}
+	if m.addseason != nil {
+		fields = append(fields, teampkhistory.FieldSeason)
+	}
+	if m.addopponent_id != nil {
+		fields = append(fields, teampkhistory.FieldOpponentID)
+	}
+	if m.addresult != nil {
+		fields = append(fields, teampkhistory.FieldResult)
+	}
+	if m.addscore != nil {
+		fields = append(fields, teampkhistory.FieldScore)
+	}
+	if m.addbuilding_hp != nil {
+		fields = append(fields, teampkhistory.FieldBuildingHp)
+	}
+	if m.addopponent_building_hp != nil {
+		fields = append(fields, teampkhistory.FieldOpponentBuildingHp)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamPkHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teampkhistory.FieldTeamID:
+		return m.AddedTeamID()
+	case teampkhistory.FieldSeason:
+		return m.AddedSeason()
+	case teampkhistory.FieldOpponentID:
+		return m.AddedOpponentID()
+	case teampkhistory.FieldResult:
+		return m.AddedResult()
+	case teampkhistory.FieldScore:
+		return m.AddedScore()
+	case teampkhistory.FieldBuildingHp:
+		return m.AddedBuildingHp()
+	case teampkhistory.FieldOpponentBuildingHp:
+		return m.AddedOpponentBuildingHp()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamPkHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case teampkhistory.FieldTeamID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBlacklist(v)
+		m.AddTeamID(v)
 		return nil
-	case player.FieldAchievements:
-		v, ok := value.(string)
+	case teampkhistory.FieldSeason:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAchievements(v)
+		m.AddSeason(v)
 		return nil
-	case player.FieldTitles:
-		v, ok := value.(string)
+	case teampkhistory.FieldOpponentID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitles(v)
+		m.AddOpponentID(v)
 		return nil
-	case player.FieldTeamInfo:
-		v, ok := value.(string)
+	case teampkhistory.FieldResult:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamInfo(v)
+		m.AddResult(v)
 		return nil
-	case player.FieldStudentIds:
-		v, ok := value.(string)
+	case teampkhistory.FieldScore:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStudentIds(v)
+		m.AddScore(v)
 		return nil
-	case player.FieldRoomID:
-		v, ok := value.(int64)
+	case teampkhistory.FieldBuildingHp:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoomID(v)
+		m.AddBuildingHp(v)
 		return nil
-	case player.FieldFitments:
-		v, ok := value.(string)
+	case teampkhistory.FieldOpponentBuildingHp:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFitments(v)
+		m.AddOpponentBuildingHp(v)
 		return nil
-	case player.FieldNonoInfo:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNonoInfo(v)
+	}
+	return fmt.Errorf("unknown TeamPkHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamPkHistoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamPkHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamPkHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamPkHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamPkHistoryMutation) ResetField(name string) error {
+	switch name {
+	case teampkhistory.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case player.FieldMailbox:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMailbox(v)
+	case teampkhistory.FieldSeason:
+		m.ResetSeason()
 		return nil
-	case player.FieldCurrentPetID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPetID(v)
+	case teampkhistory.FieldOpponentID:
+		m.ResetOpponentID()
 		return nil
-	case player.FieldCurrentPetCatchTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPetCatchTime(v)
+	case teampkhistory.FieldOpponentName:
+		m.ResetOpponentName()
 		return nil
-	case player.FieldCurrentPetDv:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPetDv(v)
+	case teampkhistory.FieldResult:
+		m.ResetResult()
 		return nil
-	case player.FieldLastLoginAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case teampkhistory.FieldScore:
+		m.ResetScore()
+		return nil
+	case teampkhistory.FieldBuildingHp:
+		m.ResetBuildingHp()
+		return nil
+	case teampkhistory.FieldOpponentBuildingHp:
+		m.ResetOpponentBuildingHp()
+		return nil
+	case teampkhistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamPkHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamPkHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamPkHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamPkHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamPkHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamPkHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamPkHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamPkHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamPkHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamPkHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamPkHistory edge %s", name)
+}
+
+// TeamPkScoreMutation represents an operation that mutates the TeamPkScore nodes in the graph.
+type TeamPkScoreMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	team_id       *int64
+	addteam_id    *int64
+	season        *int
+	addseason     *int
+	name          *string
+	score         *int
+	addscore      *int
+	wins          *int
+	addwins       *int
+	losses        *int
+	addlosses     *int
+	draws         *int
+	adddraws      *int
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TeamPkScore, error)
+	predicates    []predicate.TeamPkScore
+}
+
+var _ ent.Mutation = (*TeamPkScoreMutation)(nil)
+
+// teampkscoreOption allows management of the mutation configuration using functional options.
+type teampkscoreOption func(*TeamPkScoreMutation)
+
+// newTeamPkScoreMutation creates new mutation for the TeamPkScore entity.
+func newTeamPkScoreMutation(c config, op Op, opts ...teampkscoreOption) *TeamPkScoreMutation {
+	m := &TeamPkScoreMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamPkScore,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamPkScoreID sets the ID field of the mutation.
+func withTeamPkScoreID(id int) teampkscoreOption {
+	return func(m *TeamPkScoreMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamPkScore
+		)
+		m.oldValue = func(ctx context.Context) (*TeamPkScore, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamPkScore.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.SetLastLoginAt(v)
-		return nil
-	case player.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &id
+	}
+}
+
+// withTeamPkScore sets the old TeamPkScore of the mutation.
+func withTeamPkScore(node *TeamPkScore) teampkscoreOption {
+	return func(m *TeamPkScoreMutation) {
+		m.oldValue = func(context.Context) (*TeamPkScore, error) {
+			return node, nil
 		}
-		m.SetCreatedAt(v)
-		return nil
-	case player.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamPkScoreMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamPkScoreMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamPkScoreMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamPkScoreMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
 		}
-		m.SetUpdatedAt(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamPkScore.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamPkScoreMutation) SetTeamID(i int64) {
+	m.team_id = &i
+	m.addteam_id = nil
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamPkScoreMutation) TeamID() (r int64, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldTeamID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// AddTeamID adds i to the "team_id" field.
+func (m *TeamPkScoreMutation) AddTeamID(i int64) {
+	if m.addteam_id != nil {
+		*m.addteam_id += i
+	} else {
+		m.addteam_id = &i
+	}
+}
+
+// AddedTeamID returns the value that was added to the "team_id" field in this mutation.
+func (m *TeamPkScoreMutation) AddedTeamID() (r int64, exists bool) {
+	v := m.addteam_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamPkScoreMutation) ResetTeamID() {
+	m.team_id = nil
+	m.addteam_id = nil
+}
+
+// SetSeason sets the "season" field.
+func (m *TeamPkScoreMutation) SetSeason(i int) {
+	m.season = &i
+	m.addseason = nil
+}
+
+// Season returns the value of the "season" field in the mutation.
+func (m *TeamPkScoreMutation) Season() (r int, exists bool) {
+	v := m.season
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeason returns the old "season" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldSeason(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeason: %w", err)
+	}
+	return oldValue.Season, nil
+}
+
+// AddSeason adds i to the "season" field.
+func (m *TeamPkScoreMutation) AddSeason(i int) {
+	if m.addseason != nil {
+		*m.addseason += i
+	} else {
+		m.addseason = &i
+	}
+}
+
+// AddedSeason returns the value that was added to the "season" field in this mutation.
+func (m *TeamPkScoreMutation) AddedSeason() (r int, exists bool) {
+	v := m.addseason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeason resets all changes to the "season" field.
+func (m *TeamPkScoreMutation) ResetSeason() {
+	m.season = nil
+	m.addseason = nil
+}
+
+// SetName sets the "name" field.
+func (m *TeamPkScoreMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamPkScoreMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamPkScoreMutation) ResetName() {
+	m.name = nil
+}
+
+// SetScore sets the "score" field.
+func (m *TeamPkScoreMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *TeamPkScoreMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *TeamPkScoreMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *TeamPkScoreMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *TeamPkScoreMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetWins sets the "wins" field.
+func (m *TeamPkScoreMutation) SetWins(i int) {
+	m.wins = &i
+	m.addwins = nil
+}
+
+// Wins returns the value of the "wins" field in the mutation.
+func (m *TeamPkScoreMutation) Wins() (r int, exists bool) {
+	v := m.wins
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWins returns the old "wins" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldWins(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWins is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWins requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWins: %w", err)
+	}
+	return oldValue.Wins, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PlayerMutation) AddedFields() []string {
-	var fields []string
-	if m.addlevel != nil {
-		fields = append(fields, player.FieldLevel)
+// AddWins adds i to the "wins" field.
+func (m *TeamPkScoreMutation) AddWins(i int) {
+	if m.addwins != nil {
+		*m.addwins += i
+	} else {
+		m.addwins = &i
 	}
-	if m.addcoins != nil {
-		fields = append(fields, player.FieldCoins)
+}
+
+// AddedWins returns the value that was added to the "wins" field in this mutation.
+func (m *TeamPkScoreMutation) AddedWins() (r int, exists bool) {
+	v := m.addwins
+	if v == nil {
+		return
 	}
-	if m.addgold != nil {
-		fields = append(fields, player.FieldGold)
+	return *v, true
+}
+
+// ResetWins resets all changes to the "wins" field.
+func (m *TeamPkScoreMutation) ResetWins() {
+	m.wins = nil
+	m.addwins = nil
+}
+
+// SetLosses sets the "losses" field.
+func (m *TeamPkScoreMutation) SetLosses(i int) {
+	m.losses = &i
+	m.addlosses = nil
+}
+
+// Losses returns the value of the "losses" field in the mutation.
+func (m *TeamPkScoreMutation) Losses() (r int, exists bool) {
+	v := m.losses
+	if v == nil {
+		return
 	}
-	if m.addmap_id != nil {
-		fields = append(fields, player.FieldMapID)
+	return *v, true
+}
+
+// OldLosses returns the old "losses" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldLosses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLosses is only allowed on UpdateOne operations")
 	}
-	if m.addmap_type != nil {
-		fields = append(fields, player.FieldMapType)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLosses requires an ID field in the mutation")
 	}
-	if m.addpos_x != nil {
-		fields = append(fields, player.FieldPosX)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLosses: %w", err)
 	}
-	if m.addpos_y != nil {
-		fields = append(fields, player.FieldPosY)
+	return oldValue.Losses, nil
+}
+
+// AddLosses adds i to the "losses" field.
+func (m *TeamPkScoreMutation) AddLosses(i int) {
+	if m.addlosses != nil {
+		*m.addlosses += i
+	} else {
+		m.addlosses = &i
 	}
-	if m.addlast_map_id != nil {
-		fields = append(fields, player.FieldLastMapID)
+}
+
+// AddedLosses returns the value that was added to the "losses" field in this mutation.
+func (m *TeamPkScoreMutation) AddedLosses() (r int, exists bool) {
+	v := m.addlosses
+	if v == nil {
+		return
 	}
-	if m.addcolor != nil {
-		fields = append(fields, player.FieldColor)
+	return *v, true
+}
+
+// ResetLosses resets all changes to the "losses" field.
+func (m *TeamPkScoreMutation) ResetLosses() {
+	m.losses = nil
+	m.addlosses = nil
+}
+
+// SetDraws sets the "draws" field.
+func (m *TeamPkScoreMutation) SetDraws(i int) {
+	m.draws = &i
+	m.adddraws = nil
+}
+
+// Draws returns the value of the "draws" field in the mutation.
+func (m *TeamPkScoreMutation) Draws() (r int, exists bool) {
+	v := m.draws
+	if v == nil {
+		return
 	}
-	if m.addtexture != nil {
-		fields = append(fields, player.FieldTexture)
+	return *v, true
+}
+
+// OldDraws returns the old "draws" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldDraws(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDraws is only allowed on UpdateOne operations")
 	}
-	if m.addenergy != nil {
-		fields = append(fields, player.FieldEnergy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDraws requires an ID field in the mutation")
 	}
-	if m.addfight_badge != nil {
-		fields = append(fields, player.FieldFightBadge)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDraws: %w", err)
 	}
-	if m.addtime_today != nil {
-		fields = append(fields, player.FieldTimeToday)
+	return oldValue.Draws, nil
+}
+
+// AddDraws adds i to the "draws" field.
+func (m *TeamPkScoreMutation) AddDraws(i int) {
+	if m.adddraws != nil {
+		*m.adddraws += i
+	} else {
+		m.adddraws = &i
 	}
-	if m.addtime_limit != nil {
-		fields = append(fields, player.FieldTimeLimit)
+}
+
+// AddedDraws returns the value that was added to the "draws" field in this mutation.
+func (m *TeamPkScoreMutation) AddedDraws() (r int, exists bool) {
+	v := m.adddraws
+	if v == nil {
+		return
 	}
-	if m.addteacher_id != nil {
-		fields = append(fields, player.FieldTeacherID)
+	return *v, true
+}
+
+// ResetDraws resets all changes to the "draws" field.
+func (m *TeamPkScoreMutation) ResetDraws() {
+	m.draws = nil
+	m.adddraws = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamPkScoreMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamPkScoreMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.addstudent_id != nil {
-		fields = append(fields, player.FieldStudentID)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamPkScore entity.
+// If the TeamPkScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkScoreMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.addcur_title != nil {
-		fields = append(fields, player.FieldCurTitle)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.addroom_id != nil {
-		fields = append(fields, player.FieldRoomID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	if m.addcurrent_pet_id != nil {
-		fields = append(fields, player.FieldCurrentPetID)
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamPkScoreMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the TeamPkScoreMutation builder.
+func (m *TeamPkScoreMutation) Where(ps ...predicate.TeamPkScore) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamPkScoreMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamPkScoreMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamPkScore, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.addcurrent_pet_catch_time != nil {
-		fields = append(fields, player.FieldCurrentPetCatchTime)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamPkScoreMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamPkScoreMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamPkScore).
+func (m *TeamPkScoreMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamPkScoreMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.team_id != nil {
+		fields = append(fields, teampkscore.FieldTeamID)
 	}
-	if m.addcurrent_pet_dv != nil {
-		fields = append(fields, player.FieldCurrentPetDv)
+	if m.season != nil {
+		fields = append(fields, teampkscore.FieldSeason)
+	}
+	if m.name != nil {
+		fields = append(fields, teampkscore.FieldName)
+	}
+	if m.score != nil {
+		fields = append(fields, teampkscore.FieldScore)
+	}
+	if m.wins != nil {
+		fields = append(fields, teampkscore.FieldWins)
+	}
+	if m.losses != nil {
+		fields = append(fields, teampkscore.FieldLosses)
+	}
+	if m.draws != nil {
+		fields = append(fields, teampkscore.FieldDraws)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, teampkscore.FieldUpdatedAt)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamPkScoreMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case player.FieldLevel:
-		return m.AddedLevel()
-	case player.FieldCoins:
-		return m.AddedCoins()
-	case player.FieldGold:
-		return m.AddedGold()
-	case player.FieldMapID:
-		return m.AddedMapID()
-	case player.FieldMapType:
-		return m.AddedMapType()
-	case player.FieldPosX:
-		return m.AddedPosX()
-	case player.FieldPosY:
-		return m.AddedPosY()
-	case player.FieldLastMapID:
-		return m.AddedLastMapID()
-	case player.FieldColor:
-		return m.AddedColor()
-	case player.FieldTexture:
-		return m.AddedTexture()
-	case player.FieldEnergy:
-		return m.AddedEnergy()
-	case player.FieldFightBadge:
-		return m.AddedFightBadge()
-	case player.FieldTimeToday:
-		return m.AddedTimeToday()
-	case player.FieldTimeLimit:
-		return m.AddedTimeLimit()
-	case player.FieldTeacherID:
-		return m.AddedTeacherID()
-	case player.FieldStudentID:
-		return m.AddedStudentID()
-	case player.FieldCurTitle:
-		return m.AddedCurTitle()
-	case player.FieldRoomID:
-		return m.AddedRoomID()
-	case player.FieldCurrentPetID:
-		return m.AddedCurrentPetID()
-	case player.FieldCurrentPetCatchTime:
-		return m.AddedCurrentPetCatchTime()
-	case player.FieldCurrentPetDv:
-		return m.AddedCurrentPetDv()
+	case teampkscore.FieldTeamID:
+		return m.TeamID()
+	case teampkscore.FieldSeason:
+		return m.Season()
+	case teampkscore.FieldName:
+		return m.Name()
+	case teampkscore.FieldScore:
+		return m.Score()
+	case teampkscore.FieldWins:
+		return m.Wins()
+	case teampkscore.FieldLosses:
+		return m.Losses()
+	case teampkscore.FieldDraws:
+		return m.Draws()
+	case teampkscore.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamPkScoreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case player.FieldLevel:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLevel(v)
-		return nil
-	case player.FieldCoins:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCoins(v)
-		return nil
-	case player.FieldGold:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddGold(v)
-		return nil
-	case player.FieldMapID:
-		v, ok := value.(int)
+	case teampkscore.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teampkscore.FieldSeason:
+		return m.OldSeason(ctx)
+	case teampkscore.FieldName:
+		return m.OldName(ctx)
+	case teampkscore.FieldScore:
+		return m.OldScore(ctx)
+	case teampkscore.FieldWins:
+		return m.OldWins(ctx)
+	case teampkscore.FieldLosses:
+		return m.OldLosses(ctx)
+	case teampkscore.FieldDraws:
+		return m.OldDraws(ctx)
+	case teampkscore.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamPkScore field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamPkScoreMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teampkscore.FieldTeamID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMapID(v)
+		m.SetTeamID(v)
 		return nil
-	case player.FieldMapType:
+	case teampkscore.FieldSeason:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMapType(v)
+		m.SetSeason(v)
 		return nil
-	case player.FieldPosX:
-		v, ok := value.(int)
+	case teampkscore.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPosX(v)
+		m.SetName(v)
 		return nil
-	case player.FieldPosY:
+	case teampkscore.FieldScore:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPosY(v)
+		m.SetScore(v)
 		return nil
-	case player.FieldLastMapID:
+	case teampkscore.FieldWins:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLastMapID(v)
-		return nil
-	case player.FieldColor:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddColor(v)
-		return nil
-	case player.FieldTexture:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTexture(v)
-		return nil
-	case player.FieldEnergy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddEnergy(v)
-		return nil
-	case player.FieldFightBadge:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFightBadge(v)
+		m.SetWins(v)
 		return nil
-	case player.FieldTimeToday:
-		v, ok := value.(int64)
+	case teampkscore.FieldLosses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTimeToday(v)
+		m.SetLosses(v)
 		return nil
-	case player.FieldTimeLimit:
-		v, ok := value.(int64)
+	case teampkscore.FieldDraws:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTimeLimit(v)
+		m.SetDraws(v)
 		return nil
-	case player.FieldTeacherID:
-		v, ok := value.(int64)
+	case teampkscore.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTeacherID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case player.FieldStudentID:
+	}
+	return fmt.Errorf("unknown TeamPkScore field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamPkScoreMutation) AddedFields() []string {
+	var fields []string
+	if m.addteam_id != nil {
+		fields = append(fields, teampkscore.FieldTeamID)
+	}
+	if m.addseason != nil {
+		fields = append(fields, teampkscore.FieldSeason)
+	}
+	if m.addscore != nil {
+		fields = append(fields, teampkscore.FieldScore)
+	}
+	if m.addwins != nil {
+		fields = append(fields, teampkscore.FieldWins)
+	}
+	if m.addlosses != nil {
+		fields = append(fields, teampkscore.FieldLosses)
+	}
+	if m.adddraws != nil {
+		fields = append(fields, teampkscore.FieldDraws)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamPkScoreMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teampkscore.FieldTeamID:
+		return m.AddedTeamID()
+	case teampkscore.FieldSeason:
+		return m.AddedSeason()
+	case teampkscore.FieldScore:
+		return m.AddedScore()
+	case teampkscore.FieldWins:
+		return m.AddedWins()
+	case teampkscore.FieldLosses:
+		return m.AddedLosses()
+	case teampkscore.FieldDraws:
+		return m.AddedDraws()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamPkScoreMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case teampkscore.FieldTeamID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStudentID(v)
+		m.AddTeamID(v)
 		return nil
-	case player.FieldCurTitle:
-		v, ok := value.(int64)
+	case teampkscore.FieldSeason:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCurTitle(v)
+		m.AddSeason(v)
 		return nil
-	case player.FieldRoomID:
-		v, ok := value.(int64)
+	case teampkscore.FieldScore:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRoomID(v)
+		m.AddScore(v)
 		return nil
-	case player.FieldCurrentPetID:
-		v, ok := value.(int64)
+	case teampkscore.FieldWins:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCurrentPetID(v)
+		m.AddWins(v)
 		return nil
-	case player.FieldCurrentPetCatchTime:
-		v, ok := value.(int64)
+	case teampkscore.FieldLosses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCurrentPetCatchTime(v)
+		m.AddLosses(v)
 		return nil
-	case player.FieldCurrentPetDv:
-		v, ok := value.(int64)
+	case teampkscore.FieldDraws:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCurrentPetDv(v)
+		m.AddDraws(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Player numeric field %s", name)
+	return fmt.Errorf("unknown TeamPkScore numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlayerMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(player.FieldLastLoginAt) {
-		fields = append(fields, player.FieldLastLoginAt)
-	}
-	return fields
+func (m *TeamPkScoreMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlayerMutation) FieldCleared(name string) bool {
+func (m *TeamPkScoreMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlayerMutation) ClearField(name string) error {
-	switch name {
-	case player.FieldLastLoginAt:
-		m.ClearLastLoginAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Player nullable field %s", name)
+func (m *TeamPkScoreMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamPkScore nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlayerMutation) ResetField(name string) error {
+func (m *TeamPkScoreMutation) ResetField(name string) error {
 	switch name {
-	case player.FieldAccountID:
-		m.ResetAccountID()
-		return nil
-	case player.FieldNick:
-		m.ResetNick()
-		return nil
-	case player.FieldLevel:
-		m.ResetLevel()
-		return nil
-	case player.FieldCoins:
-		m.ResetCoins()
-		return nil
-	case player.FieldGold:
-		m.ResetGold()
-		return nil
-	case player.FieldMapID:
-		m.ResetMapID()
-		return nil
-	case player.FieldMapType:
-		m.ResetMapType()
-		return nil
-	case player.FieldPosX:
-		m.ResetPosX()
-		return nil
-	case player.FieldPosY:
-		m.ResetPosY()
-		return nil
-	case player.FieldLastMapID:
-		m.ResetLastMapID()
-		return nil
-	case player.FieldColor:
-		m.ResetColor()
-		return nil
-	case player.FieldTexture:
-		m.ResetTexture()
-		return nil
-	case player.FieldEnergy:
-		m.ResetEnergy()
-		return nil
-	case player.FieldFightBadge:
-		m.ResetFightBadge()
-		return nil
-	case player.FieldTimeToday:
-		m.ResetTimeToday()
-		return nil
-	case player.FieldTimeLimit:
-		m.ResetTimeLimit()
-		return nil
-	case player.FieldTeacherID:
-		m.ResetTeacherID()
-		return nil
-	case player.FieldStudentID:
-		m.ResetStudentID()
-		return nil
-	case player.FieldCurTitle:
-		m.ResetCurTitle()
-		return nil
-	case player.FieldTaskStatus:
-		m.ResetTaskStatus()
-		return nil
-	case player.FieldTaskBufs:
-		m.ResetTaskBufs()
-		return nil
-	case player.FieldFriends:
-		m.ResetFriends()
-		return nil
-	case player.FieldBlacklist:
-		m.ResetBlacklist()
-		return nil
-	case player.FieldAchievements:
-		m.ResetAchievements()
-		return nil
-	case player.FieldTitles:
-		m.ResetTitles()
-		return nil
-	case player.FieldTeamInfo:
-		m.ResetTeamInfo()
-		return nil
-	case player.FieldStudentIds:
-		m.ResetStudentIds()
-		return nil
-	case player.FieldRoomID:
-		m.ResetRoomID()
-		return nil
-	case player.FieldFitments:
-		m.ResetFitments()
+	case teampkscore.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case player.FieldNonoInfo:
-		m.ResetNonoInfo()
-		return nil
-	case player.FieldMailbox:
-		m.ResetMailbox()
+	case teampkscore.FieldSeason:
+		m.ResetSeason()
 		return nil
-	case player.FieldCurrentPetID:
-		m.ResetCurrentPetID()
+	case teampkscore.FieldName:
+		m.ResetName()
 		return nil
-	case player.FieldCurrentPetCatchTime:
-		m.ResetCurrentPetCatchTime()
+	case teampkscore.FieldScore:
+		m.ResetScore()
 		return nil
-	case player.FieldCurrentPetDv:
-		m.ResetCurrentPetDv()
+	case teampkscore.FieldWins:
+		m.ResetWins()
 		return nil
-	case player.FieldLastLoginAt:
-		m.ResetLastLoginAt()
+	case teampkscore.FieldLosses:
+		m.ResetLosses()
 		return nil
-	case player.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case teampkscore.FieldDraws:
+		m.ResetDraws()
 		return nil
-	case player.FieldUpdatedAt:
+	case teampkscore.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown TeamPkScore field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlayerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.account != nil {
-		edges = append(edges, player.EdgeAccount)
-	}
-	if m.pets != nil {
-		edges = append(edges, player.EdgePets)
-	}
-	if m.items != nil {
-		edges = append(edges, player.EdgeItems)
-	}
+func (m *TeamPkScoreMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case player.EdgeAccount:
-		if id := m.account; id != nil {
-			return []ent.Value{*id}
-		}
-	case player.EdgePets:
-		ids := make([]ent.Value, 0, len(m.pets))
-		for id := range m.pets {
-			ids = append(ids, id)
-		}
-		return ids
-	case player.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TeamPkScoreMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlayerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedpets != nil {
-		edges = append(edges, player.EdgePets)
-	}
-	if m.removeditems != nil {
-		edges = append(edges, player.EdgeItems)
-	}
+func (m *TeamPkScoreMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case player.EdgePets:
-		ids := make([]ent.Value, 0, len(m.removedpets))
-		for id := range m.removedpets {
-			ids = append(ids, id)
-		}
-		return ids
-	case player.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TeamPkScoreMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlayerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedaccount {
-		edges = append(edges, player.EdgeAccount)
-	}
-	if m.clearedpets {
-		edges = append(edges, player.EdgePets)
-	}
-	if m.cleareditems {
-		edges = append(edges, player.EdgeItems)
-	}
+func (m *TeamPkScoreMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlayerMutation) EdgeCleared(name string) bool {
-	switch name {
-	case player.EdgeAccount:
-		return m.clearedaccount
-	case player.EdgePets:
-		return m.clearedpets
-	case player.EdgeItems:
-		return m.cleareditems
-	}
+func (m *TeamPkScoreMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlayerMutation) ClearEdge(name string) error {
-	switch name {
-	case player.EdgeAccount:
-		m.ClearAccount()
-		return nil
-	}
-	return fmt.Errorf("unknown Player unique edge %s", name)
+func (m *TeamPkScoreMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamPkScore unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlayerMutation) ResetEdge(name string) error {
-	switch name {
-	case player.EdgeAccount:
-		m.ResetAccount()
-		return nil
-	case player.EdgePets:
-		m.ResetPets()
-		return nil
-	case player.EdgeItems:
-		m.ResetItems()
-		return nil
-	}
-	return fmt.Errorf("unknown Player edge %s", name)
+func (m *TeamPkScoreMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamPkScore edge %s", name)
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
+// TeamPkSeerScoreMutation represents an operation that mutates the TeamPkSeerScore nodes in the graph.
+type TeamPkSeerScoreMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	description        *string
-	created_at         *time.Time
-	clearedFields      map[string]struct{}
-	permissions        map[int]struct{}
-	removedpermissions map[int]struct{}
-	clearedpermissions bool
-	gm_users           map[int]struct{}
-	removedgm_users    map[int]struct{}
-	clearedgm_users    bool
-	done               bool
-	oldValue           func(context.Context) (*Role, error)
-	predicates         []predicate.Role
+	op            Op
+	typ           string
+	id            *int
+	user_id       *int64
+	adduser_id    *int64
+	season        *int
+	addseason     *int
+	team_id       *int64
+	addteam_id    *int64
+	nick          *string
+	score         *int
+	addscore      *int
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TeamPkSeerScore, error)
+	predicates    []predicate.TeamPkSeerScore
 }
 
-var _ ent.Mutation = (*RoleMutation)(nil)
+var _ ent.Mutation = (*TeamPkSeerScoreMutation)(nil)
 
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
+// teampkseerscoreOption allows management of the mutation configuration using functional options.
+type teampkseerscoreOption func(*TeamPkSeerScoreMutation)
 
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
+// newTeamPkSeerScoreMutation creates new mutation for the TeamPkSeerScore entity.
+func newTeamPkSeerScoreMutation(c config, op Op, opts ...teampkseerscoreOption) *TeamPkSeerScoreMutation {
+	m := &TeamPkSeerScoreMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRole,
+		typ:           TypeTeamPkSeerScore,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9028,20 +17957,20 @@ func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
 	return m
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id int) roleOption {
-	return func(m *RoleMutation) {
+// withTeamPkSeerScoreID sets the ID field of the mutation.
+func withTeamPkSeerScoreID(id int) teampkseerscoreOption {
+	return func(m *TeamPkSeerScoreMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Role
+			value *TeamPkSeerScore
 		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
+		m.oldValue = func(ctx context.Context) (*TeamPkSeerScore, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Role.Get(ctx, id)
+					value, err = m.Client().TeamPkSeerScore.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9050,10 +17979,10 @@ func withRoleID(id int) roleOption {
 	}
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
+// withTeamPkSeerScore sets the old TeamPkSeerScore of the mutation.
+func withTeamPkSeerScore(node *TeamPkSeerScore) teampkseerscoreOption {
+	return func(m *TeamPkSeerScoreMutation) {
+		m.oldValue = func(context.Context) (*TeamPkSeerScore, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9062,7 +17991,7 @@ func withRole(node *Role) roleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
+func (m TeamPkSeerScoreMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9070,7 +17999,7 @@ func (m RoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
+func (m TeamPkSeerScoreMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9081,7 +18010,7 @@ func (m RoleMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id int, exists bool) {
+func (m *TeamPkSeerScoreMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9092,7 +18021,7 @@ func (m *RoleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TeamPkSeerScoreMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9101,237 +18030,317 @@ func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TeamPkSeerScore.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
+// SetUserID sets the "user_id" field.
+func (m *TeamPkSeerScoreMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TeamPkSeerScoreMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the TeamPkSeerScore entity.
+// If the TeamPkSeerScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TeamPkSeerScoreMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UserID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
+// AddUserID adds i to the "user_id" field.
+func (m *TeamPkSeerScoreMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *RoleMutation) SetDescription(s string) {
-	m.description = &s
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *TeamPkSeerScoreMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *RoleMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TeamPkSeerScoreMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetSeason sets the "season" field.
+func (m *TeamPkSeerScoreMutation) SetSeason(i int) {
+	m.season = &i
+	m.addseason = nil
+}
+
+// Season returns the value of the "season" field in the mutation.
+func (m *TeamPkSeerScoreMutation) Season() (r int, exists bool) {
+	v := m.season
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldSeason returns the old "season" field's value of the TeamPkSeerScore entity.
+// If the TeamPkSeerScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *TeamPkSeerScoreMutation) OldSeason(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSeason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeason: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Season, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RoleMutation) ResetDescription() {
-	m.description = nil
+// AddSeason adds i to the "season" field.
+func (m *TeamPkSeerScoreMutation) AddSeason(i int) {
+	if m.addseason != nil {
+		*m.addseason += i
+	} else {
+		m.addseason = &i
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RoleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedSeason returns the value that was added to the "season" field in this mutation.
+func (m *TeamPkSeerScoreMutation) AddedSeason() (r int, exists bool) {
+	v := m.addseason
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetSeason resets all changes to the "season" field.
+func (m *TeamPkSeerScoreMutation) ResetSeason() {
+	m.season = nil
+	m.addseason = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamPkSeerScoreMutation) SetTeamID(i int64) {
+	m.team_id = &i
+	m.addteam_id = nil
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamPkSeerScoreMutation) TeamID() (r int64, exists bool) {
+	v := m.team_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the TeamPkSeerScore entity.
+// If the TeamPkSeerScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamPkSeerScoreMutation) OldTeamID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddTeamID adds i to the "team_id" field.
+func (m *TeamPkSeerScoreMutation) AddTeamID(i int64) {
+	if m.addteam_id != nil {
+		*m.addteam_id += i
+	} else {
+		m.addteam_id = &i
+	}
 }
 
-// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
-func (m *RoleMutation) AddPermissionIDs(ids ...int) {
-	if m.permissions == nil {
-		m.permissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.permissions[ids[i]] = struct{}{}
+// AddedTeamID returns the value that was added to the "team_id" field in this mutation.
+func (m *TeamPkSeerScoreMutation) AddedTeamID() (r int64, exists bool) {
+	v := m.addteam_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearPermissions clears the "permissions" edge to the Permission entity.
-func (m *RoleMutation) ClearPermissions() {
-	m.clearedpermissions = true
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamPkSeerScoreMutation) ResetTeamID() {
+	m.team_id = nil
+	m.addteam_id = nil
 }
 
-// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
-func (m *RoleMutation) PermissionsCleared() bool {
-	return m.clearedpermissions
+// SetNick sets the "nick" field.
+func (m *TeamPkSeerScoreMutation) SetNick(s string) {
+	m.nick = &s
 }
 
-// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
-func (m *RoleMutation) RemovePermissionIDs(ids ...int) {
-	if m.removedpermissions == nil {
-		m.removedpermissions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.permissions, ids[i])
-		m.removedpermissions[ids[i]] = struct{}{}
+// Nick returns the value of the "nick" field in the mutation.
+func (m *TeamPkSeerScoreMutation) Nick() (r string, exists bool) {
+	v := m.nick
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
-func (m *RoleMutation) RemovedPermissionsIDs() (ids []int) {
-	for id := range m.removedpermissions {
-		ids = append(ids, id)
+// OldNick returns the old "nick" field's value of the TeamPkSeerScore entity.
+// If the TeamPkSeerScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkSeerScoreMutation) OldNick(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNick is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNick requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNick: %w", err)
+	}
+	return oldValue.Nick, nil
 }
 
-// PermissionsIDs returns the "permissions" edge IDs in the mutation.
-func (m *RoleMutation) PermissionsIDs() (ids []int) {
-	for id := range m.permissions {
-		ids = append(ids, id)
-	}
-	return
+// ResetNick resets all changes to the "nick" field.
+func (m *TeamPkSeerScoreMutation) ResetNick() {
+	m.nick = nil
 }
 
-// ResetPermissions resets all changes to the "permissions" edge.
-func (m *RoleMutation) ResetPermissions() {
-	m.permissions = nil
-	m.clearedpermissions = false
-	m.removedpermissions = nil
+// SetScore sets the "score" field.
+func (m *TeamPkSeerScoreMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
 }
 
-// AddGmUserIDs adds the "gm_users" edge to the GMUser entity by ids.
-func (m *RoleMutation) AddGmUserIDs(ids ...int) {
-	if m.gm_users == nil {
-		m.gm_users = make(map[int]struct{})
+// Score returns the value of the "score" field in the mutation.
+func (m *TeamPkSeerScoreMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the TeamPkSeerScore entity.
+// If the TeamPkSeerScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkSeerScoreMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
 	}
-	for i := range ids {
-		m.gm_users[ids[i]] = struct{}{}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *TeamPkSeerScoreMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
 	}
 }
 
-// ClearGmUsers clears the "gm_users" edge to the GMUser entity.
-func (m *RoleMutation) ClearGmUsers() {
-	m.clearedgm_users = true
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *TeamPkSeerScoreMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GmUsersCleared reports if the "gm_users" edge to the GMUser entity was cleared.
-func (m *RoleMutation) GmUsersCleared() bool {
-	return m.clearedgm_users
+// ResetScore resets all changes to the "score" field.
+func (m *TeamPkSeerScoreMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
 }
 
-// RemoveGmUserIDs removes the "gm_users" edge to the GMUser entity by IDs.
-func (m *RoleMutation) RemoveGmUserIDs(ids ...int) {
-	if m.removedgm_users == nil {
-		m.removedgm_users = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.gm_users, ids[i])
-		m.removedgm_users[ids[i]] = struct{}{}
-	}
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamPkSeerScoreMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemovedGmUsers returns the removed IDs of the "gm_users" edge to the GMUser entity.
-func (m *RoleMutation) RemovedGmUsersIDs() (ids []int) {
-	for id := range m.removedgm_users {
-		ids = append(ids, id)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamPkSeerScoreMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// GmUsersIDs returns the "gm_users" edge IDs in the mutation.
-func (m *RoleMutation) GmUsersIDs() (ids []int) {
-	for id := range m.gm_users {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamPkSeerScore entity.
+// If the TeamPkSeerScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamPkSeerScoreMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetGmUsers resets all changes to the "gm_users" edge.
-func (m *RoleMutation) ResetGmUsers() {
-	m.gm_users = nil
-	m.clearedgm_users = false
-	m.removedgm_users = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamPkSeerScoreMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
+// Where appends a list predicates to the TeamPkSeerScoreMutation builder.
+func (m *TeamPkSeerScoreMutation) Where(ps ...predicate.TeamPkSeerScore) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamPkSeerScoreMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
+func (m *TeamPkSeerScoreMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamPkSeerScore, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9339,33 +18348,42 @@ func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleMutation) Op() Op {
+func (m *TeamPkSeerScoreMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
+func (m *TeamPkSeerScoreMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
+// Type returns the node type of this mutation (TeamPkSeerScore).
+func (m *TeamPkSeerScoreMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
+func (m *TeamPkSeerScoreMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_id != nil {
+		fields = append(fields, teampkseerscore.FieldUserID)
 	}
-	if m.description != nil {
-		fields = append(fields, role.FieldDescription)
+	if m.season != nil {
+		fields = append(fields, teampkseerscore.FieldSeason)
 	}
-	if m.created_at != nil {
-		fields = append(fields, role.FieldCreatedAt)
+	if m.team_id != nil {
+		fields = append(fields, teampkseerscore.FieldTeamID)
+	}
+	if m.nick != nil {
+		fields = append(fields, teampkseerscore.FieldNick)
+	}
+	if m.score != nil {
+		fields = append(fields, teampkseerscore.FieldScore)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, teampkseerscore.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9373,14 +18391,20 @@ func (m *RoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamPkSeerScoreMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldName:
-		return m.Name()
-	case role.FieldDescription:
-		return m.Description()
-	case role.FieldCreatedAt:
-		return m.CreatedAt()
+	case teampkseerscore.FieldUserID:
+		return m.UserID()
+	case teampkseerscore.FieldSeason:
+		return m.Season()
+	case teampkseerscore.FieldTeamID:
+		return m.TeamID()
+	case teampkseerscore.FieldNick:
+		return m.Nick()
+	case teampkseerscore.FieldScore:
+		return m.Score()
+	case teampkseerscore.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -9388,212 +18412,237 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamPkSeerScoreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldName:
-		return m.OldName(ctx)
-	case role.FieldDescription:
-		return m.OldDescription(ctx)
-	case role.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case teampkseerscore.FieldUserID:
+		return m.OldUserID(ctx)
+	case teampkseerscore.FieldSeason:
+		return m.OldSeason(ctx)
+	case teampkseerscore.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teampkseerscore.FieldNick:
+		return m.OldNick(ctx)
+	case teampkseerscore.FieldScore:
+		return m.OldScore(ctx)
+	case teampkseerscore.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown TeamPkSeerScore field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *TeamPkSeerScoreMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldName:
-		v, ok := value.(string)
+	case teampkseerscore.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUserID(v)
 		return nil
-	case role.FieldDescription:
+	case teampkseerscore.FieldSeason:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeason(v)
+		return nil
+	case teampkseerscore.FieldTeamID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case teampkseerscore.FieldNick:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetNick(v)
 		return nil
-	case role.FieldCreatedAt:
+	case teampkseerscore.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case teampkseerscore.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown TeamPkSeerScore field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
-	return nil
+func (m *TeamPkSeerScoreMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, teampkseerscore.FieldUserID)
+	}
+	if m.addseason != nil {
+		fields = append(fields, teampkseerscore.FieldSeason)
+	}
+	if m.addteam_id != nil {
+		fields = append(fields, teampkseerscore.FieldTeamID)
+	}
+	if m.addscore != nil {
+		fields = append(fields, teampkseerscore.FieldScore)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamPkSeerScoreMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teampkseerscore.FieldUserID:
+		return m.AddedUserID()
+	case teampkseerscore.FieldSeason:
+		return m.AddedSeason()
+	case teampkseerscore.FieldTeamID:
+		return m.AddedTeamID()
+	case teampkseerscore.FieldScore:
+		return m.AddedScore()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *TeamPkSeerScoreMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case teampkseerscore.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case teampkseerscore.FieldSeason:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeason(v)
+		return nil
+	case teampkseerscore.FieldTeamID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTeamID(v)
+		return nil
+	case teampkseerscore.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown TeamPkSeerScore numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
+func (m *TeamPkSeerScoreMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *TeamPkSeerScoreMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Role nullable field %s", name)
+func (m *TeamPkSeerScoreMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamPkSeerScore nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *TeamPkSeerScoreMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldName:
-		m.ResetName()
+	case teampkseerscore.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case role.FieldDescription:
-		m.ResetDescription()
+	case teampkseerscore.FieldSeason:
+		m.ResetSeason()
 		return nil
-	case role.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case teampkseerscore.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case teampkseerscore.FieldNick:
+		m.ResetNick()
+		return nil
+	case teampkseerscore.FieldScore:
+		m.ResetScore()
+		return nil
+	case teampkseerscore.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown TeamPkSeerScore field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.permissions != nil {
-		edges = append(edges, role.EdgePermissions)
-	}
-	if m.gm_users != nil {
-		edges = append(edges, role.EdgeGmUsers)
-	}
+func (m *TeamPkSeerScoreMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case role.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.permissions))
-		for id := range m.permissions {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeGmUsers:
-		ids := make([]ent.Value, 0, len(m.gm_users))
-		for id := range m.gm_users {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TeamPkSeerScoreMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedpermissions != nil {
-		edges = append(edges, role.EdgePermissions)
-	}
-	if m.removedgm_users != nil {
-		edges = append(edges, role.EdgeGmUsers)
-	}
+func (m *TeamPkSeerScoreMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case role.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.removedpermissions))
-		for id := range m.removedpermissions {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeGmUsers:
-		ids := make([]ent.Value, 0, len(m.removedgm_users))
-		for id := range m.removedgm_users {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TeamPkSeerScoreMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedpermissions {
-		edges = append(edges, role.EdgePermissions)
-	}
-	if m.clearedgm_users {
-		edges = append(edges, role.EdgeGmUsers)
-	}
+func (m *TeamPkSeerScoreMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
-	switch name {
-	case role.EdgePermissions:
-		return m.clearedpermissions
-	case role.EdgeGmUsers:
-		return m.clearedgm_users
-	}
+func (m *TeamPkSeerScoreMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
+func (m *TeamPkSeerScoreMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TeamPkSeerScore unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
-	switch name {
-	case role.EdgePermissions:
-		m.ResetPermissions()
-		return nil
-	case role.EdgeGmUsers:
-		m.ResetGmUsers()
-		return nil
-	}
-	return fmt.Errorf("unknown Role edge %s", name)
+func (m *TeamPkSeerScoreMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TeamPkSeerScore edge %s", name)
 }
@@ -36,6 +36,22 @@ type Pet struct {
 	Nature string `json:"nature,omitempty"`
 	// Skills holds the value of the "skills" field.
 	Skills string `json:"skills,omitempty"`
+	// LearnedSkills holds the value of the "learned_skills" field.
+	LearnedSkills string `json:"learned_skills,omitempty"`
+	// Ev holds the value of the "ev" field.
+	Ev string `json:"ev,omitempty"`
+	// SkillPp holds the value of the "skill_pp" field.
+	SkillPp string `json:"skill_pp,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Fainted holds the value of the "fainted" field.
+	Fainted bool `json:"fainted,omitempty"`
+	// Collected holds the value of the "collected" field.
+	Collected bool `json:"collected,omitempty"`
+	// RoomShow holds the value of the "room_show" field.
+	RoomShow bool `json:"room_show,omitempty"`
+	// Location holds the value of the "location" field.
+	Location int `json:"location,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -71,9 +87,11 @@ func (*Pet) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case pet.FieldID, pet.FieldPlayerID, pet.FieldSpeciesID, pet.FieldLevel, pet.FieldExp, pet.FieldHp, pet.FieldCatchTime, pet.FieldDv:
+		case pet.FieldFainted, pet.FieldCollected, pet.FieldRoomShow:
+			values[i] = new(sql.NullBool)
+		case pet.FieldID, pet.FieldPlayerID, pet.FieldSpeciesID, pet.FieldLevel, pet.FieldExp, pet.FieldHp, pet.FieldCatchTime, pet.FieldDv, pet.FieldLocation:
 			values[i] = new(sql.NullInt64)
-		case pet.FieldNature, pet.FieldSkills:
+		case pet.FieldNature, pet.FieldSkills, pet.FieldLearnedSkills, pet.FieldEv, pet.FieldSkillPp, pet.FieldStatus:
 			values[i] = new(sql.NullString)
 		case pet.FieldCreatedAt, pet.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -152,6 +170,54 @@ func (_m *Pet) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Skills = value.String
 			}
+		case pet.FieldLearnedSkills:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field learned_skills", values[i])
+			} else if value.Valid {
+				_m.LearnedSkills = value.String
+			}
+		case pet.FieldEv:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ev", values[i])
+			} else if value.Valid {
+				_m.Ev = value.String
+			}
+		case pet.FieldSkillPp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skill_pp", values[i])
+			} else if value.Valid {
+				_m.SkillPp = value.String
+			}
+		case pet.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case pet.FieldFainted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fainted", values[i])
+			} else if value.Valid {
+				_m.Fainted = value.Bool
+			}
+		case pet.FieldCollected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field collected", values[i])
+			} else if value.Valid {
+				_m.Collected = value.Bool
+			}
+		case pet.FieldRoomShow:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field room_show", values[i])
+			} else if value.Valid {
+				_m.RoomShow = value.Bool
+			}
+		case pet.FieldLocation:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = int(value.Int64)
+			}
 		case pet.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -232,6 +298,30 @@ func (_m *Pet) String() string {
 	builder.WriteString("skills=")
 	builder.WriteString(_m.Skills)
 	builder.WriteString(", ")
+	builder.WriteString("learned_skills=")
+	builder.WriteString(_m.LearnedSkills)
+	builder.WriteString(", ")
+	builder.WriteString("ev=")
+	builder.WriteString(_m.Ev)
+	builder.WriteString(", ")
+	builder.WriteString("skill_pp=")
+	builder.WriteString(_m.SkillPp)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("fainted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fainted))
+	builder.WriteString(", ")
+	builder.WriteString("collected=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Collected))
+	builder.WriteString(", ")
+	builder.WriteString("room_show=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoomShow))
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Location))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
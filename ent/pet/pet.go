@@ -32,6 +32,22 @@ const (
 	FieldNature = "nature"
 	// FieldSkills holds the string denoting the skills field in the database.
 	FieldSkills = "skills"
+	// FieldLearnedSkills holds the string denoting the learned_skills field in the database.
+	FieldLearnedSkills = "learned_skills"
+	// FieldEv holds the string denoting the ev field in the database.
+	FieldEv = "ev"
+	// FieldSkillPp holds the string denoting the skill_pp field in the database.
+	FieldSkillPp = "skill_pp"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldFainted holds the string denoting the fainted field in the database.
+	FieldFainted = "fainted"
+	// FieldCollected holds the string denoting the collected field in the database.
+	FieldCollected = "collected"
+	// FieldRoomShow holds the string denoting the room_show field in the database.
+	FieldRoomShow = "room_show"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -61,6 +77,14 @@ var Columns = []string{
 	FieldDv,
 	FieldNature,
 	FieldSkills,
+	FieldLearnedSkills,
+	FieldEv,
+	FieldSkillPp,
+	FieldStatus,
+	FieldFainted,
+	FieldCollected,
+	FieldRoomShow,
+	FieldLocation,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -90,6 +114,22 @@ var (
 	DefaultNature string
 	// DefaultSkills holds the default value on creation for the "skills" field.
 	DefaultSkills string
+	// DefaultLearnedSkills holds the default value on creation for the "learned_skills" field.
+	DefaultLearnedSkills string
+	// DefaultEv holds the default value on creation for the "ev" field.
+	DefaultEv string
+	// DefaultSkillPp holds the default value on creation for the "skill_pp" field.
+	DefaultSkillPp string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultFainted holds the default value on creation for the "fainted" field.
+	DefaultFainted bool
+	// DefaultCollected holds the default value on creation for the "collected" field.
+	DefaultCollected bool
+	// DefaultRoomShow holds the default value on creation for the "room_show" field.
+	DefaultRoomShow bool
+	// DefaultLocation holds the default value on creation for the "location" field.
+	DefaultLocation int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -151,6 +191,46 @@ func BySkills(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSkills, opts...).ToFunc()
 }
 
+// ByLearnedSkills orders the results by the learned_skills field.
+func ByLearnedSkills(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLearnedSkills, opts...).ToFunc()
+}
+
+// ByEv orders the results by the ev field.
+func ByEv(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEv, opts...).ToFunc()
+}
+
+// BySkillPp orders the results by the skill_pp field.
+func BySkillPp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkillPp, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByFainted orders the results by the fainted field.
+func ByFainted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFainted, opts...).ToFunc()
+}
+
+// ByCollected orders the results by the collected field.
+func ByCollected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollected, opts...).ToFunc()
+}
+
+// ByRoomShow orders the results by the room_show field.
+func ByRoomShow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoomShow, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -100,6 +100,46 @@ func Skills(v string) predicate.Pet {
 	return predicate.Pet(sql.FieldEQ(FieldSkills, v))
 }
 
+// LearnedSkills applies equality check predicate on the "learned_skills" field. It's identical to LearnedSkillsEQ.
+func LearnedSkills(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldLearnedSkills, v))
+}
+
+// Ev applies equality check predicate on the "ev" field. It's identical to EvEQ.
+func Ev(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldEv, v))
+}
+
+// SkillPp applies equality check predicate on the "skill_pp" field. It's identical to SkillPpEQ.
+func SkillPp(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldSkillPp, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldStatus, v))
+}
+
+// Fainted applies equality check predicate on the "fainted" field. It's identical to FaintedEQ.
+func Fainted(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldFainted, v))
+}
+
+// Collected applies equality check predicate on the "collected" field. It's identical to CollectedEQ.
+func Collected(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldCollected, v))
+}
+
+// RoomShow applies equality check predicate on the "room_show" field. It's identical to RoomShowEQ.
+func RoomShow(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldRoomShow, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v int) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldLocation, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Pet {
 	return predicate.Pet(sql.FieldEQ(FieldCreatedAt, v))
@@ -500,6 +540,336 @@ func SkillsContainsFold(v string) predicate.Pet {
 	return predicate.Pet(sql.FieldContainsFold(FieldSkills, v))
 }
 
+// LearnedSkillsEQ applies the EQ predicate on the "learned_skills" field.
+func LearnedSkillsEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsNEQ applies the NEQ predicate on the "learned_skills" field.
+func LearnedSkillsNEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsIn applies the In predicate on the "learned_skills" field.
+func LearnedSkillsIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldIn(FieldLearnedSkills, vs...))
+}
+
+// LearnedSkillsNotIn applies the NotIn predicate on the "learned_skills" field.
+func LearnedSkillsNotIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldNotIn(FieldLearnedSkills, vs...))
+}
+
+// LearnedSkillsGT applies the GT predicate on the "learned_skills" field.
+func LearnedSkillsGT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGT(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsGTE applies the GTE predicate on the "learned_skills" field.
+func LearnedSkillsGTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGTE(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsLT applies the LT predicate on the "learned_skills" field.
+func LearnedSkillsLT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLT(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsLTE applies the LTE predicate on the "learned_skills" field.
+func LearnedSkillsLTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLTE(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsContains applies the Contains predicate on the "learned_skills" field.
+func LearnedSkillsContains(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContains(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsHasPrefix applies the HasPrefix predicate on the "learned_skills" field.
+func LearnedSkillsHasPrefix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefix(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsHasSuffix applies the HasSuffix predicate on the "learned_skills" field.
+func LearnedSkillsHasSuffix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasSuffix(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsEqualFold applies the EqualFold predicate on the "learned_skills" field.
+func LearnedSkillsEqualFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEqualFold(FieldLearnedSkills, v))
+}
+
+// LearnedSkillsContainsFold applies the ContainsFold predicate on the "learned_skills" field.
+func LearnedSkillsContainsFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContainsFold(FieldLearnedSkills, v))
+}
+
+// EvEQ applies the EQ predicate on the "ev" field.
+func EvEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldEv, v))
+}
+
+// EvNEQ applies the NEQ predicate on the "ev" field.
+func EvNEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldEv, v))
+}
+
+// EvIn applies the In predicate on the "ev" field.
+func EvIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldIn(FieldEv, vs...))
+}
+
+// EvNotIn applies the NotIn predicate on the "ev" field.
+func EvNotIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldNotIn(FieldEv, vs...))
+}
+
+// EvGT applies the GT predicate on the "ev" field.
+func EvGT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGT(FieldEv, v))
+}
+
+// EvGTE applies the GTE predicate on the "ev" field.
+func EvGTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGTE(FieldEv, v))
+}
+
+// EvLT applies the LT predicate on the "ev" field.
+func EvLT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLT(FieldEv, v))
+}
+
+// EvLTE applies the LTE predicate on the "ev" field.
+func EvLTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLTE(FieldEv, v))
+}
+
+// EvContains applies the Contains predicate on the "ev" field.
+func EvContains(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContains(FieldEv, v))
+}
+
+// EvHasPrefix applies the HasPrefix predicate on the "ev" field.
+func EvHasPrefix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefix(FieldEv, v))
+}
+
+// EvHasSuffix applies the HasSuffix predicate on the "ev" field.
+func EvHasSuffix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasSuffix(FieldEv, v))
+}
+
+// EvEqualFold applies the EqualFold predicate on the "ev" field.
+func EvEqualFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEqualFold(FieldEv, v))
+}
+
+// EvContainsFold applies the ContainsFold predicate on the "ev" field.
+func EvContainsFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContainsFold(FieldEv, v))
+}
+
+// SkillPpEQ applies the EQ predicate on the "skill_pp" field.
+func SkillPpEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldSkillPp, v))
+}
+
+// SkillPpNEQ applies the NEQ predicate on the "skill_pp" field.
+func SkillPpNEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldSkillPp, v))
+}
+
+// SkillPpIn applies the In predicate on the "skill_pp" field.
+func SkillPpIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldIn(FieldSkillPp, vs...))
+}
+
+// SkillPpNotIn applies the NotIn predicate on the "skill_pp" field.
+func SkillPpNotIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldNotIn(FieldSkillPp, vs...))
+}
+
+// SkillPpGT applies the GT predicate on the "skill_pp" field.
+func SkillPpGT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGT(FieldSkillPp, v))
+}
+
+// SkillPpGTE applies the GTE predicate on the "skill_pp" field.
+func SkillPpGTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGTE(FieldSkillPp, v))
+}
+
+// SkillPpLT applies the LT predicate on the "skill_pp" field.
+func SkillPpLT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLT(FieldSkillPp, v))
+}
+
+// SkillPpLTE applies the LTE predicate on the "skill_pp" field.
+func SkillPpLTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLTE(FieldSkillPp, v))
+}
+
+// SkillPpContains applies the Contains predicate on the "skill_pp" field.
+func SkillPpContains(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContains(FieldSkillPp, v))
+}
+
+// SkillPpHasPrefix applies the HasPrefix predicate on the "skill_pp" field.
+func SkillPpHasPrefix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefix(FieldSkillPp, v))
+}
+
+// SkillPpHasSuffix applies the HasSuffix predicate on the "skill_pp" field.
+func SkillPpHasSuffix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasSuffix(FieldSkillPp, v))
+}
+
+// SkillPpEqualFold applies the EqualFold predicate on the "skill_pp" field.
+func SkillPpEqualFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEqualFold(FieldSkillPp, v))
+}
+
+// SkillPpContainsFold applies the ContainsFold predicate on the "skill_pp" field.
+func SkillPpContainsFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContainsFold(FieldSkillPp, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Pet {
+	return predicate.Pet(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// FaintedEQ applies the EQ predicate on the "fainted" field.
+func FaintedEQ(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldFainted, v))
+}
+
+// FaintedNEQ applies the NEQ predicate on the "fainted" field.
+func FaintedNEQ(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldFainted, v))
+}
+
+// CollectedEQ applies the EQ predicate on the "collected" field.
+func CollectedEQ(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldCollected, v))
+}
+
+// CollectedNEQ applies the NEQ predicate on the "collected" field.
+func CollectedNEQ(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldCollected, v))
+}
+
+// RoomShowEQ applies the EQ predicate on the "room_show" field.
+func RoomShowEQ(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldRoomShow, v))
+}
+
+// RoomShowNEQ applies the NEQ predicate on the "room_show" field.
+func RoomShowNEQ(v bool) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldRoomShow, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v int) predicate.Pet {
+	return predicate.Pet(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v int) predicate.Pet {
+	return predicate.Pet(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...int) predicate.Pet {
+	return predicate.Pet(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...int) predicate.Pet {
+	return predicate.Pet(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v int) predicate.Pet {
+	return predicate.Pet(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v int) predicate.Pet {
+	return predicate.Pet(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v int) predicate.Pet {
+	return predicate.Pet(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v int) predicate.Pet {
+	return predicate.Pet(sql.FieldLTE(FieldLocation, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Pet {
 	return predicate.Pet(sql.FieldEQ(FieldCreatedAt, v))
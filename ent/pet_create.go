@@ -131,6 +131,118 @@ func (_c *PetCreate) SetNillableSkills(v *string) *PetCreate {
 	return _c
 }
 
+// SetLearnedSkills sets the "learned_skills" field.
+func (_c *PetCreate) SetLearnedSkills(v string) *PetCreate {
+	_c.mutation.SetLearnedSkills(v)
+	return _c
+}
+
+// SetNillableLearnedSkills sets the "learned_skills" field if the given value is not nil.
+func (_c *PetCreate) SetNillableLearnedSkills(v *string) *PetCreate {
+	if v != nil {
+		_c.SetLearnedSkills(*v)
+	}
+	return _c
+}
+
+// SetEv sets the "ev" field.
+func (_c *PetCreate) SetEv(v string) *PetCreate {
+	_c.mutation.SetEv(v)
+	return _c
+}
+
+// SetNillableEv sets the "ev" field if the given value is not nil.
+func (_c *PetCreate) SetNillableEv(v *string) *PetCreate {
+	if v != nil {
+		_c.SetEv(*v)
+	}
+	return _c
+}
+
+// SetSkillPp sets the "skill_pp" field.
+func (_c *PetCreate) SetSkillPp(v string) *PetCreate {
+	_c.mutation.SetSkillPp(v)
+	return _c
+}
+
+// SetNillableSkillPp sets the "skill_pp" field if the given value is not nil.
+func (_c *PetCreate) SetNillableSkillPp(v *string) *PetCreate {
+	if v != nil {
+		_c.SetSkillPp(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PetCreate) SetStatus(v string) *PetCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PetCreate) SetNillableStatus(v *string) *PetCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetFainted sets the "fainted" field.
+func (_c *PetCreate) SetFainted(v bool) *PetCreate {
+	_c.mutation.SetFainted(v)
+	return _c
+}
+
+// SetNillableFainted sets the "fainted" field if the given value is not nil.
+func (_c *PetCreate) SetNillableFainted(v *bool) *PetCreate {
+	if v != nil {
+		_c.SetFainted(*v)
+	}
+	return _c
+}
+
+// SetCollected sets the "collected" field.
+func (_c *PetCreate) SetCollected(v bool) *PetCreate {
+	_c.mutation.SetCollected(v)
+	return _c
+}
+
+// SetNillableCollected sets the "collected" field if the given value is not nil.
+func (_c *PetCreate) SetNillableCollected(v *bool) *PetCreate {
+	if v != nil {
+		_c.SetCollected(*v)
+	}
+	return _c
+}
+
+// SetRoomShow sets the "room_show" field.
+func (_c *PetCreate) SetRoomShow(v bool) *PetCreate {
+	_c.mutation.SetRoomShow(v)
+	return _c
+}
+
+// SetNillableRoomShow sets the "room_show" field if the given value is not nil.
+func (_c *PetCreate) SetNillableRoomShow(v *bool) *PetCreate {
+	if v != nil {
+		_c.SetRoomShow(*v)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *PetCreate) SetLocation(v int) *PetCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *PetCreate) SetNillableLocation(v *int) *PetCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PetCreate) SetCreatedAt(v time.Time) *PetCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -227,6 +339,38 @@ func (_c *PetCreate) defaults() {
 		v := pet.DefaultSkills
 		_c.mutation.SetSkills(v)
 	}
+	if _, ok := _c.mutation.LearnedSkills(); !ok {
+		v := pet.DefaultLearnedSkills
+		_c.mutation.SetLearnedSkills(v)
+	}
+	if _, ok := _c.mutation.Ev(); !ok {
+		v := pet.DefaultEv
+		_c.mutation.SetEv(v)
+	}
+	if _, ok := _c.mutation.SkillPp(); !ok {
+		v := pet.DefaultSkillPp
+		_c.mutation.SetSkillPp(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := pet.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Fainted(); !ok {
+		v := pet.DefaultFainted
+		_c.mutation.SetFainted(v)
+	}
+	if _, ok := _c.mutation.Collected(); !ok {
+		v := pet.DefaultCollected
+		_c.mutation.SetCollected(v)
+	}
+	if _, ok := _c.mutation.RoomShow(); !ok {
+		v := pet.DefaultRoomShow
+		_c.mutation.SetRoomShow(v)
+	}
+	if _, ok := _c.mutation.Location(); !ok {
+		v := pet.DefaultLocation
+		_c.mutation.SetLocation(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := pet.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -266,6 +410,30 @@ func (_c *PetCreate) check() error {
 	if _, ok := _c.mutation.Skills(); !ok {
 		return &ValidationError{Name: "skills", err: errors.New(`ent: missing required field "Pet.skills"`)}
 	}
+	if _, ok := _c.mutation.LearnedSkills(); !ok {
+		return &ValidationError{Name: "learned_skills", err: errors.New(`ent: missing required field "Pet.learned_skills"`)}
+	}
+	if _, ok := _c.mutation.Ev(); !ok {
+		return &ValidationError{Name: "ev", err: errors.New(`ent: missing required field "Pet.ev"`)}
+	}
+	if _, ok := _c.mutation.SkillPp(); !ok {
+		return &ValidationError{Name: "skill_pp", err: errors.New(`ent: missing required field "Pet.skill_pp"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Pet.status"`)}
+	}
+	if _, ok := _c.mutation.Fainted(); !ok {
+		return &ValidationError{Name: "fainted", err: errors.New(`ent: missing required field "Pet.fainted"`)}
+	}
+	if _, ok := _c.mutation.Collected(); !ok {
+		return &ValidationError{Name: "collected", err: errors.New(`ent: missing required field "Pet.collected"`)}
+	}
+	if _, ok := _c.mutation.RoomShow(); !ok {
+		return &ValidationError{Name: "room_show", err: errors.New(`ent: missing required field "Pet.room_show"`)}
+	}
+	if _, ok := _c.mutation.Location(); !ok {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required field "Pet.location"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Pet.created_at"`)}
 	}
@@ -333,6 +501,38 @@ func (_c *PetCreate) createSpec() (*Pet, *sqlgraph.CreateSpec) {
 		_spec.SetField(pet.FieldSkills, field.TypeString, value)
 		_node.Skills = value
 	}
+	if value, ok := _c.mutation.LearnedSkills(); ok {
+		_spec.SetField(pet.FieldLearnedSkills, field.TypeString, value)
+		_node.LearnedSkills = value
+	}
+	if value, ok := _c.mutation.Ev(); ok {
+		_spec.SetField(pet.FieldEv, field.TypeString, value)
+		_node.Ev = value
+	}
+	if value, ok := _c.mutation.SkillPp(); ok {
+		_spec.SetField(pet.FieldSkillPp, field.TypeString, value)
+		_node.SkillPp = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(pet.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Fainted(); ok {
+		_spec.SetField(pet.FieldFainted, field.TypeBool, value)
+		_node.Fainted = value
+	}
+	if value, ok := _c.mutation.Collected(); ok {
+		_spec.SetField(pet.FieldCollected, field.TypeBool, value)
+		_node.Collected = value
+	}
+	if value, ok := _c.mutation.RoomShow(); ok {
+		_spec.SetField(pet.FieldRoomShow, field.TypeBool, value)
+		_node.RoomShow = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(pet.FieldLocation, field.TypeInt, value)
+		_node.Location = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(pet.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -197,6 +197,125 @@ func (_u *PetUpdate) SetNillableSkills(v *string) *PetUpdate {
 	return _u
 }
 
+// SetLearnedSkills sets the "learned_skills" field.
+func (_u *PetUpdate) SetLearnedSkills(v string) *PetUpdate {
+	_u.mutation.SetLearnedSkills(v)
+	return _u
+}
+
+// SetNillableLearnedSkills sets the "learned_skills" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableLearnedSkills(v *string) *PetUpdate {
+	if v != nil {
+		_u.SetLearnedSkills(*v)
+	}
+	return _u
+}
+
+// SetEv sets the "ev" field.
+func (_u *PetUpdate) SetEv(v string) *PetUpdate {
+	_u.mutation.SetEv(v)
+	return _u
+}
+
+// SetNillableEv sets the "ev" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableEv(v *string) *PetUpdate {
+	if v != nil {
+		_u.SetEv(*v)
+	}
+	return _u
+}
+
+// SetSkillPp sets the "skill_pp" field.
+func (_u *PetUpdate) SetSkillPp(v string) *PetUpdate {
+	_u.mutation.SetSkillPp(v)
+	return _u
+}
+
+// SetNillableSkillPp sets the "skill_pp" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableSkillPp(v *string) *PetUpdate {
+	if v != nil {
+		_u.SetSkillPp(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PetUpdate) SetStatus(v string) *PetUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableStatus(v *string) *PetUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFainted sets the "fainted" field.
+func (_u *PetUpdate) SetFainted(v bool) *PetUpdate {
+	_u.mutation.SetFainted(v)
+	return _u
+}
+
+// SetNillableFainted sets the "fainted" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableFainted(v *bool) *PetUpdate {
+	if v != nil {
+		_u.SetFainted(*v)
+	}
+	return _u
+}
+
+// SetCollected sets the "collected" field.
+func (_u *PetUpdate) SetCollected(v bool) *PetUpdate {
+	_u.mutation.SetCollected(v)
+	return _u
+}
+
+// SetNillableCollected sets the "collected" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableCollected(v *bool) *PetUpdate {
+	if v != nil {
+		_u.SetCollected(*v)
+	}
+	return _u
+}
+
+// SetRoomShow sets the "room_show" field.
+func (_u *PetUpdate) SetRoomShow(v bool) *PetUpdate {
+	_u.mutation.SetRoomShow(v)
+	return _u
+}
+
+// SetNillableRoomShow sets the "room_show" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableRoomShow(v *bool) *PetUpdate {
+	if v != nil {
+		_u.SetRoomShow(*v)
+	}
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *PetUpdate) SetLocation(v int) *PetUpdate {
+	_u.mutation.ResetLocation()
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *PetUpdate) SetNillableLocation(v *int) *PetUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// AddLocation adds value to the "location" field.
+func (_u *PetUpdate) AddLocation(v int) *PetUpdate {
+	_u.mutation.AddLocation(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *PetUpdate) SetCreatedAt(v time.Time) *PetUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -331,6 +450,33 @@ func (_u *PetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Skills(); ok {
 		_spec.SetField(pet.FieldSkills, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LearnedSkills(); ok {
+		_spec.SetField(pet.FieldLearnedSkills, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ev(); ok {
+		_spec.SetField(pet.FieldEv, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkillPp(); ok {
+		_spec.SetField(pet.FieldSkillPp, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(pet.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Fainted(); ok {
+		_spec.SetField(pet.FieldFainted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Collected(); ok {
+		_spec.SetField(pet.FieldCollected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RoomShow(); ok {
+		_spec.SetField(pet.FieldRoomShow, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(pet.FieldLocation, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLocation(); ok {
+		_spec.AddField(pet.FieldLocation, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(pet.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -554,6 +700,125 @@ func (_u *PetUpdateOne) SetNillableSkills(v *string) *PetUpdateOne {
 	return _u
 }
 
+// SetLearnedSkills sets the "learned_skills" field.
+func (_u *PetUpdateOne) SetLearnedSkills(v string) *PetUpdateOne {
+	_u.mutation.SetLearnedSkills(v)
+	return _u
+}
+
+// SetNillableLearnedSkills sets the "learned_skills" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableLearnedSkills(v *string) *PetUpdateOne {
+	if v != nil {
+		_u.SetLearnedSkills(*v)
+	}
+	return _u
+}
+
+// SetEv sets the "ev" field.
+func (_u *PetUpdateOne) SetEv(v string) *PetUpdateOne {
+	_u.mutation.SetEv(v)
+	return _u
+}
+
+// SetNillableEv sets the "ev" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableEv(v *string) *PetUpdateOne {
+	if v != nil {
+		_u.SetEv(*v)
+	}
+	return _u
+}
+
+// SetSkillPp sets the "skill_pp" field.
+func (_u *PetUpdateOne) SetSkillPp(v string) *PetUpdateOne {
+	_u.mutation.SetSkillPp(v)
+	return _u
+}
+
+// SetNillableSkillPp sets the "skill_pp" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableSkillPp(v *string) *PetUpdateOne {
+	if v != nil {
+		_u.SetSkillPp(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PetUpdateOne) SetStatus(v string) *PetUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableStatus(v *string) *PetUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFainted sets the "fainted" field.
+func (_u *PetUpdateOne) SetFainted(v bool) *PetUpdateOne {
+	_u.mutation.SetFainted(v)
+	return _u
+}
+
+// SetNillableFainted sets the "fainted" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableFainted(v *bool) *PetUpdateOne {
+	if v != nil {
+		_u.SetFainted(*v)
+	}
+	return _u
+}
+
+// SetCollected sets the "collected" field.
+func (_u *PetUpdateOne) SetCollected(v bool) *PetUpdateOne {
+	_u.mutation.SetCollected(v)
+	return _u
+}
+
+// SetNillableCollected sets the "collected" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableCollected(v *bool) *PetUpdateOne {
+	if v != nil {
+		_u.SetCollected(*v)
+	}
+	return _u
+}
+
+// SetRoomShow sets the "room_show" field.
+func (_u *PetUpdateOne) SetRoomShow(v bool) *PetUpdateOne {
+	_u.mutation.SetRoomShow(v)
+	return _u
+}
+
+// SetNillableRoomShow sets the "room_show" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableRoomShow(v *bool) *PetUpdateOne {
+	if v != nil {
+		_u.SetRoomShow(*v)
+	}
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *PetUpdateOne) SetLocation(v int) *PetUpdateOne {
+	_u.mutation.ResetLocation()
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *PetUpdateOne) SetNillableLocation(v *int) *PetUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// AddLocation adds value to the "location" field.
+func (_u *PetUpdateOne) AddLocation(v int) *PetUpdateOne {
+	_u.mutation.AddLocation(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *PetUpdateOne) SetCreatedAt(v time.Time) *PetUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -718,6 +983,33 @@ func (_u *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 	if value, ok := _u.mutation.Skills(); ok {
 		_spec.SetField(pet.FieldSkills, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LearnedSkills(); ok {
+		_spec.SetField(pet.FieldLearnedSkills, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ev(); ok {
+		_spec.SetField(pet.FieldEv, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkillPp(); ok {
+		_spec.SetField(pet.FieldSkillPp, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(pet.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Fainted(); ok {
+		_spec.SetField(pet.FieldFainted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Collected(); ok {
+		_spec.SetField(pet.FieldCollected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RoomShow(); ok {
+		_spec.SetField(pet.FieldRoomShow, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(pet.FieldLocation, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLocation(); ok {
+		_spec.AddField(pet.FieldLocation, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(pet.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -74,12 +74,26 @@ type Player struct {
 	StudentIds string `json:"student_ids,omitempty"`
 	// RoomID holds the value of the "room_id" field.
 	RoomID int64 `json:"room_id,omitempty"`
+	// RoomPrivacy holds the value of the "room_privacy" field.
+	RoomPrivacy int64 `json:"room_privacy,omitempty"`
 	// Fitments holds the value of the "fitments" field.
 	Fitments string `json:"fitments,omitempty"`
 	// NonoInfo holds the value of the "nono_info" field.
 	NonoInfo string `json:"nono_info,omitempty"`
 	// Mailbox holds the value of the "mailbox" field.
 	Mailbox string `json:"mailbox,omitempty"`
+	// BossClears holds the value of the "boss_clears" field.
+	BossClears string `json:"boss_clears,omitempty"`
+	// ExpPool holds the value of the "exp_pool" field.
+	ExpPool int64 `json:"exp_pool,omitempty"`
+	// GraduationCount holds the value of the "graduation_count" field.
+	GraduationCount int64 `json:"graduation_count,omitempty"`
+	// Incubator holds the value of the "incubator" field.
+	Incubator string `json:"incubator,omitempty"`
+	// SoulBeads holds the value of the "soul_beads" field.
+	SoulBeads string `json:"soul_beads,omitempty"`
+	// ItemBuffs holds the value of the "item_buffs" field.
+	ItemBuffs string `json:"item_buffs,omitempty"`
 	// CurrentPetID holds the value of the "current_pet_id" field.
 	CurrentPetID int64 `json:"current_pet_id,omitempty"`
 	// CurrentPetCatchTime holds the value of the "current_pet_catch_time" field.
@@ -106,9 +120,11 @@ type PlayerEdges struct {
 	Pets []*Pet `json:"pets,omitempty"`
 	// Items holds the value of the items edge.
 	Items []*Item `json:"items,omitempty"`
+	// PvpRatings holds the value of the pvp_ratings edge.
+	PvpRatings []*PvpRating `json:"pvp_ratings,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // AccountOrErr returns the Account value or an error if the edge
@@ -140,14 +156,23 @@ func (e PlayerEdges) ItemsOrErr() ([]*Item, error) {
 	return nil, &NotLoadedError{edge: "items"}
 }
 
+// PvpRatingsOrErr returns the PvpRatings value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) PvpRatingsOrErr() ([]*PvpRating, error) {
+	if e.loadedTypes[3] {
+		return e.PvpRatings, nil
+	}
+	return nil, &NotLoadedError{edge: "pvp_ratings"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Player) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case player.FieldID, player.FieldAccountID, player.FieldLevel, player.FieldCoins, player.FieldGold, player.FieldMapID, player.FieldMapType, player.FieldPosX, player.FieldPosY, player.FieldLastMapID, player.FieldColor, player.FieldTexture, player.FieldEnergy, player.FieldFightBadge, player.FieldTimeToday, player.FieldTimeLimit, player.FieldTeacherID, player.FieldStudentID, player.FieldCurTitle, player.FieldRoomID, player.FieldCurrentPetID, player.FieldCurrentPetCatchTime, player.FieldCurrentPetDv:
+		case player.FieldID, player.FieldAccountID, player.FieldLevel, player.FieldCoins, player.FieldGold, player.FieldMapID, player.FieldMapType, player.FieldPosX, player.FieldPosY, player.FieldLastMapID, player.FieldColor, player.FieldTexture, player.FieldEnergy, player.FieldFightBadge, player.FieldTimeToday, player.FieldTimeLimit, player.FieldTeacherID, player.FieldStudentID, player.FieldCurTitle, player.FieldRoomID, player.FieldRoomPrivacy, player.FieldExpPool, player.FieldGraduationCount, player.FieldCurrentPetID, player.FieldCurrentPetCatchTime, player.FieldCurrentPetDv:
 			values[i] = new(sql.NullInt64)
-		case player.FieldNick, player.FieldTaskStatus, player.FieldTaskBufs, player.FieldFriends, player.FieldBlacklist, player.FieldAchievements, player.FieldTitles, player.FieldTeamInfo, player.FieldStudentIds, player.FieldFitments, player.FieldNonoInfo, player.FieldMailbox:
+		case player.FieldNick, player.FieldTaskStatus, player.FieldTaskBufs, player.FieldFriends, player.FieldBlacklist, player.FieldAchievements, player.FieldTitles, player.FieldTeamInfo, player.FieldStudentIds, player.FieldFitments, player.FieldNonoInfo, player.FieldMailbox, player.FieldBossClears, player.FieldIncubator, player.FieldSoulBeads, player.FieldItemBuffs:
 			values[i] = new(sql.NullString)
 		case player.FieldLastLoginAt, player.FieldCreatedAt, player.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -340,6 +365,12 @@ func (_m *Player) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RoomID = value.Int64
 			}
+		case player.FieldRoomPrivacy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field room_privacy", values[i])
+			} else if value.Valid {
+				_m.RoomPrivacy = value.Int64
+			}
 		case player.FieldFitments:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field fitments", values[i])
@@ -358,6 +389,42 @@ func (_m *Player) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Mailbox = value.String
 			}
+		case player.FieldBossClears:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field boss_clears", values[i])
+			} else if value.Valid {
+				_m.BossClears = value.String
+			}
+		case player.FieldExpPool:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exp_pool", values[i])
+			} else if value.Valid {
+				_m.ExpPool = value.Int64
+			}
+		case player.FieldGraduationCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field graduation_count", values[i])
+			} else if value.Valid {
+				_m.GraduationCount = value.Int64
+			}
+		case player.FieldIncubator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field incubator", values[i])
+			} else if value.Valid {
+				_m.Incubator = value.String
+			}
+		case player.FieldSoulBeads:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field soul_beads", values[i])
+			} else if value.Valid {
+				_m.SoulBeads = value.String
+			}
+		case player.FieldItemBuffs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_buffs", values[i])
+			} else if value.Valid {
+				_m.ItemBuffs = value.String
+			}
 		case player.FieldCurrentPetID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field current_pet_id", values[i])
@@ -422,6 +489,11 @@ func (_m *Player) QueryItems() *ItemQuery {
 	return NewPlayerClient(_m.config).QueryItems(_m)
 }
 
+// QueryPvpRatings queries the "pvp_ratings" edge of the Player entity.
+func (_m *Player) QueryPvpRatings() *PvpRatingQuery {
+	return NewPlayerClient(_m.config).QueryPvpRatings(_m)
+}
+
 // Update returns a builder for updating this Player.
 // Note that you need to call Player.Unwrap() before calling this method if this Player
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -529,6 +601,9 @@ func (_m *Player) String() string {
 	builder.WriteString("room_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RoomID))
 	builder.WriteString(", ")
+	builder.WriteString("room_privacy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoomPrivacy))
+	builder.WriteString(", ")
 	builder.WriteString("fitments=")
 	builder.WriteString(_m.Fitments)
 	builder.WriteString(", ")
@@ -538,6 +613,24 @@ func (_m *Player) String() string {
 	builder.WriteString("mailbox=")
 	builder.WriteString(_m.Mailbox)
 	builder.WriteString(", ")
+	builder.WriteString("boss_clears=")
+	builder.WriteString(_m.BossClears)
+	builder.WriteString(", ")
+	builder.WriteString("exp_pool=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpPool))
+	builder.WriteString(", ")
+	builder.WriteString("graduation_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GraduationCount))
+	builder.WriteString(", ")
+	builder.WriteString("incubator=")
+	builder.WriteString(_m.Incubator)
+	builder.WriteString(", ")
+	builder.WriteString("soul_beads=")
+	builder.WriteString(_m.SoulBeads)
+	builder.WriteString(", ")
+	builder.WriteString("item_buffs=")
+	builder.WriteString(_m.ItemBuffs)
+	builder.WriteString(", ")
 	builder.WriteString("current_pet_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CurrentPetID))
 	builder.WriteString(", ")
@@ -70,12 +70,26 @@ const (
 	FieldStudentIds = "student_ids"
 	// FieldRoomID holds the string denoting the room_id field in the database.
 	FieldRoomID = "room_id"
+	// FieldRoomPrivacy holds the string denoting the room_privacy field in the database.
+	FieldRoomPrivacy = "room_privacy"
 	// FieldFitments holds the string denoting the fitments field in the database.
 	FieldFitments = "fitments"
 	// FieldNonoInfo holds the string denoting the nono_info field in the database.
 	FieldNonoInfo = "nono_info"
 	// FieldMailbox holds the string denoting the mailbox field in the database.
 	FieldMailbox = "mailbox"
+	// FieldBossClears holds the string denoting the boss_clears field in the database.
+	FieldBossClears = "boss_clears"
+	// FieldExpPool holds the string denoting the exp_pool field in the database.
+	FieldExpPool = "exp_pool"
+	// FieldGraduationCount holds the string denoting the graduation_count field in the database.
+	FieldGraduationCount = "graduation_count"
+	// FieldIncubator holds the string denoting the incubator field in the database.
+	FieldIncubator = "incubator"
+	// FieldSoulBeads holds the string denoting the soul_beads field in the database.
+	FieldSoulBeads = "soul_beads"
+	// FieldItemBuffs holds the string denoting the item_buffs field in the database.
+	FieldItemBuffs = "item_buffs"
 	// FieldCurrentPetID holds the string denoting the current_pet_id field in the database.
 	FieldCurrentPetID = "current_pet_id"
 	// FieldCurrentPetCatchTime holds the string denoting the current_pet_catch_time field in the database.
@@ -94,6 +108,8 @@ const (
 	EdgePets = "pets"
 	// EdgeItems holds the string denoting the items edge name in mutations.
 	EdgeItems = "items"
+	// EdgePvpRatings holds the string denoting the pvp_ratings edge name in mutations.
+	EdgePvpRatings = "pvp_ratings"
 	// Table holds the table name of the player in the database.
 	Table = "players"
 	// AccountTable is the table that holds the account relation/edge.
@@ -117,6 +133,13 @@ const (
 	ItemsInverseTable = "items"
 	// ItemsColumn is the table column denoting the items relation/edge.
 	ItemsColumn = "player_id"
+	// PvpRatingsTable is the table that holds the pvp_ratings relation/edge.
+	PvpRatingsTable = "pvp_ratings"
+	// PvpRatingsInverseTable is the table name for the PvpRating entity.
+	// It exists in this package in order to avoid circular dependency with the "pvprating" package.
+	PvpRatingsInverseTable = "pvp_ratings"
+	// PvpRatingsColumn is the table column denoting the pvp_ratings relation/edge.
+	PvpRatingsColumn = "player_id"
 )
 
 // Columns holds all SQL columns for player fields.
@@ -150,9 +173,16 @@ var Columns = []string{
 	FieldTeamInfo,
 	FieldStudentIds,
 	FieldRoomID,
+	FieldRoomPrivacy,
 	FieldFitments,
 	FieldNonoInfo,
 	FieldMailbox,
+	FieldBossClears,
+	FieldExpPool,
+	FieldGraduationCount,
+	FieldIncubator,
+	FieldSoulBeads,
+	FieldItemBuffs,
 	FieldCurrentPetID,
 	FieldCurrentPetCatchTime,
 	FieldCurrentPetDv,
@@ -226,12 +256,26 @@ var (
 	DefaultStudentIds string
 	// DefaultRoomID holds the default value on creation for the "room_id" field.
 	DefaultRoomID int64
+	// DefaultRoomPrivacy holds the default value on creation for the "room_privacy" field.
+	DefaultRoomPrivacy int64
 	// DefaultFitments holds the default value on creation for the "fitments" field.
 	DefaultFitments string
 	// DefaultNonoInfo holds the default value on creation for the "nono_info" field.
 	DefaultNonoInfo string
 	// DefaultMailbox holds the default value on creation for the "mailbox" field.
 	DefaultMailbox string
+	// DefaultBossClears holds the default value on creation for the "boss_clears" field.
+	DefaultBossClears string
+	// DefaultExpPool holds the default value on creation for the "exp_pool" field.
+	DefaultExpPool int64
+	// DefaultGraduationCount holds the default value on creation for the "graduation_count" field.
+	DefaultGraduationCount int64
+	// DefaultIncubator holds the default value on creation for the "incubator" field.
+	DefaultIncubator string
+	// DefaultSoulBeads holds the default value on creation for the "soul_beads" field.
+	DefaultSoulBeads string
+	// DefaultItemBuffs holds the default value on creation for the "item_buffs" field.
+	DefaultItemBuffs string
 	// DefaultCurrentPetID holds the default value on creation for the "current_pet_id" field.
 	DefaultCurrentPetID int64
 	// DefaultCurrentPetCatchTime holds the default value on creation for the "current_pet_catch_time" field.
@@ -394,6 +438,11 @@ func ByRoomID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRoomID, opts...).ToFunc()
 }
 
+// ByRoomPrivacy orders the results by the room_privacy field.
+func ByRoomPrivacy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoomPrivacy, opts...).ToFunc()
+}
+
 // ByFitments orders the results by the fitments field.
 func ByFitments(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFitments, opts...).ToFunc()
@@ -409,6 +458,36 @@ func ByMailbox(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMailbox, opts...).ToFunc()
 }
 
+// ByBossClears orders the results by the boss_clears field.
+func ByBossClears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBossClears, opts...).ToFunc()
+}
+
+// ByExpPool orders the results by the exp_pool field.
+func ByExpPool(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpPool, opts...).ToFunc()
+}
+
+// ByGraduationCount orders the results by the graduation_count field.
+func ByGraduationCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraduationCount, opts...).ToFunc()
+}
+
+// ByIncubator orders the results by the incubator field.
+func ByIncubator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncubator, opts...).ToFunc()
+}
+
+// BySoulBeads orders the results by the soul_beads field.
+func BySoulBeads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoulBeads, opts...).ToFunc()
+}
+
+// ByItemBuffs orders the results by the item_buffs field.
+func ByItemBuffs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemBuffs, opts...).ToFunc()
+}
+
 // ByCurrentPetID orders the results by the current_pet_id field.
 func ByCurrentPetID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrentPetID, opts...).ToFunc()
@@ -473,6 +552,20 @@ func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPvpRatingsCount orders the results by pvp_ratings count.
+func ByPvpRatingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPvpRatingsStep(), opts...)
+	}
+}
+
+// ByPvpRatings orders the results by pvp_ratings terms.
+func ByPvpRatings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPvpRatingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAccountStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -494,3 +587,10 @@ func newItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
 	)
 }
+func newPvpRatingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PvpRatingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PvpRatingsTable, PvpRatingsColumn),
+	)
+}
@@ -195,6 +195,11 @@ func RoomID(v int64) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldRoomID, v))
 }
 
+// RoomPrivacy applies equality check predicate on the "room_privacy" field. It's identical to RoomPrivacyEQ.
+func RoomPrivacy(v int64) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldRoomPrivacy, v))
+}
+
 // Fitments applies equality check predicate on the "fitments" field. It's identical to FitmentsEQ.
 func Fitments(v string) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldFitments, v))
@@ -210,6 +215,36 @@ func Mailbox(v string) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldMailbox, v))
 }
 
+// BossClears applies equality check predicate on the "boss_clears" field. It's identical to BossClearsEQ.
+func BossClears(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldBossClears, v))
+}
+
+// ExpPool applies equality check predicate on the "exp_pool" field. It's identical to ExpPoolEQ.
+func ExpPool(v int64) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldExpPool, v))
+}
+
+// GraduationCount applies equality check predicate on the "graduation_count" field. It's identical to GraduationCountEQ.
+func GraduationCount(v int64) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldGraduationCount, v))
+}
+
+// Incubator applies equality check predicate on the "incubator" field. It's identical to IncubatorEQ.
+func Incubator(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldIncubator, v))
+}
+
+// SoulBeads applies equality check predicate on the "soul_beads" field. It's identical to SoulBeadsEQ.
+func SoulBeads(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSoulBeads, v))
+}
+
+// ItemBuffs applies equality check predicate on the "item_buffs" field. It's identical to ItemBuffsEQ.
+func ItemBuffs(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldItemBuffs, v))
+}
+
 // CurrentPetID applies equality check predicate on the "current_pet_id" field. It's identical to CurrentPetIDEQ.
 func CurrentPetID(v int64) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldCurrentPetID, v))
@@ -1565,6 +1600,46 @@ func RoomIDLTE(v int64) predicate.Player {
 	return predicate.Player(sql.FieldLTE(FieldRoomID, v))
 }
 
+// RoomPrivacyEQ applies the EQ predicate on the "room_privacy" field.
+func RoomPrivacyEQ(v int64) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldRoomPrivacy, v))
+}
+
+// RoomPrivacyNEQ applies the NEQ predicate on the "room_privacy" field.
+func RoomPrivacyNEQ(v int64) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldRoomPrivacy, v))
+}
+
+// RoomPrivacyIn applies the In predicate on the "room_privacy" field.
+func RoomPrivacyIn(vs ...int64) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldRoomPrivacy, vs...))
+}
+
+// RoomPrivacyNotIn applies the NotIn predicate on the "room_privacy" field.
+func RoomPrivacyNotIn(vs ...int64) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldRoomPrivacy, vs...))
+}
+
+// RoomPrivacyGT applies the GT predicate on the "room_privacy" field.
+func RoomPrivacyGT(v int64) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldRoomPrivacy, v))
+}
+
+// RoomPrivacyGTE applies the GTE predicate on the "room_privacy" field.
+func RoomPrivacyGTE(v int64) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldRoomPrivacy, v))
+}
+
+// RoomPrivacyLT applies the LT predicate on the "room_privacy" field.
+func RoomPrivacyLT(v int64) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldRoomPrivacy, v))
+}
+
+// RoomPrivacyLTE applies the LTE predicate on the "room_privacy" field.
+func RoomPrivacyLTE(v int64) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldRoomPrivacy, v))
+}
+
 // FitmentsEQ applies the EQ predicate on the "fitments" field.
 func FitmentsEQ(v string) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldFitments, v))
@@ -1760,6 +1835,346 @@ func MailboxContainsFold(v string) predicate.Player {
 	return predicate.Player(sql.FieldContainsFold(FieldMailbox, v))
 }
 
+// BossClearsEQ applies the EQ predicate on the "boss_clears" field.
+func BossClearsEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldBossClears, v))
+}
+
+// BossClearsNEQ applies the NEQ predicate on the "boss_clears" field.
+func BossClearsNEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldBossClears, v))
+}
+
+// BossClearsIn applies the In predicate on the "boss_clears" field.
+func BossClearsIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldBossClears, vs...))
+}
+
+// BossClearsNotIn applies the NotIn predicate on the "boss_clears" field.
+func BossClearsNotIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldBossClears, vs...))
+}
+
+// BossClearsGT applies the GT predicate on the "boss_clears" field.
+func BossClearsGT(v string) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldBossClears, v))
+}
+
+// BossClearsGTE applies the GTE predicate on the "boss_clears" field.
+func BossClearsGTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldBossClears, v))
+}
+
+// BossClearsLT applies the LT predicate on the "boss_clears" field.
+func BossClearsLT(v string) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldBossClears, v))
+}
+
+// BossClearsLTE applies the LTE predicate on the "boss_clears" field.
+func BossClearsLTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldBossClears, v))
+}
+
+// BossClearsContains applies the Contains predicate on the "boss_clears" field.
+func BossClearsContains(v string) predicate.Player {
+	return predicate.Player(sql.FieldContains(FieldBossClears, v))
+}
+
+// BossClearsHasPrefix applies the HasPrefix predicate on the "boss_clears" field.
+func BossClearsHasPrefix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasPrefix(FieldBossClears, v))
+}
+
+// BossClearsHasSuffix applies the HasSuffix predicate on the "boss_clears" field.
+func BossClearsHasSuffix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasSuffix(FieldBossClears, v))
+}
+
+// BossClearsEqualFold applies the EqualFold predicate on the "boss_clears" field.
+func BossClearsEqualFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldEqualFold(FieldBossClears, v))
+}
+
+// BossClearsContainsFold applies the ContainsFold predicate on the "boss_clears" field.
+func BossClearsContainsFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldContainsFold(FieldBossClears, v))
+}
+
+// ExpPoolEQ applies the EQ predicate on the "exp_pool" field.
+func ExpPoolEQ(v int64) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldExpPool, v))
+}
+
+// ExpPoolNEQ applies the NEQ predicate on the "exp_pool" field.
+func ExpPoolNEQ(v int64) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldExpPool, v))
+}
+
+// ExpPoolIn applies the In predicate on the "exp_pool" field.
+func ExpPoolIn(vs ...int64) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldExpPool, vs...))
+}
+
+// ExpPoolNotIn applies the NotIn predicate on the "exp_pool" field.
+func ExpPoolNotIn(vs ...int64) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldExpPool, vs...))
+}
+
+// ExpPoolGT applies the GT predicate on the "exp_pool" field.
+func ExpPoolGT(v int64) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldExpPool, v))
+}
+
+// ExpPoolGTE applies the GTE predicate on the "exp_pool" field.
+func ExpPoolGTE(v int64) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldExpPool, v))
+}
+
+// ExpPoolLT applies the LT predicate on the "exp_pool" field.
+func ExpPoolLT(v int64) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldExpPool, v))
+}
+
+// ExpPoolLTE applies the LTE predicate on the "exp_pool" field.
+func ExpPoolLTE(v int64) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldExpPool, v))
+}
+
+// GraduationCountEQ applies the EQ predicate on the "graduation_count" field.
+func GraduationCountEQ(v int64) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldGraduationCount, v))
+}
+
+// GraduationCountNEQ applies the NEQ predicate on the "graduation_count" field.
+func GraduationCountNEQ(v int64) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldGraduationCount, v))
+}
+
+// GraduationCountIn applies the In predicate on the "graduation_count" field.
+func GraduationCountIn(vs ...int64) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldGraduationCount, vs...))
+}
+
+// GraduationCountNotIn applies the NotIn predicate on the "graduation_count" field.
+func GraduationCountNotIn(vs ...int64) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldGraduationCount, vs...))
+}
+
+// GraduationCountGT applies the GT predicate on the "graduation_count" field.
+func GraduationCountGT(v int64) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldGraduationCount, v))
+}
+
+// GraduationCountGTE applies the GTE predicate on the "graduation_count" field.
+func GraduationCountGTE(v int64) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldGraduationCount, v))
+}
+
+// GraduationCountLT applies the LT predicate on the "graduation_count" field.
+func GraduationCountLT(v int64) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldGraduationCount, v))
+}
+
+// GraduationCountLTE applies the LTE predicate on the "graduation_count" field.
+func GraduationCountLTE(v int64) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldGraduationCount, v))
+}
+
+// IncubatorEQ applies the EQ predicate on the "incubator" field.
+func IncubatorEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldIncubator, v))
+}
+
+// IncubatorNEQ applies the NEQ predicate on the "incubator" field.
+func IncubatorNEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldIncubator, v))
+}
+
+// IncubatorIn applies the In predicate on the "incubator" field.
+func IncubatorIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldIncubator, vs...))
+}
+
+// IncubatorNotIn applies the NotIn predicate on the "incubator" field.
+func IncubatorNotIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldIncubator, vs...))
+}
+
+// IncubatorGT applies the GT predicate on the "incubator" field.
+func IncubatorGT(v string) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldIncubator, v))
+}
+
+// IncubatorGTE applies the GTE predicate on the "incubator" field.
+func IncubatorGTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldIncubator, v))
+}
+
+// IncubatorLT applies the LT predicate on the "incubator" field.
+func IncubatorLT(v string) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldIncubator, v))
+}
+
+// IncubatorLTE applies the LTE predicate on the "incubator" field.
+func IncubatorLTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldIncubator, v))
+}
+
+// IncubatorContains applies the Contains predicate on the "incubator" field.
+func IncubatorContains(v string) predicate.Player {
+	return predicate.Player(sql.FieldContains(FieldIncubator, v))
+}
+
+// IncubatorHasPrefix applies the HasPrefix predicate on the "incubator" field.
+func IncubatorHasPrefix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasPrefix(FieldIncubator, v))
+}
+
+// IncubatorHasSuffix applies the HasSuffix predicate on the "incubator" field.
+func IncubatorHasSuffix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasSuffix(FieldIncubator, v))
+}
+
+// IncubatorEqualFold applies the EqualFold predicate on the "incubator" field.
+func IncubatorEqualFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldEqualFold(FieldIncubator, v))
+}
+
+// IncubatorContainsFold applies the ContainsFold predicate on the "incubator" field.
+func IncubatorContainsFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldContainsFold(FieldIncubator, v))
+}
+
+// SoulBeadsEQ applies the EQ predicate on the "soul_beads" field.
+func SoulBeadsEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSoulBeads, v))
+}
+
+// SoulBeadsNEQ applies the NEQ predicate on the "soul_beads" field.
+func SoulBeadsNEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldSoulBeads, v))
+}
+
+// SoulBeadsIn applies the In predicate on the "soul_beads" field.
+func SoulBeadsIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldSoulBeads, vs...))
+}
+
+// SoulBeadsNotIn applies the NotIn predicate on the "soul_beads" field.
+func SoulBeadsNotIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldSoulBeads, vs...))
+}
+
+// SoulBeadsGT applies the GT predicate on the "soul_beads" field.
+func SoulBeadsGT(v string) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldSoulBeads, v))
+}
+
+// SoulBeadsGTE applies the GTE predicate on the "soul_beads" field.
+func SoulBeadsGTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldSoulBeads, v))
+}
+
+// SoulBeadsLT applies the LT predicate on the "soul_beads" field.
+func SoulBeadsLT(v string) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldSoulBeads, v))
+}
+
+// SoulBeadsLTE applies the LTE predicate on the "soul_beads" field.
+func SoulBeadsLTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldSoulBeads, v))
+}
+
+// SoulBeadsContains applies the Contains predicate on the "soul_beads" field.
+func SoulBeadsContains(v string) predicate.Player {
+	return predicate.Player(sql.FieldContains(FieldSoulBeads, v))
+}
+
+// SoulBeadsHasPrefix applies the HasPrefix predicate on the "soul_beads" field.
+func SoulBeadsHasPrefix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasPrefix(FieldSoulBeads, v))
+}
+
+// SoulBeadsHasSuffix applies the HasSuffix predicate on the "soul_beads" field.
+func SoulBeadsHasSuffix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasSuffix(FieldSoulBeads, v))
+}
+
+// SoulBeadsEqualFold applies the EqualFold predicate on the "soul_beads" field.
+func SoulBeadsEqualFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldEqualFold(FieldSoulBeads, v))
+}
+
+// SoulBeadsContainsFold applies the ContainsFold predicate on the "soul_beads" field.
+func SoulBeadsContainsFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldContainsFold(FieldSoulBeads, v))
+}
+
+// ItemBuffsEQ applies the EQ predicate on the "item_buffs" field.
+func ItemBuffsEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldItemBuffs, v))
+}
+
+// ItemBuffsNEQ applies the NEQ predicate on the "item_buffs" field.
+func ItemBuffsNEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldItemBuffs, v))
+}
+
+// ItemBuffsIn applies the In predicate on the "item_buffs" field.
+func ItemBuffsIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldItemBuffs, vs...))
+}
+
+// ItemBuffsNotIn applies the NotIn predicate on the "item_buffs" field.
+func ItemBuffsNotIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldItemBuffs, vs...))
+}
+
+// ItemBuffsGT applies the GT predicate on the "item_buffs" field.
+func ItemBuffsGT(v string) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldItemBuffs, v))
+}
+
+// ItemBuffsGTE applies the GTE predicate on the "item_buffs" field.
+func ItemBuffsGTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldItemBuffs, v))
+}
+
+// ItemBuffsLT applies the LT predicate on the "item_buffs" field.
+func ItemBuffsLT(v string) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldItemBuffs, v))
+}
+
+// ItemBuffsLTE applies the LTE predicate on the "item_buffs" field.
+func ItemBuffsLTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldItemBuffs, v))
+}
+
+// ItemBuffsContains applies the Contains predicate on the "item_buffs" field.
+func ItemBuffsContains(v string) predicate.Player {
+	return predicate.Player(sql.FieldContains(FieldItemBuffs, v))
+}
+
+// ItemBuffsHasPrefix applies the HasPrefix predicate on the "item_buffs" field.
+func ItemBuffsHasPrefix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasPrefix(FieldItemBuffs, v))
+}
+
+// ItemBuffsHasSuffix applies the HasSuffix predicate on the "item_buffs" field.
+func ItemBuffsHasSuffix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasSuffix(FieldItemBuffs, v))
+}
+
+// ItemBuffsEqualFold applies the EqualFold predicate on the "item_buffs" field.
+func ItemBuffsEqualFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldEqualFold(FieldItemBuffs, v))
+}
+
+// ItemBuffsContainsFold applies the ContainsFold predicate on the "item_buffs" field.
+func ItemBuffsContainsFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldContainsFold(FieldItemBuffs, v))
+}
+
 // CurrentPetIDEQ applies the EQ predicate on the "current_pet_id" field.
 func CurrentPetIDEQ(v int64) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldCurrentPetID, v))
@@ -2079,6 +2494,29 @@ func HasItemsWith(preds ...predicate.Item) predicate.Player {
 	})
 }
 
+// HasPvpRatings applies the HasEdge predicate on the "pvp_ratings" edge.
+func HasPvpRatings() predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PvpRatingsTable, PvpRatingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPvpRatingsWith applies the HasEdge predicate on the "pvp_ratings" edge with a given conditions (other predicates).
+func HasPvpRatingsWith(preds ...predicate.PvpRating) predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := newPvpRatingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Player) predicate.Player {
 	return predicate.Player(sql.AndPredicates(predicates...))
@@ -10,6 +10,7 @@ import (
 	"jseer/ent/item"
 	"jseer/ent/pet"
 	"jseer/ent/player"
+	"jseer/ent/pvprating"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -399,6 +400,20 @@ func (_c *PlayerCreate) SetNillableRoomID(v *int64) *PlayerCreate {
 	return _c
 }
 
+// SetRoomPrivacy sets the "room_privacy" field.
+func (_c *PlayerCreate) SetRoomPrivacy(v int64) *PlayerCreate {
+	_c.mutation.SetRoomPrivacy(v)
+	return _c
+}
+
+// SetNillableRoomPrivacy sets the "room_privacy" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableRoomPrivacy(v *int64) *PlayerCreate {
+	if v != nil {
+		_c.SetRoomPrivacy(*v)
+	}
+	return _c
+}
+
 // SetFitments sets the "fitments" field.
 func (_c *PlayerCreate) SetFitments(v string) *PlayerCreate {
 	_c.mutation.SetFitments(v)
@@ -441,6 +456,90 @@ func (_c *PlayerCreate) SetNillableMailbox(v *string) *PlayerCreate {
 	return _c
 }
 
+// SetBossClears sets the "boss_clears" field.
+func (_c *PlayerCreate) SetBossClears(v string) *PlayerCreate {
+	_c.mutation.SetBossClears(v)
+	return _c
+}
+
+// SetNillableBossClears sets the "boss_clears" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableBossClears(v *string) *PlayerCreate {
+	if v != nil {
+		_c.SetBossClears(*v)
+	}
+	return _c
+}
+
+// SetExpPool sets the "exp_pool" field.
+func (_c *PlayerCreate) SetExpPool(v int64) *PlayerCreate {
+	_c.mutation.SetExpPool(v)
+	return _c
+}
+
+// SetNillableExpPool sets the "exp_pool" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableExpPool(v *int64) *PlayerCreate {
+	if v != nil {
+		_c.SetExpPool(*v)
+	}
+	return _c
+}
+
+// SetGraduationCount sets the "graduation_count" field.
+func (_c *PlayerCreate) SetGraduationCount(v int64) *PlayerCreate {
+	_c.mutation.SetGraduationCount(v)
+	return _c
+}
+
+// SetNillableGraduationCount sets the "graduation_count" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableGraduationCount(v *int64) *PlayerCreate {
+	if v != nil {
+		_c.SetGraduationCount(*v)
+	}
+	return _c
+}
+
+// SetIncubator sets the "incubator" field.
+func (_c *PlayerCreate) SetIncubator(v string) *PlayerCreate {
+	_c.mutation.SetIncubator(v)
+	return _c
+}
+
+// SetNillableIncubator sets the "incubator" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableIncubator(v *string) *PlayerCreate {
+	if v != nil {
+		_c.SetIncubator(*v)
+	}
+	return _c
+}
+
+// SetSoulBeads sets the "soul_beads" field.
+func (_c *PlayerCreate) SetSoulBeads(v string) *PlayerCreate {
+	_c.mutation.SetSoulBeads(v)
+	return _c
+}
+
+// SetNillableSoulBeads sets the "soul_beads" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableSoulBeads(v *string) *PlayerCreate {
+	if v != nil {
+		_c.SetSoulBeads(*v)
+	}
+	return _c
+}
+
+// SetItemBuffs sets the "item_buffs" field.
+func (_c *PlayerCreate) SetItemBuffs(v string) *PlayerCreate {
+	_c.mutation.SetItemBuffs(v)
+	return _c
+}
+
+// SetNillableItemBuffs sets the "item_buffs" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableItemBuffs(v *string) *PlayerCreate {
+	if v != nil {
+		_c.SetItemBuffs(*v)
+	}
+	return _c
+}
+
 // SetCurrentPetID sets the "current_pet_id" field.
 func (_c *PlayerCreate) SetCurrentPetID(v int64) *PlayerCreate {
 	_c.mutation.SetCurrentPetID(v)
@@ -560,6 +659,21 @@ func (_c *PlayerCreate) AddItems(v ...*Item) *PlayerCreate {
 	return _c.AddItemIDs(ids...)
 }
 
+// AddPvpRatingIDs adds the "pvp_ratings" edge to the PvpRating entity by IDs.
+func (_c *PlayerCreate) AddPvpRatingIDs(ids ...int) *PlayerCreate {
+	_c.mutation.AddPvpRatingIDs(ids...)
+	return _c
+}
+
+// AddPvpRatings adds the "pvp_ratings" edges to the PvpRating entity.
+func (_c *PlayerCreate) AddPvpRatings(v ...*PvpRating) *PlayerCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPvpRatingIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (_c *PlayerCreate) Mutation() *PlayerMutation {
 	return _c.mutation
@@ -699,6 +813,10 @@ func (_c *PlayerCreate) defaults() {
 		v := player.DefaultRoomID
 		_c.mutation.SetRoomID(v)
 	}
+	if _, ok := _c.mutation.RoomPrivacy(); !ok {
+		v := player.DefaultRoomPrivacy
+		_c.mutation.SetRoomPrivacy(v)
+	}
 	if _, ok := _c.mutation.Fitments(); !ok {
 		v := player.DefaultFitments
 		_c.mutation.SetFitments(v)
@@ -711,6 +829,30 @@ func (_c *PlayerCreate) defaults() {
 		v := player.DefaultMailbox
 		_c.mutation.SetMailbox(v)
 	}
+	if _, ok := _c.mutation.BossClears(); !ok {
+		v := player.DefaultBossClears
+		_c.mutation.SetBossClears(v)
+	}
+	if _, ok := _c.mutation.ExpPool(); !ok {
+		v := player.DefaultExpPool
+		_c.mutation.SetExpPool(v)
+	}
+	if _, ok := _c.mutation.GraduationCount(); !ok {
+		v := player.DefaultGraduationCount
+		_c.mutation.SetGraduationCount(v)
+	}
+	if _, ok := _c.mutation.Incubator(); !ok {
+		v := player.DefaultIncubator
+		_c.mutation.SetIncubator(v)
+	}
+	if _, ok := _c.mutation.SoulBeads(); !ok {
+		v := player.DefaultSoulBeads
+		_c.mutation.SetSoulBeads(v)
+	}
+	if _, ok := _c.mutation.ItemBuffs(); !ok {
+		v := player.DefaultItemBuffs
+		_c.mutation.SetItemBuffs(v)
+	}
 	if _, ok := _c.mutation.CurrentPetID(); !ok {
 		v := player.DefaultCurrentPetID
 		_c.mutation.SetCurrentPetID(v)
@@ -824,6 +966,9 @@ func (_c *PlayerCreate) check() error {
 	if _, ok := _c.mutation.RoomID(); !ok {
 		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "Player.room_id"`)}
 	}
+	if _, ok := _c.mutation.RoomPrivacy(); !ok {
+		return &ValidationError{Name: "room_privacy", err: errors.New(`ent: missing required field "Player.room_privacy"`)}
+	}
 	if _, ok := _c.mutation.Fitments(); !ok {
 		return &ValidationError{Name: "fitments", err: errors.New(`ent: missing required field "Player.fitments"`)}
 	}
@@ -833,6 +978,24 @@ func (_c *PlayerCreate) check() error {
 	if _, ok := _c.mutation.Mailbox(); !ok {
 		return &ValidationError{Name: "mailbox", err: errors.New(`ent: missing required field "Player.mailbox"`)}
 	}
+	if _, ok := _c.mutation.BossClears(); !ok {
+		return &ValidationError{Name: "boss_clears", err: errors.New(`ent: missing required field "Player.boss_clears"`)}
+	}
+	if _, ok := _c.mutation.ExpPool(); !ok {
+		return &ValidationError{Name: "exp_pool", err: errors.New(`ent: missing required field "Player.exp_pool"`)}
+	}
+	if _, ok := _c.mutation.GraduationCount(); !ok {
+		return &ValidationError{Name: "graduation_count", err: errors.New(`ent: missing required field "Player.graduation_count"`)}
+	}
+	if _, ok := _c.mutation.Incubator(); !ok {
+		return &ValidationError{Name: "incubator", err: errors.New(`ent: missing required field "Player.incubator"`)}
+	}
+	if _, ok := _c.mutation.SoulBeads(); !ok {
+		return &ValidationError{Name: "soul_beads", err: errors.New(`ent: missing required field "Player.soul_beads"`)}
+	}
+	if _, ok := _c.mutation.ItemBuffs(); !ok {
+		return &ValidationError{Name: "item_buffs", err: errors.New(`ent: missing required field "Player.item_buffs"`)}
+	}
 	if _, ok := _c.mutation.CurrentPetID(); !ok {
 		return &ValidationError{Name: "current_pet_id", err: errors.New(`ent: missing required field "Player.current_pet_id"`)}
 	}
@@ -985,6 +1148,10 @@ func (_c *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		_spec.SetField(player.FieldRoomID, field.TypeInt64, value)
 		_node.RoomID = value
 	}
+	if value, ok := _c.mutation.RoomPrivacy(); ok {
+		_spec.SetField(player.FieldRoomPrivacy, field.TypeInt64, value)
+		_node.RoomPrivacy = value
+	}
 	if value, ok := _c.mutation.Fitments(); ok {
 		_spec.SetField(player.FieldFitments, field.TypeString, value)
 		_node.Fitments = value
@@ -997,6 +1164,30 @@ func (_c *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		_spec.SetField(player.FieldMailbox, field.TypeString, value)
 		_node.Mailbox = value
 	}
+	if value, ok := _c.mutation.BossClears(); ok {
+		_spec.SetField(player.FieldBossClears, field.TypeString, value)
+		_node.BossClears = value
+	}
+	if value, ok := _c.mutation.ExpPool(); ok {
+		_spec.SetField(player.FieldExpPool, field.TypeInt64, value)
+		_node.ExpPool = value
+	}
+	if value, ok := _c.mutation.GraduationCount(); ok {
+		_spec.SetField(player.FieldGraduationCount, field.TypeInt64, value)
+		_node.GraduationCount = value
+	}
+	if value, ok := _c.mutation.Incubator(); ok {
+		_spec.SetField(player.FieldIncubator, field.TypeString, value)
+		_node.Incubator = value
+	}
+	if value, ok := _c.mutation.SoulBeads(); ok {
+		_spec.SetField(player.FieldSoulBeads, field.TypeString, value)
+		_node.SoulBeads = value
+	}
+	if value, ok := _c.mutation.ItemBuffs(); ok {
+		_spec.SetField(player.FieldItemBuffs, field.TypeString, value)
+		_node.ItemBuffs = value
+	}
 	if value, ok := _c.mutation.CurrentPetID(); ok {
 		_spec.SetField(player.FieldCurrentPetID, field.TypeInt64, value)
 		_node.CurrentPetID = value
@@ -1070,6 +1261,22 @@ func (_c *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PvpRatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PvpRatingsTable,
+			Columns: []string{player.PvpRatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,6 +11,7 @@ import (
 	"jseer/ent/pet"
 	"jseer/ent/player"
 	"jseer/ent/predicate"
+	"jseer/ent/pvprating"
 	"math"
 
 	"entgo.io/ent"
@@ -22,13 +23,14 @@ import (
 // PlayerQuery is the builder for querying Player entities.
 type PlayerQuery struct {
 	config
-	ctx         *QueryContext
-	order       []player.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Player
-	withAccount *AccountQuery
-	withPets    *PetQuery
-	withItems   *ItemQuery
+	ctx            *QueryContext
+	order          []player.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Player
+	withAccount    *AccountQuery
+	withPets       *PetQuery
+	withItems      *ItemQuery
+	withPvpRatings *PvpRatingQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +133,28 @@ func (_q *PlayerQuery) QueryItems() *ItemQuery {
 	return query
 }
 
+// QueryPvpRatings chains the current query on the "pvp_ratings" edge.
+func (_q *PlayerQuery) QueryPvpRatings() *PvpRatingQuery {
+	query := (&PvpRatingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(pvprating.Table, pvprating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.PvpRatingsTable, player.PvpRatingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Player entity from the query.
 // Returns a *NotFoundError when no Player was found.
 func (_q *PlayerQuery) First(ctx context.Context) (*Player, error) {
@@ -318,14 +342,15 @@ func (_q *PlayerQuery) Clone() *PlayerQuery {
 		return nil
 	}
 	return &PlayerQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]player.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Player{}, _q.predicates...),
-		withAccount: _q.withAccount.Clone(),
-		withPets:    _q.withPets.Clone(),
-		withItems:   _q.withItems.Clone(),
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]player.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.Player{}, _q.predicates...),
+		withAccount:    _q.withAccount.Clone(),
+		withPets:       _q.withPets.Clone(),
+		withItems:      _q.withItems.Clone(),
+		withPvpRatings: _q.withPvpRatings.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +390,17 @@ func (_q *PlayerQuery) WithItems(opts ...func(*ItemQuery)) *PlayerQuery {
 	return _q
 }
 
+// WithPvpRatings tells the query-builder to eager-load the nodes that are connected to
+// the "pvp_ratings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PlayerQuery) WithPvpRatings(opts ...func(*PvpRatingQuery)) *PlayerQuery {
+	query := (&PvpRatingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPvpRatings = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +479,11 @@ func (_q *PlayerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Playe
 	var (
 		nodes       = []*Player{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withAccount != nil,
 			_q.withPets != nil,
 			_q.withItems != nil,
+			_q.withPvpRatings != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -487,6 +524,13 @@ func (_q *PlayerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Playe
 			return nil, err
 		}
 	}
+	if query := _q.withPvpRatings; query != nil {
+		if err := _q.loadPvpRatings(ctx, query, nodes,
+			func(n *Player) { n.Edges.PvpRatings = []*PvpRating{} },
+			func(n *Player, e *PvpRating) { n.Edges.PvpRatings = append(n.Edges.PvpRatings, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -579,6 +623,36 @@ func (_q *PlayerQuery) loadItems(ctx context.Context, query *ItemQuery, nodes []
 	}
 	return nil
 }
+func (_q *PlayerQuery) loadPvpRatings(ctx context.Context, query *PvpRatingQuery, nodes []*Player, init func(*Player), assign func(*Player, *PvpRating)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Player)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pvprating.FieldPlayerID)
+	}
+	query.Where(predicate.PvpRating(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(player.PvpRatingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PlayerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "player_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PlayerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -11,6 +11,7 @@ import (
 	"jseer/ent/pet"
 	"jseer/ent/player"
 	"jseer/ent/predicate"
+	"jseer/ent/pvprating"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -549,6 +550,27 @@ func (_u *PlayerUpdate) AddRoomID(v int64) *PlayerUpdate {
 	return _u
 }
 
+// SetRoomPrivacy sets the "room_privacy" field.
+func (_u *PlayerUpdate) SetRoomPrivacy(v int64) *PlayerUpdate {
+	_u.mutation.ResetRoomPrivacy()
+	_u.mutation.SetRoomPrivacy(v)
+	return _u
+}
+
+// SetNillableRoomPrivacy sets the "room_privacy" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableRoomPrivacy(v *int64) *PlayerUpdate {
+	if v != nil {
+		_u.SetRoomPrivacy(*v)
+	}
+	return _u
+}
+
+// AddRoomPrivacy adds value to the "room_privacy" field.
+func (_u *PlayerUpdate) AddRoomPrivacy(v int64) *PlayerUpdate {
+	_u.mutation.AddRoomPrivacy(v)
+	return _u
+}
+
 // SetFitments sets the "fitments" field.
 func (_u *PlayerUpdate) SetFitments(v string) *PlayerUpdate {
 	_u.mutation.SetFitments(v)
@@ -591,6 +613,104 @@ func (_u *PlayerUpdate) SetNillableMailbox(v *string) *PlayerUpdate {
 	return _u
 }
 
+// SetBossClears sets the "boss_clears" field.
+func (_u *PlayerUpdate) SetBossClears(v string) *PlayerUpdate {
+	_u.mutation.SetBossClears(v)
+	return _u
+}
+
+// SetNillableBossClears sets the "boss_clears" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableBossClears(v *string) *PlayerUpdate {
+	if v != nil {
+		_u.SetBossClears(*v)
+	}
+	return _u
+}
+
+// SetExpPool sets the "exp_pool" field.
+func (_u *PlayerUpdate) SetExpPool(v int64) *PlayerUpdate {
+	_u.mutation.ResetExpPool()
+	_u.mutation.SetExpPool(v)
+	return _u
+}
+
+// SetNillableExpPool sets the "exp_pool" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableExpPool(v *int64) *PlayerUpdate {
+	if v != nil {
+		_u.SetExpPool(*v)
+	}
+	return _u
+}
+
+// AddExpPool adds value to the "exp_pool" field.
+func (_u *PlayerUpdate) AddExpPool(v int64) *PlayerUpdate {
+	_u.mutation.AddExpPool(v)
+	return _u
+}
+
+// SetGraduationCount sets the "graduation_count" field.
+func (_u *PlayerUpdate) SetGraduationCount(v int64) *PlayerUpdate {
+	_u.mutation.ResetGraduationCount()
+	_u.mutation.SetGraduationCount(v)
+	return _u
+}
+
+// SetNillableGraduationCount sets the "graduation_count" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableGraduationCount(v *int64) *PlayerUpdate {
+	if v != nil {
+		_u.SetGraduationCount(*v)
+	}
+	return _u
+}
+
+// AddGraduationCount adds value to the "graduation_count" field.
+func (_u *PlayerUpdate) AddGraduationCount(v int64) *PlayerUpdate {
+	_u.mutation.AddGraduationCount(v)
+	return _u
+}
+
+// SetIncubator sets the "incubator" field.
+func (_u *PlayerUpdate) SetIncubator(v string) *PlayerUpdate {
+	_u.mutation.SetIncubator(v)
+	return _u
+}
+
+// SetNillableIncubator sets the "incubator" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableIncubator(v *string) *PlayerUpdate {
+	if v != nil {
+		_u.SetIncubator(*v)
+	}
+	return _u
+}
+
+// SetSoulBeads sets the "soul_beads" field.
+func (_u *PlayerUpdate) SetSoulBeads(v string) *PlayerUpdate {
+	_u.mutation.SetSoulBeads(v)
+	return _u
+}
+
+// SetNillableSoulBeads sets the "soul_beads" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableSoulBeads(v *string) *PlayerUpdate {
+	if v != nil {
+		_u.SetSoulBeads(*v)
+	}
+	return _u
+}
+
+// SetItemBuffs sets the "item_buffs" field.
+func (_u *PlayerUpdate) SetItemBuffs(v string) *PlayerUpdate {
+	_u.mutation.SetItemBuffs(v)
+	return _u
+}
+
+// SetNillableItemBuffs sets the "item_buffs" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableItemBuffs(v *string) *PlayerUpdate {
+	if v != nil {
+		_u.SetItemBuffs(*v)
+	}
+	return _u
+}
+
 // SetCurrentPetID sets the "current_pet_id" field.
 func (_u *PlayerUpdate) SetCurrentPetID(v int64) *PlayerUpdate {
 	_u.mutation.ResetCurrentPetID()
@@ -729,6 +849,21 @@ func (_u *PlayerUpdate) AddItems(v ...*Item) *PlayerUpdate {
 	return _u.AddItemIDs(ids...)
 }
 
+// AddPvpRatingIDs adds the "pvp_ratings" edge to the PvpRating entity by IDs.
+func (_u *PlayerUpdate) AddPvpRatingIDs(ids ...int) *PlayerUpdate {
+	_u.mutation.AddPvpRatingIDs(ids...)
+	return _u
+}
+
+// AddPvpRatings adds the "pvp_ratings" edges to the PvpRating entity.
+func (_u *PlayerUpdate) AddPvpRatings(v ...*PvpRating) *PlayerUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPvpRatingIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (_u *PlayerUpdate) Mutation() *PlayerMutation {
 	return _u.mutation
@@ -782,6 +917,27 @@ func (_u *PlayerUpdate) RemoveItems(v ...*Item) *PlayerUpdate {
 	return _u.RemoveItemIDs(ids...)
 }
 
+// ClearPvpRatings clears all "pvp_ratings" edges to the PvpRating entity.
+func (_u *PlayerUpdate) ClearPvpRatings() *PlayerUpdate {
+	_u.mutation.ClearPvpRatings()
+	return _u
+}
+
+// RemovePvpRatingIDs removes the "pvp_ratings" edge to PvpRating entities by IDs.
+func (_u *PlayerUpdate) RemovePvpRatingIDs(ids ...int) *PlayerUpdate {
+	_u.mutation.RemovePvpRatingIDs(ids...)
+	return _u
+}
+
+// RemovePvpRatings removes "pvp_ratings" edges to PvpRating entities.
+func (_u *PlayerUpdate) RemovePvpRatings(v ...*PvpRating) *PlayerUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePvpRatingIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PlayerUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -978,6 +1134,12 @@ func (_u *PlayerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedRoomID(); ok {
 		_spec.AddField(player.FieldRoomID, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.RoomPrivacy(); ok {
+		_spec.SetField(player.FieldRoomPrivacy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRoomPrivacy(); ok {
+		_spec.AddField(player.FieldRoomPrivacy, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Fitments(); ok {
 		_spec.SetField(player.FieldFitments, field.TypeString, value)
 	}
@@ -987,6 +1149,30 @@ func (_u *PlayerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Mailbox(); ok {
 		_spec.SetField(player.FieldMailbox, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BossClears(); ok {
+		_spec.SetField(player.FieldBossClears, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpPool(); ok {
+		_spec.SetField(player.FieldExpPool, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpPool(); ok {
+		_spec.AddField(player.FieldExpPool, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GraduationCount(); ok {
+		_spec.SetField(player.FieldGraduationCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGraduationCount(); ok {
+		_spec.AddField(player.FieldGraduationCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Incubator(); ok {
+		_spec.SetField(player.FieldIncubator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SoulBeads(); ok {
+		_spec.SetField(player.FieldSoulBeads, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemBuffs(); ok {
+		_spec.SetField(player.FieldItemBuffs, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CurrentPetID(); ok {
 		_spec.SetField(player.FieldCurrentPetID, field.TypeInt64, value)
 	}
@@ -1136,6 +1322,51 @@ func (_u *PlayerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PvpRatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PvpRatingsTable,
+			Columns: []string{player.PvpRatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPvpRatingsIDs(); len(nodes) > 0 && !_u.mutation.PvpRatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PvpRatingsTable,
+			Columns: []string{player.PvpRatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PvpRatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PvpRatingsTable,
+			Columns: []string{player.PvpRatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{player.Label}
@@ -1674,6 +1905,27 @@ func (_u *PlayerUpdateOne) AddRoomID(v int64) *PlayerUpdateOne {
 	return _u
 }
 
+// SetRoomPrivacy sets the "room_privacy" field.
+func (_u *PlayerUpdateOne) SetRoomPrivacy(v int64) *PlayerUpdateOne {
+	_u.mutation.ResetRoomPrivacy()
+	_u.mutation.SetRoomPrivacy(v)
+	return _u
+}
+
+// SetNillableRoomPrivacy sets the "room_privacy" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableRoomPrivacy(v *int64) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetRoomPrivacy(*v)
+	}
+	return _u
+}
+
+// AddRoomPrivacy adds value to the "room_privacy" field.
+func (_u *PlayerUpdateOne) AddRoomPrivacy(v int64) *PlayerUpdateOne {
+	_u.mutation.AddRoomPrivacy(v)
+	return _u
+}
+
 // SetFitments sets the "fitments" field.
 func (_u *PlayerUpdateOne) SetFitments(v string) *PlayerUpdateOne {
 	_u.mutation.SetFitments(v)
@@ -1716,6 +1968,104 @@ func (_u *PlayerUpdateOne) SetNillableMailbox(v *string) *PlayerUpdateOne {
 	return _u
 }
 
+// SetBossClears sets the "boss_clears" field.
+func (_u *PlayerUpdateOne) SetBossClears(v string) *PlayerUpdateOne {
+	_u.mutation.SetBossClears(v)
+	return _u
+}
+
+// SetNillableBossClears sets the "boss_clears" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableBossClears(v *string) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetBossClears(*v)
+	}
+	return _u
+}
+
+// SetExpPool sets the "exp_pool" field.
+func (_u *PlayerUpdateOne) SetExpPool(v int64) *PlayerUpdateOne {
+	_u.mutation.ResetExpPool()
+	_u.mutation.SetExpPool(v)
+	return _u
+}
+
+// SetNillableExpPool sets the "exp_pool" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableExpPool(v *int64) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetExpPool(*v)
+	}
+	return _u
+}
+
+// AddExpPool adds value to the "exp_pool" field.
+func (_u *PlayerUpdateOne) AddExpPool(v int64) *PlayerUpdateOne {
+	_u.mutation.AddExpPool(v)
+	return _u
+}
+
+// SetGraduationCount sets the "graduation_count" field.
+func (_u *PlayerUpdateOne) SetGraduationCount(v int64) *PlayerUpdateOne {
+	_u.mutation.ResetGraduationCount()
+	_u.mutation.SetGraduationCount(v)
+	return _u
+}
+
+// SetNillableGraduationCount sets the "graduation_count" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableGraduationCount(v *int64) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetGraduationCount(*v)
+	}
+	return _u
+}
+
+// AddGraduationCount adds value to the "graduation_count" field.
+func (_u *PlayerUpdateOne) AddGraduationCount(v int64) *PlayerUpdateOne {
+	_u.mutation.AddGraduationCount(v)
+	return _u
+}
+
+// SetIncubator sets the "incubator" field.
+func (_u *PlayerUpdateOne) SetIncubator(v string) *PlayerUpdateOne {
+	_u.mutation.SetIncubator(v)
+	return _u
+}
+
+// SetNillableIncubator sets the "incubator" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableIncubator(v *string) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetIncubator(*v)
+	}
+	return _u
+}
+
+// SetSoulBeads sets the "soul_beads" field.
+func (_u *PlayerUpdateOne) SetSoulBeads(v string) *PlayerUpdateOne {
+	_u.mutation.SetSoulBeads(v)
+	return _u
+}
+
+// SetNillableSoulBeads sets the "soul_beads" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableSoulBeads(v *string) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetSoulBeads(*v)
+	}
+	return _u
+}
+
+// SetItemBuffs sets the "item_buffs" field.
+func (_u *PlayerUpdateOne) SetItemBuffs(v string) *PlayerUpdateOne {
+	_u.mutation.SetItemBuffs(v)
+	return _u
+}
+
+// SetNillableItemBuffs sets the "item_buffs" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableItemBuffs(v *string) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetItemBuffs(*v)
+	}
+	return _u
+}
+
 // SetCurrentPetID sets the "current_pet_id" field.
 func (_u *PlayerUpdateOne) SetCurrentPetID(v int64) *PlayerUpdateOne {
 	_u.mutation.ResetCurrentPetID()
@@ -1854,6 +2204,21 @@ func (_u *PlayerUpdateOne) AddItems(v ...*Item) *PlayerUpdateOne {
 	return _u.AddItemIDs(ids...)
 }
 
+// AddPvpRatingIDs adds the "pvp_ratings" edge to the PvpRating entity by IDs.
+func (_u *PlayerUpdateOne) AddPvpRatingIDs(ids ...int) *PlayerUpdateOne {
+	_u.mutation.AddPvpRatingIDs(ids...)
+	return _u
+}
+
+// AddPvpRatings adds the "pvp_ratings" edges to the PvpRating entity.
+func (_u *PlayerUpdateOne) AddPvpRatings(v ...*PvpRating) *PlayerUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPvpRatingIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (_u *PlayerUpdateOne) Mutation() *PlayerMutation {
 	return _u.mutation
@@ -1907,6 +2272,27 @@ func (_u *PlayerUpdateOne) RemoveItems(v ...*Item) *PlayerUpdateOne {
 	return _u.RemoveItemIDs(ids...)
 }
 
+// ClearPvpRatings clears all "pvp_ratings" edges to the PvpRating entity.
+func (_u *PlayerUpdateOne) ClearPvpRatings() *PlayerUpdateOne {
+	_u.mutation.ClearPvpRatings()
+	return _u
+}
+
+// RemovePvpRatingIDs removes the "pvp_ratings" edge to PvpRating entities by IDs.
+func (_u *PlayerUpdateOne) RemovePvpRatingIDs(ids ...int) *PlayerUpdateOne {
+	_u.mutation.RemovePvpRatingIDs(ids...)
+	return _u
+}
+
+// RemovePvpRatings removes "pvp_ratings" edges to PvpRating entities.
+func (_u *PlayerUpdateOne) RemovePvpRatings(v ...*PvpRating) *PlayerUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePvpRatingIDs(ids...)
+}
+
 // Where appends a list predicates to the PlayerUpdate builder.
 func (_u *PlayerUpdateOne) Where(ps ...predicate.Player) *PlayerUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2133,6 +2519,12 @@ func (_u *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err erro
 	if value, ok := _u.mutation.AddedRoomID(); ok {
 		_spec.AddField(player.FieldRoomID, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.RoomPrivacy(); ok {
+		_spec.SetField(player.FieldRoomPrivacy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRoomPrivacy(); ok {
+		_spec.AddField(player.FieldRoomPrivacy, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Fitments(); ok {
 		_spec.SetField(player.FieldFitments, field.TypeString, value)
 	}
@@ -2142,6 +2534,30 @@ func (_u *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err erro
 	if value, ok := _u.mutation.Mailbox(); ok {
 		_spec.SetField(player.FieldMailbox, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BossClears(); ok {
+		_spec.SetField(player.FieldBossClears, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpPool(); ok {
+		_spec.SetField(player.FieldExpPool, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpPool(); ok {
+		_spec.AddField(player.FieldExpPool, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GraduationCount(); ok {
+		_spec.SetField(player.FieldGraduationCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGraduationCount(); ok {
+		_spec.AddField(player.FieldGraduationCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Incubator(); ok {
+		_spec.SetField(player.FieldIncubator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SoulBeads(); ok {
+		_spec.SetField(player.FieldSoulBeads, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemBuffs(); ok {
+		_spec.SetField(player.FieldItemBuffs, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CurrentPetID(); ok {
 		_spec.SetField(player.FieldCurrentPetID, field.TypeInt64, value)
 	}
@@ -2291,6 +2707,51 @@ func (_u *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PvpRatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PvpRatingsTable,
+			Columns: []string{player.PvpRatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPvpRatingsIDs(); len(nodes) > 0 && !_u.mutation.PvpRatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PvpRatingsTable,
+			Columns: []string{player.PvpRatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PvpRatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.PvpRatingsTable,
+			Columns: []string{player.PvpRatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Player{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
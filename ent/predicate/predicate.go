@@ -12,6 +12,12 @@ type Account func(*sql.Selector)
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// ChatLog is the predicate function for chatlog builders.
+type ChatLog func(*sql.Selector)
+
+// ChatMute is the predicate function for chatmute builders.
+type ChatMute func(*sql.Selector)
+
 // ConfigEntry is the predicate function for configentry builders.
 type ConfigEntry func(*sql.Selector)
 
@@ -24,6 +30,9 @@ type GMUser func(*sql.Selector)
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
+// Mentorship is the predicate function for mentorship builders.
+type Mentorship func(*sql.Selector)
+
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
@@ -33,5 +42,23 @@ type Pet func(*sql.Selector)
 // Player is the predicate function for player builders.
 type Player func(*sql.Selector)
 
+// PvpRating is the predicate function for pvprating builders.
+type PvpRating func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
+
+// Team is the predicate function for team builders.
+type Team func(*sql.Selector)
+
+// TeamMember is the predicate function for teammember builders.
+type TeamMember func(*sql.Selector)
+
+// TeamPkHistory is the predicate function for teampkhistory builders.
+type TeamPkHistory func(*sql.Selector)
+
+// TeamPkScore is the predicate function for teampkscore builders.
+type TeamPkScore func(*sql.Selector)
+
+// TeamPkSeerScore is the predicate function for teampkseerscore builders.
+type TeamPkSeerScore func(*sql.Selector)
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jseer/ent/player"
+	"jseer/ent/pvprating"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PvpRating is the model entity for the PvpRating schema.
+type PvpRating struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID int `json:"player_id,omitempty"`
+	// Season holds the value of the "season" field.
+	Season int `json:"season,omitempty"`
+	// Nick holds the value of the "nick" field.
+	Nick string `json:"nick,omitempty"`
+	// Rating holds the value of the "rating" field.
+	Rating int `json:"rating,omitempty"`
+	// Wins holds the value of the "wins" field.
+	Wins int `json:"wins,omitempty"`
+	// Losses holds the value of the "losses" field.
+	Losses int `json:"losses,omitempty"`
+	// Draws holds the value of the "draws" field.
+	Draws int `json:"draws,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PvpRatingQuery when eager-loading is set.
+	Edges        PvpRatingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PvpRatingEdges holds the relations/edges for other nodes in the graph.
+type PvpRatingEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PvpRatingEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PvpRating) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pvprating.FieldID, pvprating.FieldPlayerID, pvprating.FieldSeason, pvprating.FieldRating, pvprating.FieldWins, pvprating.FieldLosses, pvprating.FieldDraws:
+			values[i] = new(sql.NullInt64)
+		case pvprating.FieldNick:
+			values[i] = new(sql.NullString)
+		case pvprating.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PvpRating fields.
+func (_m *PvpRating) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pvprating.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case pvprating.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				_m.PlayerID = int(value.Int64)
+			}
+		case pvprating.FieldSeason:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season", values[i])
+			} else if value.Valid {
+				_m.Season = int(value.Int64)
+			}
+		case pvprating.FieldNick:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nick", values[i])
+			} else if value.Valid {
+				_m.Nick = value.String
+			}
+		case pvprating.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				_m.Rating = int(value.Int64)
+			}
+		case pvprating.FieldWins:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wins", values[i])
+			} else if value.Valid {
+				_m.Wins = int(value.Int64)
+			}
+		case pvprating.FieldLosses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field losses", values[i])
+			} else if value.Valid {
+				_m.Losses = int(value.Int64)
+			}
+		case pvprating.FieldDraws:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field draws", values[i])
+			} else if value.Valid {
+				_m.Draws = int(value.Int64)
+			}
+		case pvprating.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PvpRating.
+// This includes values selected through modifiers, order, etc.
+func (_m *PvpRating) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the PvpRating entity.
+func (_m *PvpRating) QueryPlayer() *PlayerQuery {
+	return NewPvpRatingClient(_m.config).QueryPlayer(_m)
+}
+
+// Update returns a builder for updating this PvpRating.
+// Note that you need to call PvpRating.Unwrap() before calling this method if this PvpRating
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PvpRating) Update() *PvpRatingUpdateOne {
+	return NewPvpRatingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PvpRating entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PvpRating) Unwrap() *PvpRating {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PvpRating is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PvpRating) String() string {
+	var builder strings.Builder
+	builder.WriteString("PvpRating(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlayerID))
+	builder.WriteString(", ")
+	builder.WriteString("season=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Season))
+	builder.WriteString(", ")
+	builder.WriteString("nick=")
+	builder.WriteString(_m.Nick)
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rating))
+	builder.WriteString(", ")
+	builder.WriteString("wins=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Wins))
+	builder.WriteString(", ")
+	builder.WriteString("losses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Losses))
+	builder.WriteString(", ")
+	builder.WriteString("draws=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Draws))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PvpRatings is a parsable slice of PvpRating.
+type PvpRatings []*PvpRating
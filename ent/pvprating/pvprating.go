@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pvprating
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the pvprating type in the database.
+	Label = "pvp_rating"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPlayerID holds the string denoting the player_id field in the database.
+	FieldPlayerID = "player_id"
+	// FieldSeason holds the string denoting the season field in the database.
+	FieldSeason = "season"
+	// FieldNick holds the string denoting the nick field in the database.
+	FieldNick = "nick"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldWins holds the string denoting the wins field in the database.
+	FieldWins = "wins"
+	// FieldLosses holds the string denoting the losses field in the database.
+	FieldLosses = "losses"
+	// FieldDraws holds the string denoting the draws field in the database.
+	FieldDraws = "draws"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgePlayer holds the string denoting the player edge name in mutations.
+	EdgePlayer = "player"
+	// Table holds the table name of the pvprating in the database.
+	Table = "pvp_ratings"
+	// PlayerTable is the table that holds the player relation/edge.
+	PlayerTable = "pvp_ratings"
+	// PlayerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	PlayerInverseTable = "players"
+	// PlayerColumn is the table column denoting the player relation/edge.
+	PlayerColumn = "player_id"
+)
+
+// Columns holds all SQL columns for pvprating fields.
+var Columns = []string{
+	FieldID,
+	FieldPlayerID,
+	FieldSeason,
+	FieldNick,
+	FieldRating,
+	FieldWins,
+	FieldLosses,
+	FieldDraws,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNick holds the default value on creation for the "nick" field.
+	DefaultNick string
+	// DefaultRating holds the default value on creation for the "rating" field.
+	DefaultRating int
+	// DefaultWins holds the default value on creation for the "wins" field.
+	DefaultWins int
+	// DefaultLosses holds the default value on creation for the "losses" field.
+	DefaultLosses int
+	// DefaultDraws holds the default value on creation for the "draws" field.
+	DefaultDraws int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PvpRating queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPlayerID orders the results by the player_id field.
+func ByPlayerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayerID, opts...).ToFunc()
+}
+
+// BySeason orders the results by the season field.
+func BySeason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeason, opts...).ToFunc()
+}
+
+// ByNick orders the results by the nick field.
+func ByNick(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNick, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByWins orders the results by the wins field.
+func ByWins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWins, opts...).ToFunc()
+}
+
+// ByLosses orders the results by the losses field.
+func ByLosses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLosses, opts...).ToFunc()
+}
+
+// ByDraws orders the results by the draws field.
+func ByDraws(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDraws, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPlayerField orders the results by player field.
+func ByPlayerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlayerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlayerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlayerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+	)
+}
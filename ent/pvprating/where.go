@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pvprating
+
+import (
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldID, id))
+}
+
+// PlayerID applies equality check predicate on the "player_id" field. It's identical to PlayerIDEQ.
+func PlayerID(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// Season applies equality check predicate on the "season" field. It's identical to SeasonEQ.
+func Season(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldSeason, v))
+}
+
+// Nick applies equality check predicate on the "nick" field. It's identical to NickEQ.
+func Nick(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldNick, v))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldRating, v))
+}
+
+// Wins applies equality check predicate on the "wins" field. It's identical to WinsEQ.
+func Wins(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldWins, v))
+}
+
+// Losses applies equality check predicate on the "losses" field. It's identical to LossesEQ.
+func Losses(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldLosses, v))
+}
+
+// Draws applies equality check predicate on the "draws" field. It's identical to DrawsEQ.
+func Draws(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldDraws, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PlayerIDEQ applies the EQ predicate on the "player_id" field.
+func PlayerIDEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// PlayerIDNEQ applies the NEQ predicate on the "player_id" field.
+func PlayerIDNEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldPlayerID, v))
+}
+
+// PlayerIDIn applies the In predicate on the "player_id" field.
+func PlayerIDIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDNotIn applies the NotIn predicate on the "player_id" field.
+func PlayerIDNotIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldPlayerID, vs...))
+}
+
+// SeasonEQ applies the EQ predicate on the "season" field.
+func SeasonEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldSeason, v))
+}
+
+// SeasonNEQ applies the NEQ predicate on the "season" field.
+func SeasonNEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldSeason, v))
+}
+
+// SeasonIn applies the In predicate on the "season" field.
+func SeasonIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldSeason, vs...))
+}
+
+// SeasonNotIn applies the NotIn predicate on the "season" field.
+func SeasonNotIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldSeason, vs...))
+}
+
+// SeasonGT applies the GT predicate on the "season" field.
+func SeasonGT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldSeason, v))
+}
+
+// SeasonGTE applies the GTE predicate on the "season" field.
+func SeasonGTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldSeason, v))
+}
+
+// SeasonLT applies the LT predicate on the "season" field.
+func SeasonLT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldSeason, v))
+}
+
+// SeasonLTE applies the LTE predicate on the "season" field.
+func SeasonLTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldSeason, v))
+}
+
+// NickEQ applies the EQ predicate on the "nick" field.
+func NickEQ(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldNick, v))
+}
+
+// NickNEQ applies the NEQ predicate on the "nick" field.
+func NickNEQ(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldNick, v))
+}
+
+// NickIn applies the In predicate on the "nick" field.
+func NickIn(vs ...string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldNick, vs...))
+}
+
+// NickNotIn applies the NotIn predicate on the "nick" field.
+func NickNotIn(vs ...string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldNick, vs...))
+}
+
+// NickGT applies the GT predicate on the "nick" field.
+func NickGT(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldNick, v))
+}
+
+// NickGTE applies the GTE predicate on the "nick" field.
+func NickGTE(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldNick, v))
+}
+
+// NickLT applies the LT predicate on the "nick" field.
+func NickLT(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldNick, v))
+}
+
+// NickLTE applies the LTE predicate on the "nick" field.
+func NickLTE(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldNick, v))
+}
+
+// NickContains applies the Contains predicate on the "nick" field.
+func NickContains(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldContains(FieldNick, v))
+}
+
+// NickHasPrefix applies the HasPrefix predicate on the "nick" field.
+func NickHasPrefix(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldHasPrefix(FieldNick, v))
+}
+
+// NickHasSuffix applies the HasSuffix predicate on the "nick" field.
+func NickHasSuffix(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldHasSuffix(FieldNick, v))
+}
+
+// NickEqualFold applies the EqualFold predicate on the "nick" field.
+func NickEqualFold(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEqualFold(FieldNick, v))
+}
+
+// NickContainsFold applies the ContainsFold predicate on the "nick" field.
+func NickContainsFold(v string) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldContainsFold(FieldNick, v))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldRating, v))
+}
+
+// WinsEQ applies the EQ predicate on the "wins" field.
+func WinsEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldWins, v))
+}
+
+// WinsNEQ applies the NEQ predicate on the "wins" field.
+func WinsNEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldWins, v))
+}
+
+// WinsIn applies the In predicate on the "wins" field.
+func WinsIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldWins, vs...))
+}
+
+// WinsNotIn applies the NotIn predicate on the "wins" field.
+func WinsNotIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldWins, vs...))
+}
+
+// WinsGT applies the GT predicate on the "wins" field.
+func WinsGT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldWins, v))
+}
+
+// WinsGTE applies the GTE predicate on the "wins" field.
+func WinsGTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldWins, v))
+}
+
+// WinsLT applies the LT predicate on the "wins" field.
+func WinsLT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldWins, v))
+}
+
+// WinsLTE applies the LTE predicate on the "wins" field.
+func WinsLTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldWins, v))
+}
+
+// LossesEQ applies the EQ predicate on the "losses" field.
+func LossesEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldLosses, v))
+}
+
+// LossesNEQ applies the NEQ predicate on the "losses" field.
+func LossesNEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldLosses, v))
+}
+
+// LossesIn applies the In predicate on the "losses" field.
+func LossesIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldLosses, vs...))
+}
+
+// LossesNotIn applies the NotIn predicate on the "losses" field.
+func LossesNotIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldLosses, vs...))
+}
+
+// LossesGT applies the GT predicate on the "losses" field.
+func LossesGT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldLosses, v))
+}
+
+// LossesGTE applies the GTE predicate on the "losses" field.
+func LossesGTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldLosses, v))
+}
+
+// LossesLT applies the LT predicate on the "losses" field.
+func LossesLT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldLosses, v))
+}
+
+// LossesLTE applies the LTE predicate on the "losses" field.
+func LossesLTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldLosses, v))
+}
+
+// DrawsEQ applies the EQ predicate on the "draws" field.
+func DrawsEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldDraws, v))
+}
+
+// DrawsNEQ applies the NEQ predicate on the "draws" field.
+func DrawsNEQ(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldDraws, v))
+}
+
+// DrawsIn applies the In predicate on the "draws" field.
+func DrawsIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldDraws, vs...))
+}
+
+// DrawsNotIn applies the NotIn predicate on the "draws" field.
+func DrawsNotIn(vs ...int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldDraws, vs...))
+}
+
+// DrawsGT applies the GT predicate on the "draws" field.
+func DrawsGT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldDraws, v))
+}
+
+// DrawsGTE applies the GTE predicate on the "draws" field.
+func DrawsGTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldDraws, v))
+}
+
+// DrawsLT applies the LT predicate on the "draws" field.
+func DrawsLT(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldDraws, v))
+}
+
+// DrawsLTE applies the LTE predicate on the "draws" field.
+func DrawsLTE(v int) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldDraws, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PvpRating {
+	return predicate.PvpRating(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.PvpRating {
+	return predicate.PvpRating(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.PvpRating {
+	return predicate.PvpRating(func(s *sql.Selector) {
+		step := newPlayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PvpRating) predicate.PvpRating {
+	return predicate.PvpRating(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PvpRating) predicate.PvpRating {
+	return predicate.PvpRating(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PvpRating) predicate.PvpRating {
+	return predicate.PvpRating(sql.NotPredicates(p))
+}
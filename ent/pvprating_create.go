@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/player"
+	"jseer/ent/pvprating"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PvpRatingCreate is the builder for creating a PvpRating entity.
+type PvpRatingCreate struct {
+	config
+	mutation *PvpRatingMutation
+	hooks    []Hook
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_c *PvpRatingCreate) SetPlayerID(v int) *PvpRatingCreate {
+	_c.mutation.SetPlayerID(v)
+	return _c
+}
+
+// SetSeason sets the "season" field.
+func (_c *PvpRatingCreate) SetSeason(v int) *PvpRatingCreate {
+	_c.mutation.SetSeason(v)
+	return _c
+}
+
+// SetNick sets the "nick" field.
+func (_c *PvpRatingCreate) SetNick(v string) *PvpRatingCreate {
+	_c.mutation.SetNick(v)
+	return _c
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_c *PvpRatingCreate) SetNillableNick(v *string) *PvpRatingCreate {
+	if v != nil {
+		_c.SetNick(*v)
+	}
+	return _c
+}
+
+// SetRating sets the "rating" field.
+func (_c *PvpRatingCreate) SetRating(v int) *PvpRatingCreate {
+	_c.mutation.SetRating(v)
+	return _c
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_c *PvpRatingCreate) SetNillableRating(v *int) *PvpRatingCreate {
+	if v != nil {
+		_c.SetRating(*v)
+	}
+	return _c
+}
+
+// SetWins sets the "wins" field.
+func (_c *PvpRatingCreate) SetWins(v int) *PvpRatingCreate {
+	_c.mutation.SetWins(v)
+	return _c
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (_c *PvpRatingCreate) SetNillableWins(v *int) *PvpRatingCreate {
+	if v != nil {
+		_c.SetWins(*v)
+	}
+	return _c
+}
+
+// SetLosses sets the "losses" field.
+func (_c *PvpRatingCreate) SetLosses(v int) *PvpRatingCreate {
+	_c.mutation.SetLosses(v)
+	return _c
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (_c *PvpRatingCreate) SetNillableLosses(v *int) *PvpRatingCreate {
+	if v != nil {
+		_c.SetLosses(*v)
+	}
+	return _c
+}
+
+// SetDraws sets the "draws" field.
+func (_c *PvpRatingCreate) SetDraws(v int) *PvpRatingCreate {
+	_c.mutation.SetDraws(v)
+	return _c
+}
+
+// SetNillableDraws sets the "draws" field if the given value is not nil.
+func (_c *PvpRatingCreate) SetNillableDraws(v *int) *PvpRatingCreate {
+	if v != nil {
+		_c.SetDraws(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PvpRatingCreate) SetUpdatedAt(v time.Time) *PvpRatingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PvpRatingCreate) SetNillableUpdatedAt(v *time.Time) *PvpRatingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (_c *PvpRatingCreate) SetPlayer(v *Player) *PvpRatingCreate {
+	return _c.SetPlayerID(v.ID)
+}
+
+// Mutation returns the PvpRatingMutation object of the builder.
+func (_c *PvpRatingCreate) Mutation() *PvpRatingMutation {
+	return _c.mutation
+}
+
+// Save creates the PvpRating in the database.
+func (_c *PvpRatingCreate) Save(ctx context.Context) (*PvpRating, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PvpRatingCreate) SaveX(ctx context.Context) *PvpRating {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PvpRatingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PvpRatingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PvpRatingCreate) defaults() {
+	if _, ok := _c.mutation.Nick(); !ok {
+		v := pvprating.DefaultNick
+		_c.mutation.SetNick(v)
+	}
+	if _, ok := _c.mutation.Rating(); !ok {
+		v := pvprating.DefaultRating
+		_c.mutation.SetRating(v)
+	}
+	if _, ok := _c.mutation.Wins(); !ok {
+		v := pvprating.DefaultWins
+		_c.mutation.SetWins(v)
+	}
+	if _, ok := _c.mutation.Losses(); !ok {
+		v := pvprating.DefaultLosses
+		_c.mutation.SetLosses(v)
+	}
+	if _, ok := _c.mutation.Draws(); !ok {
+		v := pvprating.DefaultDraws
+		_c.mutation.SetDraws(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := pvprating.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PvpRatingCreate) check() error {
+	if _, ok := _c.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`ent: missing required field "PvpRating.player_id"`)}
+	}
+	if _, ok := _c.mutation.Season(); !ok {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required field "PvpRating.season"`)}
+	}
+	if _, ok := _c.mutation.Nick(); !ok {
+		return &ValidationError{Name: "nick", err: errors.New(`ent: missing required field "PvpRating.nick"`)}
+	}
+	if _, ok := _c.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "PvpRating.rating"`)}
+	}
+	if _, ok := _c.mutation.Wins(); !ok {
+		return &ValidationError{Name: "wins", err: errors.New(`ent: missing required field "PvpRating.wins"`)}
+	}
+	if _, ok := _c.mutation.Losses(); !ok {
+		return &ValidationError{Name: "losses", err: errors.New(`ent: missing required field "PvpRating.losses"`)}
+	}
+	if _, ok := _c.mutation.Draws(); !ok {
+		return &ValidationError{Name: "draws", err: errors.New(`ent: missing required field "PvpRating.draws"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PvpRating.updated_at"`)}
+	}
+	if len(_c.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`ent: missing required edge "PvpRating.player"`)}
+	}
+	return nil
+}
+
+func (_c *PvpRatingCreate) sqlSave(ctx context.Context) (*PvpRating, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PvpRatingCreate) createSpec() (*PvpRating, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PvpRating{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pvprating.Table, sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Season(); ok {
+		_spec.SetField(pvprating.FieldSeason, field.TypeInt, value)
+		_node.Season = value
+	}
+	if value, ok := _c.mutation.Nick(); ok {
+		_spec.SetField(pvprating.FieldNick, field.TypeString, value)
+		_node.Nick = value
+	}
+	if value, ok := _c.mutation.Rating(); ok {
+		_spec.SetField(pvprating.FieldRating, field.TypeInt, value)
+		_node.Rating = value
+	}
+	if value, ok := _c.mutation.Wins(); ok {
+		_spec.SetField(pvprating.FieldWins, field.TypeInt, value)
+		_node.Wins = value
+	}
+	if value, ok := _c.mutation.Losses(); ok {
+		_spec.SetField(pvprating.FieldLosses, field.TypeInt, value)
+		_node.Losses = value
+	}
+	if value, ok := _c.mutation.Draws(); ok {
+		_spec.SetField(pvprating.FieldDraws, field.TypeInt, value)
+		_node.Draws = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(pvprating.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pvprating.PlayerTable,
+			Columns: []string{pvprating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlayerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PvpRatingCreateBulk is the builder for creating many PvpRating entities in bulk.
+type PvpRatingCreateBulk struct {
+	config
+	err      error
+	builders []*PvpRatingCreate
+}
+
+// Save creates the PvpRating entities in the database.
+func (_c *PvpRatingCreateBulk) Save(ctx context.Context) ([]*PvpRating, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PvpRating, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PvpRatingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PvpRatingCreateBulk) SaveX(ctx context.Context) []*PvpRating {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PvpRatingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PvpRatingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
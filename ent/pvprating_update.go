@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/player"
+	"jseer/ent/predicate"
+	"jseer/ent/pvprating"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PvpRatingUpdate is the builder for updating PvpRating entities.
+type PvpRatingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PvpRatingMutation
+}
+
+// Where appends a list predicates to the PvpRatingUpdate builder.
+func (_u *PvpRatingUpdate) Where(ps ...predicate.PvpRating) *PvpRatingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_u *PvpRatingUpdate) SetPlayerID(v int) *PvpRatingUpdate {
+	_u.mutation.SetPlayerID(v)
+	return _u
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (_u *PvpRatingUpdate) SetNillablePlayerID(v *int) *PvpRatingUpdate {
+	if v != nil {
+		_u.SetPlayerID(*v)
+	}
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *PvpRatingUpdate) SetSeason(v int) *PvpRatingUpdate {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *PvpRatingUpdate) SetNillableSeason(v *int) *PvpRatingUpdate {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *PvpRatingUpdate) AddSeason(v int) *PvpRatingUpdate {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetNick sets the "nick" field.
+func (_u *PvpRatingUpdate) SetNick(v string) *PvpRatingUpdate {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *PvpRatingUpdate) SetNillableNick(v *string) *PvpRatingUpdate {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// SetRating sets the "rating" field.
+func (_u *PvpRatingUpdate) SetRating(v int) *PvpRatingUpdate {
+	_u.mutation.ResetRating()
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *PvpRatingUpdate) SetNillableRating(v *int) *PvpRatingUpdate {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// AddRating adds value to the "rating" field.
+func (_u *PvpRatingUpdate) AddRating(v int) *PvpRatingUpdate {
+	_u.mutation.AddRating(v)
+	return _u
+}
+
+// SetWins sets the "wins" field.
+func (_u *PvpRatingUpdate) SetWins(v int) *PvpRatingUpdate {
+	_u.mutation.ResetWins()
+	_u.mutation.SetWins(v)
+	return _u
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (_u *PvpRatingUpdate) SetNillableWins(v *int) *PvpRatingUpdate {
+	if v != nil {
+		_u.SetWins(*v)
+	}
+	return _u
+}
+
+// AddWins adds value to the "wins" field.
+func (_u *PvpRatingUpdate) AddWins(v int) *PvpRatingUpdate {
+	_u.mutation.AddWins(v)
+	return _u
+}
+
+// SetLosses sets the "losses" field.
+func (_u *PvpRatingUpdate) SetLosses(v int) *PvpRatingUpdate {
+	_u.mutation.ResetLosses()
+	_u.mutation.SetLosses(v)
+	return _u
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (_u *PvpRatingUpdate) SetNillableLosses(v *int) *PvpRatingUpdate {
+	if v != nil {
+		_u.SetLosses(*v)
+	}
+	return _u
+}
+
+// AddLosses adds value to the "losses" field.
+func (_u *PvpRatingUpdate) AddLosses(v int) *PvpRatingUpdate {
+	_u.mutation.AddLosses(v)
+	return _u
+}
+
+// SetDraws sets the "draws" field.
+func (_u *PvpRatingUpdate) SetDraws(v int) *PvpRatingUpdate {
+	_u.mutation.ResetDraws()
+	_u.mutation.SetDraws(v)
+	return _u
+}
+
+// SetNillableDraws sets the "draws" field if the given value is not nil.
+func (_u *PvpRatingUpdate) SetNillableDraws(v *int) *PvpRatingUpdate {
+	if v != nil {
+		_u.SetDraws(*v)
+	}
+	return _u
+}
+
+// AddDraws adds value to the "draws" field.
+func (_u *PvpRatingUpdate) AddDraws(v int) *PvpRatingUpdate {
+	_u.mutation.AddDraws(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PvpRatingUpdate) SetUpdatedAt(v time.Time) *PvpRatingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (_u *PvpRatingUpdate) SetPlayer(v *Player) *PvpRatingUpdate {
+	return _u.SetPlayerID(v.ID)
+}
+
+// Mutation returns the PvpRatingMutation object of the builder.
+func (_u *PvpRatingUpdate) Mutation() *PvpRatingMutation {
+	return _u.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (_u *PvpRatingUpdate) ClearPlayer() *PvpRatingUpdate {
+	_u.mutation.ClearPlayer()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PvpRatingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PvpRatingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PvpRatingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PvpRatingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PvpRatingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pvprating.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PvpRatingUpdate) check() error {
+	if _u.mutation.PlayerCleared() && len(_u.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PvpRating.player"`)
+	}
+	return nil
+}
+
+func (_u *PvpRatingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pvprating.Table, pvprating.Columns, sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(pvprating.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(pvprating.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(pvprating.FieldNick, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(pvprating.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRating(); ok {
+		_spec.AddField(pvprating.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Wins(); ok {
+		_spec.SetField(pvprating.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWins(); ok {
+		_spec.AddField(pvprating.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Losses(); ok {
+		_spec.SetField(pvprating.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLosses(); ok {
+		_spec.AddField(pvprating.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Draws(); ok {
+		_spec.SetField(pvprating.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDraws(); ok {
+		_spec.AddField(pvprating.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pvprating.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pvprating.PlayerTable,
+			Columns: []string{pvprating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pvprating.PlayerTable,
+			Columns: []string{pvprating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pvprating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PvpRatingUpdateOne is the builder for updating a single PvpRating entity.
+type PvpRatingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PvpRatingMutation
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_u *PvpRatingUpdateOne) SetPlayerID(v int) *PvpRatingUpdateOne {
+	_u.mutation.SetPlayerID(v)
+	return _u
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (_u *PvpRatingUpdateOne) SetNillablePlayerID(v *int) *PvpRatingUpdateOne {
+	if v != nil {
+		_u.SetPlayerID(*v)
+	}
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *PvpRatingUpdateOne) SetSeason(v int) *PvpRatingUpdateOne {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *PvpRatingUpdateOne) SetNillableSeason(v *int) *PvpRatingUpdateOne {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *PvpRatingUpdateOne) AddSeason(v int) *PvpRatingUpdateOne {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetNick sets the "nick" field.
+func (_u *PvpRatingUpdateOne) SetNick(v string) *PvpRatingUpdateOne {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *PvpRatingUpdateOne) SetNillableNick(v *string) *PvpRatingUpdateOne {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// SetRating sets the "rating" field.
+func (_u *PvpRatingUpdateOne) SetRating(v int) *PvpRatingUpdateOne {
+	_u.mutation.ResetRating()
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *PvpRatingUpdateOne) SetNillableRating(v *int) *PvpRatingUpdateOne {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// AddRating adds value to the "rating" field.
+func (_u *PvpRatingUpdateOne) AddRating(v int) *PvpRatingUpdateOne {
+	_u.mutation.AddRating(v)
+	return _u
+}
+
+// SetWins sets the "wins" field.
+func (_u *PvpRatingUpdateOne) SetWins(v int) *PvpRatingUpdateOne {
+	_u.mutation.ResetWins()
+	_u.mutation.SetWins(v)
+	return _u
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (_u *PvpRatingUpdateOne) SetNillableWins(v *int) *PvpRatingUpdateOne {
+	if v != nil {
+		_u.SetWins(*v)
+	}
+	return _u
+}
+
+// AddWins adds value to the "wins" field.
+func (_u *PvpRatingUpdateOne) AddWins(v int) *PvpRatingUpdateOne {
+	_u.mutation.AddWins(v)
+	return _u
+}
+
+// SetLosses sets the "losses" field.
+func (_u *PvpRatingUpdateOne) SetLosses(v int) *PvpRatingUpdateOne {
+	_u.mutation.ResetLosses()
+	_u.mutation.SetLosses(v)
+	return _u
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (_u *PvpRatingUpdateOne) SetNillableLosses(v *int) *PvpRatingUpdateOne {
+	if v != nil {
+		_u.SetLosses(*v)
+	}
+	return _u
+}
+
+// AddLosses adds value to the "losses" field.
+func (_u *PvpRatingUpdateOne) AddLosses(v int) *PvpRatingUpdateOne {
+	_u.mutation.AddLosses(v)
+	return _u
+}
+
+// SetDraws sets the "draws" field.
+func (_u *PvpRatingUpdateOne) SetDraws(v int) *PvpRatingUpdateOne {
+	_u.mutation.ResetDraws()
+	_u.mutation.SetDraws(v)
+	return _u
+}
+
+// SetNillableDraws sets the "draws" field if the given value is not nil.
+func (_u *PvpRatingUpdateOne) SetNillableDraws(v *int) *PvpRatingUpdateOne {
+	if v != nil {
+		_u.SetDraws(*v)
+	}
+	return _u
+}
+
+// AddDraws adds value to the "draws" field.
+func (_u *PvpRatingUpdateOne) AddDraws(v int) *PvpRatingUpdateOne {
+	_u.mutation.AddDraws(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PvpRatingUpdateOne) SetUpdatedAt(v time.Time) *PvpRatingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (_u *PvpRatingUpdateOne) SetPlayer(v *Player) *PvpRatingUpdateOne {
+	return _u.SetPlayerID(v.ID)
+}
+
+// Mutation returns the PvpRatingMutation object of the builder.
+func (_u *PvpRatingUpdateOne) Mutation() *PvpRatingMutation {
+	return _u.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (_u *PvpRatingUpdateOne) ClearPlayer() *PvpRatingUpdateOne {
+	_u.mutation.ClearPlayer()
+	return _u
+}
+
+// Where appends a list predicates to the PvpRatingUpdate builder.
+func (_u *PvpRatingUpdateOne) Where(ps ...predicate.PvpRating) *PvpRatingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PvpRatingUpdateOne) Select(field string, fields ...string) *PvpRatingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PvpRating entity.
+func (_u *PvpRatingUpdateOne) Save(ctx context.Context) (*PvpRating, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PvpRatingUpdateOne) SaveX(ctx context.Context) *PvpRating {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PvpRatingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PvpRatingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PvpRatingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pvprating.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PvpRatingUpdateOne) check() error {
+	if _u.mutation.PlayerCleared() && len(_u.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PvpRating.player"`)
+	}
+	return nil
+}
+
+func (_u *PvpRatingUpdateOne) sqlSave(ctx context.Context) (_node *PvpRating, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pvprating.Table, pvprating.Columns, sqlgraph.NewFieldSpec(pvprating.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PvpRating.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pvprating.FieldID)
+		for _, f := range fields {
+			if !pvprating.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pvprating.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(pvprating.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(pvprating.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(pvprating.FieldNick, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(pvprating.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRating(); ok {
+		_spec.AddField(pvprating.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Wins(); ok {
+		_spec.SetField(pvprating.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWins(); ok {
+		_spec.AddField(pvprating.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Losses(); ok {
+		_spec.SetField(pvprating.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLosses(); ok {
+		_spec.AddField(pvprating.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Draws(); ok {
+		_spec.SetField(pvprating.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDraws(); ok {
+		_spec.AddField(pvprating.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pvprating.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pvprating.PlayerTable,
+			Columns: []string{pvprating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pvprating.PlayerTable,
+			Columns: []string{pvprating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PvpRating{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pvprating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
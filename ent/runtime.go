@@ -5,15 +5,24 @@ package ent
 import (
 	"jseer/ent/account"
 	"jseer/ent/auditlog"
+	"jseer/ent/chatlog"
+	"jseer/ent/chatmute"
 	"jseer/ent/configentry"
 	"jseer/ent/configversion"
 	"jseer/ent/gmuser"
 	"jseer/ent/item"
+	"jseer/ent/mentorship"
 	"jseer/ent/permission"
 	"jseer/ent/pet"
 	"jseer/ent/player"
+	"jseer/ent/pvprating"
 	"jseer/ent/role"
 	"jseer/ent/schema"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"jseer/ent/teampkhistory"
+	"jseer/ent/teampkscore"
+	"jseer/ent/teampkseerscore"
 	"time"
 )
 
@@ -75,6 +84,36 @@ func init() {
 	auditlogDescCreatedAt := auditlogFields[5].Descriptor()
 	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	chatlogFields := schema.ChatLog{}.Fields()
+	_ = chatlogFields
+	// chatlogDescTargetID is the schema descriptor for target_id field.
+	chatlogDescTargetID := chatlogFields[1].Descriptor()
+	// chatlog.DefaultTargetID holds the default value on creation for the target_id field.
+	chatlog.DefaultTargetID = chatlogDescTargetID.Default.(int64)
+	// chatlogDescCreatedAt is the schema descriptor for created_at field.
+	chatlogDescCreatedAt := chatlogFields[4].Descriptor()
+	// chatlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatlog.DefaultCreatedAt = chatlogDescCreatedAt.Default.(func() time.Time)
+	chatmuteFields := schema.ChatMute{}.Fields()
+	_ = chatmuteFields
+	// chatmuteDescReason is the schema descriptor for reason field.
+	chatmuteDescReason := chatmuteFields[2].Descriptor()
+	// chatmute.DefaultReason holds the default value on creation for the reason field.
+	chatmute.DefaultReason = chatmuteDescReason.Default.(string)
+	// chatmuteDescOperator is the schema descriptor for operator field.
+	chatmuteDescOperator := chatmuteFields[3].Descriptor()
+	// chatmute.DefaultOperator holds the default value on creation for the operator field.
+	chatmute.DefaultOperator = chatmuteDescOperator.Default.(string)
+	// chatmuteDescCreatedAt is the schema descriptor for created_at field.
+	chatmuteDescCreatedAt := chatmuteFields[4].Descriptor()
+	// chatmute.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatmute.DefaultCreatedAt = chatmuteDescCreatedAt.Default.(func() time.Time)
+	// chatmuteDescUpdatedAt is the schema descriptor for updated_at field.
+	chatmuteDescUpdatedAt := chatmuteFields[5].Descriptor()
+	// chatmute.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chatmute.DefaultUpdatedAt = chatmuteDescUpdatedAt.Default.(func() time.Time)
+	// chatmute.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chatmute.UpdateDefaultUpdatedAt = chatmuteDescUpdatedAt.UpdateDefault.(func() time.Time)
 	configentryFields := schema.ConfigEntry{}.Fields()
 	_ = configentryFields
 	// configentryDescVersion is the schema descriptor for version field.
@@ -139,6 +178,20 @@ func init() {
 	item.DefaultUpdatedAt = itemDescUpdatedAt.Default.(func() time.Time)
 	// item.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	item.UpdateDefaultUpdatedAt = itemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	mentorshipFields := schema.Mentorship{}.Fields()
+	_ = mentorshipFields
+	// mentorshipDescExpShared is the schema descriptor for exp_shared field.
+	mentorshipDescExpShared := mentorshipFields[2].Descriptor()
+	// mentorship.DefaultExpShared holds the default value on creation for the exp_shared field.
+	mentorship.DefaultExpShared = mentorshipDescExpShared.Default.(int64)
+	// mentorshipDescExpTotal is the schema descriptor for exp_total field.
+	mentorshipDescExpTotal := mentorshipFields[3].Descriptor()
+	// mentorship.DefaultExpTotal holds the default value on creation for the exp_total field.
+	mentorship.DefaultExpTotal = mentorshipDescExpTotal.Default.(int64)
+	// mentorshipDescCreatedAt is the schema descriptor for created_at field.
+	mentorshipDescCreatedAt := mentorshipFields[4].Descriptor()
+	// mentorship.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mentorship.DefaultCreatedAt = mentorshipDescCreatedAt.Default.(func() time.Time)
 	permissionFields := schema.Permission{}.Fields()
 	_ = permissionFields
 	// permissionDescName is the schema descriptor for name field.
@@ -183,12 +236,44 @@ func init() {
 	petDescSkills := petFields[8].Descriptor()
 	// pet.DefaultSkills holds the default value on creation for the skills field.
 	pet.DefaultSkills = petDescSkills.Default.(string)
+	// petDescLearnedSkills is the schema descriptor for learned_skills field.
+	petDescLearnedSkills := petFields[9].Descriptor()
+	// pet.DefaultLearnedSkills holds the default value on creation for the learned_skills field.
+	pet.DefaultLearnedSkills = petDescLearnedSkills.Default.(string)
+	// petDescEv is the schema descriptor for ev field.
+	petDescEv := petFields[10].Descriptor()
+	// pet.DefaultEv holds the default value on creation for the ev field.
+	pet.DefaultEv = petDescEv.Default.(string)
+	// petDescSkillPp is the schema descriptor for skill_pp field.
+	petDescSkillPp := petFields[11].Descriptor()
+	// pet.DefaultSkillPp holds the default value on creation for the skill_pp field.
+	pet.DefaultSkillPp = petDescSkillPp.Default.(string)
+	// petDescStatus is the schema descriptor for status field.
+	petDescStatus := petFields[12].Descriptor()
+	// pet.DefaultStatus holds the default value on creation for the status field.
+	pet.DefaultStatus = petDescStatus.Default.(string)
+	// petDescFainted is the schema descriptor for fainted field.
+	petDescFainted := petFields[13].Descriptor()
+	// pet.DefaultFainted holds the default value on creation for the fainted field.
+	pet.DefaultFainted = petDescFainted.Default.(bool)
+	// petDescCollected is the schema descriptor for collected field.
+	petDescCollected := petFields[14].Descriptor()
+	// pet.DefaultCollected holds the default value on creation for the collected field.
+	pet.DefaultCollected = petDescCollected.Default.(bool)
+	// petDescRoomShow is the schema descriptor for room_show field.
+	petDescRoomShow := petFields[15].Descriptor()
+	// pet.DefaultRoomShow holds the default value on creation for the room_show field.
+	pet.DefaultRoomShow = petDescRoomShow.Default.(bool)
+	// petDescLocation is the schema descriptor for location field.
+	petDescLocation := petFields[16].Descriptor()
+	// pet.DefaultLocation holds the default value on creation for the location field.
+	pet.DefaultLocation = petDescLocation.Default.(int)
 	// petDescCreatedAt is the schema descriptor for created_at field.
-	petDescCreatedAt := petFields[9].Descriptor()
+	petDescCreatedAt := petFields[17].Descriptor()
 	// pet.DefaultCreatedAt holds the default value on creation for the created_at field.
 	pet.DefaultCreatedAt = petDescCreatedAt.Default.(func() time.Time)
 	// petDescUpdatedAt is the schema descriptor for updated_at field.
-	petDescUpdatedAt := petFields[10].Descriptor()
+	petDescUpdatedAt := petFields[18].Descriptor()
 	// pet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	pet.DefaultUpdatedAt = petDescUpdatedAt.Default.(func() time.Time)
 	// pet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -303,40 +388,96 @@ func init() {
 	playerDescRoomID := playerFields[27].Descriptor()
 	// player.DefaultRoomID holds the default value on creation for the room_id field.
 	player.DefaultRoomID = playerDescRoomID.Default.(int64)
+	// playerDescRoomPrivacy is the schema descriptor for room_privacy field.
+	playerDescRoomPrivacy := playerFields[28].Descriptor()
+	// player.DefaultRoomPrivacy holds the default value on creation for the room_privacy field.
+	player.DefaultRoomPrivacy = playerDescRoomPrivacy.Default.(int64)
 	// playerDescFitments is the schema descriptor for fitments field.
-	playerDescFitments := playerFields[28].Descriptor()
+	playerDescFitments := playerFields[29].Descriptor()
 	// player.DefaultFitments holds the default value on creation for the fitments field.
 	player.DefaultFitments = playerDescFitments.Default.(string)
 	// playerDescNonoInfo is the schema descriptor for nono_info field.
-	playerDescNonoInfo := playerFields[29].Descriptor()
+	playerDescNonoInfo := playerFields[30].Descriptor()
 	// player.DefaultNonoInfo holds the default value on creation for the nono_info field.
 	player.DefaultNonoInfo = playerDescNonoInfo.Default.(string)
 	// playerDescMailbox is the schema descriptor for mailbox field.
-	playerDescMailbox := playerFields[30].Descriptor()
+	playerDescMailbox := playerFields[31].Descriptor()
 	// player.DefaultMailbox holds the default value on creation for the mailbox field.
 	player.DefaultMailbox = playerDescMailbox.Default.(string)
+	// playerDescBossClears is the schema descriptor for boss_clears field.
+	playerDescBossClears := playerFields[32].Descriptor()
+	// player.DefaultBossClears holds the default value on creation for the boss_clears field.
+	player.DefaultBossClears = playerDescBossClears.Default.(string)
+	// playerDescExpPool is the schema descriptor for exp_pool field.
+	playerDescExpPool := playerFields[33].Descriptor()
+	// player.DefaultExpPool holds the default value on creation for the exp_pool field.
+	player.DefaultExpPool = playerDescExpPool.Default.(int64)
+	// playerDescGraduationCount is the schema descriptor for graduation_count field.
+	playerDescGraduationCount := playerFields[34].Descriptor()
+	// player.DefaultGraduationCount holds the default value on creation for the graduation_count field.
+	player.DefaultGraduationCount = playerDescGraduationCount.Default.(int64)
+	// playerDescIncubator is the schema descriptor for incubator field.
+	playerDescIncubator := playerFields[35].Descriptor()
+	// player.DefaultIncubator holds the default value on creation for the incubator field.
+	player.DefaultIncubator = playerDescIncubator.Default.(string)
+	// playerDescSoulBeads is the schema descriptor for soul_beads field.
+	playerDescSoulBeads := playerFields[36].Descriptor()
+	// player.DefaultSoulBeads holds the default value on creation for the soul_beads field.
+	player.DefaultSoulBeads = playerDescSoulBeads.Default.(string)
+	// playerDescItemBuffs is the schema descriptor for item_buffs field.
+	playerDescItemBuffs := playerFields[37].Descriptor()
+	// player.DefaultItemBuffs holds the default value on creation for the item_buffs field.
+	player.DefaultItemBuffs = playerDescItemBuffs.Default.(string)
 	// playerDescCurrentPetID is the schema descriptor for current_pet_id field.
-	playerDescCurrentPetID := playerFields[31].Descriptor()
+	playerDescCurrentPetID := playerFields[38].Descriptor()
 	// player.DefaultCurrentPetID holds the default value on creation for the current_pet_id field.
 	player.DefaultCurrentPetID = playerDescCurrentPetID.Default.(int64)
 	// playerDescCurrentPetCatchTime is the schema descriptor for current_pet_catch_time field.
-	playerDescCurrentPetCatchTime := playerFields[32].Descriptor()
+	playerDescCurrentPetCatchTime := playerFields[39].Descriptor()
 	// player.DefaultCurrentPetCatchTime holds the default value on creation for the current_pet_catch_time field.
 	player.DefaultCurrentPetCatchTime = playerDescCurrentPetCatchTime.Default.(int64)
 	// playerDescCurrentPetDv is the schema descriptor for current_pet_dv field.
-	playerDescCurrentPetDv := playerFields[33].Descriptor()
+	playerDescCurrentPetDv := playerFields[40].Descriptor()
 	// player.DefaultCurrentPetDv holds the default value on creation for the current_pet_dv field.
 	player.DefaultCurrentPetDv = playerDescCurrentPetDv.Default.(int64)
 	// playerDescCreatedAt is the schema descriptor for created_at field.
-	playerDescCreatedAt := playerFields[35].Descriptor()
+	playerDescCreatedAt := playerFields[42].Descriptor()
 	// player.DefaultCreatedAt holds the default value on creation for the created_at field.
 	player.DefaultCreatedAt = playerDescCreatedAt.Default.(func() time.Time)
 	// playerDescUpdatedAt is the schema descriptor for updated_at field.
-	playerDescUpdatedAt := playerFields[36].Descriptor()
+	playerDescUpdatedAt := playerFields[43].Descriptor()
 	// player.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	player.DefaultUpdatedAt = playerDescUpdatedAt.Default.(func() time.Time)
 	// player.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	player.UpdateDefaultUpdatedAt = playerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	pvpratingFields := schema.PvpRating{}.Fields()
+	_ = pvpratingFields
+	// pvpratingDescNick is the schema descriptor for nick field.
+	pvpratingDescNick := pvpratingFields[2].Descriptor()
+	// pvprating.DefaultNick holds the default value on creation for the nick field.
+	pvprating.DefaultNick = pvpratingDescNick.Default.(string)
+	// pvpratingDescRating is the schema descriptor for rating field.
+	pvpratingDescRating := pvpratingFields[3].Descriptor()
+	// pvprating.DefaultRating holds the default value on creation for the rating field.
+	pvprating.DefaultRating = pvpratingDescRating.Default.(int)
+	// pvpratingDescWins is the schema descriptor for wins field.
+	pvpratingDescWins := pvpratingFields[4].Descriptor()
+	// pvprating.DefaultWins holds the default value on creation for the wins field.
+	pvprating.DefaultWins = pvpratingDescWins.Default.(int)
+	// pvpratingDescLosses is the schema descriptor for losses field.
+	pvpratingDescLosses := pvpratingFields[5].Descriptor()
+	// pvprating.DefaultLosses holds the default value on creation for the losses field.
+	pvprating.DefaultLosses = pvpratingDescLosses.Default.(int)
+	// pvpratingDescDraws is the schema descriptor for draws field.
+	pvpratingDescDraws := pvpratingFields[6].Descriptor()
+	// pvprating.DefaultDraws holds the default value on creation for the draws field.
+	pvprating.DefaultDraws = pvpratingDescDraws.Default.(int)
+	// pvpratingDescUpdatedAt is the schema descriptor for updated_at field.
+	pvpratingDescUpdatedAt := pvpratingFields[7].Descriptor()
+	// pvprating.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pvprating.DefaultUpdatedAt = pvpratingDescUpdatedAt.Default.(func() time.Time)
+	// pvprating.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pvprating.UpdateDefaultUpdatedAt = pvpratingDescUpdatedAt.UpdateDefault.(func() time.Time)
 	roleFields := schema.Role{}.Fields()
 	_ = roleFields
 	// roleDescDescription is the schema descriptor for description field.
@@ -347,4 +488,168 @@ func init() {
 	roleDescCreatedAt := roleFields[2].Descriptor()
 	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
 	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	teamFields := schema.Team{}.Fields()
+	_ = teamFields
+	// teamDescName is the schema descriptor for name field.
+	teamDescName := teamFields[0].Descriptor()
+	// team.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	team.NameValidator = teamDescName.Validators[0].(func(string) error)
+	// teamDescNameKey is the schema descriptor for name_key field.
+	teamDescNameKey := teamFields[1].Descriptor()
+	// team.NameKeyValidator is a validator for the "name_key" field. It is called by the builders before save.
+	team.NameKeyValidator = teamDescNameKey.Validators[0].(func(string) error)
+	// teamDescInterest is the schema descriptor for interest field.
+	teamDescInterest := teamFields[4].Descriptor()
+	// team.DefaultInterest holds the default value on creation for the interest field.
+	team.DefaultInterest = teamDescInterest.Default.(int)
+	// teamDescJoinFlag is the schema descriptor for join_flag field.
+	teamDescJoinFlag := teamFields[5].Descriptor()
+	// team.DefaultJoinFlag holds the default value on creation for the join_flag field.
+	team.DefaultJoinFlag = teamDescJoinFlag.Default.(int)
+	// teamDescExp is the schema descriptor for exp field.
+	teamDescExp := teamFields[6].Descriptor()
+	// team.DefaultExp holds the default value on creation for the exp field.
+	team.DefaultExp = teamDescExp.Default.(int64)
+	// teamDescScore is the schema descriptor for score field.
+	teamDescScore := teamFields[7].Descriptor()
+	// team.DefaultScore holds the default value on creation for the score field.
+	team.DefaultScore = teamDescScore.Default.(int64)
+	// teamDescSlogan is the schema descriptor for slogan field.
+	teamDescSlogan := teamFields[8].Descriptor()
+	// team.DefaultSlogan holds the default value on creation for the slogan field.
+	team.DefaultSlogan = teamDescSlogan.Default.(string)
+	// teamDescNotice is the schema descriptor for notice field.
+	teamDescNotice := teamFields[9].Descriptor()
+	// team.DefaultNotice holds the default value on creation for the notice field.
+	team.DefaultNotice = teamDescNotice.Default.(string)
+	// teamDescLogoBg is the schema descriptor for logo_bg field.
+	teamDescLogoBg := teamFields[10].Descriptor()
+	// team.DefaultLogoBg holds the default value on creation for the logo_bg field.
+	team.DefaultLogoBg = teamDescLogoBg.Default.(int)
+	// teamDescLogoIcon is the schema descriptor for logo_icon field.
+	teamDescLogoIcon := teamFields[11].Descriptor()
+	// team.DefaultLogoIcon holds the default value on creation for the logo_icon field.
+	team.DefaultLogoIcon = teamDescLogoIcon.Default.(int)
+	// teamDescLogoColor is the schema descriptor for logo_color field.
+	teamDescLogoColor := teamFields[12].Descriptor()
+	// team.DefaultLogoColor holds the default value on creation for the logo_color field.
+	team.DefaultLogoColor = teamDescLogoColor.Default.(int)
+	// teamDescTxtColor is the schema descriptor for txt_color field.
+	teamDescTxtColor := teamFields[13].Descriptor()
+	// team.DefaultTxtColor holds the default value on creation for the txt_color field.
+	team.DefaultTxtColor = teamDescTxtColor.Default.(int)
+	// teamDescLogoWord is the schema descriptor for logo_word field.
+	teamDescLogoWord := teamFields[14].Descriptor()
+	// team.DefaultLogoWord holds the default value on creation for the logo_word field.
+	team.DefaultLogoWord = teamDescLogoWord.Default.(string)
+	// teamDescCreatedAt is the schema descriptor for created_at field.
+	teamDescCreatedAt := teamFields[15].Descriptor()
+	// team.DefaultCreatedAt holds the default value on creation for the created_at field.
+	team.DefaultCreatedAt = teamDescCreatedAt.Default.(func() time.Time)
+	// teamDescUpdatedAt is the schema descriptor for updated_at field.
+	teamDescUpdatedAt := teamFields[16].Descriptor()
+	// team.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	team.DefaultUpdatedAt = teamDescUpdatedAt.Default.(func() time.Time)
+	// team.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	team.UpdateDefaultUpdatedAt = teamDescUpdatedAt.UpdateDefault.(func() time.Time)
+	teammemberFields := schema.TeamMember{}.Fields()
+	_ = teammemberFields
+	// teammemberDescNick is the schema descriptor for nick field.
+	teammemberDescNick := teammemberFields[2].Descriptor()
+	// teammember.DefaultNick holds the default value on creation for the nick field.
+	teammember.DefaultNick = teammemberDescNick.Default.(string)
+	// teammemberDescPriv is the schema descriptor for priv field.
+	teammemberDescPriv := teammemberFields[3].Descriptor()
+	// teammember.DefaultPriv holds the default value on creation for the priv field.
+	teammember.DefaultPriv = teammemberDescPriv.Default.(int)
+	// teammemberDescContribution is the schema descriptor for contribution field.
+	teammemberDescContribution := teammemberFields[4].Descriptor()
+	// teammember.DefaultContribution holds the default value on creation for the contribution field.
+	teammember.DefaultContribution = teammemberDescContribution.Default.(int64)
+	// teammemberDescCanExContribution is the schema descriptor for can_ex_contribution field.
+	teammemberDescCanExContribution := teammemberFields[5].Descriptor()
+	// teammember.DefaultCanExContribution holds the default value on creation for the can_ex_contribution field.
+	teammember.DefaultCanExContribution = teammemberDescCanExContribution.Default.(int64)
+	// teammemberDescIsShow is the schema descriptor for is_show field.
+	teammemberDescIsShow := teammemberFields[6].Descriptor()
+	// teammember.DefaultIsShow holds the default value on creation for the is_show field.
+	teammember.DefaultIsShow = teammemberDescIsShow.Default.(bool)
+	// teammemberDescJoinedAt is the schema descriptor for joined_at field.
+	teammemberDescJoinedAt := teammemberFields[7].Descriptor()
+	// teammember.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	teammember.DefaultJoinedAt = teammemberDescJoinedAt.Default.(func() time.Time)
+	teampkhistoryFields := schema.TeamPkHistory{}.Fields()
+	_ = teampkhistoryFields
+	// teampkhistoryDescOpponentName is the schema descriptor for opponent_name field.
+	teampkhistoryDescOpponentName := teampkhistoryFields[3].Descriptor()
+	// teampkhistory.DefaultOpponentName holds the default value on creation for the opponent_name field.
+	teampkhistory.DefaultOpponentName = teampkhistoryDescOpponentName.Default.(string)
+	// teampkhistoryDescResult is the schema descriptor for result field.
+	teampkhistoryDescResult := teampkhistoryFields[4].Descriptor()
+	// teampkhistory.DefaultResult holds the default value on creation for the result field.
+	teampkhistory.DefaultResult = teampkhistoryDescResult.Default.(int)
+	// teampkhistoryDescScore is the schema descriptor for score field.
+	teampkhistoryDescScore := teampkhistoryFields[5].Descriptor()
+	// teampkhistory.DefaultScore holds the default value on creation for the score field.
+	teampkhistory.DefaultScore = teampkhistoryDescScore.Default.(int)
+	// teampkhistoryDescBuildingHp is the schema descriptor for building_hp field.
+	teampkhistoryDescBuildingHp := teampkhistoryFields[6].Descriptor()
+	// teampkhistory.DefaultBuildingHp holds the default value on creation for the building_hp field.
+	teampkhistory.DefaultBuildingHp = teampkhistoryDescBuildingHp.Default.(int)
+	// teampkhistoryDescOpponentBuildingHp is the schema descriptor for opponent_building_hp field.
+	teampkhistoryDescOpponentBuildingHp := teampkhistoryFields[7].Descriptor()
+	// teampkhistory.DefaultOpponentBuildingHp holds the default value on creation for the opponent_building_hp field.
+	teampkhistory.DefaultOpponentBuildingHp = teampkhistoryDescOpponentBuildingHp.Default.(int)
+	// teampkhistoryDescCreatedAt is the schema descriptor for created_at field.
+	teampkhistoryDescCreatedAt := teampkhistoryFields[8].Descriptor()
+	// teampkhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teampkhistory.DefaultCreatedAt = teampkhistoryDescCreatedAt.Default.(func() time.Time)
+	teampkscoreFields := schema.TeamPkScore{}.Fields()
+	_ = teampkscoreFields
+	// teampkscoreDescName is the schema descriptor for name field.
+	teampkscoreDescName := teampkscoreFields[2].Descriptor()
+	// teampkscore.DefaultName holds the default value on creation for the name field.
+	teampkscore.DefaultName = teampkscoreDescName.Default.(string)
+	// teampkscoreDescScore is the schema descriptor for score field.
+	teampkscoreDescScore := teampkscoreFields[3].Descriptor()
+	// teampkscore.DefaultScore holds the default value on creation for the score field.
+	teampkscore.DefaultScore = teampkscoreDescScore.Default.(int)
+	// teampkscoreDescWins is the schema descriptor for wins field.
+	teampkscoreDescWins := teampkscoreFields[4].Descriptor()
+	// teampkscore.DefaultWins holds the default value on creation for the wins field.
+	teampkscore.DefaultWins = teampkscoreDescWins.Default.(int)
+	// teampkscoreDescLosses is the schema descriptor for losses field.
+	teampkscoreDescLosses := teampkscoreFields[5].Descriptor()
+	// teampkscore.DefaultLosses holds the default value on creation for the losses field.
+	teampkscore.DefaultLosses = teampkscoreDescLosses.Default.(int)
+	// teampkscoreDescDraws is the schema descriptor for draws field.
+	teampkscoreDescDraws := teampkscoreFields[6].Descriptor()
+	// teampkscore.DefaultDraws holds the default value on creation for the draws field.
+	teampkscore.DefaultDraws = teampkscoreDescDraws.Default.(int)
+	// teampkscoreDescUpdatedAt is the schema descriptor for updated_at field.
+	teampkscoreDescUpdatedAt := teampkscoreFields[7].Descriptor()
+	// teampkscore.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teampkscore.DefaultUpdatedAt = teampkscoreDescUpdatedAt.Default.(func() time.Time)
+	// teampkscore.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teampkscore.UpdateDefaultUpdatedAt = teampkscoreDescUpdatedAt.UpdateDefault.(func() time.Time)
+	teampkseerscoreFields := schema.TeamPkSeerScore{}.Fields()
+	_ = teampkseerscoreFields
+	// teampkseerscoreDescTeamID is the schema descriptor for team_id field.
+	teampkseerscoreDescTeamID := teampkseerscoreFields[2].Descriptor()
+	// teampkseerscore.DefaultTeamID holds the default value on creation for the team_id field.
+	teampkseerscore.DefaultTeamID = teampkseerscoreDescTeamID.Default.(int64)
+	// teampkseerscoreDescNick is the schema descriptor for nick field.
+	teampkseerscoreDescNick := teampkseerscoreFields[3].Descriptor()
+	// teampkseerscore.DefaultNick holds the default value on creation for the nick field.
+	teampkseerscore.DefaultNick = teampkseerscoreDescNick.Default.(string)
+	// teampkseerscoreDescScore is the schema descriptor for score field.
+	teampkseerscoreDescScore := teampkseerscoreFields[4].Descriptor()
+	// teampkseerscore.DefaultScore holds the default value on creation for the score field.
+	teampkseerscore.DefaultScore = teampkseerscoreDescScore.Default.(int)
+	// teampkseerscoreDescUpdatedAt is the schema descriptor for updated_at field.
+	teampkseerscoreDescUpdatedAt := teampkseerscoreFields[5].Descriptor()
+	// teampkseerscore.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teampkseerscore.DefaultUpdatedAt = teampkseerscoreDescUpdatedAt.Default.(func() time.Time)
+	// teampkseerscore.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teampkseerscore.UpdateDefaultUpdatedAt = teampkseerscoreDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ChatLog keeps delivered chat lines for abuse reports. TargetID is the
+// whisper target, team ID or 0 depending on the channel.
+type ChatLog struct {
+	ent.Schema
+}
+
+func (ChatLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.Int64("target_id").Default(0),
+		field.String("channel"),
+		field.String("message"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (ChatLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
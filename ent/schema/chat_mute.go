@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ChatMute silences a player's chat and mail until the given time. Lifting
+// a mute ends it in place; game servers sync the rows changed since their
+// last poll by updated_at.
+type ChatMute struct {
+	ent.Schema
+}
+
+func (ChatMute) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id").Unique(),
+		field.Time("until"),
+		field.String("reason").Default(""),
+		field.String("operator").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (ChatMute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("updated_at"),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Mentorship links a student to their teacher. A student has at most one
+// teacher. ExpShared is the student's battle exp share not yet claimed by
+// the teacher; ExpTotal is everything shared so far.
+type Mentorship struct {
+	ent.Schema
+}
+
+func (Mentorship) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("teacher_id"),
+		field.Int64("student_id").Unique(),
+		field.Int64("exp_shared").Default(0),
+		field.Int64("exp_total").Default(0),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Mentorship) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("teacher_id"),
+	}
+}
@@ -24,6 +24,14 @@ func (Pet) Fields() []ent.Field {
 		field.Int("dv").Default(31),
 		field.String("nature").Default("normal"),
 		field.String("skills").Default(""),
+		field.String("learned_skills").Default("[]"),
+		field.String("ev").Default("{}"),
+		field.String("skill_pp").Default("{}"),
+		field.String("status").Default("{}"),
+		field.Bool("fainted").Default(false),
+		field.Bool("collected").Default(false),
+		field.Bool("room_show").Default(false),
+		field.Int("location").Default(0),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -43,9 +43,16 @@ func (Player) Fields() []ent.Field {
 		field.String("team_info").Default("{}"),
 		field.String("student_ids").Default("[]"),
 		field.Int64("room_id").Default(0),
+		field.Int64("room_privacy").Default(0),
 		field.String("fitments").Default("[]"),
 		field.String("nono_info").Default("{}"),
 		field.String("mailbox").Default("[]"),
+		field.String("boss_clears").Default("[]"),
+		field.Int64("exp_pool").Default(0),
+		field.Int64("graduation_count").Default(0),
+		field.String("incubator").Default("{}"),
+		field.String("soul_beads").Default("{}"),
+		field.String("item_buffs").Default("{}"),
 		field.Int64("current_pet_id").Default(0),
 		field.Int64("current_pet_catch_time").Default(0),
 		field.Int64("current_pet_dv").Default(31),
@@ -60,5 +67,6 @@ func (Player) Edges() []ent.Edge {
 		edge.From("account", Account.Type).Ref("players").Field("account_id").Unique().Required(),
 		edge.To("pets", Pet.Type),
 		edge.To("items", Item.Type),
+		edge.To("pvp_ratings", PvpRating.Type),
 	}
 }
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PvpRating holds a player's matchmaking rating for one season.
+type PvpRating struct {
+	ent.Schema
+}
+
+func (PvpRating) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("player_id"),
+		field.Int("season"),
+		field.String("nick").Default(""),
+		field.Int("rating").Default(1500),
+		field.Int("wins").Default(0),
+		field.Int("losses").Default(0),
+		field.Int("draws").Default(0),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (PvpRating) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).Ref("pvp_ratings").Field("player_id").Unique().Required(),
+	}
+}
+
+func (PvpRating) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("player_id", "season").Unique(),
+		index.Fields("season", "rating"),
+	}
+}
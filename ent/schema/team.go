@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Team holds a player team (guild). Team names are unique ignoring case;
+// name_key holds the lower-cased name for the unique index. legacy_id is
+// the TeamInfo ID players saved before teams had rows, set only on teams
+// imported from it.
+type Team struct {
+	ent.Schema
+}
+
+func (Team) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("name_key").NotEmpty().Unique(),
+		field.Int64("legacy_id").Optional().Nillable().Unique(),
+		field.Int64("leader_id"),
+		field.Int("interest").Default(0),
+		field.Int("join_flag").Default(0),
+		field.Int64("exp").Default(0),
+		field.Int64("score").Default(0),
+		field.String("slogan").Default(""),
+		field.String("notice").Default(""),
+		field.Int("logo_bg").Default(1),
+		field.Int("logo_icon").Default(1),
+		field.Int("logo_color").Default(0xFFFF),
+		field.Int("txt_color").Default(0xFFFF),
+		field.String("logo_word").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Team) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", TeamMember.Type),
+	}
+}
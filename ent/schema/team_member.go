@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TeamMember holds one user's membership and rank in a team. A user belongs
+// to at most one team.
+type TeamMember struct {
+	ent.Schema
+}
+
+func (TeamMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("team_id"),
+		field.Int64("user_id").Unique(),
+		field.String("nick").Default(""),
+		field.Int("priv").Default(0),
+		field.Int64("contribution").Default(0),
+		field.Int64("can_ex_contribution").Default(0),
+		field.Bool("is_show").Default(true),
+		field.Time("joined_at").Default(time.Now),
+	}
+}
+
+func (TeamMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).Ref("members").Field("team_id").Unique().Required(),
+	}
+}
+
+func (TeamMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "priv"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TeamPkHistory is one finished team-PK battle seen from one team's side.
+type TeamPkHistory struct {
+	ent.Schema
+}
+
+func (TeamPkHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("team_id"),
+		field.Int("season"),
+		field.Int64("opponent_id"),
+		field.String("opponent_name").Default(""),
+		field.Int("result").Default(0),
+		field.Int("score").Default(0),
+		field.Int("building_hp").Default(0),
+		field.Int("opponent_building_hp").Default(0),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (TeamPkHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "created_at"),
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TeamPkScore holds a team's team-PK record for one season. It outlives the
+// team so past season charts stay intact.
+type TeamPkScore struct {
+	ent.Schema
+}
+
+func (TeamPkScore) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("team_id"),
+		field.Int("season"),
+		field.String("name").Default(""),
+		field.Int("score").Default(0),
+		field.Int("wins").Default(0),
+		field.Int("losses").Default(0),
+		field.Int("draws").Default(0),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (TeamPkScore) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "season").Unique(),
+		index.Fields("season", "score"),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TeamPkSeerScore holds one player's team-PK score for one season.
+type TeamPkSeerScore struct {
+	ent.Schema
+}
+
+func (TeamPkSeerScore) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.Int("season"),
+		field.Int64("team_id").Default(0),
+		field.String("nick").Default(""),
+		field.Int("score").Default(0),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (TeamPkSeerScore) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "season").Unique(),
+		index.Fields("season", "score"),
+	}
+}
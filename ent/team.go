@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jseer/ent/team"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Team is the model entity for the Team schema.
+type Team struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// NameKey holds the value of the "name_key" field.
+	NameKey string `json:"name_key,omitempty"`
+	// LegacyID holds the value of the "legacy_id" field.
+	LegacyID *int64 `json:"legacy_id,omitempty"`
+	// LeaderID holds the value of the "leader_id" field.
+	LeaderID int64 `json:"leader_id,omitempty"`
+	// Interest holds the value of the "interest" field.
+	Interest int `json:"interest,omitempty"`
+	// JoinFlag holds the value of the "join_flag" field.
+	JoinFlag int `json:"join_flag,omitempty"`
+	// Exp holds the value of the "exp" field.
+	Exp int64 `json:"exp,omitempty"`
+	// Score holds the value of the "score" field.
+	Score int64 `json:"score,omitempty"`
+	// Slogan holds the value of the "slogan" field.
+	Slogan string `json:"slogan,omitempty"`
+	// Notice holds the value of the "notice" field.
+	Notice string `json:"notice,omitempty"`
+	// LogoBg holds the value of the "logo_bg" field.
+	LogoBg int `json:"logo_bg,omitempty"`
+	// LogoIcon holds the value of the "logo_icon" field.
+	LogoIcon int `json:"logo_icon,omitempty"`
+	// LogoColor holds the value of the "logo_color" field.
+	LogoColor int `json:"logo_color,omitempty"`
+	// TxtColor holds the value of the "txt_color" field.
+	TxtColor int `json:"txt_color,omitempty"`
+	// LogoWord holds the value of the "logo_word" field.
+	LogoWord string `json:"logo_word,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamQuery when eager-loading is set.
+	Edges        TeamEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TeamEdges holds the relations/edges for other nodes in the graph.
+type TeamEdges struct {
+	// Members holds the value of the members edge.
+	Members []*TeamMember `json:"members,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) MembersOrErr() ([]*TeamMember, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Team) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case team.FieldID, team.FieldLegacyID, team.FieldLeaderID, team.FieldInterest, team.FieldJoinFlag, team.FieldExp, team.FieldScore, team.FieldLogoBg, team.FieldLogoIcon, team.FieldLogoColor, team.FieldTxtColor:
+			values[i] = new(sql.NullInt64)
+		case team.FieldName, team.FieldNameKey, team.FieldSlogan, team.FieldNotice, team.FieldLogoWord:
+			values[i] = new(sql.NullString)
+		case team.FieldCreatedAt, team.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Team fields.
+func (_m *Team) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case team.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case team.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case team.FieldNameKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_key", values[i])
+			} else if value.Valid {
+				_m.NameKey = value.String
+			}
+		case team.FieldLegacyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field legacy_id", values[i])
+			} else if value.Valid {
+				_m.LegacyID = new(int64)
+				*_m.LegacyID = value.Int64
+			}
+		case team.FieldLeaderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leader_id", values[i])
+			} else if value.Valid {
+				_m.LeaderID = value.Int64
+			}
+		case team.FieldInterest:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interest", values[i])
+			} else if value.Valid {
+				_m.Interest = int(value.Int64)
+			}
+		case team.FieldJoinFlag:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field join_flag", values[i])
+			} else if value.Valid {
+				_m.JoinFlag = int(value.Int64)
+			}
+		case team.FieldExp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exp", values[i])
+			} else if value.Valid {
+				_m.Exp = value.Int64
+			}
+		case team.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Int64
+			}
+		case team.FieldSlogan:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slogan", values[i])
+			} else if value.Valid {
+				_m.Slogan = value.String
+			}
+		case team.FieldNotice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notice", values[i])
+			} else if value.Valid {
+				_m.Notice = value.String
+			}
+		case team.FieldLogoBg:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_bg", values[i])
+			} else if value.Valid {
+				_m.LogoBg = int(value.Int64)
+			}
+		case team.FieldLogoIcon:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_icon", values[i])
+			} else if value.Valid {
+				_m.LogoIcon = int(value.Int64)
+			}
+		case team.FieldLogoColor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_color", values[i])
+			} else if value.Valid {
+				_m.LogoColor = int(value.Int64)
+			}
+		case team.FieldTxtColor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field txt_color", values[i])
+			} else if value.Valid {
+				_m.TxtColor = int(value.Int64)
+			}
+		case team.FieldLogoWord:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_word", values[i])
+			} else if value.Valid {
+				_m.LogoWord = value.String
+			}
+		case team.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case team.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Team.
+// This includes values selected through modifiers, order, etc.
+func (_m *Team) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Team entity.
+func (_m *Team) QueryMembers() *TeamMemberQuery {
+	return NewTeamClient(_m.config).QueryMembers(_m)
+}
+
+// Update returns a builder for updating this Team.
+// Note that you need to call Team.Unwrap() before calling this method if this Team
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Team) Update() *TeamUpdateOne {
+	return NewTeamClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Team entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Team) Unwrap() *Team {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Team is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Team) String() string {
+	var builder strings.Builder
+	builder.WriteString("Team(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("name_key=")
+	builder.WriteString(_m.NameKey)
+	builder.WriteString(", ")
+	if v := _m.LegacyID; v != nil {
+		builder.WriteString("legacy_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("leader_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeaderID))
+	builder.WriteString(", ")
+	builder.WriteString("interest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Interest))
+	builder.WriteString(", ")
+	builder.WriteString("join_flag=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JoinFlag))
+	builder.WriteString(", ")
+	builder.WriteString("exp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Exp))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("slogan=")
+	builder.WriteString(_m.Slogan)
+	builder.WriteString(", ")
+	builder.WriteString("notice=")
+	builder.WriteString(_m.Notice)
+	builder.WriteString(", ")
+	builder.WriteString("logo_bg=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogoBg))
+	builder.WriteString(", ")
+	builder.WriteString("logo_icon=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogoIcon))
+	builder.WriteString(", ")
+	builder.WriteString("logo_color=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogoColor))
+	builder.WriteString(", ")
+	builder.WriteString("txt_color=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TxtColor))
+	builder.WriteString(", ")
+	builder.WriteString("logo_word=")
+	builder.WriteString(_m.LogoWord)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Teams is a parsable slice of Team.
+type Teams []*Team
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package team
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the team type in the database.
+	Label = "team"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldNameKey holds the string denoting the name_key field in the database.
+	FieldNameKey = "name_key"
+	// FieldLegacyID holds the string denoting the legacy_id field in the database.
+	FieldLegacyID = "legacy_id"
+	// FieldLeaderID holds the string denoting the leader_id field in the database.
+	FieldLeaderID = "leader_id"
+	// FieldInterest holds the string denoting the interest field in the database.
+	FieldInterest = "interest"
+	// FieldJoinFlag holds the string denoting the join_flag field in the database.
+	FieldJoinFlag = "join_flag"
+	// FieldExp holds the string denoting the exp field in the database.
+	FieldExp = "exp"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldSlogan holds the string denoting the slogan field in the database.
+	FieldSlogan = "slogan"
+	// FieldNotice holds the string denoting the notice field in the database.
+	FieldNotice = "notice"
+	// FieldLogoBg holds the string denoting the logo_bg field in the database.
+	FieldLogoBg = "logo_bg"
+	// FieldLogoIcon holds the string denoting the logo_icon field in the database.
+	FieldLogoIcon = "logo_icon"
+	// FieldLogoColor holds the string denoting the logo_color field in the database.
+	FieldLogoColor = "logo_color"
+	// FieldTxtColor holds the string denoting the txt_color field in the database.
+	FieldTxtColor = "txt_color"
+	// FieldLogoWord holds the string denoting the logo_word field in the database.
+	FieldLogoWord = "logo_word"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// Table holds the table name of the team in the database.
+	Table = "teams"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "team_members"
+	// MembersInverseTable is the table name for the TeamMember entity.
+	// It exists in this package in order to avoid circular dependency with the "teammember" package.
+	MembersInverseTable = "team_members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "team_id"
+)
+
+// Columns holds all SQL columns for team fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldNameKey,
+	FieldLegacyID,
+	FieldLeaderID,
+	FieldInterest,
+	FieldJoinFlag,
+	FieldExp,
+	FieldScore,
+	FieldSlogan,
+	FieldNotice,
+	FieldLogoBg,
+	FieldLogoIcon,
+	FieldLogoColor,
+	FieldTxtColor,
+	FieldLogoWord,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// NameKeyValidator is a validator for the "name_key" field. It is called by the builders before save.
+	NameKeyValidator func(string) error
+	// DefaultInterest holds the default value on creation for the "interest" field.
+	DefaultInterest int
+	// DefaultJoinFlag holds the default value on creation for the "join_flag" field.
+	DefaultJoinFlag int
+	// DefaultExp holds the default value on creation for the "exp" field.
+	DefaultExp int64
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int64
+	// DefaultSlogan holds the default value on creation for the "slogan" field.
+	DefaultSlogan string
+	// DefaultNotice holds the default value on creation for the "notice" field.
+	DefaultNotice string
+	// DefaultLogoBg holds the default value on creation for the "logo_bg" field.
+	DefaultLogoBg int
+	// DefaultLogoIcon holds the default value on creation for the "logo_icon" field.
+	DefaultLogoIcon int
+	// DefaultLogoColor holds the default value on creation for the "logo_color" field.
+	DefaultLogoColor int
+	// DefaultTxtColor holds the default value on creation for the "txt_color" field.
+	DefaultTxtColor int
+	// DefaultLogoWord holds the default value on creation for the "logo_word" field.
+	DefaultLogoWord string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Team queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByNameKey orders the results by the name_key field.
+func ByNameKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNameKey, opts...).ToFunc()
+}
+
+// ByLegacyID orders the results by the legacy_id field.
+func ByLegacyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegacyID, opts...).ToFunc()
+}
+
+// ByLeaderID orders the results by the leader_id field.
+func ByLeaderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaderID, opts...).ToFunc()
+}
+
+// ByInterest orders the results by the interest field.
+func ByInterest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterest, opts...).ToFunc()
+}
+
+// ByJoinFlag orders the results by the join_flag field.
+func ByJoinFlag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinFlag, opts...).ToFunc()
+}
+
+// ByExp orders the results by the exp field.
+func ByExp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExp, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// BySlogan orders the results by the slogan field.
+func BySlogan(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlogan, opts...).ToFunc()
+}
+
+// ByNotice orders the results by the notice field.
+func ByNotice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotice, opts...).ToFunc()
+}
+
+// ByLogoBg orders the results by the logo_bg field.
+func ByLogoBg(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoBg, opts...).ToFunc()
+}
+
+// ByLogoIcon orders the results by the logo_icon field.
+func ByLogoIcon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoIcon, opts...).ToFunc()
+}
+
+// ByLogoColor orders the results by the logo_color field.
+func ByLogoColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoColor, opts...).ToFunc()
+}
+
+// ByTxtColor orders the results by the txt_color field.
+func ByTxtColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxtColor, opts...).ToFunc()
+}
+
+// ByLogoWord orders the results by the logo_word field.
+func ByLogoWord(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoWord, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
@@ -0,0 +1,994 @@
+// Code generated by ent, DO NOT EDIT.
+
+package team
+
+import (
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldName, v))
+}
+
+// NameKey applies equality check predicate on the "name_key" field. It's identical to NameKeyEQ.
+func NameKey(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldNameKey, v))
+}
+
+// LegacyID applies equality check predicate on the "legacy_id" field. It's identical to LegacyIDEQ.
+func LegacyID(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLegacyID, v))
+}
+
+// LeaderID applies equality check predicate on the "leader_id" field. It's identical to LeaderIDEQ.
+func LeaderID(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLeaderID, v))
+}
+
+// Interest applies equality check predicate on the "interest" field. It's identical to InterestEQ.
+func Interest(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldInterest, v))
+}
+
+// JoinFlag applies equality check predicate on the "join_flag" field. It's identical to JoinFlagEQ.
+func JoinFlag(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldJoinFlag, v))
+}
+
+// Exp applies equality check predicate on the "exp" field. It's identical to ExpEQ.
+func Exp(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldExp, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldScore, v))
+}
+
+// Slogan applies equality check predicate on the "slogan" field. It's identical to SloganEQ.
+func Slogan(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldSlogan, v))
+}
+
+// Notice applies equality check predicate on the "notice" field. It's identical to NoticeEQ.
+func Notice(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldNotice, v))
+}
+
+// LogoBg applies equality check predicate on the "logo_bg" field. It's identical to LogoBgEQ.
+func LogoBg(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoBg, v))
+}
+
+// LogoIcon applies equality check predicate on the "logo_icon" field. It's identical to LogoIconEQ.
+func LogoIcon(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoIcon, v))
+}
+
+// LogoColor applies equality check predicate on the "logo_color" field. It's identical to LogoColorEQ.
+func LogoColor(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoColor, v))
+}
+
+// TxtColor applies equality check predicate on the "txt_color" field. It's identical to TxtColorEQ.
+func TxtColor(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldTxtColor, v))
+}
+
+// LogoWord applies equality check predicate on the "logo_word" field. It's identical to LogoWordEQ.
+func LogoWord(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoWord, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Team {
+	return predicate.Team(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldContainsFold(FieldName, v))
+}
+
+// NameKeyEQ applies the EQ predicate on the "name_key" field.
+func NameKeyEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldNameKey, v))
+}
+
+// NameKeyNEQ applies the NEQ predicate on the "name_key" field.
+func NameKeyNEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldNameKey, v))
+}
+
+// NameKeyIn applies the In predicate on the "name_key" field.
+func NameKeyIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldNameKey, vs...))
+}
+
+// NameKeyNotIn applies the NotIn predicate on the "name_key" field.
+func NameKeyNotIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldNameKey, vs...))
+}
+
+// NameKeyGT applies the GT predicate on the "name_key" field.
+func NameKeyGT(v string) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldNameKey, v))
+}
+
+// NameKeyGTE applies the GTE predicate on the "name_key" field.
+func NameKeyGTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldNameKey, v))
+}
+
+// NameKeyLT applies the LT predicate on the "name_key" field.
+func NameKeyLT(v string) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldNameKey, v))
+}
+
+// NameKeyLTE applies the LTE predicate on the "name_key" field.
+func NameKeyLTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldNameKey, v))
+}
+
+// NameKeyContains applies the Contains predicate on the "name_key" field.
+func NameKeyContains(v string) predicate.Team {
+	return predicate.Team(sql.FieldContains(FieldNameKey, v))
+}
+
+// NameKeyHasPrefix applies the HasPrefix predicate on the "name_key" field.
+func NameKeyHasPrefix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasPrefix(FieldNameKey, v))
+}
+
+// NameKeyHasSuffix applies the HasSuffix predicate on the "name_key" field.
+func NameKeyHasSuffix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasSuffix(FieldNameKey, v))
+}
+
+// NameKeyEqualFold applies the EqualFold predicate on the "name_key" field.
+func NameKeyEqualFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldEqualFold(FieldNameKey, v))
+}
+
+// NameKeyContainsFold applies the ContainsFold predicate on the "name_key" field.
+func NameKeyContainsFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldContainsFold(FieldNameKey, v))
+}
+
+// LegacyIDEQ applies the EQ predicate on the "legacy_id" field.
+func LegacyIDEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLegacyID, v))
+}
+
+// LegacyIDNEQ applies the NEQ predicate on the "legacy_id" field.
+func LegacyIDNEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldLegacyID, v))
+}
+
+// LegacyIDIn applies the In predicate on the "legacy_id" field.
+func LegacyIDIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldLegacyID, vs...))
+}
+
+// LegacyIDNotIn applies the NotIn predicate on the "legacy_id" field.
+func LegacyIDNotIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldLegacyID, vs...))
+}
+
+// LegacyIDGT applies the GT predicate on the "legacy_id" field.
+func LegacyIDGT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldLegacyID, v))
+}
+
+// LegacyIDGTE applies the GTE predicate on the "legacy_id" field.
+func LegacyIDGTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldLegacyID, v))
+}
+
+// LegacyIDLT applies the LT predicate on the "legacy_id" field.
+func LegacyIDLT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldLegacyID, v))
+}
+
+// LegacyIDLTE applies the LTE predicate on the "legacy_id" field.
+func LegacyIDLTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldLegacyID, v))
+}
+
+// LegacyIDIsNil applies the IsNil predicate on the "legacy_id" field.
+func LegacyIDIsNil() predicate.Team {
+	return predicate.Team(sql.FieldIsNull(FieldLegacyID))
+}
+
+// LegacyIDNotNil applies the NotNil predicate on the "legacy_id" field.
+func LegacyIDNotNil() predicate.Team {
+	return predicate.Team(sql.FieldNotNull(FieldLegacyID))
+}
+
+// LeaderIDEQ applies the EQ predicate on the "leader_id" field.
+func LeaderIDEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLeaderID, v))
+}
+
+// LeaderIDNEQ applies the NEQ predicate on the "leader_id" field.
+func LeaderIDNEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldLeaderID, v))
+}
+
+// LeaderIDIn applies the In predicate on the "leader_id" field.
+func LeaderIDIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldLeaderID, vs...))
+}
+
+// LeaderIDNotIn applies the NotIn predicate on the "leader_id" field.
+func LeaderIDNotIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldLeaderID, vs...))
+}
+
+// LeaderIDGT applies the GT predicate on the "leader_id" field.
+func LeaderIDGT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldLeaderID, v))
+}
+
+// LeaderIDGTE applies the GTE predicate on the "leader_id" field.
+func LeaderIDGTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldLeaderID, v))
+}
+
+// LeaderIDLT applies the LT predicate on the "leader_id" field.
+func LeaderIDLT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldLeaderID, v))
+}
+
+// LeaderIDLTE applies the LTE predicate on the "leader_id" field.
+func LeaderIDLTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldLeaderID, v))
+}
+
+// InterestEQ applies the EQ predicate on the "interest" field.
+func InterestEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldInterest, v))
+}
+
+// InterestNEQ applies the NEQ predicate on the "interest" field.
+func InterestNEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldInterest, v))
+}
+
+// InterestIn applies the In predicate on the "interest" field.
+func InterestIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldInterest, vs...))
+}
+
+// InterestNotIn applies the NotIn predicate on the "interest" field.
+func InterestNotIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldInterest, vs...))
+}
+
+// InterestGT applies the GT predicate on the "interest" field.
+func InterestGT(v int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldInterest, v))
+}
+
+// InterestGTE applies the GTE predicate on the "interest" field.
+func InterestGTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldInterest, v))
+}
+
+// InterestLT applies the LT predicate on the "interest" field.
+func InterestLT(v int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldInterest, v))
+}
+
+// InterestLTE applies the LTE predicate on the "interest" field.
+func InterestLTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldInterest, v))
+}
+
+// JoinFlagEQ applies the EQ predicate on the "join_flag" field.
+func JoinFlagEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldJoinFlag, v))
+}
+
+// JoinFlagNEQ applies the NEQ predicate on the "join_flag" field.
+func JoinFlagNEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldJoinFlag, v))
+}
+
+// JoinFlagIn applies the In predicate on the "join_flag" field.
+func JoinFlagIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldJoinFlag, vs...))
+}
+
+// JoinFlagNotIn applies the NotIn predicate on the "join_flag" field.
+func JoinFlagNotIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldJoinFlag, vs...))
+}
+
+// JoinFlagGT applies the GT predicate on the "join_flag" field.
+func JoinFlagGT(v int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldJoinFlag, v))
+}
+
+// JoinFlagGTE applies the GTE predicate on the "join_flag" field.
+func JoinFlagGTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldJoinFlag, v))
+}
+
+// JoinFlagLT applies the LT predicate on the "join_flag" field.
+func JoinFlagLT(v int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldJoinFlag, v))
+}
+
+// JoinFlagLTE applies the LTE predicate on the "join_flag" field.
+func JoinFlagLTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldJoinFlag, v))
+}
+
+// ExpEQ applies the EQ predicate on the "exp" field.
+func ExpEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldExp, v))
+}
+
+// ExpNEQ applies the NEQ predicate on the "exp" field.
+func ExpNEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldExp, v))
+}
+
+// ExpIn applies the In predicate on the "exp" field.
+func ExpIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldExp, vs...))
+}
+
+// ExpNotIn applies the NotIn predicate on the "exp" field.
+func ExpNotIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldExp, vs...))
+}
+
+// ExpGT applies the GT predicate on the "exp" field.
+func ExpGT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldExp, v))
+}
+
+// ExpGTE applies the GTE predicate on the "exp" field.
+func ExpGTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldExp, v))
+}
+
+// ExpLT applies the LT predicate on the "exp" field.
+func ExpLT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldExp, v))
+}
+
+// ExpLTE applies the LTE predicate on the "exp" field.
+func ExpLTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldExp, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int64) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int64) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int64) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldScore, v))
+}
+
+// SloganEQ applies the EQ predicate on the "slogan" field.
+func SloganEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldSlogan, v))
+}
+
+// SloganNEQ applies the NEQ predicate on the "slogan" field.
+func SloganNEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldSlogan, v))
+}
+
+// SloganIn applies the In predicate on the "slogan" field.
+func SloganIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldSlogan, vs...))
+}
+
+// SloganNotIn applies the NotIn predicate on the "slogan" field.
+func SloganNotIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldSlogan, vs...))
+}
+
+// SloganGT applies the GT predicate on the "slogan" field.
+func SloganGT(v string) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldSlogan, v))
+}
+
+// SloganGTE applies the GTE predicate on the "slogan" field.
+func SloganGTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldSlogan, v))
+}
+
+// SloganLT applies the LT predicate on the "slogan" field.
+func SloganLT(v string) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldSlogan, v))
+}
+
+// SloganLTE applies the LTE predicate on the "slogan" field.
+func SloganLTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldSlogan, v))
+}
+
+// SloganContains applies the Contains predicate on the "slogan" field.
+func SloganContains(v string) predicate.Team {
+	return predicate.Team(sql.FieldContains(FieldSlogan, v))
+}
+
+// SloganHasPrefix applies the HasPrefix predicate on the "slogan" field.
+func SloganHasPrefix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasPrefix(FieldSlogan, v))
+}
+
+// SloganHasSuffix applies the HasSuffix predicate on the "slogan" field.
+func SloganHasSuffix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasSuffix(FieldSlogan, v))
+}
+
+// SloganEqualFold applies the EqualFold predicate on the "slogan" field.
+func SloganEqualFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldEqualFold(FieldSlogan, v))
+}
+
+// SloganContainsFold applies the ContainsFold predicate on the "slogan" field.
+func SloganContainsFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldContainsFold(FieldSlogan, v))
+}
+
+// NoticeEQ applies the EQ predicate on the "notice" field.
+func NoticeEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldNotice, v))
+}
+
+// NoticeNEQ applies the NEQ predicate on the "notice" field.
+func NoticeNEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldNotice, v))
+}
+
+// NoticeIn applies the In predicate on the "notice" field.
+func NoticeIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldNotice, vs...))
+}
+
+// NoticeNotIn applies the NotIn predicate on the "notice" field.
+func NoticeNotIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldNotice, vs...))
+}
+
+// NoticeGT applies the GT predicate on the "notice" field.
+func NoticeGT(v string) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldNotice, v))
+}
+
+// NoticeGTE applies the GTE predicate on the "notice" field.
+func NoticeGTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldNotice, v))
+}
+
+// NoticeLT applies the LT predicate on the "notice" field.
+func NoticeLT(v string) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldNotice, v))
+}
+
+// NoticeLTE applies the LTE predicate on the "notice" field.
+func NoticeLTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldNotice, v))
+}
+
+// NoticeContains applies the Contains predicate on the "notice" field.
+func NoticeContains(v string) predicate.Team {
+	return predicate.Team(sql.FieldContains(FieldNotice, v))
+}
+
+// NoticeHasPrefix applies the HasPrefix predicate on the "notice" field.
+func NoticeHasPrefix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasPrefix(FieldNotice, v))
+}
+
+// NoticeHasSuffix applies the HasSuffix predicate on the "notice" field.
+func NoticeHasSuffix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasSuffix(FieldNotice, v))
+}
+
+// NoticeEqualFold applies the EqualFold predicate on the "notice" field.
+func NoticeEqualFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldEqualFold(FieldNotice, v))
+}
+
+// NoticeContainsFold applies the ContainsFold predicate on the "notice" field.
+func NoticeContainsFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldContainsFold(FieldNotice, v))
+}
+
+// LogoBgEQ applies the EQ predicate on the "logo_bg" field.
+func LogoBgEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoBg, v))
+}
+
+// LogoBgNEQ applies the NEQ predicate on the "logo_bg" field.
+func LogoBgNEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldLogoBg, v))
+}
+
+// LogoBgIn applies the In predicate on the "logo_bg" field.
+func LogoBgIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldLogoBg, vs...))
+}
+
+// LogoBgNotIn applies the NotIn predicate on the "logo_bg" field.
+func LogoBgNotIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldLogoBg, vs...))
+}
+
+// LogoBgGT applies the GT predicate on the "logo_bg" field.
+func LogoBgGT(v int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldLogoBg, v))
+}
+
+// LogoBgGTE applies the GTE predicate on the "logo_bg" field.
+func LogoBgGTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldLogoBg, v))
+}
+
+// LogoBgLT applies the LT predicate on the "logo_bg" field.
+func LogoBgLT(v int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldLogoBg, v))
+}
+
+// LogoBgLTE applies the LTE predicate on the "logo_bg" field.
+func LogoBgLTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldLogoBg, v))
+}
+
+// LogoIconEQ applies the EQ predicate on the "logo_icon" field.
+func LogoIconEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoIcon, v))
+}
+
+// LogoIconNEQ applies the NEQ predicate on the "logo_icon" field.
+func LogoIconNEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldLogoIcon, v))
+}
+
+// LogoIconIn applies the In predicate on the "logo_icon" field.
+func LogoIconIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldLogoIcon, vs...))
+}
+
+// LogoIconNotIn applies the NotIn predicate on the "logo_icon" field.
+func LogoIconNotIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldLogoIcon, vs...))
+}
+
+// LogoIconGT applies the GT predicate on the "logo_icon" field.
+func LogoIconGT(v int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldLogoIcon, v))
+}
+
+// LogoIconGTE applies the GTE predicate on the "logo_icon" field.
+func LogoIconGTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldLogoIcon, v))
+}
+
+// LogoIconLT applies the LT predicate on the "logo_icon" field.
+func LogoIconLT(v int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldLogoIcon, v))
+}
+
+// LogoIconLTE applies the LTE predicate on the "logo_icon" field.
+func LogoIconLTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldLogoIcon, v))
+}
+
+// LogoColorEQ applies the EQ predicate on the "logo_color" field.
+func LogoColorEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoColor, v))
+}
+
+// LogoColorNEQ applies the NEQ predicate on the "logo_color" field.
+func LogoColorNEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldLogoColor, v))
+}
+
+// LogoColorIn applies the In predicate on the "logo_color" field.
+func LogoColorIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldLogoColor, vs...))
+}
+
+// LogoColorNotIn applies the NotIn predicate on the "logo_color" field.
+func LogoColorNotIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldLogoColor, vs...))
+}
+
+// LogoColorGT applies the GT predicate on the "logo_color" field.
+func LogoColorGT(v int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldLogoColor, v))
+}
+
+// LogoColorGTE applies the GTE predicate on the "logo_color" field.
+func LogoColorGTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldLogoColor, v))
+}
+
+// LogoColorLT applies the LT predicate on the "logo_color" field.
+func LogoColorLT(v int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldLogoColor, v))
+}
+
+// LogoColorLTE applies the LTE predicate on the "logo_color" field.
+func LogoColorLTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldLogoColor, v))
+}
+
+// TxtColorEQ applies the EQ predicate on the "txt_color" field.
+func TxtColorEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldTxtColor, v))
+}
+
+// TxtColorNEQ applies the NEQ predicate on the "txt_color" field.
+func TxtColorNEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldTxtColor, v))
+}
+
+// TxtColorIn applies the In predicate on the "txt_color" field.
+func TxtColorIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldTxtColor, vs...))
+}
+
+// TxtColorNotIn applies the NotIn predicate on the "txt_color" field.
+func TxtColorNotIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldTxtColor, vs...))
+}
+
+// TxtColorGT applies the GT predicate on the "txt_color" field.
+func TxtColorGT(v int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldTxtColor, v))
+}
+
+// TxtColorGTE applies the GTE predicate on the "txt_color" field.
+func TxtColorGTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldTxtColor, v))
+}
+
+// TxtColorLT applies the LT predicate on the "txt_color" field.
+func TxtColorLT(v int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldTxtColor, v))
+}
+
+// TxtColorLTE applies the LTE predicate on the "txt_color" field.
+func TxtColorLTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldTxtColor, v))
+}
+
+// LogoWordEQ applies the EQ predicate on the "logo_word" field.
+func LogoWordEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldLogoWord, v))
+}
+
+// LogoWordNEQ applies the NEQ predicate on the "logo_word" field.
+func LogoWordNEQ(v string) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldLogoWord, v))
+}
+
+// LogoWordIn applies the In predicate on the "logo_word" field.
+func LogoWordIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldLogoWord, vs...))
+}
+
+// LogoWordNotIn applies the NotIn predicate on the "logo_word" field.
+func LogoWordNotIn(vs ...string) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldLogoWord, vs...))
+}
+
+// LogoWordGT applies the GT predicate on the "logo_word" field.
+func LogoWordGT(v string) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldLogoWord, v))
+}
+
+// LogoWordGTE applies the GTE predicate on the "logo_word" field.
+func LogoWordGTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldLogoWord, v))
+}
+
+// LogoWordLT applies the LT predicate on the "logo_word" field.
+func LogoWordLT(v string) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldLogoWord, v))
+}
+
+// LogoWordLTE applies the LTE predicate on the "logo_word" field.
+func LogoWordLTE(v string) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldLogoWord, v))
+}
+
+// LogoWordContains applies the Contains predicate on the "logo_word" field.
+func LogoWordContains(v string) predicate.Team {
+	return predicate.Team(sql.FieldContains(FieldLogoWord, v))
+}
+
+// LogoWordHasPrefix applies the HasPrefix predicate on the "logo_word" field.
+func LogoWordHasPrefix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasPrefix(FieldLogoWord, v))
+}
+
+// LogoWordHasSuffix applies the HasSuffix predicate on the "logo_word" field.
+func LogoWordHasSuffix(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasSuffix(FieldLogoWord, v))
+}
+
+// LogoWordEqualFold applies the EqualFold predicate on the "logo_word" field.
+func LogoWordEqualFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldEqualFold(FieldLogoWord, v))
+}
+
+// LogoWordContainsFold applies the ContainsFold predicate on the "logo_word" field.
+func LogoWordContainsFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldContainsFold(FieldLogoWord, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.TeamMember) predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := newMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Team) predicate.Team {
+	return predicate.Team(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Team) predicate.Team {
+	return predicate.Team(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Team) predicate.Team {
+	return predicate.Team(sql.NotPredicates(p))
+}
@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamCreate is the builder for creating a Team entity.
+type TeamCreate struct {
+	config
+	mutation *TeamMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *TeamCreate) SetName(v string) *TeamCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNameKey sets the "name_key" field.
+func (_c *TeamCreate) SetNameKey(v string) *TeamCreate {
+	_c.mutation.SetNameKey(v)
+	return _c
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (_c *TeamCreate) SetLegacyID(v int64) *TeamCreate {
+	_c.mutation.SetLegacyID(v)
+	return _c
+}
+
+// SetNillableLegacyID sets the "legacy_id" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableLegacyID(v *int64) *TeamCreate {
+	if v != nil {
+		_c.SetLegacyID(*v)
+	}
+	return _c
+}
+
+// SetLeaderID sets the "leader_id" field.
+func (_c *TeamCreate) SetLeaderID(v int64) *TeamCreate {
+	_c.mutation.SetLeaderID(v)
+	return _c
+}
+
+// SetInterest sets the "interest" field.
+func (_c *TeamCreate) SetInterest(v int) *TeamCreate {
+	_c.mutation.SetInterest(v)
+	return _c
+}
+
+// SetNillableInterest sets the "interest" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableInterest(v *int) *TeamCreate {
+	if v != nil {
+		_c.SetInterest(*v)
+	}
+	return _c
+}
+
+// SetJoinFlag sets the "join_flag" field.
+func (_c *TeamCreate) SetJoinFlag(v int) *TeamCreate {
+	_c.mutation.SetJoinFlag(v)
+	return _c
+}
+
+// SetNillableJoinFlag sets the "join_flag" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableJoinFlag(v *int) *TeamCreate {
+	if v != nil {
+		_c.SetJoinFlag(*v)
+	}
+	return _c
+}
+
+// SetExp sets the "exp" field.
+func (_c *TeamCreate) SetExp(v int64) *TeamCreate {
+	_c.mutation.SetExp(v)
+	return _c
+}
+
+// SetNillableExp sets the "exp" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableExp(v *int64) *TeamCreate {
+	if v != nil {
+		_c.SetExp(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *TeamCreate) SetScore(v int64) *TeamCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableScore(v *int64) *TeamCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetSlogan sets the "slogan" field.
+func (_c *TeamCreate) SetSlogan(v string) *TeamCreate {
+	_c.mutation.SetSlogan(v)
+	return _c
+}
+
+// SetNillableSlogan sets the "slogan" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableSlogan(v *string) *TeamCreate {
+	if v != nil {
+		_c.SetSlogan(*v)
+	}
+	return _c
+}
+
+// SetNotice sets the "notice" field.
+func (_c *TeamCreate) SetNotice(v string) *TeamCreate {
+	_c.mutation.SetNotice(v)
+	return _c
+}
+
+// SetNillableNotice sets the "notice" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableNotice(v *string) *TeamCreate {
+	if v != nil {
+		_c.SetNotice(*v)
+	}
+	return _c
+}
+
+// SetLogoBg sets the "logo_bg" field.
+func (_c *TeamCreate) SetLogoBg(v int) *TeamCreate {
+	_c.mutation.SetLogoBg(v)
+	return _c
+}
+
+// SetNillableLogoBg sets the "logo_bg" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableLogoBg(v *int) *TeamCreate {
+	if v != nil {
+		_c.SetLogoBg(*v)
+	}
+	return _c
+}
+
+// SetLogoIcon sets the "logo_icon" field.
+func (_c *TeamCreate) SetLogoIcon(v int) *TeamCreate {
+	_c.mutation.SetLogoIcon(v)
+	return _c
+}
+
+// SetNillableLogoIcon sets the "logo_icon" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableLogoIcon(v *int) *TeamCreate {
+	if v != nil {
+		_c.SetLogoIcon(*v)
+	}
+	return _c
+}
+
+// SetLogoColor sets the "logo_color" field.
+func (_c *TeamCreate) SetLogoColor(v int) *TeamCreate {
+	_c.mutation.SetLogoColor(v)
+	return _c
+}
+
+// SetNillableLogoColor sets the "logo_color" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableLogoColor(v *int) *TeamCreate {
+	if v != nil {
+		_c.SetLogoColor(*v)
+	}
+	return _c
+}
+
+// SetTxtColor sets the "txt_color" field.
+func (_c *TeamCreate) SetTxtColor(v int) *TeamCreate {
+	_c.mutation.SetTxtColor(v)
+	return _c
+}
+
+// SetNillableTxtColor sets the "txt_color" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableTxtColor(v *int) *TeamCreate {
+	if v != nil {
+		_c.SetTxtColor(*v)
+	}
+	return _c
+}
+
+// SetLogoWord sets the "logo_word" field.
+func (_c *TeamCreate) SetLogoWord(v string) *TeamCreate {
+	_c.mutation.SetLogoWord(v)
+	return _c
+}
+
+// SetNillableLogoWord sets the "logo_word" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableLogoWord(v *string) *TeamCreate {
+	if v != nil {
+		_c.SetLogoWord(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TeamCreate) SetCreatedAt(v time.Time) *TeamCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableCreatedAt(v *time.Time) *TeamCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TeamCreate) SetUpdatedAt(v time.Time) *TeamCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableUpdatedAt(v *time.Time) *TeamCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// AddMemberIDs adds the "members" edge to the TeamMember entity by IDs.
+func (_c *TeamCreate) AddMemberIDs(ids ...int) *TeamCreate {
+	_c.mutation.AddMemberIDs(ids...)
+	return _c
+}
+
+// AddMembers adds the "members" edges to the TeamMember entity.
+func (_c *TeamCreate) AddMembers(v ...*TeamMember) *TeamCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (_c *TeamCreate) Mutation() *TeamMutation {
+	return _c.mutation
+}
+
+// Save creates the Team in the database.
+func (_c *TeamCreate) Save(ctx context.Context) (*Team, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TeamCreate) SaveX(ctx context.Context) *Team {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TeamCreate) defaults() {
+	if _, ok := _c.mutation.Interest(); !ok {
+		v := team.DefaultInterest
+		_c.mutation.SetInterest(v)
+	}
+	if _, ok := _c.mutation.JoinFlag(); !ok {
+		v := team.DefaultJoinFlag
+		_c.mutation.SetJoinFlag(v)
+	}
+	if _, ok := _c.mutation.Exp(); !ok {
+		v := team.DefaultExp
+		_c.mutation.SetExp(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := team.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.Slogan(); !ok {
+		v := team.DefaultSlogan
+		_c.mutation.SetSlogan(v)
+	}
+	if _, ok := _c.mutation.Notice(); !ok {
+		v := team.DefaultNotice
+		_c.mutation.SetNotice(v)
+	}
+	if _, ok := _c.mutation.LogoBg(); !ok {
+		v := team.DefaultLogoBg
+		_c.mutation.SetLogoBg(v)
+	}
+	if _, ok := _c.mutation.LogoIcon(); !ok {
+		v := team.DefaultLogoIcon
+		_c.mutation.SetLogoIcon(v)
+	}
+	if _, ok := _c.mutation.LogoColor(); !ok {
+		v := team.DefaultLogoColor
+		_c.mutation.SetLogoColor(v)
+	}
+	if _, ok := _c.mutation.TxtColor(); !ok {
+		v := team.DefaultTxtColor
+		_c.mutation.SetTxtColor(v)
+	}
+	if _, ok := _c.mutation.LogoWord(); !ok {
+		v := team.DefaultLogoWord
+		_c.mutation.SetLogoWord(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := team.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := team.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TeamCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Team.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := team.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NameKey(); !ok {
+		return &ValidationError{Name: "name_key", err: errors.New(`ent: missing required field "Team.name_key"`)}
+	}
+	if v, ok := _c.mutation.NameKey(); ok {
+		if err := team.NameKeyValidator(v); err != nil {
+			return &ValidationError{Name: "name_key", err: fmt.Errorf(`ent: validator failed for field "Team.name_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LeaderID(); !ok {
+		return &ValidationError{Name: "leader_id", err: errors.New(`ent: missing required field "Team.leader_id"`)}
+	}
+	if _, ok := _c.mutation.Interest(); !ok {
+		return &ValidationError{Name: "interest", err: errors.New(`ent: missing required field "Team.interest"`)}
+	}
+	if _, ok := _c.mutation.JoinFlag(); !ok {
+		return &ValidationError{Name: "join_flag", err: errors.New(`ent: missing required field "Team.join_flag"`)}
+	}
+	if _, ok := _c.mutation.Exp(); !ok {
+		return &ValidationError{Name: "exp", err: errors.New(`ent: missing required field "Team.exp"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "Team.score"`)}
+	}
+	if _, ok := _c.mutation.Slogan(); !ok {
+		return &ValidationError{Name: "slogan", err: errors.New(`ent: missing required field "Team.slogan"`)}
+	}
+	if _, ok := _c.mutation.Notice(); !ok {
+		return &ValidationError{Name: "notice", err: errors.New(`ent: missing required field "Team.notice"`)}
+	}
+	if _, ok := _c.mutation.LogoBg(); !ok {
+		return &ValidationError{Name: "logo_bg", err: errors.New(`ent: missing required field "Team.logo_bg"`)}
+	}
+	if _, ok := _c.mutation.LogoIcon(); !ok {
+		return &ValidationError{Name: "logo_icon", err: errors.New(`ent: missing required field "Team.logo_icon"`)}
+	}
+	if _, ok := _c.mutation.LogoColor(); !ok {
+		return &ValidationError{Name: "logo_color", err: errors.New(`ent: missing required field "Team.logo_color"`)}
+	}
+	if _, ok := _c.mutation.TxtColor(); !ok {
+		return &ValidationError{Name: "txt_color", err: errors.New(`ent: missing required field "Team.txt_color"`)}
+	}
+	if _, ok := _c.mutation.LogoWord(); !ok {
+		return &ValidationError{Name: "logo_word", err: errors.New(`ent: missing required field "Team.logo_word"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Team.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Team.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TeamCreate) sqlSave(ctx context.Context) (*Team, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Team{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(team.Table, sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(team.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.NameKey(); ok {
+		_spec.SetField(team.FieldNameKey, field.TypeString, value)
+		_node.NameKey = value
+	}
+	if value, ok := _c.mutation.LegacyID(); ok {
+		_spec.SetField(team.FieldLegacyID, field.TypeInt64, value)
+		_node.LegacyID = &value
+	}
+	if value, ok := _c.mutation.LeaderID(); ok {
+		_spec.SetField(team.FieldLeaderID, field.TypeInt64, value)
+		_node.LeaderID = value
+	}
+	if value, ok := _c.mutation.Interest(); ok {
+		_spec.SetField(team.FieldInterest, field.TypeInt, value)
+		_node.Interest = value
+	}
+	if value, ok := _c.mutation.JoinFlag(); ok {
+		_spec.SetField(team.FieldJoinFlag, field.TypeInt, value)
+		_node.JoinFlag = value
+	}
+	if value, ok := _c.mutation.Exp(); ok {
+		_spec.SetField(team.FieldExp, field.TypeInt64, value)
+		_node.Exp = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(team.FieldScore, field.TypeInt64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.Slogan(); ok {
+		_spec.SetField(team.FieldSlogan, field.TypeString, value)
+		_node.Slogan = value
+	}
+	if value, ok := _c.mutation.Notice(); ok {
+		_spec.SetField(team.FieldNotice, field.TypeString, value)
+		_node.Notice = value
+	}
+	if value, ok := _c.mutation.LogoBg(); ok {
+		_spec.SetField(team.FieldLogoBg, field.TypeInt, value)
+		_node.LogoBg = value
+	}
+	if value, ok := _c.mutation.LogoIcon(); ok {
+		_spec.SetField(team.FieldLogoIcon, field.TypeInt, value)
+		_node.LogoIcon = value
+	}
+	if value, ok := _c.mutation.LogoColor(); ok {
+		_spec.SetField(team.FieldLogoColor, field.TypeInt, value)
+		_node.LogoColor = value
+	}
+	if value, ok := _c.mutation.TxtColor(); ok {
+		_spec.SetField(team.FieldTxtColor, field.TypeInt, value)
+		_node.TxtColor = value
+	}
+	if value, ok := _c.mutation.LogoWord(); ok {
+		_spec.SetField(team.FieldLogoWord, field.TypeString, value)
+		_node.LogoWord = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(team.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(team.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TeamCreateBulk is the builder for creating many Team entities in bulk.
+type TeamCreateBulk struct {
+	config
+	err      error
+	builders []*TeamCreate
+}
+
+// Save creates the Team entities in the database.
+func (_c *TeamCreateBulk) Save(ctx context.Context) ([]*Team, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Team, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TeamCreateBulk) SaveX(ctx context.Context) []*Team {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/predicate"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamUpdate is the builder for updating Team entities.
+type TeamUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamMutation
+}
+
+// Where appends a list predicates to the TeamUpdate builder.
+func (_u *TeamUpdate) Where(ps ...predicate.Team) *TeamUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TeamUpdate) SetName(v string) *TeamUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableName(v *string) *TeamUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNameKey sets the "name_key" field.
+func (_u *TeamUpdate) SetNameKey(v string) *TeamUpdate {
+	_u.mutation.SetNameKey(v)
+	return _u
+}
+
+// SetNillableNameKey sets the "name_key" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableNameKey(v *string) *TeamUpdate {
+	if v != nil {
+		_u.SetNameKey(*v)
+	}
+	return _u
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (_u *TeamUpdate) SetLegacyID(v int64) *TeamUpdate {
+	_u.mutation.ResetLegacyID()
+	_u.mutation.SetLegacyID(v)
+	return _u
+}
+
+// SetNillableLegacyID sets the "legacy_id" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableLegacyID(v *int64) *TeamUpdate {
+	if v != nil {
+		_u.SetLegacyID(*v)
+	}
+	return _u
+}
+
+// AddLegacyID adds value to the "legacy_id" field.
+func (_u *TeamUpdate) AddLegacyID(v int64) *TeamUpdate {
+	_u.mutation.AddLegacyID(v)
+	return _u
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (_u *TeamUpdate) ClearLegacyID() *TeamUpdate {
+	_u.mutation.ClearLegacyID()
+	return _u
+}
+
+// SetLeaderID sets the "leader_id" field.
+func (_u *TeamUpdate) SetLeaderID(v int64) *TeamUpdate {
+	_u.mutation.ResetLeaderID()
+	_u.mutation.SetLeaderID(v)
+	return _u
+}
+
+// SetNillableLeaderID sets the "leader_id" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableLeaderID(v *int64) *TeamUpdate {
+	if v != nil {
+		_u.SetLeaderID(*v)
+	}
+	return _u
+}
+
+// AddLeaderID adds value to the "leader_id" field.
+func (_u *TeamUpdate) AddLeaderID(v int64) *TeamUpdate {
+	_u.mutation.AddLeaderID(v)
+	return _u
+}
+
+// SetInterest sets the "interest" field.
+func (_u *TeamUpdate) SetInterest(v int) *TeamUpdate {
+	_u.mutation.ResetInterest()
+	_u.mutation.SetInterest(v)
+	return _u
+}
+
+// SetNillableInterest sets the "interest" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableInterest(v *int) *TeamUpdate {
+	if v != nil {
+		_u.SetInterest(*v)
+	}
+	return _u
+}
+
+// AddInterest adds value to the "interest" field.
+func (_u *TeamUpdate) AddInterest(v int) *TeamUpdate {
+	_u.mutation.AddInterest(v)
+	return _u
+}
+
+// SetJoinFlag sets the "join_flag" field.
+func (_u *TeamUpdate) SetJoinFlag(v int) *TeamUpdate {
+	_u.mutation.ResetJoinFlag()
+	_u.mutation.SetJoinFlag(v)
+	return _u
+}
+
+// SetNillableJoinFlag sets the "join_flag" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableJoinFlag(v *int) *TeamUpdate {
+	if v != nil {
+		_u.SetJoinFlag(*v)
+	}
+	return _u
+}
+
+// AddJoinFlag adds value to the "join_flag" field.
+func (_u *TeamUpdate) AddJoinFlag(v int) *TeamUpdate {
+	_u.mutation.AddJoinFlag(v)
+	return _u
+}
+
+// SetExp sets the "exp" field.
+func (_u *TeamUpdate) SetExp(v int64) *TeamUpdate {
+	_u.mutation.ResetExp()
+	_u.mutation.SetExp(v)
+	return _u
+}
+
+// SetNillableExp sets the "exp" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableExp(v *int64) *TeamUpdate {
+	if v != nil {
+		_u.SetExp(*v)
+	}
+	return _u
+}
+
+// AddExp adds value to the "exp" field.
+func (_u *TeamUpdate) AddExp(v int64) *TeamUpdate {
+	_u.mutation.AddExp(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamUpdate) SetScore(v int64) *TeamUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableScore(v *int64) *TeamUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamUpdate) AddScore(v int64) *TeamUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetSlogan sets the "slogan" field.
+func (_u *TeamUpdate) SetSlogan(v string) *TeamUpdate {
+	_u.mutation.SetSlogan(v)
+	return _u
+}
+
+// SetNillableSlogan sets the "slogan" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableSlogan(v *string) *TeamUpdate {
+	if v != nil {
+		_u.SetSlogan(*v)
+	}
+	return _u
+}
+
+// SetNotice sets the "notice" field.
+func (_u *TeamUpdate) SetNotice(v string) *TeamUpdate {
+	_u.mutation.SetNotice(v)
+	return _u
+}
+
+// SetNillableNotice sets the "notice" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableNotice(v *string) *TeamUpdate {
+	if v != nil {
+		_u.SetNotice(*v)
+	}
+	return _u
+}
+
+// SetLogoBg sets the "logo_bg" field.
+func (_u *TeamUpdate) SetLogoBg(v int) *TeamUpdate {
+	_u.mutation.ResetLogoBg()
+	_u.mutation.SetLogoBg(v)
+	return _u
+}
+
+// SetNillableLogoBg sets the "logo_bg" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableLogoBg(v *int) *TeamUpdate {
+	if v != nil {
+		_u.SetLogoBg(*v)
+	}
+	return _u
+}
+
+// AddLogoBg adds value to the "logo_bg" field.
+func (_u *TeamUpdate) AddLogoBg(v int) *TeamUpdate {
+	_u.mutation.AddLogoBg(v)
+	return _u
+}
+
+// SetLogoIcon sets the "logo_icon" field.
+func (_u *TeamUpdate) SetLogoIcon(v int) *TeamUpdate {
+	_u.mutation.ResetLogoIcon()
+	_u.mutation.SetLogoIcon(v)
+	return _u
+}
+
+// SetNillableLogoIcon sets the "logo_icon" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableLogoIcon(v *int) *TeamUpdate {
+	if v != nil {
+		_u.SetLogoIcon(*v)
+	}
+	return _u
+}
+
+// AddLogoIcon adds value to the "logo_icon" field.
+func (_u *TeamUpdate) AddLogoIcon(v int) *TeamUpdate {
+	_u.mutation.AddLogoIcon(v)
+	return _u
+}
+
+// SetLogoColor sets the "logo_color" field.
+func (_u *TeamUpdate) SetLogoColor(v int) *TeamUpdate {
+	_u.mutation.ResetLogoColor()
+	_u.mutation.SetLogoColor(v)
+	return _u
+}
+
+// SetNillableLogoColor sets the "logo_color" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableLogoColor(v *int) *TeamUpdate {
+	if v != nil {
+		_u.SetLogoColor(*v)
+	}
+	return _u
+}
+
+// AddLogoColor adds value to the "logo_color" field.
+func (_u *TeamUpdate) AddLogoColor(v int) *TeamUpdate {
+	_u.mutation.AddLogoColor(v)
+	return _u
+}
+
+// SetTxtColor sets the "txt_color" field.
+func (_u *TeamUpdate) SetTxtColor(v int) *TeamUpdate {
+	_u.mutation.ResetTxtColor()
+	_u.mutation.SetTxtColor(v)
+	return _u
+}
+
+// SetNillableTxtColor sets the "txt_color" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableTxtColor(v *int) *TeamUpdate {
+	if v != nil {
+		_u.SetTxtColor(*v)
+	}
+	return _u
+}
+
+// AddTxtColor adds value to the "txt_color" field.
+func (_u *TeamUpdate) AddTxtColor(v int) *TeamUpdate {
+	_u.mutation.AddTxtColor(v)
+	return _u
+}
+
+// SetLogoWord sets the "logo_word" field.
+func (_u *TeamUpdate) SetLogoWord(v string) *TeamUpdate {
+	_u.mutation.SetLogoWord(v)
+	return _u
+}
+
+// SetNillableLogoWord sets the "logo_word" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableLogoWord(v *string) *TeamUpdate {
+	if v != nil {
+		_u.SetLogoWord(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TeamUpdate) SetCreatedAt(v time.Time) *TeamUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableCreatedAt(v *time.Time) *TeamUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TeamUpdate) SetUpdatedAt(v time.Time) *TeamUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddMemberIDs adds the "members" edge to the TeamMember entity by IDs.
+func (_u *TeamUpdate) AddMemberIDs(ids ...int) *TeamUpdate {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the TeamMember entity.
+func (_u *TeamUpdate) AddMembers(v ...*TeamMember) *TeamUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (_u *TeamUpdate) Mutation() *TeamMutation {
+	return _u.mutation
+}
+
+// ClearMembers clears all "members" edges to the TeamMember entity.
+func (_u *TeamUpdate) ClearMembers() *TeamUpdate {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to TeamMember entities by IDs.
+func (_u *TeamUpdate) RemoveMemberIDs(ids ...int) *TeamUpdate {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to TeamMember entities.
+func (_u *TeamUpdate) RemoveMembers(v ...*TeamMember) *TeamUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TeamUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TeamUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TeamUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := team.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TeamUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := team.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameKey(); ok {
+		if err := team.NameKeyValidator(v); err != nil {
+			return &ValidationError{Name: "name_key", err: fmt.Errorf(`ent: validator failed for field "Team.name_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TeamUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(team.Table, team.Columns, sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(team.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NameKey(); ok {
+		_spec.SetField(team.FieldNameKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LegacyID(); ok {
+		_spec.SetField(team.FieldLegacyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLegacyID(); ok {
+		_spec.AddField(team.FieldLegacyID, field.TypeInt64, value)
+	}
+	if _u.mutation.LegacyIDCleared() {
+		_spec.ClearField(team.FieldLegacyID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LeaderID(); ok {
+		_spec.SetField(team.FieldLeaderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLeaderID(); ok {
+		_spec.AddField(team.FieldLeaderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Interest(); ok {
+		_spec.SetField(team.FieldInterest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInterest(); ok {
+		_spec.AddField(team.FieldInterest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.JoinFlag(); ok {
+		_spec.SetField(team.FieldJoinFlag, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedJoinFlag(); ok {
+		_spec.AddField(team.FieldJoinFlag, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Exp(); ok {
+		_spec.SetField(team.FieldExp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExp(); ok {
+		_spec.AddField(team.FieldExp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(team.FieldScore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(team.FieldScore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Slogan(); ok {
+		_spec.SetField(team.FieldSlogan, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notice(); ok {
+		_spec.SetField(team.FieldNotice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LogoBg(); ok {
+		_spec.SetField(team.FieldLogoBg, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLogoBg(); ok {
+		_spec.AddField(team.FieldLogoBg, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LogoIcon(); ok {
+		_spec.SetField(team.FieldLogoIcon, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLogoIcon(); ok {
+		_spec.AddField(team.FieldLogoIcon, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LogoColor(); ok {
+		_spec.SetField(team.FieldLogoColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLogoColor(); ok {
+		_spec.AddField(team.FieldLogoColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxtColor(); ok {
+		_spec.SetField(team.FieldTxtColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxtColor(); ok {
+		_spec.AddField(team.FieldTxtColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LogoWord(); ok {
+		_spec.SetField(team.FieldLogoWord, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(team.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(team.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{team.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TeamUpdateOne is the builder for updating a single Team entity.
+type TeamUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamMutation
+}
+
+// SetName sets the "name" field.
+func (_u *TeamUpdateOne) SetName(v string) *TeamUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableName(v *string) *TeamUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNameKey sets the "name_key" field.
+func (_u *TeamUpdateOne) SetNameKey(v string) *TeamUpdateOne {
+	_u.mutation.SetNameKey(v)
+	return _u
+}
+
+// SetNillableNameKey sets the "name_key" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableNameKey(v *string) *TeamUpdateOne {
+	if v != nil {
+		_u.SetNameKey(*v)
+	}
+	return _u
+}
+
+// SetLegacyID sets the "legacy_id" field.
+func (_u *TeamUpdateOne) SetLegacyID(v int64) *TeamUpdateOne {
+	_u.mutation.ResetLegacyID()
+	_u.mutation.SetLegacyID(v)
+	return _u
+}
+
+// SetNillableLegacyID sets the "legacy_id" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableLegacyID(v *int64) *TeamUpdateOne {
+	if v != nil {
+		_u.SetLegacyID(*v)
+	}
+	return _u
+}
+
+// AddLegacyID adds value to the "legacy_id" field.
+func (_u *TeamUpdateOne) AddLegacyID(v int64) *TeamUpdateOne {
+	_u.mutation.AddLegacyID(v)
+	return _u
+}
+
+// ClearLegacyID clears the value of the "legacy_id" field.
+func (_u *TeamUpdateOne) ClearLegacyID() *TeamUpdateOne {
+	_u.mutation.ClearLegacyID()
+	return _u
+}
+
+// SetLeaderID sets the "leader_id" field.
+func (_u *TeamUpdateOne) SetLeaderID(v int64) *TeamUpdateOne {
+	_u.mutation.ResetLeaderID()
+	_u.mutation.SetLeaderID(v)
+	return _u
+}
+
+// SetNillableLeaderID sets the "leader_id" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableLeaderID(v *int64) *TeamUpdateOne {
+	if v != nil {
+		_u.SetLeaderID(*v)
+	}
+	return _u
+}
+
+// AddLeaderID adds value to the "leader_id" field.
+func (_u *TeamUpdateOne) AddLeaderID(v int64) *TeamUpdateOne {
+	_u.mutation.AddLeaderID(v)
+	return _u
+}
+
+// SetInterest sets the "interest" field.
+func (_u *TeamUpdateOne) SetInterest(v int) *TeamUpdateOne {
+	_u.mutation.ResetInterest()
+	_u.mutation.SetInterest(v)
+	return _u
+}
+
+// SetNillableInterest sets the "interest" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableInterest(v *int) *TeamUpdateOne {
+	if v != nil {
+		_u.SetInterest(*v)
+	}
+	return _u
+}
+
+// AddInterest adds value to the "interest" field.
+func (_u *TeamUpdateOne) AddInterest(v int) *TeamUpdateOne {
+	_u.mutation.AddInterest(v)
+	return _u
+}
+
+// SetJoinFlag sets the "join_flag" field.
+func (_u *TeamUpdateOne) SetJoinFlag(v int) *TeamUpdateOne {
+	_u.mutation.ResetJoinFlag()
+	_u.mutation.SetJoinFlag(v)
+	return _u
+}
+
+// SetNillableJoinFlag sets the "join_flag" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableJoinFlag(v *int) *TeamUpdateOne {
+	if v != nil {
+		_u.SetJoinFlag(*v)
+	}
+	return _u
+}
+
+// AddJoinFlag adds value to the "join_flag" field.
+func (_u *TeamUpdateOne) AddJoinFlag(v int) *TeamUpdateOne {
+	_u.mutation.AddJoinFlag(v)
+	return _u
+}
+
+// SetExp sets the "exp" field.
+func (_u *TeamUpdateOne) SetExp(v int64) *TeamUpdateOne {
+	_u.mutation.ResetExp()
+	_u.mutation.SetExp(v)
+	return _u
+}
+
+// SetNillableExp sets the "exp" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableExp(v *int64) *TeamUpdateOne {
+	if v != nil {
+		_u.SetExp(*v)
+	}
+	return _u
+}
+
+// AddExp adds value to the "exp" field.
+func (_u *TeamUpdateOne) AddExp(v int64) *TeamUpdateOne {
+	_u.mutation.AddExp(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamUpdateOne) SetScore(v int64) *TeamUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableScore(v *int64) *TeamUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamUpdateOne) AddScore(v int64) *TeamUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetSlogan sets the "slogan" field.
+func (_u *TeamUpdateOne) SetSlogan(v string) *TeamUpdateOne {
+	_u.mutation.SetSlogan(v)
+	return _u
+}
+
+// SetNillableSlogan sets the "slogan" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableSlogan(v *string) *TeamUpdateOne {
+	if v != nil {
+		_u.SetSlogan(*v)
+	}
+	return _u
+}
+
+// SetNotice sets the "notice" field.
+func (_u *TeamUpdateOne) SetNotice(v string) *TeamUpdateOne {
+	_u.mutation.SetNotice(v)
+	return _u
+}
+
+// SetNillableNotice sets the "notice" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableNotice(v *string) *TeamUpdateOne {
+	if v != nil {
+		_u.SetNotice(*v)
+	}
+	return _u
+}
+
+// SetLogoBg sets the "logo_bg" field.
+func (_u *TeamUpdateOne) SetLogoBg(v int) *TeamUpdateOne {
+	_u.mutation.ResetLogoBg()
+	_u.mutation.SetLogoBg(v)
+	return _u
+}
+
+// SetNillableLogoBg sets the "logo_bg" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableLogoBg(v *int) *TeamUpdateOne {
+	if v != nil {
+		_u.SetLogoBg(*v)
+	}
+	return _u
+}
+
+// AddLogoBg adds value to the "logo_bg" field.
+func (_u *TeamUpdateOne) AddLogoBg(v int) *TeamUpdateOne {
+	_u.mutation.AddLogoBg(v)
+	return _u
+}
+
+// SetLogoIcon sets the "logo_icon" field.
+func (_u *TeamUpdateOne) SetLogoIcon(v int) *TeamUpdateOne {
+	_u.mutation.ResetLogoIcon()
+	_u.mutation.SetLogoIcon(v)
+	return _u
+}
+
+// SetNillableLogoIcon sets the "logo_icon" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableLogoIcon(v *int) *TeamUpdateOne {
+	if v != nil {
+		_u.SetLogoIcon(*v)
+	}
+	return _u
+}
+
+// AddLogoIcon adds value to the "logo_icon" field.
+func (_u *TeamUpdateOne) AddLogoIcon(v int) *TeamUpdateOne {
+	_u.mutation.AddLogoIcon(v)
+	return _u
+}
+
+// SetLogoColor sets the "logo_color" field.
+func (_u *TeamUpdateOne) SetLogoColor(v int) *TeamUpdateOne {
+	_u.mutation.ResetLogoColor()
+	_u.mutation.SetLogoColor(v)
+	return _u
+}
+
+// SetNillableLogoColor sets the "logo_color" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableLogoColor(v *int) *TeamUpdateOne {
+	if v != nil {
+		_u.SetLogoColor(*v)
+	}
+	return _u
+}
+
+// AddLogoColor adds value to the "logo_color" field.
+func (_u *TeamUpdateOne) AddLogoColor(v int) *TeamUpdateOne {
+	_u.mutation.AddLogoColor(v)
+	return _u
+}
+
+// SetTxtColor sets the "txt_color" field.
+func (_u *TeamUpdateOne) SetTxtColor(v int) *TeamUpdateOne {
+	_u.mutation.ResetTxtColor()
+	_u.mutation.SetTxtColor(v)
+	return _u
+}
+
+// SetNillableTxtColor sets the "txt_color" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableTxtColor(v *int) *TeamUpdateOne {
+	if v != nil {
+		_u.SetTxtColor(*v)
+	}
+	return _u
+}
+
+// AddTxtColor adds value to the "txt_color" field.
+func (_u *TeamUpdateOne) AddTxtColor(v int) *TeamUpdateOne {
+	_u.mutation.AddTxtColor(v)
+	return _u
+}
+
+// SetLogoWord sets the "logo_word" field.
+func (_u *TeamUpdateOne) SetLogoWord(v string) *TeamUpdateOne {
+	_u.mutation.SetLogoWord(v)
+	return _u
+}
+
+// SetNillableLogoWord sets the "logo_word" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableLogoWord(v *string) *TeamUpdateOne {
+	if v != nil {
+		_u.SetLogoWord(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TeamUpdateOne) SetCreatedAt(v time.Time) *TeamUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableCreatedAt(v *time.Time) *TeamUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TeamUpdateOne) SetUpdatedAt(v time.Time) *TeamUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddMemberIDs adds the "members" edge to the TeamMember entity by IDs.
+func (_u *TeamUpdateOne) AddMemberIDs(ids ...int) *TeamUpdateOne {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the TeamMember entity.
+func (_u *TeamUpdateOne) AddMembers(v ...*TeamMember) *TeamUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (_u *TeamUpdateOne) Mutation() *TeamMutation {
+	return _u.mutation
+}
+
+// ClearMembers clears all "members" edges to the TeamMember entity.
+func (_u *TeamUpdateOne) ClearMembers() *TeamUpdateOne {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to TeamMember entities by IDs.
+func (_u *TeamUpdateOne) RemoveMemberIDs(ids ...int) *TeamUpdateOne {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to TeamMember entities.
+func (_u *TeamUpdateOne) RemoveMembers(v ...*TeamMember) *TeamUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// Where appends a list predicates to the TeamUpdate builder.
+func (_u *TeamUpdateOne) Where(ps ...predicate.Team) *TeamUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TeamUpdateOne) Select(field string, fields ...string) *TeamUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Team entity.
+func (_u *TeamUpdateOne) Save(ctx context.Context) (*Team, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamUpdateOne) SaveX(ctx context.Context) *Team {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TeamUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TeamUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := team.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TeamUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := team.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameKey(); ok {
+		if err := team.NameKeyValidator(v); err != nil {
+			return &ValidationError{Name: "name_key", err: fmt.Errorf(`ent: validator failed for field "Team.name_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(team.Table, team.Columns, sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Team.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, team.FieldID)
+		for _, f := range fields {
+			if !team.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != team.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(team.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NameKey(); ok {
+		_spec.SetField(team.FieldNameKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LegacyID(); ok {
+		_spec.SetField(team.FieldLegacyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLegacyID(); ok {
+		_spec.AddField(team.FieldLegacyID, field.TypeInt64, value)
+	}
+	if _u.mutation.LegacyIDCleared() {
+		_spec.ClearField(team.FieldLegacyID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LeaderID(); ok {
+		_spec.SetField(team.FieldLeaderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLeaderID(); ok {
+		_spec.AddField(team.FieldLeaderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Interest(); ok {
+		_spec.SetField(team.FieldInterest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInterest(); ok {
+		_spec.AddField(team.FieldInterest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.JoinFlag(); ok {
+		_spec.SetField(team.FieldJoinFlag, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedJoinFlag(); ok {
+		_spec.AddField(team.FieldJoinFlag, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Exp(); ok {
+		_spec.SetField(team.FieldExp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExp(); ok {
+		_spec.AddField(team.FieldExp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(team.FieldScore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(team.FieldScore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Slogan(); ok {
+		_spec.SetField(team.FieldSlogan, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notice(); ok {
+		_spec.SetField(team.FieldNotice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LogoBg(); ok {
+		_spec.SetField(team.FieldLogoBg, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLogoBg(); ok {
+		_spec.AddField(team.FieldLogoBg, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LogoIcon(); ok {
+		_spec.SetField(team.FieldLogoIcon, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLogoIcon(); ok {
+		_spec.AddField(team.FieldLogoIcon, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LogoColor(); ok {
+		_spec.SetField(team.FieldLogoColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLogoColor(); ok {
+		_spec.AddField(team.FieldLogoColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TxtColor(); ok {
+		_spec.SetField(team.FieldTxtColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxtColor(); ok {
+		_spec.AddField(team.FieldTxtColor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LogoWord(); ok {
+		_spec.SetField(team.FieldLogoWord, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(team.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(team.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Team{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{team.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TeamMember is the model entity for the TeamMember schema.
+type TeamMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int `json:"team_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Nick holds the value of the "nick" field.
+	Nick string `json:"nick,omitempty"`
+	// Priv holds the value of the "priv" field.
+	Priv int `json:"priv,omitempty"`
+	// Contribution holds the value of the "contribution" field.
+	Contribution int64 `json:"contribution,omitempty"`
+	// CanExContribution holds the value of the "can_ex_contribution" field.
+	CanExContribution int64 `json:"can_ex_contribution,omitempty"`
+	// IsShow holds the value of the "is_show" field.
+	IsShow bool `json:"is_show,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamMemberQuery when eager-loading is set.
+	Edges        TeamMemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TeamMemberEdges holds the relations/edges for other nodes in the graph.
+type TeamMemberEdges struct {
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamMemberEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teammember.FieldIsShow:
+			values[i] = new(sql.NullBool)
+		case teammember.FieldID, teammember.FieldTeamID, teammember.FieldUserID, teammember.FieldPriv, teammember.FieldContribution, teammember.FieldCanExContribution:
+			values[i] = new(sql.NullInt64)
+		case teammember.FieldNick:
+			values[i] = new(sql.NullString)
+		case teammember.FieldJoinedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamMember fields.
+func (_m *TeamMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teammember.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case teammember.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = int(value.Int64)
+			}
+		case teammember.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case teammember.FieldNick:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nick", values[i])
+			} else if value.Valid {
+				_m.Nick = value.String
+			}
+		case teammember.FieldPriv:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priv", values[i])
+			} else if value.Valid {
+				_m.Priv = int(value.Int64)
+			}
+		case teammember.FieldContribution:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field contribution", values[i])
+			} else if value.Valid {
+				_m.Contribution = value.Int64
+			}
+		case teammember.FieldCanExContribution:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field can_ex_contribution", values[i])
+			} else if value.Valid {
+				_m.CanExContribution = value.Int64
+			}
+		case teammember.FieldIsShow:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_show", values[i])
+			} else if value.Valid {
+				_m.IsShow = value.Bool
+			}
+		case teammember.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				_m.JoinedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamMember.
+// This includes values selected through modifiers, order, etc.
+func (_m *TeamMember) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTeam queries the "team" edge of the TeamMember entity.
+func (_m *TeamMember) QueryTeam() *TeamQuery {
+	return NewTeamMemberClient(_m.config).QueryTeam(_m)
+}
+
+// Update returns a builder for updating this TeamMember.
+// Note that you need to call TeamMember.Unwrap() before calling this method if this TeamMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TeamMember) Update() *TeamMemberUpdateOne {
+	return NewTeamMemberClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TeamMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TeamMember) Unwrap() *TeamMember {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamMember is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TeamMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("nick=")
+	builder.WriteString(_m.Nick)
+	builder.WriteString(", ")
+	builder.WriteString("priv=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priv))
+	builder.WriteString(", ")
+	builder.WriteString("contribution=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Contribution))
+	builder.WriteString(", ")
+	builder.WriteString("can_ex_contribution=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanExContribution))
+	builder.WriteString(", ")
+	builder.WriteString("is_show=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsShow))
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(_m.JoinedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamMembers is a parsable slice of TeamMember.
+type TeamMembers []*TeamMember
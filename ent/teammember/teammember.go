@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teammember
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the teammember type in the database.
+	Label = "team_member"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldNick holds the string denoting the nick field in the database.
+	FieldNick = "nick"
+	// FieldPriv holds the string denoting the priv field in the database.
+	FieldPriv = "priv"
+	// FieldContribution holds the string denoting the contribution field in the database.
+	FieldContribution = "contribution"
+	// FieldCanExContribution holds the string denoting the can_ex_contribution field in the database.
+	FieldCanExContribution = "can_ex_contribution"
+	// FieldIsShow holds the string denoting the is_show field in the database.
+	FieldIsShow = "is_show"
+	// FieldJoinedAt holds the string denoting the joined_at field in the database.
+	FieldJoinedAt = "joined_at"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
+	// Table holds the table name of the teammember in the database.
+	Table = "team_members"
+	// TeamTable is the table that holds the team relation/edge.
+	TeamTable = "team_members"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_id"
+)
+
+// Columns holds all SQL columns for teammember fields.
+var Columns = []string{
+	FieldID,
+	FieldTeamID,
+	FieldUserID,
+	FieldNick,
+	FieldPriv,
+	FieldContribution,
+	FieldCanExContribution,
+	FieldIsShow,
+	FieldJoinedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNick holds the default value on creation for the "nick" field.
+	DefaultNick string
+	// DefaultPriv holds the default value on creation for the "priv" field.
+	DefaultPriv int
+	// DefaultContribution holds the default value on creation for the "contribution" field.
+	DefaultContribution int64
+	// DefaultCanExContribution holds the default value on creation for the "can_ex_contribution" field.
+	DefaultCanExContribution int64
+	// DefaultIsShow holds the default value on creation for the "is_show" field.
+	DefaultIsShow bool
+	// DefaultJoinedAt holds the default value on creation for the "joined_at" field.
+	DefaultJoinedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TeamMember queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByNick orders the results by the nick field.
+func ByNick(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNick, opts...).ToFunc()
+}
+
+// ByPriv orders the results by the priv field.
+func ByPriv(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriv, opts...).ToFunc()
+}
+
+// ByContribution orders the results by the contribution field.
+func ByContribution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContribution, opts...).ToFunc()
+}
+
+// ByCanExContribution orders the results by the can_ex_contribution field.
+func ByCanExContribution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanExContribution, opts...).ToFunc()
+}
+
+// ByIsShow orders the results by the is_show field.
+func ByIsShow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsShow, opts...).ToFunc()
+}
+
+// ByJoinedAt orders the results by the joined_at field.
+func ByJoinedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinedAt, opts...).ToFunc()
+}
+
+// ByTeamField orders the results by team field.
+func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTeamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+	)
+}
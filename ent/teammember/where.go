@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teammember
+
+import (
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldID, id))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldTeamID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// Nick applies equality check predicate on the "nick" field. It's identical to NickEQ.
+func Nick(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldNick, v))
+}
+
+// Priv applies equality check predicate on the "priv" field. It's identical to PrivEQ.
+func Priv(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldPriv, v))
+}
+
+// Contribution applies equality check predicate on the "contribution" field. It's identical to ContributionEQ.
+func Contribution(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldContribution, v))
+}
+
+// CanExContribution applies equality check predicate on the "can_ex_contribution" field. It's identical to CanExContributionEQ.
+func CanExContribution(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldCanExContribution, v))
+}
+
+// IsShow applies equality check predicate on the "is_show" field. It's identical to IsShowEQ.
+func IsShow(v bool) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldIsShow, v))
+}
+
+// JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
+func JoinedAt(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldUserID, v))
+}
+
+// NickEQ applies the EQ predicate on the "nick" field.
+func NickEQ(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldNick, v))
+}
+
+// NickNEQ applies the NEQ predicate on the "nick" field.
+func NickNEQ(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldNick, v))
+}
+
+// NickIn applies the In predicate on the "nick" field.
+func NickIn(vs ...string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldNick, vs...))
+}
+
+// NickNotIn applies the NotIn predicate on the "nick" field.
+func NickNotIn(vs ...string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldNick, vs...))
+}
+
+// NickGT applies the GT predicate on the "nick" field.
+func NickGT(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldNick, v))
+}
+
+// NickGTE applies the GTE predicate on the "nick" field.
+func NickGTE(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldNick, v))
+}
+
+// NickLT applies the LT predicate on the "nick" field.
+func NickLT(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldNick, v))
+}
+
+// NickLTE applies the LTE predicate on the "nick" field.
+func NickLTE(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldNick, v))
+}
+
+// NickContains applies the Contains predicate on the "nick" field.
+func NickContains(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldContains(FieldNick, v))
+}
+
+// NickHasPrefix applies the HasPrefix predicate on the "nick" field.
+func NickHasPrefix(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldHasPrefix(FieldNick, v))
+}
+
+// NickHasSuffix applies the HasSuffix predicate on the "nick" field.
+func NickHasSuffix(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldHasSuffix(FieldNick, v))
+}
+
+// NickEqualFold applies the EqualFold predicate on the "nick" field.
+func NickEqualFold(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEqualFold(FieldNick, v))
+}
+
+// NickContainsFold applies the ContainsFold predicate on the "nick" field.
+func NickContainsFold(v string) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldContainsFold(FieldNick, v))
+}
+
+// PrivEQ applies the EQ predicate on the "priv" field.
+func PrivEQ(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldPriv, v))
+}
+
+// PrivNEQ applies the NEQ predicate on the "priv" field.
+func PrivNEQ(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldPriv, v))
+}
+
+// PrivIn applies the In predicate on the "priv" field.
+func PrivIn(vs ...int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldPriv, vs...))
+}
+
+// PrivNotIn applies the NotIn predicate on the "priv" field.
+func PrivNotIn(vs ...int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldPriv, vs...))
+}
+
+// PrivGT applies the GT predicate on the "priv" field.
+func PrivGT(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldPriv, v))
+}
+
+// PrivGTE applies the GTE predicate on the "priv" field.
+func PrivGTE(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldPriv, v))
+}
+
+// PrivLT applies the LT predicate on the "priv" field.
+func PrivLT(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldPriv, v))
+}
+
+// PrivLTE applies the LTE predicate on the "priv" field.
+func PrivLTE(v int) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldPriv, v))
+}
+
+// ContributionEQ applies the EQ predicate on the "contribution" field.
+func ContributionEQ(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldContribution, v))
+}
+
+// ContributionNEQ applies the NEQ predicate on the "contribution" field.
+func ContributionNEQ(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldContribution, v))
+}
+
+// ContributionIn applies the In predicate on the "contribution" field.
+func ContributionIn(vs ...int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldContribution, vs...))
+}
+
+// ContributionNotIn applies the NotIn predicate on the "contribution" field.
+func ContributionNotIn(vs ...int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldContribution, vs...))
+}
+
+// ContributionGT applies the GT predicate on the "contribution" field.
+func ContributionGT(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldContribution, v))
+}
+
+// ContributionGTE applies the GTE predicate on the "contribution" field.
+func ContributionGTE(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldContribution, v))
+}
+
+// ContributionLT applies the LT predicate on the "contribution" field.
+func ContributionLT(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldContribution, v))
+}
+
+// ContributionLTE applies the LTE predicate on the "contribution" field.
+func ContributionLTE(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldContribution, v))
+}
+
+// CanExContributionEQ applies the EQ predicate on the "can_ex_contribution" field.
+func CanExContributionEQ(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldCanExContribution, v))
+}
+
+// CanExContributionNEQ applies the NEQ predicate on the "can_ex_contribution" field.
+func CanExContributionNEQ(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldCanExContribution, v))
+}
+
+// CanExContributionIn applies the In predicate on the "can_ex_contribution" field.
+func CanExContributionIn(vs ...int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldCanExContribution, vs...))
+}
+
+// CanExContributionNotIn applies the NotIn predicate on the "can_ex_contribution" field.
+func CanExContributionNotIn(vs ...int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldCanExContribution, vs...))
+}
+
+// CanExContributionGT applies the GT predicate on the "can_ex_contribution" field.
+func CanExContributionGT(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldCanExContribution, v))
+}
+
+// CanExContributionGTE applies the GTE predicate on the "can_ex_contribution" field.
+func CanExContributionGTE(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldCanExContribution, v))
+}
+
+// CanExContributionLT applies the LT predicate on the "can_ex_contribution" field.
+func CanExContributionLT(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldCanExContribution, v))
+}
+
+// CanExContributionLTE applies the LTE predicate on the "can_ex_contribution" field.
+func CanExContributionLTE(v int64) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldCanExContribution, v))
+}
+
+// IsShowEQ applies the EQ predicate on the "is_show" field.
+func IsShowEQ(v bool) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldIsShow, v))
+}
+
+// IsShowNEQ applies the NEQ predicate on the "is_show" field.
+func IsShowNEQ(v bool) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldIsShow, v))
+}
+
+// JoinedAtEQ applies the EQ predicate on the "joined_at" field.
+func JoinedAtEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtNEQ applies the NEQ predicate on the "joined_at" field.
+func JoinedAtNEQ(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtIn applies the In predicate on the "joined_at" field.
+func JoinedAtIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtNotIn applies the NotIn predicate on the "joined_at" field.
+func JoinedAtNotIn(vs ...time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldNotIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtGT applies the GT predicate on the "joined_at" field.
+func JoinedAtGT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGT(FieldJoinedAt, v))
+}
+
+// JoinedAtGTE applies the GTE predicate on the "joined_at" field.
+func JoinedAtGTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldGTE(FieldJoinedAt, v))
+}
+
+// JoinedAtLT applies the LT predicate on the "joined_at" field.
+func JoinedAtLT(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLT(FieldJoinedAt, v))
+}
+
+// JoinedAtLTE applies the LTE predicate on the "joined_at" field.
+func JoinedAtLTE(v time.Time) predicate.TeamMember {
+	return predicate.TeamMember(sql.FieldLTE(FieldJoinedAt, v))
+}
+
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.TeamMember {
+	return predicate.TeamMember(func(s *sql.Selector) {
+		step := newTeamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamMember) predicate.TeamMember {
+	return predicate.TeamMember(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamMember) predicate.TeamMember {
+	return predicate.TeamMember(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamMember) predicate.TeamMember {
+	return predicate.TeamMember(sql.NotPredicates(p))
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamMemberCreate is the builder for creating a TeamMember entity.
+type TeamMemberCreate struct {
+	config
+	mutation *TeamMemberMutation
+	hooks    []Hook
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *TeamMemberCreate) SetTeamID(v int) *TeamMemberCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TeamMemberCreate) SetUserID(v int64) *TeamMemberCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNick sets the "nick" field.
+func (_c *TeamMemberCreate) SetNick(v string) *TeamMemberCreate {
+	_c.mutation.SetNick(v)
+	return _c
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_c *TeamMemberCreate) SetNillableNick(v *string) *TeamMemberCreate {
+	if v != nil {
+		_c.SetNick(*v)
+	}
+	return _c
+}
+
+// SetPriv sets the "priv" field.
+func (_c *TeamMemberCreate) SetPriv(v int) *TeamMemberCreate {
+	_c.mutation.SetPriv(v)
+	return _c
+}
+
+// SetNillablePriv sets the "priv" field if the given value is not nil.
+func (_c *TeamMemberCreate) SetNillablePriv(v *int) *TeamMemberCreate {
+	if v != nil {
+		_c.SetPriv(*v)
+	}
+	return _c
+}
+
+// SetContribution sets the "contribution" field.
+func (_c *TeamMemberCreate) SetContribution(v int64) *TeamMemberCreate {
+	_c.mutation.SetContribution(v)
+	return _c
+}
+
+// SetNillableContribution sets the "contribution" field if the given value is not nil.
+func (_c *TeamMemberCreate) SetNillableContribution(v *int64) *TeamMemberCreate {
+	if v != nil {
+		_c.SetContribution(*v)
+	}
+	return _c
+}
+
+// SetCanExContribution sets the "can_ex_contribution" field.
+func (_c *TeamMemberCreate) SetCanExContribution(v int64) *TeamMemberCreate {
+	_c.mutation.SetCanExContribution(v)
+	return _c
+}
+
+// SetNillableCanExContribution sets the "can_ex_contribution" field if the given value is not nil.
+func (_c *TeamMemberCreate) SetNillableCanExContribution(v *int64) *TeamMemberCreate {
+	if v != nil {
+		_c.SetCanExContribution(*v)
+	}
+	return _c
+}
+
+// SetIsShow sets the "is_show" field.
+func (_c *TeamMemberCreate) SetIsShow(v bool) *TeamMemberCreate {
+	_c.mutation.SetIsShow(v)
+	return _c
+}
+
+// SetNillableIsShow sets the "is_show" field if the given value is not nil.
+func (_c *TeamMemberCreate) SetNillableIsShow(v *bool) *TeamMemberCreate {
+	if v != nil {
+		_c.SetIsShow(*v)
+	}
+	return _c
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_c *TeamMemberCreate) SetJoinedAt(v time.Time) *TeamMemberCreate {
+	_c.mutation.SetJoinedAt(v)
+	return _c
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_c *TeamMemberCreate) SetNillableJoinedAt(v *time.Time) *TeamMemberCreate {
+	if v != nil {
+		_c.SetJoinedAt(*v)
+	}
+	return _c
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (_c *TeamMemberCreate) SetTeam(v *Team) *TeamMemberCreate {
+	return _c.SetTeamID(v.ID)
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (_c *TeamMemberCreate) Mutation() *TeamMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the TeamMember in the database.
+func (_c *TeamMemberCreate) Save(ctx context.Context) (*TeamMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TeamMemberCreate) SaveX(ctx context.Context) *TeamMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TeamMemberCreate) defaults() {
+	if _, ok := _c.mutation.Nick(); !ok {
+		v := teammember.DefaultNick
+		_c.mutation.SetNick(v)
+	}
+	if _, ok := _c.mutation.Priv(); !ok {
+		v := teammember.DefaultPriv
+		_c.mutation.SetPriv(v)
+	}
+	if _, ok := _c.mutation.Contribution(); !ok {
+		v := teammember.DefaultContribution
+		_c.mutation.SetContribution(v)
+	}
+	if _, ok := _c.mutation.CanExContribution(); !ok {
+		v := teammember.DefaultCanExContribution
+		_c.mutation.SetCanExContribution(v)
+	}
+	if _, ok := _c.mutation.IsShow(); !ok {
+		v := teammember.DefaultIsShow
+		_c.mutation.SetIsShow(v)
+	}
+	if _, ok := _c.mutation.JoinedAt(); !ok {
+		v := teammember.DefaultJoinedAt()
+		_c.mutation.SetJoinedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TeamMemberCreate) check() error {
+	if _, ok := _c.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "TeamMember.team_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TeamMember.user_id"`)}
+	}
+	if _, ok := _c.mutation.Nick(); !ok {
+		return &ValidationError{Name: "nick", err: errors.New(`ent: missing required field "TeamMember.nick"`)}
+	}
+	if _, ok := _c.mutation.Priv(); !ok {
+		return &ValidationError{Name: "priv", err: errors.New(`ent: missing required field "TeamMember.priv"`)}
+	}
+	if _, ok := _c.mutation.Contribution(); !ok {
+		return &ValidationError{Name: "contribution", err: errors.New(`ent: missing required field "TeamMember.contribution"`)}
+	}
+	if _, ok := _c.mutation.CanExContribution(); !ok {
+		return &ValidationError{Name: "can_ex_contribution", err: errors.New(`ent: missing required field "TeamMember.can_ex_contribution"`)}
+	}
+	if _, ok := _c.mutation.IsShow(); !ok {
+		return &ValidationError{Name: "is_show", err: errors.New(`ent: missing required field "TeamMember.is_show"`)}
+	}
+	if _, ok := _c.mutation.JoinedAt(); !ok {
+		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "TeamMember.joined_at"`)}
+	}
+	if len(_c.mutation.TeamIDs()) == 0 {
+		return &ValidationError{Name: "team", err: errors.New(`ent: missing required edge "TeamMember.team"`)}
+	}
+	return nil
+}
+
+func (_c *TeamMemberCreate) sqlSave(ctx context.Context) (*TeamMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TeamMemberCreate) createSpec() (*TeamMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(teammember.Table, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(teammember.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Nick(); ok {
+		_spec.SetField(teammember.FieldNick, field.TypeString, value)
+		_node.Nick = value
+	}
+	if value, ok := _c.mutation.Priv(); ok {
+		_spec.SetField(teammember.FieldPriv, field.TypeInt, value)
+		_node.Priv = value
+	}
+	if value, ok := _c.mutation.Contribution(); ok {
+		_spec.SetField(teammember.FieldContribution, field.TypeInt64, value)
+		_node.Contribution = value
+	}
+	if value, ok := _c.mutation.CanExContribution(); ok {
+		_spec.SetField(teammember.FieldCanExContribution, field.TypeInt64, value)
+		_node.CanExContribution = value
+	}
+	if value, ok := _c.mutation.IsShow(); ok {
+		_spec.SetField(teammember.FieldIsShow, field.TypeBool, value)
+		_node.IsShow = value
+	}
+	if value, ok := _c.mutation.JoinedAt(); ok {
+		_spec.SetField(teammember.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if nodes := _c.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.TeamTable,
+			Columns: []string{teammember.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TeamMemberCreateBulk is the builder for creating many TeamMember entities in bulk.
+type TeamMemberCreateBulk struct {
+	config
+	err      error
+	builders []*TeamMemberCreate
+}
+
+// Save creates the TeamMember entities in the database.
+func (_c *TeamMemberCreateBulk) Save(ctx context.Context) ([]*TeamMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TeamMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TeamMemberCreateBulk) SaveX(ctx context.Context) []*TeamMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
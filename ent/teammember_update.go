@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/predicate"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamMemberUpdate is the builder for updating TeamMember entities.
+type TeamMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamMemberMutation
+}
+
+// Where appends a list predicates to the TeamMemberUpdate builder.
+func (_u *TeamMemberUpdate) Where(ps ...predicate.TeamMember) *TeamMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamMemberUpdate) SetTeamID(v int) *TeamMemberUpdate {
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillableTeamID(v *int) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TeamMemberUpdate) SetUserID(v int64) *TeamMemberUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillableUserID(v *int64) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TeamMemberUpdate) AddUserID(v int64) *TeamMemberUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetNick sets the "nick" field.
+func (_u *TeamMemberUpdate) SetNick(v string) *TeamMemberUpdate {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillableNick(v *string) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// SetPriv sets the "priv" field.
+func (_u *TeamMemberUpdate) SetPriv(v int) *TeamMemberUpdate {
+	_u.mutation.ResetPriv()
+	_u.mutation.SetPriv(v)
+	return _u
+}
+
+// SetNillablePriv sets the "priv" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillablePriv(v *int) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetPriv(*v)
+	}
+	return _u
+}
+
+// AddPriv adds value to the "priv" field.
+func (_u *TeamMemberUpdate) AddPriv(v int) *TeamMemberUpdate {
+	_u.mutation.AddPriv(v)
+	return _u
+}
+
+// SetContribution sets the "contribution" field.
+func (_u *TeamMemberUpdate) SetContribution(v int64) *TeamMemberUpdate {
+	_u.mutation.ResetContribution()
+	_u.mutation.SetContribution(v)
+	return _u
+}
+
+// SetNillableContribution sets the "contribution" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillableContribution(v *int64) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetContribution(*v)
+	}
+	return _u
+}
+
+// AddContribution adds value to the "contribution" field.
+func (_u *TeamMemberUpdate) AddContribution(v int64) *TeamMemberUpdate {
+	_u.mutation.AddContribution(v)
+	return _u
+}
+
+// SetCanExContribution sets the "can_ex_contribution" field.
+func (_u *TeamMemberUpdate) SetCanExContribution(v int64) *TeamMemberUpdate {
+	_u.mutation.ResetCanExContribution()
+	_u.mutation.SetCanExContribution(v)
+	return _u
+}
+
+// SetNillableCanExContribution sets the "can_ex_contribution" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillableCanExContribution(v *int64) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetCanExContribution(*v)
+	}
+	return _u
+}
+
+// AddCanExContribution adds value to the "can_ex_contribution" field.
+func (_u *TeamMemberUpdate) AddCanExContribution(v int64) *TeamMemberUpdate {
+	_u.mutation.AddCanExContribution(v)
+	return _u
+}
+
+// SetIsShow sets the "is_show" field.
+func (_u *TeamMemberUpdate) SetIsShow(v bool) *TeamMemberUpdate {
+	_u.mutation.SetIsShow(v)
+	return _u
+}
+
+// SetNillableIsShow sets the "is_show" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillableIsShow(v *bool) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetIsShow(*v)
+	}
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *TeamMemberUpdate) SetJoinedAt(v time.Time) *TeamMemberUpdate {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *TeamMemberUpdate) SetNillableJoinedAt(v *time.Time) *TeamMemberUpdate {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (_u *TeamMemberUpdate) SetTeam(v *Team) *TeamMemberUpdate {
+	return _u.SetTeamID(v.ID)
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (_u *TeamMemberUpdate) Mutation() *TeamMemberMutation {
+	return _u.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (_u *TeamMemberUpdate) ClearTeam() *TeamMemberUpdate {
+	_u.mutation.ClearTeam()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TeamMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TeamMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TeamMemberUpdate) check() error {
+	if _u.mutation.TeamCleared() && len(_u.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TeamMember.team"`)
+	}
+	return nil
+}
+
+func (_u *TeamMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teammember.Table, teammember.Columns, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(teammember.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(teammember.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(teammember.FieldNick, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priv(); ok {
+		_spec.SetField(teammember.FieldPriv, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriv(); ok {
+		_spec.AddField(teammember.FieldPriv, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Contribution(); ok {
+		_spec.SetField(teammember.FieldContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedContribution(); ok {
+		_spec.AddField(teammember.FieldContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CanExContribution(); ok {
+		_spec.SetField(teammember.FieldCanExContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCanExContribution(); ok {
+		_spec.AddField(teammember.FieldCanExContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.IsShow(); ok {
+		_spec.SetField(teammember.FieldIsShow, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(teammember.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.TeamTable,
+			Columns: []string{teammember.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.TeamTable,
+			Columns: []string{teammember.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TeamMemberUpdateOne is the builder for updating a single TeamMember entity.
+type TeamMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamMemberMutation
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamMemberUpdateOne) SetTeamID(v int) *TeamMemberUpdateOne {
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillableTeamID(v *int) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TeamMemberUpdateOne) SetUserID(v int64) *TeamMemberUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillableUserID(v *int64) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TeamMemberUpdateOne) AddUserID(v int64) *TeamMemberUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetNick sets the "nick" field.
+func (_u *TeamMemberUpdateOne) SetNick(v string) *TeamMemberUpdateOne {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillableNick(v *string) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// SetPriv sets the "priv" field.
+func (_u *TeamMemberUpdateOne) SetPriv(v int) *TeamMemberUpdateOne {
+	_u.mutation.ResetPriv()
+	_u.mutation.SetPriv(v)
+	return _u
+}
+
+// SetNillablePriv sets the "priv" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillablePriv(v *int) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetPriv(*v)
+	}
+	return _u
+}
+
+// AddPriv adds value to the "priv" field.
+func (_u *TeamMemberUpdateOne) AddPriv(v int) *TeamMemberUpdateOne {
+	_u.mutation.AddPriv(v)
+	return _u
+}
+
+// SetContribution sets the "contribution" field.
+func (_u *TeamMemberUpdateOne) SetContribution(v int64) *TeamMemberUpdateOne {
+	_u.mutation.ResetContribution()
+	_u.mutation.SetContribution(v)
+	return _u
+}
+
+// SetNillableContribution sets the "contribution" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillableContribution(v *int64) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetContribution(*v)
+	}
+	return _u
+}
+
+// AddContribution adds value to the "contribution" field.
+func (_u *TeamMemberUpdateOne) AddContribution(v int64) *TeamMemberUpdateOne {
+	_u.mutation.AddContribution(v)
+	return _u
+}
+
+// SetCanExContribution sets the "can_ex_contribution" field.
+func (_u *TeamMemberUpdateOne) SetCanExContribution(v int64) *TeamMemberUpdateOne {
+	_u.mutation.ResetCanExContribution()
+	_u.mutation.SetCanExContribution(v)
+	return _u
+}
+
+// SetNillableCanExContribution sets the "can_ex_contribution" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillableCanExContribution(v *int64) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetCanExContribution(*v)
+	}
+	return _u
+}
+
+// AddCanExContribution adds value to the "can_ex_contribution" field.
+func (_u *TeamMemberUpdateOne) AddCanExContribution(v int64) *TeamMemberUpdateOne {
+	_u.mutation.AddCanExContribution(v)
+	return _u
+}
+
+// SetIsShow sets the "is_show" field.
+func (_u *TeamMemberUpdateOne) SetIsShow(v bool) *TeamMemberUpdateOne {
+	_u.mutation.SetIsShow(v)
+	return _u
+}
+
+// SetNillableIsShow sets the "is_show" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillableIsShow(v *bool) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetIsShow(*v)
+	}
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *TeamMemberUpdateOne) SetJoinedAt(v time.Time) *TeamMemberUpdateOne {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *TeamMemberUpdateOne) SetNillableJoinedAt(v *time.Time) *TeamMemberUpdateOne {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (_u *TeamMemberUpdateOne) SetTeam(v *Team) *TeamMemberUpdateOne {
+	return _u.SetTeamID(v.ID)
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (_u *TeamMemberUpdateOne) Mutation() *TeamMemberMutation {
+	return _u.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (_u *TeamMemberUpdateOne) ClearTeam() *TeamMemberUpdateOne {
+	_u.mutation.ClearTeam()
+	return _u
+}
+
+// Where appends a list predicates to the TeamMemberUpdate builder.
+func (_u *TeamMemberUpdateOne) Where(ps ...predicate.TeamMember) *TeamMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TeamMemberUpdateOne) Select(field string, fields ...string) *TeamMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TeamMember entity.
+func (_u *TeamMemberUpdateOne) Save(ctx context.Context) (*TeamMember, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamMemberUpdateOne) SaveX(ctx context.Context) *TeamMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TeamMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TeamMemberUpdateOne) check() error {
+	if _u.mutation.TeamCleared() && len(_u.mutation.TeamIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TeamMember.team"`)
+	}
+	return nil
+}
+
+func (_u *TeamMemberUpdateOne) sqlSave(ctx context.Context) (_node *TeamMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teammember.Table, teammember.Columns, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teammember.FieldID)
+		for _, f := range fields {
+			if !teammember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teammember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(teammember.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(teammember.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(teammember.FieldNick, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priv(); ok {
+		_spec.SetField(teammember.FieldPriv, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriv(); ok {
+		_spec.AddField(teammember.FieldPriv, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Contribution(); ok {
+		_spec.SetField(teammember.FieldContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedContribution(); ok {
+		_spec.AddField(teammember.FieldContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CanExContribution(); ok {
+		_spec.SetField(teammember.FieldCanExContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCanExContribution(); ok {
+		_spec.AddField(teammember.FieldCanExContribution, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.IsShow(); ok {
+		_spec.SetField(teammember.FieldIsShow, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(teammember.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.TeamTable,
+			Columns: []string{teammember.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teammember.TeamTable,
+			Columns: []string{teammember.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TeamMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jseer/ent/teampkhistory"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TeamPkHistory is the model entity for the TeamPkHistory schema.
+type TeamPkHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int64 `json:"team_id,omitempty"`
+	// Season holds the value of the "season" field.
+	Season int `json:"season,omitempty"`
+	// OpponentID holds the value of the "opponent_id" field.
+	OpponentID int64 `json:"opponent_id,omitempty"`
+	// OpponentName holds the value of the "opponent_name" field.
+	OpponentName string `json:"opponent_name,omitempty"`
+	// Result holds the value of the "result" field.
+	Result int `json:"result,omitempty"`
+	// Score holds the value of the "score" field.
+	Score int `json:"score,omitempty"`
+	// BuildingHp holds the value of the "building_hp" field.
+	BuildingHp int `json:"building_hp,omitempty"`
+	// OpponentBuildingHp holds the value of the "opponent_building_hp" field.
+	OpponentBuildingHp int `json:"opponent_building_hp,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamPkHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teampkhistory.FieldID, teampkhistory.FieldTeamID, teampkhistory.FieldSeason, teampkhistory.FieldOpponentID, teampkhistory.FieldResult, teampkhistory.FieldScore, teampkhistory.FieldBuildingHp, teampkhistory.FieldOpponentBuildingHp:
+			values[i] = new(sql.NullInt64)
+		case teampkhistory.FieldOpponentName:
+			values[i] = new(sql.NullString)
+		case teampkhistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamPkHistory fields.
+func (_m *TeamPkHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teampkhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case teampkhistory.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = value.Int64
+			}
+		case teampkhistory.FieldSeason:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season", values[i])
+			} else if value.Valid {
+				_m.Season = int(value.Int64)
+			}
+		case teampkhistory.FieldOpponentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field opponent_id", values[i])
+			} else if value.Valid {
+				_m.OpponentID = value.Int64
+			}
+		case teampkhistory.FieldOpponentName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field opponent_name", values[i])
+			} else if value.Valid {
+				_m.OpponentName = value.String
+			}
+		case teampkhistory.FieldResult:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				_m.Result = int(value.Int64)
+			}
+		case teampkhistory.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		case teampkhistory.FieldBuildingHp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field building_hp", values[i])
+			} else if value.Valid {
+				_m.BuildingHp = int(value.Int64)
+			}
+		case teampkhistory.FieldOpponentBuildingHp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field opponent_building_hp", values[i])
+			} else if value.Valid {
+				_m.OpponentBuildingHp = int(value.Int64)
+			}
+		case teampkhistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamPkHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *TeamPkHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamPkHistory.
+// Note that you need to call TeamPkHistory.Unwrap() before calling this method if this TeamPkHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TeamPkHistory) Update() *TeamPkHistoryUpdateOne {
+	return NewTeamPkHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TeamPkHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TeamPkHistory) Unwrap() *TeamPkHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamPkHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TeamPkHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamPkHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("season=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Season))
+	builder.WriteString(", ")
+	builder.WriteString("opponent_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpponentID))
+	builder.WriteString(", ")
+	builder.WriteString("opponent_name=")
+	builder.WriteString(_m.OpponentName)
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Result))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("building_hp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BuildingHp))
+	builder.WriteString(", ")
+	builder.WriteString("opponent_building_hp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpponentBuildingHp))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamPkHistories is a parsable slice of TeamPkHistory.
+type TeamPkHistories []*TeamPkHistory
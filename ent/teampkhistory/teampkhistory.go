@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teampkhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the teampkhistory type in the database.
+	Label = "team_pk_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldSeason holds the string denoting the season field in the database.
+	FieldSeason = "season"
+	// FieldOpponentID holds the string denoting the opponent_id field in the database.
+	FieldOpponentID = "opponent_id"
+	// FieldOpponentName holds the string denoting the opponent_name field in the database.
+	FieldOpponentName = "opponent_name"
+	// FieldResult holds the string denoting the result field in the database.
+	FieldResult = "result"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldBuildingHp holds the string denoting the building_hp field in the database.
+	FieldBuildingHp = "building_hp"
+	// FieldOpponentBuildingHp holds the string denoting the opponent_building_hp field in the database.
+	FieldOpponentBuildingHp = "opponent_building_hp"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the teampkhistory in the database.
+	Table = "team_pk_histories"
+)
+
+// Columns holds all SQL columns for teampkhistory fields.
+var Columns = []string{
+	FieldID,
+	FieldTeamID,
+	FieldSeason,
+	FieldOpponentID,
+	FieldOpponentName,
+	FieldResult,
+	FieldScore,
+	FieldBuildingHp,
+	FieldOpponentBuildingHp,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOpponentName holds the default value on creation for the "opponent_name" field.
+	DefaultOpponentName string
+	// DefaultResult holds the default value on creation for the "result" field.
+	DefaultResult int
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int
+	// DefaultBuildingHp holds the default value on creation for the "building_hp" field.
+	DefaultBuildingHp int
+	// DefaultOpponentBuildingHp holds the default value on creation for the "opponent_building_hp" field.
+	DefaultOpponentBuildingHp int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TeamPkHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// BySeason orders the results by the season field.
+func BySeason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeason, opts...).ToFunc()
+}
+
+// ByOpponentID orders the results by the opponent_id field.
+func ByOpponentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpponentID, opts...).ToFunc()
+}
+
+// ByOpponentName orders the results by the opponent_name field.
+func ByOpponentName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpponentName, opts...).ToFunc()
+}
+
+// ByResult orders the results by the result field.
+func ByResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResult, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByBuildingHp orders the results by the building_hp field.
+func ByBuildingHp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildingHp, opts...).ToFunc()
+}
+
+// ByOpponentBuildingHp orders the results by the opponent_building_hp field.
+func ByOpponentBuildingHp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpponentBuildingHp, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
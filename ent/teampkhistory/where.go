@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teampkhistory
+
+import (
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldID, id))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Season applies equality check predicate on the "season" field. It's identical to SeasonEQ.
+func Season(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldSeason, v))
+}
+
+// OpponentID applies equality check predicate on the "opponent_id" field. It's identical to OpponentIDEQ.
+func OpponentID(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldOpponentID, v))
+}
+
+// OpponentName applies equality check predicate on the "opponent_name" field. It's identical to OpponentNameEQ.
+func OpponentName(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldOpponentName, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldResult, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldScore, v))
+}
+
+// BuildingHp applies equality check predicate on the "building_hp" field. It's identical to BuildingHpEQ.
+func BuildingHp(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldBuildingHp, v))
+}
+
+// OpponentBuildingHp applies equality check predicate on the "opponent_building_hp" field. It's identical to OpponentBuildingHpEQ.
+func OpponentBuildingHp(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldOpponentBuildingHp, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldTeamID, v))
+}
+
+// SeasonEQ applies the EQ predicate on the "season" field.
+func SeasonEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldSeason, v))
+}
+
+// SeasonNEQ applies the NEQ predicate on the "season" field.
+func SeasonNEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldSeason, v))
+}
+
+// SeasonIn applies the In predicate on the "season" field.
+func SeasonIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldSeason, vs...))
+}
+
+// SeasonNotIn applies the NotIn predicate on the "season" field.
+func SeasonNotIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldSeason, vs...))
+}
+
+// SeasonGT applies the GT predicate on the "season" field.
+func SeasonGT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldSeason, v))
+}
+
+// SeasonGTE applies the GTE predicate on the "season" field.
+func SeasonGTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldSeason, v))
+}
+
+// SeasonLT applies the LT predicate on the "season" field.
+func SeasonLT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldSeason, v))
+}
+
+// SeasonLTE applies the LTE predicate on the "season" field.
+func SeasonLTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldSeason, v))
+}
+
+// OpponentIDEQ applies the EQ predicate on the "opponent_id" field.
+func OpponentIDEQ(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldOpponentID, v))
+}
+
+// OpponentIDNEQ applies the NEQ predicate on the "opponent_id" field.
+func OpponentIDNEQ(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldOpponentID, v))
+}
+
+// OpponentIDIn applies the In predicate on the "opponent_id" field.
+func OpponentIDIn(vs ...int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldOpponentID, vs...))
+}
+
+// OpponentIDNotIn applies the NotIn predicate on the "opponent_id" field.
+func OpponentIDNotIn(vs ...int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldOpponentID, vs...))
+}
+
+// OpponentIDGT applies the GT predicate on the "opponent_id" field.
+func OpponentIDGT(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldOpponentID, v))
+}
+
+// OpponentIDGTE applies the GTE predicate on the "opponent_id" field.
+func OpponentIDGTE(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldOpponentID, v))
+}
+
+// OpponentIDLT applies the LT predicate on the "opponent_id" field.
+func OpponentIDLT(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldOpponentID, v))
+}
+
+// OpponentIDLTE applies the LTE predicate on the "opponent_id" field.
+func OpponentIDLTE(v int64) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldOpponentID, v))
+}
+
+// OpponentNameEQ applies the EQ predicate on the "opponent_name" field.
+func OpponentNameEQ(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldOpponentName, v))
+}
+
+// OpponentNameNEQ applies the NEQ predicate on the "opponent_name" field.
+func OpponentNameNEQ(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldOpponentName, v))
+}
+
+// OpponentNameIn applies the In predicate on the "opponent_name" field.
+func OpponentNameIn(vs ...string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldOpponentName, vs...))
+}
+
+// OpponentNameNotIn applies the NotIn predicate on the "opponent_name" field.
+func OpponentNameNotIn(vs ...string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldOpponentName, vs...))
+}
+
+// OpponentNameGT applies the GT predicate on the "opponent_name" field.
+func OpponentNameGT(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldOpponentName, v))
+}
+
+// OpponentNameGTE applies the GTE predicate on the "opponent_name" field.
+func OpponentNameGTE(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldOpponentName, v))
+}
+
+// OpponentNameLT applies the LT predicate on the "opponent_name" field.
+func OpponentNameLT(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldOpponentName, v))
+}
+
+// OpponentNameLTE applies the LTE predicate on the "opponent_name" field.
+func OpponentNameLTE(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldOpponentName, v))
+}
+
+// OpponentNameContains applies the Contains predicate on the "opponent_name" field.
+func OpponentNameContains(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldContains(FieldOpponentName, v))
+}
+
+// OpponentNameHasPrefix applies the HasPrefix predicate on the "opponent_name" field.
+func OpponentNameHasPrefix(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldHasPrefix(FieldOpponentName, v))
+}
+
+// OpponentNameHasSuffix applies the HasSuffix predicate on the "opponent_name" field.
+func OpponentNameHasSuffix(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldHasSuffix(FieldOpponentName, v))
+}
+
+// OpponentNameEqualFold applies the EqualFold predicate on the "opponent_name" field.
+func OpponentNameEqualFold(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEqualFold(FieldOpponentName, v))
+}
+
+// OpponentNameContainsFold applies the ContainsFold predicate on the "opponent_name" field.
+func OpponentNameContainsFold(v string) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldContainsFold(FieldOpponentName, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldResult, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldScore, v))
+}
+
+// BuildingHpEQ applies the EQ predicate on the "building_hp" field.
+func BuildingHpEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldBuildingHp, v))
+}
+
+// BuildingHpNEQ applies the NEQ predicate on the "building_hp" field.
+func BuildingHpNEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldBuildingHp, v))
+}
+
+// BuildingHpIn applies the In predicate on the "building_hp" field.
+func BuildingHpIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldBuildingHp, vs...))
+}
+
+// BuildingHpNotIn applies the NotIn predicate on the "building_hp" field.
+func BuildingHpNotIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldBuildingHp, vs...))
+}
+
+// BuildingHpGT applies the GT predicate on the "building_hp" field.
+func BuildingHpGT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldBuildingHp, v))
+}
+
+// BuildingHpGTE applies the GTE predicate on the "building_hp" field.
+func BuildingHpGTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldBuildingHp, v))
+}
+
+// BuildingHpLT applies the LT predicate on the "building_hp" field.
+func BuildingHpLT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldBuildingHp, v))
+}
+
+// BuildingHpLTE applies the LTE predicate on the "building_hp" field.
+func BuildingHpLTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldBuildingHp, v))
+}
+
+// OpponentBuildingHpEQ applies the EQ predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldOpponentBuildingHp, v))
+}
+
+// OpponentBuildingHpNEQ applies the NEQ predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpNEQ(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldOpponentBuildingHp, v))
+}
+
+// OpponentBuildingHpIn applies the In predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldOpponentBuildingHp, vs...))
+}
+
+// OpponentBuildingHpNotIn applies the NotIn predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpNotIn(vs ...int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldOpponentBuildingHp, vs...))
+}
+
+// OpponentBuildingHpGT applies the GT predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpGT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldOpponentBuildingHp, v))
+}
+
+// OpponentBuildingHpGTE applies the GTE predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpGTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldOpponentBuildingHp, v))
+}
+
+// OpponentBuildingHpLT applies the LT predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpLT(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldOpponentBuildingHp, v))
+}
+
+// OpponentBuildingHpLTE applies the LTE predicate on the "opponent_building_hp" field.
+func OpponentBuildingHpLTE(v int) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldOpponentBuildingHp, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamPkHistory) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamPkHistory) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamPkHistory) predicate.TeamPkHistory {
+	return predicate.TeamPkHistory(sql.NotPredicates(p))
+}
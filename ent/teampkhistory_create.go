@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/teampkhistory"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamPkHistoryCreate is the builder for creating a TeamPkHistory entity.
+type TeamPkHistoryCreate struct {
+	config
+	mutation *TeamPkHistoryMutation
+	hooks    []Hook
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *TeamPkHistoryCreate) SetTeamID(v int64) *TeamPkHistoryCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetSeason sets the "season" field.
+func (_c *TeamPkHistoryCreate) SetSeason(v int) *TeamPkHistoryCreate {
+	_c.mutation.SetSeason(v)
+	return _c
+}
+
+// SetOpponentID sets the "opponent_id" field.
+func (_c *TeamPkHistoryCreate) SetOpponentID(v int64) *TeamPkHistoryCreate {
+	_c.mutation.SetOpponentID(v)
+	return _c
+}
+
+// SetOpponentName sets the "opponent_name" field.
+func (_c *TeamPkHistoryCreate) SetOpponentName(v string) *TeamPkHistoryCreate {
+	_c.mutation.SetOpponentName(v)
+	return _c
+}
+
+// SetNillableOpponentName sets the "opponent_name" field if the given value is not nil.
+func (_c *TeamPkHistoryCreate) SetNillableOpponentName(v *string) *TeamPkHistoryCreate {
+	if v != nil {
+		_c.SetOpponentName(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *TeamPkHistoryCreate) SetResult(v int) *TeamPkHistoryCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_c *TeamPkHistoryCreate) SetNillableResult(v *int) *TeamPkHistoryCreate {
+	if v != nil {
+		_c.SetResult(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *TeamPkHistoryCreate) SetScore(v int) *TeamPkHistoryCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *TeamPkHistoryCreate) SetNillableScore(v *int) *TeamPkHistoryCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetBuildingHp sets the "building_hp" field.
+func (_c *TeamPkHistoryCreate) SetBuildingHp(v int) *TeamPkHistoryCreate {
+	_c.mutation.SetBuildingHp(v)
+	return _c
+}
+
+// SetNillableBuildingHp sets the "building_hp" field if the given value is not nil.
+func (_c *TeamPkHistoryCreate) SetNillableBuildingHp(v *int) *TeamPkHistoryCreate {
+	if v != nil {
+		_c.SetBuildingHp(*v)
+	}
+	return _c
+}
+
+// SetOpponentBuildingHp sets the "opponent_building_hp" field.
+func (_c *TeamPkHistoryCreate) SetOpponentBuildingHp(v int) *TeamPkHistoryCreate {
+	_c.mutation.SetOpponentBuildingHp(v)
+	return _c
+}
+
+// SetNillableOpponentBuildingHp sets the "opponent_building_hp" field if the given value is not nil.
+func (_c *TeamPkHistoryCreate) SetNillableOpponentBuildingHp(v *int) *TeamPkHistoryCreate {
+	if v != nil {
+		_c.SetOpponentBuildingHp(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TeamPkHistoryCreate) SetCreatedAt(v time.Time) *TeamPkHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TeamPkHistoryCreate) SetNillableCreatedAt(v *time.Time) *TeamPkHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TeamPkHistoryMutation object of the builder.
+func (_c *TeamPkHistoryCreate) Mutation() *TeamPkHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the TeamPkHistory in the database.
+func (_c *TeamPkHistoryCreate) Save(ctx context.Context) (*TeamPkHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TeamPkHistoryCreate) SaveX(ctx context.Context) *TeamPkHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamPkHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamPkHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TeamPkHistoryCreate) defaults() {
+	if _, ok := _c.mutation.OpponentName(); !ok {
+		v := teampkhistory.DefaultOpponentName
+		_c.mutation.SetOpponentName(v)
+	}
+	if _, ok := _c.mutation.Result(); !ok {
+		v := teampkhistory.DefaultResult
+		_c.mutation.SetResult(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := teampkhistory.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.BuildingHp(); !ok {
+		v := teampkhistory.DefaultBuildingHp
+		_c.mutation.SetBuildingHp(v)
+	}
+	if _, ok := _c.mutation.OpponentBuildingHp(); !ok {
+		v := teampkhistory.DefaultOpponentBuildingHp
+		_c.mutation.SetOpponentBuildingHp(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := teampkhistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TeamPkHistoryCreate) check() error {
+	if _, ok := _c.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "TeamPkHistory.team_id"`)}
+	}
+	if _, ok := _c.mutation.Season(); !ok {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required field "TeamPkHistory.season"`)}
+	}
+	if _, ok := _c.mutation.OpponentID(); !ok {
+		return &ValidationError{Name: "opponent_id", err: errors.New(`ent: missing required field "TeamPkHistory.opponent_id"`)}
+	}
+	if _, ok := _c.mutation.OpponentName(); !ok {
+		return &ValidationError{Name: "opponent_name", err: errors.New(`ent: missing required field "TeamPkHistory.opponent_name"`)}
+	}
+	if _, ok := _c.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "TeamPkHistory.result"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "TeamPkHistory.score"`)}
+	}
+	if _, ok := _c.mutation.BuildingHp(); !ok {
+		return &ValidationError{Name: "building_hp", err: errors.New(`ent: missing required field "TeamPkHistory.building_hp"`)}
+	}
+	if _, ok := _c.mutation.OpponentBuildingHp(); !ok {
+		return &ValidationError{Name: "opponent_building_hp", err: errors.New(`ent: missing required field "TeamPkHistory.opponent_building_hp"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TeamPkHistory.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TeamPkHistoryCreate) sqlSave(ctx context.Context) (*TeamPkHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TeamPkHistoryCreate) createSpec() (*TeamPkHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamPkHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(teampkhistory.Table, sqlgraph.NewFieldSpec(teampkhistory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TeamID(); ok {
+		_spec.SetField(teampkhistory.FieldTeamID, field.TypeInt64, value)
+		_node.TeamID = value
+	}
+	if value, ok := _c.mutation.Season(); ok {
+		_spec.SetField(teampkhistory.FieldSeason, field.TypeInt, value)
+		_node.Season = value
+	}
+	if value, ok := _c.mutation.OpponentID(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentID, field.TypeInt64, value)
+		_node.OpponentID = value
+	}
+	if value, ok := _c.mutation.OpponentName(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentName, field.TypeString, value)
+		_node.OpponentName = value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(teampkhistory.FieldResult, field.TypeInt, value)
+		_node.Result = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(teampkhistory.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.BuildingHp(); ok {
+		_spec.SetField(teampkhistory.FieldBuildingHp, field.TypeInt, value)
+		_node.BuildingHp = value
+	}
+	if value, ok := _c.mutation.OpponentBuildingHp(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentBuildingHp, field.TypeInt, value)
+		_node.OpponentBuildingHp = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(teampkhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TeamPkHistoryCreateBulk is the builder for creating many TeamPkHistory entities in bulk.
+type TeamPkHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*TeamPkHistoryCreate
+}
+
+// Save creates the TeamPkHistory entities in the database.
+func (_c *TeamPkHistoryCreateBulk) Save(ctx context.Context) ([]*TeamPkHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TeamPkHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamPkHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TeamPkHistoryCreateBulk) SaveX(ctx context.Context) []*TeamPkHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamPkHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamPkHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
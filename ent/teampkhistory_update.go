@@ -0,0 +1,622 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/predicate"
+	"jseer/ent/teampkhistory"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamPkHistoryUpdate is the builder for updating TeamPkHistory entities.
+type TeamPkHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamPkHistoryMutation
+}
+
+// Where appends a list predicates to the TeamPkHistoryUpdate builder.
+func (_u *TeamPkHistoryUpdate) Where(ps ...predicate.TeamPkHistory) *TeamPkHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamPkHistoryUpdate) SetTeamID(v int64) *TeamPkHistoryUpdate {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableTeamID(v *int64) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *TeamPkHistoryUpdate) AddTeamID(v int64) *TeamPkHistoryUpdate {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *TeamPkHistoryUpdate) SetSeason(v int) *TeamPkHistoryUpdate {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableSeason(v *int) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *TeamPkHistoryUpdate) AddSeason(v int) *TeamPkHistoryUpdate {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetOpponentID sets the "opponent_id" field.
+func (_u *TeamPkHistoryUpdate) SetOpponentID(v int64) *TeamPkHistoryUpdate {
+	_u.mutation.ResetOpponentID()
+	_u.mutation.SetOpponentID(v)
+	return _u
+}
+
+// SetNillableOpponentID sets the "opponent_id" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableOpponentID(v *int64) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetOpponentID(*v)
+	}
+	return _u
+}
+
+// AddOpponentID adds value to the "opponent_id" field.
+func (_u *TeamPkHistoryUpdate) AddOpponentID(v int64) *TeamPkHistoryUpdate {
+	_u.mutation.AddOpponentID(v)
+	return _u
+}
+
+// SetOpponentName sets the "opponent_name" field.
+func (_u *TeamPkHistoryUpdate) SetOpponentName(v string) *TeamPkHistoryUpdate {
+	_u.mutation.SetOpponentName(v)
+	return _u
+}
+
+// SetNillableOpponentName sets the "opponent_name" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableOpponentName(v *string) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetOpponentName(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TeamPkHistoryUpdate) SetResult(v int) *TeamPkHistoryUpdate {
+	_u.mutation.ResetResult()
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableResult(v *int) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// AddResult adds value to the "result" field.
+func (_u *TeamPkHistoryUpdate) AddResult(v int) *TeamPkHistoryUpdate {
+	_u.mutation.AddResult(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamPkHistoryUpdate) SetScore(v int) *TeamPkHistoryUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableScore(v *int) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamPkHistoryUpdate) AddScore(v int) *TeamPkHistoryUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetBuildingHp sets the "building_hp" field.
+func (_u *TeamPkHistoryUpdate) SetBuildingHp(v int) *TeamPkHistoryUpdate {
+	_u.mutation.ResetBuildingHp()
+	_u.mutation.SetBuildingHp(v)
+	return _u
+}
+
+// SetNillableBuildingHp sets the "building_hp" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableBuildingHp(v *int) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetBuildingHp(*v)
+	}
+	return _u
+}
+
+// AddBuildingHp adds value to the "building_hp" field.
+func (_u *TeamPkHistoryUpdate) AddBuildingHp(v int) *TeamPkHistoryUpdate {
+	_u.mutation.AddBuildingHp(v)
+	return _u
+}
+
+// SetOpponentBuildingHp sets the "opponent_building_hp" field.
+func (_u *TeamPkHistoryUpdate) SetOpponentBuildingHp(v int) *TeamPkHistoryUpdate {
+	_u.mutation.ResetOpponentBuildingHp()
+	_u.mutation.SetOpponentBuildingHp(v)
+	return _u
+}
+
+// SetNillableOpponentBuildingHp sets the "opponent_building_hp" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableOpponentBuildingHp(v *int) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetOpponentBuildingHp(*v)
+	}
+	return _u
+}
+
+// AddOpponentBuildingHp adds value to the "opponent_building_hp" field.
+func (_u *TeamPkHistoryUpdate) AddOpponentBuildingHp(v int) *TeamPkHistoryUpdate {
+	_u.mutation.AddOpponentBuildingHp(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TeamPkHistoryUpdate) SetCreatedAt(v time.Time) *TeamPkHistoryUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdate) SetNillableCreatedAt(v *time.Time) *TeamPkHistoryUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TeamPkHistoryMutation object of the builder.
+func (_u *TeamPkHistoryUpdate) Mutation() *TeamPkHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TeamPkHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamPkHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TeamPkHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamPkHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TeamPkHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teampkhistory.Table, teampkhistory.Columns, sqlgraph.NewFieldSpec(teampkhistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(teampkhistory.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(teampkhistory.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(teampkhistory.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(teampkhistory.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OpponentID(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOpponentID(); ok {
+		_spec.AddField(teampkhistory.FieldOpponentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OpponentName(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(teampkhistory.FieldResult, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResult(); ok {
+		_spec.AddField(teampkhistory.FieldResult, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(teampkhistory.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(teampkhistory.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BuildingHp(); ok {
+		_spec.SetField(teampkhistory.FieldBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBuildingHp(); ok {
+		_spec.AddField(teampkhistory.FieldBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OpponentBuildingHp(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOpponentBuildingHp(); ok {
+		_spec.AddField(teampkhistory.FieldOpponentBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(teampkhistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teampkhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TeamPkHistoryUpdateOne is the builder for updating a single TeamPkHistory entity.
+type TeamPkHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamPkHistoryMutation
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamPkHistoryUpdateOne) SetTeamID(v int64) *TeamPkHistoryUpdateOne {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableTeamID(v *int64) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *TeamPkHistoryUpdateOne) AddTeamID(v int64) *TeamPkHistoryUpdateOne {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *TeamPkHistoryUpdateOne) SetSeason(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableSeason(v *int) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *TeamPkHistoryUpdateOne) AddSeason(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetOpponentID sets the "opponent_id" field.
+func (_u *TeamPkHistoryUpdateOne) SetOpponentID(v int64) *TeamPkHistoryUpdateOne {
+	_u.mutation.ResetOpponentID()
+	_u.mutation.SetOpponentID(v)
+	return _u
+}
+
+// SetNillableOpponentID sets the "opponent_id" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableOpponentID(v *int64) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetOpponentID(*v)
+	}
+	return _u
+}
+
+// AddOpponentID adds value to the "opponent_id" field.
+func (_u *TeamPkHistoryUpdateOne) AddOpponentID(v int64) *TeamPkHistoryUpdateOne {
+	_u.mutation.AddOpponentID(v)
+	return _u
+}
+
+// SetOpponentName sets the "opponent_name" field.
+func (_u *TeamPkHistoryUpdateOne) SetOpponentName(v string) *TeamPkHistoryUpdateOne {
+	_u.mutation.SetOpponentName(v)
+	return _u
+}
+
+// SetNillableOpponentName sets the "opponent_name" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableOpponentName(v *string) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetOpponentName(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TeamPkHistoryUpdateOne) SetResult(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.ResetResult()
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableResult(v *int) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// AddResult adds value to the "result" field.
+func (_u *TeamPkHistoryUpdateOne) AddResult(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.AddResult(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamPkHistoryUpdateOne) SetScore(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableScore(v *int) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamPkHistoryUpdateOne) AddScore(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetBuildingHp sets the "building_hp" field.
+func (_u *TeamPkHistoryUpdateOne) SetBuildingHp(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.ResetBuildingHp()
+	_u.mutation.SetBuildingHp(v)
+	return _u
+}
+
+// SetNillableBuildingHp sets the "building_hp" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableBuildingHp(v *int) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetBuildingHp(*v)
+	}
+	return _u
+}
+
+// AddBuildingHp adds value to the "building_hp" field.
+func (_u *TeamPkHistoryUpdateOne) AddBuildingHp(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.AddBuildingHp(v)
+	return _u
+}
+
+// SetOpponentBuildingHp sets the "opponent_building_hp" field.
+func (_u *TeamPkHistoryUpdateOne) SetOpponentBuildingHp(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.ResetOpponentBuildingHp()
+	_u.mutation.SetOpponentBuildingHp(v)
+	return _u
+}
+
+// SetNillableOpponentBuildingHp sets the "opponent_building_hp" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableOpponentBuildingHp(v *int) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetOpponentBuildingHp(*v)
+	}
+	return _u
+}
+
+// AddOpponentBuildingHp adds value to the "opponent_building_hp" field.
+func (_u *TeamPkHistoryUpdateOne) AddOpponentBuildingHp(v int) *TeamPkHistoryUpdateOne {
+	_u.mutation.AddOpponentBuildingHp(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TeamPkHistoryUpdateOne) SetCreatedAt(v time.Time) *TeamPkHistoryUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TeamPkHistoryUpdateOne) SetNillableCreatedAt(v *time.Time) *TeamPkHistoryUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TeamPkHistoryMutation object of the builder.
+func (_u *TeamPkHistoryUpdateOne) Mutation() *TeamPkHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TeamPkHistoryUpdate builder.
+func (_u *TeamPkHistoryUpdateOne) Where(ps ...predicate.TeamPkHistory) *TeamPkHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TeamPkHistoryUpdateOne) Select(field string, fields ...string) *TeamPkHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TeamPkHistory entity.
+func (_u *TeamPkHistoryUpdateOne) Save(ctx context.Context) (*TeamPkHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamPkHistoryUpdateOne) SaveX(ctx context.Context) *TeamPkHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TeamPkHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamPkHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TeamPkHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TeamPkHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teampkhistory.Table, teampkhistory.Columns, sqlgraph.NewFieldSpec(teampkhistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamPkHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teampkhistory.FieldID)
+		for _, f := range fields {
+			if !teampkhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teampkhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(teampkhistory.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(teampkhistory.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(teampkhistory.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(teampkhistory.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OpponentID(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOpponentID(); ok {
+		_spec.AddField(teampkhistory.FieldOpponentID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OpponentName(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(teampkhistory.FieldResult, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResult(); ok {
+		_spec.AddField(teampkhistory.FieldResult, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(teampkhistory.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(teampkhistory.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BuildingHp(); ok {
+		_spec.SetField(teampkhistory.FieldBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBuildingHp(); ok {
+		_spec.AddField(teampkhistory.FieldBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OpponentBuildingHp(); ok {
+		_spec.SetField(teampkhistory.FieldOpponentBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOpponentBuildingHp(); ok {
+		_spec.AddField(teampkhistory.FieldOpponentBuildingHp, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(teampkhistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &TeamPkHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teampkhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jseer/ent/teampkscore"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TeamPkScore is the model entity for the TeamPkScore schema.
+type TeamPkScore struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int64 `json:"team_id,omitempty"`
+	// Season holds the value of the "season" field.
+	Season int `json:"season,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Score holds the value of the "score" field.
+	Score int `json:"score,omitempty"`
+	// Wins holds the value of the "wins" field.
+	Wins int `json:"wins,omitempty"`
+	// Losses holds the value of the "losses" field.
+	Losses int `json:"losses,omitempty"`
+	// Draws holds the value of the "draws" field.
+	Draws int `json:"draws,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamPkScore) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teampkscore.FieldID, teampkscore.FieldTeamID, teampkscore.FieldSeason, teampkscore.FieldScore, teampkscore.FieldWins, teampkscore.FieldLosses, teampkscore.FieldDraws:
+			values[i] = new(sql.NullInt64)
+		case teampkscore.FieldName:
+			values[i] = new(sql.NullString)
+		case teampkscore.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamPkScore fields.
+func (_m *TeamPkScore) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teampkscore.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case teampkscore.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = value.Int64
+			}
+		case teampkscore.FieldSeason:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season", values[i])
+			} else if value.Valid {
+				_m.Season = int(value.Int64)
+			}
+		case teampkscore.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case teampkscore.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		case teampkscore.FieldWins:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wins", values[i])
+			} else if value.Valid {
+				_m.Wins = int(value.Int64)
+			}
+		case teampkscore.FieldLosses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field losses", values[i])
+			} else if value.Valid {
+				_m.Losses = int(value.Int64)
+			}
+		case teampkscore.FieldDraws:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field draws", values[i])
+			} else if value.Valid {
+				_m.Draws = int(value.Int64)
+			}
+		case teampkscore.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamPkScore.
+// This includes values selected through modifiers, order, etc.
+func (_m *TeamPkScore) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamPkScore.
+// Note that you need to call TeamPkScore.Unwrap() before calling this method if this TeamPkScore
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TeamPkScore) Update() *TeamPkScoreUpdateOne {
+	return NewTeamPkScoreClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TeamPkScore entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TeamPkScore) Unwrap() *TeamPkScore {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamPkScore is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TeamPkScore) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamPkScore(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("season=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Season))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("wins=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Wins))
+	builder.WriteString(", ")
+	builder.WriteString("losses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Losses))
+	builder.WriteString(", ")
+	builder.WriteString("draws=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Draws))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamPkScores is a parsable slice of TeamPkScore.
+type TeamPkScores []*TeamPkScore
@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teampkscore
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the teampkscore type in the database.
+	Label = "team_pk_score"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldSeason holds the string denoting the season field in the database.
+	FieldSeason = "season"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldWins holds the string denoting the wins field in the database.
+	FieldWins = "wins"
+	// FieldLosses holds the string denoting the losses field in the database.
+	FieldLosses = "losses"
+	// FieldDraws holds the string denoting the draws field in the database.
+	FieldDraws = "draws"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the teampkscore in the database.
+	Table = "team_pk_scores"
+)
+
+// Columns holds all SQL columns for teampkscore fields.
+var Columns = []string{
+	FieldID,
+	FieldTeamID,
+	FieldSeason,
+	FieldName,
+	FieldScore,
+	FieldWins,
+	FieldLosses,
+	FieldDraws,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int
+	// DefaultWins holds the default value on creation for the "wins" field.
+	DefaultWins int
+	// DefaultLosses holds the default value on creation for the "losses" field.
+	DefaultLosses int
+	// DefaultDraws holds the default value on creation for the "draws" field.
+	DefaultDraws int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TeamPkScore queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// BySeason orders the results by the season field.
+func BySeason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeason, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByWins orders the results by the wins field.
+func ByWins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWins, opts...).ToFunc()
+}
+
+// ByLosses orders the results by the losses field.
+func ByLosses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLosses, opts...).ToFunc()
+}
+
+// ByDraws orders the results by the draws field.
+func ByDraws(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDraws, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
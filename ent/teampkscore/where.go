@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teampkscore
+
+import (
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldID, id))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Season applies equality check predicate on the "season" field. It's identical to SeasonEQ.
+func Season(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldSeason, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldName, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldScore, v))
+}
+
+// Wins applies equality check predicate on the "wins" field. It's identical to WinsEQ.
+func Wins(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldWins, v))
+}
+
+// Losses applies equality check predicate on the "losses" field. It's identical to LossesEQ.
+func Losses(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldLosses, v))
+}
+
+// Draws applies equality check predicate on the "draws" field. It's identical to DrawsEQ.
+func Draws(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldDraws, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v int64) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldTeamID, v))
+}
+
+// SeasonEQ applies the EQ predicate on the "season" field.
+func SeasonEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldSeason, v))
+}
+
+// SeasonNEQ applies the NEQ predicate on the "season" field.
+func SeasonNEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldSeason, v))
+}
+
+// SeasonIn applies the In predicate on the "season" field.
+func SeasonIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldSeason, vs...))
+}
+
+// SeasonNotIn applies the NotIn predicate on the "season" field.
+func SeasonNotIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldSeason, vs...))
+}
+
+// SeasonGT applies the GT predicate on the "season" field.
+func SeasonGT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldSeason, v))
+}
+
+// SeasonGTE applies the GTE predicate on the "season" field.
+func SeasonGTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldSeason, v))
+}
+
+// SeasonLT applies the LT predicate on the "season" field.
+func SeasonLT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldSeason, v))
+}
+
+// SeasonLTE applies the LTE predicate on the "season" field.
+func SeasonLTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldSeason, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldContainsFold(FieldName, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldScore, v))
+}
+
+// WinsEQ applies the EQ predicate on the "wins" field.
+func WinsEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldWins, v))
+}
+
+// WinsNEQ applies the NEQ predicate on the "wins" field.
+func WinsNEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldWins, v))
+}
+
+// WinsIn applies the In predicate on the "wins" field.
+func WinsIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldWins, vs...))
+}
+
+// WinsNotIn applies the NotIn predicate on the "wins" field.
+func WinsNotIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldWins, vs...))
+}
+
+// WinsGT applies the GT predicate on the "wins" field.
+func WinsGT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldWins, v))
+}
+
+// WinsGTE applies the GTE predicate on the "wins" field.
+func WinsGTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldWins, v))
+}
+
+// WinsLT applies the LT predicate on the "wins" field.
+func WinsLT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldWins, v))
+}
+
+// WinsLTE applies the LTE predicate on the "wins" field.
+func WinsLTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldWins, v))
+}
+
+// LossesEQ applies the EQ predicate on the "losses" field.
+func LossesEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldLosses, v))
+}
+
+// LossesNEQ applies the NEQ predicate on the "losses" field.
+func LossesNEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldLosses, v))
+}
+
+// LossesIn applies the In predicate on the "losses" field.
+func LossesIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldLosses, vs...))
+}
+
+// LossesNotIn applies the NotIn predicate on the "losses" field.
+func LossesNotIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldLosses, vs...))
+}
+
+// LossesGT applies the GT predicate on the "losses" field.
+func LossesGT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldLosses, v))
+}
+
+// LossesGTE applies the GTE predicate on the "losses" field.
+func LossesGTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldLosses, v))
+}
+
+// LossesLT applies the LT predicate on the "losses" field.
+func LossesLT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldLosses, v))
+}
+
+// LossesLTE applies the LTE predicate on the "losses" field.
+func LossesLTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldLosses, v))
+}
+
+// DrawsEQ applies the EQ predicate on the "draws" field.
+func DrawsEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldDraws, v))
+}
+
+// DrawsNEQ applies the NEQ predicate on the "draws" field.
+func DrawsNEQ(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldDraws, v))
+}
+
+// DrawsIn applies the In predicate on the "draws" field.
+func DrawsIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldDraws, vs...))
+}
+
+// DrawsNotIn applies the NotIn predicate on the "draws" field.
+func DrawsNotIn(vs ...int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldDraws, vs...))
+}
+
+// DrawsGT applies the GT predicate on the "draws" field.
+func DrawsGT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldDraws, v))
+}
+
+// DrawsGTE applies the GTE predicate on the "draws" field.
+func DrawsGTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldDraws, v))
+}
+
+// DrawsLT applies the LT predicate on the "draws" field.
+func DrawsLT(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldDraws, v))
+}
+
+// DrawsLTE applies the LTE predicate on the "draws" field.
+func DrawsLTE(v int) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldDraws, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamPkScore) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamPkScore) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamPkScore) predicate.TeamPkScore {
+	return predicate.TeamPkScore(sql.NotPredicates(p))
+}
@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/teampkscore"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamPkScoreCreate is the builder for creating a TeamPkScore entity.
+type TeamPkScoreCreate struct {
+	config
+	mutation *TeamPkScoreMutation
+	hooks    []Hook
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *TeamPkScoreCreate) SetTeamID(v int64) *TeamPkScoreCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetSeason sets the "season" field.
+func (_c *TeamPkScoreCreate) SetSeason(v int) *TeamPkScoreCreate {
+	_c.mutation.SetSeason(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TeamPkScoreCreate) SetName(v string) *TeamPkScoreCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *TeamPkScoreCreate) SetNillableName(v *string) *TeamPkScoreCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *TeamPkScoreCreate) SetScore(v int) *TeamPkScoreCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *TeamPkScoreCreate) SetNillableScore(v *int) *TeamPkScoreCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetWins sets the "wins" field.
+func (_c *TeamPkScoreCreate) SetWins(v int) *TeamPkScoreCreate {
+	_c.mutation.SetWins(v)
+	return _c
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (_c *TeamPkScoreCreate) SetNillableWins(v *int) *TeamPkScoreCreate {
+	if v != nil {
+		_c.SetWins(*v)
+	}
+	return _c
+}
+
+// SetLosses sets the "losses" field.
+func (_c *TeamPkScoreCreate) SetLosses(v int) *TeamPkScoreCreate {
+	_c.mutation.SetLosses(v)
+	return _c
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (_c *TeamPkScoreCreate) SetNillableLosses(v *int) *TeamPkScoreCreate {
+	if v != nil {
+		_c.SetLosses(*v)
+	}
+	return _c
+}
+
+// SetDraws sets the "draws" field.
+func (_c *TeamPkScoreCreate) SetDraws(v int) *TeamPkScoreCreate {
+	_c.mutation.SetDraws(v)
+	return _c
+}
+
+// SetNillableDraws sets the "draws" field if the given value is not nil.
+func (_c *TeamPkScoreCreate) SetNillableDraws(v *int) *TeamPkScoreCreate {
+	if v != nil {
+		_c.SetDraws(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TeamPkScoreCreate) SetUpdatedAt(v time.Time) *TeamPkScoreCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TeamPkScoreCreate) SetNillableUpdatedAt(v *time.Time) *TeamPkScoreCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TeamPkScoreMutation object of the builder.
+func (_c *TeamPkScoreCreate) Mutation() *TeamPkScoreMutation {
+	return _c.mutation
+}
+
+// Save creates the TeamPkScore in the database.
+func (_c *TeamPkScoreCreate) Save(ctx context.Context) (*TeamPkScore, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TeamPkScoreCreate) SaveX(ctx context.Context) *TeamPkScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamPkScoreCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamPkScoreCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TeamPkScoreCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := teampkscore.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := teampkscore.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.Wins(); !ok {
+		v := teampkscore.DefaultWins
+		_c.mutation.SetWins(v)
+	}
+	if _, ok := _c.mutation.Losses(); !ok {
+		v := teampkscore.DefaultLosses
+		_c.mutation.SetLosses(v)
+	}
+	if _, ok := _c.mutation.Draws(); !ok {
+		v := teampkscore.DefaultDraws
+		_c.mutation.SetDraws(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := teampkscore.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TeamPkScoreCreate) check() error {
+	if _, ok := _c.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "TeamPkScore.team_id"`)}
+	}
+	if _, ok := _c.mutation.Season(); !ok {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required field "TeamPkScore.season"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TeamPkScore.name"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "TeamPkScore.score"`)}
+	}
+	if _, ok := _c.mutation.Wins(); !ok {
+		return &ValidationError{Name: "wins", err: errors.New(`ent: missing required field "TeamPkScore.wins"`)}
+	}
+	if _, ok := _c.mutation.Losses(); !ok {
+		return &ValidationError{Name: "losses", err: errors.New(`ent: missing required field "TeamPkScore.losses"`)}
+	}
+	if _, ok := _c.mutation.Draws(); !ok {
+		return &ValidationError{Name: "draws", err: errors.New(`ent: missing required field "TeamPkScore.draws"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TeamPkScore.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TeamPkScoreCreate) sqlSave(ctx context.Context) (*TeamPkScore, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TeamPkScoreCreate) createSpec() (*TeamPkScore, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamPkScore{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(teampkscore.Table, sqlgraph.NewFieldSpec(teampkscore.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TeamID(); ok {
+		_spec.SetField(teampkscore.FieldTeamID, field.TypeInt64, value)
+		_node.TeamID = value
+	}
+	if value, ok := _c.mutation.Season(); ok {
+		_spec.SetField(teampkscore.FieldSeason, field.TypeInt, value)
+		_node.Season = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(teampkscore.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(teampkscore.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.Wins(); ok {
+		_spec.SetField(teampkscore.FieldWins, field.TypeInt, value)
+		_node.Wins = value
+	}
+	if value, ok := _c.mutation.Losses(); ok {
+		_spec.SetField(teampkscore.FieldLosses, field.TypeInt, value)
+		_node.Losses = value
+	}
+	if value, ok := _c.mutation.Draws(); ok {
+		_spec.SetField(teampkscore.FieldDraws, field.TypeInt, value)
+		_node.Draws = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(teampkscore.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TeamPkScoreCreateBulk is the builder for creating many TeamPkScore entities in bulk.
+type TeamPkScoreCreateBulk struct {
+	config
+	err      error
+	builders []*TeamPkScoreCreate
+}
+
+// Save creates the TeamPkScore entities in the database.
+func (_c *TeamPkScoreCreateBulk) Save(ctx context.Context) ([]*TeamPkScore, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TeamPkScore, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamPkScoreMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TeamPkScoreCreateBulk) SaveX(ctx context.Context) []*TeamPkScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamPkScoreCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamPkScoreCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
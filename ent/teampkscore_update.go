@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/predicate"
+	"jseer/ent/teampkscore"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamPkScoreUpdate is the builder for updating TeamPkScore entities.
+type TeamPkScoreUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamPkScoreMutation
+}
+
+// Where appends a list predicates to the TeamPkScoreUpdate builder.
+func (_u *TeamPkScoreUpdate) Where(ps ...predicate.TeamPkScore) *TeamPkScoreUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamPkScoreUpdate) SetTeamID(v int64) *TeamPkScoreUpdate {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamPkScoreUpdate) SetNillableTeamID(v *int64) *TeamPkScoreUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *TeamPkScoreUpdate) AddTeamID(v int64) *TeamPkScoreUpdate {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *TeamPkScoreUpdate) SetSeason(v int) *TeamPkScoreUpdate {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *TeamPkScoreUpdate) SetNillableSeason(v *int) *TeamPkScoreUpdate {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *TeamPkScoreUpdate) AddSeason(v int) *TeamPkScoreUpdate {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TeamPkScoreUpdate) SetName(v string) *TeamPkScoreUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TeamPkScoreUpdate) SetNillableName(v *string) *TeamPkScoreUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamPkScoreUpdate) SetScore(v int) *TeamPkScoreUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamPkScoreUpdate) SetNillableScore(v *int) *TeamPkScoreUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamPkScoreUpdate) AddScore(v int) *TeamPkScoreUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetWins sets the "wins" field.
+func (_u *TeamPkScoreUpdate) SetWins(v int) *TeamPkScoreUpdate {
+	_u.mutation.ResetWins()
+	_u.mutation.SetWins(v)
+	return _u
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (_u *TeamPkScoreUpdate) SetNillableWins(v *int) *TeamPkScoreUpdate {
+	if v != nil {
+		_u.SetWins(*v)
+	}
+	return _u
+}
+
+// AddWins adds value to the "wins" field.
+func (_u *TeamPkScoreUpdate) AddWins(v int) *TeamPkScoreUpdate {
+	_u.mutation.AddWins(v)
+	return _u
+}
+
+// SetLosses sets the "losses" field.
+func (_u *TeamPkScoreUpdate) SetLosses(v int) *TeamPkScoreUpdate {
+	_u.mutation.ResetLosses()
+	_u.mutation.SetLosses(v)
+	return _u
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (_u *TeamPkScoreUpdate) SetNillableLosses(v *int) *TeamPkScoreUpdate {
+	if v != nil {
+		_u.SetLosses(*v)
+	}
+	return _u
+}
+
+// AddLosses adds value to the "losses" field.
+func (_u *TeamPkScoreUpdate) AddLosses(v int) *TeamPkScoreUpdate {
+	_u.mutation.AddLosses(v)
+	return _u
+}
+
+// SetDraws sets the "draws" field.
+func (_u *TeamPkScoreUpdate) SetDraws(v int) *TeamPkScoreUpdate {
+	_u.mutation.ResetDraws()
+	_u.mutation.SetDraws(v)
+	return _u
+}
+
+// SetNillableDraws sets the "draws" field if the given value is not nil.
+func (_u *TeamPkScoreUpdate) SetNillableDraws(v *int) *TeamPkScoreUpdate {
+	if v != nil {
+		_u.SetDraws(*v)
+	}
+	return _u
+}
+
+// AddDraws adds value to the "draws" field.
+func (_u *TeamPkScoreUpdate) AddDraws(v int) *TeamPkScoreUpdate {
+	_u.mutation.AddDraws(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TeamPkScoreUpdate) SetUpdatedAt(v time.Time) *TeamPkScoreUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TeamPkScoreMutation object of the builder.
+func (_u *TeamPkScoreUpdate) Mutation() *TeamPkScoreMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TeamPkScoreUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamPkScoreUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TeamPkScoreUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamPkScoreUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TeamPkScoreUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := teampkscore.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TeamPkScoreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teampkscore.Table, teampkscore.Columns, sqlgraph.NewFieldSpec(teampkscore.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(teampkscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(teampkscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(teampkscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(teampkscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(teampkscore.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(teampkscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(teampkscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Wins(); ok {
+		_spec.SetField(teampkscore.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWins(); ok {
+		_spec.AddField(teampkscore.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Losses(); ok {
+		_spec.SetField(teampkscore.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLosses(); ok {
+		_spec.AddField(teampkscore.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Draws(); ok {
+		_spec.SetField(teampkscore.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDraws(); ok {
+		_spec.AddField(teampkscore.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(teampkscore.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teampkscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TeamPkScoreUpdateOne is the builder for updating a single TeamPkScore entity.
+type TeamPkScoreUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamPkScoreMutation
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamPkScoreUpdateOne) SetTeamID(v int64) *TeamPkScoreUpdateOne {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamPkScoreUpdateOne) SetNillableTeamID(v *int64) *TeamPkScoreUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *TeamPkScoreUpdateOne) AddTeamID(v int64) *TeamPkScoreUpdateOne {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *TeamPkScoreUpdateOne) SetSeason(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *TeamPkScoreUpdateOne) SetNillableSeason(v *int) *TeamPkScoreUpdateOne {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *TeamPkScoreUpdateOne) AddSeason(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TeamPkScoreUpdateOne) SetName(v string) *TeamPkScoreUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TeamPkScoreUpdateOne) SetNillableName(v *string) *TeamPkScoreUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamPkScoreUpdateOne) SetScore(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamPkScoreUpdateOne) SetNillableScore(v *int) *TeamPkScoreUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamPkScoreUpdateOne) AddScore(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetWins sets the "wins" field.
+func (_u *TeamPkScoreUpdateOne) SetWins(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.ResetWins()
+	_u.mutation.SetWins(v)
+	return _u
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (_u *TeamPkScoreUpdateOne) SetNillableWins(v *int) *TeamPkScoreUpdateOne {
+	if v != nil {
+		_u.SetWins(*v)
+	}
+	return _u
+}
+
+// AddWins adds value to the "wins" field.
+func (_u *TeamPkScoreUpdateOne) AddWins(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.AddWins(v)
+	return _u
+}
+
+// SetLosses sets the "losses" field.
+func (_u *TeamPkScoreUpdateOne) SetLosses(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.ResetLosses()
+	_u.mutation.SetLosses(v)
+	return _u
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (_u *TeamPkScoreUpdateOne) SetNillableLosses(v *int) *TeamPkScoreUpdateOne {
+	if v != nil {
+		_u.SetLosses(*v)
+	}
+	return _u
+}
+
+// AddLosses adds value to the "losses" field.
+func (_u *TeamPkScoreUpdateOne) AddLosses(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.AddLosses(v)
+	return _u
+}
+
+// SetDraws sets the "draws" field.
+func (_u *TeamPkScoreUpdateOne) SetDraws(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.ResetDraws()
+	_u.mutation.SetDraws(v)
+	return _u
+}
+
+// SetNillableDraws sets the "draws" field if the given value is not nil.
+func (_u *TeamPkScoreUpdateOne) SetNillableDraws(v *int) *TeamPkScoreUpdateOne {
+	if v != nil {
+		_u.SetDraws(*v)
+	}
+	return _u
+}
+
+// AddDraws adds value to the "draws" field.
+func (_u *TeamPkScoreUpdateOne) AddDraws(v int) *TeamPkScoreUpdateOne {
+	_u.mutation.AddDraws(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TeamPkScoreUpdateOne) SetUpdatedAt(v time.Time) *TeamPkScoreUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TeamPkScoreMutation object of the builder.
+func (_u *TeamPkScoreUpdateOne) Mutation() *TeamPkScoreMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TeamPkScoreUpdate builder.
+func (_u *TeamPkScoreUpdateOne) Where(ps ...predicate.TeamPkScore) *TeamPkScoreUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TeamPkScoreUpdateOne) Select(field string, fields ...string) *TeamPkScoreUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TeamPkScore entity.
+func (_u *TeamPkScoreUpdateOne) Save(ctx context.Context) (*TeamPkScore, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamPkScoreUpdateOne) SaveX(ctx context.Context) *TeamPkScore {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TeamPkScoreUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamPkScoreUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TeamPkScoreUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := teampkscore.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TeamPkScoreUpdateOne) sqlSave(ctx context.Context) (_node *TeamPkScore, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teampkscore.Table, teampkscore.Columns, sqlgraph.NewFieldSpec(teampkscore.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamPkScore.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teampkscore.FieldID)
+		for _, f := range fields {
+			if !teampkscore.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teampkscore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(teampkscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(teampkscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(teampkscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(teampkscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(teampkscore.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(teampkscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(teampkscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Wins(); ok {
+		_spec.SetField(teampkscore.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWins(); ok {
+		_spec.AddField(teampkscore.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Losses(); ok {
+		_spec.SetField(teampkscore.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLosses(); ok {
+		_spec.AddField(teampkscore.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Draws(); ok {
+		_spec.SetField(teampkscore.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDraws(); ok {
+		_spec.AddField(teampkscore.FieldDraws, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(teampkscore.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TeamPkScore{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teampkscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
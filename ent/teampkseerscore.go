@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jseer/ent/teampkseerscore"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TeamPkSeerScore is the model entity for the TeamPkSeerScore schema.
+type TeamPkSeerScore struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Season holds the value of the "season" field.
+	Season int `json:"season,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int64 `json:"team_id,omitempty"`
+	// Nick holds the value of the "nick" field.
+	Nick string `json:"nick,omitempty"`
+	// Score holds the value of the "score" field.
+	Score int `json:"score,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamPkSeerScore) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teampkseerscore.FieldID, teampkseerscore.FieldUserID, teampkseerscore.FieldSeason, teampkseerscore.FieldTeamID, teampkseerscore.FieldScore:
+			values[i] = new(sql.NullInt64)
+		case teampkseerscore.FieldNick:
+			values[i] = new(sql.NullString)
+		case teampkseerscore.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamPkSeerScore fields.
+func (_m *TeamPkSeerScore) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teampkseerscore.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case teampkseerscore.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case teampkseerscore.FieldSeason:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season", values[i])
+			} else if value.Valid {
+				_m.Season = int(value.Int64)
+			}
+		case teampkseerscore.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = value.Int64
+			}
+		case teampkseerscore.FieldNick:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nick", values[i])
+			} else if value.Valid {
+				_m.Nick = value.String
+			}
+		case teampkseerscore.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		case teampkseerscore.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamPkSeerScore.
+// This includes values selected through modifiers, order, etc.
+func (_m *TeamPkSeerScore) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TeamPkSeerScore.
+// Note that you need to call TeamPkSeerScore.Unwrap() before calling this method if this TeamPkSeerScore
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TeamPkSeerScore) Update() *TeamPkSeerScoreUpdateOne {
+	return NewTeamPkSeerScoreClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TeamPkSeerScore entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TeamPkSeerScore) Unwrap() *TeamPkSeerScore {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamPkSeerScore is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TeamPkSeerScore) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamPkSeerScore(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("season=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Season))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("nick=")
+	builder.WriteString(_m.Nick)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamPkSeerScores is a parsable slice of TeamPkSeerScore.
+type TeamPkSeerScores []*TeamPkSeerScore
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teampkseerscore
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the teampkseerscore type in the database.
+	Label = "team_pk_seer_score"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSeason holds the string denoting the season field in the database.
+	FieldSeason = "season"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldNick holds the string denoting the nick field in the database.
+	FieldNick = "nick"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the teampkseerscore in the database.
+	Table = "team_pk_seer_scores"
+)
+
+// Columns holds all SQL columns for teampkseerscore fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldSeason,
+	FieldTeamID,
+	FieldNick,
+	FieldScore,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTeamID holds the default value on creation for the "team_id" field.
+	DefaultTeamID int64
+	// DefaultNick holds the default value on creation for the "nick" field.
+	DefaultNick string
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TeamPkSeerScore queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// BySeason orders the results by the season field.
+func BySeason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeason, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByNick orders the results by the nick field.
+func ByNick(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNick, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
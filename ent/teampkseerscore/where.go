@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teampkseerscore
+
+import (
+	"jseer/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldUserID, v))
+}
+
+// Season applies equality check predicate on the "season" field. It's identical to SeasonEQ.
+func Season(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldSeason, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Nick applies equality check predicate on the "nick" field. It's identical to NickEQ.
+func Nick(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldNick, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldScore, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLTE(FieldUserID, v))
+}
+
+// SeasonEQ applies the EQ predicate on the "season" field.
+func SeasonEQ(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldSeason, v))
+}
+
+// SeasonNEQ applies the NEQ predicate on the "season" field.
+func SeasonNEQ(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNEQ(FieldSeason, v))
+}
+
+// SeasonIn applies the In predicate on the "season" field.
+func SeasonIn(vs ...int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldIn(FieldSeason, vs...))
+}
+
+// SeasonNotIn applies the NotIn predicate on the "season" field.
+func SeasonNotIn(vs ...int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNotIn(FieldSeason, vs...))
+}
+
+// SeasonGT applies the GT predicate on the "season" field.
+func SeasonGT(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGT(FieldSeason, v))
+}
+
+// SeasonGTE applies the GTE predicate on the "season" field.
+func SeasonGTE(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGTE(FieldSeason, v))
+}
+
+// SeasonLT applies the LT predicate on the "season" field.
+func SeasonLT(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLT(FieldSeason, v))
+}
+
+// SeasonLTE applies the LTE predicate on the "season" field.
+func SeasonLTE(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLTE(FieldSeason, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v int64) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLTE(FieldTeamID, v))
+}
+
+// NickEQ applies the EQ predicate on the "nick" field.
+func NickEQ(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldNick, v))
+}
+
+// NickNEQ applies the NEQ predicate on the "nick" field.
+func NickNEQ(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNEQ(FieldNick, v))
+}
+
+// NickIn applies the In predicate on the "nick" field.
+func NickIn(vs ...string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldIn(FieldNick, vs...))
+}
+
+// NickNotIn applies the NotIn predicate on the "nick" field.
+func NickNotIn(vs ...string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNotIn(FieldNick, vs...))
+}
+
+// NickGT applies the GT predicate on the "nick" field.
+func NickGT(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGT(FieldNick, v))
+}
+
+// NickGTE applies the GTE predicate on the "nick" field.
+func NickGTE(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGTE(FieldNick, v))
+}
+
+// NickLT applies the LT predicate on the "nick" field.
+func NickLT(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLT(FieldNick, v))
+}
+
+// NickLTE applies the LTE predicate on the "nick" field.
+func NickLTE(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLTE(FieldNick, v))
+}
+
+// NickContains applies the Contains predicate on the "nick" field.
+func NickContains(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldContains(FieldNick, v))
+}
+
+// NickHasPrefix applies the HasPrefix predicate on the "nick" field.
+func NickHasPrefix(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldHasPrefix(FieldNick, v))
+}
+
+// NickHasSuffix applies the HasSuffix predicate on the "nick" field.
+func NickHasSuffix(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldHasSuffix(FieldNick, v))
+}
+
+// NickEqualFold applies the EqualFold predicate on the "nick" field.
+func NickEqualFold(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEqualFold(FieldNick, v))
+}
+
+// NickContainsFold applies the ContainsFold predicate on the "nick" field.
+func NickContainsFold(v string) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldContainsFold(FieldNick, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLTE(FieldScore, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamPkSeerScore) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamPkSeerScore) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamPkSeerScore) predicate.TeamPkSeerScore {
+	return predicate.TeamPkSeerScore(sql.NotPredicates(p))
+}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/teampkseerscore"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamPkSeerScoreCreate is the builder for creating a TeamPkSeerScore entity.
+type TeamPkSeerScoreCreate struct {
+	config
+	mutation *TeamPkSeerScoreMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TeamPkSeerScoreCreate) SetUserID(v int64) *TeamPkSeerScoreCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSeason sets the "season" field.
+func (_c *TeamPkSeerScoreCreate) SetSeason(v int) *TeamPkSeerScoreCreate {
+	_c.mutation.SetSeason(v)
+	return _c
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *TeamPkSeerScoreCreate) SetTeamID(v int64) *TeamPkSeerScoreCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_c *TeamPkSeerScoreCreate) SetNillableTeamID(v *int64) *TeamPkSeerScoreCreate {
+	if v != nil {
+		_c.SetTeamID(*v)
+	}
+	return _c
+}
+
+// SetNick sets the "nick" field.
+func (_c *TeamPkSeerScoreCreate) SetNick(v string) *TeamPkSeerScoreCreate {
+	_c.mutation.SetNick(v)
+	return _c
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_c *TeamPkSeerScoreCreate) SetNillableNick(v *string) *TeamPkSeerScoreCreate {
+	if v != nil {
+		_c.SetNick(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *TeamPkSeerScoreCreate) SetScore(v int) *TeamPkSeerScoreCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *TeamPkSeerScoreCreate) SetNillableScore(v *int) *TeamPkSeerScoreCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TeamPkSeerScoreCreate) SetUpdatedAt(v time.Time) *TeamPkSeerScoreCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TeamPkSeerScoreCreate) SetNillableUpdatedAt(v *time.Time) *TeamPkSeerScoreCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TeamPkSeerScoreMutation object of the builder.
+func (_c *TeamPkSeerScoreCreate) Mutation() *TeamPkSeerScoreMutation {
+	return _c.mutation
+}
+
+// Save creates the TeamPkSeerScore in the database.
+func (_c *TeamPkSeerScoreCreate) Save(ctx context.Context) (*TeamPkSeerScore, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TeamPkSeerScoreCreate) SaveX(ctx context.Context) *TeamPkSeerScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamPkSeerScoreCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamPkSeerScoreCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TeamPkSeerScoreCreate) defaults() {
+	if _, ok := _c.mutation.TeamID(); !ok {
+		v := teampkseerscore.DefaultTeamID
+		_c.mutation.SetTeamID(v)
+	}
+	if _, ok := _c.mutation.Nick(); !ok {
+		v := teampkseerscore.DefaultNick
+		_c.mutation.SetNick(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := teampkseerscore.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := teampkseerscore.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TeamPkSeerScoreCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TeamPkSeerScore.user_id"`)}
+	}
+	if _, ok := _c.mutation.Season(); !ok {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required field "TeamPkSeerScore.season"`)}
+	}
+	if _, ok := _c.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`ent: missing required field "TeamPkSeerScore.team_id"`)}
+	}
+	if _, ok := _c.mutation.Nick(); !ok {
+		return &ValidationError{Name: "nick", err: errors.New(`ent: missing required field "TeamPkSeerScore.nick"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "TeamPkSeerScore.score"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TeamPkSeerScore.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TeamPkSeerScoreCreate) sqlSave(ctx context.Context) (*TeamPkSeerScore, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TeamPkSeerScoreCreate) createSpec() (*TeamPkSeerScore, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamPkSeerScore{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(teampkseerscore.Table, sqlgraph.NewFieldSpec(teampkseerscore.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(teampkseerscore.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Season(); ok {
+		_spec.SetField(teampkseerscore.FieldSeason, field.TypeInt, value)
+		_node.Season = value
+	}
+	if value, ok := _c.mutation.TeamID(); ok {
+		_spec.SetField(teampkseerscore.FieldTeamID, field.TypeInt64, value)
+		_node.TeamID = value
+	}
+	if value, ok := _c.mutation.Nick(); ok {
+		_spec.SetField(teampkseerscore.FieldNick, field.TypeString, value)
+		_node.Nick = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(teampkseerscore.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(teampkseerscore.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TeamPkSeerScoreCreateBulk is the builder for creating many TeamPkSeerScore entities in bulk.
+type TeamPkSeerScoreCreateBulk struct {
+	config
+	err      error
+	builders []*TeamPkSeerScoreCreate
+}
+
+// Save creates the TeamPkSeerScore entities in the database.
+func (_c *TeamPkSeerScoreCreateBulk) Save(ctx context.Context) ([]*TeamPkSeerScore, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TeamPkSeerScore, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamPkSeerScoreMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TeamPkSeerScoreCreateBulk) SaveX(ctx context.Context) []*TeamPkSeerScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TeamPkSeerScoreCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TeamPkSeerScoreCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"jseer/ent/predicate"
+	"jseer/ent/teampkseerscore"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamPkSeerScoreDelete is the builder for deleting a TeamPkSeerScore entity.
+type TeamPkSeerScoreDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamPkSeerScoreMutation
+}
+
+// Where appends a list predicates to the TeamPkSeerScoreDelete builder.
+func (_d *TeamPkSeerScoreDelete) Where(ps ...predicate.TeamPkSeerScore) *TeamPkSeerScoreDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TeamPkSeerScoreDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TeamPkSeerScoreDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TeamPkSeerScoreDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teampkseerscore.Table, sqlgraph.NewFieldSpec(teampkseerscore.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TeamPkSeerScoreDeleteOne is the builder for deleting a single TeamPkSeerScore entity.
+type TeamPkSeerScoreDeleteOne struct {
+	_d *TeamPkSeerScoreDelete
+}
+
+// Where appends a list predicates to the TeamPkSeerScoreDelete builder.
+func (_d *TeamPkSeerScoreDeleteOne) Where(ps ...predicate.TeamPkSeerScore) *TeamPkSeerScoreDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TeamPkSeerScoreDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teampkseerscore.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TeamPkSeerScoreDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jseer/ent/predicate"
+	"jseer/ent/teampkseerscore"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamPkSeerScoreUpdate is the builder for updating TeamPkSeerScore entities.
+type TeamPkSeerScoreUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamPkSeerScoreMutation
+}
+
+// Where appends a list predicates to the TeamPkSeerScoreUpdate builder.
+func (_u *TeamPkSeerScoreUpdate) Where(ps ...predicate.TeamPkSeerScore) *TeamPkSeerScoreUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TeamPkSeerScoreUpdate) SetUserID(v int64) *TeamPkSeerScoreUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdate) SetNillableUserID(v *int64) *TeamPkSeerScoreUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TeamPkSeerScoreUpdate) AddUserID(v int64) *TeamPkSeerScoreUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *TeamPkSeerScoreUpdate) SetSeason(v int) *TeamPkSeerScoreUpdate {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdate) SetNillableSeason(v *int) *TeamPkSeerScoreUpdate {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *TeamPkSeerScoreUpdate) AddSeason(v int) *TeamPkSeerScoreUpdate {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamPkSeerScoreUpdate) SetTeamID(v int64) *TeamPkSeerScoreUpdate {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdate) SetNillableTeamID(v *int64) *TeamPkSeerScoreUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *TeamPkSeerScoreUpdate) AddTeamID(v int64) *TeamPkSeerScoreUpdate {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// SetNick sets the "nick" field.
+func (_u *TeamPkSeerScoreUpdate) SetNick(v string) *TeamPkSeerScoreUpdate {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdate) SetNillableNick(v *string) *TeamPkSeerScoreUpdate {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamPkSeerScoreUpdate) SetScore(v int) *TeamPkSeerScoreUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdate) SetNillableScore(v *int) *TeamPkSeerScoreUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamPkSeerScoreUpdate) AddScore(v int) *TeamPkSeerScoreUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TeamPkSeerScoreUpdate) SetUpdatedAt(v time.Time) *TeamPkSeerScoreUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TeamPkSeerScoreMutation object of the builder.
+func (_u *TeamPkSeerScoreUpdate) Mutation() *TeamPkSeerScoreMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TeamPkSeerScoreUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamPkSeerScoreUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TeamPkSeerScoreUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamPkSeerScoreUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TeamPkSeerScoreUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := teampkseerscore.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TeamPkSeerScoreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teampkseerscore.Table, teampkseerscore.Columns, sqlgraph.NewFieldSpec(teampkseerscore.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(teampkseerscore.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(teampkseerscore.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(teampkseerscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(teampkseerscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(teampkseerscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(teampkseerscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(teampkseerscore.FieldNick, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(teampkseerscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(teampkseerscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(teampkseerscore.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teampkseerscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TeamPkSeerScoreUpdateOne is the builder for updating a single TeamPkSeerScore entity.
+type TeamPkSeerScoreUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamPkSeerScoreMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TeamPkSeerScoreUpdateOne) SetUserID(v int64) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdateOne) SetNillableUserID(v *int64) *TeamPkSeerScoreUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TeamPkSeerScoreUpdateOne) AddUserID(v int64) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSeason sets the "season" field.
+func (_u *TeamPkSeerScoreUpdateOne) SetSeason(v int) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.ResetSeason()
+	_u.mutation.SetSeason(v)
+	return _u
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdateOne) SetNillableSeason(v *int) *TeamPkSeerScoreUpdateOne {
+	if v != nil {
+		_u.SetSeason(*v)
+	}
+	return _u
+}
+
+// AddSeason adds value to the "season" field.
+func (_u *TeamPkSeerScoreUpdateOne) AddSeason(v int) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.AddSeason(v)
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *TeamPkSeerScoreUpdateOne) SetTeamID(v int64) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdateOne) SetNillableTeamID(v *int64) *TeamPkSeerScoreUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *TeamPkSeerScoreUpdateOne) AddTeamID(v int64) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// SetNick sets the "nick" field.
+func (_u *TeamPkSeerScoreUpdateOne) SetNick(v string) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.SetNick(v)
+	return _u
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdateOne) SetNillableNick(v *string) *TeamPkSeerScoreUpdateOne {
+	if v != nil {
+		_u.SetNick(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *TeamPkSeerScoreUpdateOne) SetScore(v int) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *TeamPkSeerScoreUpdateOne) SetNillableScore(v *int) *TeamPkSeerScoreUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *TeamPkSeerScoreUpdateOne) AddScore(v int) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TeamPkSeerScoreUpdateOne) SetUpdatedAt(v time.Time) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TeamPkSeerScoreMutation object of the builder.
+func (_u *TeamPkSeerScoreUpdateOne) Mutation() *TeamPkSeerScoreMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TeamPkSeerScoreUpdate builder.
+func (_u *TeamPkSeerScoreUpdateOne) Where(ps ...predicate.TeamPkSeerScore) *TeamPkSeerScoreUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TeamPkSeerScoreUpdateOne) Select(field string, fields ...string) *TeamPkSeerScoreUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TeamPkSeerScore entity.
+func (_u *TeamPkSeerScoreUpdateOne) Save(ctx context.Context) (*TeamPkSeerScore, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TeamPkSeerScoreUpdateOne) SaveX(ctx context.Context) *TeamPkSeerScore {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TeamPkSeerScoreUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TeamPkSeerScoreUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TeamPkSeerScoreUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := teampkseerscore.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TeamPkSeerScoreUpdateOne) sqlSave(ctx context.Context) (_node *TeamPkSeerScore, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teampkseerscore.Table, teampkseerscore.Columns, sqlgraph.NewFieldSpec(teampkseerscore.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamPkSeerScore.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teampkseerscore.FieldID)
+		for _, f := range fields {
+			if !teampkseerscore.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teampkseerscore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(teampkseerscore.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(teampkseerscore.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Season(); ok {
+		_spec.SetField(teampkseerscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeason(); ok {
+		_spec.AddField(teampkseerscore.FieldSeason, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(teampkseerscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(teampkseerscore.FieldTeamID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Nick(); ok {
+		_spec.SetField(teampkseerscore.FieldNick, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(teampkseerscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(teampkseerscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(teampkseerscore.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TeamPkSeerScore{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teampkseerscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
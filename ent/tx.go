@@ -16,6 +16,10 @@ type Tx struct {
 	Account *AccountClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// ChatLog is the client for interacting with the ChatLog builders.
+	ChatLog *ChatLogClient
+	// ChatMute is the client for interacting with the ChatMute builders.
+	ChatMute *ChatMuteClient
 	// ConfigEntry is the client for interacting with the ConfigEntry builders.
 	ConfigEntry *ConfigEntryClient
 	// ConfigVersion is the client for interacting with the ConfigVersion builders.
@@ -24,14 +28,28 @@ type Tx struct {
 	GMUser *GMUserClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// Mentorship is the client for interacting with the Mentorship builders.
+	Mentorship *MentorshipClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
+	// PvpRating is the client for interacting with the PvpRating builders.
+	PvpRating *PvpRatingClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
+	// TeamMember is the client for interacting with the TeamMember builders.
+	TeamMember *TeamMemberClient
+	// TeamPkHistory is the client for interacting with the TeamPkHistory builders.
+	TeamPkHistory *TeamPkHistoryClient
+	// TeamPkScore is the client for interacting with the TeamPkScore builders.
+	TeamPkScore *TeamPkScoreClient
+	// TeamPkSeerScore is the client for interacting with the TeamPkSeerScore builders.
+	TeamPkSeerScore *TeamPkSeerScoreClient
 
 	// lazily loaded.
 	client     *Client
@@ -165,14 +183,23 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.ChatLog = NewChatLogClient(tx.config)
+	tx.ChatMute = NewChatMuteClient(tx.config)
 	tx.ConfigEntry = NewConfigEntryClient(tx.config)
 	tx.ConfigVersion = NewConfigVersionClient(tx.config)
 	tx.GMUser = NewGMUserClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.Mentorship = NewMentorshipClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
 	tx.Pet = NewPetClient(tx.config)
 	tx.Player = NewPlayerClient(tx.config)
+	tx.PvpRating = NewPvpRatingClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.Team = NewTeamClient(tx.config)
+	tx.TeamMember = NewTeamMemberClient(tx.config)
+	tx.TeamPkHistory = NewTeamPkHistoryClient(tx.config)
+	tx.TeamPkScore = NewTeamPkScoreClient(tx.config)
+	tx.TeamPkSeerScore = NewTeamPkSeerScoreClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,242 @@
+package game
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// autoFightConfig is read from auto-fight.json (GM key auto_fight).
+type autoFightConfig struct {
+	MinHPPercent int `json:"minHpPercent"`
+	MaxFights    int `json:"maxFights"`
+	MaxTurns     int `json:"maxTurns"`
+}
+
+const autoFightConfigFile = "auto-fight.json"
+
+func defaultAutoFightConfig() autoFightConfig {
+	return autoFightConfig{
+		MinHPPercent: 30,
+		MaxFights:    50,
+		MaxTurns:     50,
+	}
+}
+
+func loadAutoFightConfig(deps *Deps) autoFightConfig {
+	cfg := defaultAutoFightConfig()
+	readStoreConfigJSON(deps, autoFightConfigFile, &cfg)
+	def := defaultAutoFightConfig()
+	if cfg.MinHPPercent < 0 || cfg.MinHPPercent >= 100 {
+		cfg.MinHPPercent = def.MinHPPercent
+	}
+	if cfg.MaxFights <= 0 {
+		cfg.MaxFights = def.MaxFights
+	}
+	if cfg.MaxTurns <= 0 {
+		cfg.MaxTurns = def.MaxTurns
+	}
+	return cfg
+}
+
+const (
+	autoFightOK uint32 = iota
+	autoFightNoCharges
+	autoFightInFight
+	autoFightNoPet
+	autoFightNoOgre
+)
+
+const (
+	autoFightStopOff uint32 = iota
+	autoFightStopCharges
+	autoFightStopLowHP
+	autoFightStopLost
+	autoFightStopLimit
+)
+
+type autoFightSummary struct {
+	Fights     int
+	Wins       int
+	StopReason uint32
+	Learned    []int
+	Drops      map[int]int
+}
+
+// sortedDrops lists the dropped items by item ID.
+func (s autoFightSummary) sortedDrops() [][2]int {
+	out := make([][2]int, 0, len(s.Drops))
+	for id, n := range s.Drops {
+		out = append(out, [2]int{id, n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+	return out
+}
+
+// runAutoFight battles the current map's ogres with the current pet, one
+// charge per fight, until charges run out, the pet's HP drops under the
+// configured share, a fight is lost or the per-run cap is reached.
+func runAutoFight(deps *Deps, user *User) (uint32, autoFightSummary) {
+	sum := autoFightSummary{Drops: map[int]int{}}
+	if user.Fight != nil {
+		return autoFightInFight, sum
+	}
+	if user.AutoFightTimes == 0 {
+		return autoFightNoCharges, sum
+	}
+	if len(user.Pets) == 0 || allPetsFainted(user) {
+		return autoFightNoPet, sum
+	}
+	ogres := autoFightOgres(user.MapID)
+	if len(ogres) == 0 {
+		return autoFightNoOgre, sum
+	}
+	cfg := loadAutoFightConfig(deps)
+	user.AutoFight = 1
+	for {
+		if user.AutoFightTimes == 0 {
+			sum.StopReason = autoFightStopCharges
+			break
+		}
+		if sum.Fights >= cfg.MaxFights {
+			sum.StopReason = autoFightStopLimit
+			break
+		}
+		player := resolveUserFightPet(user, user.CatchID, user.CurrentPetID)
+		if player.CurrentHP*100 < cfg.MinHPPercent*player.Stats.MaxHP {
+			sum.StopReason = autoFightStopLowHP
+			break
+		}
+		ogre := pickAutoFightOgre(ogres)
+		f := newAutoFightState(user, player, ogre)
+		won := simulateAutoFight(f, cfg.MaxTurns)
+		user.AutoFightTimes--
+		sum.Fights++
+		sum.Learned = append(sum.Learned, updateFightResult(deps, user, f, won)...)
+		if !won {
+			sum.StopReason = autoFightStopLost
+			break
+		}
+		sum.Wins++
+		for _, drop := range ogre.DropItems {
+			if drop.ItemID <= 0 || rand.Float64() >= drop.DropRate {
+				continue
+			}
+			lo := maxInt(1, drop.MinCount)
+			n := lo + rand.Intn(maxInt(lo, drop.MaxCount)-lo+1)
+			if grantItem(deps, user, drop.ItemID, n) {
+				sum.Drops[drop.ItemID] += n
+			}
+		}
+	}
+	user.AutoFight = 0
+	savePlayer(deps, user.ID, user)
+	return autoFightOK, sum
+}
+
+func autoFightOgres(mapID uint32) []mapOgreEntry {
+	var out []mapOgreEntry
+	for _, o := range getMapOgreEntries(mapID) {
+		if o.PetID > 0 && !o.IsBoss {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+func pickAutoFightOgre(ogres []mapOgreEntry) mapOgreEntry {
+	total := 0
+	for _, o := range ogres {
+		total += maxInt(1, o.Weight)
+	}
+	roll := rand.Intn(total)
+	for _, o := range ogres {
+		roll -= maxInt(1, o.Weight)
+		if roll < 0 {
+			return o
+		}
+	}
+	return ogres[len(ogres)-1]
+}
+
+func autoFightOgreLevel(o mapOgreEntry) int {
+	if o.MinLevel > 0 && o.MaxLevel >= o.MinLevel {
+		return o.MinLevel + rand.Intn(o.MaxLevel-o.MinLevel+1)
+	}
+	return maxInt(1, o.Level)
+}
+
+func newAutoFightState(user *User, player fightPetSnapshot, ogre mapOgreEntry) *FightState {
+	enemy := resolveEnemyFightPet(ogre.PetID, autoFightOgreLevel(ogre))
+	f := &FightState{
+		UserID:        user.ID,
+		PlayerPetID:   player.ID,
+		PlayerLevel:   player.Level,
+		PlayerDV:      player.DV,
+		PlayerHP:      player.CurrentHP,
+		PlayerMaxHP:   player.Stats.MaxHP,
+		PlayerCatch:   player.CatchTime,
+		PlayerSkills:  player.Skills,
+		PlayerStats:   player.Stats,
+		PlayerType:    player.Type,
+		PlayerSkillPP: player.SkillPP,
+		PlayerStatus:  player.Status,
+		EnemyPetID:    enemy.ID,
+		EnemyLevel:    enemy.Level,
+		EnemyHP:       enemy.CurrentHP,
+		EnemyMaxHP:    enemy.Stats.MaxHP,
+		EnemySkills:   enemy.Skills,
+		EnemyStats:    enemy.Stats,
+		EnemyType:     enemy.Type,
+		EnemyAI:       ogre.AI,
+		EnemyAIScript: ogre.AIScript,
+	}
+	ensureFightStatus(f)
+	ensureFightSkillPP(f)
+	return f
+}
+
+// simulateAutoFight plays a fight to the end without a client, one
+// resolvePvETurn per turn. The player side uses the greedy battle AI on a
+// mirrored view of the fight. Running out of turns counts as a loss.
+func simulateAutoFight(f *FightState, maxTurns int) bool {
+	for f.Turn < maxTurns && f.PlayerHP > 0 && f.EnemyHP > 0 {
+		choice := greedyAI{}.SelectSkill(mirrorFightState(f))
+		playerSkill := pickSkillWithEncore(choice, f.PlayerSkills, f.PlayerSkillPP, &f.PlayerEncoreSkill, &f.PlayerEncoreTurns)
+		if _, _, bossTimeout := resolvePvETurn(nil, f, playerSkill); bossTimeout {
+			break
+		}
+	}
+	f.PlayerHP = maxInt(0, f.PlayerHP)
+	return f.EnemyHP <= 0 && f.PlayerHP > 0
+}
+
+// mirrorFightState swaps the two sides so enemy-side AI can pick a move for
+// the player.
+func mirrorFightState(f *FightState) *FightState {
+	m := cloneFightState(f)
+	m.PlayerPetID, m.EnemyPetID = m.EnemyPetID, m.PlayerPetID
+	m.PlayerLevel, m.EnemyLevel = m.EnemyLevel, m.PlayerLevel
+	m.PlayerDV, m.EnemyDV = m.EnemyDV, m.PlayerDV
+	m.PlayerHP, m.EnemyHP = m.EnemyHP, m.PlayerHP
+	m.PlayerMaxHP, m.EnemyMaxHP = m.EnemyMaxHP, m.PlayerMaxHP
+	m.PlayerCatch, m.EnemyCatch = m.EnemyCatch, m.PlayerCatch
+	m.PlayerSkills, m.EnemySkills = m.EnemySkills, m.PlayerSkills
+	m.PlayerStats, m.EnemyStats = m.EnemyStats, m.PlayerStats
+	m.PlayerType, m.EnemyType = m.EnemyType, m.PlayerType
+	m.PlayerStage, m.EnemyStage = m.EnemyStage, m.PlayerStage
+	m.PlayerSkillPP, m.EnemySkillPP = m.EnemySkillPP, m.PlayerSkillPP
+	m.PlayerStatus, m.EnemyStatus = m.EnemyStatus, m.PlayerStatus
+	m.PlayerLastSkill, m.EnemyLastSkill = m.EnemyLastSkill, m.PlayerLastSkill
+	m.PlayerEncoreSkill, m.EnemyEncoreSkill = m.EnemyEncoreSkill, m.PlayerEncoreSkill
+	m.PlayerEncoreTurns, m.EnemyEncoreTurns = m.EnemyEncoreTurns, m.PlayerEncoreTurns
+	m.PlayerBoundTurns, m.EnemyBoundTurns = m.EnemyBoundTurns, m.PlayerBoundTurns
+	m.PlayerFlinch, m.EnemyFlinch = m.EnemyFlinch, m.PlayerFlinch
+	m.PlayerSeeded, m.EnemySeeded = m.EnemySeeded, m.PlayerSeeded
+	m.PlayerProtect, m.EnemyProtect = m.EnemyProtect, m.PlayerProtect
+	m.PlayerMist, m.EnemyMist = m.EnemyMist, m.PlayerMist
+	m.PlayerSafeguard, m.EnemySafeguard = m.EnemySafeguard, m.PlayerSafeguard
+	m.PlayerFatigue, m.EnemyFatigue = m.EnemyFatigue, m.PlayerFatigue
+	m.PlayerBuffs, m.EnemyBuffs = m.EnemyBuffs, m.PlayerBuffs
+	m.PlayerDamageTaken, m.EnemyDamageTaken = m.EnemyDamageTaken, m.PlayerDamageTaken
+	return m
+}
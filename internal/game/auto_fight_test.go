@@ -0,0 +1,93 @@
+package game
+
+import "testing"
+
+const (
+	testAutoFightMap   = 990038
+	testAutoFightSkill = 990381
+)
+
+func withAutoFightMap(t *testing.T, ogres []mapOgreEntry) {
+	t.Helper()
+	mapOgresOnce.Do(loadMapOgres)
+	mapOgresEntries[testAutoFightMap] = ogres
+	t.Cleanup(func() { delete(mapOgresEntries, testAutoFightMap) })
+}
+
+func autoFightUser(level uint32) *User {
+	seedProgressSpecies()
+	db := LoadPetDB()
+	db.mu.Lock()
+	db.skills[testAutoFightSkill] = &SkillInfo{ID: testAutoFightSkill, PP: 30, Power: 80, Category: 1, Accuracy: 100, MustHit: true}
+	db.mu.Unlock()
+	p := Pet{ID: 990102, Level: level, DV: 31, CatchTime: 1, Skills: []int{testAutoFightSkill, 0, 0, 0}}
+	p.HP = getStats(LoadPetDB().pets[int(p.ID)], int(p.Level), int(p.DV), evSet{}).MaxHP
+	return &User{MapID: testAutoFightMap, CatchID: 1, CurrentPetID: 990102, Pets: []Pet{p}}
+}
+
+func TestRunAutoFightSpendsCharges(t *testing.T) {
+	withAutoFightMap(t, []mapOgreEntry{
+		{Slot: 0, PetID: 990101, Level: 1},
+		{Slot: 1, PetID: 990102, Level: 99, IsBoss: true},
+	})
+	user := autoFightUser(60)
+	user.AutoFightTimes = 3
+	result, sum := runAutoFight(nil, user)
+	if result != autoFightOK || sum.Fights != 3 || sum.Wins != 3 || sum.StopReason != autoFightStopCharges {
+		t.Fatalf("result=%d summary=%+v", result, sum)
+	}
+	if user.AutoFightTimes != 0 || user.AutoFight != 0 {
+		t.Fatalf("charges=%d autoFight=%d", user.AutoFightTimes, user.AutoFight)
+	}
+}
+
+func TestRunAutoFightStopsOnLowHP(t *testing.T) {
+	withAutoFightMap(t, []mapOgreEntry{{Slot: 0, PetID: 990101, Level: 1}})
+	user := autoFightUser(60)
+	user.Pets[0].HP = 1
+	user.AutoFightTimes = 3
+	if _, sum := runAutoFight(nil, user); sum.Fights != 0 || sum.StopReason != autoFightStopLowHP {
+		t.Fatalf("summary=%+v", sum)
+	}
+	if user.AutoFightTimes != 3 {
+		t.Fatalf("charges=%d", user.AutoFightTimes)
+	}
+}
+
+func TestRunAutoFightRejects(t *testing.T) {
+	withAutoFightMap(t, []mapOgreEntry{{Slot: 0, PetID: 990101, Level: 1, IsBoss: true}})
+	user := autoFightUser(60)
+	if r, _ := runAutoFight(nil, user); r != autoFightNoCharges {
+		t.Fatalf("no charges=%d", r)
+	}
+	user.AutoFightTimes = 1
+	if r, _ := runAutoFight(nil, user); r != autoFightNoOgre {
+		t.Fatalf("boss only=%d", r)
+	}
+	user.Fight = &FightState{}
+	if r, _ := runAutoFight(nil, user); r != autoFightInFight {
+		t.Fatalf("in fight=%d", r)
+	}
+}
+
+func TestSimulateAutoFightRunsBossTurns(t *testing.T) {
+	user := autoFightUser(60)
+	player := resolveUserFightPet(user, user.CatchID, user.CurrentPetID)
+	f := newAutoFightState(user, player, mapOgreEntry{PetID: 990101, Level: 1})
+	startBossEncounter(f, &BossEncounter{PetID: 990101, TurnLimit: 2})
+	f.BossShieldTurns = 5
+	if simulateAutoFight(f, 10) {
+		t.Fatal("won through the boss shield")
+	}
+	if f.Turn != 2 || f.EnemyHP != f.EnemyMaxHP || f.PlayerSkillPP[testAutoFightSkill] != 28 {
+		t.Fatalf("turn=%d enemyHP=%d/%d pp=%v", f.Turn, f.EnemyHP, f.EnemyMaxHP, f.PlayerSkillPP)
+	}
+}
+
+func TestMirrorFightStateSwapsSides(t *testing.T) {
+	f := &FightState{PlayerHP: 10, EnemyHP: 3, PlayerSkills: []int{1}, EnemySkills: []int{2}}
+	m := mirrorFightState(f)
+	if m.PlayerHP != 3 || m.EnemyHP != 10 || m.EnemySkills[0] != 1 || f.PlayerHP != 10 {
+		t.Fatalf("mirror=%+v", m)
+	}
+}
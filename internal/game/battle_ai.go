@@ -0,0 +1,287 @@
+package game
+
+import "strings"
+
+// battleAI picks the enemy skill for one PvE turn.
+type battleAI interface {
+	SelectSkill(f *FightState) int
+}
+
+const (
+	aiRandom    = "random"
+	aiGreedy    = "greedy"
+	aiLookahead = "lookahead"
+	aiScripted  = "scripted"
+)
+
+// lookaheadSamples is how many times each candidate skill is simulated to
+// smooth out accuracy, crit and side-effect rolls.
+const lookaheadSamples = 4
+
+type randomAI struct{}
+
+type greedyAI struct{}
+
+type lookaheadAI struct{}
+
+// scriptedAI cycles through a fixed skill pattern, falling back to greedy
+// when the scripted skill is out of PP.
+type scriptedAI struct {
+	Script []int
+}
+
+func newBattleAI(name string, script []int) battleAI {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case aiRandom:
+		return randomAI{}
+	case aiLookahead:
+		return lookaheadAI{}
+	case aiScripted:
+		if len(script) > 0 {
+			return scriptedAI{Script: script}
+		}
+	}
+	return greedyAI{}
+}
+
+// bossBattleAI returns the AI configured for a boss: spt-boss.json first,
+// then the map-boss.json entry of the current map with the same pet.
+func bossBattleAI(mapID uint32, bossID int) (string, []int) {
+	if cfg := GetSPTBossByID(bossID); cfg != nil && cfg.AI != "" {
+		return cfg.AI, cfg.AIScript
+	}
+	for _, entry := range getMapBossEntries(int(mapID)) {
+		if entry.BossPetID == bossID && entry.AI != "" {
+			return entry.AI, entry.AIScript
+		}
+	}
+	return "", nil
+}
+
+// ogreBattleAI returns the AI of the map-ogres.json entry for petID,
+// preferring the entry in slot.
+func ogreBattleAI(mapID uint32, slot int, petID int) (string, []int) {
+	var ai string
+	var script []int
+	for _, o := range getMapOgreEntries(mapID) {
+		if o.PetID != petID {
+			continue
+		}
+		if o.Slot == slot {
+			return o.AI, o.AIScript
+		}
+		if ai == "" {
+			ai, script = o.AI, o.AIScript
+		}
+	}
+	return ai, script
+}
+
+func selectEnemySkill(f *FightState) int {
+	if f == nil {
+		return 0
+	}
+	return newBattleAI(f.EnemyAI, f.EnemyAIScript).SelectSkill(f)
+}
+
+func (randomAI) SelectSkill(f *FightState) int {
+	return selectRandomSkillWithPP(f.EnemySkills, f.EnemySkillPP)
+}
+
+func (greedyAI) SelectSkill(f *FightState) int {
+	best := 0
+	bestScore := -1.0
+	for _, sid := range usableEnemySkills(f) {
+		score := greedySkillScore(f, sid)
+		if score > bestScore {
+			bestScore = score
+			best = sid
+		}
+	}
+	return best
+}
+
+func (lookaheadAI) SelectSkill(f *FightState) int {
+	best := 0
+	bestScore := 0.0
+	for _, sid := range usableEnemySkills(f) {
+		score := 0.0
+		for i := 0; i < lookaheadSamples; i++ {
+			score += simulateEnemyTurn(f, sid)
+		}
+		if best == 0 || score > bestScore {
+			bestScore = score
+			best = sid
+		}
+	}
+	return best
+}
+
+func (a scriptedAI) SelectSkill(f *FightState) int {
+	if len(a.Script) > 0 {
+		turn := maxInt(f.Turn-1, 0)
+		sid := a.Script[turn%len(a.Script)]
+		if sid > 0 && (f.EnemySkillPP == nil || f.EnemySkillPP[sid] > 0 || !containsSkill(f.EnemySkills, sid)) {
+			return sid
+		}
+	}
+	return greedyAI{}.SelectSkill(f)
+}
+
+func usableEnemySkills(f *FightState) []int {
+	out := make([]int, 0, len(f.EnemySkills))
+	for _, sid := range f.EnemySkills {
+		if sid <= 0 {
+			continue
+		}
+		if f.EnemySkillPP != nil && f.EnemySkillPP[sid] <= 0 {
+			continue
+		}
+		if getSkillInfo(sid) == nil {
+			continue
+		}
+		out = append(out, sid)
+	}
+	return out
+}
+
+// greedySkillScore rates a skill for the enemy side: expected damage for
+// attacks, situational value for status/stage effects, scaled by how much
+// PP is left so strong moves are not burned early.
+func greedySkillScore(f *FightState, sid int) float64 {
+	info := getSkillInfo(sid)
+	if info == nil {
+		return 0
+	}
+	score := 0.0
+	if info.Power > 0 && info.Category != 4 {
+		score = float64(info.Power) * elementMultiplier(info.Type, f.PlayerType) * (float64(info.Accuracy) / 100.0)
+		if f.PlayerMaxHP > 0 && float64(f.PlayerHP)/float64(f.PlayerMaxHP) < 0.3 {
+			score *= 1.5
+			if info.Priority > 0 {
+				score *= 1.2
+			}
+		}
+	}
+	for _, call := range skillEffectCalls(info) {
+		score += effectScore(f, call)
+	}
+	if score <= 0 {
+		score = 10
+	}
+	if maxPP := getSkillPP(sid); maxPP > 0 && f.EnemySkillPP != nil {
+		score *= 0.75 + 0.25*float64(f.EnemySkillPP[sid])/float64(maxPP)
+	}
+	return score
+}
+
+func effectScore(f *FightState, call effectCall) float64 {
+	score := 0.0
+	for _, op := range call.Ops {
+		score += effectOpScore(f, op)
+	}
+	return score
+}
+
+// effectOpScore values one bound primitive for the enemy side.
+func effectOpScore(f *FightState, op effectOp) float64 {
+	enemyHealthy := f.EnemyMaxHP > 0 && f.EnemyHP*2 > f.EnemyMaxHP
+	chance := func(def int) float64 {
+		c := def
+		if v, ok := op.Params["chance"]; ok {
+			c = v
+		}
+		return float64(minInt(maxInt(c, 0), 100)) / 100
+	}
+	switch op.Name {
+	case "status", "status_random":
+		if hasMajorStatus(f.PlayerStatus) || f.PlayerSafeguard > 0 {
+			return 0
+		}
+		return 40 * chance(10)
+	case "stage":
+		stages := op.Params["stages"]
+		if down, ok := op.Params["down"]; ok {
+			stages = -down
+		}
+		if op.Params["target"] == effectTargetFoe {
+			if stages >= 0 || f.PlayerMist > 0 {
+				return 0
+			}
+			return 15 * float64(-stages) * chance(100)
+		}
+		if stages <= 0 || !enemyHealthy || sumPositiveStages(f.EnemyStage) >= 4 {
+			return 0
+		}
+		return 25 * float64(stages) * chance(100)
+	case "drain", "heal":
+		if f.EnemyHP < f.EnemyMaxHP {
+			return 15
+		}
+	case "seed":
+		if f.PlayerSeeded == 0 {
+			return 25
+		}
+	case "guard":
+		if enemyHealthy {
+			return 10
+		}
+	case "clear_stages":
+		if op.Params["target"] == effectTargetSelf && (f.EnemyStage.Atk < 0 || f.EnemyStage.SpA < 0 || f.EnemyStage.Spd < 0) {
+			return 30
+		}
+	}
+	return 0
+}
+
+// simulateEnemyTurn resolves sid against a copy of the fight and scores the
+// resulting position: HP swing plus the player's best greedy reply.
+func simulateEnemyTurn(f *FightState, sid int) float64 {
+	sim := cloneFightState(f)
+	playerHP := sim.PlayerHP
+	enemyHP := sim.EnemyHP
+	executeAttack(nil, sim, false, sid, true)
+	dealt := playerHP - sim.PlayerHP
+	healed := sim.EnemyHP - enemyHP
+	if sim.PlayerHP <= 0 {
+		return float64(dealt) + 1000
+	}
+	score := float64(dealt) + float64(healed)*0.5
+	score += 15 * float64(sumPositiveStages(sim.EnemyStage)-sumPositiveStages(f.EnemyStage))
+	if !hasMajorStatus(f.PlayerStatus) && hasMajorStatus(sim.PlayerStatus) {
+		score += 30
+	}
+	reply := 0
+	for _, psid := range sim.PlayerSkills {
+		if psid <= 0 || sim.PlayerSkillPP != nil && sim.PlayerSkillPP[psid] <= 0 {
+			continue
+		}
+		replySim := cloneFightState(sim)
+		before := replySim.EnemyHP
+		executeAttack(nil, replySim, true, psid, false)
+		reply = maxInt(reply, before-replySim.EnemyHP)
+	}
+	return score - float64(reply)*0.5
+}
+
+func cloneFightState(f *FightState) *FightState {
+	out := *f
+	out.PlayerSkillPP = cloneIntMap(f.PlayerSkillPP)
+	out.EnemySkillPP = cloneIntMap(f.EnemySkillPP)
+	out.PlayerStatus = cloneIntMap(f.PlayerStatus)
+	out.EnemyStatus = cloneIntMap(f.EnemyStatus)
+	out.PlayerBuffs = cloneBuffs(f.PlayerBuffs)
+	out.EnemyBuffs = cloneBuffs(f.EnemyBuffs)
+	return &out
+}
+
+func cloneIntMap(in map[int]int) map[int]int {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]int, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
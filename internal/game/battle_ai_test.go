@@ -0,0 +1,152 @@
+package game
+
+import "testing"
+
+func TestNewBattleAI(t *testing.T) {
+	cases := []struct {
+		name   string
+		script []int
+		want   battleAI
+	}{
+		{"", nil, greedyAI{}},
+		{"greedy", nil, greedyAI{}},
+		{"Random", nil, randomAI{}},
+		{"lookahead", nil, lookaheadAI{}},
+		{"scripted", nil, greedyAI{}},
+	}
+	for _, tc := range cases {
+		if got := newBattleAI(tc.name, tc.script); got != tc.want {
+			t.Fatalf("%q: got %T want %T", tc.name, got, tc.want)
+		}
+	}
+	if _, ok := newBattleAI("scripted", []int{1}).(scriptedAI); !ok {
+		t.Fatal("expected scripted AI")
+	}
+}
+
+func TestScriptedAICycles(t *testing.T) {
+	f := &FightState{
+		EnemySkills:  []int{10001, 10002},
+		EnemySkillPP: map[int]int{10001: 5, 10002: 5},
+	}
+	ai := scriptedAI{Script: []int{10002, 10001, 10001}}
+	want := []int{10002, 10001, 10001, 10002}
+	for i, sid := range want {
+		f.Turn = i + 1
+		if got := ai.SelectSkill(f); got != sid {
+			t.Fatalf("turn %d: got %d want %d", f.Turn, got, sid)
+		}
+	}
+}
+
+func TestRandomAIRespectsPP(t *testing.T) {
+	f := &FightState{
+		EnemySkills:  []int{10001, 10002},
+		EnemySkillPP: map[int]int{10001: 0, 10002: 3},
+	}
+	for i := 0; i < 20; i++ {
+		if got := (randomAI{}).SelectSkill(f); got != 10002 {
+			t.Fatalf("got %d", got)
+		}
+	}
+}
+
+func TestCloneFightStateIsolatesMaps(t *testing.T) {
+	f := &FightState{
+		PlayerStatus: map[int]int{statusBurn: 2},
+		EnemySkillPP: map[int]int{10001: 5},
+	}
+	sim := cloneFightState(f)
+	sim.PlayerStatus[statusBurn] = 0
+	sim.EnemySkillPP[10001] = 0
+	if f.PlayerStatus[statusBurn] != 2 || f.EnemySkillPP[10001] != 5 {
+		t.Fatalf("original mutated: %v %v", f.PlayerStatus, f.EnemySkillPP)
+	}
+}
+
+const (
+	testAISureHit  = 990271
+	testAIGamble   = 990272
+	testAIParalyze = 990273
+)
+
+// withAISkills registers a 30-power sure hit, a 150-power move with 25%
+// accuracy and a status move that always paralyses.
+func withAISkills(t *testing.T) {
+	t.Helper()
+	useMoveEffectData(t)
+	db := LoadPetDB()
+	db.mu.Lock()
+	db.skills[testAISureHit] = &SkillInfo{ID: testAISureHit, PP: 10, Power: 30, Category: 1, Accuracy: 100, MustHit: true}
+	db.skills[testAIGamble] = &SkillInfo{ID: testAIGamble, PP: 10, Power: 150, Category: 1, Accuracy: 25}
+	db.skills[testAIParalyze] = &SkillInfo{ID: testAIParalyze, PP: 10, Category: 4, Accuracy: 100, MustHit: true, SideEffects: []int{10}, SideEffectArg: "100"}
+	db.mu.Unlock()
+}
+
+func aiTestFight(playerHP int, skills ...int) *FightState {
+	f := &FightState{
+		PlayerHP:     playerHP,
+		PlayerMaxHP:  100,
+		EnemyHP:      100,
+		EnemyMaxHP:   100,
+		PlayerLevel:  10,
+		EnemyLevel:   10,
+		EnemySkills:  skills,
+		EnemySkillPP: map[int]int{},
+	}
+	for _, sid := range skills {
+		f.EnemySkillPP[sid] = 10
+	}
+	ensureFightStatus(f)
+	return f
+}
+
+func TestGreedyAIChoices(t *testing.T) {
+	withAISkills(t)
+	// 150 power at 25% accuracy scores 37.5 against 30 for the sure hit.
+	if got := (greedyAI{}).SelectSkill(aiTestFight(100, testAISureHit, testAIGamble)); got != testAIGamble {
+		t.Fatalf("attack: got %d", got)
+	}
+	// A sure paralysis scores 40 and beats both attacks...
+	f := aiTestFight(100, testAISureHit, testAIGamble, testAIParalyze)
+	if got := (greedyAI{}).SelectSkill(f); got != testAIParalyze {
+		t.Fatalf("status: got %d", got)
+	}
+	// ...but is worthless once the player already has a status.
+	f.PlayerStatus[statusBurn] = 3
+	if got := (greedyAI{}).SelectSkill(f); got != testAIGamble {
+		t.Fatalf("statused player: got %d", got)
+	}
+}
+
+func TestLookaheadAIChoices(t *testing.T) {
+	withAISkills(t)
+	// Greedy still gambles on the 25% move against a 1 HP player; the
+	// lookahead sees the sure hit finishes the fight.
+	f := aiTestFight(1, testAISureHit, testAIGamble)
+	if got := (greedyAI{}).SelectSkill(f); got != testAIGamble {
+		t.Fatalf("greedy: got %d", got)
+	}
+	if got := (lookaheadAI{}).SelectSkill(f); got != testAISureHit {
+		t.Fatalf("lookahead: got %d", got)
+	}
+	if f.PlayerHP != 1 || f.EnemySkillPP[testAISureHit] != 10 {
+		t.Fatalf("lookahead mutated the fight: hp=%d pp=%v", f.PlayerHP, f.EnemySkillPP)
+	}
+}
+
+func TestOgreBattleAI(t *testing.T) {
+	withAutoFightMap(t, []mapOgreEntry{
+		{Slot: 0, PetID: 990101, AI: aiRandom},
+		{Slot: 1, PetID: 990101, AI: aiScripted, AIScript: []int{testAISureHit}},
+	})
+	if ai, script := ogreBattleAI(testAutoFightMap, 1, 990101); ai != aiScripted || len(script) != 1 {
+		t.Fatalf("slot 1: %q %v", ai, script)
+	}
+	if ai, _ := ogreBattleAI(testAutoFightMap, 5, 990101); ai != aiRandom {
+		t.Fatalf("other slot: %q", ai)
+	}
+	if ai, _ := ogreBattleAI(testAutoFightMap, 0, 990102); ai != "" {
+		t.Fatalf("unknown pet: %q", ai)
+	}
+}
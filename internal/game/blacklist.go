@@ -0,0 +1,41 @@
+package game
+
+// blacklistedResult is returned to a sender the target has blacklisted by
+// handlers without their own result codes (mail, fight invites).
+const blacklistedResult uint32 = 1
+
+func hasBlacklisted(u *User, id uint32) bool {
+	return containsUint32(u.Blacklist, id)
+}
+
+// blockedBy reports whether targetID has blacklisted senderID. Offline
+// targets are checked against their stored blacklist.
+func blockedBy(deps *Deps, state *State, targetID, senderID uint32) bool {
+	target, ok := findPlayer(deps, state, targetID)
+	return ok && hasBlacklisted(target, senderID)
+}
+
+// broadcastToMapFrom sends a packet from senderID to everyone on the map
+// except players who blacklisted the sender.
+func broadcastToMapFrom(state *State, mapID uint32, senderID uint32, payload []byte) {
+	for _, id := range state.GetPlayersInMap(mapID) {
+		if u, ok := state.GetUser(id); ok && hasBlacklisted(u, senderID) {
+			continue
+		}
+		if conn, ok := state.GetConn(id); ok {
+			_, _ = conn.Write(payload)
+		}
+	}
+}
+
+// broadcastToChannelFrom is broadcastToMapFrom for a chat channel.
+func broadcastToChannelFrom(state *State, kind ChannelKind, id uint32, senderID uint32, payload []byte) {
+	for _, uid := range state.ChannelMembers(kind, id) {
+		if u, ok := state.GetUser(uid); ok && hasBlacklisted(u, senderID) {
+			continue
+		}
+		if conn, ok := state.GetConn(uid); ok {
+			_, _ = conn.Write(payload)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package game
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestMapBroadcastSkipsRecipientsWhoBlacklistedSender(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2, 3)
+	peers := map[uint32]net.Conn{}
+	for _, u := range users {
+		c, peer := net.Pipe()
+		defer c.Close()
+		defer peer.Close()
+		state.RegisterConn(u.ID, c)
+		state.UpdatePlayerMap(u.ID, 8)
+		peers[u.ID] = peer
+	}
+	users[2].Blacklist = []uint32{1}
+
+	got := make(chan uint32, 3)
+	for id, peer := range peers {
+		go func(id uint32, peer net.Conn) {
+			buf := make([]byte, 4)
+			if _, err := io.ReadFull(peer, buf); err == nil {
+				got <- id
+			}
+		}(id, peer)
+	}
+	go broadcastToMapFrom(state, 8, 1, []byte{1, 2, 3, 4})
+
+	seen := map[uint32]bool{}
+	timeout := time.After(200 * time.Millisecond)
+	for len(seen) < 2 {
+		select {
+		case id := <-got:
+			seen[id] = true
+		case <-timeout:
+			t.Fatalf("received by %v", seen)
+		}
+	}
+	select {
+	case id := <-got:
+		t.Fatalf("blacklisting user %d received the packet", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBlacklistRefusesRequests(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2)
+	users[1].Blacklist = []uint32{1}
+
+	if !blockedBy(nil, state, 2, 1) || blockedBy(nil, state, 1, 2) || blockedBy(nil, state, 99, 1) {
+		t.Fatal("blockedBy")
+	}
+	if r := requestFriend(nil, state, users[0], 2); r != friendBlocked {
+		t.Fatalf("friend request=%d", r)
+	}
+	_, team := createTeam(nil, state, users[0], "Seers")
+	if r, _ := inviteToTeam(nil, state, users[0], 2); r != teamBlocked || team == nil {
+		t.Fatalf("team invite=%d", r)
+	}
+}
@@ -0,0 +1,197 @@
+package game
+
+// BossEncounter describes a scripted boss fight in boss-encounters.json.
+type BossEncounter struct {
+	PetID      int          `json:"petId"`
+	Name       string       `json:"name"`
+	Level      int          `json:"level"`
+	MaxHP      int          `json:"maxHp"`
+	Skills     []int        `json:"skills,omitempty"`
+	AI         string       `json:"ai,omitempty"`
+	AIScript   []int        `json:"aiScript,omitempty"`
+	TurnLimit  int          `json:"turnLimit,omitempty"`
+	Phases     []BossPhase  `json:"phases,omitempty"`
+	FirstClear []BossReward `json:"firstClearRewards,omitempty"`
+	Repeat     []BossReward `json:"repeatRewards,omitempty"`
+}
+
+// BossPhase triggers once when the boss HP drops to HPPercent of max HP.
+type BossPhase struct {
+	HPPercent   int              `json:"hpPercent"`
+	Stages      BossStageChange  `json:"stages"`
+	Skills      []int            `json:"skills,omitempty"`
+	AI          string           `json:"ai,omitempty"`
+	AIScript    []int            `json:"aiScript,omitempty"`
+	HealPercent int              `json:"healPercent,omitempty"`
+	ClearStatus bool             `json:"clearStatus,omitempty"`
+	Shield      *BossShieldPhase `json:"shield,omitempty"`
+}
+
+type BossStageChange struct {
+	Atk int `json:"atk"`
+	Def int `json:"def"`
+	SpA int `json:"spAtk"`
+	SpD int `json:"spDef"`
+	Spd int `json:"speed"`
+	Acc int `json:"accuracy"`
+}
+
+// BossShieldPhase makes the player's moves miss the boss outright, effects
+// included, for a number of turns. An empty ImmuneTypes list blocks every
+// element.
+type BossShieldPhase struct {
+	Turns       int   `json:"turns"`
+	ImmuneTypes []int `json:"immuneTypes,omitempty"`
+}
+
+type BossReward struct {
+	ItemID int `json:"itemId"`
+	Count  int `json:"count"`
+	Coins  int `json:"coins"`
+}
+
+type bossEncounterFile struct {
+	Bosses []*BossEncounter `json:"bosses"`
+}
+
+const bossEncounterConfig = "boss-encounters.json"
+
+// getBossEncounter reads boss definitions through the GM config store on
+// every lookup so edits apply to the next challenge.
+func getBossEncounter(deps *Deps, petID int) *BossEncounter {
+	if petID <= 0 {
+		return nil
+	}
+	var cfg bossEncounterFile
+	if _, ok := readStoreConfigJSON(deps, bossEncounterConfig, &cfg); !ok {
+		return nil
+	}
+	for _, boss := range cfg.Bosses {
+		if boss != nil && boss.PetID == petID {
+			return boss
+		}
+	}
+	return nil
+}
+
+func startBossEncounter(f *FightState, boss *BossEncounter) {
+	if f == nil || boss == nil {
+		return
+	}
+	f.BossID = boss.PetID
+	f.BossTurnLimit = boss.TurnLimit
+	f.BossPhases = boss.Phases
+	if len(boss.Skills) > 0 {
+		setEnemySkills(f, boss.Skills)
+	}
+	if boss.AI != "" {
+		f.EnemyAI = boss.AI
+		f.EnemyAIScript = boss.AIScript
+	}
+}
+
+// advanceBossPhases enters every phase whose HP threshold has been crossed.
+func advanceBossPhases(f *FightState) {
+	if f == nil || f.BossID == 0 || f.EnemyHP <= 0 || f.EnemyMaxHP <= 0 {
+		return
+	}
+	for f.BossPhase < len(f.BossPhases) {
+		phase := f.BossPhases[f.BossPhase]
+		if f.EnemyHP*100 > f.EnemyMaxHP*phase.HPPercent {
+			return
+		}
+		f.BossPhase++
+		f.EnemyStage = clampStageChange(f.EnemyStage, fightStateChange{
+			Atk: phase.Stages.Atk,
+			Def: phase.Stages.Def,
+			SpA: phase.Stages.SpA,
+			SpD: phase.Stages.SpD,
+			Spd: phase.Stages.Spd,
+			Acc: phase.Stages.Acc,
+		})
+		if len(phase.Skills) > 0 {
+			setEnemySkills(f, phase.Skills)
+		}
+		if phase.AI != "" {
+			f.EnemyAI = phase.AI
+			f.EnemyAIScript = phase.AIScript
+		}
+		if phase.HealPercent > 0 {
+			f.EnemyHP = minInt(f.EnemyMaxHP, f.EnemyHP+f.EnemyMaxHP*phase.HealPercent/100)
+		}
+		if phase.ClearStatus {
+			f.EnemyStatus = make(map[int]int)
+		}
+		if phase.Shield != nil && phase.Shield.Turns > 0 {
+			f.BossShieldTurns = phase.Shield.Turns
+			f.BossImmuneTypes = phase.Shield.ImmuneTypes
+		}
+	}
+}
+
+// skipPassedBossPhases marks the phases a map boss already went through in
+// earlier fights as done without replaying their heals and shields.
+func skipPassedBossPhases(f *FightState) {
+	for f.BossPhase < len(f.BossPhases) && f.EnemyHP*100 <= f.EnemyMaxHP*f.BossPhases[f.BossPhase].HPPercent {
+		f.BossPhase++
+	}
+}
+
+func bossShieldBlocks(f *FightState, info *SkillInfo) bool {
+	if f == nil || f.BossShieldTurns <= 0 {
+		return false
+	}
+	if len(f.BossImmuneTypes) == 0 {
+		return true
+	}
+	return info != nil && containsSkill(f.BossImmuneTypes, info.Type)
+}
+
+// tickBossEncounter counts down the boss shield and reports whether the
+// turn limit has run out with both sides still standing.
+func tickBossEncounter(f *FightState) bool {
+	if f == nil || f.BossID == 0 {
+		return false
+	}
+	if f.BossShieldTurns > 0 {
+		f.BossShieldTurns--
+		if f.BossShieldTurns == 0 {
+			f.BossImmuneTypes = nil
+		}
+	}
+	return f.BossTurnLimit > 0 && f.Turn >= f.BossTurnLimit && f.PlayerHP > 0 && f.EnemyHP > 0
+}
+
+func setEnemySkills(f *FightState, skills []int) {
+	f.EnemySkills = append([]int(nil), skills...)
+	if f.EnemySkillPP == nil {
+		f.EnemySkillPP = make(map[int]int)
+	}
+	for _, sid := range f.EnemySkills {
+		if _, ok := f.EnemySkillPP[sid]; !ok && sid > 0 {
+			f.EnemySkillPP[sid] = getSkillPP(sid)
+		}
+	}
+}
+
+// grantBossRewards pays first-clear rewards once per user, repeat rewards
+// afterwards.
+func grantBossRewards(deps *Deps, user *User, boss *BossEncounter) {
+	if user == nil || boss == nil {
+		return
+	}
+	rewards := boss.Repeat
+	if !containsUint32(user.BossClears, uint32(boss.PetID)) {
+		rewards = boss.FirstClear
+		user.BossClears = append(user.BossClears, uint32(boss.PetID))
+	}
+	for _, r := range rewards {
+		if r.ItemID > 0 {
+			grantItem(deps, user, r.ItemID, maxInt(1, r.Count))
+		}
+		if r.Coins > 0 {
+			user.Coins += uint32(r.Coins)
+		}
+	}
+	savePlayer(deps, user.ID, user)
+}
@@ -0,0 +1,143 @@
+package game
+
+import "testing"
+
+func newBossTestFight() *FightState {
+	f := &FightState{
+		EnemyHP:      100,
+		EnemyMaxHP:   100,
+		EnemySkills:  []int{10001},
+		EnemySkillPP: map[int]int{10001: 10},
+		EnemyStatus:  map[int]int{statusBurn: 3},
+		PlayerHP:     100,
+		PlayerMaxHP:  100,
+	}
+	startBossEncounter(f, &BossEncounter{
+		PetID:     70,
+		TurnLimit: 3,
+		Phases: []BossPhase{
+			{HPPercent: 50, Stages: BossStageChange{Atk: 1}, Skills: []int{10002}, Shield: &BossShieldPhase{Turns: 2, ImmuneTypes: []int{5}}},
+			{HPPercent: 20, HealPercent: 10, ClearStatus: true, AI: aiRandom},
+		},
+	})
+	return f
+}
+
+func TestBossPhasesTriggerInOrder(t *testing.T) {
+	f := newBossTestFight()
+	advanceBossPhases(f)
+	if f.BossPhase != 0 {
+		t.Fatalf("phase=%d before threshold", f.BossPhase)
+	}
+
+	f.EnemyHP = 50
+	advanceBossPhases(f)
+	if f.BossPhase != 1 || f.EnemyStage.Atk != 1 {
+		t.Fatalf("phase=%d stage=%+v", f.BossPhase, f.EnemyStage)
+	}
+	if len(f.EnemySkills) != 1 || f.EnemySkills[0] != 10002 {
+		t.Fatalf("skills=%v", f.EnemySkills)
+	}
+	if f.BossShieldTurns != 2 {
+		t.Fatalf("shield=%d", f.BossShieldTurns)
+	}
+
+	f.EnemyHP = 15
+	advanceBossPhases(f)
+	if f.BossPhase != 2 || f.EnemyHP != 25 || len(f.EnemyStatus) != 0 || f.EnemyAI != aiRandom {
+		t.Fatalf("phase=%d hp=%d status=%v ai=%q", f.BossPhase, f.EnemyHP, f.EnemyStatus, f.EnemyAI)
+	}
+}
+
+func TestBossPhasesSkipToLowestThreshold(t *testing.T) {
+	f := newBossTestFight()
+	f.EnemyHP = 10
+	advanceBossPhases(f)
+	if f.BossPhase != 2 {
+		t.Fatalf("phase=%d", f.BossPhase)
+	}
+}
+
+func TestBossShieldBlocksImmuneTypes(t *testing.T) {
+	f := newBossTestFight()
+	f.EnemyHP = 50
+	advanceBossPhases(f)
+	if !bossShieldBlocks(f, &SkillInfo{Type: 5}) {
+		t.Fatal("expected electric skill to be blocked")
+	}
+	if bossShieldBlocks(f, &SkillInfo{Type: 1}) {
+		t.Fatal("grass skill should not be blocked")
+	}
+	tickBossEncounter(f)
+	tickBossEncounter(f)
+	if bossShieldBlocks(f, &SkillInfo{Type: 5}) {
+		t.Fatal("shield should have expired")
+	}
+}
+
+func TestBossShieldSkipsMoveEffects(t *testing.T) {
+	withAISkills(t)
+	f := newBossTestFight()
+	f.EnemyStatus = map[int]int{}
+	f.BossShieldTurns = 1
+	res := executeAttack(nil, f, true, testAIParalyze, true)
+	if res.State != 1 || f.EnemyStatus[statusParalysis] > 0 {
+		t.Fatalf("state=%d status=%v", res.State, f.EnemyStatus)
+	}
+	tickBossEncounter(f)
+	executeAttack(nil, f, true, testAIParalyze, true)
+	if f.EnemyStatus[statusParalysis] == 0 {
+		t.Fatalf("status=%v after shield", f.EnemyStatus)
+	}
+}
+
+func TestMapBossKeepsHPBetweenFights(t *testing.T) {
+	f := newBossTestFight()
+	f.EnemyHP = 40
+	skipPassedBossPhases(f)
+	if f.BossPhase != 1 || f.BossShieldTurns != 0 || f.EnemyStage.Atk != 0 {
+		t.Fatalf("phase=%d shield=%d stage=%+v", f.BossPhase, f.BossShieldTurns, f.EnemyStage)
+	}
+
+	key := bossShieldKey(1, 2)
+	user := &User{BossShield: map[uint64]uint32{}}
+	f.MapBossKey = key
+	f.EnemyHP = 30
+	updateFightResult(nil, user, f, false)
+	if user.BossShield[key] != 30 {
+		t.Fatalf("hp=%d after loss", user.BossShield[key])
+	}
+	updateFightResult(nil, user, f, true)
+	if user.BossShield[key] != 0 {
+		t.Fatalf("hp=%d after win", user.BossShield[key])
+	}
+}
+
+func TestBossTurnLimit(t *testing.T) {
+	f := newBossTestFight()
+	f.Turn = 2
+	if tickBossEncounter(f) {
+		t.Fatal("turn limit reached early")
+	}
+	f.Turn = 3
+	if !tickBossEncounter(f) {
+		t.Fatal("expected turn limit")
+	}
+}
+
+func TestBossRewardsFirstClearThenRepeat(t *testing.T) {
+	user := &User{}
+	boss := &BossEncounter{
+		PetID:      70,
+		FirstClear: []BossReward{{Coins: 1000}},
+		Repeat:     []BossReward{{Coins: 10}},
+	}
+	grantBossRewards(nil, user, boss)
+	grantBossRewards(nil, user, boss)
+	if user.Coins != 1010 {
+		t.Fatalf("coins=%d", user.Coins)
+	}
+	if len(user.BossClears) != 1 || user.BossClears[0] != 70 {
+		t.Fatalf("clears=%v", user.BossClears)
+	}
+}
@@ -224,7 +224,7 @@ func buildLoginResponse(u *User) []byte {
 	// 13. Pet list
 	binary.Write(buf, binary.BigEndian, uint32(len(u.Pets)))
 	for _, p := range u.Pets {
-		petBody := buildFullPetInfo(int(p.ID), int(p.CatchTime), int(p.Level), int(p.DV), p.Exp, p.Skills)
+		petBody := buildFullPetInfo(int(p.ID), int(p.CatchTime), int(p.Level), int(p.DV), p.Exp, p.EV, p.Skills)
 		buf.Write(petBody)
 	}
 
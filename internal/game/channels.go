@@ -0,0 +1,95 @@
+package game
+
+// ChannelKind names a broadcast topic family. Team, friends and room
+// channels are keyed by an ID and have explicit subscribers; world and
+// system channels reach every connected user.
+type ChannelKind uint8
+
+const (
+	ChannelTeam ChannelKind = iota + 1
+	ChannelFriends
+	ChannelWorld
+	ChannelSystem
+	ChannelRoom
+)
+
+type channelKey struct {
+	Kind ChannelKind
+	ID   uint32
+}
+
+func (k ChannelKind) global() bool {
+	return k == ChannelWorld || k == ChannelSystem
+}
+
+// JoinChannel subscribes userID to a keyed channel. Subscriptions are
+// dropped when the user's connection goes away.
+func (s *State) JoinChannel(kind ChannelKind, id uint32, userID uint32) {
+	if kind.global() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := channelKey{Kind: kind, ID: id}
+	if s.channels[key] == nil {
+		s.channels[key] = make(map[uint32]struct{})
+	}
+	s.channels[key][userID] = struct{}{}
+}
+
+func (s *State) LeaveChannel(kind ChannelKind, id uint32, userID uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leaveChannelLocked(channelKey{Kind: kind, ID: id}, userID)
+}
+
+// CloseChannel drops every subscriber of a keyed channel.
+func (s *State) CloseChannel(kind ChannelKind, id uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.channels, channelKey{Kind: kind, ID: id})
+}
+
+func (s *State) leaveChannelLocked(key channelKey, userID uint32) {
+	set := s.channels[key]
+	delete(set, userID)
+	if len(set) == 0 {
+		delete(s.channels, key)
+	}
+}
+
+// leaveAllChannelsLocked is called with s.mu held when a user disconnects.
+func (s *State) leaveAllChannelsLocked(userID uint32) {
+	for key := range s.channels {
+		s.leaveChannelLocked(key, userID)
+	}
+}
+
+// ChannelMembers lists the connected subscribers of a channel.
+func (s *State) ChannelMembers(kind ChannelKind, id uint32) []uint32 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []uint32
+	if kind.global() {
+		for uid := range s.conns {
+			out = append(out, uid)
+		}
+		return out
+	}
+	for uid := range s.channels[channelKey{Kind: kind, ID: id}] {
+		if _, ok := s.conns[uid]; ok {
+			out = append(out, uid)
+		}
+	}
+	return out
+}
+
+// BroadcastToChannel writes a prebuilt packet to every connected member of
+// a channel.
+func (s *State) BroadcastToChannel(kind ChannelKind, id uint32, payload []byte) {
+	for _, uid := range s.ChannelMembers(kind, id) {
+		if conn, ok := s.GetConn(uid); ok {
+			_, _ = conn.Write(payload)
+		}
+	}
+}
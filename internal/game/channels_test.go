@@ -0,0 +1,75 @@
+package game
+
+import (
+	"net"
+	"sort"
+	"testing"
+)
+
+func sortedIDs(ids []uint32) []uint32 {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestChannelMembersFollowSubscriptionsAndConnections(t *testing.T) {
+	state := NewState()
+	conns := map[uint32]net.Conn{}
+	for _, id := range []uint32{1, 2, 3} {
+		c, peer := net.Pipe()
+		defer c.Close()
+		defer peer.Close()
+		conns[id] = c
+		state.RegisterConn(id, c)
+	}
+	state.JoinChannel(ChannelTeam, 10000, 1)
+	state.JoinChannel(ChannelTeam, 10000, 2)
+	state.JoinChannel(ChannelTeam, 10000, 9) // offline subscriber
+	state.JoinChannel(ChannelWorld, 0, 1)    // global channels ignore subscriptions
+
+	if got := sortedIDs(state.ChannelMembers(ChannelTeam, 10000)); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("team members=%v", got)
+	}
+	if got := state.ChannelMembers(ChannelWorld, 0); len(got) != 3 {
+		t.Fatalf("world members=%v", got)
+	}
+
+	state.DropConn(conns[2])
+	if got := state.ChannelMembers(ChannelTeam, 10000); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("after disconnect=%v", got)
+	}
+	state.LeaveChannel(ChannelTeam, 10000, 1)
+	if got := state.ChannelMembers(ChannelTeam, 10000); len(got) != 0 {
+		t.Fatalf("after leave=%v", got)
+	}
+}
+
+func TestTeamChatLogIsBounded(t *testing.T) {
+	state := NewState()
+	for i := 0; i < teamChatLogSize+5; i++ {
+		recordTeamChat(state, 10001, teamChatEntry{FromID: uint32(i)})
+	}
+	log := teamChatHistory(state, 10001)
+	if len(log) != teamChatLogSize || log[0].FromID != 5 || log[len(log)-1].FromID != uint32(teamChatLogSize+4) {
+		t.Fatalf("log len=%d first=%d", len(log), log[0].FromID)
+	}
+	if len(teamChatHistory(state, 10002)) != 0 {
+		t.Fatal("unrelated team has history")
+	}
+}
+
+func TestTeamMembershipDrivesTeamChannel(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2)
+	for _, u := range users {
+		c, peer := net.Pipe()
+		defer c.Close()
+		defer peer.Close()
+		state.RegisterConn(u.ID, c)
+	}
+	_, team := createTeam(nil, state, users[0], "Seers")
+	applyToTeam(nil, state, users[1], team.ID)
+	syncTeamMembers(state, team)
+	if got := state.ChannelMembers(ChannelTeam, team.ID); len(got) != 2 {
+		t.Fatalf("channel=%v", got)
+	}
+}
@@ -0,0 +1,234 @@
+package game
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"jseer/internal/protocol"
+)
+
+// Values of the first 2102 field below chatWhisperMin select a channel;
+// anything else is the user ID of a whisper target.
+const (
+	chatMap uint32 = iota
+	chatTeam
+	chatWorld
+	chatSystem
+	chatWhisperMin
+)
+
+const (
+	chatOK uint32 = iota
+	chatOffline
+	chatTooLong
+	chatTooFast
+	chatNoTeam
+	chatNotAllowed
+	chatBlocked
+	chatEmpty
+	chatMuted
+)
+
+// chatConfig is read from chat.json (GM key chat). Channels are keyed by
+// map, whisper, team, world and system.
+type chatConfig struct {
+	Channels      map[string]chatChannelConfig `json:"channels"`
+	SystemSenders []uint32                     `json:"systemSenders"`
+}
+
+type chatChannelConfig struct {
+	MaxLen     int `json:"maxLen"`
+	CooldownMs int `json:"cooldownMs"`
+}
+
+const chatConfigFile = "chat.json"
+
+func defaultChatConfig() chatConfig {
+	return chatConfig{
+		Channels: map[string]chatChannelConfig{
+			"map":     {MaxLen: 120, CooldownMs: 1000},
+			"whisper": {MaxLen: 120, CooldownMs: 500},
+			"team":    {MaxLen: 120, CooldownMs: 1000},
+			"world":   {MaxLen: 80, CooldownMs: 10000},
+			"system":  {MaxLen: 200},
+		},
+	}
+}
+
+// chatConfig returns the cached chat.json; every chat message reads it.
+func (s *State) chatConfig(deps *Deps) chatConfig {
+	if s == nil {
+		cfg, _ := loadChatConfig(deps)
+		return cfg
+	}
+	return s.chat.get(deps, chatConfigFile, func() (chatConfig, int64) {
+		return loadChatConfig(deps)
+	})
+}
+
+func loadChatConfig(deps *Deps) (chatConfig, int64) {
+	cfg := defaultChatConfig()
+	version, _ := readStoreConfigJSON(deps, chatConfigFile, &cfg)
+	return cfg, version
+}
+
+func chatChannelName(kind uint32) string {
+	switch kind {
+	case chatTeam:
+		return "team"
+	case chatWorld:
+		return "world"
+	case chatSystem:
+		return "system"
+	}
+	return "map"
+}
+
+// chatLimiter remembers when each user last spoke on each channel.
+type chatLimiter struct {
+	mu   sync.Mutex
+	last map[uint32]map[string]time.Time
+}
+
+func newChatLimiter() *chatLimiter {
+	return &chatLimiter{last: make(map[uint32]map[string]time.Time)}
+}
+
+// allow records a message at now unless the user spoke on the channel less
+// than cooldown ago.
+func (l *chatLimiter) allow(userID uint32, channel string, cooldown time.Duration, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	seen := l.last[userID]
+	if seen == nil {
+		seen = make(map[string]time.Time)
+		l.last[userID] = seen
+	}
+	if prev, ok := seen[channel]; ok && now.Sub(prev) < cooldown {
+		return false
+	}
+	seen[channel] = now
+	return true
+}
+
+// forget drops a user's timestamps once they disconnect.
+func (l *chatLimiter) forget(userID uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.last, userID)
+}
+
+func buildChatBody(from *User, toID uint32, msg []byte) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, from.ID)
+	protocol.WriteFixedString(buf, pickNick(from, from.ID), 16)
+	binary.Write(buf, binary.BigEndian, toID)
+	binary.Write(buf, binary.BigEndian, uint32(len(msg)))
+	buf.Write(msg)
+	return buf.Bytes()
+}
+
+// routeChat delivers a 2102 message. to is the raw first request field.
+// The returned body is the sender's own copy for messages no broadcast
+// echoes back to them: whispers, and map chat while off any map.
+func routeChat(deps *Deps, state *State, user *User, to uint32, msg []byte, now time.Time) (uint32, []byte) {
+	if to == chatTeam {
+		result, entry := postTeamChat(deps, state, user, msg, now)
+		if result == chatOK {
+			packet := protocol.BuildResponse(2102, user.ID, 0, buildChatBody(user, to, entry.Msg))
+			broadcastToChannelFrom(state, ChannelTeam, user.Team.ID, user.ID, packet)
+		}
+		return result, nil
+	}
+	cfg := state.chatConfig(deps)
+	whisper := to >= chatWhisperMin
+	name := "whisper"
+	if !whisper {
+		name = chatChannelName(to)
+	}
+	limits := cfg.Channels[name]
+	switch {
+	case len(msg) == 0:
+		return chatEmpty, nil
+	case limits.MaxLen > 0 && len(msg) > limits.MaxLen:
+		return chatTooLong, nil
+	case to == chatSystem && !containsUint32(cfg.SystemSenders, user.ID):
+		return chatNotAllowed, nil
+	}
+	if isMuted(deps, user.ID, now) {
+		return chatMuted, nil
+	}
+	var target net.Conn
+	if whisper {
+		c, ok := state.GetConn(to)
+		if !ok || to == user.ID {
+			return chatOffline, nil
+		}
+		if u, ok := state.GetUser(to); ok && hasBlacklisted(u, user.ID) {
+			return chatBlocked, nil
+		}
+		target = c
+	}
+	if !state.chatLimits.allow(user.ID, name, time.Duration(limits.CooldownMs)*time.Millisecond, now) {
+		return chatTooFast, nil
+	}
+
+	text := maskSensitive(deps, string(msg))
+	logTarget := uint32(0)
+	if whisper {
+		logTarget = to
+	}
+	logChat(deps, user.ID, name, logTarget, text)
+
+	body := buildChatBody(user, to, []byte(text))
+	packet := protocol.BuildResponse(2102, user.ID, 0, body)
+	switch {
+	case whisper:
+		_, _ = target.Write(packet)
+		return chatOK, body
+	case to == chatWorld || to == chatSystem:
+		broadcastToChannelFrom(state, ChannelWorld, 0, user.ID, packet)
+	case user.RoomOwner != 0:
+		broadcastToChannelFrom(state, ChannelRoom, user.RoomOwner, user.ID, packet)
+	case user.MapID == 0:
+		return chatOK, body
+	default:
+		broadcastToMapFrom(state, user.MapID, user.ID, packet)
+	}
+	return chatOK, nil
+}
+
+// postTeamChat is team chat for both 2102 and 2929. It applies the team
+// channel's length and cooldown limits, masks the text, keeps it in the
+// team's recent history and logs it; the caller broadcasts the returned
+// entry in its own layout.
+func postTeamChat(deps *Deps, state *State, user *User, msg []byte, now time.Time) (uint32, teamChatEntry) {
+	limits := state.chatConfig(deps).Channels["team"]
+	switch {
+	case len(msg) == 0:
+		return chatEmpty, teamChatEntry{}
+	case limits.MaxLen > 0 && len(msg) > limits.MaxLen:
+		return chatTooLong, teamChatEntry{}
+	case user.Team.ID == 0:
+		return chatNoTeam, teamChatEntry{}
+	}
+	if isMuted(deps, user.ID, now) {
+		return chatMuted, teamChatEntry{}
+	}
+	if !state.chatLimits.allow(user.ID, "team", time.Duration(limits.CooldownMs)*time.Millisecond, now) {
+		return chatTooFast, teamChatEntry{}
+	}
+	text := maskSensitive(deps, string(msg))
+	entry := teamChatEntry{
+		FromID: user.ID,
+		Nick:   pickNick(user, user.ID),
+		Time:   uint32(now.Unix()),
+		Msg:    []byte(text),
+	}
+	recordTeamChat(state, user.Team.ID, entry)
+	logChat(deps, user.ID, "team", user.Team.ID, text)
+	return chatOK, entry
+}
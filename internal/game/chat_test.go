@@ -0,0 +1,117 @@
+package game
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"jseer/internal/protocol"
+)
+
+func TestChatLimiterCooldownPerChannel(t *testing.T) {
+	l := newChatLimiter()
+	now := time.Unix(1700000000, 0)
+	if !l.allow(1, "map", time.Second, now) || l.allow(1, "map", time.Second, now.Add(500*time.Millisecond)) {
+		t.Fatal("map cooldown")
+	}
+	if !l.allow(1, "world", time.Second, now) || !l.allow(2, "map", time.Second, now) {
+		t.Fatal("cooldowns leak across channels or users")
+	}
+	if !l.allow(1, "map", time.Second, now.Add(time.Second)) {
+		t.Fatal("cooldown did not expire")
+	}
+	l.forget(1)
+	if _, ok := l.last[1]; ok {
+		t.Fatal("forget kept the user's timestamps")
+	}
+}
+
+func TestRouteChatFailures(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 10001, 10002)
+	now := time.Unix(1700000000, 0)
+	cases := []struct {
+		to   uint32
+		msg  []byte
+		want uint32
+	}{
+		{chatMap, nil, chatEmpty},
+		{chatWorld, make([]byte, 81), chatTooLong},
+		{chatSystem, []byte("hi"), chatNotAllowed},
+		{chatTeam, []byte("hi"), chatNoTeam},
+		{10002, []byte("hi"), chatOffline},
+		{10001, []byte("hi"), chatOffline},
+	}
+	for _, c := range cases {
+		if r, _ := routeChat(nil, state, users[0], c.to, c.msg, now); r != c.want {
+			t.Fatalf("to=%d: result=%d want %d", c.to, r, c.want)
+		}
+	}
+	state.UpdatePlayerMap(users[0].ID, 0)
+	if r, echo := routeChat(nil, state, users[0], chatMap, []byte("hi"), now); r != chatOK || echo == nil {
+		t.Fatalf("off-map chat=%d", r)
+	}
+	if r, _ := routeChat(nil, state, users[0], chatMap, []byte("again"), now); r != chatTooFast {
+		t.Fatalf("flood=%d", r)
+	}
+}
+
+func TestTeamChatSharedBetweenCommands(t *testing.T) {
+	state := NewState()
+	user := newTeamTestUsers(state, 10001)[0]
+	_, team := createTeam(nil, state, user, "Seers")
+	now := time.Unix(1700000000, 0)
+
+	if r, _ := routeChat(nil, state, user, chatTeam, []byte("hi"), now); r != chatOK {
+		t.Fatalf("2102 team chat=%d", r)
+	}
+	if log := teamChatHistory(state, team.ID); len(log) != 1 || string(log[0].Msg) != "hi" {
+		t.Fatalf("history=%+v", log)
+	}
+	if r, _ := postTeamChat(nil, state, user, []byte("again"), now); r != chatTooFast || teamChatResult(r) != teamChatTooFast {
+		t.Fatalf("2929 after 2102=%d", r)
+	}
+	later := now.Add(time.Minute)
+	if r, _ := postTeamChat(nil, state, user, make([]byte, 121), later); r != chatTooLong {
+		t.Fatalf("long team chat=%d", r)
+	}
+	if r, entry := postTeamChat(nil, state, user, []byte("bye"), later); r != chatOK || entry.FromID != user.ID {
+		t.Fatalf("2929 team chat=%d entry=%+v", r, entry)
+	}
+	if log := teamChatHistory(state, team.ID); len(log) != 2 {
+		t.Fatalf("history=%d lines", len(log))
+	}
+}
+
+func TestRouteChatWhisper(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 10001, 10002)
+	c, peer := net.Pipe()
+	defer c.Close()
+	defer peer.Close()
+	state.RegisterConn(10002, c)
+	now := time.Unix(1700000000, 0)
+
+	got := make(chan []byte, 1)
+	go func() {
+		pkt := make([]byte, protocol.HeaderLen+4+16+4+4+2)
+		if _, err := io.ReadFull(peer, pkt); err == nil {
+			got <- pkt[protocol.HeaderLen:]
+		}
+	}()
+	r, echo := routeChat(nil, state, users[0], 10002, []byte("hi"), now)
+	if r != chatOK || echo == nil {
+		t.Fatalf("whisper=%d", r)
+	}
+	body := <-got
+	if binary.BigEndian.Uint32(body) != 10001 || binary.BigEndian.Uint32(body[20:]) != 10002 || string(body[28:]) != "hi" {
+		t.Fatalf("whisper body=%v", body)
+	}
+
+	users[1].Blacklist = []uint32{10001}
+	if r, _ := routeChat(nil, state, users[0], 10002, []byte("hi"), now.Add(time.Minute)); r != chatBlocked {
+		t.Fatalf("blacklisted whisper=%d", r)
+	}
+}
@@ -1,10 +1,14 @@
 package game
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const configDir = "./data/config"
@@ -21,6 +25,73 @@ func readConfigJSON(name string, out any) bool {
 	return true
 }
 
+// configStoreKey maps a data/config file name to the key the GM server seeds
+// it under (map-boss.json -> map_boss).
+func configStoreKey(name string) string {
+	key := strings.TrimSuffix(name, filepath.Ext(name))
+	return strings.ReplaceAll(key, "-", "_")
+}
+
+// readStoreConfigJSON prefers the GM config store copy of a data/config file
+// so GM edits apply without a restart. The returned version is 0 when the
+// file on disk was used.
+func readStoreConfigJSON(deps *Deps, name string, out any) (int64, bool) {
+	if deps != nil && deps.Store != nil {
+		entry, err := deps.Store.GetConfig(context.Background(), configStoreKey(name))
+		if err == nil && entry != nil && json.Unmarshal(entry.Value, out) == nil {
+			return entry.Version, true
+		}
+	}
+	return 0, readConfigJSON(name, out)
+}
+
+// configPollInterval is how often a storeConfigCache asks the store for
+// the config's version.
+const configPollInterval = 5 * time.Second
+
+// storeConfigCache holds a config built from readStoreConfigJSON for hot
+// paths. The stored version is polled at most once per configPollInterval
+// and the config is rebuilt only when it changes.
+type storeConfigCache[T any] struct {
+	mu      sync.Mutex
+	loaded  bool
+	version int64
+	checked time.Time
+	value   T
+}
+
+// get returns the cached config, calling load when the store holds a
+// different version of name than the one cached. load returns the value
+// together with the version readStoreConfigJSON reported.
+func (c *storeConfigCache[T]) get(deps *Deps, name string, load func() (T, int64)) T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.loaded && now.Sub(c.checked) < configPollInterval {
+		return c.value
+	}
+	c.checked = now
+	if c.loaded && storedConfigVersion(deps, name) == c.version {
+		return c.value
+	}
+	c.value, c.version = load()
+	c.loaded = true
+	return c.value
+}
+
+// storedConfigVersion returns the GM store version of name, 0 when only the
+// file on disk exists.
+func storedConfigVersion(deps *Deps, name string) int64 {
+	if deps == nil || deps.Store == nil {
+		return 0
+	}
+	entry, err := deps.Store.GetConfig(context.Background(), configStoreKey(name))
+	if err != nil || entry == nil {
+		return 0
+	}
+	return entry.Version
+}
+
 func parseUint32(raw string) uint32 {
 	if raw == "" {
 		return 0
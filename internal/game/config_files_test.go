@@ -0,0 +1,62 @@
+package game
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"jseer/internal/storage"
+)
+
+// withConfigFile runs the test from a temp dir holding configDir/name.
+func withConfigFile(t *testing.T, name string, body string) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, configDir), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, configDir, name), []byte(body), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+}
+
+// versionStore reports a config version that tests can bump.
+type versionStore struct {
+	storage.Store
+	version int64
+}
+
+func (s *versionStore) GetConfig(ctx context.Context, key string) (*storage.ConfigEntry, error) {
+	return &storage.ConfigEntry{Key: key, Value: []byte("{}"), Version: s.version}, nil
+}
+
+func TestStoreConfigCacheReloadsOnNewVersion(t *testing.T) {
+	store := &versionStore{version: 1}
+	deps := &Deps{Store: store}
+	var cache storeConfigCache[int]
+	loads := 0
+	load := func() (int, int64) {
+		loads++
+		return loads, store.version
+	}
+	poll := func() int {
+		cache.checked = cache.checked.Add(-configPollInterval)
+		return cache.get(deps, "x.json", load)
+	}
+
+	if v := cache.get(deps, "x.json", load); v != 1 {
+		t.Fatalf("first=%d", v)
+	}
+	store.version = 2
+	if v := cache.get(deps, "x.json", load); v != 1 {
+		t.Fatalf("reloaded before the poll interval: %d", v)
+	}
+	if v := poll(); v != 2 {
+		t.Fatalf("after new version=%d", v)
+	}
+	if v := poll(); v != 2 || loads != 2 {
+		t.Fatalf("same version reloaded: value=%d loads=%d", v, loads)
+	}
+}
@@ -1,8 +1,14 @@
 package game
 
+import "time"
+
 type FightState struct {
 	UserID            uint32
 	OpponentUserID    uint32
+	PvPQueue          uint32
+	TurnDeadline      time.Time
+	PvPTimeouts       int
+	DisconnectedAt    time.Time
 	PendingSkillID    int
 	PlayerLastSkill   int
 	EnemyLastSkill    int
@@ -14,6 +20,18 @@ type FightState struct {
 	EnemyBoundTurns   int
 	PlayerFlinch      bool
 	EnemyFlinch       bool
+	PlayerSeeded      int
+	EnemySeeded       int
+	PlayerProtect     int
+	EnemyProtect      int
+	PlayerMist        int
+	EnemyMist         int
+	PlayerSafeguard   int
+	EnemySafeguard    int
+	PlayerBuffs       []fightBuff
+	EnemyBuffs        []fightBuff
+	PlayerDamageTaken int
+	EnemyDamageTaken  int
 	PlayerPetID       uint32
 	PlayerLevel       uint32
 	PlayerDV          uint32
@@ -39,6 +57,15 @@ type FightState struct {
 	EnemyRewardID     int
 	EnemyRewardNm     string
 	EnemyRewardCt     int
+	EnemyAI           string
+	EnemyAIScript     []int
+	BossID            int
+	BossPhase         int
+	BossPhases        []BossPhase
+	BossTurnLimit     int
+	BossShieldTurns   int
+	BossImmuneTypes   []int
+	MapBossKey        uint64
 	PlayerFatigue     int
 	EnemyFatigue      int
 	PlayerStatus      map[int]int
@@ -0,0 +1,198 @@
+package game
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"jseer/internal/protocol"
+)
+
+const (
+	friendOK uint32 = iota
+	friendInvalid
+	friendAlready
+	friendFull
+	friendTargetFull
+	friendNoRequest
+	friendNotFound
+	friendBlocked
+)
+
+// friendConfig is read from friend.json (GM key friend). Caps[i] is the
+// friend limit at VIP level i; 0 is a player without super NoNo.
+type friendConfig struct {
+	Caps []int `json:"caps"`
+}
+
+const friendConfigFile = "friend.json"
+
+func loadFriendConfig(deps *Deps) friendConfig {
+	cfg := friendConfig{Caps: []int{100}}
+	readStoreConfigJSON(deps, friendConfigFile, &cfg)
+	if len(cfg.Caps) == 0 {
+		cfg.Caps = []int{100}
+	}
+	return cfg
+}
+
+func (c friendConfig) capFor(u *User) int {
+	lv := 0
+	if u.Nono.SuperNono > 0 {
+		lv = maxInt(1, int(u.Nono.VipLevel))
+	}
+	if lv >= len(c.Caps) {
+		lv = len(c.Caps) - 1
+	}
+	return c.Caps[lv]
+}
+
+// friendRegistry holds pending friend requests by target, then requester.
+// They are kept in memory only.
+type friendRegistry struct {
+	mu       sync.Mutex
+	requests map[uint32]map[uint32]uint32
+}
+
+func newFriendRegistry() *friendRegistry {
+	return &friendRegistry{requests: make(map[uint32]map[uint32]uint32)}
+}
+
+func hasFriend(u *User, id uint32) bool {
+	for _, f := range u.Friends {
+		if f.UserID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutFriend(list []FriendInfo, id uint32) []FriendInfo {
+	next := list[:0]
+	for _, f := range list {
+		if f.UserID != id {
+			next = append(next, f)
+		}
+	}
+	return next
+}
+
+// requestFriend records a request from user to targetID. It is pushed to
+// the target now if online, otherwise at their next login.
+func requestFriend(deps *Deps, state *State, user *User, targetID uint32) uint32 {
+	if targetID == 0 || targetID == user.ID {
+		return friendInvalid
+	}
+	if hasFriend(user, targetID) {
+		return friendAlready
+	}
+	if len(user.Friends) >= loadFriendConfig(deps).capFor(user) {
+		return friendFull
+	}
+	target, ok := findPlayer(deps, state, targetID)
+	if !ok {
+		return friendNotFound
+	}
+	if hasBlacklisted(target, user.ID) {
+		return friendBlocked
+	}
+	r := state.friends
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.requests[targetID] == nil {
+		r.requests[targetID] = make(map[uint32]uint32)
+	}
+	r.requests[targetID][user.ID] = uint32(time.Now().Unix())
+	return friendOK
+}
+
+// pendingFriendRequests lists who asked to befriend id.
+func pendingFriendRequests(state *State, id uint32) []uint32 {
+	r := state.friends
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]uint32, 0, len(r.requests[id]))
+	for from := range r.requests[id] {
+		out = append(out, from)
+	}
+	return out
+}
+
+// answerFriend consumes a request sent to user and, if accepted, adds each
+// player to the other's list.
+func answerFriend(deps *Deps, state *State, user *User, fromID uint32, accept bool) uint32 {
+	r := state.friends
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.requests[user.ID][fromID]; !ok {
+		return friendNoRequest
+	}
+	delete(r.requests[user.ID], fromID)
+	if len(r.requests[user.ID]) == 0 {
+		delete(r.requests, user.ID)
+	}
+	if !accept {
+		return friendOK
+	}
+	from, ok := findPlayer(deps, state, fromID)
+	if !ok {
+		return friendNotFound
+	}
+	cfg := loadFriendConfig(deps)
+	if !hasFriend(user, fromID) && len(user.Friends) >= cfg.capFor(user) {
+		return friendFull
+	}
+	if !hasFriend(from, user.ID) && len(from.Friends) >= cfg.capFor(from) {
+		return friendTargetFull
+	}
+	now := uint32(time.Now().Unix())
+	if !hasFriend(user, fromID) {
+		user.Friends = append(user.Friends, FriendInfo{UserID: fromID, TimePoke: now})
+		savePlayer(deps, user.ID, user)
+	}
+	if !hasFriend(from, user.ID) {
+		from.Friends = append(from.Friends, FriendInfo{UserID: user.ID, TimePoke: now})
+		savePlayer(deps, fromID, from)
+	}
+	state.JoinChannel(ChannelFriends, fromID, user.ID)
+	if _, online := state.GetConn(fromID); online {
+		state.JoinChannel(ChannelFriends, user.ID, fromID)
+	}
+	return friendOK
+}
+
+// removeFriend drops the friendship from both lists.
+func removeFriend(deps *Deps, state *State, user *User, targetID uint32) {
+	user.Friends = withoutFriend(user.Friends, targetID)
+	savePlayer(deps, user.ID, user)
+	if other, ok := findPlayer(deps, state, targetID); ok && hasFriend(other, user.ID) {
+		other.Friends = withoutFriend(other.Friends, user.ID)
+		savePlayer(deps, targetID, other)
+	}
+	state.LeaveChannel(ChannelFriends, targetID, user.ID)
+	state.LeaveChannel(ChannelFriends, user.ID, targetID)
+}
+
+// buildPresencePacket uses the 2157 layout with a single entry so clients
+// can apply it like a presence query result.
+func buildPresencePacket(user *User, online bool) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, uint32(1))
+	binary.Write(buf, binary.BigEndian, user.ID)
+	if online {
+		binary.Write(buf, binary.BigEndian, uint32(1))
+		binary.Write(buf, binary.BigEndian, user.MapType)
+		binary.Write(buf, binary.BigEndian, user.MapID)
+	} else {
+		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, uint32(0))
+	}
+	return protocol.BuildResponse(2157, user.ID, 0, buf.Bytes())
+}
+
+// pushFriendPresence tells the user's online friends they came or went.
+func pushFriendPresence(state *State, user *User, online bool) {
+	state.BroadcastToChannel(ChannelFriends, user.ID, buildPresencePacket(user, online))
+}
@@ -0,0 +1,95 @@
+package game
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"jseer/internal/protocol"
+)
+
+func TestFriendRequestNeedsAnswerAndLinksBothSides(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2)
+	a, b := users[0], users[1]
+
+	if r := requestFriend(nil, state, a, a.ID); r != friendInvalid {
+		t.Fatalf("self=%d", r)
+	}
+	if r := requestFriend(nil, state, a, 99); r != friendNotFound {
+		t.Fatalf("unknown=%d", r)
+	}
+	if r := requestFriend(nil, state, a, b.ID); r != friendOK || len(a.Friends) != 0 {
+		t.Fatalf("request=%d friends=%v", r, a.Friends)
+	}
+	if got := pendingFriendRequests(state, b.ID); len(got) != 1 || got[0] != a.ID {
+		t.Fatalf("pending=%v", got)
+	}
+	if r := answerFriend(nil, state, a, b.ID, true); r != friendNoRequest {
+		t.Fatalf("wrong direction=%d", r)
+	}
+	if r := answerFriend(nil, state, b, a.ID, true); r != friendOK {
+		t.Fatalf("answer=%d", r)
+	}
+	if !hasFriend(a, b.ID) || !hasFriend(b, a.ID) || len(pendingFriendRequests(state, b.ID)) != 0 {
+		t.Fatalf("a=%v b=%v", a.Friends, b.Friends)
+	}
+	if r := requestFriend(nil, state, a, b.ID); r != friendAlready {
+		t.Fatalf("already=%d", r)
+	}
+
+	removeFriend(nil, state, a, b.ID)
+	if hasFriend(a, b.ID) || hasFriend(b, a.ID) {
+		t.Fatal("removal was one-sided")
+	}
+}
+
+func TestFriendCapFollowsVipLevel(t *testing.T) {
+	cfg := friendConfig{Caps: []int{2, 3, 5}}
+	u := &User{}
+	if cfg.capFor(u) != 2 {
+		t.Fatal("base cap")
+	}
+	u.Nono.SuperNono = 1
+	if cfg.capFor(u) != 3 {
+		t.Fatal("vip level 0 counts as 1")
+	}
+	u.Nono.VipLevel = 9
+	if cfg.capFor(u) != 5 {
+		t.Fatal("levels above the table use the last cap")
+	}
+
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2)
+	for i := 0; i < 100; i++ {
+		users[1].Friends = append(users[1].Friends, FriendInfo{UserID: uint32(1000 + i)})
+	}
+	requestFriend(nil, state, users[0], users[1].ID)
+	if r := answerFriend(nil, state, users[1], users[0].ID, true); r != friendFull || hasFriend(users[0], users[1].ID) {
+		t.Fatalf("full answerer=%d", r)
+	}
+}
+
+func TestFriendPresencePushedToOnlineFriends(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2)
+	c, peer := net.Pipe()
+	defer c.Close()
+	defer peer.Close()
+	state.RegisterConn(2, c)
+	users[0].Friends = []FriendInfo{{UserID: 2}}
+	users[1].Friends = []FriendInfo{{UserID: 1}}
+	joinUserChannels(state, users[1])
+	users[0].MapID = 8
+
+	go pushFriendPresence(state, users[0], true)
+	pkt := make([]byte, protocol.HeaderLen+20)
+	if _, err := io.ReadFull(peer, pkt); err != nil {
+		t.Fatal(err)
+	}
+	body := pkt[protocol.HeaderLen:]
+	if binary.BigEndian.Uint32(body[4:]) != 1 || binary.BigEndian.Uint32(body[8:]) != 1 || binary.BigEndian.Uint32(body[16:]) != 8 {
+		t.Fatalf("presence body=%v", body)
+	}
+}
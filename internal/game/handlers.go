@@ -1,6 +1,8 @@
 package game
 
 import (
+	"net"
+
 	"jseer/internal/gateway"
 	"jseer/internal/storage"
 
@@ -23,6 +25,7 @@ func RegisterHandlers(s *gateway.Server, deps *Deps) {
 	state := deps.State
 	if state == nil {
 		state = NewState()
+		deps.State = state
 	}
 	registerSystemHandlers(s, deps, state)
 	registerNonoHandlers(s, deps, state)
@@ -35,16 +38,21 @@ func RegisterHandlers(s *gateway.Server, deps *Deps) {
 	registerFriendHandlers(s, deps, state)
 	registerExchangeHandlers(s)
 	registerTeamHandlers(s, deps, state)
-	registerTeamPKHandlers(s)
+	registerTeamPKHandlers(s, deps, state)
 	registerTeacherHandlers(s, deps, state)
 	registerMailHandlers(s, deps, state)
 	registerAchievementHandlers(s, deps, state)
 	registerMiscHandlers(s, deps, state)
 	registerArenaHandlers(s)
 	registerFightHandlers(s, deps, state)
+	registerPvPMatchHandlers(s, deps, state)
+	reportSkillEffectCoverage(deps.Logger)
 	registerGameHandlers(s)
 	registerCompatHandlers(s, deps, state)
 	registerStubHandlers(s)
+	// Runs after every other disconnect hook so they can still look the
+	// user up by connection.
+	s.OnDisconnect(func(conn net.Conn) { state.DropConn(conn) })
 
 	s.SetDefault(handleStubEmpty())
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"math/rand"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -21,25 +22,27 @@ func init() {
 }
 
 func registerFightHandlers(s *gateway.Server, deps *Deps, state *State) {
-	s.Register(2401, handleInviteToFight(state))
+	s.Register(2401, handleInviteToFight(deps, state))
 	s.Register(2402, handleInviteFightCancel(state))
 	s.Register(2403, handleHandleFightInvite(state))
-	s.Register(2411, handleChallengeBoss(state))
+	s.Register(2411, handleChallengeBoss(deps, state))
 	s.Register(2404, handleReadyToFight(state))
 	s.Register(2405, handleUseSkill(deps, state))
 	s.Register(2406, handleUsePetItem(state))
-	s.Register(2407, handleChangePet(state))
+	s.Register(2407, handleChangePet(deps, state))
 	s.Register(2408, handleFightNpcMonster(state))
 	s.Register(2409, handleCatchMonster(deps, state))
 	s.Register(2410, handleEscapeFight(deps, state))
-	s.Register(2412, handleAttackBoss(state))
+	s.Register(2412, handleAttackBoss(deps, state))
 	s.Register(2413, handlePetKingJoin())
 	s.Register(2427, handleNpcJoin())
 	s.Register(2431, handleStartPetWar())
 	s.Register(2441, handleLoadPercent())
+	s.OnDisconnect(func(conn net.Conn) { handlePvPDisconnect(state, conn) })
+	go runPvPTurnTimer(s, deps, state)
 }
 
-func handleChallengeBoss(state *State) gateway.Handler {
+func handleChallengeBoss(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		bossID := int(reader.ReadUint32BE())
@@ -48,88 +51,110 @@ func handleChallengeBoss(state *State) gateway.Handler {
 		}
 
 		user := state.GetOrCreateUser(ctx.UserID)
-		player := resolveUserFightPet(user, user.CatchID, user.CurrentPetID)
-		player.CatchTime = ensureCatchTime(player.CatchTime, player.ID)
-		if user.CatchID == 0 {
-			user.CatchID = player.CatchTime
-		}
-		if user.CurrentPetID == 0 {
-			user.CurrentPetID = player.ID
-		}
-		player.CatchTime = ensureCatchTime(player.CatchTime, player.ID)
-		if user.CatchID == 0 {
-			user.CatchID = player.CatchTime
-		}
-		if user.CurrentPetID == 0 {
-			user.CurrentPetID = player.ID
-		}
-		bossLevel := 1
-		bossHP := 0
-		bossRewardID := 0
-		bossRewardName := ""
-		bossRewardCount := 0
-		if cfg := GetSPTBossByID(bossID); cfg != nil {
-			if cfg.Level > 0 {
-				bossLevel = cfg.Level
-			}
-			if cfg.MaxHP > 0 {
-				bossHP = cfg.MaxHP
-			}
-			if cfg.RewardItemID > 0 {
-				bossRewardID = cfg.RewardItemID
-				bossRewardName = cfg.RewardName
-				bossRewardCount = cfg.RewardCount
-			}
-		}
-		enemy := resolveEnemyFightPet(bossID, bossLevel)
-		if bossHP > 0 {
-			enemy.Stats.MaxHP = bossHP
-			enemy.Stats.HP = bossHP
-			enemy.CurrentHP = bossHP
-		}
-		if enemy.CatchTime == 0 {
-			enemy.CatchTime = uint32(time.Now().Unix())
-		}
-
-		user.Fight = &FightState{
-			UserID:        ctx.UserID,
-			PlayerPetID:   player.ID,
-			PlayerLevel:   player.Level,
-			PlayerDV:      player.DV,
-			PlayerHP:      player.CurrentHP,
-			PlayerMaxHP:   player.Stats.MaxHP,
-			PlayerCatch:   player.CatchTime,
-			PlayerSkills:  player.Skills,
-			PlayerStats:   player.Stats,
-			PlayerType:    player.Type,
-			EnemyPetID:    enemy.ID,
-			EnemyLevel:    enemy.Level,
-			EnemyDV:       enemy.DV,
-			EnemyHP:       enemy.CurrentHP,
-			EnemyMaxHP:    enemy.Stats.MaxHP,
-			EnemyCatch:    enemy.CatchTime,
-			EnemySkills:   enemy.Skills,
-			EnemyStats:    enemy.Stats,
-			EnemyType:     enemy.Type,
-			EnemyRewardID: bossRewardID,
-			EnemyRewardNm: bossRewardName,
-			EnemyRewardCt: bossRewardCount,
+		if refuseFaintedFight(ctx, user) {
+			return
 		}
-
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(2))
-		buf.Write(buildFightUserInfo(ctx.UserID, pickNick(user, ctx.UserID)))
-		binary.Write(buf, binary.BigEndian, uint32(1))
-		buf.Write(buildSimpleFightPetInfo(int(player.ID), int(player.Level), player.CurrentHP, player.Stats.MaxHP, int(player.CatchTime), player.Skills, int(user.MapID), int(player.ID)))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		protocol.WriteFixedString(buf, "", 16)
-		binary.Write(buf, binary.BigEndian, uint32(1))
-		buf.Write(buildSimpleFightPetInfo(int(enemy.ID), int(enemy.Level), enemy.CurrentHP, enemy.Stats.MaxHP, int(enemy.CatchTime), enemy.Skills, 301, int(enemy.ID)))
-		ctx.Server.SendResponse(ctx.Conn, 2503, ctx.UserID, buf.Bytes())
+		user.Fight = newBossFight(deps, user, bossID, 1)
+		sendBossFightStart(ctx, user, user.Fight)
 		// sendPveFightStart(ctx, user, user.Fight) // Removed: Should not send 2504 here, wait for 2404
 	}
 }
 
+// newBossFight sets up a fight against bossID with the user's current pet.
+// The SPT boss config and then the boss encounter override level and HP.
+func newBossFight(deps *Deps, user *User, bossID int, level int) *FightState {
+	player := resolveUserFightPet(user, user.CatchID, user.CurrentPetID)
+	player.CatchTime = ensureCatchTime(player.CatchTime, player.ID)
+	if user.CatchID == 0 {
+		user.CatchID = player.CatchTime
+	}
+	if user.CurrentPetID == 0 {
+		user.CurrentPetID = player.ID
+	}
+	bossLevel := maxInt(1, level)
+	bossHP := 0
+	bossRewardID := 0
+	bossRewardName := ""
+	bossRewardCount := 0
+	if cfg := GetSPTBossByID(bossID); cfg != nil {
+		if cfg.Level > 0 {
+			bossLevel = cfg.Level
+		}
+		if cfg.MaxHP > 0 {
+			bossHP = cfg.MaxHP
+		}
+		if cfg.RewardItemID > 0 {
+			bossRewardID = cfg.RewardItemID
+			bossRewardName = cfg.RewardName
+			bossRewardCount = cfg.RewardCount
+		}
+	}
+	boss := getBossEncounter(deps, bossID)
+	if boss != nil {
+		if boss.Level > 0 {
+			bossLevel = boss.Level
+		}
+		if boss.MaxHP > 0 {
+			bossHP = boss.MaxHP
+		}
+	}
+	enemy := resolveEnemyFightPet(bossID, bossLevel)
+	if bossHP > 0 {
+		enemy.Stats.MaxHP = bossHP
+		enemy.Stats.HP = bossHP
+		enemy.CurrentHP = bossHP
+	}
+	if enemy.CatchTime == 0 {
+		enemy.CatchTime = uint32(time.Now().Unix())
+	}
+	enemyAI, enemyAIScript := bossBattleAI(user.MapID, bossID)
+
+	f := &FightState{
+		UserID:        user.ID,
+		PlayerPetID:   player.ID,
+		PlayerLevel:   player.Level,
+		PlayerDV:      player.DV,
+		PlayerHP:      player.CurrentHP,
+		PlayerMaxHP:   player.Stats.MaxHP,
+		PlayerCatch:   player.CatchTime,
+		PlayerSkills:  player.Skills,
+		PlayerStats:   player.Stats,
+		PlayerType:    player.Type,
+		PlayerSkillPP: player.SkillPP,
+		PlayerStatus:  player.Status,
+		EnemyPetID:    enemy.ID,
+		EnemyLevel:    enemy.Level,
+		EnemyDV:       enemy.DV,
+		EnemyHP:       enemy.CurrentHP,
+		EnemyMaxHP:    enemy.Stats.MaxHP,
+		EnemyCatch:    enemy.CatchTime,
+		EnemySkills:   enemy.Skills,
+		EnemyStats:    enemy.Stats,
+		EnemyType:     enemy.Type,
+		EnemyRewardID: bossRewardID,
+		EnemyRewardNm: bossRewardName,
+		EnemyRewardCt: bossRewardCount,
+		EnemyAI:       enemyAI,
+		EnemyAIScript: enemyAIScript,
+	}
+	startBossEncounter(f, boss)
+	return f
+}
+
+// sendBossFightStart is the 2503 that opens a boss fight.
+func sendBossFightStart(ctx *gateway.Context, user *User, f *FightState) {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, uint32(2))
+	buf.Write(buildFightUserInfo(ctx.UserID, pickNick(user, ctx.UserID)))
+	binary.Write(buf, binary.BigEndian, uint32(1))
+	buf.Write(buildSimpleFightPetInfo(int(f.PlayerPetID), int(f.PlayerLevel), f.PlayerHP, f.PlayerMaxHP, int(f.PlayerCatch), f.PlayerSkills, int(user.MapID), int(f.PlayerPetID)))
+	binary.Write(buf, binary.BigEndian, uint32(0))
+	protocol.WriteFixedString(buf, "", 16)
+	binary.Write(buf, binary.BigEndian, uint32(1))
+	buf.Write(buildSimpleFightPetInfo(int(f.EnemyPetID), int(f.EnemyLevel), f.EnemyHP, f.EnemyMaxHP, int(f.EnemyCatch), f.EnemySkills, 301, int(f.EnemyPetID)))
+	ctx.Server.SendResponse(ctx.Conn, 2503, ctx.UserID, buf.Bytes())
+}
+
 func handleReadyToFight(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
@@ -184,73 +209,8 @@ func handleUseSkill(deps *Deps, state *State) gateway.Handler {
 			return
 		}
 
-		f.Turn++
 		playerSkill := pickSkillWithEncore(reqSkillID, f.PlayerSkills, f.PlayerSkillPP, &f.PlayerEncoreSkill, &f.PlayerEncoreTurns)
-		enemySkill := pickEncoreSkill(&f.EnemyEncoreSkill, &f.EnemyEncoreTurns, f.EnemySkillPP)
-		if enemySkill == 0 {
-			enemySkill = selectAISkill(f)
-		}
-		if enemySkill == 0 {
-			enemySkill = selectRandomSkillWithPP(f.EnemySkills, f.EnemySkillPP)
-		}
-		if playerSkill > 0 {
-			if pp, maxPP, ok := consumeSkillPP(f.PlayerSkillPP, playerSkill); ok {
-				sendSkillPPUpdate(ctx, playerSkill, pp, maxPP)
-			}
-		}
-		if enemySkill > 0 {
-			consumeSkillPP(f.EnemySkillPP, enemySkill)
-		}
-		f.PlayerLastSkill = playerSkill
-		f.EnemyLastSkill = enemySkill
-
-		applyTurnStatusDamage(f.PlayerStatus, &f.PlayerHP, f.PlayerMaxHP, &f.PlayerBoundTurns)
-		applyTurnStatusDamage(f.EnemyStatus, &f.EnemyHP, f.EnemyMaxHP, &f.EnemyBoundTurns)
-
-		playerSpeed := effectiveSpeed(f.PlayerStats.Speed, f.PlayerStage.Spd, f.PlayerStatus)
-		enemySpeed := effectiveSpeed(f.EnemyStats.Speed, f.EnemyStage.Spd, f.EnemyStatus)
-		playerFirst := isPlayerFirst(playerSkill, enemySkill, playerSpeed, enemySpeed)
-
-		var first attackResult
-		var second attackResult
-		if f.PlayerHP <= 0 || f.EnemyHP <= 0 {
-			first = placeholderAttack(true, f)
-			second = placeholderAttack(false, f)
-		} else {
-			playerCanAct := canAct(f, true)
-			enemyCanAct := canAct(f, false)
-			if playerFirst {
-				if playerCanAct {
-					first = executeAttack(ctx, f, true, playerSkill, true)
-				} else {
-					first = cannotActAttack(ctx.UserID, true, f)
-				}
-				if f.EnemyHP > 0 {
-					if enemyCanAct {
-						second = executeAttack(ctx, f, false, enemySkill, false)
-					} else {
-						second = cannotActAttack(ctx.UserID, false, f)
-					}
-				} else {
-					second = placeholderAttack(false, f)
-				}
-			} else {
-				if enemyCanAct {
-					first = executeAttack(ctx, f, false, enemySkill, true)
-				} else {
-					first = cannotActAttack(ctx.UserID, false, f)
-				}
-				if f.PlayerHP > 0 {
-					if playerCanAct {
-						second = executeAttack(ctx, f, true, playerSkill, false)
-					} else {
-						second = cannotActAttack(ctx.UserID, true, f)
-					}
-				} else {
-					second = placeholderAttack(true, f)
-				}
-			}
-		}
+		first, second, bossTimeout := resolvePvETurn(ctx, f, playerSkill)
 
 		oppID := f.OpponentUserID
 		firstStatus := f.PlayerStatus
@@ -280,7 +240,7 @@ func handleUseSkill(deps *Deps, state *State) gateway.Handler {
 			syncPvPFightState(state, user, f)
 		}
 
-		if f.EnemyHP == 0 || f.PlayerHP == 0 {
+		if f.EnemyHP == 0 || f.PlayerHP == 0 || bossTimeout {
 			winner := uint32(0)
 			if f.EnemyHP == 0 {
 				winner = ctx.UserID
@@ -324,6 +284,81 @@ func handleUseSkill(deps *Deps, state *State) gateway.Handler {
 	}
 }
 
+// resolvePvETurn plays one turn against the AI with the player's chosen
+// skill: the enemy picks its move, both sides spend PP, take status damage
+// and act in speed order, then the boss encounter advances. ctx is nil for
+// simulated fights. bossTimeout reports a boss turn limit running out.
+func resolvePvETurn(ctx *gateway.Context, f *FightState, playerSkill int) (first attackResult, second attackResult, bossTimeout bool) {
+	f.Turn++
+	enemySkill := pickEncoreSkill(&f.EnemyEncoreSkill, &f.EnemyEncoreTurns, f.EnemySkillPP)
+	if enemySkill == 0 {
+		enemySkill = selectEnemySkill(f)
+	}
+	if enemySkill == 0 {
+		enemySkill = selectRandomSkillWithPP(f.EnemySkills, f.EnemySkillPP)
+	}
+	if playerSkill > 0 {
+		if pp, maxPP, ok := consumeSkillPP(f.PlayerSkillPP, playerSkill); ok && ctx != nil {
+			sendSkillPPUpdate(ctx, playerSkill, pp, maxPP)
+		}
+	}
+	if enemySkill > 0 {
+		consumeSkillPP(f.EnemySkillPP, enemySkill)
+	}
+	f.PlayerLastSkill = playerSkill
+	f.EnemyLastSkill = enemySkill
+
+	applyTurnStatusDamage(f.PlayerStatus, &f.PlayerHP, f.PlayerMaxHP, &f.PlayerBoundTurns)
+	applyTurnStatusDamage(f.EnemyStatus, &f.EnemyHP, f.EnemyMaxHP, &f.EnemyBoundTurns)
+	tickFightEffects(f)
+
+	playerSpeed := effectiveSpeed(f.PlayerStats.Speed, f.PlayerStage.Spd, f.PlayerStatus)
+	enemySpeed := effectiveSpeed(f.EnemyStats.Speed, f.EnemyStage.Spd, f.EnemyStatus)
+	playerFirst := isPlayerFirst(playerSkill, enemySkill, playerSpeed, enemySpeed)
+
+	if f.PlayerHP <= 0 || f.EnemyHP <= 0 {
+		first = placeholderAttack(true, f)
+		second = placeholderAttack(false, f)
+	} else {
+		playerCanAct := canAct(f, true)
+		enemyCanAct := canAct(f, false)
+		if playerFirst {
+			if playerCanAct {
+				first = executeAttack(ctx, f, true, playerSkill, true)
+			} else {
+				first = cannotActAttack(f.UserID, true, f)
+			}
+			if f.EnemyHP > 0 {
+				if enemyCanAct {
+					second = executeAttack(ctx, f, false, enemySkill, false)
+				} else {
+					second = cannotActAttack(f.UserID, false, f)
+				}
+			} else {
+				second = placeholderAttack(false, f)
+			}
+		} else {
+			if enemyCanAct {
+				first = executeAttack(ctx, f, false, enemySkill, true)
+			} else {
+				first = cannotActAttack(f.UserID, false, f)
+			}
+			if f.PlayerHP > 0 {
+				if playerCanAct {
+					second = executeAttack(ctx, f, true, playerSkill, false)
+				} else {
+					second = cannotActAttack(f.UserID, true, f)
+				}
+			} else {
+				second = placeholderAttack(true, f)
+			}
+		}
+	}
+	bossTimeout = tickBossEncounter(f)
+	advanceBossPhases(f)
+	return first, second, bossTimeout
+}
+
 func handleUsePetItem(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
@@ -346,11 +381,14 @@ func handleUsePetItem(state *State) gateway.Handler {
 	}
 }
 
-func handleChangePet(state *State) gateway.Handler {
+func handleChangePet(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		catchTime := reader.ReadUint32BE()
 		user := state.GetOrCreateUser(ctx.UserID)
+		if user.Fight != nil {
+			updateFightHP(deps, user, user.Fight)
+		}
 		pet := resolveUserFightPet(user, catchTime, user.CurrentPetID)
 		user.CurrentPetID = pet.ID
 		if pet.CatchTime != 0 {
@@ -366,7 +404,8 @@ func handleChangePet(state *State) gateway.Handler {
 			user.Fight.PlayerType = pet.Type
 			user.Fight.PlayerHP = pet.CurrentHP
 			user.Fight.PlayerMaxHP = pet.Stats.MaxHP
-			user.Fight.PlayerSkillPP = nil
+			user.Fight.PlayerSkillPP = pet.SkillPP
+			user.Fight.PlayerStatus = pet.Status
 		}
 		hp := uint32(pet.CurrentHP)
 		maxHP := uint32(pet.Stats.MaxHP)
@@ -415,8 +454,7 @@ func handleCatchMonster(deps *Deps, state *State) gateway.Handler {
 			Exp:       0,
 			HP:        stats.MaxHP,
 		}
-		user.Pets = append(user.Pets, newPet)
-		upsertPet(deps, user, newPet)
+		addCapturedPet(deps, user, newPet)
 		buf := new(bytes.Buffer)
 		binary.Write(buf, binary.BigEndian, catchTime)
 		binary.Write(buf, binary.BigEndian, bossID)
@@ -438,6 +476,7 @@ func handleEscapeFight(deps *Deps, state *State) gateway.Handler {
 			if user.Fight.OpponentUserID == 0 {
 				updateFightResult(deps, user, user.Fight, false)
 			} else {
+				recordPvPResult(deps, state, user.Fight.PvPQueue, ctx.UserID, user.Fight.OpponentUserID, user.Fight.OpponentUserID)
 				updateFightHP(deps, user, user.Fight)
 				if opp := state.GetOrCreateUser(user.Fight.OpponentUserID); opp != nil && opp.Fight != nil {
 					updateFightHP(deps, opp, opp.Fight)
@@ -483,6 +522,7 @@ func handleUseSkillPvP(ctx *gateway.Context, deps *Deps, state *State, user *Use
 	defer state.fightMu.Unlock()
 
 	f.PendingSkillID = playerSkill
+	f.PvPTimeouts = 0
 	opp := state.GetOrCreateUser(oppID)
 	if opp == nil || opp.Fight == nil || opp.Fight.OpponentUserID != ctx.UserID {
 		f.PendingSkillID = 0
@@ -492,9 +532,21 @@ func handleUseSkillPvP(ctx *gateway.Context, deps *Deps, state *State, user *Use
 	if opp.Fight.PendingSkillID == 0 {
 		return
 	}
+	resolvePvPTurn(ctx.Server, deps, state, user, opp)
+}
+
+// resolvePvPTurn plays out a turn once both sides have a pending skill. The
+// caller must hold state.fightMu.
+func resolvePvPTurn(srv *gateway.Server, deps *Deps, state *State, user *User, opp *User) {
+	f := user.Fight
+	oppID := f.OpponentUserID
+	ctx, online := pvpUserContext(srv, state, user.ID)
+	playerSkill := f.PendingSkillID
 	enemySkill := opp.Fight.PendingSkillID
 	f.PendingSkillID = 0
 	opp.Fight.PendingSkillID = 0
+	f.TurnDeadline = time.Time{}
+	opp.Fight.TurnDeadline = time.Time{}
 
 	if enemySkill > 0 {
 		consumeSkillPP(f.EnemySkillPP, enemySkill)
@@ -508,6 +560,7 @@ func handleUseSkillPvP(ctx *gateway.Context, deps *Deps, state *State, user *Use
 
 	applyTurnStatusDamage(f.PlayerStatus, &f.PlayerHP, f.PlayerMaxHP, &f.PlayerBoundTurns)
 	applyTurnStatusDamage(f.EnemyStatus, &f.EnemyHP, f.EnemyMaxHP, &f.EnemyBoundTurns)
+	tickFightEffects(f)
 
 	playerSpeed := effectiveSpeed(f.PlayerStats.Speed, f.PlayerStage.Spd, f.PlayerStatus)
 	enemySpeed := effectiveSpeed(f.EnemyStats.Speed, f.EnemyStage.Spd, f.EnemyStatus)
@@ -525,13 +578,13 @@ func handleUseSkillPvP(ctx *gateway.Context, deps *Deps, state *State, user *Use
 			if playerCanAct {
 				first = executeAttack(ctx, f, true, playerSkill, true)
 			} else {
-				first = cannotActAttack(ctx.UserID, true, f)
+				first = cannotActAttack(user.ID, true, f)
 			}
 			if f.EnemyHP > 0 {
 				if enemyCanAct {
 					second = executeAttack(ctx, f, false, enemySkill, false)
 				} else {
-					second = cannotActAttack(ctx.UserID, false, f)
+					second = cannotActAttack(user.ID, false, f)
 				}
 			} else {
 				second = placeholderAttack(false, f)
@@ -540,13 +593,13 @@ func handleUseSkillPvP(ctx *gateway.Context, deps *Deps, state *State, user *Use
 			if enemyCanAct {
 				first = executeAttack(ctx, f, false, enemySkill, true)
 			} else {
-				first = cannotActAttack(ctx.UserID, false, f)
+				first = cannotActAttack(user.ID, false, f)
 			}
 			if f.PlayerHP > 0 {
 				if playerCanAct {
 					second = executeAttack(ctx, f, true, playerSkill, false)
 				} else {
-					second = cannotActAttack(ctx.UserID, true, f)
+					second = cannotActAttack(user.ID, true, f)
 				}
 			} else {
 				second = placeholderAttack(true, f)
@@ -574,47 +627,60 @@ func handleUseSkillPvP(ctx *gateway.Context, deps *Deps, state *State, user *Use
 	buf := new(bytes.Buffer)
 	buf.Write(buildAttackValue(firstUserID, first.SkillID, first.AtkTimes, first.LostHP, first.GainHP, first.RemainHP, first.MaxHP, first.State, first.IsCrit, first.PetType, first.Stage, firstStatus))
 	buf.Write(buildAttackValue(secondUserID, second.SkillID, second.AtkTimes, second.LostHP, second.GainHP, second.RemainHP, second.MaxHP, second.State, second.IsCrit, second.PetType, second.Stage, secondStatus))
-	ctx.Server.SendResponse(ctx.Conn, 2505, ctx.UserID, buf.Bytes())
+	if online {
+		srv.SendResponse(ctx.Conn, 2505, user.ID, buf.Bytes())
+	}
 	if conn, ok := state.GetConn(oppID); ok {
-		ctx.Server.SendResponse(conn, 2505, oppID, buf.Bytes())
+		srv.SendResponse(conn, 2505, oppID, buf.Bytes())
 	}
 	syncPvPFightState(state, user, f)
 
 	if f.EnemyHP == 0 || f.PlayerHP == 0 {
-		winner := uint32(0)
+		winner := oppID
 		if f.EnemyHP == 0 {
-			winner = ctx.UserID
-		} else {
-			winner = oppID
-		}
-		updateFightHP(deps, user, f)
-		sendNoteUpdateProp(ctx, user, f.PlayerCatch)
-		var oppCatch uint32
-		if opp.Fight != nil {
-			oppCatch = opp.Fight.PlayerCatch
-			updateFightHP(deps, opp, opp.Fight)
-		}
-		sendFightOver(ctx, winner, 0)
-		if conn, ok := state.GetConn(oppID); ok {
-			ctx.Server.SendResponse(conn, 2506, oppID, buildFightOverBody(0, winner))
-			if body := buildNoteUpdatePropBody(opp, oppCatch); len(body) > 0 {
-				ctx.Server.SendResponse(conn, 2508, oppID, body)
+			winner = user.ID
+		}
+		finishPvPFight(srv, deps, state, user, opp, winner)
+	}
+}
+
+// finishPvPFight settles ratings and pet HP for both sides and notifies
+// whoever is still connected.
+func finishPvPFight(srv *gateway.Server, deps *Deps, state *State, user *User, opp *User, winner uint32) {
+	recordPvPResult(deps, state, user.Fight.PvPQueue, user.ID, opp.ID, winner)
+	for _, u := range []*User{user, opp} {
+		var catchTime uint32
+		if u.Fight != nil {
+			catchTime = u.Fight.PlayerCatch
+			updateFightHP(deps, u, u.Fight)
+		}
+		if conn, ok := state.GetConn(u.ID); ok {
+			srv.SendResponse(conn, 2506, u.ID, buildFightOverBody(0, winner))
+			if body := buildNoteUpdatePropBody(u, catchTime); len(body) > 0 {
+				srv.SendResponse(conn, 2508, u.ID, body)
 			}
 		}
-		user.Fight = nil
-		user.InFight = false
-		opp.Fight = nil
-		opp.InFight = false
+		u.Fight = nil
+		u.InFight = false
 	}
 }
 
-func handleInviteToFight(state *State) gateway.Handler {
+func handleInviteToFight(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		targetID := reader.ReadUint32BE()
 		mode := reader.ReadUint32BE()
 
 		user := state.GetOrCreateUser(ctx.UserID)
+		if refuseFaintedFight(ctx, user) {
+			return
+		}
+		if targetID != 0 && blockedBy(deps, state, targetID, ctx.UserID) {
+			ack := new(bytes.Buffer)
+			binary.Write(ack, binary.BigEndian, blacklistedResult)
+			ctx.Server.SendResponse(ctx.Conn, 2401, ctx.UserID, ack.Bytes())
+			return
+		}
 		user.PendingInviteTo = targetID
 		user.PendingInviteMode = mode
 
@@ -656,6 +722,9 @@ func handleHandleFightInvite(state *State) gateway.Handler {
 		_ = reader.ReadUint32BE() // mode
 
 		responder := state.GetOrCreateUser(ctx.UserID)
+		if result == 1 && refuseFaintedFight(ctx, responder) {
+			return
+		}
 		ack := new(bytes.Buffer)
 		binary.Write(ack, binary.BigEndian, uint32(0))
 		ctx.Server.SendResponse(ctx.Conn, 2403, ctx.UserID, ack.Bytes())
@@ -676,20 +745,27 @@ func handleHandleFightInvite(state *State) gateway.Handler {
 		}
 
 		inviter := state.GetOrCreateUser(inviterID)
+		state.fightMu.Lock()
+		defer state.fightMu.Unlock()
 		initPvPFightState(state, inviterID, ctx.UserID, inviter, responder)
+		sendPvPFightStart(ctx.Server, state, inviterID, ctx.UserID, inviter, responder)
+	}
+}
 
-		bodyInviter, bodyResponder := buildNoteReadyToFightPvP(inviterID, ctx.UserID, inviter, responder)
-		if conn, ok := state.GetConn(inviterID); ok {
-			ctx.Server.SendResponse(conn, 2503, inviterID, bodyInviter)
-			body := buildNoteStartFightPvP(inviterID, inviter.Fight, ctx.UserID, responder.Fight)
-			if len(body) > 0 {
-				ctx.Server.SendResponse(conn, 2504, inviterID, body)
-			}
+func sendPvPFightStart(srv *gateway.Server, state *State, inviterID uint32, responderID uint32, inviter *User, responder *User) {
+	bodyInviter, bodyResponder := buildNoteReadyToFightPvP(inviterID, responderID, inviter, responder)
+	if conn, ok := state.GetConn(inviterID); ok {
+		srv.SendResponse(conn, 2503, inviterID, bodyInviter)
+		body := buildNoteStartFightPvP(inviterID, inviter.Fight, responderID, responder.Fight)
+		if len(body) > 0 {
+			srv.SendResponse(conn, 2504, inviterID, body)
 		}
-		ctx.Server.SendResponse(ctx.Conn, 2503, ctx.UserID, bodyResponder)
-		body := buildNoteStartFightPvP(ctx.UserID, responder.Fight, inviterID, inviter.Fight)
+	}
+	if conn, ok := state.GetConn(responderID); ok {
+		srv.SendResponse(conn, 2503, responderID, bodyResponder)
+		body := buildNoteStartFightPvP(responderID, responder.Fight, inviterID, inviter.Fight)
 		if len(body) > 0 {
-			ctx.Server.SendResponse(ctx.Conn, 2504, ctx.UserID, body)
+			srv.SendResponse(conn, 2504, responderID, body)
 		}
 	}
 }
@@ -699,6 +775,9 @@ func handleFightNpcMonster(state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		slot := int(reader.ReadUint32BE())
 		user := state.GetOrCreateUser(ctx.UserID)
+		if refuseFaintedFight(ctx, user) {
+			return
+		}
 		mapID := user.MapID
 		if mapID == 0 {
 			mapID = 1
@@ -730,26 +809,31 @@ func handleFightNpcMonster(state *State) gateway.Handler {
 		if enemy.CatchTime == 0 {
 			enemy.CatchTime = uint32(time.Now().Unix())
 		}
+		enemyAI, enemyAIScript := ogreBattleAI(mapID, slot, enemyID)
 
 		user.Fight = &FightState{
-			UserID:       ctx.UserID,
-			PlayerPetID:  player.ID,
-			PlayerLevel:  player.Level,
-			PlayerDV:     player.DV,
-			PlayerHP:     player.CurrentHP,
-			PlayerMaxHP:  player.Stats.MaxHP,
-			PlayerCatch:  player.CatchTime,
-			PlayerSkills: player.Skills,
-			PlayerStats:  player.Stats,
-			PlayerType:   player.Type,
-			EnemyPetID:   enemy.ID,
-			EnemyLevel:   enemy.Level,
-			EnemyHP:      enemy.CurrentHP,
-			EnemyMaxHP:   enemy.Stats.MaxHP,
-			EnemyCatch:   enemy.CatchTime,
-			EnemySkills:  enemy.Skills,
-			EnemyStats:   enemy.Stats,
-			EnemyType:    enemy.Type,
+			UserID:        ctx.UserID,
+			PlayerPetID:   player.ID,
+			PlayerLevel:   player.Level,
+			PlayerDV:      player.DV,
+			PlayerHP:      player.CurrentHP,
+			PlayerMaxHP:   player.Stats.MaxHP,
+			PlayerCatch:   player.CatchTime,
+			PlayerSkills:  player.Skills,
+			PlayerStats:   player.Stats,
+			PlayerType:    player.Type,
+			PlayerSkillPP: player.SkillPP,
+			PlayerStatus:  player.Status,
+			EnemyPetID:    enemy.ID,
+			EnemyLevel:    enemy.Level,
+			EnemyHP:       enemy.CurrentHP,
+			EnemyMaxHP:    enemy.Stats.MaxHP,
+			EnemyCatch:    enemy.CatchTime,
+			EnemySkills:   enemy.Skills,
+			EnemyStats:    enemy.Stats,
+			EnemyType:     enemy.Type,
+			EnemyAI:       enemyAI,
+			EnemyAIScript: enemyAIScript,
 		}
 
 		ctx.Server.SendResponse(ctx.Conn, 2408, ctx.UserID, []byte{})
@@ -779,16 +863,19 @@ func sendFightPetInfo(ctx *gateway.Context, user *User, f *FightState) {
 	}
 	var body []byte
 	if pet := findPetByCatchTime(user, f.PlayerCatch); pet != nil {
-		body = buildFullPetInfo(int(pet.ID), int(pet.CatchTime), int(pet.Level), int(pet.DV), pet.Exp, pet.Skills)
+		body = buildFullPetInfo(int(pet.ID), int(pet.CatchTime), int(pet.Level), int(pet.DV), pet.Exp, pet.EV, pet.Skills)
 	} else {
-		body = buildFullPetInfo(int(f.PlayerPetID), int(f.PlayerCatch), int(f.PlayerLevel), int(f.PlayerDV), 0, f.PlayerSkills)
+		body = buildFullPetInfo(int(f.PlayerPetID), int(f.PlayerCatch), int(f.PlayerLevel), int(f.PlayerDV), 0, evSet{}, f.PlayerSkills)
 	}
 	if len(body) > 0 {
 		ctx.Server.SendResponse(ctx.Conn, 2301, ctx.UserID, body)
 	}
 }
 
-func handleAttackBoss(state *State) gateway.Handler {
+// handleAttackBoss challenges a shielded map boss. The boss keeps the HP
+// it had left after the user's last attempt, so the shield wears down over
+// several encounter fights.
+func handleAttackBoss(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
 		mapID := user.MapID
@@ -799,43 +886,30 @@ func handleAttackBoss(state *State) gateway.Handler {
 		}
 		entries := getMapBossEntries(int(mapID))
 		entry, ok := entries[region]
-		if !ok || !entry.HasShield {
+		if !ok || !entry.HasShield || user.Fight != nil {
 			ctx.Server.SendResponse(ctx.Conn, 2412, ctx.UserID, make([]byte, 4))
 			return
 		}
-
-		maxHP := 0
-		if boss := GetSPTBossByID(entry.BossPetID); boss != nil && boss.MaxHP > 0 {
-			maxHP = boss.MaxHP
-		} else {
-			base := LoadPetDB().pets[entry.BossPetID]
-			stats := getStats(base, entry.Level, 15, evSet{})
-			maxHP = stats.MaxHP
-		}
-		if maxHP <= 0 {
-			maxHP = 1
-		}
-		key := bossShieldKey(mapID, region)
-		currentHP := int(user.BossShield[key])
-		if currentHP <= 0 {
-			currentHP = maxHP
+		if refuseFaintedFight(ctx, user) {
+			return
 		}
-		damage := maxHP / 4
-		if damage < 1 {
-			damage = 1
+
+		f := newBossFight(deps, user, entry.BossPetID, entry.Level)
+		f.MapBossKey = bossShieldKey(mapID, region)
+		if user.BossShield == nil {
+			user.BossShield = make(map[uint64]uint32)
 		}
-		newHP := currentHP - damage
-		if newHP < 0 {
-			newHP = 0
+		if hp := int(user.BossShield[f.MapBossKey]); hp > 0 && hp < f.EnemyMaxHP {
+			f.EnemyHP = hp
+			skipPassedBossPhases(f)
 		}
-		user.BossShield[key] = uint32(newHP)
+		user.BossShield[f.MapBossKey] = uint32(f.EnemyHP)
+		user.Fight = f
 
 		resp := new(bytes.Buffer)
-		binary.Write(resp, binary.BigEndian, uint32(newHP))
+		binary.Write(resp, binary.BigEndian, uint32(f.EnemyHP))
 		ctx.Server.SendResponse(ctx.Conn, 2412, ctx.UserID, resp.Bytes())
-
-		body := buildMapBossListForUser(user, int(mapID))
-		ctx.Server.SendResponse(ctx.Conn, 2021, ctx.UserID, body)
+		sendBossFightStart(ctx, user, f)
 	}
 }
 
@@ -869,62 +943,36 @@ func updateFightResult(deps *Deps, user *User, f *FightState, won bool) []int {
 	if user == nil || f == nil {
 		return nil
 	}
+	if f.MapBossKey != 0 && user.BossShield != nil {
+		hp := f.EnemyHP
+		if won {
+			hp = 0
+		}
+		user.BossShield[f.MapBossKey] = uint32(hp)
+	}
 	var learned []int
 	for i := range user.Pets {
 		if user.Pets[i].CatchTime != f.PlayerCatch {
 			continue
 		}
 		p := &user.Pets[i]
-		oldLevel := p.Level
-		oldSkills := append([]int{}, p.Skills...)
-		if len(oldSkills) == 0 {
-			base := LoadPetDB().pets[int(p.ID)]
-			oldSkills = getSkillsForLevel(base, int(oldLevel))
-		}
-		p.HP = f.PlayerHP
+		recordPetCondition(p, f)
 		if won {
-			expGain := calculateExpGain(int(f.EnemyPetID), int(f.EnemyLevel), true)
-			p.Exp += expGain
-			base := LoadPetDB().pets[int(p.ID)]
-			for {
-				info := getExpInfo(base, int(p.Level), p.Exp)
-				if p.Exp < info.NextLvExp {
-					break
-				}
-				p.Exp -= info.NextLvExp
-				p.Level++
-			}
-			stats := getStats(base, int(p.Level), int(p.DV), evSet{})
-			if p.HP > stats.MaxHP {
-				p.HP = stats.MaxHP
-			}
-			if p.Level > oldLevel {
-				newSkills := getSkillsForLevel(base, int(p.Level))
-				learned = diffSkills(newSkills, oldSkills)
-				if len(learned) > 0 {
-					cur := append([]int{}, oldSkills...)
-					cur = normalizeSkillList(cur, base, int(oldLevel))
-					for _, sid := range learned {
-						placed := false
-						for idx := range cur {
-							if cur[idx] == 0 {
-								cur[idx] = sid
-								placed = true
-								break
-							}
-						}
-						if placed {
-							continue
-						}
-					}
-					p.Skills = cur
-				}
+			if base := LoadPetDB().pets[int(f.EnemyPetID)]; base != nil {
+				gainPetEV(p, base.YieldEV)
 			}
+			expGain := calculateExpGain(int(f.EnemyPetID), int(f.EnemyLevel), true)
+			expGain = boostFightExp(deps, user, expGain)
+			shareMentorExp(deps, user, expGain)
+			learned = grantPetExp(p, expGain).Learned
 		}
 		upsertPet(deps, user, *p)
 		if won && f.EnemyRewardID > 0 {
 			grantItem(deps, user, f.EnemyRewardID, maxInt(1, f.EnemyRewardCt))
 		}
+		if won && f.BossID > 0 {
+			grantBossRewards(deps, user, getBossEncounter(deps, f.BossID))
+		}
 		break
 	}
 	return learned
@@ -1124,6 +1172,8 @@ type fightPetSnapshot struct {
 	Stats     petStats
 	Type      int
 	CurrentHP int
+	SkillPP   map[int]int
+	Status    map[int]int
 }
 
 type attackResult struct {
@@ -1174,24 +1224,6 @@ const (
 	statusBleed     = 16
 )
 
-const (
-	effectSelfStage   = 4
-	effectStageChange = 5
-	effectDrain       = 1
-	effectRecoil      = 6
-	effectMultiHit    = 31
-	effectFatigue     = 20
-	effectMercy       = 8
-	effectHpRatio     = 34
-	effectPunishment  = 35
-	effectParalysis   = 10
-	effectPoison      = 11
-	effectBurn        = 12
-	effectFreeze      = 14
-	effectFlinch      = 15
-	effectConfuse     = 16
-)
-
 var stageStatIndex = map[int]fightStateChange{
 	0: {Atk: 1},
 	1: {Def: 1},
@@ -1311,17 +1343,29 @@ func resolveUserFightPet(user *User, catchTime uint32, petID uint32) fightPetSna
 		if picked == nil && len(user.Pets) > 0 {
 			picked = &user.Pets[0]
 		}
+		// A fainted pet is swapped for the first one still standing. Fight
+		// requests check allPetsFainted before getting here.
+		if picked != nil && picked.HP <= 0 {
+			for i := range user.Pets {
+				if user.Pets[i].HP > 0 {
+					picked = &user.Pets[i]
+					break
+				}
+			}
+		}
 	}
 
 	id := petID
 	level := uint32(5)
 	dv := uint32(31)
+	var ev evSet
 	currentHP := 0
 	skills := []int{}
 	if picked != nil {
 		id = picked.ID
 		level = picked.Level
 		dv = picked.DV
+		ev = picked.EV
 		catchTime = picked.CatchTime
 		skills = append([]int{}, picked.Skills...)
 		currentHP = picked.HP
@@ -1331,9 +1375,13 @@ func resolveUserFightPet(user *User, catchTime uint32, petID uint32) fightPetSna
 	}
 
 	base := LoadPetDB().pets[int(id)]
-	stats := getStats(base, int(level), int(dv), evSet{})
+	stats := getStats(base, int(level), int(dv), ev)
 	skills = normalizeSkillList(skills, base, int(level))
-	if currentHP <= 0 {
+	var pp, status map[int]int
+	if picked != nil {
+		pp = petFightPP(picked, skills)
+		status = petFightStatus(picked)
+	} else {
 		currentHP = stats.MaxHP
 	}
 	if currentHP > stats.MaxHP {
@@ -1352,6 +1400,8 @@ func resolveUserFightPet(user *User, catchTime uint32, petID uint32) fightPetSna
 		Stats:     stats,
 		Type:      typ,
 		CurrentHP: currentHP,
+		SkillPP:   pp,
+		Status:    status,
 	}
 }
 
@@ -1553,39 +1603,6 @@ func pickEncoreSkill(encoreSkill *int, encoreTurns *int, pp map[int]int) int {
 	return *encoreSkill
 }
 
-func selectAISkill(f *FightState) int {
-	if f == nil {
-		return 0
-	}
-	best := 0
-	bestScore := -1.0
-	for _, sid := range f.EnemySkills {
-		if sid <= 0 {
-			continue
-		}
-		if f.EnemySkillPP != nil && f.EnemySkillPP[sid] <= 0 {
-			continue
-		}
-		info := getSkillInfo(sid)
-		if info == nil {
-			continue
-		}
-		score := 10.0
-		if info.Power > 0 && info.Category != 4 {
-			typeMod := elementMultiplier(info.Type, f.PlayerType)
-			score = float64(info.Power) * typeMod * (float64(info.Accuracy) / 100.0)
-			if f.PlayerMaxHP > 0 && float64(f.PlayerHP)/float64(f.PlayerMaxHP) < 0.3 {
-				score *= 1.5
-			}
-		}
-		if score > bestScore {
-			bestScore = score
-			best = sid
-		}
-	}
-	return best
-}
-
 func consumeSkillPP(pp map[int]int, skillID int) (int, int, bool) {
 	if pp == nil || skillID <= 0 {
 		return 0, 0, false
@@ -1697,7 +1714,9 @@ func executeAttack(ctx *gateway.Context, f *FightState, player bool, skillID int
 	defStatus := f.PlayerStatus
 	atkDV := int(f.EnemyDV)
 	if player {
-		attackerID = ctx.UserID
+		if ctx != nil {
+			attackerID = ctx.UserID
+		}
 		atkStats = f.PlayerStats
 		defStats = f.EnemyStats
 		atkLevel = int(f.PlayerLevel)
@@ -1715,40 +1734,24 @@ func executeAttack(ctx *gateway.Context, f *FightState, player bool, skillID int
 	if info == nil {
 		return placeholderAttack(player, f)
 	}
-	if !info.MustHit {
-		if !checkHit(info.Accuracy, atkStage.Acc, defStage.Eva) {
-			return attackResult{
-				UserID:   attackerID,
-				SkillID:  uint32(skillID),
-				AtkTimes: 0,
-				LostHP:   0,
-				GainHP:   0,
-				RemainHP: atkHP,
-				MaxHP:    atkMaxHP,
-				State:    1,
-				IsCrit:   0,
-				PetType:  uint32(atkType),
-				Stage:    atkStage,
-			}
-		}
+	missed := attackResult{
+		UserID:   attackerID,
+		SkillID:  uint32(skillID),
+		AtkTimes: 0,
+		LostHP:   0,
+		GainHP:   0,
+		RemainHP: atkHP,
+		MaxHP:    atkMaxHP,
+		State:    1,
+		IsCrit:   0,
+		PetType:  uint32(atkType),
+		Stage:    atkStage,
 	}
-
-	hitCount := uint32(1)
-	if info.SideEffect == effectMultiHit {
-		minHits := 2
-		maxHits := 5
-		args := parseEffectArgs(info.SideEffectArg)
-		if len(args) >= 2 {
-			minHits = args[0]
-			maxHits = args[1]
-		}
-		if maxHits < minHits {
-			maxHits = minHits
-		}
-		if minHits < 1 {
-			minHits = 1
-		}
-		hitCount = uint32(minHits + rand.Intn(maxHits-minHits+1))
+	if findBuff(f, player, buffSeal) != nil && info.Category == 4 {
+		return missed
+	}
+	if player && bossShieldBlocks(f, info) {
+		return missed
 	}
 
 	defHP := f.PlayerHP
@@ -1757,110 +1760,84 @@ func executeAttack(ctx *gateway.Context, f *FightState, player bool, skillID int
 		defHP = f.EnemyHP
 		defMaxHP = f.EnemyMaxHP
 	}
+	calls := skillEffectCalls(info)
+	ec := &effectContext{f: f, player: player, skill: info, first: isFirst}
+
+	hitCount := uint32(1)
+	dmgInfo := info
+	ec.eachEffectOp(calls, func(p *effectPrimitive) {
+		if p.Hits != nil {
+			hitCount = p.Hits(ec)
+		}
+		if p.Modify != nil {
+			if dmgInfo == info {
+				infoCopy := *info
+				dmgInfo = &infoCopy
+			}
+			p.Modify(ec, dmgInfo)
+		}
+	})
+	sureHit := dmgInfo.MustHit || findBuff(f, player, buffSureHit) != nil
+	if !sureHit && !checkHit(info.Accuracy, atkStage.Acc, defStage.Eva) {
+		return missed
+	}
+	if info.Category != 4 && isProtected(f, !player) {
+		return missed
+	}
+	if b := findBuff(f, !player, buffEvade); b != nil && !sureHit && rand.Intn(100) < b.Value {
+		return missed
+	}
+	if b := findBuff(f, !player, buffGuardCategory); b != nil && b.Value >= 100 && (b.Arg == 0 || b.Arg == info.Category) {
+		return missed
+	}
+	if b := findBuff(f, player, buffCrit); b != nil {
+		if dmgInfo == info {
+			infoCopy := *info
+			dmgInfo = &infoCopy
+		}
+		dmgInfo.CritRate = minInt(16, maxInt(1, dmgInfo.CritRate)+b.Value)
+	}
+	critGuarded := findBuff(f, !player, buffCritGuard) != nil
+
 	totalDamage := 0
 	critHit := false
 	for i := uint32(0); i < hitCount; i++ {
-		isCrit := checkCrit(info, atkHP, atkMaxHP, defHP, defMaxHP, atkStage, isFirst)
+		isCrit := !critGuarded && checkCrit(dmgInfo, atkHP, atkMaxHP, defHP, defMaxHP, atkStage, isFirst)
 		if isCrit {
 			critHit = true
 		}
-		damage := calcDamagePower(atkStats, defStats, atkLevel, atkDV, info, atkType, defType, atkStage, defStage, defStatus, isCrit)
-		if info.SideEffect == effectHpRatio {
-			ratio := 50
-			args := parseEffectArgs(info.SideEffectArg)
-			if len(args) > 0 {
-				ratio = args[0]
-			} else if info.Power > 0 {
-				ratio = info.Power
-			}
-			if ratio < 1 {
-				ratio = 1
-			}
-			damage = defHP * ratio / 100
-			if damage < 1 && defHP > 0 {
-				damage = 1
-			}
-		} else if info.SideEffect == effectPunishment {
-			extra := sumPositiveStages(defStage) * 20
-			infoCopy := *info
-			infoCopy.Power += extra
-			damage = calcDamagePower(atkStats, defStats, atkLevel, atkDV, &infoCopy, atkType, defType, atkStage, defStage, defStatus, isCrit)
-		}
-		totalDamage += damage
-	}
-
-	if info.SideEffect == effectMercy && defHP-totalDamage < 1 {
-		totalDamage = maxInt(0, defHP-1)
-	}
-	if totalDamage < 0 {
-		totalDamage = 0
+		totalDamage += calcDamagePower(atkStats, defStats, atkLevel, atkDV, dmgInfo, atkType, defType, atkStage, defStage, defStatus, isCrit)
 	}
 
-	gainHP := 0
-	recoilDamage := 0
-	if totalDamage > 0 {
-		if info.SideEffect == effectDrain {
-			gainHP = totalDamage / 2
-		}
-		if info.SideEffect == effectRecoil {
-			divisor := 4
-			args := parseEffectArgs(info.SideEffectArg)
-			if len(args) >= 1 && args[0] > 0 {
-				divisor = args[0]
-			}
-			recoilDamage = totalDamage / divisor
+	ec.damage = totalDamage
+	ec.eachEffectOp(calls, func(p *effectPrimitive) {
+		if p.Damage != nil {
+			p.Damage(ec)
 		}
-	}
+	})
+	ec.damage = adjustIncomingDamage(f, player, info, ec.damage)
+	totalDamage = ec.damage
 
 	if player {
 		f.EnemyHP = maxInt(0, f.EnemyHP-totalDamage)
-		if gainHP > 0 {
-			f.PlayerHP = minInt(f.PlayerMaxHP, f.PlayerHP+gainHP)
-		}
-		if recoilDamage > 0 {
-			f.PlayerHP = maxInt(0, f.PlayerHP-recoilDamage)
+		if totalDamage > 0 {
+			f.EnemyDamageTaken = totalDamage
 		}
 	} else {
 		f.PlayerHP = maxInt(0, f.PlayerHP-totalDamage)
-		if gainHP > 0 {
-			f.EnemyHP = minInt(f.EnemyMaxHP, f.EnemyHP+gainHP)
-		}
-		if recoilDamage > 0 {
-			f.EnemyHP = maxInt(0, f.EnemyHP-recoilDamage)
+		if totalDamage > 0 {
+			f.PlayerDamageTaken = totalDamage
 		}
 	}
 
-	if info.SideEffect == effectSelfStage || info.SideEffect == effectStageChange {
-		applyStageEffects(f, player, info)
-		if player {
-			atkStage = f.PlayerStage
-		} else {
-			atkStage = f.EnemyStage
-		}
-	}
-	if info.SideEffect != effectDrain && info.SideEffect != effectSelfStage && info.SideEffect != effectStageChange &&
-		info.SideEffect != effectRecoil && info.SideEffect != effectMercy && info.SideEffect != effectMultiHit &&
-		info.SideEffect != effectHpRatio && info.SideEffect != effectPunishment {
-		applySkillEffect(f, player, info, totalDamage)
-	}
-	if info.SideEffect == effectFatigue {
-		turns := 1
-		args := parseEffectArgs(info.SideEffectArg)
-		if len(args) >= 2 && args[1] > 0 {
-			turns = args[1]
-		}
-		if player {
-			f.PlayerFatigue = maxInt(f.PlayerFatigue, turns)
-		} else {
-			f.EnemyFatigue = maxInt(f.EnemyFatigue, turns)
-		}
-	}
+	ec.applyEffects(calls)
+	applyOnHitBuffs(ec)
 
-	remainHP := atkHP
+	remainHP := f.EnemyHP
+	atkStage = f.EnemyStage
 	if player {
 		remainHP = f.PlayerHP
-	} else {
-		remainHP = f.EnemyHP
+		atkStage = f.PlayerStage
 	}
 
 	return attackResult{
@@ -1868,7 +1845,7 @@ func executeAttack(ctx *gateway.Context, f *FightState, player bool, skillID int
 		SkillID:  uint32(skillID),
 		AtkTimes: hitCount,
 		LostHP:   uint32(totalDamage),
-		GainHP:   gainHP,
+		GainHP:   ec.gainHP,
 		RemainHP: remainHP,
 		MaxHP:    atkMaxHP,
 		State:    0,
@@ -2008,53 +1985,6 @@ func calcDamagePower(atk petStats, def petStats, level int, dv int, info *SkillI
 	return damage
 }
 
-func applyStageEffects(f *FightState, player bool, info *SkillInfo) {
-	if info == nil {
-		return
-	}
-	if info.SideEffect != effectSelfStage && info.SideEffect != effectStageChange {
-		return
-	}
-	args := parseEffectArgs(info.SideEffectArg)
-	if len(args) < 3 {
-		return
-	}
-	stat := args[0]
-	chance := args[1]
-	stages := args[2]
-	if chance <= 0 {
-		chance = 100
-	}
-	if rand.Intn(100)+1 > chance {
-		return
-	}
-	baseChange := stageStatIndex[stat]
-	if baseChange == (fightStateChange{}) {
-		return
-	}
-	change := fightStateChange{
-		Atk: baseChange.Atk * stages,
-		Def: baseChange.Def * stages,
-		SpA: baseChange.SpA * stages,
-		SpD: baseChange.SpD * stages,
-		Spd: baseChange.Spd * stages,
-		Acc: baseChange.Acc * stages,
-	}
-	if info.SideEffect == effectStageChange && stages < 0 {
-		if player {
-			f.EnemyStage = clampStageChange(f.EnemyStage, change)
-		} else {
-			f.PlayerStage = clampStageChange(f.PlayerStage, change)
-		}
-		return
-	}
-	if player {
-		f.PlayerStage = clampStageChange(f.PlayerStage, change)
-	} else {
-		f.EnemyStage = clampStageChange(f.EnemyStage, change)
-	}
-}
-
 func parseEffectArgs(arg string) []int {
 	if arg == "" {
 		return nil
@@ -2083,57 +2013,6 @@ func hasMajorStatus(status map[int]int) bool {
 	return false
 }
 
-func applySkillEffect(f *FightState, player bool, info *SkillInfo, damage int) {
-	if f == nil || info == nil || info.SideEffect <= 0 {
-		return
-	}
-	effectID := info.SideEffect
-	args := parseEffectArgs(info.SideEffectArg)
-	if applyDirectEffect(f, player, effectID, args) {
-		return
-	}
-	effect := getSkillEffect(effectID)
-	if effect == nil {
-		return
-	}
-	if len(args) == 0 {
-		args = parseEffectArgs(effect.Args)
-	}
-	applyEffectByEID(f, player, effect.Eid, args, damage)
-}
-
-func applyDirectEffect(f *FightState, player bool, effectID int, args []int) bool {
-	switch effectID {
-	case effectParalysis:
-		applyStatusWithChance(f, player, statusParalysis, args, 10, 999)
-		return true
-	case effectPoison:
-		applyStatusWithChance(f, player, statusPoison, args, 10, 999)
-		return true
-	case effectBurn:
-		applyStatusWithChance(f, player, statusBurn, args, 10, 999)
-		return true
-	case effectFreeze:
-		applyStatusWithChance(f, player, statusFreeze, args, 10, 3)
-		return true
-	case effectFlinch:
-		chance := 10
-		if len(args) >= 1 && args[0] > 0 {
-			chance = args[0]
-		}
-		if chance < 100 && rand.Intn(100)+1 > chance {
-			return true
-		}
-		setFlinch(f, player)
-		return true
-	case effectConfuse:
-		applyStatusWithChance(f, player, statusConfuse, args, 10, 3)
-		return true
-	default:
-		return false
-	}
-}
-
 func applyStatusWithChance(f *FightState, player bool, statusID int, args []int, defaultChance int, defaultTurns int) {
 	if f == nil {
 		return
@@ -2149,6 +2028,9 @@ func applyStatusWithChance(f *FightState, player bool, statusID int, args []int,
 	if chance < 100 && rand.Intn(100)+1 > chance {
 		return
 	}
+	if player && f.EnemySafeguard > 0 || !player && f.PlayerSafeguard > 0 {
+		return
+	}
 	if player {
 		if f.EnemyStatus == nil {
 			f.EnemyStatus = make(map[int]int)
@@ -2194,169 +2076,6 @@ func setBound(f *FightState, player bool, turns int) {
 	}
 }
 
-func applyEffectByEID(f *FightState, player bool, eid int, args []int, damage int) {
-	if f == nil {
-		return
-	}
-	switch eid {
-	case 1:
-		healPercent := 50
-		if len(args) >= 1 && args[0] > 0 {
-			healPercent = args[0]
-		}
-		heal := damage * healPercent / 100
-		if heal <= 0 {
-			return
-		}
-		if player {
-			f.PlayerHP = minInt(f.PlayerMaxHP, f.PlayerHP+heal)
-		} else {
-			f.EnemyHP = minInt(f.EnemyMaxHP, f.EnemyHP+heal)
-		}
-	case 2:
-		stat := 1
-		stages := 1
-		if len(args) >= 1 {
-			stat = args[0]
-		}
-		if len(args) >= 2 {
-			stages = args[1]
-		}
-		applyStageChangeTo(f, player, true, stat, -stages)
-	case 3, 4:
-		stat := 0
-		stages := 1
-		if len(args) >= 1 {
-			stat = args[0]
-		}
-		if len(args) >= 2 {
-			stages = args[1]
-		}
-		applyStageChangeTo(f, player, false, stat, stages)
-	case 5:
-		stat := 4
-		chance := 100
-		stages := 1
-		if len(args) >= 1 {
-			stat = args[0]
-		}
-		if len(args) >= 2 {
-			chance = args[1]
-		}
-		if len(args) >= 3 {
-			stages = args[2]
-		}
-		if chance < 100 && rand.Intn(100)+1 > chance {
-			return
-		}
-		applyStageChangeTo(f, player, true, stat, -stages)
-	case 6:
-		recoilPercent := 25
-		if len(args) >= 1 && args[0] > 0 {
-			recoilPercent = args[0]
-		}
-		recoil := damage * recoilPercent / 100
-		if player {
-			f.PlayerHP = maxInt(0, f.PlayerHP-recoil)
-		} else {
-			f.EnemyHP = maxInt(0, f.EnemyHP-recoil)
-		}
-	case 7:
-		if player {
-			f.EnemyHP = minInt(f.EnemyMaxHP, f.PlayerHP)
-		} else {
-			f.PlayerHP = minInt(f.PlayerMaxHP, f.EnemyHP)
-		}
-	case 8:
-		if player {
-			if f.EnemyHP <= 0 {
-				f.EnemyHP = 1
-			}
-		} else {
-			if f.PlayerHP <= 0 {
-				f.PlayerHP = 1
-			}
-		}
-	case 9:
-		minDamage := 20
-		maxDamage := 80
-		if len(args) >= 1 {
-			minDamage = args[0]
-		}
-		if len(args) >= 2 {
-			maxDamage = args[1]
-		}
-		if damage >= minDamage && damage <= maxDamage {
-			applyStageChangeTo(f, player, false, 0, 1)
-		}
-	case 10:
-		applyStatusWithChance(f, player, statusParalysis, args, 10, 999)
-	case 11:
-		chance := 100
-		if len(args) >= 1 && args[0] > 0 {
-			chance = args[0]
-		}
-		if chance < 100 && rand.Intn(100)+1 > chance {
-			return
-		}
-		setBound(f, player, 4)
-	case 12:
-		applyStatusWithChance(f, player, statusBurn, args, 10, 999)
-	case 13:
-		applyStatusWithChance(f, player, statusPoison, args, 10, 999)
-	case 14:
-		chance := 100
-		if len(args) >= 1 && args[0] > 0 {
-			chance = args[0]
-		}
-		if chance < 100 && rand.Intn(100)+1 > chance {
-			return
-		}
-		setBound(f, player, 4)
-	case 15, 29:
-		chance := 10
-		if len(args) >= 1 && args[0] > 0 {
-			chance = args[0]
-		}
-		if chance < 100 && rand.Intn(100)+1 > chance {
-			return
-		}
-		setFlinch(f, player)
-	case 20:
-		turns := 1
-		if len(args) >= 2 && args[1] > 0 {
-			turns = args[1]
-		}
-		if player {
-			f.PlayerFatigue = maxInt(f.PlayerFatigue, turns)
-		} else {
-			f.EnemyFatigue = maxInt(f.EnemyFatigue, turns)
-		}
-	case 31:
-		return
-	case 33:
-		if player {
-			reduceSkillPP(f.EnemySkillPP, f.EnemyLastSkill)
-		} else {
-			reduceSkillPP(f.PlayerSkillPP, f.PlayerLastSkill)
-		}
-	case 34:
-		turns := 2
-		if len(args) >= 1 && args[0] > 0 {
-			turns = args[0]
-		}
-		if player {
-			f.EnemyEncoreSkill = f.EnemyLastSkill
-			f.EnemyEncoreTurns = maxInt(f.EnemyEncoreTurns, turns)
-		} else {
-			f.PlayerEncoreSkill = f.PlayerLastSkill
-			f.PlayerEncoreTurns = maxInt(f.PlayerEncoreTurns, turns)
-		}
-	case 35:
-		return
-	}
-}
-
 func reduceSkillPP(pp map[int]int, skillID int) {
 	if pp == nil || skillID <= 0 {
 		return
@@ -2384,6 +2103,9 @@ func applyStageChangeTo(f *FightState, player bool, targetDefender bool, stat in
 	if base == (fightStateChange{}) {
 		return
 	}
+	if targetDefender && stages < 0 && isMisted(f, !player) {
+		return
+	}
 	change := fightStateChange{
 		Atk: base.Atk * stages,
 		Def: base.Def * stages,
@@ -2573,6 +2295,13 @@ func minInt(a int, b int) int {
 	return b
 }
 
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
 func sumPositiveStages(stage stageModifiers) int {
 	sum := 0
 	for _, v := range []int{stage.Atk, stage.Def, stage.SpA, stage.SpD, stage.Spd, stage.Acc, stage.Eva} {
@@ -2604,6 +2333,8 @@ func initPvPFightState(state *State, inviterID uint32, responderID uint32, invit
 		PlayerSkills:   invPlayer.Skills,
 		PlayerStats:    invPlayer.Stats,
 		PlayerType:     invPlayer.Type,
+		PlayerSkillPP:  cloneSkillPP(invPlayer.SkillPP),
+		PlayerStatus:   cloneStatusMap(invPlayer.Status),
 		EnemyPetID:     resPlayer.ID,
 		EnemyLevel:     resPlayer.Level,
 		EnemyDV:        resPlayer.DV,
@@ -2613,6 +2344,8 @@ func initPvPFightState(state *State, inviterID uint32, responderID uint32, invit
 		EnemySkills:    resPlayer.Skills,
 		EnemyStats:     resPlayer.Stats,
 		EnemyType:      resPlayer.Type,
+		EnemySkillPP:   cloneSkillPP(resPlayer.SkillPP),
+		EnemyStatus:    cloneStatusMap(resPlayer.Status),
 	}
 	responder.Fight = &FightState{
 		UserID:         responderID,
@@ -2626,6 +2359,8 @@ func initPvPFightState(state *State, inviterID uint32, responderID uint32, invit
 		PlayerSkills:   resPlayer.Skills,
 		PlayerStats:    resPlayer.Stats,
 		PlayerType:     resPlayer.Type,
+		PlayerSkillPP:  cloneSkillPP(resPlayer.SkillPP),
+		PlayerStatus:   cloneStatusMap(resPlayer.Status),
 		EnemyPetID:     invPlayer.ID,
 		EnemyLevel:     invPlayer.Level,
 		EnemyDV:        invPlayer.DV,
@@ -2635,6 +2370,8 @@ func initPvPFightState(state *State, inviterID uint32, responderID uint32, invit
 		EnemySkills:    invPlayer.Skills,
 		EnemyStats:     invPlayer.Stats,
 		EnemyType:      invPlayer.Type,
+		EnemySkillPP:   cloneSkillPP(invPlayer.SkillPP),
+		EnemyStatus:    cloneStatusMap(invPlayer.Status),
 	}
 	inviter.InFight = true
 	responder.InFight = true
@@ -2703,9 +2440,16 @@ func syncPvPFightState(state *State, user *User, f *FightState) {
 	opp.Fight.PlayerSkillPP = cloneSkillPP(f.EnemySkillPP)
 	opp.Fight.PlayerBoundTurns = f.EnemyBoundTurns
 	opp.Fight.PlayerFlinch = f.EnemyFlinch
+	opp.Fight.PlayerSeeded = f.EnemySeeded
+	opp.Fight.PlayerProtect = f.EnemyProtect
+	opp.Fight.PlayerMist = f.EnemyMist
+	opp.Fight.PlayerSafeguard = f.EnemySafeguard
 	opp.Fight.PlayerEncoreSkill = f.EnemyEncoreSkill
 	opp.Fight.PlayerEncoreTurns = f.EnemyEncoreTurns
 	opp.Fight.PlayerLastSkill = f.EnemyLastSkill
+	opp.Fight.PlayerType = f.EnemyType
+	opp.Fight.PlayerBuffs = cloneBuffs(f.EnemyBuffs)
+	opp.Fight.PlayerDamageTaken = f.EnemyDamageTaken
 	opp.Fight.EnemyHP = f.PlayerHP
 	opp.Fight.EnemyMaxHP = f.PlayerMaxHP
 	opp.Fight.EnemyStatus = cloneStatusMap(f.PlayerStatus)
@@ -2714,9 +2458,16 @@ func syncPvPFightState(state *State, user *User, f *FightState) {
 	opp.Fight.EnemySkillPP = cloneSkillPP(f.PlayerSkillPP)
 	opp.Fight.EnemyBoundTurns = f.PlayerBoundTurns
 	opp.Fight.EnemyFlinch = f.PlayerFlinch
+	opp.Fight.EnemySeeded = f.PlayerSeeded
+	opp.Fight.EnemyProtect = f.PlayerProtect
+	opp.Fight.EnemyMist = f.PlayerMist
+	opp.Fight.EnemySafeguard = f.PlayerSafeguard
 	opp.Fight.EnemyEncoreSkill = f.PlayerEncoreSkill
 	opp.Fight.EnemyEncoreTurns = f.PlayerEncoreTurns
 	opp.Fight.EnemyLastSkill = f.PlayerLastSkill
+	opp.Fight.EnemyType = f.PlayerType
+	opp.Fight.EnemyBuffs = cloneBuffs(f.PlayerBuffs)
+	opp.Fight.EnemyDamageTaken = f.PlayerDamageTaken
 }
 
 func updateFightHP(deps *Deps, user *User, f *FightState) {
@@ -2731,7 +2482,7 @@ func updateFightHP(deps *Deps, user *User, f *FightState) {
 		if f.PlayerHP < 0 {
 			f.PlayerHP = 0
 		}
-		p.HP = f.PlayerHP
+		recordPetCondition(p, f)
 		upsertPet(deps, user, *p)
 		break
 	}
@@ -2764,7 +2515,7 @@ func buildNoteUpdatePropBody(user *User, catchTime uint32) []byte {
 
 func buildNoteUpdateProp(pet Pet) []byte {
 	base := LoadPetDB().pets[int(pet.ID)]
-	stats := getStats(base, int(pet.Level), int(pet.DV), evSet{})
+	stats := getStats(base, int(pet.Level), int(pet.DV), pet.EV)
 	expInfo := getExpInfo(base, int(pet.Level), pet.Exp)
 	hp := pet.HP
 	if hp <= 0 {
@@ -3,7 +3,7 @@ package game
 import (
 	"bytes"
 	"encoding/binary"
-	"time"
+	"net"
 
 	"jseer/internal/gateway"
 	"jseer/internal/protocol"
@@ -21,6 +21,13 @@ func registerFriendHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2157, handleSeeOnline(state))
 	s.Register(2158, handleRequestOut())
 	s.Register(2159, handleRequestAnswer())
+	s.OnDisconnect(func(conn net.Conn) {
+		if id, ok := state.ConnUser(conn); ok {
+			if u, ok := state.GetUser(id); ok {
+				pushFriendPresence(state, u, false)
+			}
+		}
+	})
 }
 
 func handleFriendUnknown() gateway.Handler {
@@ -48,33 +55,42 @@ func handleGetRelationList(state *State) gateway.Handler {
 	}
 }
 
+// handleFriendAdd sends a friend request; the target sees it as a 2151
+// push now or when they next log in.
 func handleFriendAdd(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		targetID := reader.ReadUint32BE()
-		if targetID == 0 || targetID == ctx.UserID {
-			resp := protocol.BuildResponse(2151, ctx.UserID, 1, []byte{})
+		user := state.GetOrCreateUser(ctx.UserID)
+		if result := requestFriend(deps, state, user, targetID); result != friendOK {
+			resp := protocol.BuildResponse(2151, ctx.UserID, int32(result), []byte{})
 			_, _ = ctx.Conn.Write(resp)
 			return
 		}
-		user := state.GetOrCreateUser(ctx.UserID)
-		found := false
-		for _, f := range user.Friends {
-			if f.UserID == targetID {
-				found = true
-				break
-			}
-		}
-		if !found {
-			user.Friends = append(user.Friends, FriendInfo{
-				UserID:   targetID,
-				TimePoke: uint32(time.Now().Unix()),
-			})
-			savePlayer(deps, ctx.UserID, user)
-		}
 		buf := new(bytes.Buffer)
 		binary.Write(buf, binary.BigEndian, targetID)
 		ctx.Server.SendResponse(ctx.Conn, 2151, ctx.UserID, buf.Bytes())
+		if conn, ok := state.GetConn(targetID); ok {
+			ctx.Server.SendResponse(conn, 2151, targetID, buildFriendRequestBody(user))
+		}
+	}
+}
+
+// buildFriendRequestBody is pushed to the target of a friend request.
+func buildFriendRequestBody(from *User) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, from.ID)
+	protocol.WriteFixedString(buf, pickNick(from, from.ID), 16)
+	return buf.Bytes()
+}
+
+// pushFriendRequests replays requests that arrived while the user was
+// offline.
+func pushFriendRequests(ctx *gateway.Context, deps *Deps, state *State) {
+	for _, id := range pendingFriendRequests(state, ctx.UserID) {
+		if from, ok := findPlayer(deps, state, id); ok {
+			ctx.Server.SendResponse(ctx.Conn, 2151, ctx.UserID, buildFriendRequestBody(from))
+		}
 	}
 }
 
@@ -83,26 +99,24 @@ func handleFriendAnswer(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		targetID := reader.ReadUint32BE()
 		accept := reader.ReadUint32BE()
-		if accept == 1 && targetID > 0 {
-			user := state.GetOrCreateUser(ctx.UserID)
-			found := false
-			for _, f := range user.Friends {
-				if f.UserID == targetID {
-					found = true
-					break
-				}
-			}
-			if !found {
-				user.Friends = append(user.Friends, FriendInfo{
-					UserID:   targetID,
-					TimePoke: uint32(time.Now().Unix()),
-				})
-				savePlayer(deps, ctx.UserID, user)
-			}
+		user := state.GetOrCreateUser(ctx.UserID)
+		if result := answerFriend(deps, state, user, targetID, accept == 1); result != friendOK {
+			resp := protocol.BuildResponse(2152, ctx.UserID, int32(result), []byte{})
+			_, _ = ctx.Conn.Write(resp)
+			return
 		}
 		buf := new(bytes.Buffer)
 		binary.Write(buf, binary.BigEndian, accept)
 		ctx.Server.SendResponse(ctx.Conn, 2152, ctx.UserID, buf.Bytes())
+		if conn, ok := state.GetConn(targetID); ok {
+			body := new(bytes.Buffer)
+			body.Write(buildFriendRequestBody(user))
+			binary.Write(body, binary.BigEndian, accept)
+			ctx.Server.SendResponse(conn, 2152, targetID, body.Bytes())
+			if accept == 1 {
+				_, _ = conn.Write(buildPresencePacket(user, true))
+			}
+		}
 	}
 }
 
@@ -111,14 +125,7 @@ func handleFriendRemove(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		targetID := reader.ReadUint32BE()
 		user := state.GetOrCreateUser(ctx.UserID)
-		next := user.Friends[:0]
-		for _, f := range user.Friends {
-			if f.UserID != targetID {
-				next = append(next, f)
-			}
-		}
-		user.Friends = next
-		savePlayer(deps, ctx.UserID, user)
+		removeFriend(deps, state, user, targetID)
 		ctx.Server.SendResponse(ctx.Conn, 2153, ctx.UserID, []byte{})
 	}
 }
@@ -133,14 +140,9 @@ func handleBlackAdd(deps *Deps, state *State) gateway.Handler {
 			return
 		}
 		user := state.GetOrCreateUser(ctx.UserID)
-		// remove from friends
-		friends := user.Friends[:0]
-		for _, f := range user.Friends {
-			if f.UserID != targetID {
-				friends = append(friends, f)
-			}
+		if hasFriend(user, targetID) {
+			removeFriend(deps, state, user, targetID)
 		}
-		user.Friends = friends
 		// add to blacklist
 		found := false
 		for _, id := range user.Blacklist {
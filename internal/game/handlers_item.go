@@ -3,6 +3,7 @@ package game
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	"jseer/internal/gateway"
 	"jseer/internal/protocol"
@@ -16,9 +17,9 @@ func registerItemHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2605, handleItemList(state))
 	s.Register(2606, handleMultiItemBuy(deps, state))
 	s.Register(2607, handleItemExpend(deps, state))
-	s.Register(2608, handleGetLastEgg())
+	s.Register(2608, handleGetLastEgg(state))
 	s.Register(2609, handleEquipUpdate())
-	s.Register(2610, handleEatSpecialMedicine())
+	s.Register(2610, handleEatSpecialMedicine(deps, state))
 	s.Register(2901, handleExchangeClothComplete())
 }
 
@@ -109,7 +110,7 @@ func handleChangeCloth(deps *Deps, state *State) gateway.Handler {
 		}
 		resp := protocol.BuildResponse(2604, ctx.UserID, 0, buf.Bytes())
 		if user.MapID > 0 {
-			state.BroadcastToMap(user.MapID, resp)
+			broadcastToScene(state, user, resp)
 		} else {
 			ctx.Server.SendResponse(ctx.Conn, 2604, ctx.UserID, buf.Bytes())
 		}
@@ -238,18 +239,31 @@ func handleItemRepair() gateway.Handler {
 	}
 }
 
-func handleGetLastEgg() gateway.Handler {
+func handleGetLastEgg(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		eggID := user.Incubator.EggItemID
+		if eggID == 0 {
+			eggID = user.Incubator.LastEggID
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, eggID)
+		binary.Write(buf, binary.BigEndian, incubationRemaining(user, time.Now()))
+		binary.Write(buf, binary.BigEndian, user.Incubator.LastPetID)
 		ctx.Server.SendResponse(ctx.Conn, 2608, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleEatSpecialMedicine() gateway.Handler {
+func handleEatSpecialMedicine(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		itemID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := usePetItem(ctx, deps, user, findPetByCatchTime(user, catchTime), itemID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, catchTime)
 		ctx.Server.SendResponse(ctx.Conn, 2610, ctx.UserID, buf.Bytes())
 	}
 }
@@ -84,13 +84,34 @@ func handleMailSend(deps *Deps, state *State) gateway.Handler {
 			targetID = ctx.UserID
 		}
 		sender := state.GetOrCreateUser(ctx.UserID)
-		recipient := state.GetOrCreateUser(targetID)
+		recipient, ok := findPlayer(deps, state, targetID)
+		if !ok {
+			if deps != nil && deps.Store != nil {
+				resp := protocol.BuildResponse(2752, ctx.UserID, 1, []byte{})
+				_, _ = ctx.Conn.Write(resp)
+				return
+			}
+			recipient = state.GetOrCreateUser(targetID)
+		}
+		result := uint32(0)
+		switch {
+		case hasBlacklisted(recipient, ctx.UserID):
+			result = blacklistedResult
+		case isMuted(deps, ctx.UserID, time.Now()):
+			result = mutedResult
+		}
+		if result != 0 {
+			buf := new(bytes.Buffer)
+			binary.Write(buf, binary.BigEndian, result)
+			ctx.Server.SendResponse(ctx.Conn, 2752, ctx.UserID, buf.Bytes())
+			return
+		}
 		mail := Mail{
 			ID:         nextMailID(),
 			SenderID:   ctx.UserID,
 			SenderName: pickNick(sender, ctx.UserID),
-			Title:      title,
-			Content:    content,
+			Title:      maskSensitive(deps, title),
+			Content:    maskSensitive(deps, content),
 			CreatedAt:  uint32(time.Now().Unix()),
 			Read:       false,
 		}
@@ -3,6 +3,7 @@ package game
 import (
 	"bytes"
 	"encoding/binary"
+	"net"
 	"sort"
 	"time"
 
@@ -27,7 +28,7 @@ func registerMapHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2061, handleChangeNickName(deps, state))
 	s.Register(2063, handleChangeColor(deps, state))
 	s.Register(2101, handlePeopleWalk(state))
-	s.Register(2102, handleChat(state))
+	s.Register(2102, handleChat(deps, state))
 	s.Register(2103, handleDanceAction(state))
 	s.Register(2104, handleAimat(state))
 	s.Register(2105, handleHitStone())
@@ -38,6 +39,11 @@ func registerMapHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2111, handlePeopleTransform(state))
 	s.Register(2112, handleOnOrOffFlying(deps, state))
 	s.Register(2113, handleRemoveCoins(deps, state))
+	s.OnDisconnect(func(conn net.Conn) {
+		if id, ok := state.ConnUser(conn); ok {
+			state.chatLimits.forget(id)
+		}
+	})
 }
 
 func handleOnMapSwitch() gateway.Handler {
@@ -244,16 +250,7 @@ func handleLeaveMap(deps *Deps, state *State) gateway.Handler {
 func handleListMapPlayer(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
-		mapID := user.MapID
-		players := state.GetPlayersInMap(mapID)
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(len(players)))
-		for _, pid := range players {
-			pUser := state.GetOrCreateUser(pid)
-			info := buildPeopleInfo(pid, pUser, uint32(time.Now().Unix()))
-			buf.Write(info)
-		}
-		ctx.Server.SendResponse(ctx.Conn, 2003, ctx.UserID, buf.Bytes())
+		ctx.Server.SendResponse(ctx.Conn, 2003, ctx.UserID, buildScenePlayerList(state, user))
 	}
 }
 
@@ -331,6 +328,11 @@ func handleChangeNickName(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		newNick := reader.ReadFixedString(16)
+		if hasSensitive(deps, newNick) {
+			resp := protocol.BuildResponse(2061, ctx.UserID, 1, []byte{})
+			_, _ = ctx.Conn.Write(resp)
+			return
+		}
 		user := state.GetOrCreateUser(ctx.UserID)
 		if newNick != "" {
 			user.Nick = newNick
@@ -386,35 +388,31 @@ func handlePeopleWalk(state *State) gateway.Handler {
 		resp := protocol.BuildResponse(2101, ctx.UserID, 0, buf.Bytes())
 
 		if user.MapID > 0 {
-			state.BroadcastToMap(user.MapID, resp)
+			broadcastToScene(state, user, resp)
 		} else {
 			ctx.Server.SendResponse(ctx.Conn, 2101, ctx.UserID, buf.Bytes())
 		}
 	}
 }
 
-func handleChat(state *State) gateway.Handler {
+// handleChat routes 2102 by its first field: map, team, world or system
+// chat, or a whisper to that user ID. Failures come back in the header.
+func handleChat(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
-		_ = reader.ReadUint32BE() // chatType
+		to := reader.ReadUint32BE()
 		msgLen := reader.ReadUint32BE()
 		msg := reader.ReadBytes(int(msgLen))
 
 		user := state.GetOrCreateUser(ctx.UserID)
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, ctx.UserID)
-		protocol.WriteFixedString(buf, pickNick(user, ctx.UserID), 16)
-		binary.Write(buf, binary.BigEndian, uint32(0)) // toID
-		binary.Write(buf, binary.BigEndian, uint32(len(msg)))
-		if len(msg) > 0 {
-			buf.Write(msg)
+		result, echo := routeChat(deps, state, user, to, msg, time.Now())
+		if result != chatOK {
+			resp := protocol.BuildResponse(2102, ctx.UserID, int32(result), []byte{})
+			_, _ = ctx.Conn.Write(resp)
+			return
 		}
-		resp := protocol.BuildResponse(2102, ctx.UserID, 0, buf.Bytes())
-
-		if user.MapID > 0 {
-			state.BroadcastToMap(user.MapID, resp)
-		} else {
-			ctx.Server.SendResponse(ctx.Conn, 2102, ctx.UserID, buf.Bytes())
+		if echo != nil {
+			ctx.Server.SendResponse(ctx.Conn, 2102, ctx.UserID, echo)
 		}
 	}
 }
@@ -442,7 +440,7 @@ func handleOnOrOffFlying(deps *Deps, state *State) gateway.Handler {
 		binary.Write(buf, binary.BigEndian, flyMode)
 		resp := protocol.BuildResponse(2112, ctx.UserID, 0, buf.Bytes())
 		if user.MapID > 0 {
-			state.BroadcastToMap(user.MapID, resp)
+			broadcastToScene(state, user, resp)
 		} else {
 			ctx.Server.SendResponse(ctx.Conn, 2112, ctx.UserID, buf.Bytes())
 		}
@@ -483,7 +481,7 @@ func handleTransformUser(state *State) gateway.Handler {
 		resp := protocol.BuildResponse(2108, ctx.UserID, 0, buf.Bytes())
 		user := state.GetOrCreateUser(ctx.UserID)
 		if user.MapID > 0 {
-			state.BroadcastToMap(user.MapID, resp)
+			broadcastToScene(state, user, resp)
 		} else {
 			ctx.Server.SendResponse(ctx.Conn, 2108, ctx.UserID, buf.Bytes())
 		}
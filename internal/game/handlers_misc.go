@@ -138,7 +138,7 @@ func handleAllConnection(state *State) gateway.Handler {
 
 func handleUserReport(deps *Deps) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		writeAudit(deps, ctx, "user_report")
+		recordUserReport(deps, ctx.UserID, ctx.Body)
 		buf := new(bytes.Buffer)
 		binary.Write(buf, binary.BigEndian, uint32(0))
 		ctx.Server.SendResponse(ctx.Conn, 7001, ctx.UserID, buf.Bytes())
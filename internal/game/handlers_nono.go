@@ -246,7 +246,7 @@ func handleNonoFollowOrHoom(deps *Deps, state *State) gateway.Handler {
 		body := buf.Bytes()
 		resp := protocol.BuildResponse(9019, ctx.UserID, 0, body)
 		if user.MapID > 0 {
-			state.BroadcastToMap(user.MapID, resp)
+			broadcastToScene(state, user, resp)
 		} else {
 			ctx.Server.SendResponse(ctx.Conn, 9019, ctx.UserID, body)
 		}
@@ -10,12 +10,12 @@ import (
 
 func registerPetHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2301, handleGetPetInfo(state))
-	s.Register(2303, handleGetPetList())
+	s.Register(2303, handleGetPetList(state))
 	s.Register(2304, handlePetRelease(deps, state))
 	s.Register(2305, handlePetShow(state))
-	s.Register(2306, handlePetCure())
+	s.Register(2306, handlePetCure(deps, state))
 	s.Register(2309, handlePetBargeList(state))
-	s.Register(2354, handleGetSoulBeadList())
+	s.Register(2354, handleGetSoulBeadList(state))
 }
 
 func handleGetPetInfo(state *State) gateway.Handler {
@@ -27,6 +27,7 @@ func handleGetPetInfo(state *State) gateway.Handler {
 		level := 5
 		dv := 31
 		exp := 0
+		var ev evSet
 		if user.CurrentPetID != 0 {
 			petID = user.CurrentPetID
 		}
@@ -42,6 +43,7 @@ func handleGetPetInfo(state *State) gateway.Handler {
 					level = int(p.Level)
 					dv = int(p.DV)
 					exp = p.Exp
+					ev = p.EV
 					skills = append([]int{}, p.Skills...)
 					break
 				}
@@ -51,15 +53,23 @@ func handleGetPetInfo(state *State) gateway.Handler {
 		if catchTime == 0 {
 			catchTime = int(targetCatch)
 		}
-		body := buildFullPetInfo(int(petID), catchTime, level, dv, exp, skills)
+		body := buildFullPetInfo(int(petID), catchTime, level, dv, exp, ev, skills)
 		ctx.Server.SendResponse(ctx.Conn, 2301, ctx.UserID, body)
 	}
 }
 
-func handleGetPetList() gateway.Handler {
+func handleGetPetList(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, uint32(len(user.Pets)))
+		for _, p := range user.Pets {
+			binary.Write(buf, binary.BigEndian, p.ID)
+			binary.Write(buf, binary.BigEndian, p.CatchTime)
+			binary.Write(buf, binary.BigEndian, p.Level)
+			binary.Write(buf, binary.BigEndian, boolToUint32(p.Collected))
+			binary.Write(buf, binary.BigEndian, boolToUint32(p.RoomShow))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2303, ctx.UserID, buf.Bytes())
 	}
 }
@@ -84,7 +94,7 @@ func handlePetRelease(deps *Deps, state *State) gateway.Handler {
 		binary.Write(buf, binary.BigEndian, uint32(0)) // homeEnergy
 		binary.Write(buf, binary.BigEndian, catchID)
 		binary.Write(buf, binary.BigEndian, uint32(1))
-		buf.Write(buildFullPetInfo(petType, int(catchID), 5, 31, 0, nil))
+		buf.Write(buildFullPetInfo(petType, int(catchID), 5, 31, 0, evSet{}, nil))
 		ctx.Server.SendResponse(ctx.Conn, 2304, ctx.UserID, buf.Bytes())
 	}
 }
@@ -127,10 +137,14 @@ func handlePetShow(state *State) gateway.Handler {
 	}
 }
 
-func handlePetCure() gateway.Handler {
+func handlePetCure(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		// Keep ack-only to match protocol expectations; healing is handled by 2310 for single pet.
-		ctx.Server.SendResponse(ctx.Conn, 2306, ctx.UserID, []byte{})
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := cureAllPets(deps, user)
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.Coins)
+		ctx.Server.SendResponse(ctx.Conn, 2306, ctx.UserID, buf.Bytes())
 	}
 }
 
@@ -148,6 +162,9 @@ func handlePetBargeList(state *State) gateway.Handler {
 		for _, p := range user.Pets {
 			caughtSet[int(p.ID)] = true
 		}
+		for _, p := range user.Warehouse {
+			caughtSet[int(p.ID)] = true
+		}
 
 		buf := new(bytes.Buffer)
 		binary.Write(buf, binary.BigEndian, maxID)
@@ -168,15 +185,20 @@ func handlePetBargeList(state *State) gateway.Handler {
 	}
 }
 
-func handleGetSoulBeadList() gateway.Handler {
+func handleGetSoulBeadList(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, uint32(len(user.SoulBeads)))
+		for _, bead := range user.SoulBeads {
+			binary.Write(buf, binary.BigEndian, bead.ObtainTime)
+			binary.Write(buf, binary.BigEndian, bead.ItemID)
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2354, ctx.UserID, buf.Bytes())
 	}
 }
 
-func buildFullPetInfo(petID int, catchTime int, level int, dv int, exp int, skillsOverride []int) []byte {
+func buildFullPetInfo(petID int, catchTime int, level int, dv int, exp int, ev evSet, skillsOverride []int) []byte {
 	db := LoadPetDB()
 	base := db.pets[petID]
 	if level <= 0 {
@@ -185,7 +207,7 @@ func buildFullPetInfo(petID int, catchTime int, level int, dv int, exp int, skil
 	if dv <= 0 {
 		dv = 31
 	}
-	stats := getStats(base, level, dv, evSet{})
+	stats := getStats(base, level, dv, ev)
 	expInfo := getExpInfo(base, level, exp)
 	skills := skillsOverride
 	if len(skills) == 0 {
@@ -217,9 +239,8 @@ func buildFullPetInfo(petID int, catchTime int, level int, dv int, exp int, skil
 	binary.Write(buf, binary.BigEndian, uint32(stats.SD))
 	binary.Write(buf, binary.BigEndian, uint32(stats.Speed))
 
-	// EVs
-	for i := 0; i < 6; i++ {
-		binary.Write(buf, binary.BigEndian, uint32(0))
+	for _, v := range ev.fields() {
+		binary.Write(buf, binary.BigEndian, uint32(*v))
 	}
 
 	validCount := 0
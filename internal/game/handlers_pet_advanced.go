@@ -3,6 +3,7 @@ package game
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	"jseer/internal/gateway"
 )
@@ -12,34 +13,34 @@ func registerPetAdvancedHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2307, handlePetStudySkill(deps, state))
 	s.Register(2308, handlePetDefault(deps, state))
 	s.Register(2310, handlePetOneCure(deps, state))
-	s.Register(2311, handlePetCollect())
-	s.Register(2312, handlePetSkillSwitch())
-	s.Register(2313, handleIsCollect())
-	s.Register(2314, handlePetEvolution())
-	s.Register(2315, handlePetHatch())
-	s.Register(2316, handlePetHatchGet())
+	s.Register(2311, handlePetCollect(deps, state))
+	s.Register(2312, handlePetSkillSwitch(deps, state))
+	s.Register(2313, handleIsCollect(state))
+	s.Register(2314, handlePetEvolution(deps, state))
+	s.Register(2315, handlePetHatch(deps, state))
+	s.Register(2316, handlePetHatchGet(deps, state))
 	s.Register(2318, handlePetSetExp(deps, state))
 	s.Register(2319, handlePetGetExp(state))
-	s.Register(2320, handlePetRoweiList())
-	s.Register(2321, handlePetRowei())
-	s.Register(2322, handlePetRetrieve())
-	s.Register(2323, handlePetRoomShow())
-	s.Register(2324, handlePetRoomList())
-	s.Register(2325, handlePetRoomInfo())
-	s.Register(2326, handleUsePetItemOutOfFight())
-	s.Register(2327, handleUseSpeedupItem())
-	s.Register(2328, handleSkillSort())
-	s.Register(2329, handleUseAutoFightItem())
-	s.Register(2330, handleOnOffAutoFight())
-	s.Register(2331, handleUseEnergyXishou())
-	s.Register(2332, handleUseStudyItem())
+	s.Register(2320, handlePetRoweiList(state))
+	s.Register(2321, handlePetRowei(deps, state))
+	s.Register(2322, handlePetRetrieve(deps, state))
+	s.Register(2323, handlePetRoomShow(deps, state))
+	s.Register(2324, handlePetRoomList(deps, state))
+	s.Register(2325, handlePetRoomInfo(deps, state))
+	s.Register(2326, handleUsePetItemOutOfFight(deps, state))
+	s.Register(2327, handleUseSpeedupItem(deps, state))
+	s.Register(2328, handleSkillSort(deps, state))
+	s.Register(2329, handleUseAutoFightItem(deps, state))
+	s.Register(2330, handleOnOffAutoFight(deps, state))
+	s.Register(2331, handleUseEnergyXishou(deps, state))
+	s.Register(2332, handleUseStudyItem(deps, state))
 	s.Register(2343, handlePetResetNature())
-	s.Register(2351, handlePetFusion())
-	s.Register(2352, handleGetSoulBeadBuf())
-	s.Register(2353, handleSetSoulBeadBuf())
-	s.Register(2356, handleGetSoulBeadStatus())
-	s.Register(2357, handleTransformSoulBead())
-	s.Register(2358, handleSoulBeadToPet())
+	s.Register(2351, handlePetFusion(deps, state))
+	s.Register(2352, handleGetSoulBeadBuf(state))
+	s.Register(2353, handleSetSoulBeadBuf(deps, state))
+	s.Register(2356, handleGetSoulBeadStatus(state))
+	s.Register(2357, handleTransformSoulBead(deps, state))
+	s.Register(2358, handleSoulBeadToPet(deps, state))
 }
 
 func handleModifyPetName(deps *Deps, state *State) gateway.Handler {
@@ -63,32 +64,21 @@ func handleModifyPetName(deps *Deps, state *State) gateway.Handler {
 	}
 }
 
+// handlePetStudySkill learns a move from the species list. An optional
+// third field names the active move to replace.
 func handlePetStudySkill(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		catchTime := reader.ReadUint32BE()
 		skillID := reader.ReadUint32BE()
-		user := state.GetOrCreateUser(ctx.UserID)
-		if catchTime > 0 && skillID > 0 {
-			for i := range user.Pets {
-				if user.Pets[i].CatchTime == catchTime {
-					exists := false
-					for _, sid := range user.Pets[i].Skills {
-						if sid == int(skillID) {
-							exists = true
-							break
-						}
-					}
-					if !exists {
-						user.Pets[i].Skills = append(user.Pets[i].Skills, int(skillID))
-					}
-					upsertPet(deps, user, user.Pets[i])
-					break
-				}
-			}
+		replaceID := uint32(0)
+		if reader.Remaining() >= 4 {
+			replaceID = reader.ReadUint32BE()
 		}
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := studyPetSkill(deps, user, findPetByCatchTime(user, catchTime), int(skillID), int(replaceID))
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2307, ctx.UserID, buf.Bytes())
 	}
 }
@@ -119,35 +109,46 @@ func handlePetOneCure(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		catchTime := reader.ReadUint32BE()
 		user := state.GetOrCreateUser(ctx.UserID)
-		if catchTime > 0 {
-			for i := range user.Pets {
-				if user.Pets[i].CatchTime == catchTime {
-					base := LoadPetDB().pets[int(user.Pets[i].ID)]
-					stats := getStats(base, int(user.Pets[i].Level), int(user.Pets[i].DV), evSet{})
-					user.Pets[i].HP = stats.MaxHP
-					upsertPet(deps, user, user.Pets[i])
-					break
-				}
-			}
-		}
+		result := cureOnePet(deps, user, catchTime)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.Coins)
 		ctx.Server.SendResponse(ctx.Conn, 2310, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleIsCollect() gateway.Handler {
+func handleIsCollect(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		collected := false
+		if p := findOwnedPet(user, catchTime); p != nil {
+			collected = p.Collected
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(1))
+		binary.Write(buf, binary.BigEndian, boolToUint32(collected))
 		ctx.Server.SendResponse(ctx.Conn, 2313, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetHatchGet() gateway.Handler {
+// handlePetHatchGet collects the hatched pet when the egg is ready; until then
+// it reports the incubating egg and remaining seconds with a zero pet.
+func handlePetHatchGet(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		for i := 0; i < 4; i++ {
+		user := state.GetOrCreateUser(ctx.UserID)
+		now := time.Now()
+		eggID := user.Incubator.EggItemID
+		remaining := incubationRemaining(user, now)
+		_, pet := collectHatchedPet(deps, user, now)
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, eggID)
+		binary.Write(buf, binary.BigEndian, remaining)
+		if pet != nil {
+			binary.Write(buf, binary.BigEndian, pet.ID)
+			binary.Write(buf, binary.BigEndian, pet.CatchTime)
+		} else {
+			binary.Write(buf, binary.BigEndian, uint32(0))
 			binary.Write(buf, binary.BigEndian, uint32(0))
 		}
 		ctx.Server.SendResponse(ctx.Conn, 2316, ctx.UserID, buf.Bytes())
@@ -160,160 +161,334 @@ func handlePetSetExp(deps *Deps, state *State) gateway.Handler {
 		catchTime := reader.ReadUint32BE()
 		expAmount := reader.ReadUint32BE()
 		user := state.GetOrCreateUser(ctx.UserID)
-		if user.ExpPool >= expAmount {
-			user.ExpPool -= expAmount
+		pet := findPetByCatchTime(user, catchTime)
+		if pet != nil && pet.Level < petLevelCap {
+			expAmount = takeExpPool(deps, user, expAmount)
 		} else {
-			expAmount = user.ExpPool
-			user.ExpPool = 0
-		}
-		if catchTime > 0 {
-			for i := range user.Pets {
-				if user.Pets[i].CatchTime == catchTime {
-					user.Pets[i].Exp += int(expAmount)
-					user.Pets[i].Skills = append([]int{}, user.Pets[i].Skills...)
-					upsertPet(deps, user, user.Pets[i])
-					break
-				}
-			}
+			expAmount = 0
 		}
 		buf := new(bytes.Buffer)
 		binary.Write(buf, binary.BigEndian, user.ExpPool)
 		ctx.Server.SendResponse(ctx.Conn, 2318, ctx.UserID, buf.Bytes())
+		if expAmount > 0 {
+			applyPetExp(ctx, deps, user, pet, int(expAmount))
+		}
 	}
 }
 
-func handlePetCollect() gateway.Handler {
+func handlePetCollect(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		flag := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := setPetCollected(deps, user, catchTime, flag != 0)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, catchTime)
+		binary.Write(buf, binary.BigEndian, flag)
 		ctx.Server.SendResponse(ctx.Conn, 2311, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetSkillSwitch() gateway.Handler {
+func handlePetSkillSwitch(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		activeID := reader.ReadUint32BE()
+		learnedID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := switchPetSkill(deps, user, findPetByCatchTime(user, catchTime), int(activeID), int(learnedID))
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2312, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetEvolution() gateway.Handler {
+func handlePetEvolution(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, learned := triggerPetEvolution(deps, user, findPetByCatchTime(user, catchTime))
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2314, ctx.UserID, buf.Bytes())
+		if result == petEvolveOK {
+			sendNoteUpdateProp(ctx, user, catchTime)
+			sendNoteUpdateSkill(ctx, learned)
+		}
 	}
 }
 
-func handlePetHatch() gateway.Handler {
+func handlePetHatch(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		itemID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		now := time.Now()
+		result := startIncubation(deps, user, itemID, now)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.Incubator.EggItemID)
+		binary.Write(buf, binary.BigEndian, incubationRemaining(user, now))
 		ctx.Server.SendResponse(ctx.Conn, 2315, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetRoweiList() gateway.Handler {
+// handlePetRoweiList returns the whole warehouse when the client sends no
+// paging arguments; otherwise page/size select a slice and the total count
+// follows the entries.
+func handlePetRoweiList(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		paged := reader.Remaining() >= 8
+		page := int(reader.ReadUint32BE())
+		size := int(reader.ReadUint32BE())
+		user := state.GetOrCreateUser(ctx.UserID)
+		pets := user.Warehouse
+		if paged {
+			pets = warehousePage(user, page, size)
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, uint32(len(pets)))
+		for _, p := range pets {
+			binary.Write(buf, binary.BigEndian, p.ID)
+			binary.Write(buf, binary.BigEndian, p.CatchTime)
+			binary.Write(buf, binary.BigEndian, boolToUint32(p.Collected))
+		}
+		if paged {
+			binary.Write(buf, binary.BigEndian, uint32(len(user.Warehouse)))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2320, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetRowei() gateway.Handler {
+func handlePetRowei(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := storePet(deps, user, catchTime)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, catchTime)
 		ctx.Server.SendResponse(ctx.Conn, 2321, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetRetrieve() gateway.Handler {
+func handlePetRetrieve(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, pet := retrievePet(deps, user, catchTime)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, catchTime)
+		if pet != nil {
+			buf.Write(buildFullPetInfo(int(pet.ID), int(pet.CatchTime), int(pet.Level), int(pet.DV), pet.Exp, pet.EV, pet.Skills))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2322, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetRoomShow() gateway.Handler {
+func handlePetRoomShow(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		flag := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := setPetRoomShow(deps, user, catchTime, flag != 0)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, catchTime)
+		binary.Write(buf, binary.BigEndian, uint32(len(roomShowPets(user))))
 		ctx.Server.SendResponse(ctx.Conn, 2323, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetRoomList() gateway.Handler {
+// handlePetRoomList lists the pets displayed in a room; visitors pass the
+// owner's user ID.
+func handlePetRoomList(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		targetID := ctx.UserID
+		if reader.Remaining() >= 4 {
+			if id := reader.ReadUint32BE(); id != 0 {
+				targetID = id
+			}
+		}
+		var pets []Pet
+		if owner, ok := findRoomPetOwner(deps, state, state.GetOrCreateUser(ctx.UserID), targetID); ok {
+			pets = roomShowPets(owner)
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, targetID)
+		binary.Write(buf, binary.BigEndian, uint32(len(pets)))
+		for _, p := range pets {
+			binary.Write(buf, binary.BigEndian, p.ID)
+			binary.Write(buf, binary.BigEndian, p.CatchTime)
+			binary.Write(buf, binary.BigEndian, p.Level)
+			binary.Write(buf, binary.BigEndian, boolToUint32(p.Collected))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2324, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handlePetRoomInfo() gateway.Handler {
+// handlePetRoomInfo returns the full info of a displayed pet; pets that are
+// not on display are not visible to anyone.
+func handlePetRoomInfo(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		targetID := reader.ReadUint32BE()
+		catchTime := reader.ReadUint32BE()
+		if targetID == 0 {
+			targetID = ctx.UserID
+		}
+		var p *Pet
+		if owner, ok := findRoomPetOwner(deps, state, state.GetOrCreateUser(ctx.UserID), targetID); ok {
+			p = findOwnedPet(owner, catchTime)
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		if p == nil || !p.RoomShow {
+			binary.Write(buf, binary.BigEndian, petShowNotFound)
+		} else {
+			binary.Write(buf, binary.BigEndian, petShowOK)
+			buf.Write(buildFullPetInfo(int(p.ID), int(p.CatchTime), int(p.Level), int(p.DV), p.Exp, p.EV, p.Skills))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2325, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleUsePetItemOutOfFight() gateway.Handler {
+func handleUsePetItemOutOfFight(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		itemID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		pet := findPetByCatchTime(user, catchTime)
+		result := usePetItem(ctx, deps, user, pet, itemID)
+		hp := 0
+		if pet != nil {
+			hp = pet.HP
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, catchTime)
+		binary.Write(buf, binary.BigEndian, itemID)
+		binary.Write(buf, binary.BigEndian, uint32(hp))
 		ctx.Server.SendResponse(ctx.Conn, 2326, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleUseSpeedupItem() gateway.Handler {
+func handleUseSpeedupItem(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		itemID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := usePetItem(ctx, deps, user, nil, itemID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.TwoTimes)
+		binary.Write(buf, binary.BigEndian, user.ThreeTimes)
 		ctx.Server.SendResponse(ctx.Conn, 2327, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleSkillSort() gateway.Handler {
+func handleSkillSort(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		order := make([]int, petSkillSlots)
+		for i := range order {
+			order[i] = int(reader.ReadUint32BE())
+		}
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := sortPetSkills(deps, user, findPetByCatchTime(user, catchTime), order)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2328, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleUseAutoFightItem() gateway.Handler {
+func handleUseAutoFightItem(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		itemID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := usePetItem(ctx, deps, user, nil, itemID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.AutoFightTimes)
 		ctx.Server.SendResponse(ctx.Conn, 2329, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleOnOffAutoFight() gateway.Handler {
+func handleOnOffAutoFight(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		reader := NewReader(ctx.Body)
+		flag := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := autoFightOK
+		sum := autoFightSummary{StopReason: autoFightStopOff}
+		if flag == 0 {
+			if user.AutoFight != 0 {
+				user.AutoFight = 0
+				savePlayer(deps, user.ID, user)
+			}
+		} else {
+			result, sum = runAutoFight(deps, user)
+		}
+		level := uint32(0)
+		if p := findPetByCatchTime(user, user.CatchID); p != nil {
+			level = p.Level
+		}
+		drops := sum.sortedDrops()
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.AutoFight)
+		binary.Write(buf, binary.BigEndian, uint32(sum.Fights))
+		binary.Write(buf, binary.BigEndian, uint32(sum.Wins))
+		binary.Write(buf, binary.BigEndian, sum.StopReason)
+		binary.Write(buf, binary.BigEndian, user.AutoFightTimes)
+		binary.Write(buf, binary.BigEndian, level)
+		binary.Write(buf, binary.BigEndian, uint32(len(drops)))
+		for _, d := range drops {
+			binary.Write(buf, binary.BigEndian, uint32(d[0]))
+			binary.Write(buf, binary.BigEndian, uint32(d[1]))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2330, ctx.UserID, buf.Bytes())
+		if sum.Fights > 0 {
+			sendNoteUpdateProp(ctx, user, user.CatchID)
+			sendNoteUpdateSkill(ctx, sum.Learned)
+		}
 	}
 }
 
-func handleUseEnergyXishou() gateway.Handler {
+func handleUseEnergyXishou(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		itemID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := usePetItem(ctx, deps, user, nil, itemID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.EnergyTimes)
 		ctx.Server.SendResponse(ctx.Conn, 2331, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleUseStudyItem() gateway.Handler {
+func handleUseStudyItem(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		catchTime := reader.ReadUint32BE()
+		itemID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, skillID := useStudyItem(deps, user, findPetByCatchTime(user, catchTime), itemID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, uint32(skillID))
 		ctx.Server.SendResponse(ctx.Conn, 2332, ctx.UserID, buf.Bytes())
 	}
 }
@@ -326,50 +501,100 @@ func handlePetResetNature() gateway.Handler {
 	}
 }
 
-func handlePetFusion() gateway.Handler {
+func handlePetFusion(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		mainCatch := reader.ReadUint32BE()
+		subCatch := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, pet := fusePets(deps, user, mainCatch, subCatch, time.Now())
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		if pet != nil {
+			binary.Write(buf, binary.BigEndian, pet.ID)
+			binary.Write(buf, binary.BigEndian, pet.CatchTime)
+		} else {
+			binary.Write(buf, binary.BigEndian, uint32(0))
+			binary.Write(buf, binary.BigEndian, uint32(0))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2351, ctx.UserID, buf.Bytes())
+		if pet != nil {
+			sendNoteUpdateProp(ctx, user, pet.CatchTime)
+		}
 	}
 }
 
-func handleGetSoulBeadBuf() gateway.Handler {
+func handleGetSoulBeadBuf(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, user.ObtainTm)
+		binary.Write(buf, binary.BigEndian, user.SoulBeadItemID)
+		binary.Write(buf, binary.BigEndian, user.ExpireTm)
 		ctx.Server.SendResponse(ctx.Conn, 2352, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleSetSoulBeadBuf() gateway.Handler {
+func handleSetSoulBeadBuf(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		obtainTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := setSoulBeadBuf(deps, user, obtainTime, time.Now())
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.ExpireTm)
 		ctx.Server.SendResponse(ctx.Conn, 2353, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleGetSoulBeadStatus() gateway.Handler {
+func handleGetSoulBeadStatus(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		obtainTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		status, remaining := soulBeadStatus(user, obtainTime, time.Now())
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, obtainTime)
+		binary.Write(buf, binary.BigEndian, status)
+		binary.Write(buf, binary.BigEndian, remaining)
 		ctx.Server.SendResponse(ctx.Conn, 2356, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTransformSoulBead() gateway.Handler {
+func handleTransformSoulBead(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		obtainTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := transformSoulBead(deps, user, obtainTime, time.Now())
+		itemID := uint32(0)
+		if idx := findSoulBead(user, obtainTime); idx >= 0 {
+			itemID = user.SoulBeads[idx].ItemID
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, obtainTime)
+		binary.Write(buf, binary.BigEndian, itemID)
 		ctx.Server.SendResponse(ctx.Conn, 2357, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleSoulBeadToPet() gateway.Handler {
+func handleSoulBeadToPet(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		obtainTime := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, pet := soulBeadToPet(deps, user, obtainTime, time.Now())
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		if pet != nil {
+			binary.Write(buf, binary.BigEndian, pet.ID)
+			binary.Write(buf, binary.BigEndian, pet.CatchTime)
+		} else {
+			binary.Write(buf, binary.BigEndian, uint32(0))
+			binary.Write(buf, binary.BigEndian, uint32(0))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2358, ctx.UserID, buf.Bytes())
 	}
 }
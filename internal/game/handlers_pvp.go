@@ -0,0 +1,125 @@
+package game
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"time"
+
+	"jseer/internal/gateway"
+	"jseer/internal/protocol"
+)
+
+const (
+	pvpResultOK uint32 = iota
+	pvpResultQueued
+	pvpResultBusy
+	pvpResultBadQueue
+	pvpResultNotQueued
+	pvpResultFainted
+)
+
+func registerPvPMatchHandlers(s *gateway.Server, deps *Deps, state *State) {
+	s.Register(2460, handlePvPJoinQueue(deps, state))
+	s.Register(2461, handlePvPLeaveQueue(state))
+	s.Register(2462, handlePvPLeaderboard(deps, state))
+	s.Register(2463, handlePvPGetRating(deps, state))
+	go runPvPMatchmaker(s, deps, state)
+}
+
+func handlePvPJoinQueue(deps *Deps, state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		queue := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		cfg := state.pvpSeasonConfig(deps)
+		rating := loadPvPRating(deps, user, cfg)
+
+		result := pvpResultOK
+		switch {
+		case queue != pvpQueueCasual && queue != pvpQueueRanked:
+			result = pvpResultBadQueue
+		case user.Fight != nil || user.InFight:
+			result = pvpResultBusy
+		case allPetsFainted(user):
+			result = pvpResultFainted
+		case !state.matchmaker.Join(&pvpQueueEntry{
+			UserID:   ctx.UserID,
+			Queue:    queue,
+			Rating:   rating.Rating,
+			JoinedAt: time.Now(),
+		}):
+			result = pvpResultQueued
+		}
+
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, uint32(rating.Rating))
+		binary.Write(buf, binary.BigEndian, uint32(cfg.Season))
+		ctx.Server.SendResponse(ctx.Conn, 2460, ctx.UserID, buf.Bytes())
+	}
+}
+
+func handlePvPLeaveQueue(state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		result := pvpResultOK
+		if !state.matchmaker.Leave(ctx.UserID) {
+			result = pvpResultNotQueued
+		}
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		ctx.Server.SendResponse(ctx.Conn, 2461, ctx.UserID, buf.Bytes())
+	}
+}
+
+func handlePvPLeaderboard(deps *Deps, state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		season := int(reader.ReadUint32BE())
+		limit := int(reader.ReadUint32BE())
+		cfg := state.pvpSeasonConfig(deps)
+		if season <= 0 {
+			season = cfg.Season
+		}
+		if limit <= 0 || limit > cfg.LeaderboardSize {
+			limit = cfg.LeaderboardSize
+		}
+
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, uint32(season))
+		if deps == nil || deps.Store == nil {
+			binary.Write(buf, binary.BigEndian, uint32(0))
+			ctx.Server.SendResponse(ctx.Conn, 2462, ctx.UserID, buf.Bytes())
+			return
+		}
+		rows, err := deps.Store.ListPvPRatings(context.Background(), season, limit)
+		if err != nil {
+			rows = nil
+		}
+		binary.Write(buf, binary.BigEndian, uint32(len(rows)))
+		for i, r := range rows {
+			binary.Write(buf, binary.BigEndian, uint32(i+1))
+			binary.Write(buf, binary.BigEndian, uint32(r.Account))
+			protocol.WriteFixedString(buf, r.Nick, 16)
+			binary.Write(buf, binary.BigEndian, uint32(r.Rating))
+			binary.Write(buf, binary.BigEndian, uint32(r.Wins))
+			binary.Write(buf, binary.BigEndian, uint32(r.Losses))
+		}
+		ctx.Server.SendResponse(ctx.Conn, 2462, ctx.UserID, buf.Bytes())
+	}
+}
+
+func handlePvPGetRating(deps *Deps, state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		cfg := state.pvpSeasonConfig(deps)
+		r := loadPvPRating(deps, user, cfg)
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, uint32(r.Season))
+		binary.Write(buf, binary.BigEndian, uint32(r.Rating))
+		binary.Write(buf, binary.BigEndian, uint32(r.Wins))
+		binary.Write(buf, binary.BigEndian, uint32(r.Losses))
+		binary.Write(buf, binary.BigEndian, uint32(r.Draws))
+		ctx.Server.SendResponse(ctx.Conn, 2463, ctx.UserID, buf.Bytes())
+	}
+}
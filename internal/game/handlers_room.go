@@ -3,6 +3,7 @@ package game
 import (
 	"bytes"
 	"encoding/binary"
+	"net"
 	"time"
 
 	"jseer/internal/gateway"
@@ -15,10 +16,19 @@ func registerRoomHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(10003, handleLeaveRoom(deps, state))
 	s.Register(10004, handleBuyFitment(deps, state))
 	s.Register(10005, handleBetrayFitment(deps, state))
-	s.Register(10006, handleFitmentUsing(state))
+	s.Register(10006, handleFitmentUsing(deps, state))
 	s.Register(10007, handleFitmentAll(state))
 	s.Register(10008, handleSetFitment(deps, state))
 	s.Register(10009, handleAddEnergy(state))
+	s.Register(10010, handleSetRoomPrivacy(deps, state))
+	s.Register(10011, handleKickRoomVisitor(state))
+	s.OnDisconnect(func(conn net.Conn) {
+		if id, ok := state.ConnUser(conn); ok {
+			if u, ok := state.GetUser(id); ok && u.RoomOwner != 0 {
+				leaveRoom(state, u, 0)
+			}
+		}
+	})
 }
 
 func handleRoomLogin(deps *Deps, state *State) gateway.Handler {
@@ -40,21 +50,34 @@ func handleRoomLogin(deps *Deps, state *State) gateway.Handler {
 		if x == 0 && y == 0 {
 			x, y = 300, 300
 		}
+		if targetID == 0 {
+			targetID = ctx.UserID
+		}
 
-		_ = targetID
 		user := state.GetOrCreateUser(ctx.UserID)
+		lastMapID := user.LastMapID
+		if user.RoomOwner == 0 {
+			lastMapID = user.MapID
+		}
+		result, _ := enterRoom(deps, state, user, targetID)
+		if result != roomOK {
+			resp := protocol.BuildResponse(10001, ctx.UserID, int32(result), []byte{})
+			_, _ = ctx.Conn.Write(resp)
+			return
+		}
 		user.MapType = 1
 		user.PosX = x
 		user.PosY = y
-		user.LastMapID = user.MapID
+		user.LastMapID = lastMapID
 		if user.RoomID == 0 {
 			user.RoomID = ctx.UserID
 		}
-		state.UpdatePlayerMap(ctx.UserID, 500001)
 		savePlayer(deps, ctx.UserID, user)
 
+		// Everyone in the room, the entrant included, gets the 2001.
 		body := buildPeopleInfo(ctx.UserID, user, uint32(time.Now().Unix()))
-		ctx.Server.SendResponse(ctx.Conn, 2001, ctx.UserID, body)
+		state.BroadcastToChannel(ChannelRoom, targetID, protocol.BuildResponse(2001, ctx.UserID, 0, body))
+		ctx.Server.SendResponse(ctx.Conn, 2003, ctx.UserID, buildScenePlayerList(state, user))
 	}
 }
 
@@ -83,7 +106,7 @@ func handleLeaveRoom(deps *Deps, state *State) gateway.Handler {
 		if mapID == 0 {
 			mapID = 1
 		}
-		state.UpdatePlayerMap(ctx.UserID, mapID)
+		leaveRoom(state, user, mapID)
 		savePlayer(deps, ctx.UserID, user)
 		ctx.Server.SendResponse(ctx.Conn, 10003, ctx.UserID, []byte{})
 	}
@@ -154,14 +177,22 @@ func handleBetrayFitment(deps *Deps, state *State) gateway.Handler {
 	}
 }
 
-func handleFitmentUsing(state *State) gateway.Handler {
+// handleFitmentUsing lists the fitments placed in targetID's room. Owners
+// who are offline are read from storage.
+func handleFitmentUsing(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		targetID := ctx.UserID
 		if reader.Remaining() >= 4 {
 			targetID = reader.ReadUint32BE()
 		}
-		user := state.GetOrCreateUser(targetID)
+		if targetID == 0 {
+			targetID = ctx.UserID
+		}
+		user, ok := findRoomOwner(deps, state, state.GetOrCreateUser(ctx.UserID), targetID)
+		if !ok {
+			user = newDefaultUser(targetID)
+		}
 		roomID := user.RoomID
 		if roomID == 0 {
 			roomID = targetID
@@ -283,3 +314,31 @@ func handleAddEnergy(state *State) gateway.Handler {
 		ctx.Server.SendResponse(ctx.Conn, 10009, ctx.UserID, buf.Bytes())
 	}
 }
+
+// handleSetRoomPrivacy sets who may visit the caller's room: 0 anyone,
+// 1 friends only, 2 nobody.
+func handleSetRoomPrivacy(deps *Deps, state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		privacy := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := setRoomPrivacy(deps, state, user, privacy)
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, user.RoomPrivacy)
+		ctx.Server.SendResponse(ctx.Conn, 10010, ctx.UserID, buf.Bytes())
+	}
+}
+
+func handleKickRoomVisitor(state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		visitorID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := kickRoomVisitor(state, user, visitorID)
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, visitorID)
+		ctx.Server.SendResponse(ctx.Conn, 10011, ctx.UserID, buf.Bytes())
+	}
+}
@@ -59,20 +59,7 @@ func handleLoginIn(deps *Deps, state *State) gateway.Handler {
 			}
 
 			if user.PlayerID > 0 {
-				if pets, err := deps.Store.ListPetsByPlayer(context.Background(), user.PlayerID); err == nil {
-					user.Pets = user.Pets[:0]
-					for _, p := range pets {
-						user.Pets = append(user.Pets, Pet{
-							ID:        uint32(p.SpeciesID),
-							CatchTime: uint32(p.CatchTime),
-							Level:     uint32(p.Level),
-							DV:        uint32(p.DV),
-							Exp:       p.Exp,
-							HP:        p.HP,
-							Skills:    decodePetSkills(p.Skills),
-						})
-					}
-				}
+				loadStoredPets(deps, user)
 				if items, err := deps.Store.ListItemsByPlayer(context.Background(), user.PlayerID); err == nil {
 					if user.Items == nil {
 						user.Items = make(map[int]*ItemInfo)
@@ -90,6 +77,11 @@ func handleLoginIn(deps *Deps, state *State) gateway.Handler {
 				}
 			}
 		}
+		user.LastLoginAt = uint32(time.Now().Unix())
+		refreshUserTeam(deps, state, user)
+		refreshUserMentors(deps, state, user)
+		savePlayer(deps, ctx.UserID, user)
+		joinUserChannels(state, user)
 		ensureStarterPet(deps, user)
 		applySpawnOverride(deps, user, user.LoginCnt == 0)
 		if user.LoginCnt == 0 {
@@ -103,6 +95,9 @@ func handleLoginIn(deps *Deps, state *State) gateway.Handler {
 		body := buildLoginResponse(user)
 		ctx.Server.SendResponse(ctx.Conn, 1001, ctx.UserID, body)
 		pushInitialMapEnter(deps, state, ctx)
+		pushTeamChatHistory(ctx, state, user)
+		pushFriendRequests(ctx, deps, state)
+		pushFriendPresence(state, user, true)
 		if user.Nono.SuperNono > 0 {
 			vipBuf := new(bytes.Buffer)
 			binary.Write(vipBuf, binary.BigEndian, ctx.UserID)
@@ -115,6 +110,7 @@ func handleLoginIn(deps *Deps, state *State) gateway.Handler {
 			binary.Write(vipBuf, binary.BigEndian, endTime)
 			ctx.Server.SendResponse(ctx.Conn, 8006, ctx.UserID, vipBuf.Bytes())
 		}
+		resumePvPFight(ctx, state, user)
 		if deps != nil && deps.Logger != nil {
 			deps.Logger.Info("LOGIN_IN response", zap.Uint32("uid", ctx.UserID))
 		}
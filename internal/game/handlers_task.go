@@ -218,8 +218,6 @@ func buildTaskCompleteResponse(taskID int, param int, user *User, deps *Deps) ([
 			}
 		}
 		captureTm = 0x69686700 + petID
-		user.CurrentPetID = uint32(petID)
-		user.CatchID = uint32(captureTm)
 		pet := createStarterPet(petID, 5)
 		if pet != nil {
 			newPet := Pet{
@@ -231,9 +229,12 @@ func buildTaskCompleteResponse(taskID int, param int, user *User, deps *Deps) ([
 				HP:        pet.HP,
 				Skills:    pet.Skills,
 			}
-			user.Pets = append(user.Pets, newPet)
-			upsertPet(deps, user, newPet)
-			user.PetDV = uint32(pet.DV)
+			addCapturedPet(deps, user, newPet)
+			if findPetByCatchTime(user, newPet.CatchTime) != nil {
+				user.CurrentPetID = newPet.ID
+				user.CatchID = newPet.CatchTime
+				user.PetDV = newPet.DV
+			}
 		}
 	} else if cfg.Rewards.PetID > 0 {
 		petID = cfg.Rewards.PetID
@@ -3,32 +3,56 @@ package game
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	"jseer/internal/gateway"
+	"jseer/internal/protocol"
 )
 
 func registerTeacherHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(3001, handleRequestAddTeacher(deps, state))
 	s.Register(3002, handleAnswerAddTeacher(deps, state))
-	s.Register(3003, handleRequestAddStudent())
+	s.Register(3003, handleRequestAddStudent(deps, state))
 	s.Register(3004, handleAnswerAddStudent(deps, state))
 	s.Register(3005, handleDeleteTeacher(deps, state))
 	s.Register(3006, handleDeleteStudent(deps, state))
-	s.Register(3007, handleExperienceSharedComplete(state))
-	s.Register(3008, handleTeacherRewardComplete())
-	s.Register(3009, handleMyExperiencePondComplete(state))
-	s.Register(3010, handleSevenNoLoginComplete())
-	s.Register(3011, handleGetMyExperienceComplete(state))
+	s.Register(3007, handleExperienceSharedComplete(deps, state))
+	s.Register(3008, handleTeacherRewardComplete(deps, state))
+	s.Register(3009, handleMyExperiencePondComplete(deps, state))
+	s.Register(3010, handleSevenNoLoginComplete(deps, state))
+	s.Register(3011, handleGetMyExperienceComplete(deps, state))
 }
 
+// buildMentorNoticeBody is pushed to the other side of a mentorship
+// request, answer or removal.
+func buildMentorNoticeBody(from *User, value uint32) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, from.ID)
+	protocol.WriteFixedString(buf, pickNick(from, from.ID), 16)
+	binary.Write(buf, binary.BigEndian, value)
+	return buf.Bytes()
+}
+
+func sendMentorResult(ctx *gateway.Context, cmd int32, result uint32, extra ...uint32) {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, result)
+	for _, v := range extra {
+		binary.Write(buf, binary.BigEndian, v)
+	}
+	ctx.Server.SendResponse(ctx.Conn, cmd, ctx.UserID, buf.Bytes())
+}
+
+// handleRequestAddTeacher asks an online player to become the caller's
+// teacher.
 func handleRequestAddTeacher(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		reader := NewReader(ctx.Body)
-		_ = reader.ReadUint32BE()
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 3001, ctx.UserID, buf.Bytes())
-		savePlayer(deps, ctx.UserID, state.GetOrCreateUser(ctx.UserID))
+		teacherID := NewReader(ctx.Body).ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := requestMentor(deps, state, user, teacherID, mentorAskTeacher)
+		sendMentorResult(ctx, 3001, result)
+		if result == mentorOK {
+			sendMentorNotice(ctx.Server, state, teacherID, 3001, user, 0)
+		}
 	}
 }
 
@@ -37,37 +61,26 @@ func handleAnswerAddTeacher(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		studentID := reader.ReadUint32BE()
 		accept := reader.ReadUint32BE()
-		if accept == 1 && studentID > 0 {
-			user := state.GetOrCreateUser(ctx.UserID)
-			found := false
-			for _, id := range user.StudentIDs {
-				if id == studentID {
-					found = true
-					break
-				}
-			}
-			if !found {
-				user.StudentIDs = append(user.StudentIDs, studentID)
-				if user.StudentID == 0 {
-					user.StudentID = studentID
-				}
-			}
-			student := state.GetOrCreateUser(studentID)
-			student.TeacherID = ctx.UserID
-			savePlayer(deps, ctx.UserID, user)
-			savePlayer(deps, studentID, student)
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := answerMentor(deps, state, user, studentID, mentorAskTeacher, accept == 1)
+		sendMentorResult(ctx, 3002, result, accept)
+		if result == mentorOK {
+			sendMentorNotice(ctx.Server, state, studentID, 3002, user, accept)
 		}
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, accept)
-		ctx.Server.SendResponse(ctx.Conn, 3002, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleRequestAddStudent() gateway.Handler {
+// handleRequestAddStudent invites an online player to become the caller's
+// student.
+func handleRequestAddStudent(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 3003, ctx.UserID, buf.Bytes())
+		studentID := NewReader(ctx.Body).ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := requestMentor(deps, state, user, studentID, mentorInviteStudent)
+		sendMentorResult(ctx, 3003, result)
+		if result == mentorOK {
+			sendMentorNotice(ctx.Server, state, studentID, 3003, user, 0)
+		}
 	}
 }
 
@@ -76,29 +89,12 @@ func handleAnswerAddStudent(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		teacherID := reader.ReadUint32BE()
 		accept := reader.ReadUint32BE()
-		if accept == 1 && teacherID > 0 {
-			user := state.GetOrCreateUser(ctx.UserID)
-			user.TeacherID = teacherID
-			teacher := state.GetOrCreateUser(teacherID)
-			found := false
-			for _, id := range teacher.StudentIDs {
-				if id == ctx.UserID {
-					found = true
-					break
-				}
-			}
-			if !found {
-				teacher.StudentIDs = append(teacher.StudentIDs, ctx.UserID)
-				if teacher.StudentID == 0 {
-					teacher.StudentID = ctx.UserID
-				}
-			}
-			savePlayer(deps, ctx.UserID, user)
-			savePlayer(deps, teacherID, teacher)
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := answerMentor(deps, state, user, teacherID, mentorInviteStudent, accept == 1)
+		sendMentorResult(ctx, 3004, result, accept)
+		if result == mentorOK {
+			sendMentorNotice(ctx.Server, state, teacherID, 3004, user, accept)
 		}
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, accept)
-		ctx.Server.SendResponse(ctx.Conn, 3004, ctx.UserID, buf.Bytes())
 	}
 }
 
@@ -106,99 +102,84 @@ func handleDeleteTeacher(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
 		teacherID := user.TeacherID
-		if teacherID > 0 {
-			teacher := state.GetOrCreateUser(teacherID)
-			next := teacher.StudentIDs[:0]
-			for _, id := range teacher.StudentIDs {
-				if id != ctx.UserID {
-					next = append(next, id)
-				}
-			}
-			teacher.StudentIDs = next
-			if teacher.StudentID == ctx.UserID {
-				teacher.StudentID = 0
-			}
-			savePlayer(deps, teacherID, teacher)
+		result := unlinkMentor(deps, state, teacherID, user.ID)
+		sendMentorResult(ctx, 3005, result)
+		if result == mentorOK {
+			sendMentorNotice(ctx.Server, state, teacherID, 3005, user, 0)
 		}
-		user.TeacherID = 0
-		savePlayer(deps, ctx.UserID, user)
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 3005, ctx.UserID, buf.Bytes())
 	}
 }
 
 func handleDeleteStudent(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		reader := NewReader(ctx.Body)
-		targetID := reader.ReadUint32BE()
+		studentID := NewReader(ctx.Body).ReadUint32BE()
 		user := state.GetOrCreateUser(ctx.UserID)
-		next := user.StudentIDs[:0]
-		for _, id := range user.StudentIDs {
-			if id != targetID {
-				next = append(next, id)
-			}
-		}
-		user.StudentIDs = next
-		if user.StudentID == targetID {
-			user.StudentID = 0
-		}
-		if targetID > 0 {
-			student := state.GetOrCreateUser(targetID)
-			if student.TeacherID == ctx.UserID {
-				student.TeacherID = 0
-			}
-			savePlayer(deps, targetID, student)
+		result := unlinkMentor(deps, state, user.ID, studentID)
+		sendMentorResult(ctx, 3006, result)
+		if result == mentorOK {
+			sendMentorNotice(ctx.Server, state, studentID, 3006, user, 0)
 		}
-		savePlayer(deps, ctx.UserID, user)
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 3006, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleExperienceSharedComplete(state *State) gateway.Handler {
+// handleExperienceSharedComplete claims the exp shared by the caller's
+// students into the exp pool.
+func handleExperienceSharedComplete(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, user.ExpPool)
-		ctx.Server.SendResponse(ctx.Conn, 3007, ctx.UserID, buf.Bytes())
+		claimed := claimMentorExp(deps, state, user)
+		sendMentorResult(ctx, 3007, mentorOK, user.ExpPool, claimed)
 	}
 }
 
-func handleTeacherRewardComplete() gateway.Handler {
+// handleTeacherRewardComplete graduates one of the caller's students.
+func handleTeacherRewardComplete(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 3008, ctx.UserID, buf.Bytes())
+		studentID := NewReader(ctx.Body).ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := graduateStudent(deps, state, user, studentID)
+		sendMentorResult(ctx, 3008, result, user.GraduationCount)
+		if result == mentorOK {
+			sendMentorNotice(ctx.Server, state, studentID, 3008, user, 0)
+		}
 	}
 }
 
-func handleMyExperiencePondComplete(state *State) gateway.Handler {
+func handleMyExperiencePondComplete(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, user.ExpPool)
-		ctx.Server.SendResponse(ctx.Conn, 3009, ctx.UserID, buf.Bytes())
+		var pending uint32
+		for _, m := range mentorStudents(deps, state, user) {
+			pending += uint32(m.ExpShared)
+		}
+		sendMentorResult(ctx, 3009, mentorOK, user.ExpPool, pending)
 	}
 }
 
-func handleSevenNoLoginComplete() gateway.Handler {
+// handleSevenNoLoginComplete drops the link with a teacher or student who
+// has not logged in for a week.
+func handleSevenNoLoginComplete(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 3010, ctx.UserID, buf.Bytes())
+		partnerID := NewReader(ctx.Body).ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		sendMentorResult(ctx, 3010, dissolveInactiveMentor(deps, state, user, partnerID, time.Now()))
 	}
 }
 
-func handleGetMyExperienceComplete(state *State) gateway.Handler {
+// handleGetMyExperienceComplete lists the exp each student has shared.
+func handleGetMyExperienceComplete(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
+		students := mentorStudents(deps, state, user)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, mentorOK)
 		binary.Write(buf, binary.BigEndian, user.ExpPool)
+		binary.Write(buf, binary.BigEndian, uint32(len(students)))
+		for _, m := range students {
+			binary.Write(buf, binary.BigEndian, uint32(m.StudentID))
+			binary.Write(buf, binary.BigEndian, uint32(m.ExpShared))
+			binary.Write(buf, binary.BigEndian, uint32(m.ExpTotal))
+		}
 		ctx.Server.SendResponse(ctx.Conn, 3011, ctx.UserID, buf.Bytes())
 	}
 }
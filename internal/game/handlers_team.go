@@ -3,6 +3,7 @@ package game
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	"jseer/internal/gateway"
 	"jseer/internal/protocol"
@@ -10,14 +11,15 @@ import (
 
 func registerTeamHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2910, handleTeamCreate(deps, state))
-	s.Register(2911, handleTeamAdd())
-	s.Register(2912, handleTeamAnswer())
+	s.Register(2911, handleTeamAdd(deps, state))
+	s.Register(2912, handleTeamAnswer(deps, state))
 	s.Register(2913, handleTeamInform())
 	s.Register(2914, handleTeamQuit(deps, state))
-	s.Register(2915, handleTeamChangeAdmin())
+	s.Register(2915, handleTeamChangeAdmin(deps, state))
 	s.Register(2916, handleTeamDeleteMember(deps, state))
-	s.Register(2917, handleTeamGetInfo(state))
-	s.Register(2918, handleTeamGetMemberList())
+	s.Register(2917, handleTeamGetInfo(deps, state))
+	s.Register(2918, handleTeamGetMemberList(deps, state))
+	s.Register(2919, handleTeamInvite(deps, state))
 	s.Register(2920, handleTeamSetJoinFlag(deps, state))
 	s.Register(2921, handleTeamSetSlogan(deps, state))
 	s.Register(2922, handleTeamModifyLogo(deps, state))
@@ -27,7 +29,7 @@ func registerTeamHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2926, handleTeamStub4Zero())
 	s.Register(2927, handleTeamShowLogo(deps, state))
 	s.Register(2928, handleTeamGetLogoInfo())
-	s.Register(2929, handleTeamChat())
+	s.Register(2929, handleTeamChat(deps, state))
 	s.Register(2930, handleTeamStub4Zero())
 	s.Register(2931, handleTeamSetNotice(deps, state))
 	s.Register(2932, handleTeamStub4Zero())
@@ -44,10 +46,10 @@ func registerTeamHandlers(s *gateway.Server, deps *Deps, state *State) {
 	s.Register(2953, handleTeamStub4Zero())
 	s.Register(2954, handleTeamStub4Zero())
 	s.Register(2961, handleTeamStub4Zero())
-	s.Register(2962, handleArmUpWork())
-	s.Register(2963, handleArmUpDonate())
-	s.Register(2964, handleTeamStub4Zero())
-	s.Register(2965, handleTeamStub4Zero())
+	s.Register(2962, handleArmUpWork(deps, state))
+	s.Register(2963, handleArmUpDonate(deps, state))
+	s.Register(2964, handleTeamShopBuy(deps, state))
+	s.Register(2965, handleTeamFacilityInfo(deps, state))
 	s.Register(2966, handleTeamStub4Zero())
 	s.Register(2967, handleTeamStub4Zero())
 	s.Register(2968, handleTeamStub4Zero())
@@ -57,44 +59,74 @@ func registerTeamHandlers(s *gateway.Server, deps *Deps, state *State) {
 
 func handleTeamCreate(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		name := reader.ReadFixedString(16)
 		user := state.GetOrCreateUser(ctx.UserID)
-		teamID := uint32(10000) + ctx.UserID
-		user.Team = TeamInfo{
-			ID:                teamID,
-			Priv:              4,
-			IsShow:            true,
-			LogoBg:            1,
-			LogoIcon:          1,
-			LogoColor:         0xFFFF,
-			TxtColor:          0xFFFF,
-			LogoWord:          "",
-			AllContribution:   0,
-			CanExContribution: 0,
-			CoreCount:         0,
-			MemberCount:       1,
+		result, team := createTeam(deps, state, user, name)
+		teamID := uint32(0)
+		if team != nil {
+			teamID = team.ID
+			state.JoinChannel(ChannelTeam, teamID, ctx.UserID)
+			savePlayer(deps, ctx.UserID, user)
 		}
-		savePlayer(deps, ctx.UserID, user)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		binary.Write(buf, binary.BigEndian, teamID)
 		ctx.Server.SendResponse(ctx.Conn, 2910, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamAdd() gateway.Handler {
+// handleTeamAdd applies to join a team. Open teams take the player in at
+// once; otherwise the admins are told about the application.
+func handleTeamAdd(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		teamID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, team := applyToTeam(deps, state, user, teamID)
+		switch result {
+		case teamOK:
+			syncTeamMembers(state, team)
+			savePlayer(deps, ctx.UserID, user)
+			sendTeamInform(ctx.Server, state, teamMemberIDs(team), teamInformJoin, team.ID, user)
+		case teamPending:
+			sendTeamInform(ctx.Server, state, teamAdmins(team), teamInformApply, team.ID, user)
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		binary.Write(buf, binary.BigEndian, teamID)
 		ctx.Server.SendResponse(ctx.Conn, 2911, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamAnswer() gateway.Handler {
+// handleTeamAnswer accepts or rejects a pending request: admins pass an
+// applicant's user ID, invitees pass the inviting team's ID.
+func handleTeamAnswer(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		ctx.Server.SendResponse(ctx.Conn, 2912, ctx.UserID, []byte{})
+		reader := NewReader(ctx.Body)
+		id := reader.ReadUint32BE()
+		accept := reader.ReadUint32BE() != 0
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, team, joinedID := answerTeam(deps, state, user, id, accept)
+		if result == teamOK {
+			joined, online := state.GetUser(joinedID)
+			if !online {
+				joined = &User{ID: joinedID}
+			}
+			if accept {
+				syncTeamMembers(state, team)
+				if online {
+					savePlayer(deps, joinedID, joined)
+				}
+				sendTeamInform(ctx.Server, state, teamMemberIDs(team), teamInformJoin, team.ID, joined)
+			} else if joinedID != ctx.UserID {
+				sendTeamInform(ctx.Server, state, []uint32{joinedID}, teamInformReject, team.ID, user)
+			}
+		}
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, id)
+		ctx.Server.SendResponse(ctx.Conn, 2912, ctx.UserID, buf.Bytes())
 	}
 }
 
@@ -104,35 +136,69 @@ func handleTeamInform() gateway.Handler {
 	}
 }
 
+func buildTeamInformBody(kind uint32, teamID uint32, from *User) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, kind)
+	binary.Write(buf, binary.BigEndian, teamID)
+	binary.Write(buf, binary.BigEndian, from.ID)
+	protocol.WriteFixedString(buf, pickNick(from, from.ID), 16)
+	return buf.Bytes()
+}
+
+// handleTeamQuit leaves the team; the leader leaving disbands it.
 func handleTeamQuit(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
-		user.Team = TeamInfo{}
+		result, team, disbanded := leaveTeam(deps, state, user)
+		if result == teamOK {
+			kind := teamInformLeave
+			if disbanded {
+				kind = teamInformDisband
+				state.CloseChannel(ChannelTeam, team.ID)
+				for _, m := range team.Members {
+					clearTeamView(deps, state, m.UserID)
+				}
+			} else {
+				state.LeaveChannel(ChannelTeam, team.ID, ctx.UserID)
+				syncTeamMembers(state, team)
+			}
+			sendTeamInform(ctx.Server, state, teamMemberIDs(team), kind, team.ID, user)
+		}
 		savePlayer(deps, ctx.UserID, user)
-		ctx.Server.SendResponse(ctx.Conn, 2914, ctx.UserID, []byte{})
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		ctx.Server.SendResponse(ctx.Conn, 2914, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamGetInfo(state *State) gateway.Handler {
+// handleTeamGetInfo returns a team's public info; without a team ID it
+// returns the caller's own team.
+func handleTeamGetInfo(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		user := state.GetOrCreateUser(ctx.UserID)
-		team := user.Team
+		reader := NewReader(ctx.Body)
+		teamID := user.Team.ID
+		if reader.Remaining() >= 4 {
+			if id := reader.ReadUint32BE(); id != 0 {
+				teamID = id
+			}
+		}
+		team := getTeam(deps, state, teamID)
+		if team == nil {
+			team = &Team{}
+		}
+		isShow := false
+		if m := team.member(ctx.UserID); m != nil {
+			isShow = m.IsShow
+		}
 		buf := new(bytes.Buffer)
 		binary.Write(buf, binary.BigEndian, team.ID)
-		binary.Write(buf, binary.BigEndian, uint32(0)) // leader
-		binary.Write(buf, binary.BigEndian, uint32(0)) // superCoreNum
-		memberCount := team.MemberCount
-		if memberCount == 0 && team.ID != 0 {
-			memberCount = 1
-		}
-		binary.Write(buf, binary.BigEndian, memberCount)
+		binary.Write(buf, binary.BigEndian, team.LeaderID)
+		binary.Write(buf, binary.BigEndian, team.coreCount())
+		binary.Write(buf, binary.BigEndian, uint32(len(team.Members)))
 		binary.Write(buf, binary.BigEndian, team.Interest)
 		binary.Write(buf, binary.BigEndian, team.JoinFlag)
-		if team.IsShow {
-			binary.Write(buf, binary.BigEndian, uint32(1))
-		} else {
-			binary.Write(buf, binary.BigEndian, uint32(0))
-		}
+		binary.Write(buf, binary.BigEndian, boolToUint32(isShow))
 		binary.Write(buf, binary.BigEndian, team.Exp)
 		binary.Write(buf, binary.BigEndian, team.Score)
 		protocol.WriteFixedString(buf, team.Name, 16)
@@ -147,16 +213,61 @@ func handleTeamGetInfo(state *State) gateway.Handler {
 	}
 }
 
-func handleTeamGetMemberList() gateway.Handler {
+// handleTeamGetMemberList pages through a team's members, highest rank
+// first. Page and page size are optional.
+func handleTeamGetMemberList(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		reader := NewReader(ctx.Body)
+		teamID := user.Team.ID
+		if reader.Remaining() >= 4 {
+			if id := reader.ReadUint32BE(); id != 0 {
+				teamID = id
+			}
+		}
+		page, size := 0, 0
+		if reader.Remaining() >= 8 {
+			page = int(reader.ReadUint32BE())
+			size = int(reader.ReadUint32BE())
+		}
+		team := getTeam(deps, state, teamID)
+		if team == nil {
+			team = &Team{ID: teamID}
+		}
+		members := teamMemberPage(team, page, size)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, team.ID)
+		binary.Write(buf, binary.BigEndian, uint32(len(team.Members)))
+		binary.Write(buf, binary.BigEndian, uint32(len(members)))
+		for _, m := range members {
+			_, online := state.GetConn(m.UserID)
+			binary.Write(buf, binary.BigEndian, m.UserID)
+			binary.Write(buf, binary.BigEndian, m.Priv)
+			binary.Write(buf, binary.BigEndian, m.Contribution)
+			binary.Write(buf, binary.BigEndian, boolToUint32(online))
+			protocol.WriteFixedString(buf, m.Nick, 16)
+		}
 		ctx.Server.SendResponse(ctx.Conn, 2918, ctx.UserID, buf.Bytes())
 	}
 }
 
+// handleTeamInvite invites a player into the caller's team.
+func handleTeamInvite(deps *Deps, state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		targetID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, team := inviteToTeam(deps, state, user, targetID)
+		if result == teamOK {
+			sendTeamInform(ctx.Server, state, []uint32{targetID}, teamInformInvite, team.ID, user)
+		}
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, targetID)
+		ctx.Server.SendResponse(ctx.Conn, 2919, ctx.UserID, buf.Bytes())
+	}
+}
+
 func handleTeamGetLogoInfo() gateway.Handler {
 	return func(ctx *gateway.Context) {
 		buf := new(bytes.Buffer)
@@ -169,30 +280,107 @@ func handleTeamGetLogoInfo() gateway.Handler {
 	}
 }
 
-func handleTeamChat() gateway.Handler {
+// handleTeamChat relays a message to every online member of the caller's
+// team, wherever they are, except those who blacklisted the caller, and
+// keeps it in the team's recent history.
+func handleTeamChat(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		ctx.Server.SendResponse(ctx.Conn, 2929, ctx.UserID, []byte{})
+		reader := NewReader(ctx.Body)
+		msgLen := reader.ReadUint32BE()
+		msg := reader.ReadBytes(int(msgLen))
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, entry := postTeamChat(deps, state, user, msg, time.Now())
+		if result != chatOK {
+			buf := new(bytes.Buffer)
+			binary.Write(buf, binary.BigEndian, teamChatResult(result))
+			ctx.Server.SendResponse(ctx.Conn, 2929, ctx.UserID, buf.Bytes())
+			return
+		}
+		resp := protocol.BuildResponse(2929, ctx.UserID, 0, buildTeamChatBody(entry))
+		broadcastToChannelFrom(state, ChannelTeam, user.Team.ID, ctx.UserID, resp)
+	}
+}
+
+// teamChatResult maps a postTeamChat failure to its 2929 result code.
+func teamChatResult(result uint32) uint32 {
+	switch result {
+	case chatNoTeam:
+		return teamNotIn
+	case chatMuted:
+		return teamMuted
+	case chatEmpty:
+		return teamChatEmpty
+	case chatTooLong:
+		return teamChatTooLong
+	}
+	return teamChatTooFast
+}
+
+func buildTeamChatBody(entry teamChatEntry) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, entry.FromID)
+	protocol.WriteFixedString(buf, entry.Nick, 16)
+	binary.Write(buf, binary.BigEndian, entry.Time)
+	binary.Write(buf, binary.BigEndian, uint32(len(entry.Msg)))
+	buf.Write(entry.Msg)
+	return buf.Bytes()
+}
+
+// pushTeamChatHistory replays the team's recent chat to a member who just
+// logged in.
+func pushTeamChatHistory(ctx *gateway.Context, state *State, user *User) {
+	if user.Team.ID == 0 {
+		return
+	}
+	for _, entry := range teamChatHistory(state, user.Team.ID) {
+		ctx.Server.SendResponse(ctx.Conn, 2929, entry.FromID, buildTeamChatBody(entry))
 	}
 }
 
-func handleTeamChangeAdmin() gateway.Handler {
+// handleTeamChangeAdmin sets a member's rank; passing the leader rank
+// hands the team over.
+func handleTeamChangeAdmin(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		targetID := reader.ReadUint32BE()
+		priv := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, team := setTeamMemberPriv(deps, state, user, targetID, priv)
+		if result == teamOK {
+			syncTeamMembers(state, team)
+			savePlayer(deps, ctx.UserID, user)
+			if target, ok := state.GetUser(targetID); ok {
+				savePlayer(deps, targetID, target)
+			}
+			sendTeamInform(ctx.Server, state, teamMemberIDs(team), teamInformPriv, team.ID, user)
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, targetID)
+		binary.Write(buf, binary.BigEndian, priv)
 		ctx.Server.SendResponse(ctx.Conn, 2915, ctx.UserID, buf.Bytes())
 	}
 }
 
+// handleTeamDeleteMember kicks a member; naming oneself leaves the team.
 func handleTeamDeleteMember(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		targetID := reader.ReadUint32BE()
 		if targetID == ctx.UserID {
-			user := state.GetOrCreateUser(ctx.UserID)
-			user.Team = TeamInfo{}
-			savePlayer(deps, ctx.UserID, user)
+			handleTeamQuit(deps, state)(ctx)
+			return
+		}
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, team := kickTeamMember(deps, state, user, targetID)
+		if result == teamOK {
+			state.LeaveChannel(ChannelTeam, team.ID, targetID)
+			clearTeamView(deps, state, targetID)
+			syncTeamMembers(state, team)
+			sendTeamInform(ctx.Server, state, append(teamMemberIDs(team), targetID), teamInformKick, team.ID, user)
 		}
 		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
 		binary.Write(buf, binary.BigEndian, targetID)
 		ctx.Server.SendResponse(ctx.Conn, 2916, ctx.UserID, buf.Bytes())
 	}
@@ -203,10 +391,9 @@ func handleTeamSetJoinFlag(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		joinFlag := reader.ReadUint32BE()
 		user := state.GetOrCreateUser(ctx.UserID)
-		user.Team.JoinFlag = joinFlag
-		savePlayer(deps, ctx.UserID, user)
+		result := updateTeam(deps, state, user, func(t *Team) { t.JoinFlag = joinFlag })
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2920, ctx.UserID, buf.Bytes())
 	}
 }
@@ -216,10 +403,10 @@ func handleTeamSetSlogan(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		slogan := reader.ReadFixedString(60)
 		user := state.GetOrCreateUser(ctx.UserID)
-		user.Team.Slogan = slogan
-		savePlayer(deps, ctx.UserID, user)
+		slogan = maskSensitive(deps, slogan)
+		result := updateTeam(deps, state, user, func(t *Team) { t.Slogan = slogan })
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2921, ctx.UserID, buf.Bytes())
 	}
 }
@@ -233,27 +420,31 @@ func handleTeamModifyLogo(deps *Deps, state *State) gateway.Handler {
 		txtColor := reader.ReadUint16BE()
 		logoWord := reader.ReadFixedString(4)
 		user := state.GetOrCreateUser(ctx.UserID)
-		user.Team.LogoBg = logoBg
-		user.Team.LogoIcon = logoIcon
-		user.Team.LogoColor = logoColor
-		user.Team.TxtColor = txtColor
-		user.Team.LogoWord = logoWord
-		savePlayer(deps, ctx.UserID, user)
+		result := updateTeam(deps, state, user, func(t *Team) {
+			t.LogoBg = logoBg
+			t.LogoIcon = logoIcon
+			t.LogoColor = logoColor
+			t.TxtColor = txtColor
+			t.LogoWord = logoWord
+		})
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2922, ctx.UserID, buf.Bytes())
 	}
 }
 
+// handleTeamShowLogo toggles whether the caller wears the team logo.
 func handleTeamShowLogo(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
 		reader := NewReader(ctx.Body)
 		flag := reader.ReadUint32BE()
 		user := state.GetOrCreateUser(ctx.UserID)
-		user.Team.IsShow = flag != 0
-		savePlayer(deps, ctx.UserID, user)
+		result := updateTeamMember(deps, state, user, func(m *TeamMember) { m.IsShow = flag != 0 })
+		if result == teamOK {
+			savePlayer(deps, ctx.UserID, user)
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2927, ctx.UserID, buf.Bytes())
 	}
 }
@@ -263,10 +454,10 @@ func handleTeamSetNotice(deps *Deps, state *State) gateway.Handler {
 		reader := NewReader(ctx.Body)
 		notice := reader.ReadFixedString(60)
 		user := state.GetOrCreateUser(ctx.UserID)
-		user.Team.Notice = notice
-		savePlayer(deps, ctx.UserID, user)
+		notice = maskSensitive(deps, notice)
+		result := updateTeam(deps, state, user, func(t *Team) { t.Notice = notice })
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
 		ctx.Server.SendResponse(ctx.Conn, 2931, ctx.UserID, buf.Bytes())
 	}
 }
@@ -279,18 +470,85 @@ func handleTeamStub4Zero() gateway.Handler {
 	}
 }
 
-func handleArmUpWork() gateway.Handler {
+// handleArmUpWork donates coins to the team.
+func handleArmUpWork(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		coins := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, points := donateTeamCoins(deps, state, user, coins)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, uint32(points))
+		binary.Write(buf, binary.BigEndian, user.Coins)
+		writeTeamProgress(buf, deps, user)
 		ctx.Server.SendResponse(ctx.Conn, 2962, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleArmUpDonate() gateway.Handler {
+// handleArmUpDonate donates items to the team.
+func handleArmUpDonate(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		itemID := reader.ReadUint32BE()
+		count := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, points := donateTeamItem(deps, state, user, int(itemID), int(count))
+		left := uint32(0)
+		if info := user.Items[int(itemID)]; info != nil {
+			left = uint32(info.Count)
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, uint32(points))
+		binary.Write(buf, binary.BigEndian, itemID)
+		binary.Write(buf, binary.BigEndian, left)
+		writeTeamProgress(buf, deps, user)
 		ctx.Server.SendResponse(ctx.Conn, 2963, ctx.UserID, buf.Bytes())
 	}
 }
+
+func handleTeamShopBuy(deps *Deps, state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		itemID := reader.ReadUint32BE()
+		count := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := buyTeamShopItem(deps, state, user, int(itemID), int(count))
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, itemID)
+		binary.Write(buf, binary.BigEndian, count)
+		binary.Write(buf, binary.BigEndian, user.Team.CanExContribution)
+		ctx.Server.SendResponse(ctx.Conn, 2964, ctx.UserID, buf.Bytes())
+	}
+}
+
+// handleTeamFacilityInfo reports the team's level progress, the caller's
+// contribution and which facilities are unlocked.
+func handleTeamFacilityInfo(deps *Deps, state *State) gateway.Handler {
+	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		cfg := loadTeamConfig(deps)
+		buf := new(bytes.Buffer)
+		writeTeamProgress(buf, deps, user)
+		binary.Write(buf, binary.BigEndian, cfg.nextLevelExp(user.Team.Exp))
+		binary.Write(buf, binary.BigEndian, user.Team.AllContribution)
+		binary.Write(buf, binary.BigEndian, user.Team.CanExContribution)
+		binary.Write(buf, binary.BigEndian, uint32(len(cfg.Facilities)))
+		for _, f := range cfg.Facilities {
+			binary.Write(buf, binary.BigEndian, uint32(f.ID))
+			binary.Write(buf, binary.BigEndian, boolToUint32(user.Team.ID != 0 && cfg.facilityUnlocked(f.ID, user.Team.Exp)))
+		}
+		ctx.Server.SendResponse(ctx.Conn, 2965, ctx.UserID, buf.Bytes())
+	}
+}
+
+func writeTeamProgress(buf *bytes.Buffer, deps *Deps, user *User) {
+	level := uint32(0)
+	if user.Team.ID != 0 {
+		level = uint32(loadTeamConfig(deps).level(user.Team.Exp))
+	}
+	binary.Write(buf, binary.BigEndian, user.Team.Exp)
+	binary.Write(buf, binary.BigEndian, level)
+}
@@ -2,247 +2,381 @@ package game
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
+	"net"
+	"time"
 
 	"jseer/internal/gateway"
+	"jseer/internal/protocol"
 )
 
-func registerTeamPKHandlers(s *gateway.Server) {
-	s.Register(4001, handleTeamPKSign())
-	s.Register(4002, handleTeamPKRegister())
-	s.Register(4003, handleTeamPKJoin())
-	s.Register(4004, handleTeamPKShot())
-	s.Register(4005, handleTeamPKRefreshDistance())
-	s.Register(4006, handleTeamPKWin())
-	s.Register(4007, handleTeamPKNote())
-	s.Register(4008, handleTeamPKFreeze())
-	s.Register(4009, handleTeamPKUnfreeze())
-	s.Register(4010, handleTeamPKBeShot())
-	s.Register(4011, handleTeamPKGetBuildingInfo())
-	s.Register(4012, handleTeamPKSituation())
-	s.Register(4013, handleTeamPKResult())
-	s.Register(4014, handleTeamPKUseShield())
-	s.Register(4017, handleTeamPKWeekyScore())
-	s.Register(4018, handleTeamPKHistory())
-	s.Register(4019, handleTeamPKSomeoneJoinInfo())
-	s.Register(4020, handleTeamPKNoPet())
-	s.Register(4022, handleTeamPKActive())
-	s.Register(4023, handleTeamPKActiveNoteGetItem())
-	s.Register(4024, handleTeamPKActiveGetAttack())
-	s.Register(4025, handleTeamPKActiveGetStone())
-	s.Register(4101, handleTeamPKTeamCharts())
-	s.Register(4102, handleTeamPKSeerCharts())
-	s.Register(2481, handleTeamPKPetFight())
-}
-
-func handleTeamPKSign() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		buf.Write(make([]byte, 24))
-		binary.Write(buf, binary.BigEndian, uint32(0x7F000001))
-		binary.Write(buf, binary.BigEndian, uint16(5100))
-		ctx.Server.SendResponse(ctx.Conn, 4001, ctx.UserID, buf.Bytes())
-	}
+// Shot distance (4005), win and note pushes (4006, 4007), being shot
+// (4010), join and no-pet notices (4019, 4020), the in-battle event items
+// (4022-4025) and PK pet fights (2481) are not implemented; they fall
+// through to the 4-byte zero stubs in registerStubHandlers.
+func registerTeamPKHandlers(s *gateway.Server, deps *Deps, state *State) {
+	s.Register(4001, handleTeamPKSign(deps, state))
+	s.Register(4002, handleTeamPKRegister(deps, state))
+	s.Register(4003, handleTeamPKJoin(state))
+	s.Register(4004, handleTeamPKShot(deps, state))
+	s.Register(4008, handleTeamPKFreeze(deps, state))
+	s.Register(4009, handleTeamPKUnfreeze(state))
+	s.Register(4011, handleTeamPKGetBuildingInfo(state))
+	s.Register(4012, handleTeamPKSituation(state))
+	s.Register(4013, handleTeamPKResult(state))
+	s.Register(4014, handleTeamPKUseShield(deps, state))
+	s.Register(4017, handleTeamPKWeekyScore(deps, state))
+	s.Register(4018, handleTeamPKHistory(deps, state))
+	s.Register(4101, handleTeamPKTeamCharts(deps, state))
+	s.Register(4102, handleTeamPKSeerCharts(deps, state))
+	go runTeamPK(s, deps, state)
 }
 
-func handleTeamPKRegister() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4002, ctx.UserID, buf.Bytes())
-	}
-}
+// Team PK phases reported by 4001.
+const (
+	teamPKPhaseIdle uint32 = iota
+	teamPKPhaseSignUp
+	teamPKPhaseBattle
+)
 
-func handleTeamPKJoin() gateway.Handler {
+// handleTeamPKSign reports the sign-up window and the caller's team PK
+// state. Battles run on this server, so its own address is advertised.
+func handleTeamPKSign(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		now := time.Now()
+		cfg := state.teamPK.config(deps)
+		start, end, _ := cfg.signUpWindow(now)
+		phase := teamPKPhaseIdle
+		if cfg.signUpOpen(now) {
+			phase = teamPKPhaseSignUp
+		}
+		instanceID := uint32(0)
+		endsAt := uint32(0)
+		state.teamPK.mu.Lock()
+		if inst := state.teamPK.byTeam[user.Team.ID]; user.Team.ID != 0 && inst != nil {
+			phase = teamPKPhaseBattle
+			instanceID = inst.ID
+			endsAt = uint32(inst.EndsAt.Unix())
+		}
+		state.teamPK.mu.Unlock()
+		if endsAt == 0 && !end.IsZero() {
+			endsAt = uint32(end.Unix())
+		}
+		startAt := uint32(0)
+		if !start.IsZero() {
+			startAt = uint32(start.Unix())
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4003, ctx.UserID, buf.Bytes())
+		binary.Write(buf, binary.BigEndian, phase)
+		binary.Write(buf, binary.BigEndian, boolToUint32(state.teamPK.isRegistered(user.Team.ID)))
+		binary.Write(buf, binary.BigEndian, startAt)
+		binary.Write(buf, binary.BigEndian, endsAt)
+		binary.Write(buf, binary.BigEndian, user.Team.ID)
+		binary.Write(buf, binary.BigEndian, instanceID)
+		binary.Write(buf, binary.BigEndian, teamPKServerIP(deps))
+		port := uint16(5100)
+		if deps != nil && deps.GamePort > 0 {
+			port = uint16(deps.GamePort)
+		}
+		binary.Write(buf, binary.BigEndian, port)
+		ctx.Server.SendResponse(ctx.Conn, 4001, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKShot() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4004, ctx.UserID, buf.Bytes())
+func teamPKServerIP(deps *Deps) uint32 {
+	if deps != nil {
+		if ip := net.ParseIP(deps.GameIP).To4(); ip != nil {
+			return binary.BigEndian.Uint32(ip)
+		}
 	}
+	return 0x7F000001
 }
 
-func handleTeamPKRefreshDistance() gateway.Handler {
+func handleTeamPKRegister(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := registerTeamPK(deps, state, user, time.Now())
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4005, ctx.UserID, buf.Bytes())
+		binary.Write(buf, binary.BigEndian, result)
+		ctx.Server.SendResponse(ctx.Conn, 4002, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKWin() gateway.Handler {
+func handleTeamPKJoin(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, inst := joinTeamPK(state, user)
+		var instanceID, side, enemyID uint32
+		if inst != nil {
+			instanceID = inst.ID
+			if inst.Sides[1].TeamID == user.Team.ID {
+				side = 1
+			}
+			enemyID = inst.Sides[1-side].TeamID
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4006, ctx.UserID, buf.Bytes())
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, instanceID)
+		binary.Write(buf, binary.BigEndian, side)
+		binary.Write(buf, binary.BigEndian, enemyID)
+		ctx.Server.SendResponse(ctx.Conn, 4003, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKNote() gateway.Handler {
+// handleTeamPKShot fires at the enemy building and tells the enemy side
+// (4010) what is left of it.
+func handleTeamPKShot(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, inst := shootTeamPK(deps, state, user, time.Now())
+		var hp, shield uint32
+		if result == teamPKOK {
+			state.teamPK.mu.Lock()
+			_, enemy := inst.side(user.Team.ID)
+			hp, shield = uint32(enemy.HP), uint32(enemy.Shield)
+			targets := make([]uint32, 0, len(enemy.Players))
+			for uid := range enemy.Players {
+				targets = append(targets, uid)
+			}
+			state.teamPK.mu.Unlock()
+			push := new(bytes.Buffer)
+			binary.Write(push, binary.BigEndian, ctx.UserID)
+			binary.Write(push, binary.BigEndian, hp)
+			binary.Write(push, binary.BigEndian, shield)
+			for _, uid := range targets {
+				if conn, ok := state.GetConn(uid); ok {
+					ctx.Server.SendResponse(conn, 4010, uid, push.Bytes())
+				}
+			}
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4007, ctx.UserID, buf.Bytes())
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, hp)
+		binary.Write(buf, binary.BigEndian, shield)
+		ctx.Server.SendResponse(ctx.Conn, 4004, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKFreeze() gateway.Handler {
+// handleTeamPKFreeze freezes an enemy player, who is told with the same
+// command.
+func handleTeamPKFreeze(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		targetID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, until := freezeTeamPK(deps, state, user, targetID, time.Now())
+		untilAt := uint32(0)
+		if result == teamPKOK {
+			untilAt = uint32(until.Unix())
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, targetID)
+		binary.Write(buf, binary.BigEndian, untilAt)
+		if result == teamPKOK {
+			if conn, ok := state.GetConn(targetID); ok {
+				ctx.Server.SendResponse(conn, 4008, targetID, buf.Bytes())
+			}
+		}
 		ctx.Server.SendResponse(ctx.Conn, 4008, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKUnfreeze() gateway.Handler {
+func handleTeamPKUnfreeze(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		reader := NewReader(ctx.Body)
+		targetID := reader.ReadUint32BE()
+		user := state.GetOrCreateUser(ctx.UserID)
+		result := unfreezeTeamPK(state, user, targetID, time.Now())
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, targetID)
+		if result == teamPKOK {
+			if conn, ok := state.GetConn(targetID); ok {
+				ctx.Server.SendResponse(conn, 4009, targetID, buf.Bytes())
+			}
+		}
 		ctx.Server.SendResponse(ctx.Conn, 4009, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKBeShot() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4010, ctx.UserID, buf.Bytes())
-	}
-}
-
-func handleTeamPKGetBuildingInfo() gateway.Handler {
+func handleTeamPKGetBuildingInfo(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		var ownHP, ownShield, enemyHP, enemyShield uint32
+		state.teamPK.mu.Lock()
+		if inst := state.teamPK.byTeam[user.Team.ID]; user.Team.ID != 0 && inst != nil {
+			own, enemy := inst.side(user.Team.ID)
+			ownHP, ownShield = uint32(own.HP), uint32(own.Shield)
+			enemyHP, enemyShield = uint32(enemy.HP), uint32(enemy.Shield)
+		}
+		state.teamPK.mu.Unlock()
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, ownHP)
+		binary.Write(buf, binary.BigEndian, ownShield)
+		binary.Write(buf, binary.BigEndian, enemyHP)
+		binary.Write(buf, binary.BigEndian, enemyShield)
 		ctx.Server.SendResponse(ctx.Conn, 4011, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKSituation() gateway.Handler {
+// handleTeamPKSituation lists both sides of the caller's battle.
+func handleTeamPKSituation(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		state.teamPK.mu.Lock()
+		inst := state.teamPK.byTeam[user.Team.ID]
+		if user.Team.ID == 0 || inst == nil {
+			state.teamPK.mu.Unlock()
+			binary.Write(buf, binary.BigEndian, uint32(0))
+			ctx.Server.SendResponse(ctx.Conn, 4012, ctx.UserID, buf.Bytes())
+			return
+		}
+		binary.Write(buf, binary.BigEndian, inst.ID)
+		binary.Write(buf, binary.BigEndian, uint32(inst.EndsAt.Unix()))
+		for _, side := range inst.Sides {
+			binary.Write(buf, binary.BigEndian, side.TeamID)
+			binary.Write(buf, binary.BigEndian, uint32(side.HP))
+			binary.Write(buf, binary.BigEndian, uint32(side.Shield))
+			binary.Write(buf, binary.BigEndian, uint32(side.Shields))
+			binary.Write(buf, binary.BigEndian, uint32(len(side.Players)))
+			protocol.WriteFixedString(buf, side.Name, 16)
+		}
+		state.teamPK.mu.Unlock()
 		ctx.Server.SendResponse(ctx.Conn, 4012, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKResult() gateway.Handler {
+func handleTeamPKResult(state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4013, ctx.UserID, buf.Bytes())
+		user := state.GetOrCreateUser(ctx.UserID)
+		ctx.Server.SendResponse(ctx.Conn, 4013, ctx.UserID, buildTeamPKResultBody(state, user.Team.ID))
 	}
 }
 
-func handleTeamPKUseShield() gateway.Handler {
+// buildTeamPKResultBody describes the team's last battle; has is 0 when it
+// has not fought yet.
+func buildTeamPKResultBody(state *State, teamID uint32) []byte {
+	res, ok := lastTeamPKResult(state, teamID)
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, boolToUint32(ok))
+	binary.Write(buf, binary.BigEndian, res.Result)
+	binary.Write(buf, binary.BigEndian, res.OpponentID)
+	binary.Write(buf, binary.BigEndian, uint32(res.Score))
+	binary.Write(buf, binary.BigEndian, uint32(res.HP))
+	binary.Write(buf, binary.BigEndian, uint32(res.EnemyHP))
+	return buf.Bytes()
+}
+
+func handleTeamPKUseShield(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		result, side := useTeamPKShield(deps, state, user)
+		var shield, left uint32
+		if side != nil {
+			state.teamPK.mu.Lock()
+			shield, left = uint32(side.Shield), uint32(side.Shields)
+			state.teamPK.mu.Unlock()
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, result)
+		binary.Write(buf, binary.BigEndian, shield)
+		binary.Write(buf, binary.BigEndian, left)
 		ctx.Server.SendResponse(ctx.Conn, 4014, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKWeekyScore() gateway.Handler {
+// handleTeamPKWeekyScore reports the team's season record and the score
+// it has earned since Monday.
+func handleTeamPKWeekyScore(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		cfg := state.teamPK.config(deps)
+		var score, wins, losses, draws, weekly int
+		if deps != nil && deps.Store != nil && user.Team.ID != 0 {
+			if r, err := deps.Store.GetTeamPKScore(context.Background(), int64(user.Team.ID), cfg.Season); err == nil && r != nil {
+				score, wins, losses, draws = r.Score, r.Wins, r.Losses, r.Draws
+			}
+			if history, err := deps.Store.ListTeamPKHistory(context.Background(), int64(user.Team.ID), 0); err == nil {
+				weekly = weeklyTeamPKScore(history, time.Now())
+			}
+		}
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		binary.Write(buf, binary.BigEndian, uint32(weekly))
+		binary.Write(buf, binary.BigEndian, uint32(score))
+		binary.Write(buf, binary.BigEndian, uint32(wins))
+		binary.Write(buf, binary.BigEndian, uint32(losses))
+		binary.Write(buf, binary.BigEndian, uint32(draws))
 		ctx.Server.SendResponse(ctx.Conn, 4017, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKHistory() gateway.Handler {
+func handleTeamPKHistory(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		user := state.GetOrCreateUser(ctx.UserID)
+		cfg := state.teamPK.config(deps)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		if deps == nil || deps.Store == nil || user.Team.ID == 0 {
+			binary.Write(buf, binary.BigEndian, uint32(0))
+			ctx.Server.SendResponse(ctx.Conn, 4018, ctx.UserID, buf.Bytes())
+			return
+		}
+		history, _ := deps.Store.ListTeamPKHistory(context.Background(), int64(user.Team.ID), cfg.HistorySize)
+		binary.Write(buf, binary.BigEndian, uint32(len(history)))
+		for _, h := range history {
+			binary.Write(buf, binary.BigEndian, uint32(h.OpponentID))
+			binary.Write(buf, binary.BigEndian, uint32(h.Result))
+			binary.Write(buf, binary.BigEndian, uint32(h.Score))
+			binary.Write(buf, binary.BigEndian, uint32(h.BuildingHP))
+			binary.Write(buf, binary.BigEndian, uint32(h.OpponentBuildingHP))
+			binary.Write(buf, binary.BigEndian, uint32(h.CreatedAt))
+			protocol.WriteFixedString(buf, h.OpponentName, 16)
+		}
 		ctx.Server.SendResponse(ctx.Conn, 4018, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKSomeoneJoinInfo() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4019, ctx.UserID, buf.Bytes())
-	}
-}
-
-func handleTeamPKNoPet() gateway.Handler {
+func handleTeamPKTeamCharts(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		cfg := state.teamPK.config(deps)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4020, ctx.UserID, buf.Bytes())
-	}
-}
-
-func handleTeamPKActive() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4022, ctx.UserID, buf.Bytes())
-	}
-}
-
-func handleTeamPKActiveNoteGetItem() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4023, ctx.UserID, buf.Bytes())
-	}
-}
-
-func handleTeamPKActiveGetAttack() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4024, ctx.UserID, buf.Bytes())
-	}
-}
-
-func handleTeamPKActiveGetStone() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 4025, ctx.UserID, buf.Bytes())
-	}
-}
-
-func handleTeamPKTeamCharts() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		if deps == nil || deps.Store == nil {
+			binary.Write(buf, binary.BigEndian, uint32(0))
+			ctx.Server.SendResponse(ctx.Conn, 4101, ctx.UserID, buf.Bytes())
+			return
+		}
+		rows, _ := deps.Store.ListTeamPKScores(context.Background(), cfg.Season, cfg.LeaderboardSize)
+		binary.Write(buf, binary.BigEndian, uint32(len(rows)))
+		for _, r := range rows {
+			binary.Write(buf, binary.BigEndian, uint32(r.TeamID))
+			binary.Write(buf, binary.BigEndian, uint32(r.Score))
+			binary.Write(buf, binary.BigEndian, uint32(r.Wins))
+			protocol.WriteFixedString(buf, r.Name, 16)
+		}
 		ctx.Server.SendResponse(ctx.Conn, 4101, ctx.UserID, buf.Bytes())
 	}
 }
 
-func handleTeamPKSeerCharts() gateway.Handler {
+func handleTeamPKSeerCharts(deps *Deps, state *State) gateway.Handler {
 	return func(ctx *gateway.Context) {
+		cfg := state.teamPK.config(deps)
 		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
+		if deps == nil || deps.Store == nil {
+			binary.Write(buf, binary.BigEndian, uint32(0))
+			ctx.Server.SendResponse(ctx.Conn, 4102, ctx.UserID, buf.Bytes())
+			return
+		}
+		rows, _ := deps.Store.ListTeamPKSeerScores(context.Background(), cfg.Season, cfg.LeaderboardSize)
+		binary.Write(buf, binary.BigEndian, uint32(len(rows)))
+		for _, r := range rows {
+			binary.Write(buf, binary.BigEndian, uint32(r.UserID))
+			binary.Write(buf, binary.BigEndian, uint32(r.TeamID))
+			binary.Write(buf, binary.BigEndian, uint32(r.Score))
+			protocol.WriteFixedString(buf, r.Nick, 16)
+		}
 		ctx.Server.SendResponse(ctx.Conn, 4102, ctx.UserID, buf.Bytes())
 	}
 }
-
-func handleTeamPKPetFight() gateway.Handler {
-	return func(ctx *gateway.Context) {
-		buf := new(bytes.Buffer)
-		binary.Write(buf, binary.BigEndian, uint32(0))
-		ctx.Server.SendResponse(ctx.Conn, 2481, ctx.UserID, buf.Bytes())
-	}
-}
@@ -4,9 +4,11 @@ import "sync"
 
 // MapBossEntry describes a map boss configuration for MAP_BOSS (2021).
 type MapBossEntry struct {
-	BossPetID int  `json:"bossPetId"`
-	Level     int  `json:"level"`
-	HasShield bool `json:"hasShield"`
+	BossPetID int    `json:"bossPetId"`
+	Level     int    `json:"level"`
+	HasShield bool   `json:"hasShield"`
+	AI        string `json:"ai,omitempty"`
+	AIScript  []int  `json:"aiScript,omitempty"`
 }
 
 type mapBossFile struct {
@@ -60,23 +62,23 @@ func loadMapBossConfig() {
 
 func defaultMapBossConfig() map[int]map[uint32]MapBossEntry {
 	return map[int]map[uint32]MapBossEntry{
-		12:  {0: {47, 10, true}, 1: {83, 5, false}},
-		22:  {0: {34, 25, false}},
-		21:  {0: {34, 25, false}},
-		17:  {0: {42, 35, false}},
-		40:  {0: {50, 65, false}},
-		27:  {0: {69, 45, false}},
-		32:  {0: {70, 70, false}},
-		106: {0: {88, 60, false}},
-		49:  {0: {113, 55, false}},
-		314: {0: {132, 70, false}},
-		53:  {0: {187, 50, false}},
-		60:  {0: {216, 80, false}},
-		325: {0: {264, 70, false}},
-		61:  {0: {421, 70, false}},
-		348: {0: {274, 75, false}, 1: {391, 70, false}, 2: {216, 80, false}, 3: {413, 75, false}},
-		59:  {0: {347, 70, false}},
-		16:  {0: {393, 75, false}},
-		10:  {0: {4150, 80, false}},
+		12:  {0: {BossPetID: 47, Level: 10, HasShield: true}, 1: {BossPetID: 83, Level: 5, HasShield: false}},
+		22:  {0: {BossPetID: 34, Level: 25, HasShield: false}},
+		21:  {0: {BossPetID: 34, Level: 25, HasShield: false}},
+		17:  {0: {BossPetID: 42, Level: 35, HasShield: false}},
+		40:  {0: {BossPetID: 50, Level: 65, HasShield: false}},
+		27:  {0: {BossPetID: 69, Level: 45, HasShield: false}},
+		32:  {0: {BossPetID: 70, Level: 70, HasShield: false}},
+		106: {0: {BossPetID: 88, Level: 60, HasShield: false}},
+		49:  {0: {BossPetID: 113, Level: 55, HasShield: false}},
+		314: {0: {BossPetID: 132, Level: 70, HasShield: false}},
+		53:  {0: {BossPetID: 187, Level: 50, HasShield: false}},
+		60:  {0: {BossPetID: 216, Level: 80, HasShield: false}},
+		325: {0: {BossPetID: 264, Level: 70, HasShield: false}},
+		61:  {0: {BossPetID: 421, Level: 70, HasShield: false}},
+		348: {0: {BossPetID: 274, Level: 75, HasShield: false}, 1: {BossPetID: 391, Level: 70, HasShield: false}, 2: {BossPetID: 216, Level: 80, HasShield: false}, 3: {BossPetID: 413, Level: 75, HasShield: false}},
+		59:  {0: {BossPetID: 347, Level: 70, HasShield: false}},
+		16:  {0: {BossPetID: 393, Level: 75, HasShield: false}},
+		10:  {0: {BossPetID: 4150, Level: 80, HasShield: false}},
 	}
 }
@@ -14,14 +14,30 @@ type mapOgreMap struct {
 }
 
 type mapOgreEntry struct {
-	Slot  int `json:"slot"`
-	PetID int `json:"petId"`
-	Shiny int `json:"shiny"`
+	Slot      int           `json:"slot"`
+	PetID     int           `json:"petId"`
+	Shiny     int           `json:"shiny"`
+	Weight    int           `json:"weight"`
+	Level     int           `json:"level"`
+	MinLevel  int           `json:"minLevel"`
+	MaxLevel  int           `json:"maxLevel"`
+	IsBoss    bool          `json:"isBoss"`
+	AI        string        `json:"ai,omitempty"`
+	AIScript  []int         `json:"aiScript,omitempty"`
+	DropItems []mapOgreDrop `json:"dropItems"`
+}
+
+type mapOgreDrop struct {
+	ItemID   int     `json:"itemId"`
+	DropRate float64 `json:"dropRate"`
+	MinCount int     `json:"minCount"`
+	MaxCount int     `json:"maxCount"`
 }
 
 var (
-	mapOgresOnce sync.Once
-	mapOgresData map[uint32]map[int][2]uint32
+	mapOgresOnce    sync.Once
+	mapOgresData    map[uint32]map[int][2]uint32
+	mapOgresEntries map[uint32][]mapOgreEntry
 )
 
 func getMapOgreSlots(mapID uint32) map[int][2]uint32 {
@@ -32,6 +48,22 @@ func getMapOgreSlots(mapID uint32) map[int][2]uint32 {
 	return map[int][2]uint32{}
 }
 
+// getMapOgreEntries returns the full ogre entries of a map. Maps that only
+// have built-in slots get level-5 entries for them.
+func getMapOgreEntries(mapID uint32) []mapOgreEntry {
+	mapOgresOnce.Do(loadMapOgres)
+	if entries, ok := mapOgresEntries[mapID]; ok {
+		return entries
+	}
+	slots := mapOgresData[mapID]
+	out := make([]mapOgreEntry, 0, len(slots))
+	for slot, data := range slots {
+		out = append(out, mapOgreEntry{Slot: slot, PetID: int(data[0]), Shiny: int(data[1]), Level: 5})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Slot < out[j].Slot })
+	return out
+}
+
 func buildMapOgreBody(mapID uint32) []byte {
 	slots := getMapOgreSlots(mapID)
 	body := make([]byte, 9*8)
@@ -61,6 +93,7 @@ func putU32(buf []byte, offset int, value uint32) {
 
 func loadMapOgres() {
 	mapOgresData = defaultMapOgres()
+	mapOgresEntries = make(map[uint32][]mapOgreEntry)
 
 	var cfg mapOgresFile
 	if !readConfigJSON("map-ogres.json", &cfg) {
@@ -73,6 +106,7 @@ func loadMapOgres() {
 		if mapID == 0 {
 			continue
 		}
+		mapOgresEntries[mapID] = append([]mapOgreEntry(nil), entry.Ogres...)
 		slots := normalizeOgreSlots(entry.Ogres)
 		out[mapID] = slots
 	}
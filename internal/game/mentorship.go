@@ -0,0 +1,444 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"jseer/internal/gateway"
+	"jseer/internal/storage"
+)
+
+const (
+	mentorOK uint32 = iota
+	mentorSelf
+	mentorOffline
+	mentorHasTeacher
+	mentorFull
+	mentorLowLevel
+	mentorTooStrong
+	mentorNoRequest
+	mentorNotLinked
+	mentorNotReady
+	mentorActive
+	mentorBlocked
+)
+
+// Pending request kinds: a student asking for a teacher (3001) or a
+// teacher inviting a student (3003).
+const (
+	mentorAskTeacher uint32 = iota + 1
+	mentorInviteStudent
+)
+
+// mentorConfig is read from teacher.json (GM key teacher). Levels compare
+// against the highest pet level a player owns.
+type mentorConfig struct {
+	MaxStudents     int          `json:"maxStudents"`
+	TeacherLevel    int          `json:"teacherLevel"`
+	GraduationLevel int          `json:"graduationLevel"`
+	ShareRate       int          `json:"shareRate"`
+	InactiveDays    int          `json:"inactiveDays"`
+	Rewards         []BossReward `json:"rewards"`
+}
+
+const mentorConfigFile = "teacher.json"
+
+func defaultMentorConfig() mentorConfig {
+	return mentorConfig{
+		MaxStudents:     3,
+		TeacherLevel:    50,
+		GraduationLevel: 40,
+		ShareRate:       10,
+		InactiveDays:    7,
+		Rewards:         []BossReward{{Coins: 2000}},
+	}
+}
+
+func loadMentorConfig(deps *Deps) mentorConfig {
+	cfg := defaultMentorConfig()
+	readStoreConfigJSON(deps, mentorConfigFile, &cfg)
+	if cfg.MaxStudents <= 0 {
+		cfg.MaxStudents = defaultMentorConfig().MaxStudents
+	}
+	if cfg.InactiveDays <= 0 {
+		cfg.InactiveDays = defaultMentorConfig().InactiveDays
+	}
+	return cfg
+}
+
+// mentorRegistry holds pending requests, which are not persisted, and the
+// mentorships themselves when there is no store.
+type mentorRegistry struct {
+	mu       sync.Mutex
+	requests map[uint32]map[uint32]uint32
+	links    map[uint32]*storage.Mentorship
+}
+
+func newMentorRegistry() *mentorRegistry {
+	return &mentorRegistry{
+		requests: make(map[uint32]map[uint32]uint32),
+		links:    make(map[uint32]*storage.Mentorship),
+	}
+}
+
+func (r *mentorRegistry) get(deps *Deps, studentID uint32) *storage.Mentorship {
+	if deps != nil && deps.Store != nil {
+		m, err := deps.Store.GetMentorship(context.Background(), int64(studentID))
+		if err != nil {
+			return nil
+		}
+		return m
+	}
+	return r.links[studentID]
+}
+
+func (r *mentorRegistry) list(deps *Deps, teacherID uint32) []*storage.Mentorship {
+	if deps != nil && deps.Store != nil {
+		rows, _ := deps.Store.ListMentorships(context.Background(), int64(teacherID))
+		return rows
+	}
+	var out []*storage.Mentorship
+	for _, m := range r.links {
+		if m.TeacherID == int64(teacherID) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func (r *mentorRegistry) create(deps *Deps, teacherID, studentID uint32) error {
+	in := &storage.Mentorship{TeacherID: int64(teacherID), StudentID: int64(studentID)}
+	if deps != nil && deps.Store != nil {
+		_, err := deps.Store.CreateMentorship(context.Background(), in)
+		return err
+	}
+	if r.links[studentID] != nil {
+		return storage.ErrMentorshipExists
+	}
+	in.CreatedAt = time.Now().Unix()
+	r.links[studentID] = in
+	return nil
+}
+
+func (r *mentorRegistry) remove(deps *Deps, studentID uint32) {
+	if deps != nil && deps.Store != nil {
+		_ = deps.Store.DeleteMentorship(context.Background(), int64(studentID))
+		return
+	}
+	delete(r.links, studentID)
+}
+
+func (r *mentorRegistry) addExp(deps *Deps, studentID uint32, exp int64) {
+	if deps != nil && deps.Store != nil {
+		_, _ = deps.Store.AddMentorshipExp(context.Background(), int64(studentID), exp)
+		return
+	}
+	if m := r.links[studentID]; m != nil {
+		m.ExpShared += exp
+		m.ExpTotal += exp
+	}
+}
+
+func (r *mentorRegistry) claim(deps *Deps, teacherID uint32) int64 {
+	if deps != nil && deps.Store != nil {
+		total, err := deps.Store.ClaimMentorshipExp(context.Background(), int64(teacherID))
+		if err != nil {
+			return 0
+		}
+		return total
+	}
+	var total int64
+	for _, m := range r.list(deps, teacherID) {
+		total += m.ExpShared
+		m.ExpShared = 0
+	}
+	return total
+}
+
+// syncView copies the user's links into TeacherID and StudentIDs.
+func (r *mentorRegistry) syncView(deps *Deps, user *User) {
+	user.TeacherID = 0
+	if m := r.get(deps, user.ID); m != nil {
+		user.TeacherID = uint32(m.TeacherID)
+	}
+	user.StudentIDs = user.StudentIDs[:0]
+	for _, m := range r.list(deps, user.ID) {
+		user.StudentIDs = append(user.StudentIDs, uint32(m.StudentID))
+	}
+	user.StudentID = 0
+	if len(user.StudentIDs) > 0 {
+		user.StudentID = user.StudentIDs[0]
+	}
+}
+
+// refreshPeer updates a loaded user's view after the other side changed a
+// link. Users that are not loaded pick the change up at login.
+func (r *mentorRegistry) refreshPeer(deps *Deps, state *State, id uint32) {
+	if u, ok := loadedUser(deps, state, id); ok {
+		r.syncView(deps, u)
+		savePlayer(deps, u.ID, u)
+	}
+}
+
+// checkPair validates that teacherID may take studentID as a student.
+func (r *mentorRegistry) checkPair(deps *Deps, state *State, cfg mentorConfig, teacherID, studentID uint32) uint32 {
+	if teacherID == studentID {
+		return mentorSelf
+	}
+	if r.get(deps, studentID) != nil {
+		return mentorHasTeacher
+	}
+	if len(r.list(deps, teacherID)) >= cfg.MaxStudents {
+		return mentorFull
+	}
+	if int(playerPetLevel(deps, state, teacherID)) < cfg.TeacherLevel {
+		return mentorLowLevel
+	}
+	if int(playerPetLevel(deps, state, studentID)) >= cfg.GraduationLevel {
+		return mentorTooStrong
+	}
+	return mentorOK
+}
+
+func mentorPair(kind, fromID, targetID uint32) (teacherID, studentID uint32) {
+	if kind == mentorAskTeacher {
+		return targetID, fromID
+	}
+	return fromID, targetID
+}
+
+// requestMentor records a pending request from user to an online target.
+func requestMentor(deps *Deps, state *State, user *User, targetID uint32, kind uint32) uint32 {
+	if targetID == user.ID {
+		return mentorSelf
+	}
+	if _, ok := state.GetConn(targetID); !ok {
+		return mentorOffline
+	}
+	if blockedBy(deps, state, targetID, user.ID) {
+		return mentorBlocked
+	}
+	r := state.mentors
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	teacherID, studentID := mentorPair(kind, user.ID, targetID)
+	if result := r.checkPair(deps, state, loadMentorConfig(deps), teacherID, studentID); result != mentorOK {
+		return result
+	}
+	if r.requests[targetID] == nil {
+		r.requests[targetID] = make(map[uint32]uint32)
+	}
+	r.requests[targetID][user.ID] = kind
+	return mentorOK
+}
+
+// answerMentor consumes a pending request sent to user and, if accepted,
+// links both players.
+func answerMentor(deps *Deps, state *State, user *User, fromID uint32, kind uint32, accept bool) uint32 {
+	r := state.mentors
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.requests[user.ID][fromID] != kind {
+		return mentorNoRequest
+	}
+	delete(r.requests[user.ID], fromID)
+	if !accept {
+		return mentorOK
+	}
+	teacherID, studentID := mentorPair(kind, fromID, user.ID)
+	if result := r.checkPair(deps, state, loadMentorConfig(deps), teacherID, studentID); result != mentorOK {
+		return result
+	}
+	if err := r.create(deps, teacherID, studentID); err != nil {
+		if errors.Is(err, storage.ErrMentorshipExists) {
+			return mentorHasTeacher
+		}
+		return mentorNotLinked
+	}
+	r.refreshPeer(deps, state, teacherID)
+	r.refreshPeer(deps, state, studentID)
+	return mentorOK
+}
+
+// unlinkMentor removes the link between teacherID and studentID.
+func unlinkMentor(deps *Deps, state *State, teacherID, studentID uint32) uint32 {
+	r := state.mentors
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := r.get(deps, studentID)
+	if m == nil || uint32(m.TeacherID) != teacherID {
+		return mentorNotLinked
+	}
+	r.remove(deps, studentID)
+	r.refreshPeer(deps, state, teacherID)
+	r.refreshPeer(deps, state, studentID)
+	return mentorOK
+}
+
+// shareMentorExp credits a teacher with a share of exp their student
+// earned in battle. The student keeps the full amount.
+func shareMentorExp(deps *Deps, user *User, exp int) {
+	if deps == nil || deps.State == nil || user.TeacherID == 0 {
+		return
+	}
+	share := exp * loadMentorConfig(deps).ShareRate / 100
+	if share <= 0 {
+		return
+	}
+	r := deps.State.mentors
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.addExp(deps, user.ID, int64(share))
+}
+
+// claimMentorExp moves all exp shared by the user's students into the
+// user's exp pool.
+func claimMentorExp(deps *Deps, state *State, user *User) uint32 {
+	r := state.mentors
+	r.mu.Lock()
+	total := uint32(r.claim(deps, user.ID))
+	r.mu.Unlock()
+	addExpPool(deps, user, total)
+	return total
+}
+
+// mentorStudents returns the user's links, oldest first.
+func mentorStudents(deps *Deps, state *State, user *User) []*storage.Mentorship {
+	r := state.mentors
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.list(deps, user.ID)
+}
+
+// graduateStudent ends a mentorship whose student reached the graduation
+// level. The teacher keeps the unclaimed share and gets the rewards.
+func graduateStudent(deps *Deps, state *State, teacher *User, studentID uint32) uint32 {
+	cfg := loadMentorConfig(deps)
+	r := state.mentors
+	r.mu.Lock()
+	m := r.get(deps, studentID)
+	if m == nil || uint32(m.TeacherID) != teacher.ID {
+		r.mu.Unlock()
+		return mentorNotLinked
+	}
+	if int(playerPetLevel(deps, state, studentID)) < cfg.GraduationLevel {
+		r.mu.Unlock()
+		return mentorNotReady
+	}
+	r.remove(deps, studentID)
+	r.syncView(deps, teacher)
+	r.refreshPeer(deps, state, studentID)
+	r.mu.Unlock()
+
+	teacher.ExpPool += uint32(m.ExpShared)
+	teacher.GraduationCount++
+	for _, reward := range cfg.Rewards {
+		if reward.ItemID > 0 {
+			grantItem(deps, teacher, reward.ItemID, maxInt(1, reward.Count))
+		}
+		if reward.Coins > 0 {
+			teacher.Coins += uint32(reward.Coins)
+		}
+	}
+	savePlayer(deps, teacher.ID, teacher)
+	return mentorOK
+}
+
+// dissolveInactiveMentor ends the user's link with a partner who has not
+// logged in for InactiveDays.
+func dissolveInactiveMentor(deps *Deps, state *State, user *User, partnerID uint32, now time.Time) uint32 {
+	cfg := loadMentorConfig(deps)
+	r := state.mentors
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	teacherID, studentID := partnerID, user.ID
+	if m := r.get(deps, studentID); m == nil || uint32(m.TeacherID) != teacherID {
+		teacherID, studentID = user.ID, partnerID
+		if m := r.get(deps, studentID); m == nil || uint32(m.TeacherID) != teacherID {
+			return mentorNotLinked
+		}
+	}
+	if _, ok := state.GetConn(partnerID); ok {
+		return mentorActive
+	}
+	last := playerLastLogin(deps, state, partnerID)
+	if last == 0 || now.Sub(time.Unix(int64(last), 0)) < time.Duration(cfg.InactiveDays)*24*time.Hour {
+		return mentorActive
+	}
+	r.remove(deps, studentID)
+	r.refreshPeer(deps, state, teacherID)
+	r.refreshPeer(deps, state, studentID)
+	return mentorOK
+}
+
+// refreshUserMentors reconciles the user's teacher and students with the
+// mentorship store at login.
+func refreshUserMentors(deps *Deps, state *State, user *User) {
+	r := state.mentors
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.syncView(deps, user)
+}
+
+func highestPetLevel(pets ...[]Pet) uint32 {
+	var lv uint32
+	for _, list := range pets {
+		for _, p := range list {
+			if p.Level > lv {
+				lv = p.Level
+			}
+		}
+	}
+	return lv
+}
+
+// playerPetLevel returns the highest pet level of a loaded user, falling
+// back to the store for players that are not loaded.
+func playerPetLevel(deps *Deps, state *State, id uint32) uint32 {
+	if u, ok := loadedUser(deps, state, id); ok {
+		return highestPetLevel(u.Pets, u.Warehouse)
+	}
+	if deps == nil || deps.Store == nil {
+		return 0
+	}
+	p, err := deps.Store.GetPlayerByAccount(context.Background(), int64(id))
+	if err != nil {
+		return 0
+	}
+	pets, err := deps.Store.ListPetsByPlayer(context.Background(), p.ID)
+	if err != nil {
+		return 0
+	}
+	var lv uint32
+	for _, pet := range pets {
+		if uint32(pet.Level) > lv {
+			lv = uint32(pet.Level)
+		}
+	}
+	return lv
+}
+
+func playerLastLogin(deps *Deps, state *State, id uint32) uint32 {
+	if u, ok := loadedUser(deps, state, id); ok {
+		return u.LastLoginAt
+	}
+	if deps == nil || deps.Store == nil {
+		return 0
+	}
+	p, err := deps.Store.GetPlayerByAccount(context.Background(), int64(id))
+	if err != nil {
+		return 0
+	}
+	return uint32(p.LastLoginAt)
+}
+
+// sendMentorNotice pushes cmd to id if online, with the sender and a value.
+func sendMentorNotice(srv *gateway.Server, state *State, id uint32, cmd int32, from *User, value uint32) {
+	conn, ok := state.GetConn(id)
+	if !ok {
+		return
+	}
+	srv.SendResponse(conn, cmd, id, buildMentorNoticeBody(from, value))
+}
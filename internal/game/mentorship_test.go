@@ -0,0 +1,122 @@
+package game
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func newMentorTestUsers(t *testing.T, state *State) (teacher, student *User) {
+	users := newTeamTestUsers(state, 1, 2)
+	for _, u := range users {
+		c, peer := net.Pipe()
+		t.Cleanup(func() { c.Close(); peer.Close() })
+		state.RegisterConn(u.ID, c)
+	}
+	users[0].Pets = []Pet{{Level: 60}}
+	users[1].Pets = []Pet{{Level: 10}}
+	return users[0], users[1]
+}
+
+func TestMentorRequestAndAnswerLinkBothSides(t *testing.T) {
+	state := NewState()
+	teacher, student := newMentorTestUsers(t, state)
+
+	if r := answerMentor(nil, state, teacher, student.ID, mentorAskTeacher, true); r != mentorNoRequest {
+		t.Fatalf("answer without request=%d", r)
+	}
+	if r := requestMentor(nil, state, student, 99, mentorAskTeacher); r != mentorOffline {
+		t.Fatalf("offline target=%d", r)
+	}
+	if r := requestMentor(nil, state, student, teacher.ID, mentorAskTeacher); r != mentorOK {
+		t.Fatalf("request=%d", r)
+	}
+	if r := answerMentor(nil, state, teacher, student.ID, mentorAskTeacher, true); r != mentorOK {
+		t.Fatalf("answer=%d", r)
+	}
+	if student.TeacherID != teacher.ID || len(teacher.StudentIDs) != 1 || teacher.StudentID != student.ID {
+		t.Fatalf("teacher=%v student.TeacherID=%d", teacher.StudentIDs, student.TeacherID)
+	}
+	if r := requestMentor(nil, state, teacher, student.ID, mentorInviteStudent); r != mentorHasTeacher {
+		t.Fatalf("second teacher=%d", r)
+	}
+
+	if r := unlinkMentor(nil, state, teacher.ID, student.ID); r != mentorOK || student.TeacherID != 0 || len(teacher.StudentIDs) != 0 {
+		t.Fatalf("unlink=%d teacher=%v student=%d", r, teacher.StudentIDs, student.TeacherID)
+	}
+	if r := unlinkMentor(nil, state, teacher.ID, student.ID); r != mentorNotLinked {
+		t.Fatalf("unlink twice=%d", r)
+	}
+}
+
+func TestMentorLevelChecks(t *testing.T) {
+	state := NewState()
+	teacher, student := newMentorTestUsers(t, state)
+	teacher.Pets[0].Level = 20
+	if r := requestMentor(nil, state, teacher, student.ID, mentorInviteStudent); r != mentorLowLevel {
+		t.Fatalf("weak teacher=%d", r)
+	}
+	teacher.Pets[0].Level = 60
+	student.Warehouse = []Pet{{Level: 45}}
+	if r := requestMentor(nil, state, teacher, student.ID, mentorInviteStudent); r != mentorTooStrong {
+		t.Fatalf("strong student=%d", r)
+	}
+}
+
+func TestMentorExpShareAndGraduation(t *testing.T) {
+	state := NewState()
+	deps := &Deps{State: state}
+	teacher, student := newMentorTestUsers(t, state)
+	requestMentor(deps, state, teacher, student.ID, mentorInviteStudent)
+	answerMentor(deps, state, student, teacher.ID, mentorInviteStudent, true)
+
+	shareMentorExp(deps, student, 100)
+	shareMentorExp(deps, student, 55)
+	if got := mentorStudents(deps, state, teacher); len(got) != 1 || got[0].ExpShared != 15 || got[0].ExpTotal != 15 {
+		t.Fatalf("shared=%+v", got[0])
+	}
+	if claimed := claimMentorExp(deps, state, teacher); claimed != 15 || teacher.ExpPool != 15 {
+		t.Fatalf("claimed=%d pool=%d", claimed, teacher.ExpPool)
+	}
+	shareMentorExp(deps, student, 50)
+
+	if r := graduateStudent(deps, state, teacher, student.ID); r != mentorNotReady {
+		t.Fatalf("early graduation=%d", r)
+	}
+	student.Pets[0].Level = 40
+	coins := teacher.Coins
+	if r := graduateStudent(deps, state, teacher, student.ID); r != mentorOK {
+		t.Fatalf("graduation=%d", r)
+	}
+	if teacher.GraduationCount != 1 || teacher.Coins != coins+2000 || teacher.ExpPool != 20 {
+		t.Fatalf("count=%d coins=%d pool=%d", teacher.GraduationCount, teacher.Coins, teacher.ExpPool)
+	}
+	if student.TeacherID != 0 || len(teacher.StudentIDs) != 0 {
+		t.Fatal("link kept after graduation")
+	}
+}
+
+func TestMentorInactiveDissolve(t *testing.T) {
+	state := NewState()
+	teacher, student := newMentorTestUsers(t, state)
+	requestMentor(nil, state, teacher, student.ID, mentorInviteStudent)
+	answerMentor(nil, state, student, teacher.ID, mentorInviteStudent, true)
+	now := time.Unix(1700000000, 0)
+
+	if r := dissolveInactiveMentor(nil, state, student, teacher.ID, now); r != mentorActive {
+		t.Fatalf("online teacher=%d", r)
+	}
+	conn, _ := state.GetConn(teacher.ID)
+	state.DropConn(conn)
+	teacher.LastLoginAt = uint32(now.Add(-6 * 24 * time.Hour).Unix())
+	if r := dissolveInactiveMentor(nil, state, student, teacher.ID, now); r != mentorActive {
+		t.Fatalf("six days=%d", r)
+	}
+	teacher.LastLoginAt = uint32(now.Add(-8 * 24 * time.Hour).Unix())
+	if r := dissolveInactiveMentor(nil, state, student, 77, now); r != mentorNotLinked {
+		t.Fatalf("stranger=%d", r)
+	}
+	if r := dissolveInactiveMentor(nil, state, student, teacher.ID, now); r != mentorOK || student.TeacherID != 0 || len(teacher.StudentIDs) != 0 {
+		t.Fatalf("dissolve=%d", r)
+	}
+}
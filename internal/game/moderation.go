@@ -0,0 +1,183 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"jseer/internal/storage"
+)
+
+// sensitiveWordsConfig is read from sensitive-words.json (GM key
+// sensitive_words). Mask replaces each rune of a matched word.
+type sensitiveWordsConfig struct {
+	Words []string `json:"words"`
+	Mask  string   `json:"mask"`
+}
+
+const sensitiveWordsConfigFile = "sensitive-words.json"
+
+// wordFilterSet is the built automaton for the GM word list and its mask.
+type wordFilterSet struct {
+	filter *wordFilter
+	mask   string
+}
+
+func buildWordFilter(deps *Deps) (wordFilterSet, int64) {
+	var cfg sensitiveWordsConfig
+	version, _ := readStoreConfigJSON(deps, sensitiveWordsConfigFile, &cfg)
+	if cfg.Mask == "" {
+		cfg.Mask = "*"
+	}
+	return wordFilterSet{filter: newWordFilter(cfg.Words), mask: cfg.Mask}, version
+}
+
+// loadWordFilter returns the state's cached automaton, rebuilt only when
+// the stored word list's version changes.
+func loadWordFilter(deps *Deps) (*wordFilter, string) {
+	if deps == nil || deps.State == nil {
+		set, _ := buildWordFilter(deps)
+		return set.filter, set.mask
+	}
+	set := deps.State.words.get(deps, sensitiveWordsConfigFile, func() (wordFilterSet, int64) {
+		return buildWordFilter(deps)
+	})
+	return set.filter, set.mask
+}
+
+// maskSensitive returns s with listed words masked out.
+func maskSensitive(deps *Deps, s string) string {
+	f, mask := loadWordFilter(deps)
+	return f.Mask(s, mask)
+}
+
+// hasSensitive is used where text is rejected instead of masked, such as
+// nicknames and team names.
+func hasSensitive(deps *Deps, s string) bool {
+	f, _ := loadWordFilter(deps)
+	return f.Match(s)
+}
+
+// mutedResult is the mail send result for a muted sender.
+const mutedResult uint32 = 2
+
+// muteCache mirrors the GM chat mutes so chat and mail need not read the
+// store per message. It loads the running mutes once and then, at most once
+// per configPollInterval, applies the rows the GM server changed since the
+// previous sync. Lifting a mute updates its row, so lifts arrive the same
+// way.
+type muteCache struct {
+	mu     sync.Mutex
+	loaded bool
+	synced time.Time
+	until  map[uint32]int64
+}
+
+func newMuteCache() *muteCache {
+	return &muteCache{until: make(map[uint32]int64)}
+}
+
+func (c *muteCache) sync(deps *Deps, now time.Time) {
+	if c.loaded && now.Sub(c.synced) < configPollInterval {
+		return
+	}
+	var rows []*storage.ChatMute
+	var err error
+	if c.loaded {
+		// Windows overlap by one interval to absorb clock skew with the GM
+		// server; re-applying a row is harmless.
+		since := c.synced.Add(-configPollInterval).Unix()
+		rows, err = deps.Store.ListChatMutesChangedSince(context.Background(), since)
+	} else {
+		rows, err = deps.Store.ListChatMutes(context.Background(), now.Unix(), 0)
+	}
+	if err != nil {
+		if deps.Logger != nil {
+			deps.Logger.Warn("chat mute sync failed", zap.Error(err))
+		}
+		return
+	}
+	for _, m := range rows {
+		c.until[uint32(m.UserID)] = m.Until
+	}
+	c.loaded = true
+	c.synced = now
+}
+
+// muted reports whether userID's mute is still running at now, dropping
+// mutes that have run out.
+func (c *muteCache) muted(deps *Deps, userID uint32, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sync(deps, now)
+	until, ok := c.until[userID]
+	if ok && until <= now.Unix() {
+		delete(c.until, userID)
+		return false
+	}
+	return ok
+}
+
+// isMuted reports whether a GM mute on userID is still running at now.
+func isMuted(deps *Deps, userID uint32, now time.Time) bool {
+	if deps == nil || deps.Store == nil {
+		return false
+	}
+	if deps.State != nil {
+		return deps.State.mutes.muted(deps, userID, now)
+	}
+	m, err := deps.Store.GetChatMute(context.Background(), int64(userID))
+	return err == nil && m != nil && m.Until > now.Unix()
+}
+
+// logChat stores a delivered message for the GM chat log search.
+func logChat(deps *Deps, userID uint32, channel string, targetID uint32, msg string) {
+	if deps == nil || deps.Store == nil {
+		return
+	}
+	err := deps.Store.AddChatLog(context.Background(), &storage.ChatLog{
+		UserID:   int64(userID),
+		TargetID: int64(targetID),
+		Channel:  channel,
+		Message:  msg,
+	})
+	if err != nil && deps.Logger != nil {
+		deps.Logger.Warn("chat log failed", zap.Uint32("user", userID), zap.Error(err))
+	}
+}
+
+// userReport is the audit detail of a 7001 report. The GM side looks up
+// the target's chat log from here.
+type userReport struct {
+	Reporter uint32 `json:"reporter"`
+	Reason   uint32 `json:"reason"`
+	Text     string `json:"text,omitempty"`
+}
+
+// recordUserReport parses a 7001 body (targetID, reason, then an optional
+// length-prefixed text) into an audit entry on the reported player.
+func recordUserReport(deps *Deps, reporterID uint32, body []byte) {
+	if deps == nil || deps.Store == nil {
+		return
+	}
+	reader := NewReader(body)
+	targetID := reader.ReadUint32BE()
+	report := userReport{Reporter: reporterID, Reason: reader.ReadUint32BE()}
+	if reader.Remaining() >= 4 {
+		n := int(reader.ReadUint32BE())
+		if n > 0 && reader.Remaining() >= n {
+			report.Text = string(reader.ReadBytes(n))
+		}
+	}
+	detail, _ := json.Marshal(report)
+	_, _ = deps.Store.CreateAuditLog(context.Background(), &storage.AuditLog{
+		Operator:   "user",
+		Action:     "user_report",
+		Resource:   "player",
+		ResourceID: itoa(targetID),
+		Detail:     string(detail),
+	})
+}
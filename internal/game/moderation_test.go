@@ -0,0 +1,123 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"jseer/internal/storage"
+)
+
+// moderationStore serves the word list and records chat logs, mutes and
+// reports; other Store methods are not used on these paths.
+type moderationStore struct {
+	storage.Store
+	words []string
+	mute  *storage.ChatMute
+	logs  []*storage.ChatLog
+	audit []*storage.AuditLog
+	syncs int
+}
+
+func (s *moderationStore) GetConfig(ctx context.Context, key string) (*storage.ConfigEntry, error) {
+	if key != "sensitive_words" {
+		return nil, errors.New("not found")
+	}
+	value, _ := json.Marshal(sensitiveWordsConfig{Words: s.words})
+	return &storage.ConfigEntry{Key: key, Value: value, Version: 1}, nil
+}
+
+func (s *moderationStore) GetChatMute(ctx context.Context, userID int64) (*storage.ChatMute, error) {
+	if s.mute == nil || s.mute.UserID != userID {
+		return nil, errors.New("not found")
+	}
+	return s.mute, nil
+}
+
+func (s *moderationStore) ListChatMutes(ctx context.Context, activeAt int64, limit int) ([]*storage.ChatMute, error) {
+	s.syncs++
+	if s.mute == nil || s.mute.Until <= activeAt {
+		return nil, nil
+	}
+	return []*storage.ChatMute{s.mute}, nil
+}
+
+func (s *moderationStore) ListChatMutesChangedSince(ctx context.Context, since int64) ([]*storage.ChatMute, error) {
+	s.syncs++
+	if s.mute == nil || s.mute.UpdatedAt < since {
+		return nil, nil
+	}
+	return []*storage.ChatMute{s.mute}, nil
+}
+
+func (s *moderationStore) AddChatLog(ctx context.Context, in *storage.ChatLog) error {
+	s.logs = append(s.logs, in)
+	return nil
+}
+
+func (s *moderationStore) CreateAuditLog(ctx context.Context, in *storage.AuditLog) (*storage.AuditLog, error) {
+	s.audit = append(s.audit, in)
+	return in, nil
+}
+
+func TestChatIsMaskedLoggedAndMuted(t *testing.T) {
+	state := NewState()
+	store := &moderationStore{words: []string{"noob"}}
+	deps := &Deps{State: state, Store: store}
+	user := newTeamTestUsers(state, 10001)[0]
+	state.UpdatePlayerMap(user.ID, 0)
+	now := time.Unix(1700000000, 0)
+
+	r, echo := routeChat(deps, state, user, chatMap, []byte("you NOOB"), now)
+	if r != chatOK || string(echo[28:]) != "you ****" {
+		t.Fatalf("chat=%d echo=%q", r, echo)
+	}
+	if len(store.logs) != 1 || store.logs[0].Message != "you ****" || store.logs[0].Channel != "map" {
+		t.Fatalf("logs=%+v", store.logs)
+	}
+
+	muteAt := now.Add(time.Minute)
+	store.mute = &storage.ChatMute{UserID: 10001, Until: muteAt.Add(time.Hour).Unix(), UpdatedAt: muteAt.Unix()}
+	if r, _ := routeChat(deps, state, user, chatMap, []byte("hi"), muteAt); r != chatMuted {
+		t.Fatalf("muted chat=%d", r)
+	}
+	if r, _ := routeChat(deps, state, user, chatMap, []byte("hi"), muteAt.Add(time.Second)); r != chatMuted || store.syncs != 2 {
+		t.Fatalf("muted chat=%d syncs=%d", r, store.syncs)
+	}
+	if r, _ := routeChat(deps, state, user, chatMap, []byte("hi"), muteAt.Add(2*time.Hour)); r != chatOK {
+		t.Fatalf("expired mute=%d", r)
+	}
+
+	liftAt := muteAt.Add(3 * time.Hour)
+	store.mute = &storage.ChatMute{UserID: 10001, Until: liftAt.Add(time.Hour).Unix(), UpdatedAt: liftAt.Unix()}
+	if r, _ := routeChat(deps, state, user, chatMap, []byte("hi"), liftAt); r != chatMuted {
+		t.Fatalf("muted again=%d", r)
+	}
+	store.mute.Until, store.mute.UpdatedAt = liftAt.Add(time.Minute).Unix(), liftAt.Add(time.Minute).Unix()
+	if r, _ := routeChat(deps, state, user, chatMap, []byte("hi"), liftAt.Add(time.Minute)); r != chatOK {
+		t.Fatalf("lifted mute=%d", r)
+	}
+}
+
+func TestSensitiveTeamNameAndReport(t *testing.T) {
+	state := NewState()
+	store := &moderationStore{words: []string{"坏蛋"}}
+	deps := &Deps{State: state, Store: store}
+	user := newTeamTestUsers(state, 1)[0]
+
+	if r, team := createTeam(deps, state, user, "大坏蛋战队"); r != teamBadName || team != nil {
+		t.Fatalf("team name=%d", r)
+	}
+
+	body := []byte{0, 0, 0, 2, 0, 0, 0, 3, 0, 0, 0, 2, 'h', 'i'}
+	recordUserReport(deps, 1, body)
+	if len(store.audit) != 1 || store.audit[0].ResourceID != "2" {
+		t.Fatalf("audit=%+v", store.audit)
+	}
+	var got userReport
+	if err := json.Unmarshal([]byte(store.audit[0].Detail), &got); err != nil || got != (userReport{Reporter: 1, Reason: 3, Text: "hi"}) {
+		t.Fatalf("detail=%s", store.audit[0].Detail)
+	}
+}
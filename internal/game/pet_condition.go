@@ -0,0 +1,182 @@
+package game
+
+import (
+	"jseer/internal/gateway"
+	"jseer/internal/protocol"
+)
+
+// A pet's condition is its HP, per-skill PP and lasting status. Fights start
+// from it and write it back when they end; only curing restores it. HP 0
+// means the pet has fainted, which is also stored as a flag so rows from
+// before HP was tracked are not mistaken for fainted pets.
+
+// lastingPetStatuses survive the end of a fight; the rest are fight-only.
+var lastingPetStatuses = []int{statusParalysis, statusPoison, statusBurn, statusFreeze, statusSleep}
+
+// economyConfig holds the economy.json fields the game server reads (GM key
+// economy).
+type economyConfig struct {
+	PetCureCost    int `json:"pet_cure_cost"`
+	PetOneCureCost int `json:"pet_one_cure_cost"`
+}
+
+const economyConfigFile = "economy.json"
+
+func loadEconomyConfig(deps *Deps) economyConfig {
+	cfg := economyConfig{PetCureCost: 50, PetOneCureCost: 20}
+	readStoreConfigJSON(deps, economyConfigFile, &cfg)
+	cfg.PetCureCost = maxInt(0, cfg.PetCureCost)
+	cfg.PetOneCureCost = maxInt(0, cfg.PetOneCureCost)
+	return cfg
+}
+
+const (
+	petCureOK uint32 = iota
+	petCureNoCoins
+	petCureInFight
+	petCureNoPet
+)
+
+// petFightPP returns the PP the pet's active moves enter a fight with.
+func petFightPP(p *Pet, skills []int) map[int]int {
+	pp := make(map[int]int, len(skills))
+	for _, sid := range skills {
+		if sid <= 0 {
+			continue
+		}
+		maxPP := getSkillPP(sid)
+		if left, ok := p.SkillPP[sid]; ok && left < maxPP {
+			pp[sid] = maxInt(0, left)
+		} else {
+			pp[sid] = maxPP
+		}
+	}
+	return pp
+}
+
+func petFightStatus(p *Pet) map[int]int {
+	status := make(map[int]int)
+	for id, turns := range p.Status {
+		if turns > 0 {
+			status[id] = turns
+		}
+	}
+	return status
+}
+
+// recordPetCondition copies the player side of f back onto p.
+func recordPetCondition(p *Pet, f *FightState) {
+	p.HP = maxInt(0, f.PlayerHP)
+	p.SkillPP = nil
+	for sid, left := range f.PlayerSkillPP {
+		if sid > 0 && left < getSkillPP(sid) {
+			if p.SkillPP == nil {
+				p.SkillPP = make(map[int]int)
+			}
+			p.SkillPP[sid] = maxInt(0, left)
+		}
+	}
+	p.Status = nil
+	for _, id := range lastingPetStatuses {
+		if turns := f.PlayerStatus[id]; turns > 0 {
+			if p.Status == nil {
+				p.Status = make(map[int]int)
+			}
+			p.Status[id] = turns
+		}
+	}
+}
+
+// curePet restores full HP and PP and clears lasting status.
+func curePet(p *Pet) bool {
+	if !needsCure(p) {
+		return false
+	}
+	p.HP = petMaxHP(p)
+	p.SkillPP = nil
+	p.Status = nil
+	return true
+}
+
+func needsCure(p *Pet) bool {
+	return p.HP < petMaxHP(p) || len(p.SkillPP) > 0 || len(p.Status) > 0
+}
+
+// fightAllFainted is the header result that refuses to start a fight while
+// every bag pet has fainted.
+const fightAllFainted int32 = 1
+
+// allPetsFainted reports whether the user has bag pets and none can fight.
+func allPetsFainted(user *User) bool {
+	if len(user.Pets) == 0 {
+		return false
+	}
+	for i := range user.Pets {
+		if user.Pets[i].HP > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// refuseFaintedFight answers the request with fightAllFainted when the user
+// has no pet able to fight.
+func refuseFaintedFight(ctx *gateway.Context, user *User) bool {
+	if !allPetsFainted(user) {
+		return false
+	}
+	_, _ = ctx.Conn.Write(protocol.BuildResponse(ctx.CmdID, ctx.UserID, fightAllFainted, []byte{}))
+	return true
+}
+
+func petMaxHP(p *Pet) int {
+	return getStats(LoadPetDB().pets[int(p.ID)], int(p.Level), int(p.DV), p.EV).MaxHP
+}
+
+// payForCure charges cost coins unless the player has super NoNo. Nothing is
+// charged when no pet needs curing.
+func payForCure(deps *Deps, user *User, pets []*Pet, cost int) uint32 {
+	if user.Fight != nil {
+		return petCureInFight
+	}
+	need := false
+	for _, p := range pets {
+		need = need || needsCure(p)
+	}
+	if !need {
+		return petCureOK
+	}
+	if user.Nono.SuperNono > 0 {
+		cost = 0
+	}
+	if int(user.Coins) < cost {
+		return petCureNoCoins
+	}
+	user.Coins -= uint32(cost)
+	for _, p := range pets {
+		if curePet(p) {
+			upsertPet(deps, user, *p)
+		}
+	}
+	if cost > 0 {
+		savePlayer(deps, user.ID, user)
+	}
+	return petCureOK
+}
+
+// cureAllPets heals every pet in the bag at a healing station.
+func cureAllPets(deps *Deps, user *User) uint32 {
+	pets := make([]*Pet, 0, len(user.Pets))
+	for i := range user.Pets {
+		pets = append(pets, &user.Pets[i])
+	}
+	return payForCure(deps, user, pets, loadEconomyConfig(deps).PetCureCost)
+}
+
+func cureOnePet(deps *Deps, user *User, catchTime uint32) uint32 {
+	p := findPetByCatchTime(user, catchTime)
+	if p == nil {
+		return petCureNoPet
+	}
+	return payForCure(deps, user, []*Pet{p}, loadEconomyConfig(deps).PetOneCureCost)
+}
@@ -0,0 +1,77 @@
+package game
+
+import "testing"
+
+func TestPetConditionCarriesOverFights(t *testing.T) {
+	seedProgressSpecies()
+	p := &Pet{ID: 990101, Level: 5, DV: 31, CatchTime: 7, HP: 9, Skills: []int{10001, 0, 0, 0}, SkillPP: map[int]int{10001: 4}}
+	user := &User{Pets: []Pet{*p}}
+	snap := resolveUserFightPet(user, 7, 0)
+	if snap.CurrentHP != 9 || snap.SkillPP[10001] != 4 {
+		t.Fatalf("snapshot hp=%d pp=%v", snap.CurrentHP, snap.SkillPP)
+	}
+	f := &FightState{
+		PlayerCatch:   7,
+		PlayerHP:      -3,
+		PlayerSkillPP: map[int]int{10001: 2},
+		PlayerStatus:  map[int]int{statusPoison: 2, statusConfuse: 1},
+	}
+	updateFightResult(nil, user, f, false)
+	got := user.Pets[0]
+	if got.HP != 0 || got.SkillPP[10001] != 2 || got.Status[statusPoison] != 2 || got.Status[statusConfuse] != 0 {
+		t.Fatalf("pet=%+v", got)
+	}
+}
+
+func TestResolveUserFightPetSkipsFainted(t *testing.T) {
+	seedProgressSpecies()
+	user := &User{CatchID: 1, Pets: []Pet{
+		{ID: 990101, Level: 5, CatchTime: 1, HP: 0},
+		{ID: 990102, Level: 5, CatchTime: 2, HP: 10},
+	}}
+	if snap := resolveUserFightPet(user, 0, 0); snap.CatchTime != 2 || snap.CurrentHP != 10 {
+		t.Fatalf("snapshot=%+v", snap)
+	}
+}
+
+func TestCureAllPetsCharges(t *testing.T) {
+	seedProgressSpecies()
+	user := &User{Coins: 60, Pets: []Pet{
+		{ID: 990101, Level: 5, DV: 31, HP: 0, Status: map[int]int{statusBurn: 3}},
+		{ID: 990102, Level: 5, DV: 31, HP: 1, SkillPP: map[int]int{10001: 0}},
+	}}
+	if r := cureAllPets(nil, user); r != petCureOK || user.Coins != 10 {
+		t.Fatalf("cure=%d coins=%d", r, user.Coins)
+	}
+	for _, p := range user.Pets {
+		if needsCure(&p) || p.HP == 0 {
+			t.Fatalf("not cured: %+v", p)
+		}
+	}
+	// Healthy pets are not charged for.
+	if r := cureAllPets(nil, user); r != petCureOK || user.Coins != 10 {
+		t.Fatalf("healthy cure=%d coins=%d", r, user.Coins)
+	}
+	user.Pets[0].HP = 1
+	if r := cureAllPets(nil, user); r != petCureNoCoins || user.Pets[0].HP != 1 {
+		t.Fatalf("poor cure=%d hp=%d", r, user.Pets[0].HP)
+	}
+	user.Nono.SuperNono = 1
+	if r := cureAllPets(nil, user); r != petCureOK || user.Coins != 10 || user.Pets[0].HP == 1 {
+		t.Fatalf("vip cure=%d coins=%d", r, user.Coins)
+	}
+}
+
+func TestAllPetsFaintedRefusesFights(t *testing.T) {
+	user := &User{Pets: []Pet{{ID: 1, CatchTime: 1, HP: 0}, {ID: 2, CatchTime: 2, HP: 0}}, AutoFightTimes: 1}
+	if !allPetsFainted(user) {
+		t.Fatal("expected all fainted")
+	}
+	if r, _ := runAutoFight(nil, user); r != autoFightNoPet {
+		t.Fatalf("auto fight=%d", r)
+	}
+	user.Pets[1].HP = 1
+	if allPetsFainted(user) || allPetsFainted(&User{}) {
+		t.Fatal("a standing pet or an empty bag is not all fainted")
+	}
+}
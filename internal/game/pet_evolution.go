@@ -0,0 +1,108 @@
+package game
+
+const (
+	petEvolveOK uint32 = iota
+	petEvolveNoPet
+	petEvolveFinalForm
+	petEvolveLevelTooLow
+	petEvolveNeedItem
+)
+
+// petEvolution is the next step in a species' evolution chain.
+type petEvolution struct {
+	To        *PetBase
+	Level     int
+	Triggered bool
+	ItemID    int
+	ItemCount int
+}
+
+// getPetEvolution returns how petID evolves, or nil for a final form.
+// pets.xml mostly puts EvolvFlag/EvolvItem on the evolved form rather than
+// on the species that evolves, so both ends of the pair are consulted.
+func getPetEvolution(petID int) *petEvolution {
+	db := LoadPetDB()
+	from := db.pets[petID]
+	if from == nil || from.EvolvesTo <= 0 {
+		return nil
+	}
+	to := db.pets[from.EvolvesTo]
+	if to == nil {
+		return nil
+	}
+	evo := &petEvolution{To: to, Level: from.EvolvingLv}
+	for _, base := range []*PetBase{from, to} {
+		if base.EvolvFlag > 0 || base.EvolvItem > 0 {
+			evo.Triggered = true
+			evo.ItemID = base.EvolvItem
+			evo.ItemCount = maxInt(1, base.EvolvItemCount)
+			break
+		}
+	}
+	return evo
+}
+
+// autoEvolvePet applies every level-based evolution the pet now qualifies
+// for and returns the moves learned along the way.
+func autoEvolvePet(p *Pet) []int {
+	var learned []int
+	for i := 0; i < 8; i++ {
+		evo := getPetEvolution(int(p.ID))
+		if evo == nil || evo.Triggered || int(p.Level) < evo.Level {
+			break
+		}
+		learned = append(learned, evolvePet(p, evo.To)...)
+	}
+	return learned
+}
+
+// evolvePet switches p to species to, keeping HP at full if it was full and
+// at 0 if p has fainted, and fills empty skill slots with moves the new
+// species knows at p's level; the rest are kept as inactive moves.
+func evolvePet(p *Pet, to *PetBase) []int {
+	db := LoadPetDB()
+	from := db.pets[int(p.ID)]
+	oldSkills := normalizeSkillList(append([]int{}, p.Skills...), from, int(p.Level))
+	oldMax := getStats(from, int(p.Level), int(p.DV), p.EV).MaxHP
+	newMax := getStats(to, int(p.Level), int(p.DV), p.EV).MaxHP
+	p.ID = uint32(to.ID)
+	if p.HP > 0 && (p.HP >= oldMax || p.HP > newMax) {
+		p.HP = newMax
+	}
+	p.Skills = oldSkills
+	learned := diffSkills(getSkillsForLevel(to, int(p.Level)), knownSkills(p))
+	for _, sid := range learned {
+		addPetSkill(p, sid)
+	}
+	return learned
+}
+
+// triggerPetEvolution performs an EvolvFlag evolution requested by the
+// client, consuming the required items.
+func triggerPetEvolution(deps *Deps, user *User, p *Pet) (uint32, []int) {
+	if p == nil {
+		return petEvolveNoPet, nil
+	}
+	evo := getPetEvolution(int(p.ID))
+	if evo == nil {
+		return petEvolveFinalForm, nil
+	}
+	if int(p.Level) < evo.Level {
+		return petEvolveLevelTooLow, nil
+	}
+	if evo.ItemID > 0 {
+		info := user.Items[evo.ItemID]
+		if info == nil || info.Count < evo.ItemCount {
+			return petEvolveNeedItem, nil
+		}
+		info.Count -= evo.ItemCount
+		if info.Count <= 0 {
+			delete(user.Items, evo.ItemID)
+		}
+		upsertItem(deps, user, evo.ItemID)
+	}
+	learned := evolvePet(p, evo.To)
+	learned = append(learned, autoEvolvePet(p)...)
+	upsertPet(deps, user, *p)
+	return petEvolveOK, learned
+}
@@ -0,0 +1,65 @@
+package game
+
+import "testing"
+
+func seedEvolutionChain() {
+	db := LoadPetDB()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.pets[990001] = &PetBase{ID: 990001, Hp: 40, EvolvesTo: 990002, EvolvingLv: 16, Learnable: []LearnableMove{{ID: 10001, Level: 1}}}
+	db.pets[990002] = &PetBase{ID: 990002, Hp: 60, EvolvesTo: 990003, EvolvingLv: 32, Learnable: []LearnableMove{{ID: 10001, Level: 1}, {ID: 10002, Level: 16}}}
+	db.pets[990003] = &PetBase{ID: 990003, Hp: 80, EvolvFlag: 1, EvolvItem: 400050, EvolvItemCount: 2}
+}
+
+func TestAutoEvolvePetByLevel(t *testing.T) {
+	seedEvolutionChain()
+	p := &Pet{ID: 990001, Level: 15, Skills: []int{10001}}
+	if learned := autoEvolvePet(p); p.ID != 990001 || len(learned) != 0 {
+		t.Fatalf("evolved early: id=%d learned=%v", p.ID, learned)
+	}
+
+	p.Level = 40
+	p.HP = getStats(LoadPetDB().pets[990001], 40, 0, evSet{}).MaxHP
+	learned := autoEvolvePet(p)
+	if p.ID != 990002 {
+		t.Fatalf("id=%d, triggered evolution should not happen automatically", p.ID)
+	}
+	if len(learned) != 1 || learned[0] != 10002 || p.Skills[1] != 10002 {
+		t.Fatalf("learned=%v skills=%v", learned, p.Skills)
+	}
+	if max := getStats(LoadPetDB().pets[990002], 40, 0, evSet{}).MaxHP; p.HP != max {
+		t.Fatalf("hp=%d max=%d", p.HP, max)
+	}
+}
+
+func TestEvolvePetKeepsFaintedAtZeroHP(t *testing.T) {
+	seedEvolutionChain()
+	p := &Pet{ID: 990001, Level: 20, Skills: []int{10001}}
+	evolvePet(p, LoadPetDB().pets[990002])
+	if p.ID != 990002 || p.HP != 0 {
+		t.Fatalf("id=%d hp=%d", p.ID, p.HP)
+	}
+}
+
+func TestTriggerPetEvolutionConsumesItems(t *testing.T) {
+	seedEvolutionChain()
+	user := &User{Items: map[int]*ItemInfo{400050: {Count: 1}}}
+	p := &Pet{ID: 990002, Level: 20}
+	if result, _ := triggerPetEvolution(nil, user, p); result != petEvolveLevelTooLow {
+		t.Fatalf("result=%d", result)
+	}
+	p.Level = 32
+	if result, _ := triggerPetEvolution(nil, user, p); result != petEvolveNeedItem || p.ID != 990002 {
+		t.Fatalf("result=%d id=%d", result, p.ID)
+	}
+	user.Items[400050].Count = 3
+	if result, _ := triggerPetEvolution(nil, user, p); result != petEvolveOK || p.ID != 990003 {
+		t.Fatalf("result=%d id=%d", result, p.ID)
+	}
+	if user.Items[400050].Count != 1 {
+		t.Fatalf("items=%d", user.Items[400050].Count)
+	}
+	if result, _ := triggerPetEvolution(nil, user, p); result != petEvolveFinalForm {
+		t.Fatalf("result=%d", result)
+	}
+}
@@ -0,0 +1,393 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SoulBead is a bead left behind by a pet consumed in fusion. PetID is the
+// species it turns back into.
+type SoulBead struct {
+	ObtainTime uint32 `json:"obtainTime"`
+	ItemID     uint32 `json:"itemId"`
+	PetID      uint32 `json:"petId"`
+	DV         uint32 `json:"dv"`
+	Nature     uint32 `json:"nature"`
+}
+
+// soulBeadRecord is the persisted form of the player's beads, the bead
+// currently nurtured in the buffer and the fusion counter.
+type soulBeadRecord struct {
+	Beads     []SoulBead `json:"beads,omitempty"`
+	ObtainTm  uint32     `json:"obtainTm,omitempty"`
+	ItemID    uint32     `json:"itemId,omitempty"`
+	ExpireTm  uint32     `json:"expireTm,omitempty"`
+	FuseTimes uint32     `json:"fuseTimes,omitempty"`
+}
+
+func encodeSoulBeads(u *User) string {
+	data, err := json.Marshal(soulBeadRecord{
+		Beads:     u.SoulBeads,
+		ObtainTm:  u.ObtainTm,
+		ItemID:    u.SoulBeadItemID,
+		ExpireTm:  u.ExpireTm,
+		FuseTimes: u.FuseTimes,
+	})
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func applySoulBeads(u *User, raw string) {
+	var rec soulBeadRecord
+	if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+		return
+	}
+	u.SoulBeads = rec.Beads
+	u.ObtainTm = rec.ObtainTm
+	u.SoulBeadItemID = rec.ItemID
+	u.ExpireTm = rec.ExpireTm
+	u.FuseTimes = rec.FuseTimes
+}
+
+// pet-fusion.json: fusion recipes plus the soul bead each species leaves.
+type petFusionConfig struct {
+	Fusions   []*fusionRecipe   `json:"fusions"`
+	SoulBeads []*soulBeadConfig `json:"soulBeads"`
+}
+
+type fusionRecipe struct {
+	MainPetID   int              `json:"mainPetId"`
+	SubPetID    int              `json:"subPetId"`
+	ResultPetID int              `json:"resultPetId"`
+	MinLevel    int              `json:"minLevel"`
+	Level       int              `json:"level"`
+	SuccessRate int              `json:"successRate"`
+	DVBonus     int              `json:"dvBonus"`
+	Materials   []fusionMaterial `json:"materials"`
+}
+
+type fusionMaterial struct {
+	ItemID int `json:"itemId"`
+	Count  int `json:"count"`
+}
+
+type soulBeadConfig struct {
+	PetID           int `json:"petId"`
+	ItemID          int `json:"itemId"`
+	MatureSeconds   int `json:"matureSeconds"`
+	TransformItemID int `json:"transformItemId"`
+	TransformPetID  int `json:"transformPetId"`
+	Level           int `json:"level"`
+}
+
+const petFusionConfigFile = "pet-fusion.json"
+
+const (
+	fusionOK uint32 = iota
+	fusionFailed
+	fusionNoPet
+	fusionSamePet
+	fusionNoRecipe
+	fusionLevelTooLow
+	fusionNeedItem
+	fusionInFight
+)
+
+const (
+	soulBeadOK uint32 = iota
+	soulBeadNotFound
+	soulBeadBusy
+	soulBeadNotMature
+	soulBeadNoTransform
+)
+
+const (
+	soulBeadIdle uint32 = iota
+	soulBeadMaturing
+	soulBeadMature
+)
+
+func loadPetFusionConfig(deps *Deps) petFusionConfig {
+	var cfg petFusionConfig
+	readStoreConfigJSON(deps, petFusionConfigFile, &cfg)
+	return cfg
+}
+
+func (c petFusionConfig) recipe(mainID, subID uint32) *fusionRecipe {
+	for _, r := range c.Fusions {
+		if r != nil && r.MainPetID == int(mainID) && r.SubPetID == int(subID) && r.ResultPetID > 0 {
+			return r
+		}
+	}
+	return nil
+}
+
+func (c petFusionConfig) beadForPet(petID uint32) *soulBeadConfig {
+	for _, b := range c.SoulBeads {
+		if b != nil && b.PetID == int(petID) && b.ItemID > 0 {
+			return b
+		}
+	}
+	return nil
+}
+
+func (c petFusionConfig) beadForItem(itemID uint32) *soulBeadConfig {
+	for _, b := range c.SoulBeads {
+		if b != nil && b.ItemID == int(itemID) {
+			return b
+		}
+	}
+	for _, b := range c.SoulBeads {
+		if b != nil && b.TransformItemID == int(itemID) {
+			return b
+		}
+	}
+	return nil
+}
+
+func logPetFusion(deps *Deps, msg string, fields ...zap.Field) {
+	if deps == nil || deps.Logger == nil {
+		return
+	}
+	deps.Logger.Info(msg, fields...)
+}
+
+// removeBagPet drops a bag pet from the user and from storage, moving the
+// current pet to the first remaining one if needed.
+func removeBagPet(deps *Deps, user *User, catchTime uint32) {
+	for i := range user.Pets {
+		if user.Pets[i].CatchTime == catchTime {
+			user.Pets = append(user.Pets[:i], user.Pets[i+1:]...)
+			break
+		}
+	}
+	if deps != nil && deps.Store != nil && user.PlayerID != 0 {
+		_ = deps.Store.DeletePet(context.Background(), user.PlayerID, int64(catchTime))
+	}
+	if user.CatchID == catchTime && len(user.Pets) > 0 {
+		first := user.Pets[0]
+		user.CatchID = first.CatchTime
+		user.CurrentPetID = first.ID
+		user.PetDV = first.DV
+	}
+}
+
+func hasFusionMaterials(user *User, materials []fusionMaterial) bool {
+	for _, m := range materials {
+		info := user.Items[m.ItemID]
+		if info == nil || info.Count < maxInt(1, m.Count) {
+			return false
+		}
+	}
+	return true
+}
+
+func consumeFusionMaterials(deps *Deps, user *User, materials []fusionMaterial) {
+	for _, m := range materials {
+		info := user.Items[m.ItemID]
+		info.Count -= maxInt(1, m.Count)
+		if info.Count <= 0 {
+			delete(user.Items, m.ItemID)
+		}
+		upsertItem(deps, user, m.ItemID)
+	}
+}
+
+// fusePets fuses the sub pet into the main pet following the matching
+// recipe. Materials are spent on every attempt; on success the main pet
+// becomes the result species (keeping its catch time), the sub pet is
+// consumed and, if its species has one, leaves a soul bead behind.
+func fusePets(deps *Deps, user *User, mainCatch, subCatch uint32, now time.Time) (uint32, *Pet) {
+	if mainCatch == subCatch {
+		return fusionSamePet, nil
+	}
+	main := findPetByCatchTime(user, mainCatch)
+	sub := findPetByCatchTime(user, subCatch)
+	if main == nil || sub == nil {
+		return fusionNoPet, nil
+	}
+	if user.Fight != nil {
+		return fusionInFight, nil
+	}
+	cfg := loadPetFusionConfig(deps)
+	recipe := cfg.recipe(main.ID, sub.ID)
+	if recipe == nil {
+		return fusionNoRecipe, nil
+	}
+	if int(main.Level) < recipe.MinLevel || int(sub.Level) < recipe.MinLevel {
+		return fusionLevelTooLow, nil
+	}
+	if !hasFusionMaterials(user, recipe.Materials) {
+		return fusionNeedItem, nil
+	}
+	consumeFusionMaterials(deps, user, recipe.Materials)
+	user.FuseTimes++
+	if rand.Intn(100) >= recipe.SuccessRate {
+		savePlayer(deps, user.ID, user)
+		logPetFusion(deps, "pet_fusion_failed", zap.Uint32("uid", user.ID),
+			zap.Uint32("main_pet", main.ID), zap.Uint32("sub_pet", sub.ID))
+		return fusionFailed, nil
+	}
+
+	subPet := *sub
+	dv := minInt(31, int(main.DV+sub.DV)/2+rand.Intn(maxInt(0, recipe.DVBonus)+1))
+	level := maxInt(1, recipe.Level)
+	result := LoadPetDB().pets[recipe.ResultPetID]
+	oldID := main.ID
+	main.ID = uint32(recipe.ResultPetID)
+	main.Level = uint32(level)
+	main.Exp = 0
+	main.DV = uint32(dv)
+	main.Skills = getSkillsForLevel(result, level)
+	main.HP = getStats(result, level, dv, main.EV).MaxHP
+	fused := *main
+	upsertPet(deps, user, fused)
+	removeBagPet(deps, user, subCatch)
+	if bead := cfg.beadForPet(subPet.ID); bead != nil {
+		user.SoulBeads = append(user.SoulBeads, SoulBead{
+			ObtainTime: nextSoulBeadTime(user, now),
+			ItemID:     uint32(bead.ItemID),
+			PetID:      subPet.ID,
+			DV:         subPet.DV,
+			Nature:     subPet.Nature,
+		})
+	}
+	if user.CatchID == mainCatch {
+		user.CurrentPetID = fused.ID
+		user.PetDV = fused.DV
+	}
+	savePlayer(deps, user.ID, user)
+	logPetFusion(deps, "pet_fusion", zap.Uint32("uid", user.ID), zap.Uint32("main_pet", oldID),
+		zap.Uint32("sub_pet", subPet.ID), zap.Uint32("result_pet", fused.ID), zap.Uint32("dv", fused.DV))
+	return fusionOK, &fused
+}
+
+func nextSoulBeadTime(user *User, now time.Time) uint32 {
+	t := uint32(now.Unix())
+	for findSoulBead(user, t) >= 0 {
+		t++
+	}
+	return t
+}
+
+func findSoulBead(user *User, obtainTime uint32) int {
+	for i := range user.SoulBeads {
+		if user.SoulBeads[i].ObtainTime == obtainTime {
+			return i
+		}
+	}
+	return -1
+}
+
+// soulBeadStatus reports whether a bead is idle, nurturing in the buffer or
+// mature, with the seconds left until it matures.
+func soulBeadStatus(user *User, obtainTime uint32, now time.Time) (uint32, uint32) {
+	if user.SoulBeadItemID == 0 || user.ObtainTm != obtainTime {
+		return soulBeadIdle, 0
+	}
+	cur := uint32(now.Unix())
+	if cur >= user.ExpireTm {
+		return soulBeadMature, 0
+	}
+	return soulBeadMaturing, user.ExpireTm - cur
+}
+
+// setSoulBeadBuf starts nurturing a bead. Only one bead fits in the buffer.
+func setSoulBeadBuf(deps *Deps, user *User, obtainTime uint32, now time.Time) uint32 {
+	idx := findSoulBead(user, obtainTime)
+	if idx < 0 {
+		return soulBeadNotFound
+	}
+	if user.SoulBeadItemID != 0 && user.ObtainTm != obtainTime {
+		return soulBeadBusy
+	}
+	bead := user.SoulBeads[idx]
+	seconds := 0
+	if cfg := loadPetFusionConfig(deps).beadForItem(bead.ItemID); cfg != nil {
+		seconds = maxInt(0, cfg.MatureSeconds)
+	}
+	user.ObtainTm = bead.ObtainTime
+	user.SoulBeadItemID = bead.ItemID
+	user.ExpireTm = uint32(now.Unix()) + uint32(seconds)
+	savePlayer(deps, user.ID, user)
+	return soulBeadOK
+}
+
+func clearSoulBeadBuf(user *User, obtainTime uint32) {
+	if user.ObtainTm == obtainTime {
+		user.ObtainTm = 0
+		user.SoulBeadItemID = 0
+		user.ExpireTm = 0
+	}
+}
+
+// transformSoulBead upgrades a mature buffered bead into its transformed
+// bead and species.
+func transformSoulBead(deps *Deps, user *User, obtainTime uint32, now time.Time) uint32 {
+	idx := findSoulBead(user, obtainTime)
+	if idx < 0 {
+		return soulBeadNotFound
+	}
+	if status, _ := soulBeadStatus(user, obtainTime, now); status != soulBeadMature {
+		return soulBeadNotMature
+	}
+	bead := &user.SoulBeads[idx]
+	cfg := loadPetFusionConfig(deps).beadForItem(bead.ItemID)
+	if cfg == nil || cfg.ItemID != int(bead.ItemID) || cfg.TransformItemID <= 0 {
+		return soulBeadNoTransform
+	}
+	oldItem := bead.ItemID
+	bead.ItemID = uint32(cfg.TransformItemID)
+	if cfg.TransformPetID > 0 {
+		bead.PetID = uint32(cfg.TransformPetID)
+	}
+	clearSoulBeadBuf(user, obtainTime)
+	savePlayer(deps, user.ID, user)
+	logPetFusion(deps, "soul_bead_transform", zap.Uint32("uid", user.ID),
+		zap.Uint32("from_item", oldItem), zap.Uint32("to_item", bead.ItemID))
+	return soulBeadOK
+}
+
+// soulBeadToPet turns a bead back into a pet with the DV and nature it was
+// made from. A bead still nurturing in the buffer cannot be used.
+func soulBeadToPet(deps *Deps, user *User, obtainTime uint32, now time.Time) (uint32, *Pet) {
+	idx := findSoulBead(user, obtainTime)
+	if idx < 0 {
+		return soulBeadNotFound, nil
+	}
+	if status, _ := soulBeadStatus(user, obtainTime, now); status == soulBeadMaturing {
+		return soulBeadNotMature, nil
+	}
+	bead := user.SoulBeads[idx]
+	level := 1
+	if cfg := loadPetFusionConfig(deps).beadForItem(bead.ItemID); cfg != nil {
+		level = maxInt(1, cfg.Level)
+	}
+	base := LoadPetDB().pets[int(bead.PetID)]
+	catchTime := uint32(now.Unix())
+	for findPetByCatchTime(user, catchTime) != nil || findWarehousePet(user, catchTime) >= 0 {
+		catchTime++
+	}
+	pet := Pet{
+		ID:        bead.PetID,
+		CatchTime: catchTime,
+		Level:     uint32(level),
+		DV:        bead.DV,
+		Nature:    bead.Nature,
+		HP:        getStats(base, level, int(bead.DV), evSet{}).MaxHP,
+		Skills:    getSkillsForLevel(base, level),
+	}
+	user.SoulBeads = append(user.SoulBeads[:idx], user.SoulBeads[idx+1:]...)
+	clearSoulBeadBuf(user, obtainTime)
+	addCapturedPet(deps, user, pet)
+	savePlayer(deps, user.ID, user)
+	logPetFusion(deps, "soul_bead_to_pet", zap.Uint32("uid", user.ID),
+		zap.Uint32("item_id", bead.ItemID), zap.Uint32("pet_id", pet.ID))
+	return soulBeadOK, &pet
+}
@@ -0,0 +1,125 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+const testFusionConfig = `{
+  "fusions": [{"mainPetId": 3, "subPetId": 6, "resultPetId": 91, "minLevel": 40,
+    "successRate": 100, "dvBonus": 0, "materials": [{"itemId": 300044, "count": 1}]},
+    {"mainPetId": 1, "subPetId": 4, "resultPetId": 91, "successRate": 0}],
+  "soulBeads": [{"petId": 6, "itemId": 1700006, "matureSeconds": 60,
+    "transformItemId": 1700106, "transformPetId": 5, "level": 2}]
+}`
+
+func newFusionTestUser() *User {
+	return &User{
+		Pets: []Pet{
+			{ID: 3, CatchTime: 100, Level: 40, DV: 20, Nature: 4},
+			{ID: 6, CatchTime: 101, Level: 45, DV: 30, Nature: 7},
+			{ID: 1, CatchTime: 102, Level: 10},
+			{ID: 4, CatchTime: 103, Level: 10},
+		},
+		CatchID: 101,
+		Items:   map[int]*ItemInfo{300044: {Count: 1}},
+	}
+}
+
+func TestFusePetsConsumesSubAndLeavesBead(t *testing.T) {
+	withConfigFile(t, petFusionConfigFile, testFusionConfig)
+	user := newFusionTestUser()
+	now := time.Unix(5000, 0)
+
+	if r, _ := fusePets(nil, user, 100, 100, now); r != fusionSamePet {
+		t.Fatalf("same pet=%d", r)
+	}
+	if r, _ := fusePets(nil, user, 101, 100, now); r != fusionNoRecipe {
+		t.Fatalf("reversed=%d", r)
+	}
+	r, pet := fusePets(nil, user, 100, 101, now)
+	if r != fusionOK || pet == nil || pet.ID != 91 || pet.CatchTime != 100 || pet.DV != 25 || pet.Nature != 4 {
+		t.Fatalf("fuse=%d pet=%+v", r, pet)
+	}
+	if len(user.Pets) != 3 || findPetByCatchTime(user, 101) != nil {
+		t.Fatalf("sub pet not consumed: %v", user.Pets)
+	}
+	if user.Items[300044] != nil || user.FuseTimes != 1 {
+		t.Fatalf("items=%v fuseTimes=%d", user.Items, user.FuseTimes)
+	}
+	if user.CatchID != 100 {
+		t.Fatalf("current pet=%d", user.CatchID)
+	}
+	if len(user.SoulBeads) != 1 || user.SoulBeads[0].ItemID != 1700006 || user.SoulBeads[0].DV != 30 {
+		t.Fatalf("beads=%+v", user.SoulBeads)
+	}
+}
+
+func TestFusePetsFailureKeepsPets(t *testing.T) {
+	withConfigFile(t, petFusionConfigFile, testFusionConfig)
+	user := newFusionTestUser()
+	if r, _ := fusePets(nil, user, 102, 103, time.Now()); r != fusionFailed {
+		t.Fatalf("fuse=%d", r)
+	}
+	if len(user.Pets) != 4 || user.FuseTimes != 1 {
+		t.Fatalf("pets=%d fuseTimes=%d", len(user.Pets), user.FuseTimes)
+	}
+	user.Items = nil
+	if r, _ := fusePets(nil, user, 100, 101, time.Now()); r != fusionNeedItem {
+		t.Fatalf("no materials=%d", r)
+	}
+}
+
+func TestSoulBeadLifecycle(t *testing.T) {
+	withConfigFile(t, petFusionConfigFile, testFusionConfig)
+	now := time.Unix(5000, 0)
+	user := &User{SoulBeads: []SoulBead{
+		{ObtainTime: 10, ItemID: 1700006, PetID: 6, DV: 30, Nature: 7},
+		{ObtainTime: 11, ItemID: 1700006, PetID: 6, DV: 12},
+	}}
+
+	if r := setSoulBeadBuf(nil, user, 10, now); r != soulBeadOK {
+		t.Fatalf("set buf=%d", r)
+	}
+	if r := setSoulBeadBuf(nil, user, 11, now); r != soulBeadBusy {
+		t.Fatalf("second buf=%d", r)
+	}
+	if status, left := soulBeadStatus(user, 10, now.Add(20*time.Second)); status != soulBeadMaturing || left != 40 {
+		t.Fatalf("status=%d left=%d", status, left)
+	}
+	if r := transformSoulBead(nil, user, 10, now); r != soulBeadNotMature {
+		t.Fatalf("early transform=%d", r)
+	}
+	if r, _ := soulBeadToPet(nil, user, 10, now); r != soulBeadNotMature {
+		t.Fatalf("early to pet=%d", r)
+	}
+	if r := transformSoulBead(nil, user, 10, now.Add(time.Minute)); r != soulBeadOK {
+		t.Fatalf("transform=%d", r)
+	}
+	if user.SoulBeads[0].ItemID != 1700106 || user.SoulBeads[0].PetID != 5 || user.SoulBeadItemID != 0 {
+		t.Fatalf("bead=%+v buf=%d", user.SoulBeads[0], user.SoulBeadItemID)
+	}
+
+	r, pet := soulBeadToPet(nil, user, 10, now.Add(time.Minute))
+	if r != soulBeadOK || pet == nil || pet.ID != 5 || pet.DV != 30 || pet.Nature != 7 || pet.Level != 2 {
+		t.Fatalf("to pet=%d pet=%+v", r, pet)
+	}
+	if len(user.SoulBeads) != 1 || len(user.Pets) != 1 {
+		t.Fatalf("beads=%v pets=%v", user.SoulBeads, user.Pets)
+	}
+}
+
+func TestSoulBeadRecordRoundTrip(t *testing.T) {
+	user := &User{
+		SoulBeads:      []SoulBead{{ObtainTime: 1, ItemID: 2, PetID: 3}},
+		ObtainTm:       1,
+		SoulBeadItemID: 2,
+		ExpireTm:       99,
+		FuseTimes:      4,
+	}
+	out := &User{}
+	applySoulBeads(out, encodeSoulBeads(user))
+	if len(out.SoulBeads) != 1 || out.ExpireTm != 99 || out.FuseTimes != 4 || out.SoulBeadItemID != 2 {
+		t.Fatalf("round trip=%+v", out)
+	}
+}
@@ -0,0 +1,160 @@
+package game
+
+import (
+	"encoding/json"
+	"math/rand"
+	"time"
+)
+
+// IncubatorInfo is the per-player egg incubator, persisted on the player row.
+type IncubatorInfo struct {
+	EggItemID uint32 `json:"eggItemId,omitempty"`
+	StartedAt uint32 `json:"startedAt,omitempty"`
+	HatchAt   uint32 `json:"hatchAt,omitempty"`
+	LastEggID uint32 `json:"lastEggId,omitempty"`
+	LastPetID uint32 `json:"lastPetId,omitempty"`
+}
+
+func encodeIncubator(in IncubatorInfo) string {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func decodeIncubator(raw string) IncubatorInfo {
+	var in IncubatorInfo
+	_ = json.Unmarshal([]byte(raw), &in)
+	return in
+}
+
+// eggHatchEntry maps an egg item to its incubation time and the weighted
+// species it can hatch into (egg-hatch.json).
+type eggHatchEntry struct {
+	ItemID       int           `json:"itemId"`
+	HatchSeconds int           `json:"hatchSeconds"`
+	Level        int           `json:"level"`
+	Pets         []eggHatchPet `json:"pets"`
+}
+
+type eggHatchPet struct {
+	PetID  int `json:"petId"`
+	Weight int `json:"weight"`
+}
+
+type eggHatchFile struct {
+	Eggs []*eggHatchEntry `json:"eggs"`
+}
+
+const eggHatchConfig = "egg-hatch.json"
+
+const (
+	incubateOK uint32 = iota
+	incubateBusy
+	incubateNotEgg
+	incubateNoItem
+	incubateEmpty
+	incubateNotReady
+)
+
+func getEggHatchEntry(deps *Deps, itemID uint32) *eggHatchEntry {
+	if itemID == 0 {
+		return nil
+	}
+	var cfg eggHatchFile
+	if _, ok := readStoreConfigJSON(deps, eggHatchConfig, &cfg); !ok {
+		return nil
+	}
+	for _, egg := range cfg.Eggs {
+		if egg != nil && egg.ItemID == int(itemID) && len(egg.Pets) > 0 {
+			return egg
+		}
+	}
+	return nil
+}
+
+func drawEggSpecies(egg *eggHatchEntry) int {
+	total := 0
+	for _, p := range egg.Pets {
+		total += maxInt(0, p.Weight)
+	}
+	if total == 0 {
+		return egg.Pets[rand.Intn(len(egg.Pets))].PetID
+	}
+	roll := rand.Intn(total)
+	for _, p := range egg.Pets {
+		roll -= maxInt(0, p.Weight)
+		if roll < 0 {
+			return p.PetID
+		}
+	}
+	return egg.Pets[len(egg.Pets)-1].PetID
+}
+
+// startIncubation consumes one egg item and starts its timer.
+func startIncubation(deps *Deps, user *User, itemID uint32, now time.Time) uint32 {
+	if user.Incubator.EggItemID != 0 {
+		return incubateBusy
+	}
+	egg := getEggHatchEntry(deps, itemID)
+	if egg == nil {
+		return incubateNotEgg
+	}
+	info := user.Items[int(itemID)]
+	if info == nil || info.Count <= 0 {
+		return incubateNoItem
+	}
+	info.Count--
+	if info.Count <= 0 {
+		delete(user.Items, int(itemID))
+	}
+	upsertItem(deps, user, int(itemID))
+	start := uint32(now.Unix())
+	user.Incubator.EggItemID = itemID
+	user.Incubator.StartedAt = start
+	user.Incubator.HatchAt = start + uint32(maxInt(0, egg.HatchSeconds))
+	savePlayer(deps, user.ID, user)
+	return incubateOK
+}
+
+func incubationRemaining(user *User, now time.Time) uint32 {
+	cur := uint32(now.Unix())
+	if user.Incubator.EggItemID == 0 || cur >= user.Incubator.HatchAt {
+		return 0
+	}
+	return user.Incubator.HatchAt - cur
+}
+
+// collectHatchedPet hatches a finished egg into a new pet routed to the bag
+// or warehouse.
+func collectHatchedPet(deps *Deps, user *User, now time.Time) (uint32, *Pet) {
+	if user.Incubator.EggItemID == 0 {
+		return incubateEmpty, nil
+	}
+	if incubationRemaining(user, now) > 0 {
+		return incubateNotReady, nil
+	}
+	egg := getEggHatchEntry(deps, user.Incubator.EggItemID)
+	if egg == nil {
+		return incubateNotEgg, nil
+	}
+	inst := createStarterPet(drawEggSpecies(egg), maxInt(1, egg.Level))
+	catchTime := uint32(now.Unix())
+	for findPetByCatchTime(user, catchTime) != nil || findWarehousePet(user, catchTime) >= 0 {
+		catchTime++
+	}
+	pet := Pet{
+		ID:        uint32(inst.ID),
+		CatchTime: catchTime,
+		Level:     uint32(inst.Level),
+		DV:        uint32(inst.DV),
+		Nature:    uint32(inst.Nature),
+		HP:        inst.MaxHP,
+		Skills:    inst.Skills,
+	}
+	addCapturedPet(deps, user, pet)
+	user.Incubator = IncubatorInfo{LastEggID: user.Incubator.EggItemID, LastPetID: pet.ID}
+	savePlayer(deps, user.ID, user)
+	return incubateOK, &pet
+}
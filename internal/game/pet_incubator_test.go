@@ -0,0 +1,61 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIncubateAndHatchEgg(t *testing.T) {
+	withConfigFile(t, eggHatchConfig, `{"eggs":[{"itemId":300422,"hatchSeconds":60,"level":3,"pets":[{"petId":7,"weight":1}]}]}`)
+	user := &User{Items: map[int]*ItemInfo{300422: {Count: 1}}}
+	now := time.Unix(1000, 0)
+
+	if r := startIncubation(nil, user, 400066, now); r != incubateNotEgg {
+		t.Fatalf("unknown egg=%d", r)
+	}
+	if r := startIncubation(nil, user, 300422, now); r != incubateOK {
+		t.Fatalf("start=%d", r)
+	}
+	if user.Items[300422] != nil {
+		t.Fatal("egg item not consumed")
+	}
+	if r := startIncubation(nil, user, 300422, now); r != incubateBusy {
+		t.Fatalf("second egg=%d", r)
+	}
+	if left := incubationRemaining(user, now.Add(20*time.Second)); left != 40 {
+		t.Fatalf("remaining=%d", left)
+	}
+	if r, _ := collectHatchedPet(nil, user, now.Add(30*time.Second)); r != incubateNotReady {
+		t.Fatalf("early collect=%d", r)
+	}
+
+	r, pet := collectHatchedPet(nil, user, now.Add(time.Minute))
+	if r != incubateOK || pet == nil || pet.ID != 7 || pet.Level != 3 {
+		t.Fatalf("collect=%d pet=%+v", r, pet)
+	}
+	if len(user.Pets) != 1 || user.Incubator.EggItemID != 0 || user.Incubator.LastPetID != 7 {
+		t.Fatalf("pets=%v incubator=%+v", user.Pets, user.Incubator)
+	}
+	if r, _ := collectHatchedPet(nil, user, now.Add(time.Minute)); r != incubateEmpty {
+		t.Fatalf("empty collect=%d", r)
+	}
+}
+
+func TestIncubatorRoundTrip(t *testing.T) {
+	in := IncubatorInfo{EggItemID: 300422, StartedAt: 10, HatchAt: 70}
+	if out := decodeIncubator(encodeIncubator(in)); out != in {
+		t.Fatalf("round trip=%+v", out)
+	}
+	if out := decodeIncubator("{}"); out != (IncubatorInfo{}) {
+		t.Fatalf("empty=%+v", out)
+	}
+}
+
+func TestDrawEggSpeciesSkipsZeroWeight(t *testing.T) {
+	egg := &eggHatchEntry{Pets: []eggHatchPet{{PetID: 1, Weight: 0}, {PetID: 4, Weight: 5}}}
+	for i := 0; i < 50; i++ {
+		if id := drawEggSpecies(egg); id != 4 {
+			t.Fatalf("drew %d", id)
+		}
+	}
+}
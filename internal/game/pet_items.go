@@ -0,0 +1,250 @@
+package game
+
+import (
+	"encoding/json"
+
+	"jseer/internal/gateway"
+)
+
+// itemBuffRecord persists the charge counters that out-of-fight items add
+// to the player.
+type itemBuffRecord struct {
+	TwoTimes       uint32 `json:"twoTimes,omitempty"`
+	ThreeTimes     uint32 `json:"threeTimes,omitempty"`
+	AutoFight      uint32 `json:"autoFight,omitempty"`
+	AutoFightTimes uint32 `json:"autoFightTimes,omitempty"`
+	EnergyTimes    uint32 `json:"energyTimes,omitempty"`
+	LearnTimes     uint32 `json:"learnTimes,omitempty"`
+}
+
+func encodeItemBuffs(u *User) string {
+	data, err := json.Marshal(itemBuffRecord{
+		TwoTimes:       u.TwoTimes,
+		ThreeTimes:     u.ThreeTimes,
+		AutoFight:      u.AutoFight,
+		AutoFightTimes: u.AutoFightTimes,
+		EnergyTimes:    u.EnergyTimes,
+		LearnTimes:     u.LearnTimes,
+	})
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func applyItemBuffs(u *User, raw string) {
+	var rec itemBuffRecord
+	if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+		return
+	}
+	u.TwoTimes = rec.TwoTimes
+	u.ThreeTimes = rec.ThreeTimes
+	u.AutoFight = rec.AutoFight
+	u.AutoFightTimes = rec.AutoFightTimes
+	u.EnergyTimes = rec.EnergyTimes
+	u.LearnTimes = rec.LearnTimes
+}
+
+// pet-items.json lists the effects each usable item applies. Pet effects
+// need a target pet; the rest charge counters on the player.
+type petItemConfig struct {
+	ItemID  int             `json:"itemId"`
+	Effects []petItemEffect `json:"effects"`
+}
+
+type petItemEffect struct {
+	Type  string `json:"type"`
+	Value int    `json:"value"`
+	Stat  string `json:"stat"`
+}
+
+type petItemFile struct {
+	Items []*petItemConfig `json:"items"`
+}
+
+const petItemConfigFile = "pet-items.json"
+
+const (
+	itemEffectHeal      = "heal"
+	itemEffectRestorePP = "restorePp"
+	itemEffectExp       = "exp"
+	itemEffectResetEV   = "resetEv"
+	itemEffectDoubleExp = "doubleExp"
+	itemEffectTripleExp = "tripleExp"
+	itemEffectAutoFight = "autoFight"
+	itemEffectEnergy    = "energy"
+)
+
+const (
+	itemUseOK uint32 = iota
+	itemUseNoItem
+	itemUseNotUsable
+	itemUseNoPet
+	itemUseInFight
+	itemUseNoEffect
+)
+
+func getPetItem(deps *Deps, itemID uint32) *petItemConfig {
+	var cfg petItemFile
+	if _, ok := readStoreConfigJSON(deps, petItemConfigFile, &cfg); !ok {
+		return nil
+	}
+	for _, it := range cfg.Items {
+		if it != nil && it.ItemID == int(itemID) && len(it.Effects) > 0 {
+			return it
+		}
+	}
+	return nil
+}
+
+func isPetItemEffect(kind string) bool {
+	switch kind {
+	case itemEffectHeal, itemEffectRestorePP, itemEffectExp, itemEffectResetEV:
+		return true
+	}
+	return false
+}
+
+// usePetItem applies every effect of itemID and consumes one item. Nothing
+// is consumed when no effect changes anything (e.g. healing a full-HP pet).
+func usePetItem(ctx *gateway.Context, deps *Deps, user *User, p *Pet, itemID uint32) uint32 {
+	info := user.Items[int(itemID)]
+	if info == nil || info.Count <= 0 {
+		return itemUseNoItem
+	}
+	cfg := getPetItem(deps, itemID)
+	if cfg == nil {
+		return itemUseNotUsable
+	}
+	for _, eff := range cfg.Effects {
+		if !isPetItemEffect(eff.Type) {
+			continue
+		}
+		if p == nil {
+			return itemUseNoPet
+		}
+		if user.Fight != nil {
+			return itemUseInFight
+		}
+	}
+	petChanged, userChanged := false, false
+	for _, eff := range cfg.Effects {
+		switch eff.Type {
+		case itemEffectHeal:
+			petChanged = healPet(p, eff.Value) || petChanged
+		case itemEffectRestorePP:
+			petChanged = restorePetPP(p, eff.Value) || petChanged
+		case itemEffectExp:
+			if eff.Value > 0 && p.Level < petLevelCap {
+				applyPetExp(ctx, deps, user, p, eff.Value)
+				petChanged = true
+			}
+		case itemEffectResetEV:
+			petChanged = resetPetEV(p, eff.Stat) || petChanged
+		case itemEffectDoubleExp:
+			user.TwoTimes += uint32(maxInt(0, eff.Value))
+			userChanged = true
+		case itemEffectTripleExp:
+			user.ThreeTimes += uint32(maxInt(0, eff.Value))
+			userChanged = true
+		case itemEffectAutoFight:
+			user.AutoFightTimes += uint32(maxInt(0, eff.Value))
+			userChanged = true
+		case itemEffectEnergy:
+			user.EnergyTimes += uint32(maxInt(0, eff.Value))
+			userChanged = true
+		}
+	}
+	if !petChanged && !userChanged {
+		return itemUseNoEffect
+	}
+	info.Count--
+	if info.Count <= 0 {
+		delete(user.Items, int(itemID))
+	}
+	upsertItem(deps, user, int(itemID))
+	if petChanged {
+		upsertPet(deps, user, *p)
+		if ctx != nil {
+			sendNoteUpdateProp(ctx, user, p.CatchTime)
+		}
+	}
+	if userChanged {
+		savePlayer(deps, user.ID, user)
+	}
+	return itemUseOK
+}
+
+// healPet restores amount HP, or all of it when amount is 0.
+func healPet(p *Pet, amount int) bool {
+	maxHP := petMaxHP(p)
+	if p.HP >= maxHP {
+		return false
+	}
+	if amount <= 0 {
+		p.HP = maxHP
+	} else {
+		p.HP = minInt(maxHP, p.HP+amount)
+	}
+	return true
+}
+
+// restorePetPP gives every skill amount PP back, or refills it when amount
+// is 0.
+func restorePetPP(p *Pet, amount int) bool {
+	if len(p.SkillPP) == 0 {
+		return false
+	}
+	for sid, pp := range p.SkillPP {
+		maxPP := getSkillPP(sid)
+		if amount <= 0 || pp+amount >= maxPP {
+			delete(p.SkillPP, sid)
+		} else {
+			p.SkillPP[sid] = pp + amount
+		}
+	}
+	return true
+}
+
+// resetPetEV clears one EV stat, or all of them when stat is empty, and
+// caps HP at the lower max HP.
+func resetPetEV(p *Pet, stat string) bool {
+	old := p.EV
+	switch stat {
+	case "":
+		p.EV = evSet{}
+	case "hp":
+		p.EV.HP = 0
+	case "atk":
+		p.EV.Atk = 0
+	case "def":
+		p.EV.Def = 0
+	case "spa":
+		p.EV.SpA = 0
+	case "spd":
+		p.EV.SpD = 0
+	case "spe":
+		p.EV.Spd = 0
+	}
+	if p.EV == old {
+		return false
+	}
+	p.HP = minInt(p.HP, petMaxHP(p))
+	return true
+}
+
+// boostFightExp applies and spends one triple- or double-exp charge.
+func boostFightExp(deps *Deps, user *User, exp int) int {
+	switch {
+	case user.ThreeTimes > 0:
+		user.ThreeTimes--
+		exp *= 3
+	case user.TwoTimes > 0:
+		user.TwoTimes--
+		exp *= 2
+	default:
+		return exp
+	}
+	savePlayer(deps, user.ID, user)
+	return exp
+}
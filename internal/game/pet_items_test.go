@@ -0,0 +1,81 @@
+package game
+
+import "testing"
+
+const testPetItemConfig = `{"items":[
+  {"itemId":300011,"effects":[{"type":"heal","value":5}]},
+  {"itemId":300019,"effects":[{"type":"restorePp"}]},
+  {"itemId":300027,"effects":[{"type":"doubleExp","value":2}]},
+  {"itemId":300037,"effects":[{"type":"resetEv","stat":"atk"}]},
+  {"itemId":300099,"effects":[{"type":"exp","value":8}]}
+]}`
+
+func TestUsePetItemHealsAndConsumes(t *testing.T) {
+	withConfigFile(t, petItemConfigFile, testPetItemConfig)
+	// No species data: getStats falls back to MaxHP 20.
+	user := &User{Items: map[int]*ItemInfo{300011: {Count: 2}}}
+	p := &Pet{ID: 990401, Level: 5, HP: 12}
+	if r := usePetItem(nil, nil, user, p, 300011); r != itemUseOK || p.HP != 17 {
+		t.Fatalf("heal=%d hp=%d", r, p.HP)
+	}
+	if r := usePetItem(nil, nil, user, p, 300011); r != itemUseOK || p.HP != 20 || user.Items[300011] != nil {
+		t.Fatalf("heal capped=%d hp=%d items=%v", r, p.HP, user.Items)
+	}
+	user.Items[300011] = &ItemInfo{Count: 1}
+	if r := usePetItem(nil, nil, user, p, 300011); r != itemUseNoEffect || user.Items[300011].Count != 1 {
+		t.Fatalf("full hp=%d", r)
+	}
+	if r := usePetItem(nil, nil, user, nil, 300011); r != itemUseNoPet {
+		t.Fatalf("no pet=%d", r)
+	}
+	user.Fight = &FightState{}
+	if r := usePetItem(nil, nil, user, p, 300011); r != itemUseInFight {
+		t.Fatalf("in fight=%d", r)
+	}
+}
+
+func TestUsePetItemRestoresPPAndResetsEV(t *testing.T) {
+	withConfigFile(t, petItemConfigFile, testPetItemConfig)
+	user := &User{Items: map[int]*ItemInfo{300019: {Count: 1}, 300037: {Count: 1}}}
+	p := &Pet{ID: 990401, Level: 5, SkillPP: map[int]int{10001: 3}, EV: evSet{Atk: 40, Def: 8}}
+	if r := usePetItem(nil, nil, user, p, 300019); r != itemUseOK || len(p.SkillPP) != 0 {
+		t.Fatalf("pp=%d %v", r, p.SkillPP)
+	}
+	if r := usePetItem(nil, nil, user, p, 300037); r != itemUseOK || p.EV.Atk != 0 || p.EV.Def != 8 {
+		t.Fatalf("ev=%d %+v", r, p.EV)
+	}
+}
+
+func TestUsePetItemGrantsExp(t *testing.T) {
+	withConfigFile(t, petItemConfigFile, testPetItemConfig)
+	seedProgressSpecies()
+	user := &User{Items: map[int]*ItemInfo{300099: {Count: 1}}}
+	p := &Pet{ID: 990101, Level: 2, Skills: []int{10001, 0, 0, 0}}
+	if r := usePetItem(nil, nil, user, p, 300099); r != itemUseOK || p.Level != 3 {
+		t.Fatalf("exp=%d level=%d", r, p.Level)
+	}
+}
+
+func TestDoubleExpChargesApplyToFights(t *testing.T) {
+	withConfigFile(t, petItemConfigFile, testPetItemConfig)
+	user := &User{Items: map[int]*ItemInfo{300027: {Count: 1}}, ThreeTimes: 1}
+	if r := usePetItem(nil, nil, user, nil, 300027); r != itemUseOK || user.TwoTimes != 2 {
+		t.Fatalf("charge=%d twoTimes=%d", r, user.TwoTimes)
+	}
+	got := []int{boostFightExp(nil, user, 10), boostFightExp(nil, user, 10), boostFightExp(nil, user, 10), boostFightExp(nil, user, 10)}
+	want := []int{30, 20, 20, 10}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("boosted=%v", got)
+		}
+	}
+}
+
+func TestItemBuffsRoundTrip(t *testing.T) {
+	user := &User{TwoTimes: 1, ThreeTimes: 2, AutoFightTimes: 3, EnergyTimes: 4}
+	out := &User{}
+	applyItemBuffs(out, encodeItemBuffs(user))
+	if out.TwoTimes != 1 || out.ThreeTimes != 2 || out.AutoFightTimes != 3 || out.EnergyTimes != 4 {
+		t.Fatalf("round trip=%+v", out)
+	}
+}
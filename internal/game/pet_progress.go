@@ -0,0 +1,117 @@
+package game
+
+import "jseer/internal/gateway"
+
+const petLevelCap = 100
+
+// petExpResult reports what grantPetExp changed on a pet.
+type petExpResult struct {
+	OldLevel uint32
+	Learned  []int
+	Evolved  bool
+}
+
+// gainPetEV adds the effort values a defeated pet yields to p, within the
+// per-stat and per-pet caps.
+func gainPetEV(p *Pet, yield evSet) {
+	total := 0
+	for _, v := range p.EV.fields() {
+		total += *v
+	}
+	gains := yield.fields()
+	for i, v := range p.EV.fields() {
+		gain := minInt(*gains[i], minInt(evStatMax-*v, evTotalMax-total))
+		if gain > 0 {
+			*v += gain
+			total += gain
+		}
+	}
+}
+
+// grantPetExp is the single levelling path for every exp source. It applies
+// as many level-ups as the exp covers, learning each level's moves and
+// running level-based evolution as thresholds are crossed.
+func grantPetExp(p *Pet, amount int) petExpResult {
+	if p == nil {
+		return petExpResult{}
+	}
+	res := petExpResult{OldLevel: p.Level}
+	if amount <= 0 || p.Level >= petLevelCap {
+		return res
+	}
+	oldID := p.ID
+	p.Exp += amount
+	for p.Level < petLevelCap {
+		base := LoadPetDB().pets[int(p.ID)]
+		next := getExpInfo(base, int(p.Level), p.Exp).NextLvExp
+		if p.Exp < next {
+			break
+		}
+		p.Exp -= next
+		p.Level++
+		res.Learned = append(res.Learned, learnLevelMoves(p, base)...)
+		res.Learned = append(res.Learned, autoEvolvePet(p)...)
+	}
+	if p.Level >= petLevelCap {
+		p.Exp = 0
+	}
+	res.Evolved = p.ID != oldID
+	stats := getStats(LoadPetDB().pets[int(p.ID)], int(p.Level), int(p.DV), p.EV)
+	if p.HP > stats.MaxHP {
+		p.HP = stats.MaxHP
+	}
+	return res
+}
+
+// learnLevelMoves fills empty skill slots with the moves base learns at
+// exactly p.Level. Moves that do not fit are kept as inactive moves.
+func learnLevelMoves(p *Pet, base *PetBase) []int {
+	if base == nil {
+		return nil
+	}
+	var atLevel []int
+	for _, mv := range base.Learnable {
+		if mv.Level == int(p.Level) {
+			atLevel = append(atLevel, mv.ID)
+		}
+	}
+	p.Skills = normalizeSkillList(append([]int{}, p.Skills...), base, int(p.Level)-1)
+	learned := diffSkills(atLevel, knownSkills(p))
+	for _, sid := range learned {
+		addPetSkill(p, sid)
+	}
+	return learned
+}
+
+// applyPetExp grants exp to a pet owned by user, persists it and, when ctx
+// is set, sends the property and new-skill notifications.
+func applyPetExp(ctx *gateway.Context, deps *Deps, user *User, p *Pet, amount int) petExpResult {
+	res := grantPetExp(p, amount)
+	upsertPet(deps, user, *p)
+	if ctx != nil {
+		sendNoteUpdateProp(ctx, user, p.CatchTime)
+		sendNoteUpdateSkill(ctx, res.Learned)
+	}
+	return res
+}
+
+func addExpPool(deps *Deps, user *User, amount uint32) {
+	if user == nil || amount == 0 {
+		return
+	}
+	user.ExpPool += amount
+	savePlayer(deps, user.ID, user)
+}
+
+// takeExpPool removes up to amount from the pool and returns what was taken.
+func takeExpPool(deps *Deps, user *User, amount uint32) uint32 {
+	if user == nil || amount == 0 {
+		return 0
+	}
+	if amount > user.ExpPool {
+		amount = user.ExpPool
+	}
+	user.ExpPool -= amount
+	savePlayer(deps, user.ID, user)
+	return amount
+}
@@ -0,0 +1,82 @@
+package game
+
+import "testing"
+
+func seedProgressSpecies() {
+	db := LoadPetDB()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.pets[990101] = &PetBase{ID: 990101, Hp: 40, GrowthType: 1, EvolvesTo: 990102, EvolvingLv: 5, Learnable: []LearnableMove{
+		{ID: 10001, Level: 1},
+		{ID: 10002, Level: 3},
+		{ID: 10003, Level: 4},
+	}}
+	db.pets[990102] = &PetBase{ID: 990102, Hp: 60, GrowthType: 1, Learnable: []LearnableMove{
+		{ID: 10001, Level: 1},
+		{ID: 10004, Level: 5},
+		{ID: 10005, Level: 6},
+	}}
+}
+
+func TestGrantPetExpMultiLevelWithEvolution(t *testing.T) {
+	seedProgressSpecies()
+	p := &Pet{ID: 990101, Level: 2, Skills: []int{10001, 0, 0, 0}}
+	// growth type 1 needs level^3 exp per level: 8 + 27 + 64 to reach level 5.
+	res := grantPetExp(p, 8+27+64+10)
+	if p.Level != 5 || p.Exp != 10 {
+		t.Fatalf("level=%d exp=%d", p.Level, p.Exp)
+	}
+	if !res.Evolved || p.ID != 990102 {
+		t.Fatalf("expected evolution at level 5, id=%d", p.ID)
+	}
+	want := []int{10001, 10002, 10003, 10004}
+	for i, sid := range want {
+		if p.Skills[i] != sid {
+			t.Fatalf("skills=%v learned=%v", p.Skills, res.Learned)
+		}
+	}
+	if len(res.Learned) != 3 {
+		t.Fatalf("learned=%v", res.Learned)
+	}
+}
+
+func TestGrantPetExpStopsAtLevelCap(t *testing.T) {
+	seedProgressSpecies()
+	p := &Pet{ID: 990102, Level: petLevelCap - 1}
+	grantPetExp(p, 1<<30)
+	if p.Level != petLevelCap || p.Exp != 0 {
+		t.Fatalf("level=%d exp=%d", p.Level, p.Exp)
+	}
+	if res := grantPetExp(p, 100); len(res.Learned) != 0 || p.Exp != 0 {
+		t.Fatalf("exp granted past the cap: %d", p.Exp)
+	}
+}
+
+func TestTakeExpPool(t *testing.T) {
+	user := &User{ExpPool: 50}
+	if got := takeExpPool(nil, user, 80); got != 50 || user.ExpPool != 0 {
+		t.Fatalf("took=%d pool=%d", got, user.ExpPool)
+	}
+}
+
+func TestGainPetEVCaps(t *testing.T) {
+	if ev := parseEVSet("0 2 0 1 0 3"); ev != (evSet{Atk: 2, SpA: 1, Spd: 3}) {
+		t.Fatalf("parsed=%+v", ev)
+	}
+	p := &Pet{EV: evSet{Atk: 250, Def: 200, SpA: 50}}
+	gainPetEV(p, evSet{HP: 2, Atk: 10, SpD: 3, Spd: 1})
+	// Atk stops at 255 and SpD takes what is left of the 510 total.
+	if want := (evSet{HP: 2, Atk: 255, Def: 200, SpA: 50, SpD: 3}); p.EV != want {
+		t.Fatalf("ev=%+v want %+v", p.EV, want)
+	}
+}
+
+func TestPetStatsUseEV(t *testing.T) {
+	seedProgressSpecies()
+	p := &Pet{ID: 990101, Level: 100}
+	plain := petMaxHP(p)
+	p.EV.HP = 252
+	if got := petMaxHP(p); got != plain+63 {
+		t.Fatalf("max hp=%d without ev=%d", got, plain)
+	}
+}
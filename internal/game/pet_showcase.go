@@ -0,0 +1,65 @@
+package game
+
+import "sort"
+
+// petRoomShowMax is how many pets a room can display at once.
+const petRoomShowMax = 3
+
+const (
+	petShowOK uint32 = iota
+	petShowNotFound
+	petShowFull
+)
+
+// findOwnedPet looks a pet up in the bag, then the warehouse.
+func findOwnedPet(user *User, catchTime uint32) *Pet {
+	if p := findPetByCatchTime(user, catchTime); p != nil {
+		return p
+	}
+	if idx := findWarehousePet(user, catchTime); idx >= 0 {
+		return &user.Warehouse[idx]
+	}
+	return nil
+}
+
+// roomShowPets lists the pets displayed in the user's room, oldest first.
+func roomShowPets(user *User) []Pet {
+	var out []Pet
+	for _, list := range [][]Pet{user.Pets, user.Warehouse} {
+		for _, p := range list {
+			if p.RoomShow {
+				out = append(out, p)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CatchTime < out[j].CatchTime })
+	return out
+}
+
+func setPetRoomShow(deps *Deps, user *User, catchTime uint32, show bool) uint32 {
+	p := findOwnedPet(user, catchTime)
+	if p == nil {
+		return petShowNotFound
+	}
+	if p.RoomShow == show {
+		return petShowOK
+	}
+	if show && len(roomShowPets(user)) >= petRoomShowMax {
+		return petShowFull
+	}
+	p.RoomShow = show
+	upsertPet(deps, user, *p)
+	return petShowOK
+}
+
+func setPetCollected(deps *Deps, user *User, catchTime uint32, collected bool) uint32 {
+	p := findOwnedPet(user, catchTime)
+	if p == nil {
+		return petShowNotFound
+	}
+	if p.Collected != collected {
+		p.Collected = collected
+		upsertPet(deps, user, *p)
+	}
+	return petShowOK
+}
@@ -0,0 +1,92 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"jseer/internal/storage"
+)
+
+// offlinePetStore serves one stored player and their pets.
+type offlinePetStore struct {
+	storage.Store
+	player *storage.Player
+	pets   []*storage.Pet
+}
+
+func (s *offlinePetStore) GetPlayerByAccount(ctx context.Context, account int64) (*storage.Player, error) {
+	if s.player == nil || s.player.Account != account {
+		return nil, errors.New("not found")
+	}
+	return s.player, nil
+}
+
+func (s *offlinePetStore) ListPetsByPlayer(ctx context.Context, playerID int64) ([]*storage.Pet, error) {
+	return s.pets, nil
+}
+
+func TestPetRoomShowLimitAndOrder(t *testing.T) {
+	user := &User{
+		Pets:      []Pet{{ID: 1, CatchTime: 30}, {ID: 2, CatchTime: 10}},
+		Warehouse: []Pet{{ID: 3, CatchTime: 20}, {ID: 4, CatchTime: 40}},
+	}
+	for _, ct := range []uint32{30, 10, 20} {
+		if r := setPetRoomShow(nil, user, ct, true); r != petShowOK {
+			t.Fatalf("show %d=%d", ct, r)
+		}
+	}
+	if r := setPetRoomShow(nil, user, 40, true); r != petShowFull {
+		t.Fatalf("over limit=%d", r)
+	}
+	if r := setPetRoomShow(nil, user, 99, true); r != petShowNotFound {
+		t.Fatalf("missing=%d", r)
+	}
+	shown := roomShowPets(user)
+	if len(shown) != 3 || shown[0].CatchTime != 10 || shown[1].CatchTime != 20 || shown[2].CatchTime != 30 {
+		t.Fatalf("shown=%+v", shown)
+	}
+	setPetRoomShow(nil, user, 20, false)
+	if r := setPetRoomShow(nil, user, 40, true); r != petShowOK || user.Warehouse[1].RoomShow != true {
+		t.Fatalf("after hide=%d", r)
+	}
+}
+
+func TestSetPetCollected(t *testing.T) {
+	user := &User{Warehouse: []Pet{{ID: 3, CatchTime: 20}}}
+	if r := setPetCollected(nil, user, 20, true); r != petShowOK || !user.Warehouse[0].Collected {
+		t.Fatalf("collect=%d", r)
+	}
+	if r := setPetCollected(nil, user, 21, true); r != petShowNotFound {
+		t.Fatalf("missing=%d", r)
+	}
+}
+
+func TestOfflineRoomOwnerPetsLoadFromStore(t *testing.T) {
+	state := NewState()
+	store := &offlinePetStore{
+		player: &storage.Player{ID: 7, Account: 20001, Nick: "owner"},
+		pets: []*storage.Pet{
+			{SpeciesID: 1, CatchTime: 10, Level: 5, RoomShow: true},
+			{SpeciesID: 2, CatchTime: 11, Level: 5, Location: petLocationWarehouse, HP: 0, Fainted: true},
+		},
+	}
+	deps := &Deps{State: state, Store: store}
+	visitor := &User{ID: 10001}
+	owner, ok := findRoomPetOwner(deps, state, visitor, 20001)
+	if !ok || len(owner.Pets) != 1 || len(owner.Warehouse) != 1 {
+		t.Fatalf("owner=%+v ok=%v", owner, ok)
+	}
+	if shown := roomShowPets(owner); len(shown) != 1 || shown[0].CatchTime != 10 {
+		t.Fatalf("shown=%+v", shown)
+	}
+	if owner.Pets[0].HP == 0 || owner.Warehouse[0].HP != 0 {
+		t.Fatalf("hp bag=%d warehouse=%d", owner.Pets[0].HP, owner.Warehouse[0].HP)
+	}
+	if _, cached := state.GetUser(20001); cached {
+		t.Fatal("offline owner cached in state")
+	}
+	if _, ok := findRoomPetOwner(deps, state, visitor, 20002); ok {
+		t.Fatal("unknown owner found")
+	}
+}
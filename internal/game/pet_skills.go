@@ -0,0 +1,223 @@
+package game
+
+import "slices"
+
+const petSkillSlots = 4
+
+const (
+	petSkillOK uint32 = iota
+	petSkillNoPet
+	petSkillNotLearnable
+	petSkillKnown
+	petSkillNotKnown
+	petSkillBadOrder
+	petSkillNoItem
+	petSkillInFight
+)
+
+// studyItemConfig maps an item to the move it teaches (study-items.json).
+// PetIDs, when set, limits the item to those species.
+type studyItemConfig struct {
+	ItemID  int   `json:"itemId"`
+	SkillID int   `json:"skillId"`
+	PetIDs  []int `json:"petIds"`
+}
+
+type studyItemFile struct {
+	Items []*studyItemConfig `json:"items"`
+}
+
+const studyItemConfigFile = "study-items.json"
+
+func getStudyItem(deps *Deps, itemID uint32) *studyItemConfig {
+	var cfg studyItemFile
+	if _, ok := readStoreConfigJSON(deps, studyItemConfigFile, &cfg); !ok {
+		return nil
+	}
+	for _, it := range cfg.Items {
+		if it != nil && it.ItemID == int(itemID) && it.SkillID > 0 {
+			return it
+		}
+	}
+	return nil
+}
+
+// knownSkills returns the active and inactive moves of p.
+func knownSkills(p *Pet) []int {
+	out := make([]int, 0, len(p.Skills)+len(p.Learned))
+	out = append(out, p.Skills...)
+	return append(out, p.Learned...)
+}
+
+func petKnowsSkill(p *Pet, skillID int) bool {
+	return containsSkill(knownSkills(p), skillID)
+}
+
+// canLearnSkill reports whether the species learns skillID at or below the
+// pet's level.
+func canLearnSkill(p *Pet, skillID int) bool {
+	base := LoadPetDB().pets[int(p.ID)]
+	if base == nil {
+		return false
+	}
+	for _, mv := range base.Learnable {
+		if mv.ID == skillID && mv.Level <= int(p.Level) {
+			return true
+		}
+	}
+	return false
+}
+
+// ensurePetSkillSlots pads the active set to four slots. Pets saved before
+// inactive moves existed may carry more than four; the extras become
+// inactive instead of being dropped.
+func ensurePetSkillSlots(p *Pet) {
+	if extra := len(p.Skills) - petSkillSlots; extra > 0 {
+		for _, sid := range p.Skills[:extra] {
+			if sid != 0 && !containsSkill(p.Learned, sid) {
+				p.Learned = append(p.Learned, sid)
+			}
+		}
+	}
+	p.Skills = normalizeSkillList(p.Skills, LoadPetDB().pets[int(p.ID)], int(p.Level))
+}
+
+// addPetSkill puts skillID in the first free active slot, or in the
+// inactive list once all four slots are taken.
+func addPetSkill(p *Pet, skillID int) {
+	ensurePetSkillSlots(p)
+	for idx := range p.Skills {
+		if p.Skills[idx] == 0 {
+			p.Skills[idx] = skillID
+			return
+		}
+	}
+	p.Learned = append(p.Learned, skillID)
+}
+
+func removeLearnedSkill(p *Pet, skillID int) bool {
+	for i, sid := range p.Learned {
+		if sid == skillID {
+			p.Learned = append(p.Learned[:i], p.Learned[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func activeSkillSlot(p *Pet, skillID int) int {
+	for idx, sid := range p.Skills {
+		if sid == skillID && sid != 0 {
+			return idx
+		}
+	}
+	return -1
+}
+
+// studyPetSkill (re)learns a species move. When replaceID names an active
+// move, the new move takes its slot and the old one becomes inactive.
+func studyPetSkill(deps *Deps, user *User, p *Pet, skillID int, replaceID int) uint32 {
+	if p == nil {
+		return petSkillNoPet
+	}
+	if user.Fight != nil {
+		return petSkillInFight
+	}
+	if !canLearnSkill(p, skillID) {
+		return petSkillNotLearnable
+	}
+	if activeSkillSlot(p, skillID) >= 0 {
+		return petSkillKnown
+	}
+	if replaceID != 0 {
+		if activeSkillSlot(p, replaceID) < 0 {
+			return petSkillNotKnown
+		}
+		if !petKnowsSkill(p, skillID) {
+			p.Learned = append(p.Learned, skillID)
+		}
+		return switchPetSkill(deps, user, p, replaceID, skillID)
+	}
+	if petKnowsSkill(p, skillID) {
+		return petSkillKnown
+	}
+	addPetSkill(p, skillID)
+	upsertPet(deps, user, *p)
+	return petSkillOK
+}
+
+// switchPetSkill swaps an active move with an inactive one in place.
+func switchPetSkill(deps *Deps, user *User, p *Pet, activeID int, learnedID int) uint32 {
+	if p == nil {
+		return petSkillNoPet
+	}
+	if user.Fight != nil {
+		return petSkillInFight
+	}
+	ensurePetSkillSlots(p)
+	slot := activeSkillSlot(p, activeID)
+	if slot < 0 || !removeLearnedSkill(p, learnedID) {
+		return petSkillNotKnown
+	}
+	p.Skills[slot] = learnedID
+	p.Learned = append(p.Learned, activeID)
+	upsertPet(deps, user, *p)
+	return petSkillOK
+}
+
+// sortPetSkills reorders the active set; order must be a permutation of it.
+func sortPetSkills(deps *Deps, user *User, p *Pet, order []int) uint32 {
+	if p == nil {
+		return petSkillNoPet
+	}
+	if user.Fight != nil {
+		return petSkillInFight
+	}
+	if len(order) != petSkillSlots {
+		return petSkillBadOrder
+	}
+	ensurePetSkillSlots(p)
+	counts := make(map[int]int, petSkillSlots)
+	for _, sid := range p.Skills {
+		counts[sid]++
+	}
+	for _, sid := range order {
+		counts[sid]--
+		if counts[sid] < 0 {
+			return petSkillBadOrder
+		}
+	}
+	p.Skills = append([]int{}, order...)
+	upsertPet(deps, user, *p)
+	return petSkillOK
+}
+
+// useStudyItem teaches the item's move regardless of the species move list,
+// consuming the item.
+func useStudyItem(deps *Deps, user *User, p *Pet, itemID uint32) (uint32, int) {
+	if p == nil {
+		return petSkillNoPet, 0
+	}
+	if user.Fight != nil {
+		return petSkillInFight, 0
+	}
+	info := user.Items[int(itemID)]
+	cfg := getStudyItem(deps, itemID)
+	if info == nil || info.Count <= 0 || cfg == nil {
+		return petSkillNoItem, 0
+	}
+	if len(cfg.PetIDs) > 0 && !slices.Contains(cfg.PetIDs, int(p.ID)) {
+		return petSkillNotLearnable, cfg.SkillID
+	}
+	if petKnowsSkill(p, cfg.SkillID) {
+		return petSkillKnown, cfg.SkillID
+	}
+	info.Count--
+	if info.Count <= 0 {
+		delete(user.Items, int(itemID))
+	}
+	upsertItem(deps, user, int(itemID))
+	addPetSkill(p, cfg.SkillID)
+	upsertPet(deps, user, *p)
+	return petSkillOK, cfg.SkillID
+}
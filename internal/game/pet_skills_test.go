@@ -0,0 +1,104 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func seedSkillSpecies() {
+	db := LoadPetDB()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.pets[990301] = &PetBase{ID: 990301, Hp: 40, Learnable: []LearnableMove{
+		{ID: 10001, Level: 1},
+		{ID: 10002, Level: 2},
+		{ID: 10003, Level: 3},
+		{ID: 10004, Level: 4},
+		{ID: 10005, Level: 5},
+		{ID: 10006, Level: 30},
+	}}
+}
+
+func TestStudyPetSkillValidatesLearnable(t *testing.T) {
+	seedSkillSpecies()
+	user := &User{}
+	p := &Pet{ID: 990301, Level: 10, Skills: []int{10001, 10002, 10003, 0}}
+
+	if r := studyPetSkill(nil, user, p, 10006, 0); r != petSkillNotLearnable {
+		t.Fatalf("above level=%d", r)
+	}
+	if r := studyPetSkill(nil, user, p, 20001, 0); r != petSkillNotLearnable {
+		t.Fatalf("foreign move=%d", r)
+	}
+	if r := studyPetSkill(nil, user, p, 10004, 0); r != petSkillOK || p.Skills[3] != 10004 {
+		t.Fatalf("study=%d skills=%v", r, p.Skills)
+	}
+	if r := studyPetSkill(nil, user, p, 10005, 0); r != petSkillOK || len(p.Learned) != 1 || p.Learned[0] != 10005 {
+		t.Fatalf("overflow=%d learned=%v", r, p.Learned)
+	}
+	if r := studyPetSkill(nil, user, p, 10005, 0); r != petSkillKnown {
+		t.Fatalf("relearn known=%d", r)
+	}
+	if r := studyPetSkill(nil, user, p, 10005, 10002); r != petSkillOK || p.Skills[1] != 10005 || p.Learned[0] != 10002 {
+		t.Fatalf("replace=%d skills=%v learned=%v", r, p.Skills, p.Learned)
+	}
+}
+
+func TestSwitchAndSortPetSkills(t *testing.T) {
+	seedSkillSpecies()
+	user := &User{}
+	p := &Pet{ID: 990301, Level: 10, Skills: []int{10001, 10002, 10003, 10004}, Learned: []int{10005}}
+
+	if r := switchPetSkill(nil, user, p, 10003, 10006); r != petSkillNotKnown {
+		t.Fatalf("unknown inactive=%d", r)
+	}
+	if r := switchPetSkill(nil, user, p, 10003, 10005); r != petSkillOK || p.Skills[2] != 10005 || p.Learned[0] != 10003 {
+		t.Fatalf("switch=%d skills=%v learned=%v", r, p.Skills, p.Learned)
+	}
+	if r := sortPetSkills(nil, user, p, []int{10004, 10005, 10001, 10001}); r != petSkillBadOrder {
+		t.Fatalf("duplicate order=%d", r)
+	}
+	if r := sortPetSkills(nil, user, p, []int{10004, 10005, 10001, 10002}); r != petSkillOK || p.Skills[0] != 10004 {
+		t.Fatalf("sort=%d skills=%v", r, p.Skills)
+	}
+	user.Fight = &FightState{}
+	if r := sortPetSkills(nil, user, p, []int{10001, 10002, 10004, 10005}); r != petSkillInFight {
+		t.Fatalf("in fight=%d", r)
+	}
+}
+
+func TestLegacyExtraSkillsBecomeInactive(t *testing.T) {
+	seedSkillSpecies()
+	p := &Pet{ID: 990301, Level: 10, Skills: []int{10001, 10002, 10003, 10004, 10005}}
+	ensurePetSkillSlots(p)
+	if len(p.Skills) != petSkillSlots || len(p.Learned) != 1 || p.Learned[0] != 10001 {
+		t.Fatalf("skills=%v learned=%v", p.Skills, p.Learned)
+	}
+}
+
+func TestUseStudyItemTeachesConfiguredMove(t *testing.T) {
+	seedSkillSpecies()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, configDir), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	cfg := `{"items":[{"itemId":300401,"skillId":20001},{"itemId":300404,"skillId":20002,"petIds":[1]}]}`
+	if err := os.WriteFile(filepath.Join(dir, configDir, studyItemConfigFile), []byte(cfg), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	user := &User{Items: map[int]*ItemInfo{300401: {Count: 1}, 300404: {Count: 1}}}
+	p := &Pet{ID: 990301, Level: 10, Skills: []int{10001, 0, 0, 0}}
+	if r, _ := useStudyItem(nil, user, p, 300404); r != petSkillNotLearnable || user.Items[300404] == nil {
+		t.Fatalf("restricted item=%d", r)
+	}
+	r, sid := useStudyItem(nil, user, p, 300401)
+	if r != petSkillOK || sid != 20001 || p.Skills[1] != 20001 || user.Items[300401] != nil {
+		t.Fatalf("study item=%d skills=%v", r, p.Skills)
+	}
+	if r, _ := useStudyItem(nil, user, p, 300401); r != petSkillNoItem {
+		t.Fatalf("no item=%d", r)
+	}
+}
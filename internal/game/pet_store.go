@@ -3,6 +3,7 @@ package game
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"jseer/internal/storage"
 )
@@ -29,6 +30,55 @@ func decodePetSkills(raw string) []int {
 	return list
 }
 
+// Pet natures are stored as their numeric ID; rows written before natures
+// were tracked hold "normal" and decode as nature 0.
+func encodePetNature(nature uint32) string {
+	return strconv.FormatUint(uint64(nature), 10)
+}
+
+func decodePetNature(raw string) uint32 {
+	v, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(v)
+}
+
+func encodePetEV(ev evSet) string {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func decodePetEV(raw string) evSet {
+	var ev evSet
+	_ = json.Unmarshal([]byte(raw), &ev)
+	return ev
+}
+
+// Skill PP ({"skillID": pp}, missing skills are at full PP) and lasting
+// status ({"statusID": turns}) share this encoding.
+func encodePetIntMap(m map[int]int) string {
+	if len(m) == 0 {
+		return "{}"
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func decodePetIntMap(raw string) map[int]int {
+	var m map[int]int
+	if err := json.Unmarshal([]byte(raw), &m); err != nil || len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
 func upsertPet(deps *Deps, user *User, pet Pet) {
 	if deps == nil || deps.Store == nil || user == nil || user.PlayerID == 0 {
 		return
@@ -42,6 +92,57 @@ func upsertPet(deps *Deps, user *User, pet Pet) {
 		CatchTime: int64(pet.CatchTime),
 		DV:        int(pet.DV),
 		Skills:    encodePetSkills(pet.Skills),
-		Nature:    "normal",
+		Learned:   encodePetSkills(pet.Learned),
+		EV:        encodePetEV(pet.EV),
+		SkillPP:   encodePetIntMap(pet.SkillPP),
+		Status:    encodePetIntMap(pet.Status),
+		Fainted:   pet.HP <= 0,
+		Collected: pet.Collected,
+		RoomShow:  pet.RoomShow,
+		Nature:    encodePetNature(pet.Nature),
+		Location:  petLocation(user, pet.CatchTime),
 	})
 }
+
+// loadStoredPets replaces the user's bag and warehouse with their stored
+// pets. Both are left alone if the store cannot be read.
+func loadStoredPets(deps *Deps, user *User) bool {
+	if deps == nil || deps.Store == nil || user.PlayerID == 0 {
+		return false
+	}
+	pets, err := deps.Store.ListPetsByPlayer(context.Background(), user.PlayerID)
+	if err != nil {
+		return false
+	}
+	user.Pets = user.Pets[:0]
+	user.Warehouse = user.Warehouse[:0]
+	for _, p := range pets {
+		pet := Pet{
+			ID:        uint32(p.SpeciesID),
+			CatchTime: uint32(p.CatchTime),
+			Level:     uint32(p.Level),
+			DV:        uint32(p.DV),
+			Nature:    decodePetNature(p.Nature),
+			Exp:       p.Exp,
+			HP:        p.HP,
+			Skills:    decodePetSkills(p.Skills),
+			Learned:   decodePetSkills(p.Learned),
+			EV:        decodePetEV(p.EV),
+			SkillPP:   decodePetIntMap(p.SkillPP),
+			Status:    decodePetIntMap(p.Status),
+			Collected: p.Collected,
+			RoomShow:  p.RoomShow,
+		}
+		// Rows saved before HP was tracked hold 0 without the fainted
+		// flag; they load at full HP.
+		if pet.HP <= 0 && !p.Fainted {
+			pet.HP = petMaxHP(&pet)
+		}
+		if p.Location == petLocationWarehouse {
+			user.Warehouse = append(user.Warehouse, pet)
+		} else {
+			user.Pets = append(user.Pets, pet)
+		}
+	}
+	return true
+}
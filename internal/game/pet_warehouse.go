@@ -0,0 +1,114 @@
+package game
+
+const (
+	petLocationBag       = 0
+	petLocationWarehouse = 1
+)
+
+const (
+	petBagSize             = 6
+	petWarehousePageSize   = 50
+	petWarehouseMaxPerPage = 200
+)
+
+const (
+	petStorageOK uint32 = iota
+	petStorageNotFound
+	petStorageBagFull
+	petStorageLastPet
+	petStorageInFight
+)
+
+func petLocation(user *User, catchTime uint32) int {
+	if findWarehousePet(user, catchTime) >= 0 {
+		return petLocationWarehouse
+	}
+	return petLocationBag
+}
+
+func findWarehousePet(user *User, catchTime uint32) int {
+	if user == nil {
+		return -1
+	}
+	for i := range user.Warehouse {
+		if user.Warehouse[i].CatchTime == catchTime {
+			return i
+		}
+	}
+	return -1
+}
+
+// addCapturedPet puts a new pet in the bag, or in the warehouse once the bag
+// is full.
+func addCapturedPet(deps *Deps, user *User, pet Pet) {
+	if len(user.Pets) >= petBagSize {
+		user.Warehouse = append(user.Warehouse, pet)
+	} else {
+		user.Pets = append(user.Pets, pet)
+	}
+	upsertPet(deps, user, pet)
+}
+
+// storePet moves a bag pet into the warehouse.
+func storePet(deps *Deps, user *User, catchTime uint32) uint32 {
+	idx := -1
+	for i := range user.Pets {
+		if user.Pets[i].CatchTime == catchTime {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return petStorageNotFound
+	}
+	if len(user.Pets) == 1 {
+		return petStorageLastPet
+	}
+	if user.Fight != nil {
+		return petStorageInFight
+	}
+	pet := user.Pets[idx]
+	user.Pets = append(user.Pets[:idx], user.Pets[idx+1:]...)
+	user.Warehouse = append(user.Warehouse, pet)
+	upsertPet(deps, user, pet)
+	if user.CatchID == catchTime {
+		first := user.Pets[0]
+		user.CatchID = first.CatchTime
+		user.CurrentPetID = first.ID
+		user.PetDV = first.DV
+		savePlayer(deps, user.ID, user)
+	}
+	return petStorageOK
+}
+
+// retrievePet moves a warehouse pet into the bag.
+func retrievePet(deps *Deps, user *User, catchTime uint32) (uint32, *Pet) {
+	idx := findWarehousePet(user, catchTime)
+	if idx < 0 {
+		return petStorageNotFound, nil
+	}
+	if len(user.Pets) >= petBagSize {
+		return petStorageBagFull, nil
+	}
+	if user.Fight != nil {
+		return petStorageInFight, nil
+	}
+	pet := user.Warehouse[idx]
+	user.Warehouse = append(user.Warehouse[:idx], user.Warehouse[idx+1:]...)
+	user.Pets = append(user.Pets, pet)
+	upsertPet(deps, user, pet)
+	return petStorageOK, &user.Pets[len(user.Pets)-1]
+}
+
+// warehousePage returns one page of the warehouse, oldest capture first.
+func warehousePage(user *User, page int, size int) []Pet {
+	if size <= 0 {
+		size = petWarehousePageSize
+	}
+	size = minInt(size, petWarehouseMaxPerPage)
+	start := page * size
+	if page < 0 || start >= len(user.Warehouse) {
+		return nil
+	}
+	return user.Warehouse[start:minInt(len(user.Warehouse), start+size)]
+}
@@ -0,0 +1,62 @@
+package game
+
+import "testing"
+
+func newWarehouseTestUser(bag int) *User {
+	user := &User{}
+	for i := 0; i < bag; i++ {
+		user.Pets = append(user.Pets, Pet{ID: 1, CatchTime: uint32(100 + i)})
+	}
+	user.CatchID = 100
+	return user
+}
+
+func TestCapturedPetGoesToWarehouseWhenBagFull(t *testing.T) {
+	user := newWarehouseTestUser(petBagSize - 1)
+	addCapturedPet(nil, user, Pet{ID: 2, CatchTime: 200})
+	addCapturedPet(nil, user, Pet{ID: 3, CatchTime: 201})
+	if len(user.Pets) != petBagSize || len(user.Warehouse) != 1 || user.Warehouse[0].CatchTime != 201 {
+		t.Fatalf("bag=%d warehouse=%v", len(user.Pets), user.Warehouse)
+	}
+	if petLocation(user, 201) != petLocationWarehouse || petLocation(user, 200) != petLocationBag {
+		t.Fatal("unexpected pet locations")
+	}
+}
+
+func TestStoreAndRetrievePet(t *testing.T) {
+	user := newWarehouseTestUser(2)
+	if r := storePet(nil, user, 100); r != petStorageOK {
+		t.Fatalf("store=%d", r)
+	}
+	if user.CatchID != 101 {
+		t.Fatalf("current pet not reassigned: %d", user.CatchID)
+	}
+	if r := storePet(nil, user, 101); r != petStorageLastPet {
+		t.Fatalf("store last=%d", r)
+	}
+	if r, pet := retrievePet(nil, user, 100); r != petStorageOK || pet == nil || len(user.Warehouse) != 0 {
+		t.Fatalf("retrieve=%d warehouse=%v", r, user.Warehouse)
+	}
+
+	full := newWarehouseTestUser(petBagSize)
+	full.Warehouse = []Pet{{ID: 9, CatchTime: 900}}
+	if r, _ := retrievePet(nil, full, 900); r != petStorageBagFull {
+		t.Fatalf("retrieve into full bag=%d", r)
+	}
+}
+
+func TestWarehousePaging(t *testing.T) {
+	user := &User{}
+	for i := 0; i < 5; i++ {
+		user.Warehouse = append(user.Warehouse, Pet{CatchTime: uint32(i)})
+	}
+	if page := warehousePage(user, 1, 2); len(page) != 2 || page[0].CatchTime != 2 {
+		t.Fatalf("page=%v", page)
+	}
+	if page := warehousePage(user, 2, 2); len(page) != 1 {
+		t.Fatalf("last page=%v", page)
+	}
+	if page := warehousePage(user, 3, 2); page != nil {
+		t.Fatalf("past end=%v", page)
+	}
+}
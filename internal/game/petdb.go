@@ -18,18 +18,24 @@ type LearnableMove struct {
 }
 
 type PetBase struct {
-	ID         int
-	Type       int
-	BaseExp    int
-	Name       string
-	Hp         int
-	Atk        int
-	Def        int
-	SpAtk      int
-	SpDef      int
-	Spd        int
-	GrowthType int
-	Learnable  []LearnableMove
+	ID             int
+	Type           int
+	BaseExp        int
+	Name           string
+	Hp             int
+	Atk            int
+	Def            int
+	SpAtk          int
+	SpDef          int
+	Spd            int
+	GrowthType     int
+	EvolvesTo      int
+	EvolvingLv     int
+	EvolvFlag      int
+	EvolvItem      int
+	EvolvItemCount int
+	YieldEV        evSet
+	Learnable      []LearnableMove
 }
 
 type SkillInfo struct {
@@ -42,6 +48,7 @@ type SkillInfo struct {
 	Priority       int
 	CritRate       int
 	SideEffect     int
+	SideEffects    []int
 	SideEffectArg  string
 	MustHit        bool
 	CritAtkFirst   bool
@@ -131,6 +138,7 @@ func loadSkills(path string, out map[int]*SkillInfo) error {
 		priority := 0
 		critRate := 1
 		sideEffect := 0
+		var sideEffects []int
 		sideArg := ""
 		mustHit := false
 		critAtkFirst := false
@@ -160,6 +168,7 @@ func loadSkills(path string, out map[int]*SkillInfo) error {
 				priority, _ = strconv.Atoi(attr.Value)
 			case "SideEffect":
 				sideEffect = parseFirstInt(attr.Value)
+				sideEffects = parseEffectArgs(attr.Value)
 			case "SideEffectArg":
 				sideArg = attr.Value
 			case "MustHit":
@@ -203,6 +212,7 @@ func loadSkills(path string, out map[int]*SkillInfo) error {
 				Priority:       priority,
 				CritRate:       critRate,
 				SideEffect:     sideEffect,
+				SideEffects:    sideEffects,
 				SideEffectArg:  sideArg,
 				MustHit:        mustHit,
 				CritAtkFirst:   critAtkFirst,
@@ -254,7 +264,7 @@ func loadPets(path string, out map[int]*PetBase) error {
 			name := t.Name.Local
 			switch name {
 			case "Monster":
-				current = &PetBase{}
+				current = &PetBase{EvolvItemCount: 1}
 				for _, attr := range t.Attr {
 					switch attr.Name.Local {
 					case "ID":
@@ -263,6 +273,8 @@ func loadPets(path string, out map[int]*PetBase) error {
 						current.Type, _ = strconv.Atoi(attr.Value)
 					case "YieldingExp":
 						current.BaseExp, _ = strconv.Atoi(attr.Value)
+					case "YieldingEV":
+						current.YieldEV = parseEVSet(attr.Value)
 					case "DefName":
 						current.Name = attr.Value
 					case "Hp", "HP":
@@ -279,6 +291,16 @@ func loadPets(path string, out map[int]*PetBase) error {
 						current.Spd, _ = strconv.Atoi(attr.Value)
 					case "GrowthType":
 						current.GrowthType, _ = strconv.Atoi(attr.Value)
+					case "EvolvesTo":
+						current.EvolvesTo, _ = strconv.Atoi(attr.Value)
+					case "EvolvingLv":
+						current.EvolvingLv, _ = strconv.Atoi(attr.Value)
+					case "EvolvFlag":
+						current.EvolvFlag, _ = strconv.Atoi(attr.Value)
+					case "EvolvItem":
+						current.EvolvItem, _ = strconv.Atoi(attr.Value)
+					case "EvolvItemCount":
+						current.EvolvItemCount, _ = strconv.Atoi(attr.Value)
 					}
 				}
 			case "LearnableMoves":
@@ -366,12 +388,35 @@ func createStarterPet(petID int, level int) *PetInstance {
 }
 
 type evSet struct {
-	HP  int
-	Atk int
-	Def int
-	SpA int
-	SpD int
-	Spd int
+	HP  int `json:"hp,omitempty"`
+	Atk int `json:"atk,omitempty"`
+	Def int `json:"def,omitempty"`
+	SpA int `json:"spa,omitempty"`
+	SpD int `json:"spd,omitempty"`
+	Spd int `json:"spe,omitempty"`
+}
+
+// Effort values stop at evStatMax per stat and evTotalMax per pet.
+const (
+	evStatMax  = 255
+	evTotalMax = 510
+)
+
+// parseEVSet reads a YieldingEV list: hp, atk, def, sp_atk, sp_def, spd.
+func parseEVSet(raw string) evSet {
+	var ev evSet
+	fields := ev.fields()
+	for i, f := range strings.Fields(raw) {
+		if i < len(fields) {
+			*fields[i], _ = strconv.Atoi(f)
+		}
+	}
+	return ev
+}
+
+// fields lists the stats in YieldingEV and pet info order.
+func (e *evSet) fields() [6]*int {
+	return [6]*int{&e.HP, &e.Atk, &e.Def, &e.SpA, &e.SpD, &e.Spd}
 }
 
 type petStats struct {
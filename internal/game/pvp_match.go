@@ -0,0 +1,283 @@
+package game
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"jseer/internal/gateway"
+	"jseer/internal/storage"
+)
+
+const (
+	pvpQueueCasual uint32 = 0
+	pvpQueueRanked uint32 = 1
+)
+
+// pvpSeasonConfig is read from pvp-season.json (GM key pvp_season).
+type pvpSeasonConfig struct {
+	Season          int `json:"season"`
+	StartRating     int `json:"startRating"`
+	KFactor         int `json:"kFactor"`
+	BaseWindow      int `json:"baseWindow"`
+	WindowPerSecond int `json:"windowPerSecond"`
+	MaxWindow       int `json:"maxWindow"`
+	LeaderboardSize int `json:"leaderboardSize"`
+}
+
+const pvpSeasonConfigFile = "pvp-season.json"
+
+func defaultPvPSeasonConfig() pvpSeasonConfig {
+	return pvpSeasonConfig{
+		Season:          1,
+		StartRating:     1500,
+		KFactor:         32,
+		BaseWindow:      100,
+		WindowPerSecond: 10,
+		MaxWindow:       800,
+		LeaderboardSize: 50,
+	}
+}
+
+// pvpSeasonConfig returns the cached pvp-season.json; the matchmaker reads
+// it every second.
+func (s *State) pvpSeasonConfig(deps *Deps) pvpSeasonConfig {
+	if s == nil {
+		cfg, _ := loadPvPSeasonConfig(deps)
+		return cfg
+	}
+	return s.pvpSeason.get(deps, pvpSeasonConfigFile, func() (pvpSeasonConfig, int64) {
+		return loadPvPSeasonConfig(deps)
+	})
+}
+
+func loadPvPSeasonConfig(deps *Deps) (pvpSeasonConfig, int64) {
+	cfg := defaultPvPSeasonConfig()
+	version, _ := readStoreConfigJSON(deps, pvpSeasonConfigFile, &cfg)
+	def := defaultPvPSeasonConfig()
+	if cfg.Season <= 0 {
+		cfg.Season = def.Season
+	}
+	if cfg.StartRating <= 0 {
+		cfg.StartRating = def.StartRating
+	}
+	if cfg.KFactor <= 0 {
+		cfg.KFactor = def.KFactor
+	}
+	if cfg.MaxWindow <= 0 {
+		cfg.MaxWindow = def.MaxWindow
+	}
+	if cfg.LeaderboardSize <= 0 {
+		cfg.LeaderboardSize = def.LeaderboardSize
+	}
+	return cfg, version
+}
+
+type pvpQueueEntry struct {
+	UserID   uint32
+	Queue    uint32
+	Rating   int
+	JoinedAt time.Time
+}
+
+// pvpMatchmaker pairs queued players whose ratings fall inside a window that
+// widens the longer the older player has waited.
+type pvpMatchmaker struct {
+	mu      sync.Mutex
+	entries map[uint32]*pvpQueueEntry
+}
+
+func newPvPMatchmaker() *pvpMatchmaker {
+	return &pvpMatchmaker{entries: make(map[uint32]*pvpQueueEntry)}
+}
+
+func (m *pvpMatchmaker) Join(entry *pvpQueueEntry) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[entry.UserID]; ok {
+		return false
+	}
+	m.entries[entry.UserID] = entry
+	return true
+}
+
+func (m *pvpMatchmaker) Leave(userID uint32) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[userID]; !ok {
+		return false
+	}
+	delete(m.entries, userID)
+	return true
+}
+
+func (m *pvpMatchmaker) Queued(userID uint32) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.entries[userID]
+	return ok
+}
+
+// Match removes and returns every pair that can be made at now.
+func (m *pvpMatchmaker) Match(now time.Time, cfg pvpSeasonConfig) [][2]*pvpQueueEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	waiting := make([]*pvpQueueEntry, 0, len(m.entries))
+	for _, e := range m.entries {
+		waiting = append(waiting, e)
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		if !waiting[i].JoinedAt.Equal(waiting[j].JoinedAt) {
+			return waiting[i].JoinedAt.Before(waiting[j].JoinedAt)
+		}
+		return waiting[i].UserID < waiting[j].UserID
+	})
+	var pairs [][2]*pvpQueueEntry
+	paired := make(map[uint32]bool)
+	for i, a := range waiting {
+		if paired[a.UserID] {
+			continue
+		}
+		window := matchWindow(a, now, cfg)
+		var best *pvpQueueEntry
+		bestDiff := 0
+		for _, b := range waiting[i+1:] {
+			if paired[b.UserID] || b.Queue != a.Queue {
+				continue
+			}
+			diff := absInt(a.Rating - b.Rating)
+			if diff > window {
+				continue
+			}
+			if best == nil || diff < bestDiff {
+				best = b
+				bestDiff = diff
+			}
+		}
+		if best == nil {
+			continue
+		}
+		paired[a.UserID] = true
+		paired[best.UserID] = true
+		delete(m.entries, a.UserID)
+		delete(m.entries, best.UserID)
+		pairs = append(pairs, [2]*pvpQueueEntry{a, best})
+	}
+	return pairs
+}
+
+func matchWindow(e *pvpQueueEntry, now time.Time, cfg pvpSeasonConfig) int {
+	waited := int(now.Sub(e.JoinedAt) / time.Second)
+	if waited < 0 {
+		waited = 0
+	}
+	return minInt(cfg.MaxWindow, cfg.BaseWindow+cfg.WindowPerSecond*waited)
+}
+
+// eloUpdate returns the new ratings after a game; score is 1 for an A win,
+// 0 for a B win and 0.5 for a draw.
+func eloUpdate(ratingA int, ratingB int, score float64, k int) (int, int) {
+	expectA := 1 / (1 + math.Pow(10, float64(ratingB-ratingA)/400))
+	delta := int(math.Round(float64(k) * (score - expectA)))
+	return ratingA + delta, ratingB - delta
+}
+
+func loadPvPRating(deps *Deps, user *User, cfg pvpSeasonConfig) *storage.PvPRating {
+	if deps != nil && deps.Store != nil && user != nil && user.PlayerID != 0 {
+		if r, err := deps.Store.GetPvPRating(context.Background(), user.PlayerID, cfg.Season); err == nil && r != nil {
+			return r
+		}
+	}
+	r := &storage.PvPRating{Season: cfg.Season, Rating: cfg.StartRating}
+	if user != nil {
+		r.PlayerID = user.PlayerID
+		r.Nick = user.Nick
+	}
+	return r
+}
+
+func savePvPRating(deps *Deps, r *storage.PvPRating) {
+	if deps == nil || deps.Store == nil || r == nil || r.PlayerID == 0 {
+		return
+	}
+	if _, err := deps.Store.SavePvPRating(context.Background(), r); err != nil && deps.Logger != nil {
+		deps.Logger.Warn("pvp rating save failed", zap.Int64("player_id", r.PlayerID), zap.Error(err))
+	}
+}
+
+// recordPvPResult updates ranked ratings once a matched fight ends.
+// winner is 0 for a draw.
+func recordPvPResult(deps *Deps, state *State, queue uint32, userA uint32, userB uint32, winner uint32) {
+	if queue != pvpQueueRanked || state == nil {
+		return
+	}
+	cfg := state.pvpSeasonConfig(deps)
+	a := state.GetOrCreateUser(userA)
+	b := state.GetOrCreateUser(userB)
+	ra := loadPvPRating(deps, a, cfg)
+	rb := loadPvPRating(deps, b, cfg)
+	score := 0.5
+	switch winner {
+	case userA:
+		score = 1
+		ra.Wins++
+		rb.Losses++
+	case userB:
+		score = 0
+		ra.Losses++
+		rb.Wins++
+	default:
+		ra.Draws++
+		rb.Draws++
+	}
+	ra.Rating, rb.Rating = eloUpdate(ra.Rating, rb.Rating, score, cfg.KFactor)
+	ra.Nick = a.Nick
+	rb.Nick = b.Nick
+	savePvPRating(deps, ra)
+	savePvPRating(deps, rb)
+}
+
+func runPvPMatchmaker(srv *gateway.Server, deps *Deps, state *State) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		startPvPMatches(srv, deps, state, now)
+	}
+}
+
+func startPvPMatches(srv *gateway.Server, deps *Deps, state *State, now time.Time) {
+	cfg := state.pvpSeasonConfig(deps)
+	pairs := state.matchmaker.Match(now, cfg)
+	if len(pairs) == 0 {
+		return
+	}
+	state.fightMu.Lock()
+	defer state.fightMu.Unlock()
+	for _, pair := range pairs {
+		a := state.GetOrCreateUser(pair[0].UserID)
+		b := state.GetOrCreateUser(pair[1].UserID)
+		_, aOnline := state.GetConn(a.ID)
+		_, bOnline := state.GetConn(b.ID)
+		if !aOnline || a.Fight != nil || !bOnline || b.Fight != nil {
+			// Put back whoever is still able to fight.
+			if aOnline && a.Fight == nil {
+				state.matchmaker.Join(pair[0])
+			}
+			if bOnline && b.Fight == nil {
+				state.matchmaker.Join(pair[1])
+			}
+			continue
+		}
+		initPvPFightState(state, a.ID, b.ID, a, b)
+		if a.Fight == nil || b.Fight == nil {
+			continue
+		}
+		a.Fight.PvPQueue = pair[0].Queue
+		b.Fight.PvPQueue = pair[0].Queue
+		sendPvPFightStart(srv, state, a.ID, b.ID, a, b)
+	}
+}
@@ -0,0 +1,58 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPvPMatchWindowWidensWithWait(t *testing.T) {
+	cfg := defaultPvPSeasonConfig()
+	start := time.Unix(1000, 0)
+	m := newPvPMatchmaker()
+	m.Join(&pvpQueueEntry{UserID: 1, Queue: pvpQueueRanked, Rating: 1500, JoinedAt: start})
+	m.Join(&pvpQueueEntry{UserID: 2, Queue: pvpQueueRanked, Rating: 1700, JoinedAt: start})
+
+	if pairs := m.Match(start, cfg); len(pairs) != 0 {
+		t.Fatalf("matched outside window: %v", pairs)
+	}
+	pairs := m.Match(start.Add(10*time.Second), cfg)
+	if len(pairs) != 1 || pairs[0][0].UserID != 1 || pairs[0][1].UserID != 2 {
+		t.Fatalf("pairs=%v", pairs)
+	}
+	if m.Queued(1) || m.Queued(2) {
+		t.Fatal("matched players should leave the queue")
+	}
+}
+
+func TestPvPMatchPrefersClosestRatingInSameQueue(t *testing.T) {
+	cfg := defaultPvPSeasonConfig()
+	now := time.Unix(1000, 0)
+	m := newPvPMatchmaker()
+	m.Join(&pvpQueueEntry{UserID: 1, Queue: pvpQueueRanked, Rating: 1500, JoinedAt: now})
+	m.Join(&pvpQueueEntry{UserID: 2, Queue: pvpQueueCasual, Rating: 1500, JoinedAt: now})
+	m.Join(&pvpQueueEntry{UserID: 3, Queue: pvpQueueRanked, Rating: 1590, JoinedAt: now})
+	m.Join(&pvpQueueEntry{UserID: 4, Queue: pvpQueueRanked, Rating: 1520, JoinedAt: now})
+
+	pairs := m.Match(now, cfg)
+	if len(pairs) != 1 || pairs[0][1].UserID != 4 {
+		t.Fatalf("pairs=%v", pairs)
+	}
+	if !m.Queued(2) || !m.Queued(3) {
+		t.Fatal("unmatched players should stay queued")
+	}
+}
+
+func TestEloUpdate(t *testing.T) {
+	a, b := eloUpdate(1500, 1500, 1, 32)
+	if a != 1516 || b != 1484 {
+		t.Fatalf("win: a=%d b=%d", a, b)
+	}
+	a, b = eloUpdate(1500, 1500, 0.5, 32)
+	if a != 1500 || b != 1500 {
+		t.Fatalf("draw: a=%d b=%d", a, b)
+	}
+	a, b = eloUpdate(1800, 1400, 0, 32)
+	if a != 1771 || b != 1429 {
+		t.Fatalf("upset: a=%d b=%d", a, b)
+	}
+}
@@ -0,0 +1,195 @@
+package game
+
+import (
+	"net"
+	"time"
+
+	"jseer/internal/gateway"
+)
+
+// pvpTurnConfig is read from pvp-turn.json (GM key pvp_turn).
+type pvpTurnConfig struct {
+	TurnSeconds      int `json:"turnSeconds"`
+	MaxTimeouts      int `json:"maxTimeouts"`
+	ReconnectSeconds int `json:"reconnectSeconds"`
+}
+
+const pvpTurnConfigFile = "pvp-turn.json"
+
+func defaultPvPTurnConfig() pvpTurnConfig {
+	return pvpTurnConfig{
+		TurnSeconds:      30,
+		MaxTimeouts:      3,
+		ReconnectSeconds: 60,
+	}
+}
+
+// pvpTurnConfig returns the cached pvp-turn.json; the turn timer reads it
+// every second.
+func (s *State) pvpTurnConfig(deps *Deps) pvpTurnConfig {
+	if s == nil {
+		cfg, _ := loadPvPTurnConfig(deps)
+		return cfg
+	}
+	return s.pvpTurn.get(deps, pvpTurnConfigFile, func() (pvpTurnConfig, int64) {
+		return loadPvPTurnConfig(deps)
+	})
+}
+
+func loadPvPTurnConfig(deps *Deps) (pvpTurnConfig, int64) {
+	cfg := defaultPvPTurnConfig()
+	version, _ := readStoreConfigJSON(deps, pvpTurnConfigFile, &cfg)
+	def := defaultPvPTurnConfig()
+	if cfg.TurnSeconds <= 0 {
+		cfg.TurnSeconds = def.TurnSeconds
+	}
+	if cfg.MaxTimeouts <= 0 {
+		cfg.MaxTimeouts = def.MaxTimeouts
+	}
+	if cfg.ReconnectSeconds < 0 {
+		cfg.ReconnectSeconds = def.ReconnectSeconds
+	}
+	return cfg, version
+}
+
+// pvpUserContext builds a context for server-driven fight updates. online is
+// false when the user has no live connection, in which case nothing may be
+// sent through the returned context.
+func pvpUserContext(srv *gateway.Server, state *State, userID uint32) (*gateway.Context, bool) {
+	ctx := &gateway.Context{Server: srv, UserID: userID}
+	conn, ok := state.GetConn(userID)
+	if ok {
+		ctx.Conn = conn
+	}
+	return ctx, ok
+}
+
+func runPvPTurnTimer(srv *gateway.Server, deps *Deps, state *State) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		checkPvPTimers(srv, deps, state, now)
+	}
+}
+
+// checkPvPTimers arms turn deadlines, plays a default skill for whoever let
+// the deadline pass, and ends fights by forfeit after too many consecutive
+// timeouts or once a disconnected player's reconnect window has closed.
+func checkPvPTimers(srv *gateway.Server, deps *Deps, state *State, now time.Time) {
+	cfg := state.pvpTurnConfig(deps)
+	fighters := state.PvPFighters()
+	state.fightMu.Lock()
+	defer state.fightMu.Unlock()
+	for _, user := range fighters {
+		f := user.Fight
+		if f == nil || f.OpponentUserID == 0 || user.ID > f.OpponentUserID {
+			continue
+		}
+		opp := state.GetOrCreateUser(f.OpponentUserID)
+		if opp.Fight == nil || opp.Fight.OpponentUserID != user.ID {
+			continue
+		}
+		userGone := reconnectExpired(f, now, cfg)
+		oppGone := reconnectExpired(opp.Fight, now, cfg)
+		if userGone || oppGone {
+			finishPvPFight(srv, deps, state, user, opp, pvpForfeitWinner(user.ID, userGone, opp.ID, oppGone))
+			continue
+		}
+		if f.TurnDeadline.IsZero() {
+			deadline := now.Add(time.Duration(cfg.TurnSeconds) * time.Second)
+			f.TurnDeadline = deadline
+			opp.Fight.TurnDeadline = deadline
+			continue
+		}
+		if now.Before(f.TurnDeadline) {
+			continue
+		}
+		autoPvPSkill(srv, state, user)
+		autoPvPSkill(srv, state, opp)
+		userOut := f.PvPTimeouts >= cfg.MaxTimeouts
+		oppOut := opp.Fight.PvPTimeouts >= cfg.MaxTimeouts
+		if userOut || oppOut {
+			finishPvPFight(srv, deps, state, user, opp, pvpForfeitWinner(user.ID, userOut, opp.ID, oppOut))
+			continue
+		}
+		resolvePvPTurn(srv, deps, state, user, opp)
+	}
+}
+
+func reconnectExpired(f *FightState, now time.Time, cfg pvpTurnConfig) bool {
+	if f.DisconnectedAt.IsZero() {
+		return false
+	}
+	return now.Sub(f.DisconnectedAt) >= time.Duration(cfg.ReconnectSeconds)*time.Second
+}
+
+// pvpForfeitWinner returns the side that did not forfeit, or 0 when both did.
+func pvpForfeitWinner(userA uint32, aOut bool, userB uint32, bOut bool) uint32 {
+	switch {
+	case aOut && bOut:
+		return 0
+	case aOut:
+		return userB
+	default:
+		return userA
+	}
+}
+
+// autoPvPSkill picks a skill for a side that has not chosen one this turn and
+// counts the timeout against it.
+func autoPvPSkill(srv *gateway.Server, state *State, user *User) {
+	f := user.Fight
+	if f.PendingSkillID != 0 {
+		return
+	}
+	f.PvPTimeouts++
+	skill := pickSkillWithEncore(0, f.PlayerSkills, f.PlayerSkillPP, &f.PlayerEncoreSkill, &f.PlayerEncoreTurns)
+	if skill == 0 {
+		skill = selectRandomSkillWithPP(f.PlayerSkills, f.PlayerSkillPP)
+	}
+	if skill == 0 && len(f.PlayerSkills) > 0 {
+		skill = f.PlayerSkills[0]
+	}
+	if pp, maxPP, ok := consumeSkillPP(f.PlayerSkillPP, skill); ok {
+		if ctx, online := pvpUserContext(srv, state, user.ID); online {
+			sendSkillPPUpdate(ctx, skill, pp, maxPP)
+		}
+	}
+	f.PendingSkillID = skill
+}
+
+// handlePvPDisconnect starts the reconnect window for a player who drops out
+// of a PvP fight and takes them out of the matchmaking queue.
+func handlePvPDisconnect(state *State, conn net.Conn) {
+	userID, ok := state.ConnUser(conn)
+	if !ok {
+		return
+	}
+	state.matchmaker.Leave(userID)
+	state.fightMu.Lock()
+	defer state.fightMu.Unlock()
+	user := state.GetOrCreateUser(userID)
+	if user.Fight != nil && user.Fight.OpponentUserID != 0 {
+		user.Fight.DisconnectedAt = time.Now()
+	}
+}
+
+// resumePvPFight puts a returning player back into their fight scene.
+func resumePvPFight(ctx *gateway.Context, state *State, user *User) {
+	state.fightMu.Lock()
+	defer state.fightMu.Unlock()
+	f := user.Fight
+	if f == nil || f.OpponentUserID == 0 {
+		return
+	}
+	opp := state.GetOrCreateUser(f.OpponentUserID)
+	if opp.Fight == nil || opp.Fight.OpponentUserID != user.ID {
+		return
+	}
+	f.DisconnectedAt = time.Time{}
+	ready, _ := buildNoteReadyToFightPvP(user.ID, opp.ID, user, opp)
+	ctx.Server.SendResponse(ctx.Conn, 2503, user.ID, ready)
+	if body := buildNoteStartFightPvP(user.ID, f, opp.ID, opp.Fight); len(body) > 0 {
+		ctx.Server.SendResponse(ctx.Conn, 2504, user.ID, body)
+	}
+}
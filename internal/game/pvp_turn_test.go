@@ -0,0 +1,85 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func newPvPTurnTestState() (*State, *User, *User) {
+	state := NewState()
+	a := state.GetOrCreateUser(1)
+	b := state.GetOrCreateUser(2)
+	initPvPFightState(state, a.ID, b.ID, a, b)
+	for _, u := range []*User{a, b} {
+		u.Fight.PlayerHP, u.Fight.PlayerMaxHP = 100000, 100000
+		u.Fight.EnemyHP, u.Fight.EnemyMaxHP = 100000, 100000
+		ensureFightStats(u, u.Fight)
+		ensureFightStatus(u.Fight)
+		ensureFightSkillPP(u.Fight)
+	}
+	return state, a, b
+}
+
+func TestPvPTurnTimeoutPlaysDefaultSkill(t *testing.T) {
+	state, a, b := newPvPTurnTestState()
+	now := time.Unix(1000, 0)
+	checkPvPTimers(nil, nil, state, now)
+	if a.Fight.TurnDeadline.IsZero() || !a.Fight.TurnDeadline.Equal(b.Fight.TurnDeadline) {
+		t.Fatalf("deadline not armed: %v %v", a.Fight.TurnDeadline, b.Fight.TurnDeadline)
+	}
+
+	a.Fight.PendingSkillID = a.Fight.PlayerSkills[0]
+	checkPvPTimers(nil, nil, state, now.Add(10*time.Second))
+	if a.Fight.Turn != 0 {
+		t.Fatal("turn resolved before the deadline")
+	}
+	checkPvPTimers(nil, nil, state, now.Add(31*time.Second))
+	if a.Fight == nil || a.Fight.Turn != 1 || b.Fight.Turn != 1 {
+		t.Fatal("expected the turn to resolve on timeout")
+	}
+	if a.Fight.PvPTimeouts != 0 || b.Fight.PvPTimeouts != 1 {
+		t.Fatalf("timeouts a=%d b=%d", a.Fight.PvPTimeouts, b.Fight.PvPTimeouts)
+	}
+}
+
+func TestPvPForfeitAfterRepeatedTimeouts(t *testing.T) {
+	state, a, b := newPvPTurnTestState()
+	now := time.Unix(1000, 0)
+	for i := 0; i < defaultPvPTurnConfig().MaxTimeouts; i++ {
+		checkPvPTimers(nil, nil, state, now)
+		now = now.Add(time.Minute)
+		if a.Fight != nil {
+			a.Fight.PendingSkillID = a.Fight.PlayerSkills[0]
+		}
+		checkPvPTimers(nil, nil, state, now)
+	}
+	if a.Fight != nil || b.Fight != nil || a.InFight || b.InFight {
+		t.Fatal("expected the fight to end by forfeit")
+	}
+}
+
+func TestPvPReconnectWindow(t *testing.T) {
+	state, a, b := newPvPTurnTestState()
+	now := time.Unix(1000, 0)
+	b.Fight.DisconnectedAt = now
+	checkPvPTimers(nil, nil, state, now.Add(10*time.Second))
+	if a.Fight == nil {
+		t.Fatal("fight ended inside the reconnect window")
+	}
+	checkPvPTimers(nil, nil, state, now.Add(61*time.Second))
+	if a.Fight != nil || b.Fight != nil {
+		t.Fatal("expected forfeit once the reconnect window closed")
+	}
+}
+
+func TestPvPForfeitWinner(t *testing.T) {
+	if w := pvpForfeitWinner(1, true, 2, false); w != 2 {
+		t.Fatalf("winner=%d", w)
+	}
+	if w := pvpForfeitWinner(1, false, 2, true); w != 1 {
+		t.Fatalf("winner=%d", w)
+	}
+	if w := pvpForfeitWinner(1, true, 2, true); w != 0 {
+		t.Fatalf("winner=%d", w)
+	}
+}
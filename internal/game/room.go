@@ -0,0 +1,186 @@
+package game
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"jseer/internal/protocol"
+)
+
+// roomMapID is the map every room is drawn on. Who sees whom inside a room
+// is decided by the ChannelRoom set keyed by the owner's ID instead.
+const roomMapID uint32 = 500001
+
+// Room privacy, set by the owner with 10010.
+const (
+	roomPrivacyOpen uint32 = iota
+	roomPrivacyFriends
+	roomPrivacyClosed
+)
+
+const (
+	roomOK uint32 = iota
+	roomNotFound
+	roomNotFriend
+	roomClosed
+	roomBlocked
+	roomNotVisitor
+	roomBadPrivacy
+)
+
+// canVisitRoom applies the owner's privacy setting and blacklist. Owners
+// can always enter their own room.
+func canVisitRoom(owner *User, visitorID uint32) uint32 {
+	switch {
+	case owner.ID == visitorID:
+		return roomOK
+	case hasBlacklisted(owner, visitorID):
+		return roomBlocked
+	case owner.RoomPrivacy == roomPrivacyClosed:
+		return roomClosed
+	case owner.RoomPrivacy == roomPrivacyFriends && !hasFriend(owner, visitorID):
+		return roomNotFriend
+	}
+	return roomOK
+}
+
+// findRoomOwner returns the owner with their fitments, loading offline
+// owners from storage without caching them.
+func findRoomOwner(deps *Deps, state *State, user *User, ownerID uint32) (*User, bool) {
+	if ownerID == user.ID {
+		return user, true
+	}
+	return findPlayer(deps, state, ownerID)
+}
+
+// findRoomPetOwner is findRoomOwner with the owner's pets, read from
+// storage for offline owners.
+func findRoomPetOwner(deps *Deps, state *State, user *User, ownerID uint32) (*User, bool) {
+	owner, ok := findRoomOwner(deps, state, user, ownerID)
+	if !ok {
+		return nil, false
+	}
+	if _, loaded := loadedUser(deps, state, ownerID); !loaded {
+		loadStoredPets(deps, owner)
+	}
+	return owner, true
+}
+
+// EnterRoom moves userID off their map into ownerID's room.
+func (s *State) EnterRoom(userID, ownerID uint32) {
+	s.UpdatePlayerMap(userID, 0)
+	s.mu.Lock()
+	if u, ok := s.users[userID]; ok {
+		u.MapID = roomMapID
+		u.RoomOwner = ownerID
+	}
+	s.mu.Unlock()
+	s.JoinChannel(ChannelRoom, ownerID, userID)
+}
+
+// enterRoom checks access and puts user in ownerID's room. The caller
+// announces the entry once the user's position is set.
+func enterRoom(deps *Deps, state *State, user *User, ownerID uint32) (uint32, *User) {
+	owner, ok := findRoomOwner(deps, state, user, ownerID)
+	if !ok {
+		return roomNotFound, nil
+	}
+	if r := canVisitRoom(owner, user.ID); r != roomOK {
+		return r, nil
+	}
+	if user.RoomOwner != 0 {
+		leaveRoom(state, user, 0)
+	}
+	state.EnterRoom(user.ID, ownerID)
+	return roomOK, owner
+}
+
+// leaveRoom moves user from their room to mapID and tells the visitors
+// left behind with a 2002.
+func leaveRoom(state *State, user *User, mapID uint32) {
+	ownerID := user.RoomOwner
+	state.UpdatePlayerMap(user.ID, mapID)
+	if ownerID == 0 {
+		return
+	}
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, user.ID)
+	state.BroadcastToChannel(ChannelRoom, ownerID, protocol.BuildResponse(2002, user.ID, 0, buf.Bytes()))
+}
+
+// evictFromRoom sends a visitor back to the map they came from and pushes
+// 10012 with the owner's ID so the client leaves the room scene.
+func evictFromRoom(state *State, visitor *User) {
+	ownerID := visitor.RoomOwner
+	mapID := visitor.LastMapID
+	if mapID == 0 || mapID == roomMapID {
+		mapID = 1
+	}
+	leaveRoom(state, visitor, mapID)
+	if conn, ok := state.GetConn(visitor.ID); ok {
+		buf := new(bytes.Buffer)
+		binary.Write(buf, binary.BigEndian, ownerID)
+		_, _ = conn.Write(protocol.BuildResponse(10012, visitor.ID, 0, buf.Bytes()))
+	}
+}
+
+// kickRoomVisitor lets an owner remove someone standing in their room.
+func kickRoomVisitor(state *State, owner *User, visitorID uint32) uint32 {
+	visitor, ok := state.GetUser(visitorID)
+	if !ok || visitorID == owner.ID || visitor.RoomOwner != owner.ID {
+		return roomNotVisitor
+	}
+	evictFromRoom(state, visitor)
+	return roomOK
+}
+
+// setRoomPrivacy stores the owner's setting and evicts visitors it no
+// longer admits.
+func setRoomPrivacy(deps *Deps, state *State, owner *User, privacy uint32) uint32 {
+	if privacy > roomPrivacyClosed {
+		return roomBadPrivacy
+	}
+	owner.RoomPrivacy = privacy
+	savePlayer(deps, owner.ID, owner)
+	for _, id := range state.ChannelMembers(ChannelRoom, owner.ID) {
+		if canVisitRoom(owner, id) == roomOK {
+			continue
+		}
+		if v, ok := state.GetUser(id); ok {
+			evictFromRoom(state, v)
+		}
+	}
+	return roomOK
+}
+
+// scenePlayers lists who the user can see: room visitors inside a room,
+// otherwise everyone on the map.
+func scenePlayers(state *State, user *User) []uint32 {
+	if user.RoomOwner != 0 {
+		return state.ChannelMembers(ChannelRoom, user.RoomOwner)
+	}
+	return state.GetPlayersInMap(user.MapID)
+}
+
+// broadcastToScene is BroadcastToMap that keeps room traffic inside the
+// room.
+func broadcastToScene(state *State, user *User, payload []byte) {
+	if user.RoomOwner != 0 {
+		state.BroadcastToChannel(ChannelRoom, user.RoomOwner, payload)
+		return
+	}
+	state.BroadcastToMap(user.MapID, payload)
+}
+
+// buildScenePlayerList is the 2003 body for the user's current scene.
+func buildScenePlayerList(state *State, user *User) []byte {
+	players := scenePlayers(state, user)
+	now := uint32(time.Now().Unix())
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, uint32(len(players)))
+	for _, pid := range players {
+		buf.Write(buildPeopleInfo(pid, state.GetOrCreateUser(pid), now))
+	}
+	return buf.Bytes()
+}
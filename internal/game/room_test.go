@@ -0,0 +1,116 @@
+package game
+
+import (
+	"io"
+	"net"
+	"sort"
+	"testing"
+)
+
+// newRoomTestUsers registers online users whose pushes are discarded.
+func newRoomTestUsers(t *testing.T, state *State, ids ...uint32) []*User {
+	users := newTeamTestUsers(state, ids...)
+	for _, u := range users {
+		c, peer := net.Pipe()
+		t.Cleanup(func() { c.Close(); peer.Close() })
+		go io.Copy(io.Discard, peer)
+		state.RegisterConn(u.ID, c)
+		state.UpdatePlayerMap(u.ID, 8)
+	}
+	return users
+}
+
+func sortedScene(state *State, u *User) []uint32 {
+	ids := scenePlayers(state, u)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestRoomVisitorsOnlySeeTheirRoom(t *testing.T) {
+	state := NewState()
+	users := newRoomTestUsers(t, state, 1, 2, 3, 4)
+
+	for _, visit := range [][2]int{{0, 0}, {1, 0}, {2, 2}, {3, 2}} {
+		u, owner := users[visit[0]], users[visit[1]]
+		if r, _ := enterRoom(nil, state, u, owner.ID); r != roomOK {
+			t.Fatalf("user %d entering room %d=%d", u.ID, owner.ID, r)
+		}
+	}
+	if got := sortedScene(state, users[1]); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("room 1 scene=%v", got)
+	}
+	if got := sortedScene(state, users[3]); len(got) != 2 || got[0] != 3 || got[1] != 4 {
+		t.Fatalf("room 3 scene=%v", got)
+	}
+	if got := state.GetPlayersInMap(roomMapID); len(got) != 0 {
+		t.Fatalf("room users left on the shared map: %v", got)
+	}
+
+	leaveRoom(state, users[1], 8)
+	if users[1].RoomOwner != 0 || users[1].MapID != 8 || len(scenePlayers(state, users[0])) != 1 {
+		t.Fatalf("leave: owner=%d map=%d", users[1].RoomOwner, users[1].MapID)
+	}
+}
+
+func TestRoomPrivacy(t *testing.T) {
+	state := NewState()
+	users := newRoomTestUsers(t, state, 1, 2, 3)
+	owner, stranger, friend := users[0], users[1], users[2]
+	owner.Friends = []FriendInfo{{UserID: friend.ID}}
+
+	enterRoom(nil, state, stranger, owner.ID)
+	enterRoom(nil, state, friend, owner.ID)
+	if r := setRoomPrivacy(nil, state, owner, 9); r != roomBadPrivacy {
+		t.Fatalf("bad privacy=%d", r)
+	}
+	if r := setRoomPrivacy(nil, state, owner, roomPrivacyFriends); r != roomOK {
+		t.Fatalf("friends only=%d", r)
+	}
+	if stranger.RoomOwner != 0 || friend.RoomOwner != owner.ID {
+		t.Fatalf("after friends only: stranger in %d, friend in %d", stranger.RoomOwner, friend.RoomOwner)
+	}
+	if r, _ := enterRoom(nil, state, stranger, owner.ID); r != roomNotFriend {
+		t.Fatalf("stranger visit=%d", r)
+	}
+
+	setRoomPrivacy(nil, state, owner, roomPrivacyClosed)
+	if friend.RoomOwner != 0 {
+		t.Fatal("friend kept in closed room")
+	}
+	if r, _ := enterRoom(nil, state, friend, owner.ID); r != roomClosed {
+		t.Fatalf("friend visit=%d", r)
+	}
+	if r, _ := enterRoom(nil, state, owner, owner.ID); r != roomOK {
+		t.Fatalf("owner visit=%d", r)
+	}
+
+	setRoomPrivacy(nil, state, owner, roomPrivacyOpen)
+	owner.Blacklist = []uint32{stranger.ID}
+	if r, _ := enterRoom(nil, state, stranger, owner.ID); r != roomBlocked {
+		t.Fatalf("blacklisted visit=%d", r)
+	}
+	if r, _ := enterRoom(nil, state, stranger, 99); r != roomNotFound {
+		t.Fatalf("unknown room=%d", r)
+	}
+}
+
+func TestRoomKick(t *testing.T) {
+	state := NewState()
+	users := newRoomTestUsers(t, state, 1, 2, 3)
+	owner, visitor := users[0], users[1]
+	visitor.LastMapID = 8
+	enterRoom(nil, state, visitor, owner.ID)
+
+	if r := kickRoomVisitor(state, owner, users[2].ID); r != roomNotVisitor {
+		t.Fatalf("kick outsider=%d", r)
+	}
+	if r := kickRoomVisitor(state, users[2], visitor.ID); r != roomNotVisitor {
+		t.Fatalf("kick by non-owner=%d", r)
+	}
+	if r := kickRoomVisitor(state, owner, visitor.ID); r != roomOK {
+		t.Fatalf("kick=%d", r)
+	}
+	if visitor.RoomOwner != 0 || visitor.MapID != 8 {
+		t.Fatalf("kicked visitor: room=%d map=%d", visitor.RoomOwner, visitor.MapID)
+	}
+}
@@ -0,0 +1,1065 @@
+package game
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// effectContext carries the state a skill side effect may read or change
+// while one skill is being resolved. params holds the bound parameters of
+// the primitive being run.
+type effectContext struct {
+	f      *FightState
+	player bool
+	skill  *SkillInfo
+	first  bool
+	params map[string]int
+	damage int
+	gainHP int
+}
+
+type effectHandler func(c *effectContext)
+
+// effectPrimitive is one building block of move_effects.xml. Hits and
+// Modify run before damage is rolled (Modify edits a copy of the skill),
+// Damage adjusts the rolled damage and Apply runs once it has landed.
+type effectPrimitive struct {
+	Hits   func(c *effectContext) uint32
+	Modify func(c *effectContext, info *SkillInfo)
+	Damage effectHandler
+	Apply  effectHandler
+}
+
+// moveEffect is one Effect entry of move_effects.xml.
+type moveEffect struct {
+	ID   int
+	Args []string
+	Desc string
+	Ops  []moveEffectOp
+}
+
+// moveEffectOp keeps literal parameters in Params and "$name" references
+// to the effect's args in Refs.
+type moveEffectOp struct {
+	Name   string
+	Params map[string]int
+	Refs   map[string]string
+	prim   *effectPrimitive
+}
+
+// effectOp is a moveEffectOp bound to the args of one skill.
+type effectOp struct {
+	Name   string
+	Params map[string]int
+	prim   *effectPrimitive
+}
+
+type effectCall struct {
+	ID     int
+	Args   []int
+	Effect *moveEffect
+	Ops    []effectOp
+}
+
+const (
+	effectTargetFoe = iota
+	effectTargetSelf
+	effectTargetBoth
+)
+
+// Lasting effects added by the buff primitive.
+const (
+	buffReflect = iota + 1
+	buffShield
+	buffResist
+	buffGuardCategory
+	buffEvade
+	buffPower
+	buffCrit
+	buffCritGuard
+	buffRegen
+	buffDot
+	buffDrain
+	buffRetaliate
+	buffStrike
+	buffHitStage
+	buffGrow
+	buffSureHit
+	buffSeal
+	buffHealBlock
+	buffPerish
+	buffDelayedHeal
+	buffStreak
+)
+
+// fightBuff is a lasting effect on one side of a fight. Turns below zero
+// never run out.
+type fightBuff struct {
+	Kind  int
+	Turns int
+	Value int
+	Arg   int
+}
+
+var statusNames = map[string]int{
+	"paralysis": statusParalysis,
+	"poison":    statusPoison,
+	"burn":      statusBurn,
+	"freeze":    statusFreeze,
+	"fear":      statusFear,
+	"fatigue":   statusFatigue,
+	"sleep":     statusSleep,
+	"petrify":   statusPetrify,
+	"confuse":   statusConfuse,
+	"bleed":     statusBleed,
+}
+
+var statNames = map[string]int{
+	"atk":      0,
+	"def":      1,
+	"sp_atk":   2,
+	"sp_def":   3,
+	"spd":      4,
+	"accuracy": 5,
+}
+
+// effectParamNames maps the symbolic values move_effects.xml may use for a
+// parameter. Anything else has to be a number.
+var effectParamNames = map[string]map[string]int{
+	"target": {"foe": effectTargetFoe, "self": effectTargetSelf, "both": effectTargetBoth},
+	"status": statusNames,
+	"stat":   statNames,
+	"arg":    mergeNames(statusNames, statNames),
+	"kind": {
+		"protect":        1,
+		"mist":           2,
+		"safeguard":      3,
+		"reflect":        buffReflect,
+		"shield":         buffShield,
+		"resist":         buffResist,
+		"guard_category": buffGuardCategory,
+		"evade":          buffEvade,
+		"power":          buffPower,
+		"crit":           buffCrit,
+		"crit_guard":     buffCritGuard,
+		"regen":          buffRegen,
+		"dot":            buffDot,
+		"drain":          buffDrain,
+		"retaliate":      buffRetaliate,
+		"strike":         buffStrike,
+		"hit_stage":      buffHitStage,
+		"grow":           buffGrow,
+		"sure_hit":       buffSureHit,
+		"seal":           buffSeal,
+		"heal_block":     buffHealBlock,
+		"perish":         buffPerish,
+		"delayed_heal":   buffDelayedHeal,
+	},
+}
+
+func mergeNames(maps ...map[string]int) map[string]int {
+	out := make(map[string]int)
+	for _, m := range maps {
+		for k, v := range m {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+var effectPrimitives = map[string]*effectPrimitive{
+	"hits": {Hits: func(c *effectContext) uint32 {
+		minHits := maxInt(1, c.val("min", 1))
+		maxHits := maxInt(minHits, c.val("max", minHits))
+		return uint32(minHits + rand.Intn(maxHits-minHits+1))
+	}},
+
+	"power_foe_low_hp": {Modify: func(c *effectContext, info *SkillInfo) {
+		if hp, maxHP := c.defenderHP(); maxHP > 0 && hp < maxHP/2 {
+			c.scalePower(info)
+		}
+	}},
+	"power_self_low_hp": {Modify: func(c *effectContext, info *SkillInfo) {
+		if hp, maxHP := c.attackerHP(); maxHP > 0 && hp < maxHP/c.pos("div", 2) {
+			c.scalePower(info)
+		}
+	}},
+	"power_order": {Modify: func(c *effectContext, info *SkillInfo) {
+		if c.first == (c.val("first", 1) == 1) {
+			c.scalePower(info)
+		}
+	}},
+	"power_foe_status": {Modify: func(c *effectContext, info *SkillInfo) {
+		status := c.defenderStatus()
+		if id, ok := c.params["status"]; ok && status[id] > 0 || !ok && hasMajorStatus(status) {
+			c.scalePower(info)
+		}
+	}},
+	"power_foe_stages": {Modify: func(c *effectContext, info *SkillInfo) {
+		info.Power += sumPositiveStages(*c.defenderStage()) * c.val("step", 20)
+	}},
+	"power_self_stages": {Modify: func(c *effectContext, info *SkillInfo) {
+		info.Power = c.val("base", info.Power) + sumPositiveStages(*c.attackerStage())*c.val("step", 20)
+	}},
+	"power_streak": {Modify: func(c *effectContext, info *SkillInfo) {
+		b := findBuff(c.f, c.player, buffStreak)
+		if b == nil || b.Arg != info.ID {
+			addBuff(c.f, c.player, fightBuff{Kind: buffStreak, Turns: -1, Arg: info.ID})
+			return
+		}
+		b.Value++
+		info.Power += minInt(b.Value*c.val("step", 20), c.val("max", 80))
+	}},
+	"power_random": {Modify: func(c *effectContext, info *SkillInfo) {
+		lo := c.val("min", 40)
+		hi := maxInt(lo, c.val("max", 160))
+		info.Power = lo + rand.Intn(hi-lo+1)
+	}},
+	"power_lost_hp": {Modify: func(c *effectContext, info *SkillInfo) {
+		hp, maxHP := c.attackerHP()
+		if maxHP > 0 {
+			info.Power = maxInt(20, c.val("max", 200)*(maxHP-hp)/maxHP)
+		}
+	}},
+	"power_dv": {Modify: func(c *effectContext, info *SkillInfo) {
+		info.PwrBindDv = 1
+		if c.val("mul", 5) >= 10 {
+			info.PwrBindDv = 2
+		}
+	}},
+	"crit": {Modify: func(c *effectContext, info *SkillInfo) {
+		info.CritRate = minInt(16, maxInt(1, info.CritRate)+c.val("rate", 1))
+	}},
+	"must_hit": {Modify: func(c *effectContext, info *SkillInfo) {
+		info.MustHit = true
+	}},
+
+	"damage_equalize": {Damage: func(c *effectContext) {
+		hp, _ := c.attackerHP()
+		foeHP, _ := c.defenderHP()
+		c.damage = maxInt(0, foeHP-hp)
+	}},
+	"damage_mercy": {Damage: func(c *effectContext) {
+		hp, _ := c.defenderHP()
+		if hp-c.damage < 1 {
+			c.damage = maxInt(0, hp-1)
+		}
+	}},
+	"damage_ohko": {Damage: func(c *effectContext) {
+		if c.roll(1) {
+			c.damage, _ = c.defenderHP()
+		}
+	}},
+	"damage_counter": {Damage: func(c *effectContext) {
+		c.damage = *c.attackerCounter(&c.f.PlayerDamageTaken, &c.f.EnemyDamageTaken) * c.val("mul", 2)
+	}},
+	"damage_level": {Damage: func(c *effectContext) {
+		level := c.f.EnemyLevel
+		if c.player {
+			level = c.f.PlayerLevel
+		}
+		c.damage = maxInt(1, int(level))
+	}},
+	"damage_add": {Damage: func(c *effectContext) {
+		if c.roll(100) {
+			c.damage += c.val("amount", 0)
+		}
+	}},
+	"damage_bonus": {Damage: func(c *effectContext) {
+		if c.roll(100) {
+			c.damage += c.damage * c.val("pct", 100) / 100
+		}
+	}},
+	"damage_self_hp": {Damage: func(c *effectContext) {
+		c.damage, _ = c.attackerHP()
+	}},
+
+	"drain": {Apply: func(c *effectContext) {
+		if c.damage <= 0 {
+			return
+		}
+		amount := c.damage * c.val("pct", 50) / 100
+		if div, ok := c.params["div"]; ok && div > 0 {
+			amount = c.damage / div
+		}
+		c.gainHP += c.healAttacker(amount)
+	}},
+	"recoil": {Apply: func(c *effectContext) {
+		if c.damage <= 0 {
+			return
+		}
+		amount := c.damage / c.pos("div", 4)
+		if pct, ok := c.params["pct"]; ok {
+			amount = c.damage * pct / 100
+		}
+		c.damageSide(c.player, amount)
+	}},
+	"heal": {Apply: func(c *effectContext) {
+		_, maxHP := c.attackerHP()
+		amount := maxHP / c.pos("div", 2)
+		if pct, ok := c.params["pct"]; ok {
+			amount = maxHP * pct / 100
+		} else if n, ok := c.params["amount"]; ok {
+			amount = n
+		}
+		c.gainHP += c.healAttacker(amount)
+	}},
+	"heal_full": {Apply: func(c *effectContext) {
+		_, maxHP := c.attackerHP()
+		c.gainHP += c.healAttacker(maxHP)
+	}},
+	"hurt": {Apply: func(c *effectContext) {
+		for _, side := range c.targets(effectTargetFoe) {
+			maxHP := c.f.EnemyMaxHP
+			if side {
+				maxHP = c.f.PlayerMaxHP
+			}
+			c.damageSide(side, maxHP/c.pos("div", 4))
+		}
+	}},
+	"faint_self": {Apply: func(c *effectContext) {
+		hp, _ := c.attackerHP()
+		c.damageSide(c.player, hp)
+	}},
+
+	"stage": {Apply: func(c *effectContext) {
+		if !c.roll(100) {
+			return
+		}
+		stages := c.val("stages", 1)
+		if down, ok := c.params["down"]; ok {
+			stages = -down
+		}
+		applyStageChangeTo(c.f, c.player, c.target(effectTargetSelf) == effectTargetFoe, c.val("stat", 0), stages)
+	}},
+	"stages_all": {Apply: func(c *effectContext) {
+		if !c.roll(100) {
+			return
+		}
+		stages := c.val("stages", 1)
+		if down, ok := c.params["down"]; ok {
+			stages = -down
+		}
+		for stat := 0; stat <= 4; stat++ {
+			applyStageChangeTo(c.f, c.player, c.target(effectTargetSelf) == effectTargetFoe, stat, stages)
+		}
+	}},
+	"clear_stages": {Apply: func(c *effectContext) {
+		if !c.roll(100) {
+			return
+		}
+		sign := c.val("sign", 0)
+		for _, side := range c.targets(effectTargetSelf) {
+			for _, v := range stageFields(c.sideStage(side)) {
+				if sign == 0 || sign < 0 && *v < 0 || sign > 0 && *v > 0 {
+					*v = 0
+				}
+			}
+		}
+	}},
+	"invert_stages": {Apply: func(c *effectContext) {
+		for _, side := range c.targets(effectTargetFoe) {
+			for _, v := range stageFields(c.sideStage(side)) {
+				if *v > 0 {
+					*v = -*v
+				}
+			}
+		}
+	}},
+	"steal_stages": {Apply: func(c *effectContext) {
+		own, foe := stageFields(c.attackerStage()), stageFields(c.defenderStage())
+		for i := range foe {
+			if *foe[i] > 0 {
+				*own[i] = clampStage(*own[i] + *foe[i])
+				*foe[i] = 0
+			}
+		}
+	}},
+	"copy_stages": {Apply: func(c *effectContext) {
+		*c.attackerStage() = *c.defenderStage()
+	}},
+	"reflect_debuff": {Apply: func(c *effectContext) {
+		own, foe := stageFields(c.attackerStage()), stageFields(c.defenderStage())
+		misted := isMisted(c.f, !c.player)
+		for i := range own {
+			if *own[i] < 0 {
+				if !misted {
+					*foe[i] = clampStage(*foe[i] + *own[i])
+				}
+				*own[i] = 0
+			}
+		}
+	}},
+	"status": {Apply: func(c *effectContext) {
+		applyStatusWithChance(c.f, c.player, c.val("status", statusParalysis), nil, c.val("chance", 10), c.pos("turns", 2))
+	}},
+	"status_random": {Apply: func(c *effectContext) {
+		ids := []int{statusParalysis, statusPoison, statusBurn, statusFreeze, statusConfuse}
+		applyStatusWithChance(c.f, c.player, ids[rand.Intn(len(ids))], nil, c.val("chance", 20), c.pos("turns", 3))
+	}},
+	"cure": {Apply: func(c *effectContext) {
+		for _, side := range c.targets(effectTargetSelf) {
+			status := c.f.EnemyStatus
+			if side {
+				status = c.f.PlayerStatus
+			}
+			for id := range status {
+				if id != statusFatigue {
+					delete(status, id)
+				}
+			}
+		}
+	}},
+	"seed": {Apply: func(c *effectContext) {
+		counter := c.attackerCounter(&c.f.EnemySeeded, &c.f.PlayerSeeded)
+		*counter = maxInt(*counter, c.pos("turns", 5))
+	}},
+	"flinch": {Apply: func(c *effectContext) {
+		if c.roll(10) {
+			setFlinch(c.f, c.player)
+		}
+	}},
+	"fatigue": {Apply: func(c *effectContext) {
+		if !c.roll(100) {
+			return
+		}
+		turns := c.pos("turns", 1)
+		for _, side := range c.targets(effectTargetSelf) {
+			counter := &c.f.EnemyFatigue
+			if side {
+				counter = &c.f.PlayerFatigue
+			}
+			*counter = maxInt(*counter, turns)
+		}
+	}},
+	"bind": {Apply: func(c *effectContext) {
+		if c.roll(100) {
+			setBound(c.f, c.player, c.pos("turns", 4))
+		}
+	}},
+	"pp_down": {Apply: func(c *effectContext) {
+		if !c.roll(100) {
+			return
+		}
+		pp, last := c.f.PlayerSkillPP, c.f.PlayerLastSkill
+		if c.player {
+			pp, last = c.f.EnemySkillPP, c.f.EnemyLastSkill
+		}
+		for i := 0; i < c.pos("amount", 1); i++ {
+			reduceSkillPP(pp, last)
+		}
+	}},
+	"max_hp_down": {Apply: func(c *effectContext) {
+		hp, maxHP := c.attackerCounter(&c.f.EnemyHP, &c.f.PlayerHP), c.attackerCounter(&c.f.EnemyMaxHP, &c.f.PlayerMaxHP)
+		*maxHP = maxInt(1, *maxHP-c.val("amount", 0))
+		*hp = minInt(*hp, *maxHP)
+	}},
+	"guard": {Apply: func(c *effectContext) {
+		turns := c.pos("turns", 1)
+		switch c.val("kind", 1) {
+		case 1:
+			*c.attackerCounter(&c.f.PlayerProtect, &c.f.EnemyProtect) = turns
+		case 2:
+			*c.attackerCounter(&c.f.PlayerMist, &c.f.EnemyMist) = turns
+		case 3:
+			*c.attackerCounter(&c.f.PlayerSafeguard, &c.f.EnemySafeguard) = turns
+		}
+	}},
+	"encore": {Apply: func(c *effectContext) {
+		turns := c.pos("turns", 2)
+		if c.player {
+			c.f.EnemyEncoreSkill = c.f.EnemyLastSkill
+			c.f.EnemyEncoreTurns = maxInt(c.f.EnemyEncoreTurns, turns)
+		} else {
+			c.f.PlayerEncoreSkill = c.f.PlayerLastSkill
+			c.f.PlayerEncoreTurns = maxInt(c.f.PlayerEncoreTurns, turns)
+		}
+	}},
+	"swap_type": {Apply: func(c *effectContext) {
+		c.f.PlayerType, c.f.EnemyType = c.f.EnemyType, c.f.PlayerType
+	}},
+	"copy_type": {Apply: func(c *effectContext) {
+		if c.player {
+			c.f.PlayerType = c.f.EnemyType
+		} else {
+			c.f.EnemyType = c.f.PlayerType
+		}
+	}},
+	"buff": {Apply: func(c *effectContext) {
+		if !c.roll(100) {
+			return
+		}
+		turns := c.pos("turns", 1)
+		if hi := c.val("turns_max", 0); hi > turns {
+			turns += rand.Intn(hi - turns + 1)
+		}
+		kind := c.val("kind", 0)
+		for _, side := range c.targets(effectTargetSelf) {
+			addBuff(c.f, side, fightBuff{Kind: kind, Turns: turns, Value: c.buffValue(side, kind), Arg: c.val("arg", 0)})
+		}
+	}},
+}
+
+// buffValue reads value, pct, mul (×100) or div (100/div). For regen and
+// dot div is a share of the target's max HP instead.
+func (c *effectContext) buffValue(side bool, kind int) int {
+	value := c.val("value", 0)
+	if pct, ok := c.params["pct"]; ok {
+		value = pct
+	}
+	if mul, ok := c.params["mul"]; ok {
+		value = mul * 100
+	}
+	if div, ok := c.params["div"]; ok && div > 0 {
+		value = 100 / div
+		if kind == buffRegen || kind == buffDot {
+			maxHP := c.f.EnemyMaxHP
+			if side {
+				maxHP = c.f.PlayerMaxHP
+			}
+			value = maxInt(1, maxHP/div)
+		}
+	}
+	return value
+}
+
+// moveEffectDB is move_effects.xml, loaded once.
+type moveEffectDB struct {
+	mu      sync.Mutex
+	loaded  bool
+	err     error
+	effects map[int]*moveEffect
+}
+
+var globalMoveEffects = &moveEffectDB{}
+
+func LoadMoveEffects() map[int]*moveEffect {
+	db := globalMoveEffects
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.loaded {
+		return db.effects
+	}
+	db.effects = make(map[int]*moveEffect)
+	if path := resolveMoveEffectsPath(); path != "" {
+		db.err = loadMoveEffects(path, db.effects)
+	}
+	db.loaded = true
+	return db.effects
+}
+
+func resolveMoveEffectsPath() string {
+	if v := os.Getenv("JSEER_MOVE_EFFECTS_PATH"); v != "" {
+		return v
+	}
+	path := filepath.Join(resolveDataRoot(), "move_effects.xml")
+	if st, err := os.Stat(path); err == nil && !st.IsDir() {
+		return path
+	}
+	return ""
+}
+
+type moveEffectsXML struct {
+	Effects []struct {
+		ID   int    `xml:"ID,attr"`
+		Args string `xml:"Args,attr"`
+		Desc string `xml:"Desc,attr"`
+		Ops  []struct {
+			Name  string     `xml:"Name,attr"`
+			Attrs []xml.Attr `xml:",any,attr"`
+		} `xml:"Op"`
+	} `xml:"Effect"`
+}
+
+// loadMoveEffects parses path into out. Unknown primitives or parameter
+// values fail the load so a typo cannot silently disable an effect.
+func loadMoveEffects(path string, out map[int]*moveEffect) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var doc moveEffectsXML
+	if err := xml.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+	for _, e := range doc.Effects {
+		effect := &moveEffect{ID: e.ID, Args: strings.Fields(e.Args), Desc: e.Desc}
+		for _, o := range e.Ops {
+			op := moveEffectOp{Name: o.Name, Params: map[string]int{}, Refs: map[string]string{}, prim: effectPrimitives[o.Name]}
+			if op.prim == nil {
+				return fmt.Errorf("effect %d: unknown op %q", e.ID, o.Name)
+			}
+			for _, attr := range o.Attrs {
+				name := attr.Name.Local
+				if name == "Name" {
+					continue
+				}
+				if ref, ok := strings.CutPrefix(attr.Value, "$"); ok {
+					op.Refs[name] = ref
+					continue
+				}
+				v, err := parseEffectParam(name, attr.Value)
+				if err != nil {
+					return fmt.Errorf("effect %d op %s: %w", e.ID, o.Name, err)
+				}
+				op.Params[name] = v
+			}
+			effect.Ops = append(effect.Ops, op)
+		}
+		out[effect.ID] = effect
+	}
+	return nil
+}
+
+func parseEffectParam(name, value string) (int, error) {
+	if v, err := strconv.Atoi(value); err == nil {
+		return v, nil
+	}
+	if v, ok := effectParamNames[name][value]; ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("bad %s %q", name, value)
+}
+
+// bind applies args to the effect's ops: literal attributes are defaults,
+// args override parameters of the same name and "$name" references are
+// resolved last.
+func (e *moveEffect) bind(args []int) []effectOp {
+	named := make(map[string]int, len(e.Args))
+	for i, name := range e.Args {
+		if i < len(args) {
+			named[name] = args[i]
+		}
+	}
+	ops := make([]effectOp, 0, len(e.Ops))
+	for _, op := range e.Ops {
+		params := make(map[string]int, len(op.Params)+len(named))
+		for k, v := range op.Params {
+			params[k] = v
+		}
+		for k, v := range named {
+			params[k] = v
+		}
+		for k, ref := range op.Refs {
+			if v, ok := named[ref]; ok {
+				params[k] = v
+			} else {
+				delete(params, k)
+			}
+		}
+		ops = append(ops, effectOp{Name: op.Name, Params: params, prim: op.prim})
+	}
+	return ops
+}
+
+// skillEffectCalls splits SideEffectArg across every SideEffect of a skill:
+// each effect takes as many args as it names, the last one takes the rest.
+// Unknown effects are skipped and consume no args.
+func skillEffectCalls(info *SkillInfo) []effectCall {
+	if info == nil {
+		return nil
+	}
+	ids := info.SideEffects
+	if len(ids) == 0 && info.SideEffect > 0 {
+		ids = []int{info.SideEffect}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	effects := LoadMoveEffects()
+	args := parseEffectArgs(info.SideEffectArg)
+	calls := make([]effectCall, 0, len(ids))
+	for i, id := range ids {
+		effect := effects[id]
+		if effect == nil {
+			continue
+		}
+		n := len(args)
+		if i < len(ids)-1 && len(effect.Args) < n {
+			n = len(effect.Args)
+		}
+		calls = append(calls, effectCall{ID: id, Args: args[:n], Effect: effect, Ops: effect.bind(args[:n])})
+		args = args[n:]
+	}
+	return calls
+}
+
+// eachEffectOp runs fn for every bound op of calls with c.params set.
+func (c *effectContext) eachEffectOp(calls []effectCall, fn func(p *effectPrimitive)) {
+	for _, call := range calls {
+		for _, op := range call.Ops {
+			c.params = op.Params
+			fn(op.prim)
+		}
+	}
+}
+
+// applyEffects runs the Apply step of every op, skipping ops gated on a
+// min_damage the hit did not reach.
+func (c *effectContext) applyEffects(calls []effectCall) {
+	c.eachEffectOp(calls, func(p *effectPrimitive) {
+		if p.Apply == nil {
+			return
+		}
+		if v, ok := c.params["min_damage"]; ok && c.damage < v {
+			return
+		}
+		p.Apply(c)
+	})
+}
+
+// unimplementedSkillEffects lists side effect IDs referenced by skills.xml
+// that move_effects.xml does not define.
+func unimplementedSkillEffects() []int {
+	effects := LoadMoveEffects()
+	seen := make(map[int]struct{})
+	var out []int
+	for _, info := range LoadPetDB().skills {
+		for _, id := range info.SideEffects {
+			if _, ok := seen[id]; ok || id <= 0 {
+				continue
+			}
+			seen[id] = struct{}{}
+			if effects[id] == nil {
+				out = append(out, id)
+			}
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+func reportSkillEffectCoverage(logger *zap.Logger) {
+	if logger == nil {
+		return
+	}
+	LoadMoveEffects()
+	if err := globalMoveEffects.err; err != nil {
+		logger.Error("move effects load failed", zap.Error(err))
+	}
+	missing := unimplementedSkillEffects()
+	if len(missing) == 0 {
+		return
+	}
+	logger.Warn("skill side effects missing from move_effects.xml", zap.Int("count", len(missing)), zap.Ints("effect_ids", missing))
+}
+
+// tickFightEffects runs the once-per-turn part of lasting side effects.
+func tickFightEffects(f *FightState) {
+	if f == nil {
+		return
+	}
+	if f.PlayerSeeded > 0 {
+		drain := minInt(f.PlayerHP, maxInt(1, f.PlayerMaxHP/8))
+		f.PlayerHP -= drain
+		f.EnemyHP = minInt(f.EnemyMaxHP, f.EnemyHP+drain)
+		f.PlayerSeeded--
+	}
+	if f.EnemySeeded > 0 {
+		drain := minInt(f.EnemyHP, maxInt(1, f.EnemyMaxHP/8))
+		f.EnemyHP -= drain
+		f.PlayerHP = minInt(f.PlayerMaxHP, f.PlayerHP+drain)
+		f.EnemySeeded--
+	}
+	for _, v := range []*int{&f.PlayerProtect, &f.EnemyProtect, &f.PlayerMist, &f.EnemyMist, &f.PlayerSafeguard, &f.EnemySafeguard} {
+		if *v > 0 {
+			*v--
+		}
+	}
+	tickFightBuffs(f, true)
+	tickFightBuffs(f, false)
+}
+
+func tickFightBuffs(f *FightState, player bool) {
+	buffs := sideBuffs(f, player)
+	hp, maxHP := &f.EnemyHP, f.EnemyMaxHP
+	if player {
+		hp, maxHP = &f.PlayerHP, f.PlayerMaxHP
+	}
+	kept := (*buffs)[:0]
+	for _, b := range *buffs {
+		switch b.Kind {
+		case buffRegen:
+			if findBuff(f, player, buffHealBlock) == nil {
+				*hp = minInt(maxHP, *hp+b.Value)
+			}
+		case buffDot:
+			*hp = maxInt(0, *hp-b.Value)
+		case buffGrow:
+			applyStageChangeTo(f, player, false, 0, b.Value)
+			applyStageChangeTo(f, player, false, 2, b.Value)
+		}
+		if b.Turns < 0 {
+			kept = append(kept, b)
+			continue
+		}
+		b.Turns--
+		if b.Turns > 0 {
+			kept = append(kept, b)
+			continue
+		}
+		switch b.Kind {
+		case buffPerish:
+			*hp = 0
+		case buffDelayedHeal:
+			*hp = maxHP
+		}
+	}
+	*buffs = kept
+}
+
+func sideBuffs(f *FightState, player bool) *[]fightBuff {
+	if player {
+		return &f.PlayerBuffs
+	}
+	return &f.EnemyBuffs
+}
+
+func findBuff(f *FightState, player bool, kind int) *fightBuff {
+	buffs := *sideBuffs(f, player)
+	for i := range buffs {
+		if buffs[i].Kind == kind {
+			return &buffs[i]
+		}
+	}
+	return nil
+}
+
+// addBuff replaces any running buff of the same kind.
+func addBuff(f *FightState, player bool, b fightBuff) {
+	if cur := findBuff(f, player, b.Kind); cur != nil {
+		*cur = b
+		return
+	}
+	buffs := sideBuffs(f, player)
+	*buffs = append(*buffs, b)
+}
+
+func removeBuff(f *FightState, player bool, kind int) {
+	buffs := sideBuffs(f, player)
+	for i := range *buffs {
+		if (*buffs)[i].Kind == kind {
+			*buffs = append((*buffs)[:i], (*buffs)[i+1:]...)
+			return
+		}
+	}
+}
+
+// matchingBuff returns the side's buff of kind when its Arg is 0 or arg.
+func matchingBuff(f *FightState, player bool, kind int, arg int) *fightBuff {
+	b := findBuff(f, player, kind)
+	if b == nil || b.Arg != 0 && b.Arg != arg {
+		return nil
+	}
+	return b
+}
+
+func cloneBuffs(in []fightBuff) []fightBuff {
+	if in == nil {
+		return nil
+	}
+	return append([]fightBuff(nil), in...)
+}
+
+// adjustIncomingDamage applies the attacker's power buff and the
+// defender's resist, guard and shield buffs to damage.
+func adjustIncomingDamage(f *FightState, player bool, info *SkillInfo, damage int) int {
+	if damage <= 0 {
+		return 0
+	}
+	if b := matchingBuff(f, player, buffPower, info.Type); b != nil {
+		damage = damage * b.Value / 100
+	}
+	if b := matchingBuff(f, !player, buffResist, info.Type); b != nil {
+		damage -= damage * b.Value / 100
+	}
+	if b := findBuff(f, !player, buffGuardCategory); b != nil && (b.Arg == 0 || b.Arg == info.Category) {
+		damage -= damage * minInt(b.Value, 100) / 100
+	}
+	if b := findBuff(f, !player, buffShield); b != nil {
+		absorbed := minInt(damage, b.Value)
+		damage -= absorbed
+		b.Value -= absorbed
+		if b.Value <= 0 {
+			removeBuff(f, !player, buffShield)
+		}
+	}
+	return maxInt(0, damage)
+}
+
+// applyOnHitBuffs runs buffs triggered by a landed direct hit.
+func applyOnHitBuffs(c *effectContext) {
+	if c.damage <= 0 {
+		return
+	}
+	f, player := c.f, c.player
+	if b := findBuff(f, !player, buffReflect); b != nil {
+		c.damageSide(player, c.damage*b.Value/100)
+	}
+	if b := findBuff(f, !player, buffRetaliate); b != nil {
+		applyStatusWithChance(f, !player, b.Arg, nil, b.Value, defaultStatusTurns(b.Arg))
+	}
+	if b := findBuff(f, player, buffStrike); b != nil {
+		applyStatusWithChance(f, player, b.Arg, nil, b.Value, defaultStatusTurns(b.Arg))
+	}
+	if b := findBuff(f, !player, buffHitStage); b != nil {
+		applyStageChangeTo(f, !player, false, b.Arg, b.Value)
+	}
+	if b := findBuff(f, player, buffDrain); b != nil {
+		c.gainHP += c.healAttacker(c.damage * b.Value / 100)
+	}
+}
+
+func defaultStatusTurns(statusID int) int {
+	switch statusID {
+	case statusFreeze, statusConfuse:
+		return 3
+	case statusFear:
+		return 1
+	case statusSleep, statusPetrify:
+		return 2
+	}
+	return 999
+}
+
+func isProtected(f *FightState, player bool) bool {
+	if player {
+		return f.PlayerProtect > 0
+	}
+	return f.EnemyProtect > 0
+}
+
+func isMisted(f *FightState, player bool) bool {
+	if player {
+		return f.PlayerMist > 0
+	}
+	return f.EnemyMist > 0
+}
+
+// val returns the bound parameter name, def when it is not set.
+func (c *effectContext) val(name string, def int) int {
+	if v, ok := c.params[name]; ok {
+		return v
+	}
+	return def
+}
+
+// pos is val for counts and divisors, where zero also means def.
+func (c *effectContext) pos(name string, def int) int {
+	if v := c.val(name, 0); v > 0 {
+		return v
+	}
+	return def
+}
+
+// roll succeeds with the percentage chance in the chance parameter.
+func (c *effectContext) roll(def int) bool {
+	chance := c.val("chance", def)
+	return chance >= 100 || rand.Intn(100)+1 <= chance
+}
+
+// scalePower multiplies power by mul, or raises it by pct percent.
+func (c *effectContext) scalePower(info *SkillInfo) {
+	if pct, ok := c.params["pct"]; ok {
+		info.Power += info.Power * pct / 100
+		return
+	}
+	info.Power *= c.val("mul", 2)
+}
+
+func (c *effectContext) target(def int) int {
+	return c.val("target", def)
+}
+
+// targets lists the sides (true is the player) the target parameter names.
+func (c *effectContext) targets(def int) []bool {
+	switch c.target(def) {
+	case effectTargetSelf:
+		return []bool{c.player}
+	case effectTargetBoth:
+		return []bool{c.player, !c.player}
+	}
+	return []bool{!c.player}
+}
+
+func (c *effectContext) attackerHP() (int, int) {
+	if c.player {
+		return c.f.PlayerHP, c.f.PlayerMaxHP
+	}
+	return c.f.EnemyHP, c.f.EnemyMaxHP
+}
+
+func (c *effectContext) defenderHP() (int, int) {
+	if c.player {
+		return c.f.EnemyHP, c.f.EnemyMaxHP
+	}
+	return c.f.PlayerHP, c.f.PlayerMaxHP
+}
+
+func (c *effectContext) defenderStatus() map[int]int {
+	if c.player {
+		return c.f.EnemyStatus
+	}
+	return c.f.PlayerStatus
+}
+
+func (c *effectContext) sideStage(player bool) *stageModifiers {
+	if player {
+		return &c.f.PlayerStage
+	}
+	return &c.f.EnemyStage
+}
+
+func (c *effectContext) attackerStage() *stageModifiers {
+	return c.sideStage(c.player)
+}
+
+func (c *effectContext) defenderStage() *stageModifiers {
+	return c.sideStage(!c.player)
+}
+
+func stageFields(s *stageModifiers) []*int {
+	return []*int{&s.Atk, &s.Def, &s.SpA, &s.SpD, &s.Spd, &s.Acc, &s.Eva}
+}
+
+func (c *effectContext) attackerCounter(playerSide *int, enemySide *int) *int {
+	if c.player {
+		return playerSide
+	}
+	return enemySide
+}
+
+// healAttacker restores up to amount HP unless a heal block is running and
+// returns what was actually healed.
+func (c *effectContext) healAttacker(amount int) int {
+	if amount <= 0 || findBuff(c.f, c.player, buffHealBlock) != nil {
+		return 0
+	}
+	hp, maxHP := c.attackerCounter(&c.f.PlayerHP, &c.f.EnemyHP), c.attackerCounter(&c.f.PlayerMaxHP, &c.f.EnemyMaxHP)
+	healed := minInt(*maxHP-*hp, amount)
+	if healed <= 0 {
+		return 0
+	}
+	*hp += healed
+	return healed
+}
+
+func (c *effectContext) damageSide(player bool, amount int) {
+	if amount <= 0 {
+		return
+	}
+	if player {
+		c.f.PlayerHP = maxInt(0, c.f.PlayerHP-amount)
+	} else {
+		c.f.EnemyHP = maxInt(0, c.f.EnemyHP-amount)
+	}
+}
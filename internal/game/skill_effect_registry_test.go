@@ -0,0 +1,724 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var testMoveEffectsOnce sync.Once
+
+// useMoveEffectData loads data/xml/move_effects.xml; tests run from
+// internal/game where ./data does not exist.
+func useMoveEffectData(t *testing.T) {
+	t.Helper()
+	testMoveEffectsOnce.Do(func() {
+		db := globalMoveEffects
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		db.effects = make(map[int]*moveEffect)
+		db.err = loadMoveEffects("../../data/xml/move_effects.xml", db.effects)
+		db.loaded = true
+	})
+	if err := globalMoveEffects.err; err != nil {
+		t.Fatalf("load move effects: %v", err)
+	}
+}
+
+func newEffectTestFight() *FightState {
+	return &FightState{
+		PlayerHP:      100,
+		PlayerMaxHP:   200,
+		EnemyHP:       100,
+		EnemyMaxHP:    200,
+		PlayerSkillPP: map[int]int{},
+		EnemySkillPP:  map[int]int{},
+		PlayerStatus:  map[int]int{},
+		EnemyStatus:   map[int]int{},
+	}
+}
+
+// runMoveEffect resolves the damage and apply steps of effect id for the
+// player with the given args and rolled damage.
+func runMoveEffect(t *testing.T, f *FightState, id int, args []int, damage int) *effectContext {
+	t.Helper()
+	effect := LoadMoveEffects()[id]
+	if effect == nil {
+		t.Fatalf("effect %d not defined", id)
+	}
+	calls := []effectCall{{ID: id, Args: args, Effect: effect, Ops: effect.bind(args)}}
+	c := &effectContext{f: f, player: true, skill: &SkillInfo{}, damage: damage}
+	c.eachEffectOp(calls, func(p *effectPrimitive) {
+		if p.Damage != nil {
+			p.Damage(c)
+		}
+	})
+	c.applyEffects(calls)
+	return c
+}
+
+func TestMoveEffects(t *testing.T) {
+	useMoveEffectData(t)
+	cases := []struct {
+		name   string
+		id     int
+		args   []int
+		damage int
+		setup  func(f *FightState)
+		check  func(t *testing.T, f *FightState, c *effectContext)
+	}{
+		{"drain", 1, nil, 40, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerHP != 120 || c.gainHP != 20 {
+				t.Fatalf("hp=%d gain=%d", f.PlayerHP, c.gainHP)
+			}
+		}},
+		{"clear debuff", 3, nil, 0, func(f *FightState) {
+			f.PlayerStage = stageModifiers{Atk: -2, Def: 1}
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerStage.Atk != 0 || f.PlayerStage.Def != 1 {
+				t.Fatalf("stage=%+v", f.PlayerStage)
+			}
+		}},
+		{"self stage", 4, []int{0, 100, 2}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerStage.Atk != 2 {
+				t.Fatalf("stage=%+v", f.PlayerStage)
+			}
+		}},
+		{"foe stage down", 5, []int{1, 100, -1}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStage.Def != -1 || f.PlayerStage.Def != 0 {
+				t.Fatalf("player=%+v enemy=%+v", f.PlayerStage, f.EnemyStage)
+			}
+		}},
+		{"foe stage down blocked by mist", 5, []int{1, 100, -1}, 0, func(f *FightState) {
+			f.EnemyMist = 2
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStage.Def != 0 {
+				t.Fatalf("enemy=%+v", f.EnemyStage)
+			}
+		}},
+		{"recoil", 6, []int{4}, 40, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerHP != 90 {
+				t.Fatalf("hp=%d", f.PlayerHP)
+			}
+		}},
+		{"equalize", 7, nil, 0, func(f *FightState) {
+			f.PlayerHP = 30
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if c.damage != 70 {
+				t.Fatalf("damage=%d", c.damage)
+			}
+		}},
+		{"mercy", 8, nil, 500, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if c.damage != 99 {
+				t.Fatalf("damage=%d", c.damage)
+			}
+		}},
+		{"paralysis", 10, []int{100}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStatus[statusParalysis] == 0 {
+				t.Fatalf("status=%v", f.EnemyStatus)
+			}
+		}},
+		{"poison", 11, []int{100}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStatus[statusPoison] == 0 {
+				t.Fatalf("status=%v", f.EnemyStatus)
+			}
+		}},
+		{"burn", 12, []int{100}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStatus[statusBurn] == 0 {
+				t.Fatalf("status=%v", f.EnemyStatus)
+			}
+		}},
+		{"burn blocked by safeguard", 12, []int{100}, 0, func(f *FightState) {
+			f.EnemySafeguard = 3
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStatus[statusBurn] != 0 {
+				t.Fatalf("status=%v", f.EnemyStatus)
+			}
+		}},
+		{"freeze", 14, []int{100}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStatus[statusFreeze] != 3 {
+				t.Fatalf("status=%v", f.EnemyStatus)
+			}
+		}},
+		{"confuse", 16, []int{100}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStatus[statusConfuse] != 3 {
+				t.Fatalf("status=%v", f.EnemyStatus)
+			}
+		}},
+		{"fear", 22, []int{100, 2}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStatus[statusFear] != 2 {
+				t.Fatalf("status=%v", f.EnemyStatus)
+			}
+		}},
+		{"leech seed", 13, nil, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemySeeded != 5 {
+				t.Fatalf("seeded=%d", f.EnemySeeded)
+			}
+		}},
+		{"flinch", 15, []int{100}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if !f.EnemyFlinch {
+				t.Fatal("expected flinch")
+			}
+		}},
+		{"flinch chance", 29, []int{100}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if !f.EnemyFlinch {
+				t.Fatal("expected flinch")
+			}
+		}},
+		{"fatigue", 20, []int{100, 2}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerFatigue != 2 {
+				t.Fatalf("fatigue=%d", f.PlayerFatigue)
+			}
+		}},
+		{"pp down", 39, []int{100, 2}, 0, func(f *FightState) {
+			f.EnemyLastSkill = 10001
+			f.EnemySkillPP[10001] = 5
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemySkillPP[10001] != 3 {
+				t.Fatalf("pp=%d", f.EnemySkillPP[10001])
+			}
+		}},
+		{"clear foe buffs", 33, nil, 0, func(f *FightState) {
+			f.EnemyStage = stageModifiers{Atk: 2, Def: -1}
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStage.Atk != 0 || f.EnemyStage.Def != -1 {
+				t.Fatalf("stage=%+v", f.EnemyStage)
+			}
+		}},
+		{"counter", 34, []int{2}, 0, func(f *FightState) {
+			f.PlayerDamageTaken = 30
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if c.damage != 60 {
+				t.Fatalf("damage=%d", c.damage)
+			}
+		}},
+		{"one hit ko", 36, []int{100}, 10, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if c.damage != 100 {
+				t.Fatalf("damage=%d", c.damage)
+			}
+		}},
+		{"protect", 46, []int{1}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if !isProtected(f, true) {
+				t.Fatal("expected protect")
+			}
+		}},
+		{"mist", 47, nil, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerMist != 5 {
+				t.Fatalf("mist=%d", f.PlayerMist)
+			}
+		}},
+		{"safeguard", 48, []int{3}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerSafeguard != 3 {
+				t.Fatalf("safeguard=%d", f.PlayerSafeguard)
+			}
+		}},
+		{"heal full and cure", 87, nil, 0, func(f *FightState) {
+			f.PlayerStatus[statusBurn] = 3
+		}, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerHP != 200 || f.PlayerStatus[statusBurn] != 0 || c.gainHP != 100 {
+				t.Fatalf("hp=%d gain=%d status=%v", f.PlayerHP, c.gainHP, f.PlayerStatus)
+			}
+		}},
+		{"min damage reached", 107, []int{50, 0}, 60, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerStage.Atk != 1 {
+				t.Fatalf("stage=%+v", f.PlayerStage)
+			}
+		}},
+		{"min damage missed", 107, []int{50, 0}, 30, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.PlayerStage.Atk != 0 {
+				t.Fatalf("stage=%+v", f.PlayerStage)
+			}
+		}},
+		{"two foe stages by reference", 196, []int{0, 100, -1, 2, 100, -2}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if f.EnemyStage.Atk != -1 || f.EnemyStage.SpA != -2 {
+				t.Fatalf("stage=%+v", f.EnemyStage)
+			}
+		}},
+		{"retaliate buff", 84, []int{4, 30}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			b := findBuff(f, true, buffRetaliate)
+			if b == nil || b.Turns != 4 || b.Value != 30 || b.Arg != statusParalysis {
+				t.Fatalf("buffs=%+v", f.PlayerBuffs)
+			}
+		}},
+		{"perish both", 62, []int{3}, 0, nil, func(t *testing.T, f *FightState, c *effectContext) {
+			if findBuff(f, true, buffPerish) == nil || findBuff(f, false, buffPerish) == nil {
+				t.Fatalf("player=%+v enemy=%+v", f.PlayerBuffs, f.EnemyBuffs)
+			}
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newEffectTestFight()
+			if tc.setup != nil {
+				tc.setup(f)
+			}
+			c := runMoveEffect(t, f, tc.id, tc.args, tc.damage)
+			tc.check(t, f, c)
+		})
+	}
+}
+
+func TestMoveEffectPower(t *testing.T) {
+	useMoveEffectData(t)
+	cases := []struct {
+		name  string
+		id    int
+		args  []int
+		setup func(f *FightState)
+		want  int
+	}{
+		{"low hp double", 2, nil, func(f *FightState) { f.EnemyHP = 50 }, 80},
+		{"low hp double inactive", 2, nil, nil, 40},
+		{"punishment", 35, nil, func(f *FightState) { f.EnemyStage = stageModifiers{Atk: 2, Def: -1} }, 80},
+		{"desperate", 37, []int{4, 3}, func(f *FightState) { f.PlayerHP = 40 }, 120},
+		{"foe burned", 96, nil, func(f *FightState) { f.EnemyStatus[statusBurn] = 2 }, 80},
+		{"foe any status pct", 133, []int{50}, func(f *FightState) { f.EnemyStatus[statusPoison] = 2 }, 60},
+		{"moving second", 30, nil, nil, 80},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newEffectTestFight()
+			if tc.setup != nil {
+				tc.setup(f)
+			}
+			info := &SkillInfo{ID: 10001, Power: 40, SideEffects: []int{tc.id}, SideEffectArg: strings.Trim(fmt.Sprint(tc.args), "[]")}
+			c := &effectContext{f: f, player: true, skill: info}
+			got := *info
+			c.eachEffectOp(skillEffectCalls(info), func(p *effectPrimitive) {
+				if p.Modify != nil {
+					p.Modify(c, &got)
+				}
+			})
+			if got.Power != tc.want {
+				t.Fatalf("power=%d want %d", got.Power, tc.want)
+			}
+		})
+	}
+}
+
+func TestMultiHitRange(t *testing.T) {
+	useMoveEffectData(t)
+	calls := skillEffectCalls(&SkillInfo{SideEffects: []int{31}, SideEffectArg: "2 3"})
+	c := &effectContext{f: newEffectTestFight(), player: true, params: calls[0].Ops[0].Params}
+	for i := 0; i < 50; i++ {
+		if hits := calls[0].Ops[0].prim.Hits(c); hits < 2 || hits > 3 {
+			t.Fatalf("hits=%d", hits)
+		}
+	}
+}
+
+// TestMoveEffectsCoverSkills keeps move_effects.xml in step with the side
+// effects skills.xml uses.
+func TestMoveEffectsCoverSkills(t *testing.T) {
+	useMoveEffectData(t)
+	skills := make(map[int]*SkillInfo)
+	if err := loadSkills("../../data/xml/skills.xml", skills); err != nil {
+		t.Fatalf("load skills: %v", err)
+	}
+	effects := LoadMoveEffects()
+	for _, info := range skills {
+		for _, id := range info.SideEffects {
+			if id > 0 && effects[id] == nil {
+				t.Errorf("skill %d: side effect %d not in move_effects.xml", info.ID, id)
+			}
+		}
+	}
+}
+
+// TestMoveEffectBindings pins the primitives and parameters every effect in
+// move_effects.xml binds to when each of its args is given a distinct value.
+func TestMoveEffectBindings(t *testing.T) {
+	useMoveEffectData(t)
+	tests := []struct {
+		id   int
+		want string
+	}{
+		{1, "drain(pct=50)"},
+		{2, "power_foe_low_hp(mul=2)"},
+		{3, "clear_stages(sign=-1 target=1)"},
+		{4, "stage(chance=1002 stages=1003 stat=1001 target=1)"},
+		{5, "stage(chance=1002 stages=1003 stat=1001 target=0)"},
+		{6, "recoil(div=1001)"},
+		{7, "damage_equalize()"},
+		{8, "damage_mercy()"},
+		{9, "power_streak(max=1002 step=1001)"},
+		{10, "status(chance=1001 status=0 turns=999)"},
+		{11, "status(chance=1001 status=1 turns=999)"},
+		{12, "status(chance=1001 status=2 turns=999)"},
+		{13, "seed(turns=1001)"},
+		{14, "status(chance=1001 status=5 turns=3)"},
+		{15, "flinch(chance=1001)"},
+		{16, "status(chance=1001 status=10 turns=3)"},
+		{20, "fatigue(chance=1001 target=1 turns=1002)"},
+		{21, "buff(div=1003 kind=1 turns=1001 turns_max=1002)"},
+		{22, "status(chance=1001 status=6 turns=1002)"},
+		{28, "buff(kind=17 target=0 turns=1001)"},
+		{29, "flinch(chance=1001)"},
+		{30, "power_order(first=0 mul=2)"},
+		{31, "hits(max=1002 min=1001)"},
+		{32, "crit(rate=1001)"},
+		{33, "clear_stages(sign=1 target=0)"},
+		{34, "damage_counter(mul=1001)"},
+		{35, "power_foe_stages(step=20)"},
+		{36, "damage_ohko(chance=1001)"},
+		{37, "power_self_low_hp(div=1001 mul=1002)"},
+		{38, "max_hp_down(amount=1001)"},
+		{39, "pp_down(amount=1002 chance=1001)"},
+		{40, "power_order(first=1 mul=2)"},
+		{41, "buff(arg=3 kind=3 turns=1001 turns_max=1002 value=50)"},
+		{42, "buff(arg=5 kind=6 turns=1001 turns_max=1002 value=200)"},
+		{43, "heal(div=1001)"},
+		{44, "buff(arg=2 kind=4 turns=1001 value=50)"},
+		{45, "copy_stages(turns=1001)"},
+		{46, "guard(kind=1 turns=1001)"},
+		{47, "guard(kind=2 turns=1001)"},
+		{48, "guard(kind=3 turns=1001)"},
+		{49, "buff(kind=2 turns=99 value=1001)"},
+		{50, "buff(arg=1 kind=4 turns=1001 value=50)"},
+		{51, "copy_type(turns=1001) copy_stages(turns=1001)"},
+		{52, "buff(kind=5 turns=1001 value=100)"},
+		{53, "buff(kind=6 mul=1002 turns=1001)"},
+		{54, "buff(div=1002 kind=6 target=0 turns=1001)"},
+		{55, "swap_type(turns=1001)"},
+		{56, "copy_type(turns=1001)"},
+		{57, "buff(div=1002 kind=9 turns=1001)"},
+		{58, "buff(kind=7 turns=1001 value=8)"},
+		{59, "stage(chance=100 stages=1002 stat=1001 target=1) faint_self(stages=1002 stat=1001)"},
+		{60, "stage(chance=1002 down=1 stat=1001 target=0)"},
+		{61, "power_random(max=160 min=40)"},
+		{62, "buff(kind=19 target=2 turns=1001)"},
+		{63, "reflect_debuff()"},
+		{64, "power_order(first=0 mul=2)"},
+		{65, "buff(arg=1002 kind=6 mul=1003 turns=1001 type=1002)"},
+		{66, "heal(div=1001)"},
+		{67, "fatigue(chance=30 target=0 turns=1001)"},
+		{68, "buff(kind=8 turns=1001)"},
+		{69, "buff(kind=18 target=0 turns=1001)"},
+		{70, "power_self_stages(base=40 step=20)"},
+		{71, "buff(div=8 kind=10 target=0 turns=5)"},
+		{72, "fatigue(chance=100 target=1 turns=1)"},
+		{73, "encore(turns=1001)"},
+		{74, "status(chance=20 status=10 turns=3)"},
+		{75, "status_random(chance=20 turns=3)"},
+		{76, "buff(chance=1001 kind=10 target=0 turns=1002 value=1003)"},
+		{77, "buff(kind=9 turns=1001 value=1002)"},
+		{78, "buff(arg=1 kind=4 turns=1001 value=100)"},
+		{79, "stage(stages=2 stat=2 target=1) stage(stages=1 stat=4 target=1) stage(stages=1 stat=5 target=1)"},
+		{80, "hurt(div=4 target=2)"},
+		{81, "buff(kind=16 turns=1001)"},
+		{82, "status(chance=10 status=5 turns=3)"},
+		{83, "stage(stages=2 stat=0 target=1)"},
+		{84, "buff(arg=0 kind=12 odds=1002 turns=1001 value=1002)"},
+		{85, "steal_stages()"},
+		{86, "buff(arg=4 kind=4 turns=1001 value=100)"},
+		{87, "heal_full() cure(target=1)"},
+		{88, "status(chance=1001 status=8 turns=1002)"},
+		{89, "buff(div=1002 kind=11 turns=1001)"},
+		{90, "buff(kind=6 mul=1002 turns=1001)"},
+		{91, "buff(kind=1 turns=1001 value=50)"},
+		{92, "buff(arg=5 kind=12 odds=1002 turns=1001 value=1002)"},
+		{93, "damage_bonus(chance=1001 pct=1002)"},
+		{94, "status(chance=1001 status=9 turns=2)"},
+		{95, "drain(div=1001)"},
+		{96, "power_foe_status(mul=2 status=2)"},
+		{97, "power_foe_status(mul=2 status=5)"},
+		{98, "buff(kind=6 mul=1002 turns=1001)"},
+		{99, "status(chance=1001 status=10 turns=3)"},
+		{100, "power_lost_hp(max=200)"},
+		{101, "drain(pct=1001)"},
+		{102, "power_foe_status(mul=2 status=0)"},
+		{103, "status(chance=1001 status=6 turns=1)"},
+		{104, "buff(arg=6 kind=12 odds=1002 turns=1001 value=1002)"},
+		{105, "drain(div=1001)"},
+		{106, "buff(arg=2 kind=4 turns=1001 value=100)"},
+		{107, "stage(min_damage=1001 stages=1 stat=1002 target=1)"},
+		{108, "buff(arg=2 kind=12 odds=1002 turns=1001 value=1002)"},
+		{109, "buff(arg=5 kind=13 odds=1002 turns=1001 value=1002)"},
+		{110, "buff(arg=1 kind=14 odds=1002 stages=1003 turns=1001 value=1003)"},
+		{111, "hits(max=5 min=2)"},
+		{112, "damage_self_hp() faint_self()"},
+		{113, "power_dv(mul=5)"},
+		{114, "status(chance=1001 status=2 turns=999)"},
+		{115, "fatigue(chance=1001 target=0 turns=1002)"},
+		{116, "buff(kind=5 turns=1001 value=1002)"},
+		{117, "buff(kind=5 turns=1001 value=1002)"},
+		{118, "clear_stages(sign=1 target=0) clear_stages(sign=-1 target=1) cure(target=1)"},
+		{119, "crit(rate=4)"},
+		{120, "buff(kind=19 target=0 turns=1001)"},
+		{121, "status(chance=1001 status=0 turns=999)"},
+		{122, "stage(chance=1002 stages=1003 stat=1001 target=0)"},
+		{123, "buff(arg=1002 kind=14 stages=1003 stat=1002 turns=1001 value=1003)"},
+		{124, "stages_all(chance=1001 stages=1002 target=0)"},
+		{125, "buff(kind=2 turns=1001 value=1002)"},
+		{126, "buff(kind=15 turns=1001 value=1002)"},
+		{128, "guard(kind=2 turns=1001) guard(kind=3 turns=1001)"},
+		{129, "hits(min=1001)"},
+		{130, "stage(chance=1002 down=1 stat=1001 target=0)"},
+		{131, "buff(arg=1001 kind=4 turns=1 value=100)"},
+		{132, "status(chance=10 status=2 turns=999)"},
+		{133, "power_foe_status(pct=1001)"},
+		{134, "fatigue(chance=1001 target=1 turns=1002)"},
+		{135, "power_order(first=1 pct=1001)"},
+		{136, "heal(div=1001)"},
+		{139, "hits(max=10 min=5)"},
+		{141, "power_foe_status(pct=1001 status=5)"},
+		{143, "invert_stages(target=0)"},
+		{145, "status(chance=30 status=1 turns=1001)"},
+		{147, "status(chance=1001 status=1002 turns=2)"},
+		{148, "stage(chance=1002 stages=1003 stat=1001 target=0)"},
+		{151, "status(chance=1001 status=2 turns=1002)"},
+		{154, "seed(turns=1001)"},
+		{158, "stage(chance=1002 stages=1003 stat=1001 target=1)"},
+		{159, "stage(chance=1002 stages=1003 stat=1001 target=1)"},
+		{162, "status(chance=1001 status=8 turns=2)"},
+		{167, "status(chance=1001 status=6 turns=1)"},
+		{168, "drain(pct=50)"},
+		{172, "recoil(div=1001)"},
+		{173, "status(chance=1001 status=1002 turns=2)"},
+		{175, "stage(chance=1002 stages=1003 stat=1001 target=1)"},
+		{178, "drain(div=1002 stat=1001)"},
+		{179, "damage_add(amount=1001)"},
+		{180, "clear_stages(sign=1 target=0 turns=1001) guard(kind=2 turns=1001)"},
+		{181, "status(chance=1001 status=1002 turns=1003)"},
+		{182, "stage(chance=1003 stages=1004 stat=1002 target=1 turns=1001)"},
+		{184, "stage(chance=1002 stages=1003 stat=1001 target=1)"},
+		{185, "fatigue(chance=100 target=1 turns=1001)"},
+		{186, "stage(chance=1002 stages=1003 stat=1001 target=1)"},
+		{188, "status(chance=10 status=5 turns=3)"},
+		{192, "flinch(chance=1001)"},
+		{193, "status(chance=1001 status=5 turns=3)"},
+		{194, "status(chance=1002 status=1001 turns=1003)"},
+		{195, "clear_stages(sign=1 target=0)"},
+		{196, "stage(chance=1002 chance2=1005 stages=1003 stages2=1006 stat=1001 stat2=1004 target=0) stage(chance=1005 chance2=1005 stages=1006 stages2=1006 stat=1004 stat2=1004 target=0)"},
+		{201, "stage(stages=1002 stat=1001 target=1)"},
+		{202, "faint_self()"},
+		{401, "status(chance=10 status=5 turns=3)"},
+		{402, "status(chance=1001 status=6 turns=1)"},
+		{405, "bind(chance=1001 turns=4)"},
+		{410, "fatigue(chance=1001 target=0 turns=1002)"},
+		{411, "stages_all(chance=1003 down=1 max=1002 min=1001 target=0)"},
+		{412, "stage(stages=1001 stat=0 target=1)"},
+		{413, "stage(chance=1001 down=1 stat=4 target=0)"},
+		{415, "damage_add(amount=1001)"},
+		{418, "stage(stages=1002 stat=1001 target=0)"},
+		{421, "crit(rate=16)"},
+		{422, "flinch(chance=1001)"},
+		{428, "status(chance=1001 status=6 turns=1)"},
+		{429, "damage_bonus(chance=1001 chance2=1002 pct=1003)"},
+		{430, "stage(stages=1002 stat=1001 target=1)"},
+		{431, "crit(rate=4)"},
+		{434, "status(chance=1001 status=5 turns=1002)"},
+		{436, "flinch(chance=1001)"},
+		{437, "stage(stages=1002 stat=1001 target=0)"},
+		{438, "status(chance=1001 status=6 turns=1002)"},
+		{439, "guard(kind=2 turns=1001) guard(kind=3 turns=1001)"},
+		{441, "status(chance=1001 status=2 turns=999)"},
+		{444, "steal_stages()"},
+		{445, "crit(rate=2)"},
+		{447, "damage_add(amount=1001)"},
+		{448, "stage(chance=1002 stages=1003 stat=1001 target=0)"},
+		{449, "guard(chance=1001 kind=2 turns=1002)"},
+		{450, "stage(chance=1002 down=1 stat=1001 target=0)"},
+		{451, "status(chance=1001 status=10 turns=3)"},
+		{453, "status(chance=20 status=1001 turns=2)"},
+		{454, "stage(down=1002 stages=1002 stat=1001 target=0)"},
+		{455, "stage(stages=1002 stat=1001 target=1)"},
+		{456, "damage_add(amount=1001)"},
+		{458, "status(chance=1001 status=10 turns=3)"},
+		{459, "flinch(chance=1001)"},
+		{460, "damage_bonus(chance=1001 pct=1002)"},
+		{461, "drain(div=1001)"},
+		{463, "buff(kind=2 turns=1001 value=1002)"},
+		{464, "clear_stages(chance=1001 sign=1 target=0)"},
+		{465, "buff(arg=0 kind=3 turns=1002 value=1001)"},
+		{466, "drain(pct=1001)"},
+		{467, "power_foe_status(pct=1002 status=1001)"},
+		{468, "status(chance=20 status=2 turns=999)"},
+		{471, "stage(down=1001 stat=1 target=0)"},
+		{472, "recoil(div=1001)"},
+		{473, "stage(min_damage=1001 stages=1003 stat=1002 target=1)"},
+		{474, "stage(chance=1002 stages=1003 stat=1001 target=1)"},
+		{475, "stage(min_damage=1001 stages=1 stat=1002 target=1)"},
+		{476, "flinch(chance=1001)"},
+		{478, "buff(kind=17 target=0 turns=1001)"},
+		{482, "status(chance=1001 status=6 turns=1002)"},
+		{484, "hits(max=1001 min=1002)"},
+		{485, "crit(rate=8)"},
+		{487, "stage(min_damage=1001 stages=1 stat=1002 target=1)"},
+		{488, "flinch(chance=1002 min_damage=1001)"},
+		{489, "recoil(div=1001)"},
+		{490, "stage(down=1003 min_damage=1001 stat=1002 target=0)"},
+		{494, "power_foe_low_hp(mul=2)"},
+		{495, "stage(chance=1002 down=1 stat=1001 target=0)"},
+		{508, "buff(kind=2 turns=1 value=1001)"},
+		{545, "buff(kind=2 turns=1001 value=1002)"},
+		{687, "stage(chance=1002 down=1 stat=1001 target=0)"},
+		{691, "heal(amount=1001)"},
+		{700, "buff(kind=17 target=0 turns=1001)"},
+		{773, "must_hit()"},
+		{935, "crit(rate=16)"},
+		{976, "drain(pct=1001)"},
+		{1083, "guard(kind=3 turns=1001)"},
+		{1211, "clear_stages(chance=1001 sign=1 target=0)"},
+		{1248, "drain(pct=1001)"},
+		{1257, "status(chance=1001 status=6 turns=1)"},
+		{1470, "stages_all(stages=1001 target=1)"},
+		{1603, "status(chance=1001 status=6 turns=1002)"},
+		{1605, "heal(pct=1001)"},
+		{1635, "heal(amount=1001 turns=1002) buff(amount=1001 kind=20 turns=1002)"},
+		{1850, "stages_all(stages=1001 target=1)"},
+		{1901, "damage_level()"},
+		{1925, "flinch(chance=1001)"},
+		{2236, "guard(kind=1 turns=1001)"},
+		{2237, "damage_add(amount=1001 chance=1002)"},
+	}
+	effects := LoadMoveEffects()
+	if len(effects) != len(tests) {
+		t.Errorf("move_effects.xml defines %d effects, table has %d", len(effects), len(tests))
+	}
+	for _, tt := range tests {
+		effect := effects[tt.id]
+		if effect == nil {
+			t.Errorf("effect %d not defined", tt.id)
+			continue
+		}
+		ops := effect.bind(sampleEffectArgs(effect))
+		for _, op := range ops {
+			if op.prim == nil || op.prim != effectPrimitives[op.Name] {
+				t.Errorf("effect %d: op %s bound to wrong primitive", tt.id, op.Name)
+			}
+		}
+		if got := formatEffectOps(ops); got != tt.want {
+			t.Errorf("effect %d: got %s, want %s", tt.id, got, tt.want)
+		}
+	}
+}
+
+// TestSkillEffectsRun runs the side effects of every skill in skills.xml
+// with its own args against a fresh fight.
+func TestSkillEffectsRun(t *testing.T) {
+	useMoveEffectData(t)
+	skills := make(map[int]*SkillInfo)
+	if err := loadSkills("../../data/xml/skills.xml", skills); err != nil {
+		t.Fatalf("load skills: %v", err)
+	}
+	for _, info := range skills {
+		calls := skillEffectCalls(info)
+		if len(calls) == 0 {
+			continue
+		}
+		c := &effectContext{f: newEffectTestFight(), player: true, skill: info, damage: 40}
+		c.eachEffectOp(calls, func(p *effectPrimitive) {
+			if p.Hits != nil {
+				p.Hits(c)
+			}
+			if p.Modify != nil {
+				modified := *info
+				p.Modify(c, &modified)
+			}
+			if p.Damage != nil {
+				p.Damage(c)
+			}
+		})
+		c.applyEffects(calls)
+	}
+}
+
+func TestSkillEffectCallsSplitArgs(t *testing.T) {
+	useMoveEffectData(t)
+	info := &SkillInfo{SideEffect: 4, SideEffects: []int{4, 10}, SideEffectArg: "0 100 1 30"}
+	calls := skillEffectCalls(info)
+	if len(calls) != 2 {
+		t.Fatalf("calls=%d", len(calls))
+	}
+	if len(calls[0].Args) != 3 || calls[0].Args[2] != 1 {
+		t.Fatalf("first args=%v", calls[0].Args)
+	}
+	if len(calls[1].Args) != 1 || calls[1].Args[0] != 30 {
+		t.Fatalf("second args=%v", calls[1].Args)
+	}
+	if p := calls[0].Ops[0].Params; p["stat"] != 0 || p["chance"] != 100 || p["stages"] != 1 || p["target"] != effectTargetSelf {
+		t.Fatalf("first params=%v", p)
+	}
+	if p := calls[1].Ops[0].Params; p["chance"] != 30 || p["status"] != statusParalysis {
+		t.Fatalf("second params=%v", p)
+	}
+}
+
+func TestTickFightEffects(t *testing.T) {
+	f := newEffectTestFight()
+	f.EnemySeeded = 1
+	f.PlayerProtect = 1
+	tickFightEffects(f)
+	if f.EnemyHP != 75 || f.PlayerHP != 125 {
+		t.Fatalf("hp player=%d enemy=%d", f.PlayerHP, f.EnemyHP)
+	}
+	if f.EnemySeeded != 0 || f.PlayerProtect != 0 {
+		t.Fatalf("counters seeded=%d protect=%d", f.EnemySeeded, f.PlayerProtect)
+	}
+}
+
+func TestFightBuffs(t *testing.T) {
+	info := &SkillInfo{Type: 1, Category: 1}
+	f := newEffectTestFight()
+	addBuff(f, false, fightBuff{Kind: buffShield, Turns: 3, Value: 30})
+	if got := adjustIncomingDamage(f, true, info, 50); got != 20 {
+		t.Fatalf("shielded damage=%d", got)
+	}
+	if findBuff(f, false, buffShield) != nil {
+		t.Fatal("spent shield should be removed")
+	}
+	addBuff(f, true, fightBuff{Kind: buffPower, Turns: 1, Value: 200})
+	addBuff(f, false, fightBuff{Kind: buffGuardCategory, Turns: 1, Value: 50, Arg: 1})
+	if got := adjustIncomingDamage(f, true, info, 50); got != 50 {
+		t.Fatalf("power and guard damage=%d", got)
+	}
+
+	f = newEffectTestFight()
+	addBuff(f, false, fightBuff{Kind: buffReflect, Turns: 2, Value: 50})
+	applyOnHitBuffs(&effectContext{f: f, player: true, damage: 40})
+	if f.PlayerHP != 80 {
+		t.Fatalf("reflected hp=%d", f.PlayerHP)
+	}
+
+	f = newEffectTestFight()
+	addBuff(f, true, fightBuff{Kind: buffRegen, Turns: 2, Value: 20})
+	addBuff(f, false, fightBuff{Kind: buffPerish, Turns: 1})
+	tickFightEffects(f)
+	if f.PlayerHP != 120 || f.EnemyHP != 0 {
+		t.Fatalf("hp player=%d enemy=%d", f.PlayerHP, f.EnemyHP)
+	}
+	if len(f.PlayerBuffs) != 1 || f.PlayerBuffs[0].Turns != 1 || len(f.EnemyBuffs) != 0 {
+		t.Fatalf("buffs player=%+v enemy=%+v", f.PlayerBuffs, f.EnemyBuffs)
+	}
+}
+
+// sampleEffectArgs gives each named arg of effect a distinct value that no
+// literal parameter in move_effects.xml uses.
+func sampleEffectArgs(effect *moveEffect) []int {
+	args := make([]int, len(effect.Args))
+	for i := range args {
+		args[i] = 1001 + i
+	}
+	return args
+}
+
+// formatEffectOps renders bound ops as "name(k=v ...)" with sorted keys.
+func formatEffectOps(ops []effectOp) string {
+	parts := make([]string, len(ops))
+	for i, op := range ops {
+		keys := make([]string, 0, len(op.Params))
+		for k := range op.Params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		kv := make([]string, len(keys))
+		for j, k := range keys {
+			kv[j] = fmt.Sprintf("%s=%d", k, op.Params[k])
+		}
+		parts[i] = op.Name + "(" + strings.Join(kv, " ") + ")"
+	}
+	return strings.Join(parts, " ")
+}
@@ -10,6 +10,8 @@ type SPTBoss struct {
 	RewardName   string `json:"rewardName"`
 	RewardItemID int    `json:"rewardItemId"`
 	RewardCount  int    `json:"rewardCount"`
+	AI           string `json:"ai,omitempty"`
+	AIScript     []int  `json:"aiScript,omitempty"`
 }
 
 type sptBossFile struct {
@@ -43,10 +43,17 @@ type Pet struct {
 	CatchTime uint32
 	Level     uint32
 	DV        uint32
+	Nature    uint32
 	Exp       int
 	HP        int
 	Name      string
 	Skills    []int
+	Learned   []int
+	EV        evSet
+	SkillPP   map[int]int
+	Status    map[int]int
+	Collected bool
+	RoomShow  bool
 }
 
 type NonoInfo struct {
@@ -101,6 +108,9 @@ type TeamInfo struct {
 	LogoColor         uint16
 	TxtColor          uint16
 	LogoWord          string
+	// Imported marks a view built from the team rows. A saved TeamInfo
+	// without it predates them and is imported once at login.
+	Imported bool
 }
 
 type User struct {
@@ -122,6 +132,8 @@ type User struct {
 	TimeToday  uint32
 	TimeLimit  uint32
 	LoginCnt   uint32
+	// LastLoginAt is the unix time of the latest login.
+	LastLoginAt uint32
 
 	VipFlags uint32
 	VipStage uint32
@@ -158,6 +170,7 @@ type User struct {
 	PetDV             uint32
 	Clothes           []Cloth
 	Pets              []Pet
+	Warehouse         []Pet
 	Friends           []FriendInfo
 	Blacklist         []uint32
 	Achievements      []uint32
@@ -169,6 +182,9 @@ type User struct {
 	Team              TeamInfo
 	Mailbox           []Mail
 	BossShield        map[uint64]uint32
+	BossClears        []uint32
+	Incubator         IncubatorInfo
+	SoulBeads         []SoulBead
 	PendingInviteTo   uint32
 	PendingInviteMode uint32
 
@@ -179,22 +195,45 @@ type User struct {
 	Fight         *FightState
 	InFight       bool
 	RoomID        uint32
+	RoomPrivacy   uint32
 	Fitments      []Fitment
+	// RoomOwner is whose room the user is standing in, 0 outside rooms.
+	RoomOwner uint32
 }
 
 type State struct {
-	mu       sync.RWMutex
-	fightMu  sync.Mutex
-	users    map[uint32]*User
-	conns    map[uint32]net.Conn
-	mapUsers map[uint32]map[uint32]struct{}
+	mu         sync.RWMutex
+	fightMu    sync.Mutex
+	users      map[uint32]*User
+	conns      map[uint32]net.Conn
+	mapUsers   map[uint32]map[uint32]struct{}
+	matchmaker *pvpMatchmaker
+	teams      *teamRegistry
+	teamPK     *teamPKManager
+	mentors    *mentorRegistry
+	friends    *friendRegistry
+	chatLimits *chatLimiter
+	chat       storeConfigCache[chatConfig]
+	words      storeConfigCache[wordFilterSet]
+	pvpSeason  storeConfigCache[pvpSeasonConfig]
+	pvpTurn    storeConfigCache[pvpTurnConfig]
+	mutes      *muteCache
+	channels   map[channelKey]map[uint32]struct{}
 }
 
 func NewState() *State {
 	return &State{
-		users:    make(map[uint32]*User),
-		conns:    make(map[uint32]net.Conn),
-		mapUsers: make(map[uint32]map[uint32]struct{}),
+		users:      make(map[uint32]*User),
+		conns:      make(map[uint32]net.Conn),
+		mapUsers:   make(map[uint32]map[uint32]struct{}),
+		matchmaker: newPvPMatchmaker(),
+		teams:      newTeamRegistry(),
+		teamPK:     newTeamPKManager(),
+		mentors:    newMentorRegistry(),
+		friends:    newFriendRegistry(),
+		chatLimits: newChatLimiter(),
+		mutes:      newMuteCache(),
+		channels:   make(map[channelKey]map[uint32]struct{}),
 	}
 }
 
@@ -211,12 +250,59 @@ func (s *State) GetConn(userID uint32) (net.Conn, bool) {
 	return conn, ok
 }
 
+// ConnUser returns the user conn currently belongs to.
+func (s *State) ConnUser(conn net.Conn) (uint32, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for id, c := range s.conns {
+		if c == conn {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// DropConn forgets conn and returns the user it belonged to. A user who has
+// already logged in again on a newer connection is left alone.
+func (s *State) DropConn(conn net.Conn) (uint32, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, c := range s.conns {
+		if c == conn {
+			delete(s.conns, id)
+			s.leaveAllChannelsLocked(id)
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (s *State) PvPFighters() []*User {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []*User
+	for _, u := range s.users {
+		if u.Fight != nil && u.Fight.OpponentUserID != 0 {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
 func (s *State) OnlineCount() int {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	return len(s.conns)
 }
 
+// GetUser returns the user only if it is already loaded.
+func (s *State) GetUser(userID uint32) (*User, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.users[userID]
+	return u, ok
+}
+
 func (s *State) GetOrCreateUser(userID uint32) *User {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -235,6 +321,10 @@ func (s *State) UpdatePlayerMap(userID, mapID uint32) {
 	if !ok {
 		return
 	}
+	if u.RoomOwner != 0 {
+		s.leaveChannelLocked(channelKey{Kind: ChannelRoom, ID: u.RoomOwner}, userID)
+		u.RoomOwner = 0
+	}
 	oldMap := u.MapID
 	if oldMap != 0 {
 		if set, ok := s.mapUsers[oldMap]; ok {
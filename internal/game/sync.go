@@ -73,6 +73,7 @@ func syncUserFromPlayer(userID uint32, u *User, p *storage.Player) *User {
 	if p.Fitments != "" {
 		u.Fitments = decodeFitments(p.Fitments)
 	}
+	u.RoomPrivacy = uint32(p.RoomPrivacy)
 	if p.NonoInfo != "" {
 		if n := decodeNonoInfo(p.NonoInfo); n != nil {
 			u.Nono = *n
@@ -83,6 +84,23 @@ func syncUserFromPlayer(userID uint32, u *User, p *storage.Player) *User {
 	} else if u.Mailbox == nil {
 		u.Mailbox = make([]Mail, 0)
 	}
+	if p.BossClears != "" {
+		u.BossClears = decodeUint32List(p.BossClears)
+	} else if u.BossClears == nil {
+		u.BossClears = make([]uint32, 0)
+	}
+	u.ExpPool = uint32(p.ExpPool)
+	u.GraduationCount = uint32(p.GraduationCount)
+	u.LastLoginAt = uint32(p.LastLoginAt)
+	if p.Incubator != "" {
+		u.Incubator = decodeIncubator(p.Incubator)
+	}
+	if p.SoulBeads != "" {
+		applySoulBeads(u, p.SoulBeads)
+	}
+	if p.ItemBuffs != "" {
+		applyItemBuffs(u, p.ItemBuffs)
+	}
 	u.CurrentPetID = uint32(p.CurrentPetID)
 	u.CatchID = uint32(p.CurrentPetCatchTime)
 	u.PetDV = uint32(p.CurrentPetDV)
@@ -123,15 +141,51 @@ func buildPlayerUpdate(u *User, accountID int64) *storage.Player {
 		TeamInfo:            encodeTeamInfo(u.Team),
 		StudentIDs:          encodeStudentIDs(u.StudentIDs),
 		RoomID:              int64(u.RoomID),
+		RoomPrivacy:         int64(u.RoomPrivacy),
 		Fitments:            encodeFitments(u.Fitments),
 		NonoInfo:            encodeNonoInfo(u.Nono),
 		Mailbox:             encodeMailbox(u.Mailbox),
+		BossClears:          encodeUint32List(u.BossClears),
+		ExpPool:             int64(u.ExpPool),
+		GraduationCount:     int64(u.GraduationCount),
+		LastLoginAt:         int64(u.LastLoginAt),
+		Incubator:           encodeIncubator(u.Incubator),
+		SoulBeads:           encodeSoulBeads(u),
+		ItemBuffs:           encodeItemBuffs(u),
 		CurrentPetID:        int64(u.CurrentPetID),
 		CurrentPetCatchTime: int64(u.CatchID),
 		CurrentPetDV:        int64(u.PetDV),
 	}
 }
 
+// loadedUser returns a user whose data came from the store. Users that
+// other handlers created with defaults are skipped so they are never saved
+// over the stored row.
+func loadedUser(deps *Deps, state *State, id uint32) (*User, bool) {
+	u, ok := state.GetUser(id)
+	if !ok || (deps != nil && deps.Store != nil && u.PlayerID == 0) {
+		return nil, false
+	}
+	return u, true
+}
+
+// findPlayer returns a loaded user or, failing that, a detached copy of
+// the stored player that is not cached in state. Changes to either can be
+// written back with savePlayer.
+func findPlayer(deps *Deps, state *State, id uint32) (*User, bool) {
+	if u, ok := loadedUser(deps, state, id); ok {
+		return u, true
+	}
+	if deps == nil || deps.Store == nil {
+		return nil, false
+	}
+	p, err := deps.Store.GetPlayerByAccount(context.Background(), int64(id))
+	if err != nil || p == nil {
+		return nil, false
+	}
+	return syncUserFromPlayer(id, newDefaultUser(id), p), true
+}
+
 func savePlayer(deps *Deps, userID uint32, u *User) {
 	if deps == nil || deps.Store == nil || u == nil {
 		return
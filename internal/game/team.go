@@ -0,0 +1,828 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"jseer/internal/gateway"
+	"jseer/internal/storage"
+)
+
+// Team is the shared team (guild) record. Each member's User.Team is a view
+// of it, refreshed whenever the team changes.
+type Team struct {
+	ID        uint32
+	Name      string
+	LeaderID  uint32
+	Interest  uint32
+	JoinFlag  uint32
+	Exp       uint32
+	Score     uint32
+	Slogan    string
+	Notice    string
+	LogoBg    uint16
+	LogoIcon  uint16
+	LogoColor uint16
+	TxtColor  uint16
+	LogoWord  string
+	Members   []TeamMember
+}
+
+type TeamMember struct {
+	UserID            uint32
+	Nick              string
+	Priv              uint32
+	Contribution      uint32
+	CanExContribution uint32
+	IsShow            bool
+	JoinTime          uint32
+}
+
+const (
+	teamPrivMember uint32 = 0
+	teamPrivAdmin  uint32 = 3
+	teamPrivLeader uint32 = 4
+)
+
+const (
+	teamJoinOpen uint32 = iota
+	teamJoinApprove
+	teamJoinClosed
+)
+
+const (
+	teamMemberCap        = 50
+	teamMemberPageSize   = 20
+	teamMemberMaxPerPage = 100
+	teamChatLogSize      = 30
+)
+
+const (
+	teamOK uint32 = iota
+	teamNotFound
+	teamAlreadyIn
+	teamNotIn
+	teamNoPriv
+	teamFull
+	teamNameTaken
+	teamBadName
+	teamClosed
+	teamNoRequest
+	teamPending
+	teamNoCoins
+	teamNoItem
+	teamNoContribution
+	teamLocked
+	teamBadItem
+	teamBlocked
+	teamMuted
+	teamChatEmpty
+	teamChatTooLong
+	teamChatTooFast
+)
+
+// Kinds of the 2913 notice pushed to team members and invitees.
+const (
+	teamInformApply uint32 = iota + 1
+	teamInformInvite
+	teamInformJoin
+	teamInformLeave
+	teamInformKick
+	teamInformDisband
+	teamInformPriv
+	teamInformReject
+	teamInformPKStart
+)
+
+// teamRegistry caches loaded teams and holds the pending invitations and
+// applications, which are not persisted.
+type teamRegistry struct {
+	mu      sync.Mutex
+	teams   map[uint32]*Team
+	nextID  uint32
+	invites map[uint32]map[uint32]uint32
+	applies map[uint32]map[uint32]struct{}
+	chats   map[uint32][]teamChatEntry
+}
+
+// teamChatEntry is one line of a team's recent chat, replayed to members
+// when they log in.
+type teamChatEntry struct {
+	FromID uint32
+	Nick   string
+	Time   uint32
+	Msg    []byte
+}
+
+func newTeamRegistry() *teamRegistry {
+	return &teamRegistry{
+		teams:   make(map[uint32]*Team),
+		nextID:  10000,
+		invites: make(map[uint32]map[uint32]uint32),
+		applies: make(map[uint32]map[uint32]struct{}),
+		chats:   make(map[uint32][]teamChatEntry),
+	}
+}
+
+func (t *Team) member(userID uint32) *TeamMember {
+	for i := range t.Members {
+		if t.Members[i].UserID == userID {
+			return &t.Members[i]
+		}
+	}
+	return nil
+}
+
+func (t *Team) removeMember(userID uint32) {
+	for i := range t.Members {
+		if t.Members[i].UserID == userID {
+			t.Members = append(t.Members[:i], t.Members[i+1:]...)
+			return
+		}
+	}
+}
+
+// sortMembers orders members by rank, then by join time.
+func (t *Team) sortMembers() {
+	sort.SliceStable(t.Members, func(i, j int) bool {
+		if t.Members[i].Priv != t.Members[j].Priv {
+			return t.Members[i].Priv > t.Members[j].Priv
+		}
+		return t.Members[i].JoinTime < t.Members[j].JoinTime
+	})
+}
+
+func (t *Team) coreCount() uint32 {
+	n := uint32(0)
+	for _, m := range t.Members {
+		if m.Priv >= teamPrivAdmin {
+			n++
+		}
+	}
+	return n
+}
+
+// loadTeam returns the cached team or loads it from the store. The caller
+// holds r.mu.
+func (r *teamRegistry) loadTeam(deps *Deps, teamID uint32) *Team {
+	if teamID == 0 {
+		return nil
+	}
+	if t := r.teams[teamID]; t != nil {
+		return t
+	}
+	if deps == nil || deps.Store == nil {
+		return nil
+	}
+	row, err := deps.Store.GetTeam(context.Background(), int64(teamID))
+	if err != nil || row == nil {
+		return nil
+	}
+	members, err := deps.Store.ListTeamMembers(context.Background(), row.ID)
+	if err != nil {
+		return nil
+	}
+	t := teamFromStorage(row, members)
+	r.teams[teamID] = t
+	return t
+}
+
+func (r *teamRegistry) nameTaken(name string) bool {
+	for _, t := range r.teams {
+		if storage.TeamNameKey(t.Name) == storage.TeamNameKey(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func teamFromStorage(row *storage.Team, members []*storage.TeamMember) *Team {
+	t := &Team{
+		ID:        uint32(row.ID),
+		Name:      row.Name,
+		LeaderID:  uint32(row.LeaderID),
+		Interest:  uint32(row.Interest),
+		JoinFlag:  uint32(row.JoinFlag),
+		Exp:       uint32(row.Exp),
+		Score:     uint32(row.Score),
+		Slogan:    row.Slogan,
+		Notice:    row.Notice,
+		LogoBg:    uint16(row.LogoBg),
+		LogoIcon:  uint16(row.LogoIcon),
+		LogoColor: uint16(row.LogoColor),
+		TxtColor:  uint16(row.TxtColor),
+		LogoWord:  row.LogoWord,
+	}
+	for _, m := range members {
+		t.Members = append(t.Members, TeamMember{
+			UserID:            uint32(m.UserID),
+			Nick:              m.Nick,
+			Priv:              uint32(m.Priv),
+			Contribution:      uint32(m.Contribution),
+			CanExContribution: uint32(m.CanExContribution),
+			IsShow:            m.IsShow,
+			JoinTime:          uint32(m.JoinedAt),
+		})
+	}
+	t.sortMembers()
+	return t
+}
+
+func teamToStorage(t *Team) *storage.Team {
+	return &storage.Team{
+		ID:        int64(t.ID),
+		Name:      t.Name,
+		LeaderID:  int64(t.LeaderID),
+		Interest:  int(t.Interest),
+		JoinFlag:  int(t.JoinFlag),
+		Exp:       int64(t.Exp),
+		Score:     int64(t.Score),
+		Slogan:    t.Slogan,
+		Notice:    t.Notice,
+		LogoBg:    int(t.LogoBg),
+		LogoIcon:  int(t.LogoIcon),
+		LogoColor: int(t.LogoColor),
+		TxtColor:  int(t.TxtColor),
+		LogoWord:  t.LogoWord,
+	}
+}
+
+func saveTeam(deps *Deps, t *Team) {
+	if deps == nil || deps.Store == nil {
+		return
+	}
+	_, _ = deps.Store.UpdateTeam(context.Background(), teamToStorage(t))
+}
+
+func saveTeamMember(deps *Deps, t *Team, m *TeamMember) {
+	if deps == nil || deps.Store == nil {
+		return
+	}
+	_, _ = deps.Store.SaveTeamMember(context.Background(), &storage.TeamMember{
+		TeamID:            int64(t.ID),
+		UserID:            int64(m.UserID),
+		Nick:              m.Nick,
+		Priv:              int(m.Priv),
+		Contribution:      int64(m.Contribution),
+		CanExContribution: int64(m.CanExContribution),
+		IsShow:            m.IsShow,
+	})
+}
+
+func deleteTeamMember(deps *Deps, userID uint32) {
+	if deps == nil || deps.Store == nil {
+		return
+	}
+	_ = deps.Store.DeleteTeamMember(context.Background(), int64(userID))
+}
+
+// syncTeamInfo rebuilds user.Team from the shared team; a nil team or a
+// user who is no longer a member clears it.
+func syncTeamInfo(user *User, t *Team) {
+	if user == nil {
+		return
+	}
+	var m *TeamMember
+	if t != nil {
+		m = t.member(user.ID)
+	}
+	if m == nil {
+		user.Team = TeamInfo{}
+		return
+	}
+	user.Team = TeamInfo{
+		ID:                t.ID,
+		Priv:              m.Priv,
+		IsShow:            m.IsShow,
+		AllContribution:   m.Contribution,
+		CanExContribution: m.CanExContribution,
+		CoreCount:         t.coreCount(),
+		Name:              t.Name,
+		MemberCount:       uint32(len(t.Members)),
+		Interest:          t.Interest,
+		JoinFlag:          t.JoinFlag,
+		Exp:               t.Exp,
+		Score:             t.Score,
+		Slogan:            t.Slogan,
+		Notice:            t.Notice,
+		LogoBg:            t.LogoBg,
+		LogoIcon:          t.LogoIcon,
+		LogoColor:         t.LogoColor,
+		TxtColor:          t.TxtColor,
+		LogoWord:          t.LogoWord,
+		Imported:          true,
+	}
+}
+
+// syncTeamMembers refreshes the team view of every member held in memory
+// and subscribes them to the team channel.
+func syncTeamMembers(state *State, t *Team) {
+	for _, m := range t.Members {
+		if u, ok := state.GetUser(m.UserID); ok {
+			syncTeamInfo(u, t)
+			state.JoinChannel(ChannelTeam, t.ID, m.UserID)
+		}
+	}
+}
+
+// clearTeamView drops the team view of a member who was kicked or whose
+// team was disbanded. Offline members are loaded and saved too, so their
+// stored view cannot bring the team back at their next login.
+func clearTeamView(deps *Deps, state *State, userID uint32) {
+	if u, ok := findPlayer(deps, state, userID); ok {
+		syncTeamInfo(u, nil)
+		savePlayer(deps, userID, u)
+	}
+}
+
+// refreshUserTeam reconciles the user's stored team view with the team
+// records at login; teams that no longer exist are dropped. A TeamInfo
+// saved before teams had rows is imported first, once.
+func refreshUserTeam(deps *Deps, state *State, user *User) {
+	if deps == nil || deps.Store == nil {
+		return
+	}
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	teamID := uint32(0)
+	if m, err := deps.Store.GetTeamMember(context.Background(), int64(user.ID)); err == nil && m != nil {
+		teamID = uint32(m.TeamID)
+	} else if user.Team.ID != 0 && !user.Team.Imported {
+		teamID = r.importLegacyTeam(deps, user)
+	}
+	syncTeamInfo(user, r.loadTeam(deps, teamID))
+}
+
+// importLegacyTeam turns the TeamInfo saved on players from before teams had
+// their own records into Team and TeamMember rows. Members of one legacy
+// team find each other by its legacy ID; whoever logs in first holds the
+// team until its legacy leader arrives. A legacy name taken by another team
+// gets the legacy ID appended. The caller holds r.mu.
+func (r *teamRegistry) importLegacyTeam(deps *Deps, user *User) uint32 {
+	legacy := user.Team
+	name := strings.TrimSpace(legacy.Name)
+	if name == "" {
+		return 0
+	}
+	var t *Team
+	if row, err := deps.Store.GetTeamByLegacyID(context.Background(), int64(legacy.ID)); err == nil && row != nil {
+		t = r.loadTeam(deps, uint32(row.ID))
+	} else {
+		t = &Team{
+			Name:      name,
+			LeaderID:  user.ID,
+			Interest:  legacy.Interest,
+			JoinFlag:  legacy.JoinFlag,
+			Exp:       legacy.Exp,
+			Score:     legacy.Score,
+			Slogan:    legacy.Slogan,
+			Notice:    legacy.Notice,
+			LogoBg:    legacy.LogoBg,
+			LogoIcon:  legacy.LogoIcon,
+			LogoColor: legacy.LogoColor,
+			TxtColor:  legacy.TxtColor,
+			LogoWord:  legacy.LogoWord,
+		}
+		in := teamToStorage(t)
+		in.LegacyID = int64(legacy.ID)
+		row, err := deps.Store.CreateTeam(context.Background(), in)
+		if errors.Is(err, storage.ErrTeamNameTaken) {
+			t.Name = fmt.Sprintf("%s#%d", name, legacy.ID)
+			in.Name = t.Name
+			row, err = deps.Store.CreateTeam(context.Background(), in)
+		}
+		if err != nil || row == nil {
+			return 0
+		}
+		t.ID = uint32(row.ID)
+		r.teams[t.ID] = t
+	}
+	if t == nil || len(t.Members) >= teamMemberCap {
+		return 0
+	}
+	priv := legacy.Priv
+	if priv >= teamPrivLeader {
+		priv = teamPrivLeader
+		if t.LeaderID != user.ID {
+			if leader := t.member(t.LeaderID); leader != nil && leader.Priv >= teamPrivLeader {
+				priv = teamPrivAdmin
+			} else {
+				t.LeaderID = user.ID
+				saveTeam(deps, t)
+			}
+		}
+	}
+	t.Members = append(t.Members, TeamMember{
+		UserID:            user.ID,
+		Nick:              pickNick(user, user.ID),
+		Priv:              priv,
+		Contribution:      legacy.AllContribution,
+		CanExContribution: legacy.CanExContribution,
+		IsShow:            legacy.IsShow,
+		JoinTime:          uint32(time.Now().Unix()),
+	})
+	t.sortMembers()
+	saveTeamMember(deps, t, t.member(user.ID))
+	return t.ID
+}
+
+func userTeam(deps *Deps, state *State, user *User) *Team {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loadTeam(deps, user.Team.ID)
+}
+
+func getTeam(deps *Deps, state *State, teamID uint32) *Team {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loadTeam(deps, teamID)
+}
+
+func createTeam(deps *Deps, state *State, user *User, name string) (uint32, *Team) {
+	name = strings.TrimSpace(name)
+	if name == "" || hasSensitive(deps, name) {
+		return teamBadName, nil
+	}
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.inTeam(deps, user) {
+		return teamAlreadyIn, nil
+	}
+	if r.nameTaken(name) {
+		return teamNameTaken, nil
+	}
+	t := &Team{
+		Name:      name,
+		LeaderID:  user.ID,
+		LogoBg:    1,
+		LogoIcon:  1,
+		LogoColor: 0xFFFF,
+		TxtColor:  0xFFFF,
+	}
+	if deps != nil && deps.Store != nil {
+		row, err := deps.Store.CreateTeam(context.Background(), teamToStorage(t))
+		if errors.Is(err, storage.ErrTeamNameTaken) {
+			return teamNameTaken, nil
+		}
+		if err != nil || row == nil {
+			return teamNotFound, nil
+		}
+		t.ID = uint32(row.ID)
+	} else {
+		r.nextID++
+		t.ID = r.nextID
+	}
+	r.teams[t.ID] = t
+	r.addMember(deps, t, user, teamPrivLeader)
+	syncTeamInfo(user, t)
+	return teamOK, t
+}
+
+// addMember adds user to t and drops any other requests it had pending.
+// The caller holds r.mu.
+func (r *teamRegistry) addMember(deps *Deps, t *Team, user *User, priv uint32) {
+	t.Members = append(t.Members, TeamMember{
+		UserID:   user.ID,
+		Nick:     pickNick(user, user.ID),
+		Priv:     priv,
+		IsShow:   true,
+		JoinTime: uint32(time.Now().Unix()),
+	})
+	t.sortMembers()
+	saveTeamMember(deps, t, t.member(user.ID))
+	delete(r.invites, user.ID)
+	for _, set := range r.applies {
+		delete(set, user.ID)
+	}
+}
+
+// applyToTeam joins an open team directly, or files an application with a
+// team that approves new members.
+func applyToTeam(deps *Deps, state *State, user *User, teamID uint32) (uint32, *Team) {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.inTeam(deps, user) {
+		return teamAlreadyIn, nil
+	}
+	t := r.loadTeam(deps, teamID)
+	if t == nil {
+		return teamNotFound, nil
+	}
+	if len(t.Members) >= teamMemberCap {
+		return teamFull, t
+	}
+	switch t.JoinFlag {
+	case teamJoinOpen:
+		r.addMember(deps, t, user, teamPrivMember)
+		return teamOK, t
+	case teamJoinApprove:
+		if r.applies[teamID] == nil {
+			r.applies[teamID] = make(map[uint32]struct{})
+		}
+		r.applies[teamID][user.ID] = struct{}{}
+		return teamPending, t
+	}
+	return teamClosed, t
+}
+
+// inviteToTeam records an invitation from an admin of the caller's team.
+func inviteToTeam(deps *Deps, state *State, user *User, targetID uint32) (uint32, *Team) {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.loadTeam(deps, user.Team.ID)
+	if t == nil {
+		return teamNotIn, nil
+	}
+	if m := t.member(user.ID); m == nil || m.Priv < teamPrivAdmin {
+		return teamNoPriv, t
+	}
+	if t.member(targetID) != nil {
+		return teamAlreadyIn, t
+	}
+	if len(t.Members) >= teamMemberCap {
+		return teamFull, t
+	}
+	if blockedBy(deps, state, targetID, user.ID) {
+		return teamBlocked, t
+	}
+	if r.invites[targetID] == nil {
+		r.invites[targetID] = make(map[uint32]uint32)
+	}
+	r.invites[targetID][t.ID] = user.ID
+	return teamOK, t
+}
+
+// answerTeam settles a pending request. An admin answers an application by
+// passing the applicant's user ID; an invitee answers by passing the team ID.
+// joinedID is the user the request concerned.
+func answerTeam(deps *Deps, state *State, user *User, id uint32, accept bool) (result uint32, t *Team, joinedID uint32) {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if own := r.loadTeam(deps, user.Team.ID); own != nil {
+		if _, ok := r.applies[own.ID][id]; ok {
+			if m := own.member(user.ID); m == nil || m.Priv < teamPrivAdmin {
+				return teamNoPriv, own, id
+			}
+			delete(r.applies[own.ID], id)
+			if !accept {
+				return teamOK, own, id
+			}
+			if len(own.Members) >= teamMemberCap {
+				return teamFull, own, id
+			}
+			applicant, ok := state.GetUser(id)
+			if !ok {
+				applicant = &User{ID: id}
+			}
+			if r.inTeam(deps, applicant) {
+				return teamAlreadyIn, own, id
+			}
+			r.addMember(deps, own, applicant, teamPrivMember)
+			return teamOK, own, id
+		}
+	}
+	inviter, ok := r.invites[user.ID][id]
+	if !ok {
+		return teamNoRequest, nil, user.ID
+	}
+	delete(r.invites[user.ID], id)
+	t = r.loadTeam(deps, id)
+	if t == nil || t.member(inviter) == nil {
+		return teamNotFound, nil, user.ID
+	}
+	if !accept {
+		return teamOK, t, user.ID
+	}
+	if r.inTeam(deps, user) {
+		return teamAlreadyIn, t, user.ID
+	}
+	if len(t.Members) >= teamMemberCap {
+		return teamFull, t, user.ID
+	}
+	r.addMember(deps, t, user, teamPrivMember)
+	return teamOK, t, user.ID
+}
+
+// inTeam reports whether user belongs to a team, asking the store for users
+// that are not loaded. The caller holds r.mu.
+func (r *teamRegistry) inTeam(deps *Deps, user *User) bool {
+	if r.loadTeam(deps, user.Team.ID) != nil {
+		return true
+	}
+	if deps == nil || deps.Store == nil {
+		return false
+	}
+	m, err := deps.Store.GetTeamMember(context.Background(), int64(user.ID))
+	return err == nil && m != nil
+}
+
+// leaveTeam removes a member. When the leader leaves, the team is
+// disbanded and disbanded is true.
+func leaveTeam(deps *Deps, state *State, user *User) (result uint32, t *Team, disbanded bool) {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t = r.loadTeam(deps, user.Team.ID)
+	if t == nil || t.member(user.ID) == nil {
+		user.Team = TeamInfo{}
+		return teamNotIn, nil, false
+	}
+	if t.LeaderID == user.ID {
+		r.disband(deps, t)
+		return teamOK, t, true
+	}
+	t.removeMember(user.ID)
+	deleteTeamMember(deps, user.ID)
+	user.Team = TeamInfo{}
+	return teamOK, t, false
+}
+
+// disband deletes t. Members keep a stale view until synced by the caller.
+func (r *teamRegistry) disband(deps *Deps, t *Team) {
+	delete(r.teams, t.ID)
+	delete(r.applies, t.ID)
+	delete(r.chats, t.ID)
+	for _, set := range r.invites {
+		delete(set, t.ID)
+	}
+	if deps != nil && deps.Store != nil {
+		_ = deps.Store.DeleteTeam(context.Background(), int64(t.ID))
+	}
+}
+
+// kickTeamMember removes a lower-ranked member; only admins may kick.
+func kickTeamMember(deps *Deps, state *State, user *User, targetID uint32) (uint32, *Team) {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.loadTeam(deps, user.Team.ID)
+	if t == nil {
+		return teamNotIn, nil
+	}
+	self, target := t.member(user.ID), t.member(targetID)
+	if self == nil || target == nil {
+		return teamNotIn, t
+	}
+	if self.Priv < teamPrivAdmin || target.Priv >= self.Priv {
+		return teamNoPriv, t
+	}
+	t.removeMember(targetID)
+	deleteTeamMember(deps, targetID)
+	return teamOK, t
+}
+
+// setTeamMemberPriv changes a member's rank; only the leader may. Giving
+// the leader rank away transfers leadership and makes the old leader an
+// admin.
+func setTeamMemberPriv(deps *Deps, state *State, user *User, targetID uint32, priv uint32) (uint32, *Team) {
+	if priv > teamPrivLeader {
+		return teamNoPriv, nil
+	}
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.loadTeam(deps, user.Team.ID)
+	if t == nil {
+		return teamNotIn, nil
+	}
+	if t.LeaderID != user.ID || targetID == user.ID {
+		return teamNoPriv, t
+	}
+	target := t.member(targetID)
+	if target == nil {
+		return teamNotIn, t
+	}
+	target.Priv = priv
+	saveTeamMember(deps, t, target)
+	if priv == teamPrivLeader {
+		self := t.member(user.ID)
+		self.Priv = teamPrivAdmin
+		saveTeamMember(deps, t, self)
+		t.LeaderID = targetID
+		saveTeam(deps, t)
+	}
+	t.sortMembers()
+	return teamOK, t
+}
+
+// updateTeam applies an admin's change to the shared team record.
+func updateTeam(deps *Deps, state *State, user *User, apply func(t *Team)) uint32 {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.loadTeam(deps, user.Team.ID)
+	if t == nil {
+		return teamNotIn
+	}
+	if m := t.member(user.ID); m == nil || m.Priv < teamPrivAdmin {
+		return teamNoPriv
+	}
+	apply(t)
+	saveTeam(deps, t)
+	syncTeamMembers(state, t)
+	return teamOK
+}
+
+// updateTeamMember applies a change to the caller's own membership.
+func updateTeamMember(deps *Deps, state *State, user *User, apply func(m *TeamMember)) uint32 {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.loadTeam(deps, user.Team.ID)
+	if t == nil {
+		return teamNotIn
+	}
+	m := t.member(user.ID)
+	if m == nil {
+		return teamNotIn
+	}
+	apply(m)
+	saveTeamMember(deps, t, m)
+	syncTeamInfo(user, t)
+	return teamOK
+}
+
+func teamMemberPage(t *Team, page int, size int) []TeamMember {
+	if size <= 0 {
+		size = teamMemberPageSize
+	}
+	if size > teamMemberMaxPerPage {
+		size = teamMemberMaxPerPage
+	}
+	start := page * size
+	if page < 0 || start >= len(t.Members) {
+		return nil
+	}
+	return t.Members[start:minInt(start+size, len(t.Members))]
+}
+
+func teamAdmins(t *Team) []uint32 {
+	var out []uint32
+	for _, m := range t.Members {
+		if m.Priv >= teamPrivAdmin {
+			out = append(out, m.UserID)
+		}
+	}
+	return out
+}
+
+func teamMemberIDs(t *Team) []uint32 {
+	out := make([]uint32, 0, len(t.Members))
+	for _, m := range t.Members {
+		out = append(out, m.UserID)
+	}
+	return out
+}
+
+// sendTeamInform pushes a 2913 notice to each online user in ids.
+func sendTeamInform(srv *gateway.Server, state *State, ids []uint32, kind uint32, teamID uint32, from *User) {
+	body := buildTeamInformBody(kind, teamID, from)
+	for _, id := range ids {
+		if conn, ok := state.GetConn(id); ok {
+			srv.SendResponse(conn, 2913, id, body)
+		}
+	}
+}
+
+// recordTeamChat appends a line to the team's chat log, keeping only the
+// newest teamChatLogSize lines.
+func recordTeamChat(state *State, teamID uint32, entry teamChatEntry) {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	log := append(r.chats[teamID], entry)
+	if len(log) > teamChatLogSize {
+		log = append([]teamChatEntry(nil), log[len(log)-teamChatLogSize:]...)
+	}
+	r.chats[teamID] = log
+}
+
+func teamChatHistory(state *State, teamID uint32) []teamChatEntry {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]teamChatEntry(nil), r.chats[teamID]...)
+}
+
+// joinUserChannels subscribes a user who just logged in to their team
+// channel and to each friend's channel.
+func joinUserChannels(state *State, user *User) {
+	if user.Team.ID != 0 {
+		state.JoinChannel(ChannelTeam, user.Team.ID, user.ID)
+	}
+	for _, f := range user.Friends {
+		state.JoinChannel(ChannelFriends, f.UserID, user.ID)
+	}
+}
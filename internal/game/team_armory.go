@@ -0,0 +1,247 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"jseer/internal/storage"
+)
+
+// teamConfig is read from team.json (GM key team). LevelExp[i] is the team
+// exp needed for level i+1.
+type teamConfig struct {
+	LevelExp             []int            `json:"levelExp"`
+	CoinsPerContribution int              `json:"coinsPerContribution"`
+	DonateItems          []teamDonateItem `json:"donateItems"`
+	Facilities           []teamFacility   `json:"facilities"`
+	Shop                 []teamShopItem   `json:"shop"`
+}
+
+type teamDonateItem struct {
+	ItemID       int `json:"itemId"`
+	Contribution int `json:"contribution"`
+}
+
+type teamFacility struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Level int    `json:"level"`
+}
+
+type teamShopItem struct {
+	ItemID   int `json:"itemId"`
+	Cost     int `json:"cost"`
+	Facility int `json:"facility"`
+}
+
+const teamConfigFile = "team.json"
+
+func defaultTeamConfig() teamConfig {
+	return teamConfig{
+		LevelExp:             []int{0, 1000, 3000, 6000, 10000},
+		CoinsPerContribution: 10,
+		Facilities:           []teamFacility{{ID: 1, Name: "shop", Level: 1}},
+	}
+}
+
+func loadTeamConfig(deps *Deps) teamConfig {
+	cfg := defaultTeamConfig()
+	readStoreConfigJSON(deps, teamConfigFile, &cfg)
+	if len(cfg.LevelExp) == 0 {
+		cfg.LevelExp = defaultTeamConfig().LevelExp
+	}
+	sort.Ints(cfg.LevelExp)
+	if cfg.CoinsPerContribution <= 0 {
+		cfg.CoinsPerContribution = defaultTeamConfig().CoinsPerContribution
+	}
+	return cfg
+}
+
+// level returns the team level reached with exp, starting at 1.
+func (c teamConfig) level(exp uint32) int {
+	lv := 0
+	for _, need := range c.LevelExp {
+		if int64(exp) >= int64(need) {
+			lv++
+		}
+	}
+	return maxInt(1, lv)
+}
+
+// nextLevelExp returns the exp needed for the next level, or 0 at the cap.
+func (c teamConfig) nextLevelExp(exp uint32) uint32 {
+	lv := c.level(exp)
+	if lv >= len(c.LevelExp) {
+		return 0
+	}
+	return uint32(c.LevelExp[lv])
+}
+
+func (c teamConfig) facilityUnlocked(id int, exp uint32) bool {
+	for _, f := range c.Facilities {
+		if f.ID == id {
+			return c.level(exp) >= f.Level
+		}
+	}
+	return false
+}
+
+func (c teamConfig) donateValue(itemID int) int {
+	for _, it := range c.DonateItems {
+		if it.ItemID == itemID {
+			return it.Contribution
+		}
+	}
+	return 0
+}
+
+func (c teamConfig) shopItem(itemID int) *teamShopItem {
+	for i := range c.Shop {
+		if c.Shop[i].ItemID == itemID {
+			return &c.Shop[i]
+		}
+	}
+	return nil
+}
+
+// applyTeamContribution adds exp to the caller's team and contribution to
+// the caller in one store transaction. A negative canEx spends exchangeable
+// contribution.
+func applyTeamContribution(deps *Deps, state *State, user *User, exp, contribution, canEx int) (uint32, *Team) {
+	return applyTeamChange(deps, state, user, storage.TeamContribution{
+		Exp:               int64(exp),
+		Contribution:      int64(contribution),
+		CanExContribution: int64(canEx),
+	})
+}
+
+// applyTeamChange applies in to the caller's team and membership. Coins and
+// items being donated are taken from the caller in the same store
+// transaction, and from the in-memory user only once it has committed.
+func applyTeamChange(deps *Deps, state *State, user *User, in storage.TeamContribution) (uint32, *Team) {
+	r := state.teams
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.loadTeam(deps, user.Team.ID)
+	if t == nil {
+		return teamNotIn, nil
+	}
+	m := t.member(user.ID)
+	if m == nil {
+		return teamNotIn, t
+	}
+	if int64(m.CanExContribution)+in.CanExContribution < 0 {
+		return teamNoContribution, t
+	}
+	if deps != nil && deps.Store != nil {
+		in.TeamID = int64(t.ID)
+		in.UserID = int64(user.ID)
+		in.PlayerID = user.PlayerID
+		row, member, err := deps.Store.AddTeamContribution(context.Background(), &in)
+		switch {
+		case errors.Is(err, storage.ErrTeamContribution):
+			return teamNoContribution, t
+		case errors.Is(err, storage.ErrNotEnoughCoins):
+			return teamNoCoins, t
+		case errors.Is(err, storage.ErrNotEnoughItems):
+			return teamNoItem, t
+		}
+		if err != nil {
+			return teamNotFound, t
+		}
+		t.Exp = uint32(row.Exp)
+		m.Contribution = uint32(member.Contribution)
+		m.CanExContribution = uint32(member.CanExContribution)
+	} else {
+		t.Exp += uint32(in.Exp)
+		m.Contribution += uint32(in.Contribution)
+		m.CanExContribution = uint32(int64(m.CanExContribution) + in.CanExContribution)
+	}
+	user.Coins -= uint32(in.Coins)
+	if in.ItemCount > 0 {
+		if info := user.Items[in.ItemID]; info != nil {
+			info.Count -= in.ItemCount
+			if info.Count <= 0 {
+				delete(user.Items, in.ItemID)
+			}
+		}
+	}
+	syncTeamMembers(state, t)
+	return teamOK, t
+}
+
+// donateTeamCoins turns coins into contribution and team exp. Only whole
+// contribution points are charged for.
+func donateTeamCoins(deps *Deps, state *State, user *User, coins uint32) (uint32, int) {
+	cfg := loadTeamConfig(deps)
+	points := int(coins) / cfg.CoinsPerContribution
+	cost := uint32(points * cfg.CoinsPerContribution)
+	if points <= 0 {
+		return teamBadItem, 0
+	}
+	if user.Coins < cost {
+		return teamNoCoins, 0
+	}
+	result, _ := applyTeamChange(deps, state, user, storage.TeamContribution{
+		Exp:               int64(points),
+		Contribution:      int64(points),
+		CanExContribution: int64(points),
+		Coins:             int64(cost),
+	})
+	if result != teamOK {
+		return result, 0
+	}
+	return teamOK, points
+}
+
+func donateTeamItem(deps *Deps, state *State, user *User, itemID int, count int) (uint32, int) {
+	value := loadTeamConfig(deps).donateValue(itemID)
+	if value <= 0 || count <= 0 {
+		return teamBadItem, 0
+	}
+	info := user.Items[itemID]
+	if info == nil || info.Count < count {
+		return teamNoItem, 0
+	}
+	points := value * count
+	result, _ := applyTeamChange(deps, state, user, storage.TeamContribution{
+		Exp:               int64(points),
+		Contribution:      int64(points),
+		CanExContribution: int64(points),
+		ItemID:            itemID,
+		ItemCount:         count,
+	})
+	if result != teamOK {
+		return result, 0
+	}
+	return teamOK, points
+}
+
+// buyTeamShopItem spends exchangeable contribution on an item from an
+// unlocked team facility. The contribution is refunded if the item cannot
+// be granted.
+func buyTeamShopItem(deps *Deps, state *State, user *User, itemID int, count int) uint32 {
+	cfg := loadTeamConfig(deps)
+	item := cfg.shopItem(itemID)
+	if item == nil || count <= 0 {
+		return teamBadItem
+	}
+	t := userTeam(deps, state, user)
+	if t == nil {
+		return teamNotIn
+	}
+	if !cfg.facilityUnlocked(item.Facility, t.Exp) {
+		return teamLocked
+	}
+	cost := item.Cost * count
+	if result, _ := applyTeamContribution(deps, state, user, 0, 0, -cost); result != teamOK {
+		return result
+	}
+	if !grantItem(deps, user, itemID, count) {
+		applyTeamContribution(deps, state, user, 0, 0, cost)
+		return teamBadItem
+	}
+	savePlayer(deps, user.ID, user)
+	return teamOK
+}
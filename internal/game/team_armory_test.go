@@ -0,0 +1,113 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"jseer/internal/storage"
+)
+
+func TestTeamConfigLevels(t *testing.T) {
+	cfg := teamConfig{
+		LevelExp:   []int{0, 100, 300},
+		Facilities: []teamFacility{{ID: 1, Level: 1}, {ID: 2, Level: 3}},
+	}
+	cases := []struct {
+		exp   uint32
+		level int
+		next  uint32
+	}{{0, 1, 100}, {99, 1, 100}, {100, 2, 300}, {5000, 3, 0}}
+	for _, c := range cases {
+		if lv, next := cfg.level(c.exp), cfg.nextLevelExp(c.exp); lv != c.level || next != c.next {
+			t.Fatalf("exp %d: level=%d next=%d", c.exp, lv, next)
+		}
+	}
+	if !cfg.facilityUnlocked(1, 0) || cfg.facilityUnlocked(2, 299) || !cfg.facilityUnlocked(2, 300) {
+		t.Fatal("facility gating")
+	}
+	if cfg.facilityUnlocked(9, 5000) {
+		t.Fatal("unknown facility unlocked")
+	}
+}
+
+func TestTeamDonationsAndSpending(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2)
+	_, team := createTeam(nil, state, users[0], "Seers")
+	users[0].Coins = 105
+
+	if r, pts := donateTeamCoins(nil, state, users[0], 105); r != teamOK || pts != 10 || users[0].Coins != 5 {
+		t.Fatalf("donate coins=%d pts=%d coins=%d", r, pts, users[0].Coins)
+	}
+	if r, _ := donateTeamCoins(nil, state, users[0], 50); r != teamNoCoins {
+		t.Fatalf("over budget=%d", r)
+	}
+	if r, _ := donateTeamCoins(nil, state, users[1], 0); r != teamBadItem {
+		t.Fatalf("zero coins=%d", r)
+	}
+	if team.Exp != 10 || users[0].Team.AllContribution != 10 || users[0].Team.CanExContribution != 10 || users[0].Team.Exp != 10 {
+		t.Fatalf("after donation team=%d view=%+v", team.Exp, users[0].Team)
+	}
+
+	if r, _ := applyTeamContribution(nil, state, users[0], 0, 0, -11); r != teamNoContribution {
+		t.Fatalf("overspend=%d", r)
+	}
+	if r, _ := applyTeamContribution(nil, state, users[0], 0, 0, -4); r != teamOK || users[0].Team.CanExContribution != 6 || users[0].Team.AllContribution != 10 {
+		t.Fatalf("spend=%d view=%+v", r, users[0].Team)
+	}
+	if r, _ := applyTeamContribution(nil, state, users[1], 1, 1, 1); r != teamNotIn {
+		t.Fatalf("outsider=%d", r)
+	}
+	if r, _ := donateTeamItem(nil, state, users[0], 400001, 1); r != teamBadItem {
+		t.Fatalf("undonatable item=%d", r)
+	}
+	if r := buyTeamShopItem(nil, state, users[0], 300013, 1); r != teamBadItem {
+		t.Fatalf("item not in default shop=%d", r)
+	}
+}
+
+func TestTeamDonationTakenWithContribution(t *testing.T) {
+	withConfigFile(t, teamConfigFile, `{"donateItems":[{"itemId":400001,"contribution":3}]}`)
+	state := NewState()
+	store := newTeamRowStore()
+	deps := &Deps{State: state, Store: store}
+	users := newTeamTestUsers(state, 1)
+	user := users[0]
+	user.PlayerID = 9
+	user.Coins = 100
+	user.Items = map[int]*ItemInfo{400001: {Count: 2}}
+	createTeam(deps, state, user, "Seers")
+
+	store.addErr = errors.New("write failed")
+	if r, _ := donateTeamCoins(deps, state, user, 100); r != teamNotFound || user.Coins != 100 {
+		t.Fatalf("failed donation=%d coins=%d", r, user.Coins)
+	}
+	if r, _ := donateTeamItem(deps, state, user, 400001, 2); r != teamNotFound || user.Items[400001].Count != 2 {
+		t.Fatalf("failed item donation=%d items=%v", r, user.Items)
+	}
+	// The stored balance can be lower than the in-memory one, e.g. after a
+	// GM edit; the store's own check wins.
+	store.addErr = storage.ErrNotEnoughCoins
+	if r, _ := donateTeamCoins(deps, state, user, 100); r != teamNoCoins || user.Coins != 100 {
+		t.Fatalf("short coins=%d coins=%d", r, user.Coins)
+	}
+	store.addErr = storage.ErrNotEnoughItems
+	if r, _ := donateTeamItem(deps, state, user, 400001, 2); r != teamNoItem || user.Items[400001].Count != 2 {
+		t.Fatalf("short items=%d items=%v", r, user.Items)
+	}
+	if len(store.given) != 0 {
+		t.Fatalf("given=%+v", store.given)
+	}
+
+	store.addErr = nil
+	if r, pts := donateTeamCoins(deps, state, user, 100); r != teamOK || pts != 10 || user.Coins != 0 {
+		t.Fatalf("donation=%d pts=%d coins=%d", r, pts, user.Coins)
+	}
+	if r, pts := donateTeamItem(deps, state, user, 400001, 2); r != teamOK || pts != 6 || user.Items[400001] != nil {
+		t.Fatalf("item donation=%d pts=%d items=%v", r, pts, user.Items)
+	}
+	coins, item := store.given[0], store.given[1]
+	if coins.PlayerID != 9 || coins.Coins != 100 || item.ItemID != 400001 || item.ItemCount != 2 || user.Team.AllContribution != 16 {
+		t.Fatalf("given=%+v view=%+v", store.given, user.Team)
+	}
+}
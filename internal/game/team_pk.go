@@ -0,0 +1,574 @@
+package game
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"jseer/internal/gateway"
+	"jseer/internal/storage"
+)
+
+// teamPKConfig is read from team-pk.json (GM key team_pk). Sign-up is open
+// on SignUpWeekdays (0 is Sunday) between the two hours; registered teams
+// are paired when it closes.
+type teamPKConfig struct {
+	Season          int   `json:"season"`
+	SignUpWeekdays  []int `json:"signUpWeekdays"`
+	SignUpStartHour int   `json:"signUpStartHour"`
+	SignUpEndHour   int   `json:"signUpEndHour"`
+	BattleMinutes   int   `json:"battleMinutes"`
+	BuildingHP      int   `json:"buildingHp"`
+	ShieldHP        int   `json:"shieldHp"`
+	Shields         int   `json:"shields"`
+	ShotDamage      int   `json:"shotDamage"`
+	FreezeSeconds   int   `json:"freezeSeconds"`
+	ShotIntervalMs  int   `json:"shotIntervalMs"`
+	FreezeCooldown  int   `json:"freezeCooldownSeconds"`
+	WinScore        int   `json:"winScore"`
+	DrawScore       int   `json:"drawScore"`
+	LoseScore       int   `json:"loseScore"`
+	HitScore        int   `json:"hitScore"`
+	LeaderboardSize int   `json:"leaderboardSize"`
+	HistorySize     int   `json:"historySize"`
+}
+
+const teamPKConfigFile = "team-pk.json"
+
+func defaultTeamPKConfig() teamPKConfig {
+	return teamPKConfig{
+		Season:          1,
+		SignUpWeekdays:  []int{5, 6},
+		SignUpStartHour: 19,
+		SignUpEndHour:   20,
+		BattleMinutes:   20,
+		BuildingHP:      10000,
+		ShieldHP:        2000,
+		Shields:         3,
+		ShotDamage:      100,
+		FreezeSeconds:   10,
+		ShotIntervalMs:  1000,
+		FreezeCooldown:  30,
+		WinScore:        3,
+		DrawScore:       1,
+		LoseScore:       0,
+		HitScore:        1,
+		LeaderboardSize: 50,
+		HistorySize:     20,
+	}
+}
+
+// config returns the cached team-pk.json; the battle ticker reads it twice a
+// second.
+func (m *teamPKManager) config(deps *Deps) teamPKConfig {
+	return m.cfg.get(deps, teamPKConfigFile, func() (teamPKConfig, int64) {
+		return loadTeamPKConfig(deps)
+	})
+}
+
+func loadTeamPKConfig(deps *Deps) (teamPKConfig, int64) {
+	cfg := defaultTeamPKConfig()
+	version, _ := readStoreConfigJSON(deps, teamPKConfigFile, &cfg)
+	def := defaultTeamPKConfig()
+	if cfg.Season <= 0 {
+		cfg.Season = def.Season
+	}
+	if cfg.BattleMinutes <= 0 {
+		cfg.BattleMinutes = def.BattleMinutes
+	}
+	if cfg.BuildingHP <= 0 {
+		cfg.BuildingHP = def.BuildingHP
+	}
+	if cfg.ShotDamage <= 0 {
+		cfg.ShotDamage = def.ShotDamage
+	}
+	if cfg.LeaderboardSize <= 0 {
+		cfg.LeaderboardSize = def.LeaderboardSize
+	}
+	if cfg.HistorySize <= 0 {
+		cfg.HistorySize = def.HistorySize
+	}
+	if cfg.ShotIntervalMs < 0 {
+		cfg.ShotIntervalMs = def.ShotIntervalMs
+	}
+	if cfg.FreezeCooldown < 0 {
+		cfg.FreezeCooldown = def.FreezeCooldown
+	}
+	return cfg, version
+}
+
+// signUpWindow returns the sign-up window of now's day, if there is one.
+func (c teamPKConfig) signUpWindow(now time.Time) (time.Time, time.Time, bool) {
+	for _, day := range c.SignUpWeekdays {
+		if int(now.Weekday()) != day {
+			continue
+		}
+		midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		start := midnight.Add(time.Duration(c.SignUpStartHour) * time.Hour)
+		end := midnight.Add(time.Duration(c.SignUpEndHour) * time.Hour)
+		return start, end, end.After(start)
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+func (c teamPKConfig) signUpOpen(now time.Time) bool {
+	start, end, ok := c.signUpWindow(now)
+	return ok && !now.Before(start) && now.Before(end)
+}
+
+const (
+	teamPKOK uint32 = iota
+	teamPKNotInTeam
+	teamPKNoPriv
+	teamPKClosed
+	teamPKRegistered
+	teamPKNoBattle
+	teamPKNotJoined
+	teamPKFrozen
+	teamPKNoShield
+	teamPKBadTarget
+	teamPKCooldown
+)
+
+// History results, as stored in TeamPkHistory.
+const (
+	teamPKDraw uint32 = iota
+	teamPKWin
+	teamPKLose
+)
+
+type teamPKSide struct {
+	TeamID  uint32
+	Name    string
+	HP      int
+	Shield  int
+	Shields int
+	Players map[uint32]struct{}
+	Hits    map[uint32]int
+}
+
+// LastShot and LastFreeze hold when each player last fired and froze, for
+// the shot interval and freeze cooldown.
+type teamPKInstance struct {
+	ID         uint32
+	Sides      [2]*teamPKSide
+	EndsAt     time.Time
+	Frozen     map[uint32]time.Time
+	LastShot   map[uint32]time.Time
+	LastFreeze map[uint32]time.Time
+	Season     int
+	Done       bool
+	Winner     uint32
+	Players    map[uint32]string
+}
+
+// teamPKResult is a team's last finished battle, reported by 4013.
+type teamPKResult struct {
+	Result     uint32
+	OpponentID uint32
+	Score      int
+	HP         int
+	EnemyHP    int
+}
+
+type teamPKManager struct {
+	mu         sync.Mutex
+	nextID     uint32
+	registered map[uint32]time.Time
+	instances  map[uint32]*teamPKInstance
+	byTeam     map[uint32]*teamPKInstance
+	results    map[uint32]teamPKResult
+	cfg        storeConfigCache[teamPKConfig]
+}
+
+func newTeamPKManager() *teamPKManager {
+	return &teamPKManager{
+		registered: make(map[uint32]time.Time),
+		instances:  make(map[uint32]*teamPKInstance),
+		byTeam:     make(map[uint32]*teamPKInstance),
+		results:    make(map[uint32]teamPKResult),
+	}
+}
+
+// side returns the user's side and the enemy side of the instance.
+func (inst *teamPKInstance) side(teamID uint32) (*teamPKSide, *teamPKSide) {
+	if inst.Sides[0].TeamID == teamID {
+		return inst.Sides[0], inst.Sides[1]
+	}
+	return inst.Sides[1], inst.Sides[0]
+}
+
+func (inst *teamPKInstance) frozen(userID uint32, now time.Time) bool {
+	until, ok := inst.Frozen[userID]
+	return ok && now.Before(until)
+}
+
+// coolingDown reports whether less than wait has passed since last[userID].
+func coolingDown(last map[uint32]time.Time, userID uint32, wait time.Duration, now time.Time) bool {
+	at, ok := last[userID]
+	return ok && now.Sub(at) < wait
+}
+
+// registerTeamPK signs the caller's team up; only admins may.
+func registerTeamPK(deps *Deps, state *State, user *User, now time.Time) uint32 {
+	if user.Team.ID == 0 {
+		return teamPKNotInTeam
+	}
+	if user.Team.Priv < teamPrivAdmin {
+		return teamPKNoPriv
+	}
+	if !state.teamPK.config(deps).signUpOpen(now) {
+		return teamPKClosed
+	}
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.registered[user.Team.ID]; ok || m.byTeam[user.Team.ID] != nil {
+		return teamPKRegistered
+	}
+	m.registered[user.Team.ID] = now
+	return teamPKOK
+}
+
+func (m *teamPKManager) isRegistered(teamID uint32) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.registered[teamID]
+	return ok
+}
+
+// matchTeamPK pairs the registered teams in sign-up order and starts their
+// battles. A team left without an opponent stays registered for the next
+// window.
+func matchTeamPK(deps *Deps, state *State, now time.Time) []*teamPKInstance {
+	cfg := state.teamPK.config(deps)
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]uint32, 0, len(m.registered))
+	for id := range m.registered {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := m.registered[ids[i]], m.registered[ids[j]]
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return ids[i] < ids[j]
+	})
+	var started []*teamPKInstance
+	for i := 0; i+1 < len(ids); i += 2 {
+		m.nextID++
+		inst := &teamPKInstance{
+			ID:         m.nextID,
+			EndsAt:     now.Add(time.Duration(cfg.BattleMinutes) * time.Minute),
+			Frozen:     make(map[uint32]time.Time),
+			LastShot:   make(map[uint32]time.Time),
+			LastFreeze: make(map[uint32]time.Time),
+			Season:     cfg.Season,
+			Players:    make(map[uint32]string),
+		}
+		for k, id := range ids[i : i+2] {
+			name := ""
+			if t := getTeam(deps, state, id); t != nil {
+				name = t.Name
+			}
+			inst.Sides[k] = &teamPKSide{
+				TeamID:  id,
+				Name:    name,
+				HP:      cfg.BuildingHP,
+				Shields: cfg.Shields,
+				Players: make(map[uint32]struct{}),
+				Hits:    make(map[uint32]int),
+			}
+			delete(m.registered, id)
+			m.byTeam[id] = inst
+		}
+		m.instances[inst.ID] = inst
+		started = append(started, inst)
+	}
+	return started
+}
+
+// joinTeamPK puts the caller into their team's running battle.
+func joinTeamPK(state *State, user *User) (uint32, *teamPKInstance) {
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst := m.byTeam[user.Team.ID]
+	if user.Team.ID == 0 || inst == nil || inst.Done {
+		return teamPKNoBattle, nil
+	}
+	own, _ := inst.side(user.Team.ID)
+	own.Players[user.ID] = struct{}{}
+	inst.Players[user.ID] = pickNick(user, user.ID)
+	return teamPKOK, inst
+}
+
+// activeTeamPK returns the running battle the caller has joined.
+func (m *teamPKManager) activeTeamPK(user *User) (*teamPKInstance, uint32) {
+	inst := m.byTeam[user.Team.ID]
+	if user.Team.ID == 0 || inst == nil || inst.Done {
+		return nil, teamPKNoBattle
+	}
+	own, _ := inst.side(user.Team.ID)
+	if _, ok := own.Players[user.ID]; !ok {
+		return nil, teamPKNotJoined
+	}
+	return inst, teamPKOK
+}
+
+// shootTeamPK fires at the enemy building, at most once per shot interval.
+// The shield soaks damage first. The battle is over once a building falls.
+func shootTeamPK(deps *Deps, state *State, user *User, now time.Time) (uint32, *teamPKInstance) {
+	cfg := state.teamPK.config(deps)
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst, result := m.activeTeamPK(user)
+	if inst == nil {
+		return result, nil
+	}
+	if inst.frozen(user.ID, now) {
+		return teamPKFrozen, inst
+	}
+	if coolingDown(inst.LastShot, user.ID, time.Duration(cfg.ShotIntervalMs)*time.Millisecond, now) {
+		return teamPKCooldown, inst
+	}
+	inst.LastShot[user.ID] = now
+	own, enemy := inst.side(user.Team.ID)
+	damage := cfg.ShotDamage
+	if enemy.Shield > 0 {
+		soaked := minInt(enemy.Shield, damage)
+		enemy.Shield -= soaked
+		damage -= soaked
+	}
+	enemy.HP = maxInt(0, enemy.HP-damage)
+	own.Hits[user.ID]++
+	if enemy.HP == 0 {
+		inst.Done = true
+	}
+	return teamPKOK, inst
+}
+
+func useTeamPKShield(deps *Deps, state *State, user *User) (uint32, *teamPKSide) {
+	cfg := state.teamPK.config(deps)
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst, result := m.activeTeamPK(user)
+	if inst == nil {
+		return result, nil
+	}
+	own, _ := inst.side(user.Team.ID)
+	if own.Shields <= 0 {
+		return teamPKNoShield, own
+	}
+	own.Shields--
+	own.Shield = cfg.ShieldHP
+	return teamPKOK, own
+}
+
+// freezeTeamPK stops an enemy player from shooting for a while. Each player
+// may freeze once per freeze cooldown.
+func freezeTeamPK(deps *Deps, state *State, user *User, targetID uint32, now time.Time) (uint32, time.Time) {
+	cfg := state.teamPK.config(deps)
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst, result := m.activeTeamPK(user)
+	if inst == nil {
+		return result, time.Time{}
+	}
+	if inst.frozen(user.ID, now) {
+		return teamPKFrozen, time.Time{}
+	}
+	_, enemy := inst.side(user.Team.ID)
+	if _, ok := enemy.Players[targetID]; !ok {
+		return teamPKBadTarget, time.Time{}
+	}
+	if coolingDown(inst.LastFreeze, user.ID, time.Duration(cfg.FreezeCooldown)*time.Second, now) {
+		return teamPKCooldown, time.Time{}
+	}
+	inst.LastFreeze[user.ID] = now
+	until := now.Add(time.Duration(cfg.FreezeSeconds) * time.Second)
+	inst.Frozen[targetID] = until
+	return teamPKOK, until
+}
+
+// unfreezeTeamPK frees a frozen teammate.
+func unfreezeTeamPK(state *State, user *User, targetID uint32, now time.Time) uint32 {
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inst, result := m.activeTeamPK(user)
+	if inst == nil {
+		return result
+	}
+	own, _ := inst.side(user.Team.ID)
+	if _, ok := own.Players[targetID]; !ok || !inst.frozen(targetID, now) {
+		return teamPKBadTarget
+	}
+	delete(inst.Frozen, targetID)
+	return teamPKOK
+}
+
+// takeFinishedTeamPK removes battles that are over or out of time.
+func takeFinishedTeamPK(state *State, now time.Time) []*teamPKInstance {
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*teamPKInstance
+	for id, inst := range m.instances {
+		if !inst.Done && now.Before(inst.EndsAt) {
+			continue
+		}
+		inst.Done = true
+		a, b := inst.Sides[0], inst.Sides[1]
+		switch {
+		case a.HP > b.HP:
+			inst.Winner = a.TeamID
+		case b.HP > a.HP:
+			inst.Winner = b.TeamID
+		}
+		delete(m.instances, id)
+		delete(m.byTeam, a.TeamID)
+		delete(m.byTeam, b.TeamID)
+		out = append(out, inst)
+	}
+	return out
+}
+
+// settleTeamPK records a finished battle: team scores, history, seer scores
+// and each team's last result.
+func settleTeamPK(deps *Deps, state *State, inst *teamPKInstance) {
+	cfg := state.teamPK.config(deps)
+	for k := range inst.Sides {
+		own, enemy := inst.Sides[k], inst.Sides[1-k]
+		res := teamPKResult{Result: teamPKDraw, OpponentID: enemy.TeamID, Score: cfg.DrawScore, HP: own.HP, EnemyHP: enemy.HP}
+		switch inst.Winner {
+		case own.TeamID:
+			res.Result, res.Score = teamPKWin, cfg.WinScore
+		case enemy.TeamID:
+			res.Result, res.Score = teamPKLose, cfg.LoseScore
+		}
+		state.teamPK.mu.Lock()
+		state.teamPK.results[own.TeamID] = res
+		state.teamPK.mu.Unlock()
+		saveTeamPKSide(deps, cfg, inst, own, enemy, res)
+	}
+}
+
+func saveTeamPKSide(deps *Deps, cfg teamPKConfig, inst *teamPKInstance, own, enemy *teamPKSide, res teamPKResult) {
+	if deps == nil || deps.Store == nil {
+		return
+	}
+	ctx := context.Background()
+	score, err := deps.Store.GetTeamPKScore(ctx, int64(own.TeamID), inst.Season)
+	if err != nil || score == nil {
+		score = &storage.TeamPKScore{TeamID: int64(own.TeamID), Season: inst.Season}
+	}
+	score.Name = own.Name
+	score.Score += res.Score
+	switch res.Result {
+	case teamPKWin:
+		score.Wins++
+	case teamPKLose:
+		score.Losses++
+	default:
+		score.Draws++
+	}
+	if _, err := deps.Store.SaveTeamPKScore(ctx, score); err != nil && deps.Logger != nil {
+		deps.Logger.Warn("team pk score save failed", zap.Uint32("team", own.TeamID), zap.Error(err))
+	}
+	_ = deps.Store.AddTeamPKHistory(ctx, &storage.TeamPKHistory{
+		TeamID:             int64(own.TeamID),
+		Season:             inst.Season,
+		OpponentID:         int64(enemy.TeamID),
+		OpponentName:       enemy.Name,
+		Result:             int(res.Result),
+		Score:              res.Score,
+		BuildingHP:         own.HP,
+		OpponentBuildingHP: enemy.HP,
+	})
+	for uid, hits := range own.Hits {
+		seer, err := deps.Store.GetTeamPKSeerScore(ctx, int64(uid), inst.Season)
+		if err != nil || seer == nil {
+			seer = &storage.TeamPKSeerScore{UserID: int64(uid), Season: inst.Season}
+		}
+		seer.TeamID = int64(own.TeamID)
+		seer.Nick = inst.Players[uid]
+		seer.Score += hits * cfg.HitScore
+		_, _ = deps.Store.SaveTeamPKSeerScore(ctx, seer)
+	}
+}
+
+func lastTeamPKResult(state *State, teamID uint32) (teamPKResult, bool) {
+	m := state.teamPK
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res, ok := m.results[teamID]
+	return res, ok
+}
+
+// weekStart returns Monday 00:00 of now's week.
+func weekStart(now time.Time) time.Time {
+	days := (int(now.Weekday()) + 6) % 7
+	d := now.AddDate(0, 0, -days)
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, now.Location())
+}
+
+// weeklyTeamPKScore sums the scores of this week's battles in history.
+func weeklyTeamPKScore(history []*storage.TeamPKHistory, now time.Time) int {
+	since := weekStart(now).Unix()
+	total := 0
+	for _, h := range history {
+		if h.CreatedAt >= since {
+			total += h.Score
+		}
+	}
+	return total
+}
+
+func runTeamPK(srv *gateway.Server, deps *Deps, state *State) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		tickTeamPK(srv, deps, state, now)
+	}
+}
+
+// tickTeamPK pairs registered teams once sign-up has closed and settles
+// battles that have ended.
+func tickTeamPK(srv *gateway.Server, deps *Deps, state *State, now time.Time) {
+	if !state.teamPK.config(deps).signUpOpen(now) {
+		for _, inst := range matchTeamPK(deps, state, now) {
+			for k, side := range inst.Sides {
+				enemy := inst.Sides[1-k]
+				from := &User{ID: enemy.TeamID, Nick: enemy.Name}
+				sendTeamInform(srv, state, teamMemberIDsOf(deps, state, side.TeamID), teamInformPKStart, side.TeamID, from)
+			}
+		}
+	}
+	for _, inst := range takeFinishedTeamPK(state, now) {
+		settleTeamPK(deps, state, inst)
+		for _, side := range inst.Sides {
+			body := buildTeamPKResultBody(state, side.TeamID)
+			for uid := range side.Players {
+				if conn, ok := state.GetConn(uid); ok {
+					srv.SendResponse(conn, 4013, uid, body)
+				}
+			}
+		}
+	}
+}
+
+func teamMemberIDsOf(deps *Deps, state *State, teamID uint32) []uint32 {
+	if t := getTeam(deps, state, teamID); t != nil {
+		return teamMemberIDs(t)
+	}
+	return nil
+}
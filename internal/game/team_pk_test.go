@@ -0,0 +1,159 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"jseer/internal/storage"
+)
+
+// Default config: sign-up Friday and Saturday 19:00-20:00.
+var (
+	teamPKSignUpTime = time.Date(2026, 10, 16, 19, 30, 0, 0, time.Local) // Friday
+	teamPKClosedTime = time.Date(2026, 10, 16, 20, 0, 0, 0, time.Local)
+)
+
+func TestTeamPKSignUpWindow(t *testing.T) {
+	cfg := defaultTeamPKConfig()
+	if !cfg.signUpOpen(teamPKSignUpTime) {
+		t.Fatal("window should be open")
+	}
+	if cfg.signUpOpen(teamPKClosedTime) || cfg.signUpOpen(teamPKSignUpTime.AddDate(0, 0, -1)) {
+		t.Fatal("window should be closed")
+	}
+}
+
+func newTeamPKBattle(t *testing.T) (*State, []*User, *teamPKInstance) {
+	t.Helper()
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2, 3)
+	_, red := createTeam(nil, state, users[0], "Red")
+	_, blue := createTeam(nil, state, users[1], "Blue")
+	applyToTeam(nil, state, users[2], blue.ID)
+	syncTeamMembers(state, blue)
+
+	if r := registerTeamPK(nil, state, users[2], teamPKSignUpTime); r != teamPKNoPriv {
+		t.Fatalf("member register=%d", r)
+	}
+	if r := registerTeamPK(nil, state, users[0], teamPKClosedTime); r != teamPKClosed {
+		t.Fatalf("closed register=%d", r)
+	}
+	if r := registerTeamPK(nil, state, users[0], teamPKSignUpTime); r != teamPKOK {
+		t.Fatalf("register=%d", r)
+	}
+	if r := registerTeamPK(nil, state, users[0], teamPKSignUpTime); r != teamPKRegistered {
+		t.Fatalf("register twice=%d", r)
+	}
+	registerTeamPK(nil, state, users[1], teamPKSignUpTime.Add(time.Minute))
+
+	started := matchTeamPK(nil, state, teamPKClosedTime)
+	if len(started) != 1 || started[0].Sides[0].TeamID != red.ID || started[0].Sides[1].TeamID != blue.ID {
+		t.Fatalf("started=%+v", started)
+	}
+	for _, u := range users {
+		if r, _ := joinTeamPK(state, u); r != teamPKOK {
+			t.Fatalf("join %d=%d", u.ID, r)
+		}
+	}
+	return state, users, started[0]
+}
+
+func TestTeamPKShootShieldAndFreeze(t *testing.T) {
+	state, users, inst := newTeamPKBattle(t)
+	cfg := defaultTeamPKConfig()
+	now := teamPKClosedTime
+
+	if r, _ := useTeamPKShield(nil, state, users[1]); r != teamPKOK {
+		t.Fatalf("shield=%d", r)
+	}
+	shootTeamPK(nil, state, users[0], now)
+	blue := inst.Sides[1]
+	if blue.HP != cfg.BuildingHP || blue.Shield != cfg.ShieldHP-cfg.ShotDamage {
+		t.Fatalf("shielded hp=%d shield=%d", blue.HP, blue.Shield)
+	}
+
+	if r, _ := freezeTeamPK(nil, state, users[1], 1, now); r != teamPKOK {
+		t.Fatalf("freeze=%d", r)
+	}
+	if r, _ := freezeTeamPK(nil, state, users[1], 3, now); r != teamPKBadTarget {
+		t.Fatalf("freeze teammate=%d", r)
+	}
+	if r, _ := shootTeamPK(nil, state, users[0], now); r != teamPKFrozen {
+		t.Fatalf("frozen shot=%d", r)
+	}
+	if r := unfreezeTeamPK(state, users[1], 1, now); r != teamPKBadTarget {
+		t.Fatalf("unfreeze enemy=%d", r)
+	}
+	if r, _ := shootTeamPK(nil, state, users[0], now.Add(time.Duration(cfg.FreezeSeconds)*time.Second)); r != teamPKOK {
+		t.Fatalf("shot after thaw=%d", r)
+	}
+	if len(takeFinishedTeamPK(state, now)) != 0 {
+		t.Fatal("battle ended early")
+	}
+}
+
+func TestTeamPKBuildingFallsAndSettles(t *testing.T) {
+	state, users, inst := newTeamPKBattle(t)
+	cfg := defaultTeamPKConfig()
+	interval := time.Duration(cfg.ShotIntervalMs) * time.Millisecond
+	for i := 0; i < cfg.BuildingHP/cfg.ShotDamage; i++ {
+		if r, _ := shootTeamPK(nil, state, users[0], teamPKClosedTime.Add(time.Duration(i)*interval)); r != teamPKOK {
+			t.Fatalf("shot %d=%d", i, r)
+		}
+	}
+	if !inst.Done || inst.Sides[1].HP != 0 {
+		t.Fatalf("done=%v hp=%d", inst.Done, inst.Sides[1].HP)
+	}
+	finished := takeFinishedTeamPK(state, teamPKClosedTime)
+	if len(finished) != 1 || finished[0].Winner != inst.Sides[0].TeamID {
+		t.Fatalf("finished=%+v", finished)
+	}
+	settleTeamPK(nil, state, finished[0])
+	if res, ok := lastTeamPKResult(state, inst.Sides[0].TeamID); !ok || res.Result != teamPKWin || res.Score != cfg.WinScore {
+		t.Fatalf("winner result=%+v", res)
+	}
+	if res, _ := lastTeamPKResult(state, inst.Sides[1].TeamID); res.Result != teamPKLose {
+		t.Fatalf("loser result=%+v", res)
+	}
+	if r, _ := joinTeamPK(state, users[0]); r != teamPKNoBattle {
+		t.Fatalf("join after end=%d", r)
+	}
+}
+
+func TestTeamPKShotIntervalAndFreezeCooldown(t *testing.T) {
+	state, users, _ := newTeamPKBattle(t)
+	cfg := defaultTeamPKConfig()
+	now := teamPKClosedTime
+
+	shootTeamPK(nil, state, users[0], now)
+	if r, _ := shootTeamPK(nil, state, users[0], now.Add(time.Millisecond)); r != teamPKCooldown {
+		t.Fatalf("rapid shot=%d", r)
+	}
+	if r, _ := shootTeamPK(nil, state, users[2], now.Add(time.Millisecond)); r != teamPKOK {
+		t.Fatalf("other player shot=%d", r)
+	}
+	if r, _ := shootTeamPK(nil, state, users[0], now.Add(time.Duration(cfg.ShotIntervalMs)*time.Millisecond)); r != teamPKOK {
+		t.Fatalf("shot after interval=%d", r)
+	}
+
+	freezeTeamPK(nil, state, users[0], 2, now)
+	thawed := now.Add(time.Duration(cfg.FreezeSeconds) * time.Second)
+	if r, _ := freezeTeamPK(nil, state, users[0], 2, thawed); r != teamPKCooldown {
+		t.Fatalf("refreeze=%d", r)
+	}
+	if r, _ := freezeTeamPK(nil, state, users[0], 2, now.Add(time.Duration(cfg.FreezeCooldown)*time.Second)); r != teamPKOK {
+		t.Fatalf("freeze after cooldown=%d", r)
+	}
+}
+
+func TestWeeklyTeamPKScore(t *testing.T) {
+	now := time.Date(2026, 10, 21, 12, 0, 0, 0, time.Local) // Wednesday
+	history := []*storage.TeamPKHistory{
+		{Score: 3, CreatedAt: time.Date(2026, 10, 19, 0, 0, 1, 0, time.Local).Unix()},
+		{Score: 1, CreatedAt: time.Date(2026, 10, 21, 9, 0, 0, 0, time.Local).Unix()},
+		{Score: 3, CreatedAt: time.Date(2026, 10, 18, 23, 0, 0, 0, time.Local).Unix()},
+	}
+	if got := weeklyTeamPKScore(history, now); got != 4 {
+		t.Fatalf("weekly=%d", got)
+	}
+}
@@ -25,6 +25,7 @@ type teamInfoPayload struct {
 	LogoColor         uint16 `json:"logoColor"`
 	TxtColor          uint16 `json:"txtColor"`
 	LogoWord          string `json:"logoWord"`
+	Imported          bool   `json:"imported,omitempty"`
 }
 
 func decodeTeamInfo(raw string) TeamInfo {
@@ -56,6 +57,7 @@ func decodeTeamInfo(raw string) TeamInfo {
 		LogoColor:         p.LogoColor,
 		TxtColor:          p.TxtColor,
 		LogoWord:          p.LogoWord,
+		Imported:          p.Imported,
 	}
 }
 
@@ -81,6 +83,7 @@ func encodeTeamInfo(info TeamInfo) string {
 		LogoColor:         info.LogoColor,
 		TxtColor:          info.TxtColor,
 		LogoWord:          info.LogoWord,
+		Imported:          info.Imported,
 	}
 	data, err := json.Marshal(p)
 	if err != nil {
@@ -0,0 +1,271 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"jseer/internal/storage"
+)
+
+// teamRowStore keeps team and member rows in maps; other Store methods are
+// not used on the team paths under test.
+type teamRowStore struct {
+	storage.Store
+	teams   map[int64]*storage.Team
+	members map[int64]*storage.TeamMember
+	// given records the donations AddTeamContribution committed; addErr
+	// makes it fail with that error instead.
+	given  []storage.TeamContribution
+	addErr error
+}
+
+func newTeamRowStore() *teamRowStore {
+	return &teamRowStore{teams: map[int64]*storage.Team{}, members: map[int64]*storage.TeamMember{}}
+}
+
+func (s *teamRowStore) CreateTeam(ctx context.Context, in *storage.Team) (*storage.Team, error) {
+	if _, err := s.GetTeamByName(ctx, in.Name); err == nil {
+		return nil, storage.ErrTeamNameTaken
+	}
+	row := *in
+	row.ID = int64(len(s.teams) + 1)
+	s.teams[row.ID] = &row
+	return &row, nil
+}
+
+func (s *teamRowStore) GetTeam(ctx context.Context, id int64) (*storage.Team, error) {
+	if row, ok := s.teams[id]; ok {
+		return row, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *teamRowStore) GetTeamByName(ctx context.Context, name string) (*storage.Team, error) {
+	for _, row := range s.teams {
+		if storage.TeamNameKey(row.Name) == storage.TeamNameKey(name) {
+			return row, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *teamRowStore) GetTeamByLegacyID(ctx context.Context, legacyID int64) (*storage.Team, error) {
+	for _, row := range s.teams {
+		if row.LegacyID == legacyID {
+			return row, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *teamRowStore) UpdateTeam(ctx context.Context, in *storage.Team) (*storage.Team, error) {
+	row := *in
+	s.teams[in.ID] = &row
+	return &row, nil
+}
+
+func (s *teamRowStore) ListTeamMembers(ctx context.Context, teamID int64) ([]*storage.TeamMember, error) {
+	var out []*storage.TeamMember
+	for _, m := range s.members {
+		if m.TeamID == teamID {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func (s *teamRowStore) GetTeamMember(ctx context.Context, userID int64) (*storage.TeamMember, error) {
+	if m, ok := s.members[userID]; ok {
+		return m, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *teamRowStore) SaveTeamMember(ctx context.Context, in *storage.TeamMember) (*storage.TeamMember, error) {
+	row := *in
+	s.members[in.UserID] = &row
+	return &row, nil
+}
+
+func (s *teamRowStore) AddTeamContribution(ctx context.Context, in *storage.TeamContribution) (*storage.Team, *storage.TeamMember, error) {
+	if s.addErr != nil {
+		return nil, nil, s.addErr
+	}
+	team, member := s.teams[in.TeamID], s.members[in.UserID]
+	team.Exp += in.Exp
+	member.Contribution += in.Contribution
+	member.CanExContribution += in.CanExContribution
+	s.given = append(s.given, *in)
+	return team, member, nil
+}
+
+func (s *teamRowStore) GetConfig(ctx context.Context, key string) (*storage.ConfigEntry, error) {
+	return nil, errors.New("not found")
+}
+
+func newTeamTestUsers(state *State, ids ...uint32) []*User {
+	out := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, state.GetOrCreateUser(id))
+	}
+	return out
+}
+
+func TestTeamCreateAndUniqueName(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2)
+	result, team := createTeam(nil, state, users[0], "Seers")
+	if result != teamOK || team == nil {
+		t.Fatalf("create=%d", result)
+	}
+	if users[0].Team.ID != team.ID || users[0].Team.Priv != teamPrivLeader || team.LeaderID != 1 {
+		t.Fatalf("leader view=%+v", users[0].Team)
+	}
+	if r, _ := createTeam(nil, state, users[1], "seers"); r != teamNameTaken {
+		t.Fatalf("duplicate name=%d", r)
+	}
+	if r, _ := createTeam(nil, state, users[0], "Other"); r != teamAlreadyIn {
+		t.Fatalf("second team=%d", r)
+	}
+	if r, _ := createTeam(nil, state, users[1], "  "); r != teamBadName {
+		t.Fatalf("blank name=%d", r)
+	}
+}
+
+func TestTeamApplyInviteAndAnswer(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2, 3, 4)
+	_, team := createTeam(nil, state, users[0], "Seers")
+
+	if r, _ := applyToTeam(nil, state, users[1], team.ID); r != teamOK {
+		t.Fatalf("open apply=%d", r)
+	}
+	updateTeam(nil, state, users[0], func(t *Team) { t.JoinFlag = teamJoinApprove })
+	if r, _ := applyToTeam(nil, state, users[2], team.ID); r != teamPending {
+		t.Fatalf("approve apply=%d", r)
+	}
+	if r, _, _ := answerTeam(nil, state, users[1], 3, true); r != teamNoPriv {
+		t.Fatalf("member answer=%d", r)
+	}
+	if r, _, id := answerTeam(nil, state, users[0], 3, true); r != teamOK || id != 3 {
+		t.Fatalf("admin answer=%d id=%d", r, id)
+	}
+
+	if r, _ := inviteToTeam(nil, state, users[1], 4); r != teamNoPriv {
+		t.Fatalf("member invite=%d", r)
+	}
+	if r, _ := inviteToTeam(nil, state, users[0], 4); r != teamOK {
+		t.Fatalf("invite=%d", r)
+	}
+	if r, _, _ := answerTeam(nil, state, users[3], team.ID, true); r != teamOK {
+		t.Fatalf("accept invite=%d", r)
+	}
+	if r, _, _ := answerTeam(nil, state, users[3], team.ID, true); r != teamNoRequest {
+		t.Fatalf("answer twice=%d", r)
+	}
+	syncTeamMembers(state, team)
+	if len(team.Members) != 4 || users[3].Team.ID != team.ID || users[3].Team.MemberCount != 4 {
+		t.Fatalf("members=%d view=%+v", len(team.Members), users[3].Team)
+	}
+	if team.Members[0].UserID != 1 {
+		t.Fatalf("leader not first: %+v", team.Members)
+	}
+	if page := teamMemberPage(team, 1, 3); len(page) != 1 || page[0].UserID != 4 {
+		t.Fatalf("page=%+v", page)
+	}
+
+	updateTeam(nil, state, users[0], func(t *Team) { t.JoinFlag = teamJoinClosed })
+	outsider := state.GetOrCreateUser(5)
+	if r, _ := applyToTeam(nil, state, outsider, team.ID); r != teamClosed {
+		t.Fatalf("closed apply=%d", r)
+	}
+}
+
+func TestTeamKickTransferAndDisband(t *testing.T) {
+	state := NewState()
+	users := newTeamTestUsers(state, 1, 2, 3)
+	_, team := createTeam(nil, state, users[0], "Seers")
+	applyToTeam(nil, state, users[1], team.ID)
+	applyToTeam(nil, state, users[2], team.ID)
+	syncTeamMembers(state, team)
+
+	if r, _ := kickTeamMember(nil, state, users[1], 3); r != teamNoPriv {
+		t.Fatalf("member kick=%d", r)
+	}
+	if r, _ := setTeamMemberPriv(nil, state, users[0], 2, teamPrivAdmin); r != teamOK {
+		t.Fatalf("promote=%d", r)
+	}
+	if r, _ := kickTeamMember(nil, state, users[1], 1); r != teamNoPriv {
+		t.Fatalf("admin kicks leader=%d", r)
+	}
+	if r, _ := kickTeamMember(nil, state, users[1], 3); r != teamOK || team.member(3) != nil {
+		t.Fatalf("admin kick=%d", r)
+	}
+
+	if r, _ := setTeamMemberPriv(nil, state, users[0], 2, teamPrivLeader); r != teamOK {
+		t.Fatalf("transfer=%d", r)
+	}
+	syncTeamMembers(state, team)
+	if team.LeaderID != 2 || users[0].Team.Priv != teamPrivAdmin || users[1].Team.Priv != teamPrivLeader {
+		t.Fatalf("after transfer leader=%d old=%d new=%d", team.LeaderID, users[0].Team.Priv, users[1].Team.Priv)
+	}
+
+	if r, _, disbanded := leaveTeam(nil, state, users[0]); r != teamOK || disbanded || users[0].Team.ID != 0 {
+		t.Fatalf("leave=%d disbanded=%v", r, disbanded)
+	}
+	if r, _, disbanded := leaveTeam(nil, state, users[1]); r != teamOK || !disbanded {
+		t.Fatalf("leader leave=%d disbanded=%v", r, disbanded)
+	}
+	if getTeam(nil, state, team.ID) != nil {
+		t.Fatal("team still registered after disband")
+	}
+}
+
+func TestRefreshUserTeamImportsLegacyTeamInfo(t *testing.T) {
+	state := NewState()
+	deps := &Deps{State: state, Store: newTeamRowStore()}
+	users := newTeamTestUsers(state, 1, 2)
+	users[1].Team = TeamInfo{ID: 77, Priv: teamPrivMember, Name: "Old Seers", AllContribution: 40, Slogan: "hi"}
+	users[0].Team = TeamInfo{ID: 77, Priv: teamPrivLeader, Name: "old seers"}
+
+	refreshUserTeam(deps, state, users[1])
+	team := userTeam(deps, state, users[1])
+	if team == nil || team.Name != "Old Seers" || team.Slogan != "hi" || users[1].Team.AllContribution != 40 {
+		t.Fatalf("member import team=%+v view=%+v", team, users[1].Team)
+	}
+	refreshUserTeam(deps, state, users[0])
+	if users[0].Team.ID != team.ID || users[0].Team.Priv != teamPrivLeader || team.LeaderID != 1 || len(team.Members) != 2 {
+		t.Fatalf("leader import view=%+v team=%+v", users[0].Team, team)
+	}
+	// Once imported, the member row wins over the saved TeamInfo.
+	refreshUserTeam(deps, state, users[1])
+	if len(team.Members) != 2 || users[1].Team.ID != team.ID {
+		t.Fatalf("reimport members=%d view=%+v", len(team.Members), users[1].Team)
+	}
+}
+
+func TestRefreshUserTeamImportsByLegacyIDOnce(t *testing.T) {
+	state := NewState()
+	store := newTeamRowStore()
+	deps := &Deps{State: state, Store: store}
+	users := newTeamTestUsers(state, 1, 2, 3)
+	users[0].Team = TeamInfo{ID: 77, Priv: teamPrivLeader, Name: "Seers"}
+	users[1].Team = TeamInfo{ID: 78, Priv: teamPrivMember, Name: "Seers"}
+
+	refreshUserTeam(deps, state, users[0])
+	refreshUserTeam(deps, state, users[1])
+	first, second := userTeam(deps, state, users[0]), userTeam(deps, state, users[1])
+	if first == nil || second == nil || first.ID == second.ID || second.Name != "Seers#78" {
+		t.Fatalf("same-name legacy teams first=%+v second=%+v", first, second)
+	}
+
+	// A view saved after the import is not imported again: a member kicked
+	// while offline stays out.
+	kicked := TeamInfo{ID: first.ID, Priv: teamPrivMember, Name: "Seers", Imported: true}
+	users[2].Team = decodeTeamInfo(encodeTeamInfo(kicked))
+	refreshUserTeam(deps, state, users[2])
+	if users[2].Team.ID != 0 || len(first.Members) != 1 {
+		t.Fatalf("kicked member view=%+v members=%d", users[2].Team, len(first.Members))
+	}
+}
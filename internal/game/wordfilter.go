@@ -0,0 +1,129 @@
+package game
+
+import (
+	"strings"
+	"unicode"
+)
+
+// wordFilter is an Aho–Corasick automaton over lower-cased runes, so one
+// pass over a message finds every listed word regardless of case.
+type wordFilter struct {
+	nodes []wordNode
+}
+
+type wordNode struct {
+	next map[rune]int
+	fail int
+	// out is the rune length of the longest word ending at this node,
+	// including words reached through fail links.
+	out int
+}
+
+func newWordFilter(words []string) *wordFilter {
+	f := &wordFilter{nodes: []wordNode{{next: map[rune]int{}}}}
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		cur, n := 0, 0
+		for _, r := range w {
+			r = unicode.ToLower(r)
+			nxt, ok := f.nodes[cur].next[r]
+			if !ok {
+				nxt = len(f.nodes)
+				f.nodes = append(f.nodes, wordNode{next: map[rune]int{}})
+				f.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+			n++
+		}
+		f.nodes[cur].out = maxInt(f.nodes[cur].out, n)
+	}
+
+	queue := make([]int, 0, len(f.nodes))
+	for _, child := range f.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range f.nodes[cur].next {
+			fail := f.nodes[cur].fail
+			for fail != 0 && !f.hasEdge(fail, r) {
+				fail = f.nodes[fail].fail
+			}
+			if nxt, ok := f.nodes[fail].next[r]; ok && nxt != child {
+				fail = nxt
+			} else {
+				fail = 0
+			}
+			f.nodes[child].fail = fail
+			f.nodes[child].out = maxInt(f.nodes[child].out, f.nodes[fail].out)
+			queue = append(queue, child)
+		}
+	}
+	return f
+}
+
+func (f *wordFilter) hasEdge(node int, r rune) bool {
+	_, ok := f.nodes[node].next[r]
+	return ok
+}
+
+func (f *wordFilter) step(cur int, r rune) int {
+	for {
+		if nxt, ok := f.nodes[cur].next[r]; ok {
+			return nxt
+		}
+		if cur == 0 {
+			return 0
+		}
+		cur = f.nodes[cur].fail
+	}
+}
+
+// Match reports whether s contains any listed word.
+func (f *wordFilter) Match(s string) bool {
+	if f == nil || len(f.nodes) == 1 {
+		return false
+	}
+	cur := 0
+	for _, r := range s {
+		cur = f.step(cur, unicode.ToLower(r))
+		if f.nodes[cur].out > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Mask replaces every rune that is part of a listed word with repl.
+func (f *wordFilter) Mask(s, repl string) string {
+	if f == nil || len(f.nodes) == 1 {
+		return s
+	}
+	runes := []rune(s)
+	masked := make([]bool, len(runes))
+	hit := false
+	cur := 0
+	for i, r := range runes {
+		cur = f.step(cur, unicode.ToLower(r))
+		for j := f.nodes[cur].out; j > 0; j-- {
+			masked[i-j+1] = true
+			hit = true
+		}
+	}
+	if !hit {
+		return s
+	}
+	var b strings.Builder
+	for i, r := range runes {
+		if masked[i] {
+			b.WriteString(repl)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,25 @@
+package game
+
+import "testing"
+
+func TestWordFilterMask(t *testing.T) {
+	f := newWordFilter([]string{"he", "she", "hers", "ab", "bc", "坏人", " "})
+	cases := []struct{ in, want string }{
+		{"ushers", "u*****"},
+		{"xabcx", "x***x"},
+		{"SHE said", "*** said"},
+		{"你是坏人吗", "你是**吗"},
+		{"clean text", "clean text"},
+	}
+	for _, c := range cases {
+		if got := f.Mask(c.in, "*"); got != c.want {
+			t.Fatalf("Mask(%q)=%q want %q", c.in, got, c.want)
+		}
+	}
+	if !f.Match("a HeRs b") || f.Match("hxe") {
+		t.Fatal("Match")
+	}
+	if newWordFilter(nil).Match("anything") {
+		t.Fatal("empty filter matched")
+	}
+}
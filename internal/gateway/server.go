@@ -34,6 +34,7 @@ type Server struct {
 	logger        *zap.Logger
 	handlers      map[int32]Handler
 	defaultHandle Handler
+	onDisconnect  []func(net.Conn)
 	mu            sync.RWMutex
 }
 
@@ -64,6 +65,23 @@ func (s *Server) SetDefault(h Handler) {
 	s.defaultHandle = h
 }
 
+// OnDisconnect registers a callback run after a client connection closes.
+func (s *Server) OnDisconnect(h func(net.Conn)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onDisconnect = append(s.onDisconnect, h)
+}
+
+func (s *Server) notifyDisconnect(conn net.Conn) {
+	s.mu.RLock()
+	hooks := make([]func(net.Conn), len(s.onDisconnect))
+	copy(hooks, s.onDisconnect)
+	s.mu.RUnlock()
+	for _, h := range hooks {
+		h(conn)
+	}
+}
+
 func (s *Server) Start(ctx context.Context) error {
 	ln, err := net.Listen("tcp", s.cfg.Address)
 	if err != nil {
@@ -89,6 +107,7 @@ func (s *Server) Start(ctx context.Context) error {
 }
 
 func (s *Server) handleConn(conn net.Conn) {
+	defer s.notifyDisconnect(conn)
 	defer conn.Close()
 	_ = conn.SetReadDeadline(time.Now().Add(time.Duration(s.cfg.HandshakeTimeoutS) * time.Second))
 	reader := bufio.NewReaderSize(conn, s.cfg.ReadBufferBytes)
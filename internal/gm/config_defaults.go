@@ -77,6 +77,8 @@ func defaultConfigSeeds() []configSeed {
 				"recycle_rate":      0.6,
 				"daily_reward_coin": 1000,
 				"daily_reward_gold": 10,
+				"pet_cure_cost":     50,
+				"pet_one_cure_cost": 20,
 			},
 		},
 	}
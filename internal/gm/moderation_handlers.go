@@ -0,0 +1,94 @@
+package gm
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/kataras/iris/v12"
+
+	"jseer/internal/storage"
+)
+
+type muteRequest struct {
+	UserID  int64  `json:"user_id"`
+	Minutes int64  `json:"minutes"`
+	Reason  string `json:"reason"`
+}
+
+func (s *Server) handleMuteList(ctx iris.Context) {
+	limit, _ := ctx.URLParamInt("limit")
+	if limit <= 0 {
+		limit = 100
+	}
+	activeAt := time.Now().Unix()
+	if ctx.URLParamExists("all") {
+		activeAt = 0
+	}
+	items, err := s.store.ListChatMutes(ctx.Request().Context(), activeAt, limit)
+	if err != nil {
+		s.fail(ctx, iris.StatusInternalServerError, err.Error())
+		return
+	}
+	s.ok(ctx, iris.Map{"items": items})
+}
+
+// handleMuteCreate mutes a player for the given minutes, replacing any
+// running mute.
+func (s *Server) handleMuteCreate(ctx iris.Context) {
+	var req muteRequest
+	if err := ctx.ReadJSON(&req); err != nil || req.UserID <= 0 || req.Minutes <= 0 {
+		s.fail(ctx, iris.StatusBadRequest, "invalid payload")
+		return
+	}
+	mute, err := s.store.SaveChatMute(ctx.Request().Context(), &storage.ChatMute{
+		UserID:   req.UserID,
+		Until:    time.Now().Add(time.Duration(req.Minutes) * time.Minute).Unix(),
+		Reason:   req.Reason,
+		Operator: s.operatorFromCtx(ctx),
+	})
+	if err != nil {
+		s.fail(ctx, iris.StatusInternalServerError, err.Error())
+		return
+	}
+	s.audit(ctx, "moderation.mute", "player", strconv.FormatInt(req.UserID, 10), req.Reason)
+	s.ok(ctx, mute)
+}
+
+// handleMuteDelete lifts a running mute. The row is kept so game servers
+// pick the change up on their next mute sync.
+func (s *Server) handleMuteDelete(ctx iris.Context) {
+	userID, err := ctx.Params().GetInt64("user_id")
+	if err != nil {
+		s.fail(ctx, iris.StatusBadRequest, "invalid user_id")
+		return
+	}
+	if err := s.store.LiftChatMute(ctx.Request().Context(), userID); err != nil {
+		s.fail(ctx, iris.StatusInternalServerError, err.Error())
+		return
+	}
+	s.audit(ctx, "moderation.unmute", "player", strconv.FormatInt(userID, 10), "mute lifted")
+	s.ok(ctx, iris.Map{"status": "ok"})
+}
+
+// handleChatLogSearch filters the chat log by user_id, target_id, channel,
+// keyword and a since/until Unix time range, newest first.
+func (s *Server) handleChatLogSearch(ctx iris.Context) {
+	q := storage.ChatLogQuery{
+		UserID:   ctx.URLParamInt64Default("user_id", 0),
+		TargetID: ctx.URLParamInt64Default("target_id", 0),
+		Channel:  ctx.URLParam("channel"),
+		Keyword:  ctx.URLParam("keyword"),
+		Since:    ctx.URLParamInt64Default("since", 0),
+		Until:    ctx.URLParamInt64Default("until", 0),
+		Limit:    ctx.URLParamIntDefault("limit", 100),
+	}
+	if q.Limit <= 0 || q.Limit > 1000 {
+		q.Limit = 100
+	}
+	items, err := s.store.SearchChatLogs(ctx.Request().Context(), q)
+	if err != nil {
+		s.fail(ctx, iris.StatusInternalServerError, err.Error())
+		return
+	}
+	s.ok(ctx, iris.Map{"items": items})
+}
@@ -0,0 +1,38 @@
+package gm
+
+import (
+	"encoding/json"
+
+	"github.com/kataras/iris/v12"
+)
+
+func (s *Server) handlePvPLeaderboard(ctx iris.Context) {
+	season, _ := ctx.URLParamInt("season")
+	if season <= 0 {
+		season = s.currentPvPSeason(ctx)
+	}
+	limit, _ := ctx.URLParamInt("limit")
+	if limit <= 0 {
+		limit = 50
+	}
+	items, err := s.store.ListPvPRatings(ctx.Request().Context(), season, limit)
+	if err != nil {
+		s.fail(ctx, iris.StatusInternalServerError, err.Error())
+		return
+	}
+	s.ok(ctx, iris.Map{"season": season, "items": items})
+}
+
+func (s *Server) currentPvPSeason(ctx iris.Context) int {
+	entry, err := s.store.GetConfig(ctx.Request().Context(), "pvp_season")
+	if err != nil || entry == nil {
+		return 1
+	}
+	var cfg struct {
+		Season int `json:"season"`
+	}
+	if json.Unmarshal(entry.Value, &cfg) != nil || cfg.Season <= 0 {
+		return 1
+	}
+	return cfg.Season
+}
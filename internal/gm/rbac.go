@@ -32,6 +32,9 @@ var defaultPermissions = []struct {
 	{"role.write", "角色管理", "新增/修改角色"},
 	{"permission.read", "权限查看", "查看权限"},
 	{"permission.write", "权限管理", "新增/修改权限"},
+	{"pvp.read", "天梯查看", "查看 PvP 排行榜"},
+	{"moderation.read", "聊天监管查看", "查看禁言与聊天记录"},
+	{"moderation.write", "聊天监管", "禁言与解除禁言"},
 }
 
 func (s *Server) requirePermission(code string) iris.Handler {
@@ -61,6 +61,13 @@ func NewServer(cfg config.GMConfig, store storage.Store, logger *zap.Logger) *Se
 
 	secured.Get("/audit", s.requirePermission("audit.read"), s.handleAuditList)
 
+	secured.Get("/pvp/leaderboard", s.requirePermission("pvp.read"), s.handlePvPLeaderboard)
+
+	secured.Get("/moderation/mutes", s.requirePermission("moderation.read"), s.handleMuteList)
+	secured.Post("/moderation/mutes", s.requirePermission("moderation.write"), s.handleMuteCreate)
+	secured.Delete("/moderation/mutes/{user_id:int}", s.requirePermission("moderation.write"), s.handleMuteDelete)
+	secured.Get("/moderation/chat-logs", s.requirePermission("moderation.read"), s.handleChatLogSearch)
+
 	s.bootstrap()
 	return s
 }
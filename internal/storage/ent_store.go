@@ -7,14 +7,23 @@ import (
 	"jseer/ent"
 	"jseer/ent/account"
 	"jseer/ent/auditlog"
+	"jseer/ent/chatlog"
+	"jseer/ent/chatmute"
 	"jseer/ent/configentry"
 	"jseer/ent/configversion"
 	"jseer/ent/gmuser"
 	"jseer/ent/item"
+	"jseer/ent/mentorship"
 	"jseer/ent/permission"
 	"jseer/ent/pet"
 	"jseer/ent/player"
+	"jseer/ent/pvprating"
 	"jseer/ent/role"
+	"jseer/ent/team"
+	"jseer/ent/teammember"
+	"jseer/ent/teampkhistory"
+	"jseer/ent/teampkscore"
+	"jseer/ent/teampkseerscore"
 
 	"jseer/internal/config"
 )
@@ -106,12 +115,20 @@ func (s *EntStore) CreatePlayer(ctx context.Context, in *Player) (*Player, error
 		SetTeamInfo(normalizeJSON(in.TeamInfo)).
 		SetStudentIds(normalizeJSONArray(in.StudentIDs)).
 		SetRoomID(in.RoomID).
+		SetRoomPrivacy(in.RoomPrivacy).
 		SetFitments(normalizeJSONArray(in.Fitments)).
 		SetNonoInfo(normalizeJSON(in.NonoInfo)).
 		SetMailbox(normalizeJSONArray(in.Mailbox)).
+		SetBossClears(normalizeJSONArray(in.BossClears)).
+		SetExpPool(in.ExpPool).
+		SetGraduationCount(in.GraduationCount).
+		SetIncubator(normalizeJSON(in.Incubator)).
+		SetSoulBeads(normalizeJSON(in.SoulBeads)).
+		SetItemBuffs(normalizeJSON(in.ItemBuffs)).
 		SetCurrentPetID(in.CurrentPetID).
 		SetCurrentPetCatchTime(in.CurrentPetCatchTime).
 		SetCurrentPetDv(in.CurrentPetDV).
+		SetNillableLastLoginAt(unixTimePtr(in.LastLoginAt)).
 		Save(ctx)
 	if err != nil {
 		return nil, err
@@ -148,12 +165,20 @@ func (s *EntStore) UpdatePlayer(ctx context.Context, in *Player) (*Player, error
 		SetTeamInfo(normalizeJSON(in.TeamInfo)).
 		SetStudentIds(normalizeJSONArray(in.StudentIDs)).
 		SetRoomID(in.RoomID).
+		SetRoomPrivacy(in.RoomPrivacy).
 		SetFitments(normalizeJSONArray(in.Fitments)).
 		SetNonoInfo(normalizeJSON(in.NonoInfo)).
 		SetMailbox(normalizeJSONArray(in.Mailbox)).
+		SetBossClears(normalizeJSONArray(in.BossClears)).
+		SetExpPool(in.ExpPool).
+		SetGraduationCount(in.GraduationCount).
+		SetIncubator(normalizeJSON(in.Incubator)).
+		SetSoulBeads(normalizeJSON(in.SoulBeads)).
+		SetItemBuffs(normalizeJSON(in.ItemBuffs)).
 		SetCurrentPetID(in.CurrentPetID).
 		SetCurrentPetCatchTime(in.CurrentPetCatchTime).
 		SetCurrentPetDv(in.CurrentPetDV).
+		SetNillableLastLoginAt(unixTimePtr(in.LastLoginAt)).
 		Save(ctx)
 	if err != nil {
 		return nil, err
@@ -242,6 +267,14 @@ func (s *EntStore) ListPetsByPlayer(ctx context.Context, playerID int64) ([]*Pet
 			Skills:    row.Skills,
 			CatchTime: row.CatchTime,
 			DV:        row.Dv,
+			Location:  row.Location,
+			Learned:   row.LearnedSkills,
+			EV:        row.Ev,
+			SkillPP:   row.SkillPp,
+			Status:    row.Status,
+			Fainted:   row.Fainted,
+			Collected: row.Collected,
+			RoomShow:  row.RoomShow,
 		})
 	}
 	return out, nil
@@ -264,7 +297,15 @@ func (s *EntStore) UpsertPet(ctx context.Context, in *Pet) (*Pet, error) {
 			SetCatchTime(in.CatchTime).
 			SetDv(in.DV).
 			SetSkills(in.Skills).
+			SetLearnedSkills(normalizeJSONArray(in.Learned)).
+			SetEv(normalizeJSON(in.EV)).
+			SetSkillPp(normalizeJSON(in.SkillPP)).
+			SetStatus(normalizeJSON(in.Status)).
+			SetFainted(in.Fainted).
+			SetCollected(in.Collected).
+			SetRoomShow(in.RoomShow).
 			SetNature(in.Nature).
+			SetLocation(in.Location).
 			Save(ctx)
 		if err != nil {
 			return nil, err
@@ -277,7 +318,15 @@ func (s *EntStore) UpsertPet(ctx context.Context, in *Pet) (*Pet, error) {
 			SetHp(in.HP).
 			SetDv(in.DV).
 			SetSkills(in.Skills).
+			SetLearnedSkills(normalizeJSONArray(in.Learned)).
+			SetEv(normalizeJSON(in.EV)).
+			SetSkillPp(normalizeJSON(in.SkillPP)).
+			SetStatus(normalizeJSON(in.Status)).
+			SetFainted(in.Fainted).
+			SetCollected(in.Collected).
+			SetRoomShow(in.RoomShow).
 			SetNature(in.Nature).
+			SetLocation(in.Location).
 			Save(ctx)
 		if err != nil {
 			return nil, err
@@ -294,9 +343,678 @@ func (s *EntStore) UpsertPet(ctx context.Context, in *Pet) (*Pet, error) {
 		Skills:    row.Skills,
 		CatchTime: row.CatchTime,
 		DV:        row.Dv,
+		Location:  row.Location,
+		Learned:   row.LearnedSkills,
+		EV:        row.Ev,
+		SkillPP:   row.SkillPp,
+		Status:    row.Status,
+		Fainted:   row.Fainted,
+		Collected: row.Collected,
+		RoomShow:  row.RoomShow,
 	}, nil
 }
 
+func (s *EntStore) DeletePet(ctx context.Context, playerID int64, catchTime int64) error {
+	_, err := s.client.Pet.Delete().
+		Where(pet.PlayerIDEQ(int(playerID)), pet.CatchTimeEQ(catchTime)).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *EntStore) GetPvPRating(ctx context.Context, playerID int64, season int) (*PvPRating, error) {
+	row, err := s.client.PvpRating.Query().
+		Where(pvprating.PlayerIDEQ(int(playerID)), pvprating.SeasonEQ(season)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapPvPRating(row), nil
+}
+
+func (s *EntStore) SavePvPRating(ctx context.Context, in *PvPRating) (*PvPRating, error) {
+	row, err := s.client.PvpRating.Query().
+		Where(pvprating.PlayerIDEQ(int(in.PlayerID)), pvprating.SeasonEQ(in.Season)).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		row, err = s.client.PvpRating.Create().
+			SetPlayerID(int(in.PlayerID)).
+			SetSeason(in.Season).
+			SetNick(in.Nick).
+			SetRating(in.Rating).
+			SetWins(in.Wins).
+			SetLosses(in.Losses).
+			SetDraws(in.Draws).
+			Save(ctx)
+	} else {
+		row, err = row.Update().
+			SetNick(in.Nick).
+			SetRating(in.Rating).
+			SetWins(in.Wins).
+			SetLosses(in.Losses).
+			SetDraws(in.Draws).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapPvPRating(row), nil
+}
+
+func (s *EntStore) ListPvPRatings(ctx context.Context, season int, limit int) ([]*PvPRating, error) {
+	q := s.client.PvpRating.Query().
+		Where(pvprating.SeasonEQ(season)).
+		WithPlayer().
+		Order(ent.Desc(pvprating.FieldRating), ent.Asc(pvprating.FieldUpdatedAt))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*PvPRating, 0, len(rows))
+	for _, row := range rows {
+		r := mapPvPRating(row)
+		if row.Edges.Player != nil {
+			r.Account = int64(row.Edges.Player.AccountID)
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (s *EntStore) CreateTeam(ctx context.Context, in *Team) (*Team, error) {
+	create := s.client.Team.Create().
+		SetName(in.Name).
+		SetNameKey(TeamNameKey(in.Name)).
+		SetLeaderID(in.LeaderID).
+		SetInterest(in.Interest).
+		SetJoinFlag(in.JoinFlag).
+		SetExp(in.Exp).
+		SetScore(in.Score).
+		SetSlogan(in.Slogan).
+		SetNotice(in.Notice).
+		SetLogoBg(in.LogoBg).
+		SetLogoIcon(in.LogoIcon).
+		SetLogoColor(in.LogoColor).
+		SetTxtColor(in.TxtColor).
+		SetLogoWord(in.LogoWord)
+	if in.LegacyID != 0 {
+		create.SetLegacyID(in.LegacyID)
+	}
+	row, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrTeamNameTaken
+		}
+		return nil, err
+	}
+	return mapTeam(row), nil
+}
+
+func (s *EntStore) GetTeam(ctx context.Context, id int64) (*Team, error) {
+	row, err := s.client.Team.Get(ctx, int(id))
+	if err != nil {
+		return nil, err
+	}
+	return mapTeam(row), nil
+}
+
+func (s *EntStore) GetTeamByName(ctx context.Context, name string) (*Team, error) {
+	row, err := s.client.Team.Query().Where(team.NameKeyEQ(TeamNameKey(name))).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapTeam(row), nil
+}
+
+func (s *EntStore) GetTeamByLegacyID(ctx context.Context, legacyID int64) (*Team, error) {
+	row, err := s.client.Team.Query().Where(team.LegacyIDEQ(legacyID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapTeam(row), nil
+}
+
+func (s *EntStore) UpdateTeam(ctx context.Context, in *Team) (*Team, error) {
+	row, err := s.client.Team.UpdateOneID(int(in.ID)).
+		SetLeaderID(in.LeaderID).
+		SetInterest(in.Interest).
+		SetJoinFlag(in.JoinFlag).
+		SetExp(in.Exp).
+		SetScore(in.Score).
+		SetSlogan(in.Slogan).
+		SetNotice(in.Notice).
+		SetLogoBg(in.LogoBg).
+		SetLogoIcon(in.LogoIcon).
+		SetLogoColor(in.LogoColor).
+		SetTxtColor(in.TxtColor).
+		SetLogoWord(in.LogoWord).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapTeam(row), nil
+}
+
+func (s *EntStore) DeleteTeam(ctx context.Context, id int64) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.TeamMember.Delete().Where(teammember.TeamIDEQ(int(id))).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.Team.Delete().Where(team.IDEQ(int(id))).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *EntStore) ListTeamMembers(ctx context.Context, teamID int64) ([]*TeamMember, error) {
+	rows, err := s.client.TeamMember.Query().
+		Where(teammember.TeamIDEQ(int(teamID))).
+		Order(ent.Desc(teammember.FieldPriv), ent.Asc(teammember.FieldJoinedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TeamMember, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapTeamMember(row))
+	}
+	return out, nil
+}
+
+func (s *EntStore) GetTeamMember(ctx context.Context, userID int64) (*TeamMember, error) {
+	row, err := s.client.TeamMember.Query().Where(teammember.UserIDEQ(userID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapTeamMember(row), nil
+}
+
+func (s *EntStore) SaveTeamMember(ctx context.Context, in *TeamMember) (*TeamMember, error) {
+	row, err := s.client.TeamMember.Query().Where(teammember.UserIDEQ(in.UserID)).Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		row, err = s.client.TeamMember.Create().
+			SetTeamID(int(in.TeamID)).
+			SetUserID(in.UserID).
+			SetNick(in.Nick).
+			SetPriv(in.Priv).
+			SetContribution(in.Contribution).
+			SetCanExContribution(in.CanExContribution).
+			SetIsShow(in.IsShow).
+			Save(ctx)
+	} else {
+		row, err = row.Update().
+			SetTeamID(int(in.TeamID)).
+			SetNick(in.Nick).
+			SetPriv(in.Priv).
+			SetContribution(in.Contribution).
+			SetCanExContribution(in.CanExContribution).
+			SetIsShow(in.IsShow).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapTeamMember(row), nil
+}
+
+func (s *EntStore) DeleteTeamMember(ctx context.Context, userID int64) error {
+	_, err := s.client.TeamMember.Delete().Where(teammember.UserIDEQ(userID)).Exec(ctx)
+	return err
+}
+
+func (s *EntStore) AddTeamContribution(ctx context.Context, in *TeamContribution) (*Team, *TeamMember, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	member, err := tx.TeamMember.Query().
+		Where(teammember.UserIDEQ(in.UserID), teammember.TeamIDEQ(int(in.TeamID))).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+	if member.CanExContribution+in.CanExContribution < 0 {
+		_ = tx.Rollback()
+		return nil, nil, ErrTeamContribution
+	}
+	member, err = member.Update().
+		AddContribution(in.Contribution).
+		AddCanExContribution(in.CanExContribution).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+	row, err := tx.Team.UpdateOneID(int(in.TeamID)).AddExp(in.Exp).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+	if in.Coins > 0 {
+		n, err := tx.Player.Update().
+			Where(player.IDEQ(int(in.PlayerID)), player.CoinsGTE(in.Coins)).
+			AddCoins(-in.Coins).
+			Save(ctx)
+		if err == nil && n == 0 {
+			err = ErrNotEnoughCoins
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, nil, err
+		}
+	}
+	if in.ItemCount > 0 {
+		if err := takeItemTx(ctx, tx, in.PlayerID, in.ItemID, in.ItemCount); err != nil {
+			_ = tx.Rollback()
+			return nil, nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return mapTeam(row), mapTeamMember(member), nil
+}
+
+// takeItemTx removes count of an item from a player, deleting the row once
+// none are left. It fails with ErrNotEnoughItems if the player has fewer.
+func takeItemTx(ctx context.Context, tx *ent.Tx, playerID int64, itemID int, count int) error {
+	row, err := tx.Item.Query().
+		Where(item.PlayerIDEQ(int(playerID)), item.ItemIDEQ(itemID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrNotEnoughItems
+	}
+	if err != nil {
+		return err
+	}
+	if row.Count < count {
+		return ErrNotEnoughItems
+	}
+	if row.Count == count {
+		return tx.Item.DeleteOne(row).Exec(ctx)
+	}
+	return row.Update().AddCount(-count).Exec(ctx)
+}
+
+func (s *EntStore) GetTeamPKScore(ctx context.Context, teamID int64, season int) (*TeamPKScore, error) {
+	row, err := s.client.TeamPkScore.Query().
+		Where(teampkscore.TeamIDEQ(teamID), teampkscore.SeasonEQ(season)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapTeamPKScore(row), nil
+}
+
+func (s *EntStore) SaveTeamPKScore(ctx context.Context, in *TeamPKScore) (*TeamPKScore, error) {
+	row, err := s.client.TeamPkScore.Query().
+		Where(teampkscore.TeamIDEQ(in.TeamID), teampkscore.SeasonEQ(in.Season)).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		row, err = s.client.TeamPkScore.Create().
+			SetTeamID(in.TeamID).
+			SetSeason(in.Season).
+			SetName(in.Name).
+			SetScore(in.Score).
+			SetWins(in.Wins).
+			SetLosses(in.Losses).
+			SetDraws(in.Draws).
+			Save(ctx)
+	} else {
+		row, err = row.Update().
+			SetName(in.Name).
+			SetScore(in.Score).
+			SetWins(in.Wins).
+			SetLosses(in.Losses).
+			SetDraws(in.Draws).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapTeamPKScore(row), nil
+}
+
+func (s *EntStore) ListTeamPKScores(ctx context.Context, season int, limit int) ([]*TeamPKScore, error) {
+	q := s.client.TeamPkScore.Query().
+		Where(teampkscore.SeasonEQ(season)).
+		Order(ent.Desc(teampkscore.FieldScore), ent.Asc(teampkscore.FieldUpdatedAt))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TeamPKScore, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapTeamPKScore(row))
+	}
+	return out, nil
+}
+
+func (s *EntStore) GetTeamPKSeerScore(ctx context.Context, userID int64, season int) (*TeamPKSeerScore, error) {
+	row, err := s.client.TeamPkSeerScore.Query().
+		Where(teampkseerscore.UserIDEQ(userID), teampkseerscore.SeasonEQ(season)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapTeamPKSeerScore(row), nil
+}
+
+func (s *EntStore) SaveTeamPKSeerScore(ctx context.Context, in *TeamPKSeerScore) (*TeamPKSeerScore, error) {
+	row, err := s.client.TeamPkSeerScore.Query().
+		Where(teampkseerscore.UserIDEQ(in.UserID), teampkseerscore.SeasonEQ(in.Season)).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		row, err = s.client.TeamPkSeerScore.Create().
+			SetUserID(in.UserID).
+			SetSeason(in.Season).
+			SetTeamID(in.TeamID).
+			SetNick(in.Nick).
+			SetScore(in.Score).
+			Save(ctx)
+	} else {
+		row, err = row.Update().
+			SetTeamID(in.TeamID).
+			SetNick(in.Nick).
+			SetScore(in.Score).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapTeamPKSeerScore(row), nil
+}
+
+func (s *EntStore) ListTeamPKSeerScores(ctx context.Context, season int, limit int) ([]*TeamPKSeerScore, error) {
+	q := s.client.TeamPkSeerScore.Query().
+		Where(teampkseerscore.SeasonEQ(season)).
+		Order(ent.Desc(teampkseerscore.FieldScore), ent.Asc(teampkseerscore.FieldUpdatedAt))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TeamPKSeerScore, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapTeamPKSeerScore(row))
+	}
+	return out, nil
+}
+
+func (s *EntStore) AddTeamPKHistory(ctx context.Context, in *TeamPKHistory) error {
+	_, err := s.client.TeamPkHistory.Create().
+		SetTeamID(in.TeamID).
+		SetSeason(in.Season).
+		SetOpponentID(in.OpponentID).
+		SetOpponentName(in.OpponentName).
+		SetResult(in.Result).
+		SetScore(in.Score).
+		SetBuildingHp(in.BuildingHP).
+		SetOpponentBuildingHp(in.OpponentBuildingHP).
+		Save(ctx)
+	return err
+}
+
+func (s *EntStore) ListTeamPKHistory(ctx context.Context, teamID int64, limit int) ([]*TeamPKHistory, error) {
+	q := s.client.TeamPkHistory.Query().
+		Where(teampkhistory.TeamIDEQ(teamID)).
+		Order(ent.Desc(teampkhistory.FieldCreatedAt))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TeamPKHistory, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &TeamPKHistory{
+			ID:                 int64(row.ID),
+			TeamID:             row.TeamID,
+			Season:             row.Season,
+			OpponentID:         row.OpponentID,
+			OpponentName:       row.OpponentName,
+			Result:             row.Result,
+			Score:              row.Score,
+			BuildingHP:         row.BuildingHp,
+			OpponentBuildingHP: row.OpponentBuildingHp,
+			CreatedAt:          row.CreatedAt.Unix(),
+		})
+	}
+	return out, nil
+}
+
+func (s *EntStore) CreateMentorship(ctx context.Context, in *Mentorship) (*Mentorship, error) {
+	row, err := s.client.Mentorship.Create().
+		SetTeacherID(in.TeacherID).
+		SetStudentID(in.StudentID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrMentorshipExists
+		}
+		return nil, err
+	}
+	return mapMentorship(row), nil
+}
+
+func (s *EntStore) GetMentorship(ctx context.Context, studentID int64) (*Mentorship, error) {
+	row, err := s.client.Mentorship.Query().Where(mentorship.StudentIDEQ(studentID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapMentorship(row), nil
+}
+
+func (s *EntStore) ListMentorships(ctx context.Context, teacherID int64) ([]*Mentorship, error) {
+	rows, err := s.client.Mentorship.Query().
+		Where(mentorship.TeacherIDEQ(teacherID)).
+		Order(ent.Asc(mentorship.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Mentorship, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapMentorship(row))
+	}
+	return out, nil
+}
+
+func (s *EntStore) DeleteMentorship(ctx context.Context, studentID int64) error {
+	_, err := s.client.Mentorship.Delete().Where(mentorship.StudentIDEQ(studentID)).Exec(ctx)
+	return err
+}
+
+func (s *EntStore) AddMentorshipExp(ctx context.Context, studentID int64, exp int64) (*Mentorship, error) {
+	row, err := s.client.Mentorship.Query().Where(mentorship.StudentIDEQ(studentID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err = row.Update().AddExpShared(exp).AddExpTotal(exp).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapMentorship(row), nil
+}
+
+func (s *EntStore) ClaimMentorshipExp(ctx context.Context, teacherID int64) (int64, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := tx.Mentorship.Query().Where(mentorship.TeacherIDEQ(teacherID)).All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	var total int64
+	for _, row := range rows {
+		total += row.ExpShared
+	}
+	if _, err := tx.Mentorship.Update().Where(mentorship.TeacherIDEQ(teacherID)).SetExpShared(0).Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (s *EntStore) SaveChatMute(ctx context.Context, in *ChatMute) (*ChatMute, error) {
+	row, err := s.client.ChatMute.Query().Where(chatmute.UserIDEQ(in.UserID)).Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		row, err = s.client.ChatMute.Create().
+			SetUserID(in.UserID).
+			SetUntil(time.Unix(in.Until, 0)).
+			SetReason(in.Reason).
+			SetOperator(in.Operator).
+			Save(ctx)
+	} else {
+		row, err = row.Update().
+			SetUntil(time.Unix(in.Until, 0)).
+			SetReason(in.Reason).
+			SetOperator(in.Operator).
+			SetCreatedAt(time.Now()).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapChatMute(row), nil
+}
+
+func (s *EntStore) GetChatMute(ctx context.Context, userID int64) (*ChatMute, error) {
+	row, err := s.client.ChatMute.Query().Where(chatmute.UserIDEQ(userID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapChatMute(row), nil
+}
+
+func (s *EntStore) LiftChatMute(ctx context.Context, userID int64) error {
+	_, err := s.client.ChatMute.Update().
+		Where(chatmute.UserIDEQ(userID), chatmute.UntilGT(time.Now())).
+		SetUntil(time.Now()).
+		Save(ctx)
+	return err
+}
+
+func (s *EntStore) ListChatMutes(ctx context.Context, activeAt int64, limit int) ([]*ChatMute, error) {
+	query := s.client.ChatMute.Query().Order(ent.Desc(chatmute.FieldCreatedAt))
+	if activeAt > 0 {
+		query = query.Where(chatmute.UntilGT(time.Unix(activeAt, 0)))
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*ChatMute, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapChatMute(row))
+	}
+	return out, nil
+}
+
+func (s *EntStore) ListChatMutesChangedSince(ctx context.Context, since int64) ([]*ChatMute, error) {
+	rows, err := s.client.ChatMute.Query().
+		Where(chatmute.UpdatedAtGTE(time.Unix(since, 0))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*ChatMute, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapChatMute(row))
+	}
+	return out, nil
+}
+
+func (s *EntStore) AddChatLog(ctx context.Context, in *ChatLog) error {
+	return s.client.ChatLog.Create().
+		SetUserID(in.UserID).
+		SetTargetID(in.TargetID).
+		SetChannel(in.Channel).
+		SetMessage(in.Message).
+		Exec(ctx)
+}
+
+func (s *EntStore) SearchChatLogs(ctx context.Context, q ChatLogQuery) ([]*ChatLog, error) {
+	query := s.client.ChatLog.Query().Order(ent.Desc(chatlog.FieldCreatedAt), ent.Desc(chatlog.FieldID))
+	if q.UserID != 0 {
+		query = query.Where(chatlog.UserIDEQ(q.UserID))
+	}
+	if q.TargetID != 0 {
+		query = query.Where(chatlog.TargetIDEQ(q.TargetID))
+	}
+	if q.Channel != "" {
+		query = query.Where(chatlog.ChannelEQ(q.Channel))
+	}
+	if q.Keyword != "" {
+		query = query.Where(chatlog.MessageContainsFold(q.Keyword))
+	}
+	if q.Since > 0 {
+		query = query.Where(chatlog.CreatedAtGTE(time.Unix(q.Since, 0)))
+	}
+	if q.Until > 0 {
+		query = query.Where(chatlog.CreatedAtLTE(time.Unix(q.Until, 0)))
+	}
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*ChatLog, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &ChatLog{
+			ID:        int64(row.ID),
+			UserID:    row.UserID,
+			TargetID:  row.TargetID,
+			Channel:   row.Channel,
+			Message:   row.Message,
+			CreatedAt: row.CreatedAt.Unix(),
+		})
+	}
+	return out, nil
+}
+
 func (s *EntStore) ListConfigKeys(ctx context.Context) ([]string, error) {
 	return s.client.ConfigEntry.Query().Select(configentry.FieldKey).Strings(ctx)
 }
@@ -890,12 +1608,146 @@ func mapPlayer(row *ent.Player) *Player {
 		TeamInfo:            row.TeamInfo,
 		StudentIDs:          row.StudentIds,
 		RoomID:              row.RoomID,
+		RoomPrivacy:         row.RoomPrivacy,
 		Fitments:            row.Fitments,
 		NonoInfo:            row.NonoInfo,
 		Mailbox:             row.Mailbox,
+		BossClears:          row.BossClears,
+		ExpPool:             row.ExpPool,
+		GraduationCount:     row.GraduationCount,
+		Incubator:           row.Incubator,
+		SoulBeads:           row.SoulBeads,
+		ItemBuffs:           row.ItemBuffs,
 		CurrentPetID:        row.CurrentPetID,
 		CurrentPetCatchTime: row.CurrentPetCatchTime,
 		CurrentPetDV:        row.CurrentPetDv,
+		LastLoginAt:         unixOrZero(row.LastLoginAt),
+	}
+}
+
+// unixTimePtr maps 0 to nil so an unset login time is left untouched.
+func unixTimePtr(sec int64) *time.Time {
+	if sec <= 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func mapPvPRating(row *ent.PvpRating) *PvPRating {
+	if row == nil {
+		return nil
+	}
+	return &PvPRating{
+		ID:        int64(row.ID),
+		PlayerID:  int64(row.PlayerID),
+		Season:    row.Season,
+		Nick:      row.Nick,
+		Rating:    row.Rating,
+		Wins:      row.Wins,
+		Losses:    row.Losses,
+		Draws:     row.Draws,
+		UpdatedAt: row.UpdatedAt.Unix(),
+	}
+}
+
+func mapTeamPKScore(row *ent.TeamPkScore) *TeamPKScore {
+	return &TeamPKScore{
+		ID:        int64(row.ID),
+		TeamID:    row.TeamID,
+		Season:    row.Season,
+		Name:      row.Name,
+		Score:     row.Score,
+		Wins:      row.Wins,
+		Losses:    row.Losses,
+		Draws:     row.Draws,
+		UpdatedAt: row.UpdatedAt.Unix(),
+	}
+}
+
+func mapTeamPKSeerScore(row *ent.TeamPkSeerScore) *TeamPKSeerScore {
+	return &TeamPKSeerScore{
+		ID:        int64(row.ID),
+		UserID:    row.UserID,
+		Season:    row.Season,
+		TeamID:    row.TeamID,
+		Nick:      row.Nick,
+		Score:     row.Score,
+		UpdatedAt: row.UpdatedAt.Unix(),
+	}
+}
+
+func mapMentorship(row *ent.Mentorship) *Mentorship {
+	return &Mentorship{
+		ID:        int64(row.ID),
+		TeacherID: row.TeacherID,
+		StudentID: row.StudentID,
+		ExpShared: row.ExpShared,
+		ExpTotal:  row.ExpTotal,
+		CreatedAt: row.CreatedAt.Unix(),
+	}
+}
+
+func mapChatMute(row *ent.ChatMute) *ChatMute {
+	return &ChatMute{
+		ID:        int64(row.ID),
+		UserID:    row.UserID,
+		Until:     row.Until.Unix(),
+		Reason:    row.Reason,
+		Operator:  row.Operator,
+		CreatedAt: row.CreatedAt.Unix(),
+		UpdatedAt: row.UpdatedAt.Unix(),
+	}
+}
+
+func mapTeam(row *ent.Team) *Team {
+	if row == nil {
+		return nil
+	}
+	out := &Team{
+		ID:        int64(row.ID),
+		Name:      row.Name,
+		LeaderID:  row.LeaderID,
+		Interest:  row.Interest,
+		JoinFlag:  row.JoinFlag,
+		Exp:       row.Exp,
+		Score:     row.Score,
+		Slogan:    row.Slogan,
+		Notice:    row.Notice,
+		LogoBg:    row.LogoBg,
+		LogoIcon:  row.LogoIcon,
+		LogoColor: row.LogoColor,
+		TxtColor:  row.TxtColor,
+		LogoWord:  row.LogoWord,
+		CreatedAt: row.CreatedAt.Unix(),
+	}
+	if row.LegacyID != nil {
+		out.LegacyID = *row.LegacyID
+	}
+	return out
+}
+
+func mapTeamMember(row *ent.TeamMember) *TeamMember {
+	if row == nil {
+		return nil
+	}
+	return &TeamMember{
+		ID:                int64(row.ID),
+		TeamID:            int64(row.TeamID),
+		UserID:            row.UserID,
+		Nick:              row.Nick,
+		Priv:              row.Priv,
+		Contribution:      row.Contribution,
+		CanExContribution: row.CanExContribution,
+		IsShow:            row.IsShow,
+		JoinedAt:          row.JoinedAt.Unix(),
 	}
 }
 
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -22,6 +23,16 @@ type memoryStore struct {
 	versions      map[string][]*ConfigVersion
 	items         map[int64][]*Item
 	pets          map[int64][]*Pet
+	pvpRatings    map[int64][]*PvPRating
+	nextTeamID    int64
+	teams         map[int64]*Team
+	teamMembers   map[int64]*TeamMember
+	pkScores      []*TeamPKScore
+	pkSeerScores  []*TeamPKSeerScore
+	pkHistory     []*TeamPKHistory
+	mentorships   map[int64]*Mentorship
+	chatMutes     map[int64]*ChatMute
+	chatLogs      []*ChatLog
 	audit         []*AuditLog
 	gmUsers       map[int64]*GMUser
 	gmRoles       map[int64]*GMRole
@@ -32,18 +43,23 @@ type memoryStore struct {
 
 func newMemoryStore() *memoryStore {
 	return &memoryStore{
-		accounts:  make(map[int64]*Account),
-		players:   make(map[int64]*Player),
-		config:    make(map[string]*ConfigEntry),
-		versions:  make(map[string][]*ConfigVersion),
-		items:     make(map[int64][]*Item),
-		pets:      make(map[int64][]*Pet),
-		audit:     make([]*AuditLog, 0),
-		gmUsers:   make(map[int64]*GMUser),
-		gmRoles:   make(map[int64]*GMRole),
-		gmPerms:   make(map[int64]*GMPermission),
-		userRoles: make(map[int64][]int64),
-		rolePerms: make(map[int64][]int64),
+		accounts:    make(map[int64]*Account),
+		players:     make(map[int64]*Player),
+		config:      make(map[string]*ConfigEntry),
+		versions:    make(map[string][]*ConfigVersion),
+		items:       make(map[int64][]*Item),
+		pets:        make(map[int64][]*Pet),
+		pvpRatings:  make(map[int64][]*PvPRating),
+		teams:       make(map[int64]*Team),
+		teamMembers: make(map[int64]*TeamMember),
+		mentorships: make(map[int64]*Mentorship),
+		chatMutes:   make(map[int64]*ChatMute),
+		audit:       make([]*AuditLog, 0),
+		gmUsers:     make(map[int64]*GMUser),
+		gmRoles:     make(map[int64]*GMRole),
+		gmPerms:     make(map[int64]*GMPermission),
+		userRoles:   make(map[int64][]int64),
+		rolePerms:   make(map[int64][]int64),
 	}
 }
 
@@ -123,11 +139,14 @@ func (s *memoryStore) UpdatePlayer(ctx context.Context, in *Player) (*Player, er
 	if in == nil {
 		return nil, errors.New("nil player")
 	}
-	_, ok := s.players[in.ID]
+	prev, ok := s.players[in.ID]
 	if !ok {
 		return nil, errors.New("not found")
 	}
 	copy := *in
+	if copy.LastLoginAt == 0 {
+		copy.LastLoginAt = prev.LastLoginAt
+	}
 	s.players[copy.ID] = &copy
 	return &copy, nil
 }
@@ -213,7 +232,15 @@ func (s *memoryStore) UpsertPet(ctx context.Context, in *Pet) (*Pet, error) {
 			it.HP = in.HP
 			it.DV = in.DV
 			it.Skills = in.Skills
+			it.Learned = in.Learned
+			it.EV = in.EV
+			it.SkillPP = in.SkillPP
+			it.Status = in.Status
+			it.Fainted = in.Fainted
+			it.Collected = in.Collected
+			it.RoomShow = in.RoomShow
 			it.Nature = in.Nature
+			it.Location = in.Location
 			copy := *it
 			return &copy, nil
 		}
@@ -224,6 +251,589 @@ func (s *memoryStore) UpsertPet(ctx context.Context, in *Pet) (*Pet, error) {
 	return &inCopy, nil
 }
 
+func (s *memoryStore) DeletePet(ctx context.Context, playerID int64, catchTime int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := s.pets[playerID]
+	next := list[:0]
+	for _, it := range list {
+		if it.CatchTime != catchTime {
+			next = append(next, it)
+		}
+	}
+	if len(next) == 0 {
+		delete(s.pets, playerID)
+	} else {
+		s.pets[playerID] = next
+	}
+	return nil
+}
+
+func (s *memoryStore) GetPvPRating(ctx context.Context, playerID int64, season int) (*PvPRating, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, r := range s.pvpRatings[playerID] {
+		if r.Season == season {
+			copy := *r
+			return &copy, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *memoryStore) SavePvPRating(ctx context.Context, in *PvPRating) (*PvPRating, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copy := *in
+	copy.UpdatedAt = time.Now().Unix()
+	list := s.pvpRatings[in.PlayerID]
+	for i, r := range list {
+		if r.Season == in.Season {
+			copy.ID = r.ID
+			list[i] = &copy
+			out := copy
+			return &out, nil
+		}
+	}
+	copy.ID = time.Now().UnixNano()
+	s.pvpRatings[in.PlayerID] = append(list, &copy)
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) CreateTeam(ctx context.Context, in *Team) (*Team, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.teams {
+		if TeamNameKey(t.Name) == TeamNameKey(in.Name) || (in.LegacyID != 0 && t.LegacyID == in.LegacyID) {
+			return nil, ErrTeamNameTaken
+		}
+	}
+	s.nextTeamID++
+	copy := *in
+	copy.ID = s.nextTeamID
+	copy.CreatedAt = time.Now().Unix()
+	s.teams[copy.ID] = &copy
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) GetTeamByName(ctx context.Context, name string) (*Team, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.teams {
+		if TeamNameKey(t.Name) == TeamNameKey(name) {
+			copy := *t
+			return &copy, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *memoryStore) GetTeamByLegacyID(ctx context.Context, legacyID int64) (*Team, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.teams {
+		if legacyID != 0 && t.LegacyID == legacyID {
+			copy := *t
+			return &copy, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *memoryStore) GetTeam(ctx context.Context, id int64) (*Team, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	t, ok := s.teams[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copy := *t
+	return &copy, nil
+}
+
+func (s *memoryStore) UpdateTeam(ctx context.Context, in *Team) (*Team, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.teams[in.ID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copy := *in
+	copy.Name = t.Name
+	copy.CreatedAt = t.CreatedAt
+	s.teams[in.ID] = &copy
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) DeleteTeam(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.teams, id)
+	for uid, m := range s.teamMembers {
+		if m.TeamID == id {
+			delete(s.teamMembers, uid)
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) ListTeamMembers(ctx context.Context, teamID int64) ([]*TeamMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*TeamMember, 0)
+	for _, m := range s.teamMembers {
+		if m.TeamID == teamID {
+			copy := *m
+			out = append(out, &copy)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Priv != out[j].Priv {
+			return out[i].Priv > out[j].Priv
+		}
+		return out[i].JoinedAt < out[j].JoinedAt
+	})
+	return out, nil
+}
+
+func (s *memoryStore) GetTeamMember(ctx context.Context, userID int64) (*TeamMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, ok := s.teamMembers[userID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copy := *m
+	return &copy, nil
+}
+
+func (s *memoryStore) SaveTeamMember(ctx context.Context, in *TeamMember) (*TeamMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copy := *in
+	if old, ok := s.teamMembers[in.UserID]; ok {
+		copy.ID = old.ID
+		copy.JoinedAt = old.JoinedAt
+	} else {
+		copy.ID = time.Now().UnixNano()
+		copy.JoinedAt = time.Now().Unix()
+	}
+	s.teamMembers[in.UserID] = &copy
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) AddTeamContribution(ctx context.Context, in *TeamContribution) (*Team, *TeamMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team, ok := s.teams[in.TeamID]
+	member, ok2 := s.teamMembers[in.UserID]
+	if !ok || !ok2 || member.TeamID != in.TeamID {
+		return nil, nil, errors.New("not found")
+	}
+	if member.CanExContribution+in.CanExContribution < 0 {
+		return nil, nil, ErrTeamContribution
+	}
+	p, ok := s.players[in.PlayerID]
+	if in.Coins > 0 && !ok {
+		return nil, nil, errors.New("not found")
+	}
+	if in.Coins > 0 && p.Coins < in.Coins {
+		return nil, nil, ErrNotEnoughCoins
+	}
+	if in.ItemCount > 0 && s.itemCountLocked(in.PlayerID, in.ItemID) < in.ItemCount {
+		return nil, nil, ErrNotEnoughItems
+	}
+	if in.Coins > 0 {
+		p.Coins -= in.Coins
+	}
+	if in.ItemCount > 0 {
+		s.takeItemLocked(in.PlayerID, in.ItemID, in.ItemCount)
+	}
+	member.Contribution += in.Contribution
+	member.CanExContribution += in.CanExContribution
+	team.Exp += in.Exp
+	t, m := *team, *member
+	return &t, &m, nil
+}
+
+// itemCountLocked is how many of an item a player holds. The caller holds
+// s.mu.
+func (s *memoryStore) itemCountLocked(playerID int64, itemID int) int {
+	n := 0
+	for _, it := range s.items[playerID] {
+		if it.ItemID == itemID {
+			n += it.Count
+		}
+	}
+	return n
+}
+
+// takeItemLocked removes count of an item from a player, dropping it once
+// none are left. The caller holds s.mu.
+func (s *memoryStore) takeItemLocked(playerID int64, itemID int, count int) {
+	list := s.items[playerID]
+	next := list[:0]
+	for _, it := range list {
+		if it.ItemID == itemID {
+			it.Count -= count
+			if it.Count <= 0 {
+				continue
+			}
+		}
+		next = append(next, it)
+	}
+	if len(next) == 0 {
+		delete(s.items, playerID)
+	} else {
+		s.items[playerID] = next
+	}
+}
+
+func (s *memoryStore) GetTeamPKScore(ctx context.Context, teamID int64, season int) (*TeamPKScore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, r := range s.pkScores {
+		if r.TeamID == teamID && r.Season == season {
+			copy := *r
+			return &copy, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *memoryStore) SaveTeamPKScore(ctx context.Context, in *TeamPKScore) (*TeamPKScore, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copy := *in
+	copy.UpdatedAt = time.Now().Unix()
+	for i, r := range s.pkScores {
+		if r.TeamID == in.TeamID && r.Season == in.Season {
+			copy.ID = r.ID
+			s.pkScores[i] = &copy
+			out := copy
+			return &out, nil
+		}
+	}
+	copy.ID = time.Now().UnixNano()
+	s.pkScores = append(s.pkScores, &copy)
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) ListTeamPKScores(ctx context.Context, season int, limit int) ([]*TeamPKScore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*TeamPKScore, 0)
+	for _, r := range s.pkScores {
+		if r.Season == season {
+			copy := *r
+			out = append(out, &copy)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].UpdatedAt < out[j].UpdatedAt
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (s *memoryStore) GetTeamPKSeerScore(ctx context.Context, userID int64, season int) (*TeamPKSeerScore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, r := range s.pkSeerScores {
+		if r.UserID == userID && r.Season == season {
+			copy := *r
+			return &copy, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *memoryStore) SaveTeamPKSeerScore(ctx context.Context, in *TeamPKSeerScore) (*TeamPKSeerScore, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copy := *in
+	copy.UpdatedAt = time.Now().Unix()
+	for i, r := range s.pkSeerScores {
+		if r.UserID == in.UserID && r.Season == in.Season {
+			copy.ID = r.ID
+			s.pkSeerScores[i] = &copy
+			out := copy
+			return &out, nil
+		}
+	}
+	copy.ID = time.Now().UnixNano()
+	s.pkSeerScores = append(s.pkSeerScores, &copy)
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) ListTeamPKSeerScores(ctx context.Context, season int, limit int) ([]*TeamPKSeerScore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*TeamPKSeerScore, 0)
+	for _, r := range s.pkSeerScores {
+		if r.Season == season {
+			copy := *r
+			out = append(out, &copy)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].UpdatedAt < out[j].UpdatedAt
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (s *memoryStore) AddTeamPKHistory(ctx context.Context, in *TeamPKHistory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copy := *in
+	copy.ID = time.Now().UnixNano()
+	copy.CreatedAt = time.Now().Unix()
+	s.pkHistory = append(s.pkHistory, &copy)
+	return nil
+}
+
+func (s *memoryStore) ListTeamPKHistory(ctx context.Context, teamID int64, limit int) ([]*TeamPKHistory, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*TeamPKHistory, 0)
+	for i := len(s.pkHistory) - 1; i >= 0; i-- {
+		if r := s.pkHistory[i]; r.TeamID == teamID {
+			copy := *r
+			out = append(out, &copy)
+			if limit > 0 && len(out) >= limit {
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) CreateMentorship(ctx context.Context, in *Mentorship) (*Mentorship, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.mentorships[in.StudentID]; ok {
+		return nil, ErrMentorshipExists
+	}
+	copy := *in
+	copy.ID = time.Now().UnixNano()
+	copy.CreatedAt = time.Now().Unix()
+	s.mentorships[copy.StudentID] = &copy
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) GetMentorship(ctx context.Context, studentID int64) (*Mentorship, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, ok := s.mentorships[studentID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copy := *m
+	return &copy, nil
+}
+
+func (s *memoryStore) ListMentorships(ctx context.Context, teacherID int64) ([]*Mentorship, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*Mentorship, 0)
+	for _, m := range s.mentorships {
+		if m.TeacherID == teacherID {
+			copy := *m
+			out = append(out, &copy)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+func (s *memoryStore) DeleteMentorship(ctx context.Context, studentID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.mentorships, studentID)
+	return nil
+}
+
+func (s *memoryStore) AddMentorshipExp(ctx context.Context, studentID int64, exp int64) (*Mentorship, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.mentorships[studentID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	m.ExpShared += exp
+	m.ExpTotal += exp
+	copy := *m
+	return &copy, nil
+}
+
+func (s *memoryStore) ClaimMentorshipExp(ctx context.Context, teacherID int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var total int64
+	for _, m := range s.mentorships {
+		if m.TeacherID == teacherID {
+			total += m.ExpShared
+			m.ExpShared = 0
+		}
+	}
+	return total, nil
+}
+
+func (s *memoryStore) SaveChatMute(ctx context.Context, in *ChatMute) (*ChatMute, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copy := *in
+	copy.ID = time.Now().UnixNano()
+	if prev, ok := s.chatMutes[in.UserID]; ok {
+		copy.ID = prev.ID
+	}
+	copy.CreatedAt = time.Now().Unix()
+	copy.UpdatedAt = copy.CreatedAt
+	s.chatMutes[copy.UserID] = &copy
+	out := copy
+	return &out, nil
+}
+
+func (s *memoryStore) GetChatMute(ctx context.Context, userID int64) (*ChatMute, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, ok := s.chatMutes[userID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copy := *m
+	return &copy, nil
+}
+
+func (s *memoryStore) LiftChatMute(ctx context.Context, userID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now().Unix()
+	if m, ok := s.chatMutes[userID]; ok && m.Until > now {
+		m.Until = now
+		m.UpdatedAt = now
+	}
+	return nil
+}
+
+func (s *memoryStore) ListChatMutes(ctx context.Context, activeAt int64, limit int) ([]*ChatMute, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*ChatMute, 0)
+	for _, m := range s.chatMutes {
+		if activeAt > 0 && m.Until <= activeAt {
+			continue
+		}
+		copy := *m
+		out = append(out, &copy)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt > out[j].CreatedAt })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (s *memoryStore) ListChatMutesChangedSince(ctx context.Context, since int64) ([]*ChatMute, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*ChatMute, 0)
+	for _, m := range s.chatMutes {
+		if m.UpdatedAt >= since {
+			copy := *m
+			out = append(out, &copy)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) AddChatLog(ctx context.Context, in *ChatLog) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copy := *in
+	copy.ID = int64(len(s.chatLogs) + 1)
+	copy.CreatedAt = time.Now().Unix()
+	s.chatLogs = append(s.chatLogs, &copy)
+	return nil
+}
+
+func (s *memoryStore) SearchChatLogs(ctx context.Context, q ChatLogQuery) ([]*ChatLog, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keyword := strings.ToLower(q.Keyword)
+	out := make([]*ChatLog, 0)
+	for i := len(s.chatLogs) - 1; i >= 0; i-- {
+		l := s.chatLogs[i]
+		switch {
+		case q.UserID != 0 && l.UserID != q.UserID,
+			q.TargetID != 0 && l.TargetID != q.TargetID,
+			q.Channel != "" && l.Channel != q.Channel,
+			keyword != "" && !strings.Contains(strings.ToLower(l.Message), keyword),
+			q.Since > 0 && l.CreatedAt < q.Since,
+			q.Until > 0 && l.CreatedAt > q.Until:
+			continue
+		}
+		copy := *l
+		out = append(out, &copy)
+		if q.Limit > 0 && len(out) >= q.Limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) DeleteTeamMember(ctx context.Context, userID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.teamMembers, userID)
+	return nil
+}
+
+func (s *memoryStore) ListPvPRatings(ctx context.Context, season int, limit int) ([]*PvPRating, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*PvPRating, 0)
+	for _, list := range s.pvpRatings {
+		for _, r := range list {
+			if r.Season == season {
+				copy := *r
+				if p, ok := s.players[r.PlayerID]; ok {
+					copy.Account = p.Account
+				}
+				out = append(out, &copy)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rating != out[j].Rating {
+			return out[i].Rating > out[j].Rating
+		}
+		return out[i].UpdatedAt < out[j].UpdatedAt
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
 func (s *memoryStore) ListConfigKeys(ctx context.Context) ([]string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
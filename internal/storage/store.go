@@ -1,6 +1,10 @@
 package storage
 
-import "context"
+import (
+	"context"
+	"errors"
+	"strings"
+)
 
 type Store interface {
 	Ping(ctx context.Context) error
@@ -20,6 +24,52 @@ type Store interface {
 	DeleteItem(ctx context.Context, playerID int64, itemID int) error
 	ListPetsByPlayer(ctx context.Context, playerID int64) ([]*Pet, error)
 	UpsertPet(ctx context.Context, in *Pet) (*Pet, error)
+	DeletePet(ctx context.Context, playerID int64, catchTime int64) error
+
+	// PvP ratings
+	GetPvPRating(ctx context.Context, playerID int64, season int) (*PvPRating, error)
+	SavePvPRating(ctx context.Context, in *PvPRating) (*PvPRating, error)
+	ListPvPRatings(ctx context.Context, season int, limit int) ([]*PvPRating, error)
+
+	// Teams
+	CreateTeam(ctx context.Context, in *Team) (*Team, error)
+	GetTeam(ctx context.Context, id int64) (*Team, error)
+	GetTeamByName(ctx context.Context, name string) (*Team, error)
+	GetTeamByLegacyID(ctx context.Context, legacyID int64) (*Team, error)
+	UpdateTeam(ctx context.Context, in *Team) (*Team, error)
+	DeleteTeam(ctx context.Context, id int64) error
+	ListTeamMembers(ctx context.Context, teamID int64) ([]*TeamMember, error)
+	GetTeamMember(ctx context.Context, userID int64) (*TeamMember, error)
+	SaveTeamMember(ctx context.Context, in *TeamMember) (*TeamMember, error)
+	DeleteTeamMember(ctx context.Context, userID int64) error
+	AddTeamContribution(ctx context.Context, in *TeamContribution) (*Team, *TeamMember, error)
+
+	// Team PK
+	GetTeamPKScore(ctx context.Context, teamID int64, season int) (*TeamPKScore, error)
+	SaveTeamPKScore(ctx context.Context, in *TeamPKScore) (*TeamPKScore, error)
+	ListTeamPKScores(ctx context.Context, season int, limit int) ([]*TeamPKScore, error)
+	GetTeamPKSeerScore(ctx context.Context, userID int64, season int) (*TeamPKSeerScore, error)
+	SaveTeamPKSeerScore(ctx context.Context, in *TeamPKSeerScore) (*TeamPKSeerScore, error)
+	ListTeamPKSeerScores(ctx context.Context, season int, limit int) ([]*TeamPKSeerScore, error)
+	AddTeamPKHistory(ctx context.Context, in *TeamPKHistory) error
+	ListTeamPKHistory(ctx context.Context, teamID int64, limit int) ([]*TeamPKHistory, error)
+
+	// Mentorships
+	CreateMentorship(ctx context.Context, in *Mentorship) (*Mentorship, error)
+	GetMentorship(ctx context.Context, studentID int64) (*Mentorship, error)
+	ListMentorships(ctx context.Context, teacherID int64) ([]*Mentorship, error)
+	DeleteMentorship(ctx context.Context, studentID int64) error
+	AddMentorshipExp(ctx context.Context, studentID int64, exp int64) (*Mentorship, error)
+	ClaimMentorshipExp(ctx context.Context, teacherID int64) (int64, error)
+
+	// Chat moderation
+	SaveChatMute(ctx context.Context, in *ChatMute) (*ChatMute, error)
+	GetChatMute(ctx context.Context, userID int64) (*ChatMute, error)
+	LiftChatMute(ctx context.Context, userID int64) error
+	ListChatMutes(ctx context.Context, activeAt int64, limit int) ([]*ChatMute, error)
+	ListChatMutesChangedSince(ctx context.Context, since int64) ([]*ChatMute, error)
+	AddChatLog(ctx context.Context, in *ChatLog) error
+	SearchChatLogs(ctx context.Context, q ChatLogQuery) ([]*ChatLog, error)
 
 	// Configs & versions
 	ListConfigKeys(ctx context.Context) ([]string, error)
@@ -101,9 +151,17 @@ type Player struct {
 	TeamInfo            string
 	StudentIDs          string
 	RoomID              int64
+	RoomPrivacy         int64
 	Fitments            string
 	NonoInfo            string
 	Mailbox             string
+	BossClears          string
+	ExpPool             int64
+	GraduationCount     int64
+	Incubator           string
+	SoulBeads           string
+	ItemBuffs           string
+	LastLoginAt         int64
 }
 
 type Item struct {
@@ -123,10 +181,188 @@ type Pet struct {
 	HP        int
 	Nature    string
 	Skills    string
+	Learned   string
+	EV        string
+	SkillPP   string
+	Status    string
+	Fainted   bool
+	Collected bool
+	RoomShow  bool
 	CatchTime int64
 	DV        int
+	Location  int
+}
+
+// PvPRating is one player's season rating. Account is only filled in by
+// ListPvPRatings so leaderboards need no per-row player lookup.
+type PvPRating struct {
+	ID        int64  `json:"id"`
+	PlayerID  int64  `json:"player_id"`
+	Account   int64  `json:"account,omitempty"`
+	Season    int    `json:"season"`
+	Nick      string `json:"nick"`
+	Rating    int    `json:"rating"`
+	Wins      int    `json:"wins"`
+	Losses    int    `json:"losses"`
+	Draws     int    `json:"draws"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+type Team struct {
+	ID        int64
+	LegacyID  int64
+	Name      string
+	LeaderID  int64
+	Interest  int
+	JoinFlag  int
+	Exp       int64
+	Score     int64
+	Slogan    string
+	Notice    string
+	LogoBg    int
+	LogoIcon  int
+	LogoColor int
+	TxtColor  int
+	LogoWord  string
+	CreatedAt int64
 }
 
+type TeamMember struct {
+	ID                int64
+	TeamID            int64
+	UserID            int64
+	Nick              string
+	Priv              int
+	Contribution      int64
+	CanExContribution int64
+	IsShow            bool
+	JoinedAt          int64
+}
+
+// TeamContribution is applied atomically to a team and one of its members.
+// CanExContribution may be negative when contribution is spent. Coins and
+// ItemCount are what the donating player (PlayerID) hands over; they are
+// taken from the player in the same transaction.
+type TeamContribution struct {
+	TeamID            int64
+	UserID            int64
+	Exp               int64
+	Contribution      int64
+	CanExContribution int64
+	PlayerID          int64
+	Coins             int64
+	ItemID            int
+	ItemCount         int
+}
+
+type TeamPKScore struct {
+	ID        int64
+	TeamID    int64
+	Season    int
+	Name      string
+	Score     int
+	Wins      int
+	Losses    int
+	Draws     int
+	UpdatedAt int64
+}
+
+type TeamPKSeerScore struct {
+	ID        int64
+	UserID    int64
+	Season    int
+	TeamID    int64
+	Nick      string
+	Score     int
+	UpdatedAt int64
+}
+
+// TeamPKHistory is one finished battle from TeamID's side. Result is 1 for
+// a win, 2 for a loss and 0 for a draw.
+type TeamPKHistory struct {
+	ID                 int64
+	TeamID             int64
+	Season             int
+	OpponentID         int64
+	OpponentName       string
+	Result             int
+	Score              int
+	BuildingHP         int
+	OpponentBuildingHP int
+	CreatedAt          int64
+}
+
+// Mentorship links StudentID to TeacherID. ExpShared is the unclaimed part
+// of ExpTotal.
+type Mentorship struct {
+	ID        int64
+	TeacherID int64
+	StudentID int64
+	ExpShared int64
+	ExpTotal  int64
+	CreatedAt int64
+}
+
+// ChatMute silences UserID until the Unix time Until. A lifted mute keeps
+// its row with Until set to the time it was lifted, so UpdatedAt covers
+// every change.
+type ChatMute struct {
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"user_id"`
+	Until     int64  `json:"until"`
+	Reason    string `json:"reason"`
+	Operator  string `json:"operator"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// ChatLog is one delivered chat line. TargetID is the whisper target or
+// team ID, 0 for map and world chat.
+type ChatLog struct {
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"user_id"`
+	TargetID  int64  `json:"target_id"`
+	Channel   string `json:"channel"`
+	Message   string `json:"message"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// ChatLogQuery filters SearchChatLogs; zero fields match everything.
+// Results are newest first.
+type ChatLogQuery struct {
+	UserID   int64
+	TargetID int64
+	Channel  string
+	Keyword  string
+	Since    int64
+	Until    int64
+	Limit    int
+}
+
+// ErrTeamNameTaken is returned by CreateTeam when the name is in use.
+var ErrTeamNameTaken = errors.New("team name taken")
+
+// TeamNameKey is the form team names are compared in: trimmed and lower
+// case, so names differing only in case collide.
+func TeamNameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// ErrTeamContribution is returned by AddTeamContribution when the member
+// cannot spend that much contribution.
+var ErrTeamContribution = errors.New("not enough team contribution")
+
+// ErrNotEnoughCoins and ErrNotEnoughItems are returned by
+// AddTeamContribution when the player holds less than they donate.
+var (
+	ErrNotEnoughCoins = errors.New("not enough coins")
+	ErrNotEnoughItems = errors.New("not enough items")
+)
+
+// ErrMentorshipExists is returned by CreateMentorship when the student
+// already has a teacher.
+var ErrMentorshipExists = errors.New("student already has a teacher")
+
 type ConfigEntry struct {
 	Key      string
 	Value    []byte